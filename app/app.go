@@ -266,6 +266,8 @@ func NewOsmosisApp(
 			ProtorevKeeper:     app.ProtoRevKeeper,
 			PoolManagerKeeper:  app.PoolManagerKeeper,
 			ConcentratedKeeper: app.ConcentratedLiquidityKeeper,
+			WasmKeeper:         app.WasmKeeper,
+			CommitMultiStore:   app.CommitMultiStore(),
 		}
 
 		sqsIngester, err := sqsConfig.Initialize(appCodec, sqsKeepers)
@@ -90,10 +90,12 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.DynamicSpreadFactorProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
+			poolmanagerclient.TakerFeeOverrideProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
 		},
 	),
@@ -125,7 +125,7 @@ var moduleAccountPermissions = map[string][]string{
 	wasmtypes.ModuleName:                          {authtypes.Burner},
 	tokenfactorytypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:                    {authtypes.Staking},
-	poolmanagertypes.ModuleName:                   nil,
+	poolmanagertypes.ModuleName:                   {authtypes.Minter},
 	cosmwasmpooltypes.ModuleName:                  nil,
 }
 
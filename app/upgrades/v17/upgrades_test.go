@@ -64,11 +64,12 @@ func dummyTwapRecord(poolId uint64, t time.Time, asset0 string, asset1 string, s
 		Asset0Denom: asset0,
 		Asset1Denom: asset1,
 
-		P0LastSpotPrice:             sp0,
-		P1LastSpotPrice:             osmomath.OneDec().Quo(sp0),
-		P0ArithmeticTwapAccumulator: accum0,
-		P1ArithmeticTwapAccumulator: accum1,
-		GeometricTwapAccumulator:    geomAccum,
+		P0LastSpotPrice:              sp0,
+		P1LastSpotPrice:              osmomath.OneDec().Quo(sp0),
+		P0ArithmeticTwapAccumulator:  accum0,
+		P1ArithmeticTwapAccumulator:  accum1,
+		GeometricTwapAccumulator:     geomAccum,
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 }
 
@@ -1,6 +1,8 @@
 package v21
 
 import (
+	"fmt"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -158,6 +160,12 @@ func CreateUpgradeHandler(
 
 		// Set CL param:
 		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyHookGasLimit, concentratedliquiditytypes.DefaultContractHookGasLimit)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyPositionNFTContract, "")
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeySpreadRewardMinPositionAgeBlocks, uint64(0))
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyRangeOrderMinNotional, concentratedliquiditytypes.DefaultRangeOrderMinNotional)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxRangeOrdersPerTick, concentratedliquiditytypes.DefaultMaxRangeOrdersPerTick)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxAutoCompoundPositionsPerEpoch, concentratedliquiditytypes.DefaultMaxAutoCompoundPositionsPerEpoch)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyAutoCompoundMaxSlippage, concentratedliquiditytypes.DefaultAutoCompoundMaxSlippage)
 
 		// Add protorev to the taker fee exclusion list:
 		protorevModuleAccount := keepers.AccountKeeper.GetModuleAccount(ctx, protorevtypes.ModuleName)
@@ -176,6 +184,23 @@ func CreateUpgradeHandler(
 		keepers.ProtoRevKeeper.SetCyclicArbProfitTrackerValue(ctx, allCyclicArbProfitsCoins)
 		keepers.ProtoRevKeeper.SetCyclicArbProfitTrackerStartHeight(ctx, ctx.BlockHeight())
 
+		// Existing TWAP records have a zero geometric variance accumulator, so volatility can only
+		// be computed for windows starting from the upgrade onwards.
+		keepers.TwapKeeper.SetGeometricVarianceAccumulatorStartTime(ctx, ctx.BlockTime())
+
+		// The pool manager mints the tokens in of simulated swaps within the cache context of the simulation.
+		poolManagerModuleAccount, ok := keepers.AccountKeeper.GetModuleAccount(ctx, poolmanagertypes.ModuleName).(*authtypes.ModuleAccount)
+		if !ok {
+			return nil, fmt.Errorf("account of module %s is not a module account", poolmanagertypes.ModuleName)
+		}
+		poolManagerModuleAccount.Permissions = []string{authtypes.Minter}
+		keepers.AccountKeeper.SetModuleAccount(ctx, poolManagerModuleAccount)
+
+		// Pools created before the upgrade are added to the index used by the aggregated TWAP query.
+		if err := keepers.TwapKeeper.IndexPoolsByDenomPair(ctx); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
@@ -533,6 +533,10 @@ adaptive-fee-enabled = "true"
 # SQS service is disabled by default.
 is-enabled = "false"
 
+# The storage backend of the sidecar query server. Either "redis" or "memory".
+# The in-memory backend keeps all state in the node process and ignores db-host and db-port.
+db-type = "{{ .SidecarQueryServerConfig.StorageType }}"
+
 # The hostname and address of the sidecar query server storage.
 db-host = "{{ .SidecarQueryServerConfig.StorageHost }}"
 db-port = "{{ .SidecarQueryServerConfig.StoragePort }}"
@@ -580,6 +584,42 @@ route-cache-enabled = "{{ .SidecarQueryServerConfig.Router.RouteCacheEnabled }}"
 # The number of seconds to cache routes for before expiry.
 route-cache-expiry-seconds = "{{ .SidecarQueryServerConfig.Router.RouteCacheExpirySeconds }}"
 
+# The list of code IDs of CosmWasm pools that are quoted by querying the pool contract
+# against a state snapshot taken at ingest time. All other CosmWasm pools are treated as transmuter pools.
+general-cosmwasm-code-ids = "{{ .SidecarQueryServerConfig.Router.GeneralCosmWasmCodeIDs }}"
+
+# The maximum gas a single CosmWasm pool contract query may consume when quoting.
+cosmwasm-query-gas-limit = "{{ .SidecarQueryServerConfig.Router.CosmWasmQueryGasLimit }}"
+
+# The maximum number of quote requests in a single batch quote request.
+max-batch-quote-requests = "{{ .SidecarQueryServerConfig.Router.MaxBatchQuoteRequests }}"
+
+# The number of most recent blocks of pool and taker fee state retained for historical quotes.
+# Quotes may be requested against a past height within this window. Zero disables the historical state.
+historical-state-retention-blocks = "{{ .SidecarQueryServerConfig.Router.HistoricalStateRetentionBlocks }}"
+
+# The price of a single unit of gas in uosmo, e.g. "0.0025".
+# Routes are ranked and split by the amount out less the gas cost valued in the token out.
+# Gas is not accounted for if empty.
+gas-price-uosmo = "{{ .SidecarQueryServerConfig.Router.GasPriceUOSMO }}"
+
+# The maximum number of token pairs a single quote stream connection may subscribe to.
+quote-stream-max-subscriptions-per-connection = "{{ .SidecarQueryServerConfig.QuoteStream.MaxSubscriptionsPerConnection }}"
+
+# The maximum number of distinct token pairs streamed across all clients.
+# Quotes for every streamed pair are recomputed after each block.
+quote-stream-max-topics = "{{ .SidecarQueryServerConfig.QuoteStream.MaxTopics }}"
+
+# The maximum number of distinct token pairs a single client may subscribe to across all of its connections.
+quote-stream-max-topics-per-client = "{{ .SidecarQueryServerConfig.QuoteStream.MaxTopicsPerClient }}"
+
+# The number of pending quote updates buffered per stream connection.
+# Once full, the oldest pending update is dropped in favor of the latest one.
+quote-stream-buffer-size = "{{ .SidecarQueryServerConfig.QuoteStream.BufferSize }}"
+
+# The number of most recent blocks of quote updates retained for resuming a stream from a height.
+quote-stream-resume-window-blocks = "{{ .SidecarQueryServerConfig.QuoteStream.ResumeWindowBlocks }}"
+
 ###############################################################################
 ###              		       Wasm Configuration    					    ###
 ###############################################################################
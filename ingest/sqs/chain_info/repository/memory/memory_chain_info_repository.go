@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+)
+
+type chainInfoRepo struct {
+	repositoryManager mvc.TxManager
+}
+
+const (
+	chainInfoNamespace  = "chainInfo"
+	latestHeightKey     = "latestHeight"
+	latestHeightTimeKey = "timeLatestHeight"
+)
+
+var (
+	_ mvc.ChainInfoRepository = &chainInfoRepo{}
+)
+
+// NewChainInfoRepo creates a new in-memory repository for chain information
+func NewChainInfoRepo(repositoryManager mvc.TxManager) mvc.ChainInfoRepository {
+	return &chainInfoRepo{
+		repositoryManager: repositoryManager,
+	}
+}
+
+// StoreLatestHeight implements mvc.ChainInfoRepository.
+func (r *chainInfoRepo) StoreLatestHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	return memoryTx.Set(chainInfoNamespace, latestHeightKey, []byte(strconv.FormatUint(height, 10)), 0)
+}
+
+// GetLatestHeight implements mvc.ChainInfoRepository.
+// Returns domain.ErrNotFound if the height was never stored.
+func (r *chainInfoRepo) GetLatestHeight(ctx context.Context) (uint64, error) {
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return 0, err
+	}
+
+	heightBytes, ok := memoryTx.Get(chainInfoNamespace, latestHeightKey)
+	if !ok {
+		return 0, domain.ErrNotFound
+	}
+
+	height, err := strconv.ParseUint(string(heightBytes), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing height: %v", err)
+	}
+
+	return height, nil
+}
+
+// GetLatestHeightRetrievalTime implements mvc.ChainInfoRepository.
+// Returns domain.ErrNotFound if the retrieval time was never stored.
+func (r *chainInfoRepo) GetLatestHeightRetrievalTime(ctx context.Context) (time.Time, error) {
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	timeBytes, ok := memoryTx.Get(chainInfoNamespace, latestHeightTimeKey)
+	if !ok {
+		return time.Time{}, domain.ErrNotFound
+	}
+
+	var retrievalTime time.Time
+	if err := retrievalTime.UnmarshalBinary(timeBytes); err != nil {
+		return time.Time{}, err
+	}
+
+	return retrievalTime, nil
+}
+
+// StoreLatestHeightRetrievalTime implements mvc.ChainInfoRepository.
+func (r *chainInfoRepo) StoreLatestHeightRetrievalTime(ctx context.Context, time time.Time) error {
+	tx := r.repositoryManager.StartTx()
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	// always in UTC
+	timeBytes, err := time.UTC().MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if err := memoryTx.Set(chainInfoNamespace, latestHeightTimeKey, timeBytes, 0); err != nil {
+		return err
+	}
+
+	return tx.Exec(ctx)
+}
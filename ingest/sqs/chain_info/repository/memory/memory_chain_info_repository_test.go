@@ -0,0 +1,78 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/memory"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
+)
+
+// Tests that the chain information is read back as stored and that
+// missing entries are reported with the backend-neutral not found error.
+func TestMemoryChainInfoRepo(t *testing.T) {
+	const defaultHeight = uint64(100)
+
+	ctx := context.Background()
+	txManager := memoryrepo.NewTxManager()
+	chainInfoRepo := memory.NewChainInfoRepo(txManager)
+
+	_, err := chainInfoRepo.GetLatestHeight(ctx)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = chainInfoRepo.GetLatestHeightRetrievalTime(ctx)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	tx := txManager.StartTx()
+	require.NoError(t, chainInfoRepo.StoreLatestHeight(ctx, tx, defaultHeight))
+
+	// Not visible before Exec.
+	_, err = chainInfoRepo.GetLatestHeight(ctx)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, tx.Exec(ctx))
+
+	height, err := chainInfoRepo.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, defaultHeight, height)
+
+	// The retrieval time is stored in UTC.
+	retrievalTime := time.Unix(1_700_000_000, 0).In(time.FixedZone("UTC+1", 60*60))
+	require.NoError(t, chainInfoRepo.StoreLatestHeightRetrievalTime(ctx, retrievalTime))
+
+	actualRetrievalTime, err := chainInfoRepo.GetLatestHeightRetrievalTime(ctx)
+	require.NoError(t, err)
+	require.Equal(t, retrievalTime.UTC(), actualRetrievalTime)
+
+	// Transactions of other storage backends are rejected.
+	err = chainInfoRepo.StoreLatestHeight(ctx, redisrepo.NewRedisTx(nil), defaultHeight)
+	require.ErrorIs(t, err, domain.UnexpectedTxBackendError{ExpectedBackend: "in-memory"})
+}
+
+// Tests that the chain info usecase stores the height retrieval time on the first read
+// when the repository reports that it is not found.
+func TestMemoryChainInfoRepo_Usecase(t *testing.T) {
+	const defaultHeight = uint64(100)
+
+	ctx := context.Background()
+	txManager := memoryrepo.NewTxManager()
+	chainInfoRepo := memory.NewChainInfoRepo(txManager)
+	chainInfoUsecase := usecase.NewChainInfoUsecase(time.Minute, chainInfoRepo, txManager)
+
+	tx := txManager.StartTx()
+	require.NoError(t, chainInfoRepo.StoreLatestHeight(ctx, tx, defaultHeight))
+	require.NoError(t, tx.Exec(ctx))
+
+	height, err := chainInfoUsecase.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, defaultHeight, height)
+
+	_, err = chainInfoRepo.GetLatestHeightRetrievalTime(ctx)
+	require.NoError(t, err)
+}
@@ -2,12 +2,17 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
 )
 
 type chainInfoRepo struct {
@@ -34,7 +39,7 @@ func NewChainInfoRepo(repositoryManager mvc.TxManager) *chainInfoRepo {
 
 // StoreLatestHeight stores the latest blockchain height into Redis
 func (r *chainInfoRepo) StoreLatestHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
@@ -55,9 +60,10 @@ func (r *chainInfoRepo) StoreLatestHeight(ctx context.Context, tx mvc.Tx, height
 }
 
 // GetLatestHeight retrieves the latest blockchain height from Redis
+// Returns domain.ErrNotFound if the height was never stored.
 func (r *chainInfoRepo) GetLatestHeight(ctx context.Context) (uint64, error) {
 	tx := r.repositoryManager.StartTx()
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return 0, err
 	}
@@ -71,6 +77,9 @@ func (r *chainInfoRepo) GetLatestHeight(ctx context.Context) (uint64, error) {
 	heightCmd := pipeliner.HGet(ctx, latestHeightKey, latestHeightField)
 
 	if err := tx.Exec(ctx); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, domain.ErrNotFound
+		}
 		return 0, err
 	}
 
@@ -84,9 +93,10 @@ func (r *chainInfoRepo) GetLatestHeight(ctx context.Context) (uint64, error) {
 }
 
 // GetLatestHeightRetrievalTime implements mvc.ChainInfoRepository.
+// Returns domain.ErrNotFound if the retrieval time was never stored.
 func (r *chainInfoRepo) GetLatestHeightRetrievalTime(ctx context.Context) (time.Time, error) {
 	tx := r.repositoryManager.StartTx()
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -99,6 +109,9 @@ func (r *chainInfoRepo) GetLatestHeightRetrievalTime(ctx context.Context) (time.
 	cmd := pipeliner.Get(ctx, latestHeightTimeKey)
 
 	if err := tx.Exec(ctx); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return time.Time{}, domain.ErrNotFound
+		}
 		return time.Time{}, err
 	}
 
@@ -115,7 +128,7 @@ func (r *chainInfoRepo) GetLatestHeightRetrievalTime(ctx context.Context) (time.
 // StoreLatestHeightRetrievalTime implements mvc.ChainInfoRepository.
 func (r *chainInfoRepo) StoreLatestHeightRetrievalTime(ctx context.Context, time time.Time) error {
 	tx := r.repositoryManager.StartTx()
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
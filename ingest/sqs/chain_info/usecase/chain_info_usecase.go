@@ -2,10 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"github.com/go-redis/redis"
-
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
 )
@@ -46,8 +45,7 @@ func (p *chainInfoUseCase) GetLatestHeight(ctx context.Context) (uint64, error)
 	if err != nil {
 		// If there is no entry, then we can assume that the height has never been retrieved,
 		// so we store the current time.
-		// TODO: clean up this error handling
-		if err.Error() == redis.Nil.Error() {
+		if errors.Is(err, domain.ErrNotFound) {
 			// Store the latest height retrieval time
 			if err := p.chainInfoRepository.StoreLatestHeightRetrievalTime(ctx, currentTimeUTC); err != nil {
 				return 0, err
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BatchQuoteRequest is a single request for the optimal quote
+// of swapping the token in for the token out denom within a batch.
+type BatchQuoteRequest struct {
+	TokenIn       sdk.Coin
+	TokenOutDenom string
+}
+
+// BatchQuoteResult is the result of a single request within a batch.
+// Exactly one of Quote and Error is set.
+type BatchQuoteResult struct {
+	TokenIn       sdk.Coin "json:\"token_in\""
+	TokenOutDenom string   "json:\"token_out_denom\""
+	Quote         Quote    "json:\"quote,omitempty\""
+	Error         string   "json:\"error,omitempty\""
+}
+
+// BatchQuote is the result of a batch of quote requests.
+// All quotes are computed against the pool state of the same height.
+type BatchQuote struct {
+	Height uint64 "json:\"height\""
+	// Results are in the same order as the requests.
+	Results []BatchQuoteResult "json:\"results\""
+}
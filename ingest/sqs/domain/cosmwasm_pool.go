@@ -0,0 +1,59 @@
+package domain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CosmWasmPoolQuerier runs queries against CosmWasm pool contracts
+// using the state snapshot captured at ingest time.
+type CosmWasmPoolQuerier interface {
+	// UpdateSnapshot records the block being ingested. Subsequent queries run against
+	// the chain state at the height of that block once it is committed.
+	// Called by the ingester at the end of every block.
+	UpdateSnapshot(ctx sdk.Context) error
+
+	// QuerySmart runs the smart query request against the contract with the given address.
+	// Gas consumption of the query is capped at gasLimit.
+	// Returns error if no block has been ingested yet, the ingested height is not committed yet,
+	// the gas limit is exceeded or the contract errors.
+	QuerySmart(contractAddress string, request []byte, gasLimit uint64) ([]byte, error)
+}
+
+// CosmWasmPoolRouterConfig encapsulates the parameters for routing through
+// generic CosmWasm pools that are quoted by querying the pool contract.
+type CosmWasmPoolRouterConfig struct {
+	// GeneralCosmWasmCodeIDs is the allowlist of code IDs of generic CosmWasm pools.
+	// CosmWasm pools with any other code ID are treated as transmuter pools.
+	GeneralCosmWasmCodeIDs map[uint64]struct{}
+	// QueryGasLimit is the maximum gas a single contract query may consume.
+	QueryGasLimit uint64
+	// Querier runs the contract queries.
+	Querier CosmWasmPoolQuerier
+}
+
+// NewCosmWasmPoolRouterConfig returns a new CosmWasm pool router config
+// from the given router config and querier.
+func NewCosmWasmPoolRouterConfig(routerConfig RouterConfig, querier CosmWasmPoolQuerier) CosmWasmPoolRouterConfig {
+	generalCosmWasmCodeIDs := make(map[uint64]struct{}, len(routerConfig.GeneralCosmWasmCodeIDs))
+	for _, codeID := range routerConfig.GeneralCosmWasmCodeIDs {
+		generalCosmWasmCodeIDs[codeID] = struct{}{}
+	}
+
+	return CosmWasmPoolRouterConfig{
+		GeneralCosmWasmCodeIDs: generalCosmWasmCodeIDs,
+		QueryGasLimit:          routerConfig.CosmWasmQueryGasLimit,
+		Querier:                querier,
+	}
+}
+
+// IsGeneralCosmWasmCodeID returns true if the pool with the given code ID
+// should be quoted by querying its contract.
+// Returns false if no querier is configured.
+func (c CosmWasmPoolRouterConfig) IsGeneralCosmWasmCodeID(codeID uint64) bool {
+	if c.Querier == nil {
+		return false
+	}
+
+	_, ok := c.GeneralCosmWasmCodeIDs[codeID]
+	return ok
+}
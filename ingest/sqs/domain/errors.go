@@ -3,6 +3,8 @@ package domain
 import (
 	"errors"
 	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 var (
@@ -131,3 +133,105 @@ type StaleHeightError struct {
 func (e StaleHeightError) Error() string {
 	return fmt.Sprintf("stored height (%d) is stale, time since last update (%d), max allowed seconds (%d)", e.StoredHeight, e.TimeSinceLastUpdate, e.MaxAllowedTimeDeltaSecs)
 }
+
+type CosmWasmPoolSnapshotNotSetError struct{}
+
+func (e CosmWasmPoolSnapshotNotSetError) Error() string {
+	return "cosmwasm pool state snapshot is not set"
+}
+
+type CosmWasmPoolSnapshotNotCommittedError struct {
+	Height       int64
+	LatestHeight int64
+}
+
+func (e CosmWasmPoolSnapshotNotCommittedError) Error() string {
+	return fmt.Sprintf("cosmwasm pool state snapshot at ingested height (%d) is not committed yet, latest committed height (%d)", e.Height, e.LatestHeight)
+}
+
+type CosmWasmPoolQueryError struct {
+	PoolId uint64
+	Err    error
+}
+
+func (e CosmWasmPoolQueryError) Error() string {
+	return fmt.Sprintf("failed to query cosmwasm pool (%d): %s", e.PoolId, e.Err)
+}
+
+type QuoteStreamSubscriptionLimitError struct {
+	NumSubscriptions int
+	MaxSubscriptions int
+}
+
+func (e QuoteStreamSubscriptionLimitError) Error() string {
+	return fmt.Sprintf("number of subscriptions (%d) must be between 1 and (%d)", e.NumSubscriptions, e.MaxSubscriptions)
+}
+
+type QuoteStreamTopicLimitError struct {
+	NumTopics int
+	MaxTopics int
+}
+
+func (e QuoteStreamTopicLimitError) Error() string {
+	return fmt.Sprintf("number of streamed token pairs (%d) would exceed the limit (%d)", e.NumTopics, e.MaxTopics)
+}
+
+type QuoteStreamClientTopicLimitError struct {
+	ClientID  string
+	NumTopics int
+	MaxTopics int
+}
+
+func (e QuoteStreamClientTopicLimitError) Error() string {
+	return fmt.Sprintf("number of token pairs subscribed to by client (%s) (%d) would exceed the limit (%d)", e.ClientID, e.NumTopics, e.MaxTopics)
+}
+
+type BatchQuoteRequestLimitError struct {
+	NumRequests int
+	MaxRequests int
+}
+
+func (e BatchQuoteRequestLimitError) Error() string {
+	return fmt.Sprintf("number of batch quote requests (%d) must be between 1 and (%d)", e.NumRequests, e.MaxRequests)
+}
+
+type BatchQuoteInconsistentHeightError struct {
+	Attempts int
+}
+
+func (e BatchQuoteInconsistentHeightError) Error() string {
+	return fmt.Sprintf("failed to read pool state at a consistent height after (%d) attempts", e.Attempts)
+}
+
+type HistoricalStateNotFoundError struct {
+	Height uint64
+}
+
+func (e HistoricalStateNotFoundError) Error() string {
+	return fmt.Sprintf("state at height (%d) is not found. It is either outside of the retention window or not yet ingested", e.Height)
+}
+
+type InvalidSlippageToleranceError struct {
+	SlippageTolerance osmomath.Dec
+}
+
+func (e InvalidSlippageToleranceError) Error() string {
+	return fmt.Sprintf("slippage tolerance (%s) must be greater than or equal to zero and less than one", e.SlippageTolerance)
+}
+
+type ZeroTokenOutMinAmountError struct {
+	AmountOut         osmomath.Int
+	SlippageTolerance osmomath.Dec
+}
+
+func (e ZeroTokenOutMinAmountError) Error() string {
+	return fmt.Sprintf("token out min amount is zero for amount out (%s) and slippage tolerance (%s)", e.AmountOut, e.SlippageTolerance)
+}
+
+type UnexpectedTxBackendError struct {
+	ExpectedBackend string
+}
+
+func (e UnexpectedTxBackendError) Error() string {
+	return fmt.Sprintf("transaction is not a %s transaction", e.ExpectedBackend)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+)
+
+// HistoricalHeightKeyType is a custom type for the historical height key.
+type HistoricalHeightKeyType string
+
+const (
+	// HistoricalHeightCtxKey is the key used to store the height of the historical state to read in the request context
+	HistoricalHeightCtxKey HistoricalHeightKeyType = "historical_height"
+)
+
+// WithHistoricalHeight returns a copy of the context that instructs the repositories
+// to read the state ingested at the given height instead of the latest state.
+func WithHistoricalHeight(ctx context.Context, height uint64) context.Context {
+	return context.WithValue(ctx, HistoricalHeightCtxKey, height)
+}
+
+// GetHistoricalHeightFromContext returns the height of the historical state to read and true
+// if it is set on the context. Returns false if the latest state is to be read.
+func GetHistoricalHeightFromContext(ctx context.Context) (uint64, bool) {
+	height, ok := ctx.Value(HistoricalHeightCtxKey).(uint64)
+	return height, ok
+}
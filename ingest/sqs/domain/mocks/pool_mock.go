@@ -22,6 +22,8 @@ type MockRoutablePool struct {
 	TokenOutDenom        string
 	TakerFee             osmomath.Dec
 	SpreadFactor         osmomath.Dec
+	TakerFeeOverride     *osmomath.Dec
+	GasEstimate          uint64
 
 	mockedTokenOut sdk.Coin
 }
@@ -68,6 +70,7 @@ func (mp *MockRoutablePool) GetSQSPoolModel() domain.SQSPool {
 		TotalValueLockedUSDC: mp.TotalValueLockedUSDC,
 		SpreadFactor:         DefaultSpreadFactor,
 		PoolDenoms:           mp.Denoms,
+		TakerFeeOverride:     mp.TakerFeeOverride,
 	}
 }
 
@@ -91,6 +94,30 @@ func (mp *MockRoutablePool) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Co
 	return balancerPool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(tokenIn), mp.TokenOutDenom, mp.SpreadFactor)
 }
 
+// CalculateTokenOutByTokenInWithGas implements domain.RoutablePool.
+func (mp *MockRoutablePool) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	tokenOut, err := mp.CalculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	return tokenOut, mp.GasEstimate, nil
+}
+
+// CalculateTokenInByTokenOut implements routerusecase.RoutablePool.
+func (mp *MockRoutablePool) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	if mp.PoolType == poolmanagertypes.CosmWasm {
+		return sdk.NewCoin(tokenInDenom, tokenOut.Amount), nil
+	}
+
+	// Cast to balancer
+	balancerPool, ok := mp.ChainPoolModel.(*balancer.Pool)
+	if !ok {
+		panic("not a balancer pool")
+	}
+
+	return balancerPool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(tokenOut), tokenInDenom, mp.SpreadFactor)
+}
+
 // String implements domain.RoutablePool.
 func (*MockRoutablePool) String() string {
 	panic("unimplemented")
@@ -123,6 +150,11 @@ func (mp *MockRoutablePool) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (tokenInAfte
 	return tokenIn.Sub(sdk.NewCoin(tokenIn.Denom, mp.TakerFee.Mul(tokenIn.Amount.ToLegacyDec()).TruncateInt()))
 }
 
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+func (mp *MockRoutablePool) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	return tokenIn.Add(sdk.NewCoin(tokenIn.Denom, mp.TakerFee.Mul(tokenIn.Amount.ToLegacyDec()).TruncateInt()))
+}
+
 // GetTakerFee implements domain.PoolI.
 func (mp *MockRoutablePool) GetTakerFee() math.LegacyDec {
 	return mp.TakerFee
@@ -164,6 +196,7 @@ func deepCopyPool(mp *MockRoutablePool) *MockRoutablePool {
 		Denoms:               newDenoms,
 		TotalValueLockedUSDC: newTotalValueLocker,
 		PoolType:             mp.PoolType,
+		GasEstimate:          mp.GasEstimate,
 
 		// Note these are not deep copied.
 		ChainPoolModel: mp.ChainPoolModel,
@@ -207,6 +240,12 @@ func WithChainPoolModel(mockPool *MockRoutablePool, chainPool poolmanagertypes.P
 	return newPool
 }
 
+func WithGasEstimate(mockPool *MockRoutablePool, gasEstimate uint64) *MockRoutablePool {
+	newPool := deepCopyPool(mockPool)
+	newPool.GasEstimate = gasEstimate
+	return newPool
+}
+
 func WithTakerFee(mockPool *MockRoutablePool, takerFee osmomath.Dec) *MockRoutablePool {
 	newPool := deepCopyPool(mockPool)
 	newPool.TakerFee = takerFee
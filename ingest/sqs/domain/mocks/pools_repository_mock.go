@@ -45,3 +45,13 @@ func (r *RedisPoolsRepositoryMock) StorePools(ctx context.Context, tx mvc.Tx, al
 	r.Pools = allPools
 	return nil
 }
+
+// StorePoolsAtHeight implements mvc.PoolsRepository.
+func (*RedisPoolsRepositoryMock) StorePoolsAtHeight(ctx context.Context, tx mvc.Tx, height uint64, pools []domain.PoolI) error {
+	panic("unimplemented")
+}
+
+// DeletePoolsUpToHeight implements mvc.PoolsRepository.
+func (*RedisPoolsRepositoryMock) DeletePoolsUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	panic("unimplemented")
+}
@@ -19,7 +19,7 @@ type PoolsUsecaseMock struct {
 // GetRoutesFromCandidates implements mvc.PoolsUsecase.
 // Note that taker fee are ignored and not set
 // Note that tick models are not set
-func (pm *PoolsUsecaseMock) GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, tokenInDenom string, tokenOutDenom string) ([]route.RouteImpl, error) {
+func (pm *PoolsUsecaseMock) GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom string, tokenOutDenom string) ([]route.RouteImpl, error) {
 	finalRoutes := make([]route.RouteImpl, 0, len(candidateRoutes.Routes))
 	for _, candidateRoute := range candidateRoutes.Routes {
 		routablePools := make([]domain.RoutablePool, 0, len(candidateRoute.Pools))
@@ -37,7 +37,33 @@ func (pm *PoolsUsecaseMock) GetRoutesFromCandidates(ctx context.Context, candida
 			}
 
 			// TODO: note that taker fee is force set to zero
-			routablePool := pools.NewRoutablePool(foundPool, candidatePool.TokenOutDenom, osmomath.ZeroDec())
+			routablePool := pools.NewRoutablePool(foundPool, candidatePool.TokenOutDenom, osmomath.ZeroDec(), domain.CosmWasmPoolRouterConfig{})
+			routablePools = append(routablePools, routablePool)
+		}
+
+		finalRoutes = append(finalRoutes, route.RouteImpl{
+			Pools: routablePools,
+		})
+	}
+
+	return finalRoutes, nil
+}
+
+// ConvertCandidateRoutes implements mvc.PoolsUsecase.
+// Note that taker fee are ignored and not set
+// Note that tick models are not set
+func (pm *PoolsUsecaseMock) ConvertCandidateRoutes(candidateRoutes route.CandidateRoutes, poolsByID map[uint64]domain.PoolI, tickModelMap map[uint64]domain.TickModel, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom string) ([]route.RouteImpl, error) {
+	finalRoutes := make([]route.RouteImpl, 0, len(candidateRoutes.Routes))
+	for _, candidateRoute := range candidateRoutes.Routes {
+		routablePools := make([]domain.RoutablePool, 0, len(candidateRoute.Pools))
+		for _, candidatePool := range candidateRoute.Pools {
+			foundPool, ok := poolsByID[candidatePool.ID]
+			if !ok {
+				return nil, fmt.Errorf("pool with id %d not found in given pools", candidatePool.ID)
+			}
+
+			// TODO: note that taker fee is force set to zero
+			routablePool := pools.NewRoutablePool(foundPool, candidatePool.TokenOutDenom, osmomath.ZeroDec(), domain.CosmWasmPoolRouterConfig{})
 			routablePools = append(routablePools, routablePool)
 		}
 
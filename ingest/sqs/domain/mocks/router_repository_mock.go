@@ -11,8 +11,9 @@ import (
 )
 
 type RedisRouterRepositoryMock struct {
-	TakerFees domain.TakerFeeMap
-	Routes    map[domain.DenomPair]route.CandidateRoutes
+	TakerFees      domain.TakerFeeMap
+	RouteTakerFees domain.RouteTakerFeeMap
+	Routes         map[domain.DenomPair]route.CandidateRoutes
 }
 
 // GetAllTakerFees implements domain.RouterRepository.
@@ -74,4 +75,30 @@ func (r *RedisRouterRepositoryMock) SetTakerFee(ctx context.Context, tx mvc.Tx,
 	return nil
 }
 
+// SetTakerFeesAtHeight implements domain.RouterRepository.
+func (*RedisRouterRepositoryMock) SetTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, takerFees domain.TakerFeeMap) error {
+	panic("unimplemented")
+}
+
+// DeleteTakerFeesUpToHeight implements domain.RouterRepository.
+func (*RedisRouterRepositoryMock) DeleteTakerFeesUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	panic("unimplemented")
+}
+
+// GetAllRouteTakerFees implements domain.RouterRepository.
+func (r *RedisRouterRepositoryMock) GetAllRouteTakerFees(ctx context.Context) (domain.RouteTakerFeeMap, error) {
+	return r.RouteTakerFees, nil
+}
+
+// SetRouteTakerFees implements domain.RouterRepository.
+func (r *RedisRouterRepositoryMock) SetRouteTakerFees(ctx context.Context, tx mvc.Tx, routeTakerFees domain.RouteTakerFeeMap) error {
+	r.RouteTakerFees = routeTakerFees
+	return nil
+}
+
+// SetRouteTakerFeesAtHeight implements domain.RouterRepository.
+func (*RedisRouterRepositoryMock) SetRouteTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, routeTakerFees domain.RouteTakerFeeMap) error {
+	panic("unimplemented")
+}
+
 var _ mvc.RouterRepository = &RedisRouterRepositoryMock{}
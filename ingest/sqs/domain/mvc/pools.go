@@ -8,6 +8,8 @@ import (
 )
 
 // PoolsRepository represent the pool's repository contract
+// If a historical height is set on the context, reads return the state stored at that height.
+// See domain.WithHistoricalHeight.
 type PoolsRepository interface {
 	// GetAllPools atomically reads and returns all on-chain pools sorted by ID.
 	// Note that this does NOT return tick models for the concentrated pools
@@ -23,6 +25,12 @@ type PoolsRepository interface {
 	StorePools(ctx context.Context, tx Tx, pools []domain.PoolI) error
 	// ClearAllPools atomically clears all pools.
 	ClearAllPools(ctx context.Context, tx Tx) error
+
+	// StorePoolsAtHeight atomically stores the given pools as the historical state at the given height.
+	StorePoolsAtHeight(ctx context.Context, tx Tx, height uint64, pools []domain.PoolI) error
+	// DeletePoolsUpToHeight atomically deletes the historical pool state at all heights
+	// less than or equal to the given height.
+	DeletePoolsUpToHeight(ctx context.Context, tx Tx, height uint64) error
 }
 
 // PoolsUsecase represent the pool's usecases
@@ -31,7 +39,14 @@ type PoolsUsecase interface {
 
 	// GetRoutesFromCandidates converts candidate routes to routes intrusmented with all the data necessary for estimating
 	// a swap. This data entails the pool data, the taker fee.
-	GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
+	// The taker fee of a pool in a multihop route is the route taker fee of the route's token out denom
+	// unless the pool has a taker fee override.
+	GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
+	// ConvertCandidateRoutes is the equivalent of GetRoutesFromCandidates that converts candidate routes using
+	// the given pools and tick models instead of reading them from the repository.
+	// Used for sharing the pool state between multiple quotes.
+	// Returns error if a pool in the candidate routes is not present in pools or a tick model is missing for a concentrated pool.
+	ConvertCandidateRoutes(candidateRoutes route.CandidateRoutes, pools map[uint64]domain.PoolI, tickModelMap map[uint64]domain.TickModel, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom string) ([]route.RouteImpl, error)
 
 	GetTickModelMap(ctx context.Context, poolIDs []uint64) (map[uint64]domain.TickModel, error)
 	// GetPool returns the pool with the given ID.
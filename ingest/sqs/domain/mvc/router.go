@@ -11,10 +11,23 @@ import (
 )
 
 // RouterRepository represent the router's repository contract
+// If a historical height is set on the context, taker fee reads return the taker fees stored at that height.
+// See domain.WithHistoricalHeight.
 type RouterRepository interface {
 	GetTakerFee(ctx context.Context, denom0, denom1 string) (osmomath.Dec, error)
 	GetAllTakerFees(ctx context.Context) (domain.TakerFeeMap, error)
 	SetTakerFee(ctx context.Context, tx Tx, denom0, denom1 string, takerFee osmomath.Dec) error
+	// SetTakerFeesAtHeight atomically stores the given taker fees as the historical state at the given height.
+	SetTakerFeesAtHeight(ctx context.Context, tx Tx, height uint64, takerFees domain.TakerFeeMap) error
+	// DeleteTakerFeesUpToHeight atomically deletes the historical taker fees and route taker fees
+	// at all heights less than or equal to the given height.
+	DeleteTakerFeesUpToHeight(ctx context.Context, tx Tx, height uint64) error
+	// GetAllRouteTakerFees returns the taker fee overrides of multihop routes keyed by their token out denom.
+	GetAllRouteTakerFees(ctx context.Context) (domain.RouteTakerFeeMap, error)
+	// SetRouteTakerFees atomically replaces the taker fee overrides of multihop routes with the given ones.
+	SetRouteTakerFees(ctx context.Context, tx Tx, routeTakerFees domain.RouteTakerFeeMap) error
+	// SetRouteTakerFeesAtHeight atomically stores the given route taker fees as the historical state at the given height.
+	SetRouteTakerFeesAtHeight(ctx context.Context, tx Tx, height uint64, routeTakerFees domain.RouteTakerFeeMap) error
 	// SetRoutesTx sets the routes for the given denoms in the given transaction.
 	// Sorts denom0 and denom1 lexicographically before setting the routes.
 	// Returns error if the transaction fails.
@@ -34,6 +47,18 @@ type RouterRepository interface {
 type RouterUsecase interface {
 	// GetOptimalQuote returns the optimal quote for the given tokenIn and tokenOutDenom.
 	GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error)
+	// GetOptimalQuoteExactAmountOut returns the optimal quote for swapping tokenInDenom for exactly the given tokenOut.
+	GetOptimalQuoteExactAmountOut(ctx context.Context, tokenOut sdk.Coin, tokenInDenom string) (domain.Quote, error)
+	// GetOptimalQuoteBatch returns the optimal quote for each of the given requests.
+	// All quotes are computed against the pool state of the same height. Candidate routes and
+	// pool state are loaded once and shared by all requests.
+	// A failure to quote an individual request is reported in its result rather than failing the batch.
+	// Returns error if the number of requests is zero or exceeds the limit or if the pool state fails to be loaded.
+	GetOptimalQuoteBatch(ctx context.Context, requests []domain.BatchQuoteRequest) (domain.BatchQuote, error)
+	// GetSwapTx returns the unsigned swap message for the optimal quote of swapping tokenIn for tokenOutDenom
+	// by the given sender. The token out min amount is the quote's amount out less the slippage tolerance.
+	// Returns error if the slippage tolerance is not in [0, 1), if the quote fails or if the message is invalid.
+	GetSwapTx(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, sender string, slippageTolerance osmomath.Dec) (domain.SwapTx, error)
 	// GetBestSingleRouteQuote returns the best single route quote for the given tokenIn and tokenOutDenom.
 	GetBestSingleRouteQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error)
 	// GetCustomQuote returns the custom quote for the given tokenIn, tokenOutDenom and poolIDs.
@@ -51,3 +76,23 @@ type RouterUsecase interface {
 	// StoreRoutes stores all router state in the files locally. Used for debugging.
 	StoreRouterStateFiles(ctx context.Context) error
 }
+
+// BlockNotifier is notified after the data ingested from a block is committed to storage.
+type BlockNotifier interface {
+	// NotifyBlock notifies that the block at the given height has been committed to storage.
+	// Must not block the caller.
+	NotifyBlock(height uint64)
+}
+
+// QuoteStreamUsecase pushes fresh quotes for the subscribed token pairs after every ingested block.
+type QuoteStreamUsecase interface {
+	BlockNotifier
+
+	// Subscribe subscribes the client to quote updates for the given token pairs until ctx is done.
+	// clientID identifies the client across its connections for enforcing the per-client topic limit.
+	// If fromHeight is non-zero, the retained updates with a height greater than fromHeight are replayed first.
+	// The returned channel is closed once ctx is done.
+	// Returns error if the number of subscriptions is zero or exceeds the per-connection limit,
+	// or if subscribing would exceed the global or the per-client topic limit.
+	Subscribe(ctx context.Context, clientID string, subscriptions []domain.QuoteSubscription, fromHeight uint64) (<-chan domain.QuoteUpdate, error)
+}
@@ -49,6 +49,9 @@ type SQSPool struct {
 	Balances     sdk.Coins    `json:"balances"`
 	PoolDenoms   []string     `json:"pool_denoms"`
 	SpreadFactor osmomath.Dec `json:"spread_factor"`
+	// TakerFeeOverride is the taker fee override of the pool, if any.
+	// It takes precedence over the taker fees of the denom pairs of the pool.
+	TakerFeeOverride *osmomath.Dec `json:"taker_fee_override,omitempty"`
 }
 
 type LiquidityDepthsWithRange = clqueryproto.LiquidityDepthWithRange
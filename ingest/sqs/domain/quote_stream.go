@@ -0,0 +1,40 @@
+package domain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuoteStreamConfig defines the config for streaming quote updates.
+type QuoteStreamConfig struct {
+	// MaxSubscriptionsPerConnection is the maximum number of token pairs a single connection may subscribe to.
+	MaxSubscriptionsPerConnection int `mapstructure:"max_subscriptions_per_connection"`
+	// MaxTopics is the maximum number of distinct token pairs streamed across all clients.
+	// Quotes are computed for every topic on every block so this bounds the work per block.
+	MaxTopics int `mapstructure:"max_topics"`
+	// MaxTopicsPerClient is the maximum number of distinct token pairs a single client
+	// may be subscribed to across all of its connections.
+	MaxTopicsPerClient int `mapstructure:"max_topics_per_client"`
+	// BufferSize is the number of pending updates buffered per connection.
+	// Once full, the oldest pending update is dropped in favor of the latest one.
+	BufferSize int `mapstructure:"buffer_size"`
+	// ResumeWindowBlocks is the number of most recent blocks of updates retained per token pair
+	// so that clients can resume from a height after reconnecting.
+	ResumeWindowBlocks int `mapstructure:"resume_window_blocks"`
+}
+
+// QuoteSubscription is a subscription to quote updates for swapping the token in
+// for the token out denom.
+type QuoteSubscription struct {
+	TokenIn       sdk.Coin
+	TokenOutDenom string
+}
+
+// QuoteUpdate is a quote pushed to subscribers after a block is ingested.
+// Exactly one of Quote and Error is set.
+type QuoteUpdate struct {
+	Height        uint64   "json:\"height\""
+	TokenIn       sdk.Coin "json:\"token_in\""
+	TokenOutDenom string   "json:\"token_out_denom\""
+	Quote         Quote    "json:\"quote,omitempty\""
+	Error         string   "json:\"error,omitempty\""
+}
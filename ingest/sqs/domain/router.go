@@ -24,6 +24,17 @@ type RoutablePool interface {
 
 	CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error)
 	ChargeTakerFeeExactIn(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin)
+	// CalculateTokenOutByTokenInWithGas is the equivalent of CalculateTokenOutByTokenIn
+	// that also returns the gas estimate of the swap.
+	// The swap is computed once for both the amount out and the gas.
+	CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error)
+
+	// CalculateTokenInByTokenOut calculates the amount of token in denominated in tokenInDenom
+	// that is required to receive exactly tokenOut from the pool.
+	CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error)
+	// ChargeTakerFeeExactOut charges the taker fee on top of the given token in
+	// and returns the token in after the fee has been added.
+	ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin)
 
 	// SetTokenOutDenom sets the token out denom on the routable pool.
 	SetTokenOutDenom(tokenOutDenom string)
@@ -38,15 +49,27 @@ type RoutablePool interface {
 type RoutableResultPool interface {
 	RoutablePool
 	GetBalances() sdk.Coins
+
+	// GetTokenInDenom returns the token in denom of the pool.
+	// Only set for exact amount out quotes.
+	GetTokenInDenom() string
 }
 
 type Route interface {
 	GetPools() []RoutablePool
 	// AddPool adds pool to route.
-	AddPool(pool PoolI, tokenOut string, takerFee osmomath.Dec)
+	AddPool(pool PoolI, tokenOut string, takerFee osmomath.Dec, cosmWasmConfig CosmWasmPoolRouterConfig)
 	// CalculateTokenOutByTokenIn calculates the token out amount given the token in amount.
 	// Returns error if the calculation fails.
 	CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error)
+	// CalculateTokenOutByTokenInWithGas calculates the token out amount given the token in amount
+	// together with the gas estimate of swapping over all pools in the route.
+	// Returns error if the calculation fails.
+	CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error)
+	// CalculateTokenInByTokenOut calculates the token in amount denominated in tokenInDenom
+	// that is required to receive exactly the given token out amount from the route.
+	// Returns error if the calculation fails.
+	CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error)
 
 	GetTokenOutDenom() string
 
@@ -59,6 +82,13 @@ type Route interface {
 	// Returns the spot price before swap and effective spot price.
 	PrepareResultPools(tokenIn sdk.Coin) (osmomath.Dec, osmomath.Dec, error)
 
+	// PrepareResultPoolsExactAmountOut is the exact amount out equivalent of PrepareResultPools.
+	// Additionally, it sets the token in denom on each result pool so that the route
+	// maps directly onto poolmanager's SwapAmountOutRoute.
+	// Note that it mutates the route.
+	// Returns the spot price before swap and effective spot price.
+	PrepareResultPoolsExactAmountOut(tokenOut sdk.Coin, tokenInDenom string) (osmomath.Dec, osmomath.Dec, error)
+
 	String() string
 }
 
@@ -66,6 +96,9 @@ type SplitRoute interface {
 	Route
 	GetAmountIn() osmomath.Int
 	GetAmountOut() osmomath.Int
+	// GetGasEstimate returns the gas estimate of swapping the amount in over the route.
+	// Returns zero if gas was not estimated.
+	GetGasEstimate() uint64
 }
 
 type Quote interface {
@@ -94,6 +127,40 @@ type RouterConfig struct {
 	RouteCacheEnabled         bool `mapstructure:"route_cache_enabled"`
 	// The number of seconds to cache routes for before expiry.
 	RouteCacheExpirySeconds uint64 `mapstructure:"route_cache_expiry_seconds"`
+	// The allowlist of code IDs of CosmWasm pools that are quoted by querying the pool contract.
+	// All other CosmWasm pools are treated as transmuter pools.
+	GeneralCosmWasmCodeIDs []uint64 `mapstructure:"general_cosmwasm_code_ids"`
+	// The maximum gas a single CosmWasm pool contract query may consume.
+	CosmWasmQueryGasLimit uint64 `mapstructure:"cosmwasm_query_gas_limit"`
+	// The maximum number of quote requests in a single batch.
+	MaxBatchQuoteRequests int `mapstructure:"max_batch_quote_requests"`
+	// The number of most recent blocks of pool and taker fee state retained for historical quotes.
+	// Historical state is not retained if zero.
+	HistoricalStateRetentionBlocks uint64 `mapstructure:"historical_state_retention_blocks"`
+	// The price of a single unit of gas in uosmo as a decimal string.
+	// Routes are ranked and split by the amount out less the gas cost valued in the token out.
+	// Gas is not accounted for if empty.
+	GasPriceUOSMO string `mapstructure:"gas_price_uosmo"`
+}
+
+// GetGasPriceUOSMO returns the parsed gas price in uosmo.
+// Returns zero if the gas price is not configured.
+// Returns error if the gas price is not a valid non-negative decimal.
+func (c RouterConfig) GetGasPriceUOSMO() (osmomath.Dec, error) {
+	if c.GasPriceUOSMO == "" {
+		return osmomath.ZeroDec(), nil
+	}
+
+	gasPrice, err := osmomath.NewDecFromStr(c.GasPriceUOSMO)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	if gasPrice.IsNegative() {
+		return osmomath.Dec{}, fmt.Errorf("gas price (%s) must not be negative", gasPrice)
+	}
+
+	return gasPrice, nil
 }
 
 // DenomPair encapsulates a pair of denoms.
@@ -173,6 +240,28 @@ func (tfm TakerFeeMap) GetTakerFee(denom0, denom1 string) osmomath.Dec {
 	return takerFee
 }
 
+// GetPoolTakerFee returns the taker fee for swapping the given denoms against the given pool.
+// The taker fee override of the pool takes precedence over the taker fee of the denoms.
+func (tfm TakerFeeMap) GetPoolTakerFee(pool PoolI, denom0, denom1 string) osmomath.Dec {
+	if takerFeeOverride := pool.GetSQSPoolModel().TakerFeeOverride; takerFeeOverride != nil {
+		return *takerFeeOverride
+	}
+
+	return tfm.GetTakerFee(denom0, denom1)
+}
+
+// GetRouteHopTakerFee returns the taker fee for swapping the given denoms against the given pool
+// as a hop of a route with the given taker fee override, which is nil if the route has none.
+// The taker fee override of the pool takes precedence over the taker fee override of the route,
+// which takes precedence over the taker fee of the denoms.
+func (tfm TakerFeeMap) GetRouteHopTakerFee(pool PoolI, denom0, denom1 string, routeTakerFee *osmomath.Dec) osmomath.Dec {
+	if routeTakerFee != nil && pool.GetSQSPoolModel().TakerFeeOverride == nil {
+		return *routeTakerFee
+	}
+
+	return tfm.GetPoolTakerFee(pool, denom0, denom1)
+}
+
 // SetTakerFee sets the taker fee for the given denoms.
 // It sorts the denoms lexicographically before setting the taker fee.
 func (tfm TakerFeeMap) SetTakerFee(denom0, denom1 string, takerFee osmomath.Dec) {
@@ -184,11 +273,34 @@ func (tfm TakerFeeMap) SetTakerFee(denom0, denom1 string, takerFee osmomath.Dec)
 	tfm[DenomPair{Denom0: denom0, Denom1: denom1}] = takerFee
 }
 
+// RouteTakerFeeMap is a map of the token out denom of multihop routes to their taker fee override.
+type RouteTakerFeeMap map[string]osmomath.Dec
+
+// GetRouteTakerFee returns the taker fee override of a route with the given number of pools
+// ending in the given token out denom. Returns nil if the route is a single hop route
+// or if there is no override for its token out denom.
+func (rtfm RouteTakerFeeMap) GetRouteTakerFee(numPools int, tokenOutDenom string) *osmomath.Dec {
+	if numPools < 2 {
+		return nil
+	}
+
+	takerFee, found := rtfm[tokenOutDenom]
+	if !found {
+		return nil
+	}
+
+	return &takerFee
+}
+
 // TakerFeeForPair represents the taker fee for a pair of tokens
 type TakerFeeForPair struct {
 	Denom0   string
 	Denom1   string
 	TakerFee osmomath.Dec
+	// MultihopTakerFees are the taker fees charged for the pair instead of TakerFee
+	// when it is swapped as a hop of a multihop route, keyed by the token out denom of the route.
+	// Empty if the pool has a taker fee override.
+	MultihopTakerFees RouteTakerFeeMap
 }
 
 var DefaultTakerFee = osmomath.MustNewDecFromStr("0.001000000000000000")
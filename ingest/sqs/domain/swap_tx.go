@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
+)
+
+// SwapTx is an unsigned poolmanager swap message built from the optimal quote.
+// The message is MsgSwapExactAmountIn if the quote has a single route
+// and MsgSplitRouteSwapExactAmountIn otherwise.
+type SwapTx struct {
+	Quote Quote "json:\"quote\""
+	// TypeURL is the type URL of the message for packing it into a transaction.
+	TypeURL string "json:\"type_url\""
+	// AminoJSON is the sorted amino JSON encoding of the message as signed in legacy amino JSON mode.
+	AminoJSON json.RawMessage "json:\"amino_json\""
+	// Proto is the protobuf encoding of the message.
+	Proto []byte "json:\"proto\""
+	// TokenOutMinAmount is the quote's amount out less the slippage tolerance.
+	TokenOutMinAmount osmomath.Int "json:\"token_out_min_amount\""
+	// GasEstimate is an upper bound estimate of the gas consumed by the message.
+	GasEstimate uint64 "json:\"gas_estimate\""
+}
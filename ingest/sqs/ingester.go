@@ -17,16 +17,19 @@ type sqsIngester struct {
 	txManager         mvc.TxManager
 	poolsIngester     mvc.AtomicIngester
 	chainInfoIngester mvc.AtomicIngester
+	blockNotifier     mvc.BlockNotifier
 }
 
 // NewSidecarQueryServerIngester creates a new sidecar query server ingester.
 // poolsRepository is the storage for pools.
 // gammKeeper is the keeper for Gamm pools.
-func NewSidecarQueryServerIngester(poolsIngester, chainInfoIngester mvc.AtomicIngester, txManager mvc.TxManager) ingest.Ingester {
+// blockNotifier is notified after the data of every block is committed.
+func NewSidecarQueryServerIngester(poolsIngester, chainInfoIngester mvc.AtomicIngester, txManager mvc.TxManager, blockNotifier mvc.BlockNotifier) ingest.Ingester {
 	return &sqsIngester{
 		txManager:         txManager,
 		chainInfoIngester: chainInfoIngester,
 		poolsIngester:     poolsIngester,
+		blockNotifier:     blockNotifier,
 	}
 }
 
@@ -48,7 +51,15 @@ func (i *sqsIngester) ProcessBlock(ctx sdk.Context) error {
 	}
 
 	// Flush all writes atomically
-	return tx.Exec(goCtx)
+	if err := tx.Exec(goCtx); err != nil {
+		return err
+	}
+
+	// Notify only after the writes are committed so that
+	// the notified components observe the data of this block.
+	i.blockNotifier.NotifyBlock(uint64(ctx.BlockHeight()))
+
+	return nil
 }
 
 // GetName implements ingest.Ingester.
@@ -1,6 +1,7 @@
 package common
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -16,6 +17,11 @@ type SQSIngestKeepers struct {
 	ProtorevKeeper     ProtorevKeeper
 	PoolManagerKeeper  PoolManagerKeeper
 	ConcentratedKeeper ConcentratedKeeper
+	WasmKeeper         WasmKeeper
+
+	// CommitMultiStore is the root multistore of the app.
+	// It is used for creating immutable state snapshots to query CosmWasm pools against.
+	CommitMultiStore storetypes.MultiStore
 }
 
 // PoolKeeper is an interface for getting pools from a keeper.
@@ -62,6 +68,12 @@ type PoolManagerKeeper interface {
 	) (denoms []string, err error)
 
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
+
+	GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error)
+
+	GetAllRouteTakerFees(ctx sdk.Context) ([]poolmanagertypes.RouteTakerFee, error)
+
+	GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, error)
 }
 
 // ConcentratedKeeper is an interface for the concentrated keeper.
@@ -69,3 +81,8 @@ type ConcentratedKeeper interface {
 	PoolKeeper
 	GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]queryproto.LiquidityDepthWithRange, int64, error)
 }
+
+// WasmKeeper is an interface for querying CosmWasm contracts.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
@@ -45,6 +45,8 @@ type poolIngester struct {
 	poolManagerKeeper  common.PoolManagerKeeper
 	logger             log.Logger
 
+	cosmWasmPoolQuerier domain.CosmWasmPoolQuerier
+
 	routerConfig domain.RouterConfig
 }
 
@@ -70,7 +72,9 @@ const (
 var uosmoPrecisionBigDec = osmomath.NewBigDec(uosmoPrecision)
 
 // NewPoolIngester returns a new pool ingester.
-func NewPoolIngester(poolsRepository mvc.PoolsRepository, routerRepository mvc.RouterRepository, tokensUseCase domain.TokensUsecase, repositoryManager mvc.TxManager, routerConfig domain.RouterConfig, keepers common.SQSIngestKeepers) mvc.AtomicIngester {
+// If cosmWasmPoolQuerier is non-nil and there are general CosmWasm code IDs configured,
+// the ingester updates the querier's state snapshot at the end of every block.
+func NewPoolIngester(poolsRepository mvc.PoolsRepository, routerRepository mvc.RouterRepository, tokensUseCase domain.TokensUsecase, repositoryManager mvc.TxManager, routerConfig domain.RouterConfig, keepers common.SQSIngestKeepers, cosmWasmPoolQuerier domain.CosmWasmPoolQuerier) mvc.AtomicIngester {
 	return &poolIngester{
 		poolsRepository:    poolsRepository,
 		routerRepository:   routerRepository,
@@ -83,6 +87,8 @@ func NewPoolIngester(poolsRepository mvc.PoolsRepository, routerRepository mvc.R
 		protorevKeeper:     keepers.ProtorevKeeper,
 		poolManagerKeeper:  keepers.PoolManagerKeeper,
 		routerConfig:       routerConfig,
+
+		cosmWasmPoolQuerier: cosmWasmPoolQuerier,
 	}
 }
 
@@ -164,6 +170,14 @@ func (pi *poolIngester) processPoolState(ctx sdk.Context, tx mvc.Tx) error {
 		allPoolsParsed = append(allPoolsParsed, pool)
 	}
 
+	// Point the state snapshot for quoting general CosmWasm pools to the height being ingested.
+	// Failing to do so is not fatal.
+	if pi.cosmWasmPoolQuerier != nil && len(pi.routerConfig.GeneralCosmWasmCodeIDs) > 0 {
+		if err := pi.cosmWasmPoolQuerier.UpdateSnapshot(ctx); err != nil {
+			pi.logger.Error("error updating cosmwasm pool state snapshot", zap.Int64("height", ctx.BlockHeight()), zap.Error(err))
+		}
+	}
+
 	pi.logger.Info("ingesting pools to Redis", zap.Int64("height", ctx.BlockHeight()), zap.Int("num_cfmm", len(cfmmPools)), zap.Int("num_concentrated", len(concentratedPools)), zap.Int("num_cosmwasm", len(cosmWasmPools)))
 
 	err = pi.poolsRepository.StorePools(goCtx, tx, allPoolsParsed)
@@ -177,6 +191,23 @@ func (pi *poolIngester) processPoolState(ctx sdk.Context, tx mvc.Tx) error {
 		return err
 	}
 
+	// persist route taker fees
+	routeTakerFeeMap, err := pi.getRouteTakerFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := pi.routerRepository.SetRouteTakerFees(goCtx, tx, routeTakerFeeMap); err != nil {
+		return err
+	}
+
+	// persist the historical state unless disabled.
+	if pi.routerConfig.HistoricalStateRetentionBlocks > 0 {
+		if err := pi.persistHistoricalState(ctx, tx, allPoolsParsed, denomPairToTakerFeeMap, routeTakerFeeMap); err != nil {
+			return err
+		}
+	}
+
 	// Update routes every RouteUpdateHeightInterval blocks unless RouteUpdateHeightInterval is 0.
 	if pi.routerConfig.RouteUpdateHeightInterval > routeIngestDisablePlaceholder && ctx.BlockHeight()%int64(pi.routerConfig.RouteUpdateHeightInterval) == 0 {
 		allPools := make([]domain.PoolI, 0, len(allPoolsParsed))
@@ -270,9 +301,14 @@ func (pi *poolIngester) convertPool(
 		poolDenomsMap[poolDenom] = struct{}{}
 	}
 
-	spreadFactor := pool.GetSpreadFactor(ctx)
+	// The effective spread factor accounts for the concentrated liquidity
+	// dynamic spread factor so that routing quotes match the charged fee.
+	spreadFactor, err := pi.poolManagerKeeper.GetEffectiveSpreadFactor(ctx, pool.GetId())
+	if err != nil {
+		return nil, err
+	}
 
-	// Note that this must follow the call to GetPoolDenoms() and GetSpreadFactor.
+	// Note that this must follow the call to GetPoolDenoms() and GetEffectiveSpreadFactor.
 	// Otherwise, the CosmWasmPool model panics.
 	pool = pool.AsSerializablePool()
 
@@ -350,6 +386,16 @@ func (pi *poolIngester) convertPool(
 		return nil, err
 	}
 
+	// The taker fee override of the pool, if any, takes precedence over the denom pair taker fees.
+	var takerFeeOverride *osmomath.Dec
+	poolTakerFee, found, err := pi.poolManagerKeeper.GetPoolTakerFee(ctx, pool.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		takerFeeOverride = &poolTakerFee
+	}
+
 	// Get the tick model for concentrated pools
 	var tickModel *domain.TickModel
 
@@ -384,6 +430,7 @@ func (pi *poolIngester) convertPool(
 			Balances:              balances,
 			PoolDenoms:            denoms,
 			SpreadFactor:          spreadFactor,
+			TakerFeeOverride:      takerFeeOverride,
 		},
 		TickModel: tickModel,
 	}, nil
@@ -401,6 +448,54 @@ func (pi *poolIngester) persistTakerFees(ctx sdk.Context, tx mvc.Tx, takerFeeMap
 	return nil
 }
 
+// getRouteTakerFees returns the taker fee overrides of multihop routes keyed by their token out denom.
+func (pi *poolIngester) getRouteTakerFees(ctx sdk.Context) (domain.RouteTakerFeeMap, error) {
+	routeTakerFees, err := pi.poolManagerKeeper.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTakerFeeMap := make(domain.RouteTakerFeeMap, len(routeTakerFees))
+	for _, routeTakerFee := range routeTakerFees {
+		routeTakerFeeMap[routeTakerFee.TokenOutDenom] = routeTakerFee.TakerFee
+	}
+
+	return routeTakerFeeMap, nil
+}
+
+// persistHistoricalState stores the pools, taker fees and route taker fees as the historical state at the current height
+// and prunes the historical state that falls out of the retention window.
+func (pi *poolIngester) persistHistoricalState(ctx sdk.Context, tx mvc.Tx, pools []domain.PoolI, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	if err := pi.poolsRepository.StorePoolsAtHeight(goCtx, tx, height, pools); err != nil {
+		return err
+	}
+
+	if err := pi.routerRepository.SetTakerFeesAtHeight(goCtx, tx, height, takerFeeMap); err != nil {
+		return err
+	}
+
+	if err := pi.routerRepository.SetRouteTakerFeesAtHeight(goCtx, tx, height, routeTakerFeeMap); err != nil {
+		return err
+	}
+
+	retentionBlocks := pi.routerConfig.HistoricalStateRetentionBlocks
+	if height <= retentionBlocks {
+		return nil
+	}
+
+	// Prune every height that fell out of the retention window rather than only the latest one
+	// so that heights left over from skipped blocks or a lowered retention are pruned as well.
+	prunedHeight := height - retentionBlocks
+	if err := pi.poolsRepository.DeletePoolsUpToHeight(goCtx, tx, prunedHeight); err != nil {
+		return err
+	}
+
+	return pi.routerRepository.DeleteTakerFeesUpToHeight(goCtx, tx, prunedHeight)
+}
+
 // SetLogger implements ingest.AtomicIngester.
 func (pi *poolIngester) SetLogger(logger log.Logger) {
 	pi.logger = logger
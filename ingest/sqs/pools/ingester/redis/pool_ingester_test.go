@@ -12,11 +12,11 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
-	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/common"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/ingester/redis"
 	redisingester "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/ingester/redis"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
 	clqueryproto "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -477,7 +477,7 @@ func (s *IngesterTestSuite) TestProcessBlock() {
 		// Note: this is a dummy tx that is not initialized correctly.
 		// We do note expect it to be called or used by the system under test
 		// due to using the mock repository.
-		redisTx = mvc.NewRedisTx(nil)
+		redisTx = redisrepo.NewRedisTx(nil)
 	)
 
 	// Set the default taker fee
@@ -499,7 +499,7 @@ func (s *IngesterTestSuite) TestProcessBlock() {
 		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
 	}
 
-	poolIngester := redisingester.NewPoolIngester(redisRepoMock, redisRouterMock, tokensUseCaseMock, nil, domain.RouterConfig{}, sqsKeepers)
+	poolIngester := redisingester.NewPoolIngester(redisRepoMock, redisRouterMock, tokensUseCaseMock, nil, domain.RouterConfig{}, sqsKeepers, nil)
 	poolIngester.SetLogger(&log.NoOpLogger{})
 
 	err := poolIngester.ProcessBlock(s.Ctx, redisTx)
@@ -581,7 +581,7 @@ func (s *IngesterTestSuite) initializePoolIngester() *redisingester.PoolIngester
 		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
 	}
 
-	atomicIngester := redisingester.NewPoolIngester(nil, nil, nil, nil, domain.RouterConfig{}, sqsKeepers)
+	atomicIngester := redisingester.NewPoolIngester(nil, nil, nil, nil, domain.RouterConfig{}, sqsKeepers, nil)
 	poolIngester, ok := atomicIngester.(*redisingester.PoolIngester)
 	poolIngester.SetLogger(&log.NoOpLogger{})
 	s.Require().True(ok)
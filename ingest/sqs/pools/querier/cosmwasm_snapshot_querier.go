@@ -0,0 +1,121 @@
+package querier
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/common"
+)
+
+// cosmWasmSnapshotQuerier runs CosmWasm pool contract queries against an immutable
+// view of the chain state at the height of the last ingested block.
+// Queries are served concurrently outside of block execution, so they must never
+// read the mutable state of the block being processed.
+//
+// Pools are ingested at the end of the block, before it is committed. To quote against
+// the same height as the ingested pool balances, the snapshot is captured lazily by the
+// first query after the ingested height is committed. Until then, queries fail rather
+// than mixing the state of two heights.
+type cosmWasmSnapshotQuerier struct {
+	wasmKeeper       common.WasmKeeper
+	commitMultiStore storetypes.MultiStore
+
+	// ingestedCtx is the context of the last ingested block.
+	ingestedCtx atomic.Pointer[sdk.Context]
+
+	// snapshotMu serializes the capture of the snapshot.
+	snapshotMu  sync.Mutex
+	snapshotCtx atomic.Pointer[sdk.Context]
+}
+
+var _ domain.CosmWasmPoolQuerier = &cosmWasmSnapshotQuerier{}
+
+// NewCosmWasmSnapshotQuerier returns a new CosmWasm pool querier that queries contracts
+// using the wasm keeper against state snapshots of the given root multistore.
+func NewCosmWasmSnapshotQuerier(wasmKeeper common.WasmKeeper, commitMultiStore storetypes.MultiStore) domain.CosmWasmPoolQuerier {
+	return &cosmWasmSnapshotQuerier{
+		wasmKeeper:       wasmKeeper,
+		commitMultiStore: commitMultiStore,
+	}
+}
+
+// UpdateSnapshot implements domain.CosmWasmPoolQuerier.
+// Records the height of the block being ingested. The immutable view of that height
+// is captured by the first query after it is committed.
+func (q *cosmWasmSnapshotQuerier) UpdateSnapshot(ctx sdk.Context) error {
+	// The mutable state of the block must not be retained, so only the header is kept.
+	ingestedCtx := sdk.NewContext(nil, ctx.BlockHeader(), false, ctx.Logger())
+	q.ingestedCtx.Store(&ingestedCtx)
+	return nil
+}
+
+// getSnapshotCtx returns the context over the immutable view of the last ingested height,
+// capturing it if this is the first query since the height was committed.
+// Returns error if no block has been ingested yet or the ingested height is not yet committed.
+func (q *cosmWasmSnapshotQuerier) getSnapshotCtx() (*sdk.Context, error) {
+	ingestedCtx := q.ingestedCtx.Load()
+	if ingestedCtx == nil {
+		return nil, domain.CosmWasmPoolSnapshotNotSetError{}
+	}
+	snapshotHeight := ingestedCtx.BlockHeight()
+
+	if snapshotCtx := q.snapshotCtx.Load(); snapshotCtx != nil && snapshotCtx.BlockHeight() == snapshotHeight {
+		return snapshotCtx, nil
+	}
+
+	q.snapshotMu.Lock()
+	defer q.snapshotMu.Unlock()
+
+	// The snapshot may have been captured while waiting for the lock.
+	if snapshotCtx := q.snapshotCtx.Load(); snapshotCtx != nil && snapshotCtx.BlockHeight() == snapshotHeight {
+		return snapshotCtx, nil
+	}
+
+	// Branching a version that does not exist yet would silently read empty stores.
+	if latestVersion := q.commitMultiStore.LatestVersion(); latestVersion < snapshotHeight {
+		return nil, domain.CosmWasmPoolSnapshotNotCommittedError{Height: snapshotHeight, LatestHeight: latestVersion}
+	}
+
+	snapshotMultiStore, err := q.commitMultiStore.CacheMultiStoreWithVersion(snapshotHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotCtx := ingestedCtx.WithMultiStore(snapshotMultiStore)
+	q.snapshotCtx.Store(&snapshotCtx)
+
+	return &snapshotCtx, nil
+}
+
+// QuerySmart implements domain.CosmWasmPoolQuerier.
+func (q *cosmWasmSnapshotQuerier) QuerySmart(contractAddress string, request []byte, gasLimit uint64) (response []byte, err error) {
+	snapshotCtx, err := q.getSnapshotCtx()
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Out of gas and contract errors may panic.
+	defer func() {
+		if r := recover(); r != nil {
+			response = nil
+			err = fmt.Errorf("cosmwasm query panicked: %v", r)
+		}
+	}()
+
+	// Branch the snapshot so that concurrent queries do not share a cache.
+	queryCtx := snapshotCtx.
+		WithMultiStore(snapshotCtx.MultiStore().CacheMultiStore()).
+		WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	return q.wasmKeeper.QuerySmart(queryCtx, contractAddr, request)
+}
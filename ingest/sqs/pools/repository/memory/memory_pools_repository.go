@@ -0,0 +1,323 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+type memoryPoolsRepo struct {
+	appCodec          codec.Codec
+	repositoryManager mvc.TxManager
+}
+
+var (
+	_ mvc.PoolsRepository = &memoryPoolsRepo{}
+)
+
+const (
+	sqsPoolModelNamespace      = "pools/sqs"
+	chainPoolModelNamespace    = "pools/chain"
+	concentratedTicksNamespace = "pools/ticks"
+	// historicalHeightsNamespace indexes the heights at which the historical pool state is stored.
+	historicalHeightsNamespace = "pools/heights"
+)
+
+// NewMemoryPoolsRepo will create an in-memory implementation of pools.Repository
+// Pools are stored serialized so that every read returns a fresh copy that
+// is safe to mutate by the caller.
+func NewMemoryPoolsRepo(appCodec codec.Codec, repositoryManager mvc.TxManager) mvc.PoolsRepository {
+	return &memoryPoolsRepo{
+		appCodec:          appCodec,
+		repositoryManager: repositoryManager,
+	}
+}
+
+// GetAllPools implements mvc.PoolsRepository.
+// Atomically reads all pools from the latest committed snapshot.
+func (r *memoryPoolsRepo) GetAllPools(ctx context.Context) ([]domain.PoolI, error) {
+	memoryTx, err := r.startMemoryTx()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := getNamespaces(ctx)
+
+	sqsPoolMapByID := memoryTx.GetAll(namespaces.sqsPoolModel)
+	chainPoolMapByID := memoryTx.GetAll(namespaces.chainPoolModel)
+
+	// There is always at least one pool at any ingested height.
+	if height, isHistorical := domain.GetHistoricalHeightFromContext(ctx); isHistorical && len(sqsPoolMapByID) == 0 {
+		return nil, domain.HistoricalStateNotFoundError{Height: height}
+	}
+
+	if len(sqsPoolMapByID) != len(chainPoolMapByID) {
+		return nil, fmt.Errorf("pools count mismatch: sqsPoolMapByID: %d, chainPoolMapByID: %d", len(sqsPoolMapByID), len(chainPoolMapByID))
+	}
+
+	pools := make([]domain.PoolI, 0, len(sqsPoolMapByID))
+	for poolIDKeyStr, sqsPoolModelBytes := range sqsPoolMapByID {
+		chainPoolModelBytes, ok := chainPoolMapByID[poolIDKeyStr]
+		if !ok {
+			return nil, fmt.Errorf("pool ID %s not found in chainPoolMapByID", poolIDKeyStr)
+		}
+
+		pool, err := r.unmarshalPool(sqsPoolModelBytes, chainPoolModelBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, pool)
+	}
+
+	// Sort by ID ascending.
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].GetId() < pools[j].GetId()
+	})
+
+	return pools, nil
+}
+
+// GetPools implements mvc.PoolsRepository.
+func (r *memoryPoolsRepo) GetPools(ctx context.Context, poolIDs map[uint64]struct{}) (map[uint64]domain.PoolI, error) {
+	memoryTx, err := r.startMemoryTx()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := getNamespaces(ctx)
+
+	pools := make(map[uint64]domain.PoolI, len(poolIDs))
+	for poolID := range poolIDs {
+		poolIDKeyStr := strconv.FormatUint(poolID, 10)
+
+		sqsPoolModelBytes, ok := memoryTx.Get(namespaces.sqsPoolModel, poolIDKeyStr)
+		if !ok {
+			return nil, domain.PoolNotFoundError{PoolID: poolID}
+		}
+
+		chainPoolModelBytes, ok := memoryTx.Get(namespaces.chainPoolModel, poolIDKeyStr)
+		if !ok {
+			return nil, domain.PoolNotFoundError{PoolID: poolID}
+		}
+
+		pool, err := r.unmarshalPool(sqsPoolModelBytes, chainPoolModelBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		pools[poolID] = pool
+	}
+
+	return pools, nil
+}
+
+// GetTickModelForPools implements mvc.PoolsRepository.
+// CONTRACT: pools must be concentrated
+func (r *memoryPoolsRepo) GetTickModelForPools(ctx context.Context, pools []uint64) (map[uint64]domain.TickModel, error) {
+	memoryTx, err := r.startMemoryTx()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := getNamespaces(ctx)
+
+	result := make(map[uint64]domain.TickModel, len(pools))
+	for _, poolID := range pools {
+		tickModelBytes, ok := memoryTx.Get(namespaces.concentratedTicks, strconv.FormatUint(poolID, 10))
+		if !ok {
+			return nil, domain.ConcentratedPoolNoTickModelError{PoolId: poolID}
+		}
+
+		var tickData domain.TickModel
+		if err := json.Unmarshal(tickModelBytes, &tickData); err != nil {
+			return nil, err
+		}
+		result[poolID] = tickData
+	}
+
+	return result, nil
+}
+
+// StorePools implements mvc.PoolsRepository.
+func (r *memoryPoolsRepo) StorePools(ctx context.Context, tx mvc.Tx, pools []domain.PoolI) error {
+	return r.storePools(tx, latestNamespaces, pools)
+}
+
+// StorePoolsAtHeight implements mvc.PoolsRepository.
+func (r *memoryPoolsRepo) StorePoolsAtHeight(ctx context.Context, tx mvc.Tx, height uint64, pools []domain.PoolI) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := memoryTx.Set(historicalHeightsNamespace, strconv.FormatUint(height, 10), nil, 0); err != nil {
+		return err
+	}
+
+	return r.storePools(tx, historicalNamespaces(height), pools)
+}
+
+// storePools stores the given pools in the given namespaces.
+func (r *memoryPoolsRepo) storePools(tx mvc.Tx, namespaces poolNamespaces, pools []domain.PoolI) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		serializedSQSPoolModel, err := json.Marshal(pool.GetSQSPoolModel())
+		if err != nil {
+			return err
+		}
+
+		serializedChainPoolModel, err := r.appCodec.MarshalInterfaceJSON(pool.GetUnderlyingPool())
+		if err != nil {
+			return err
+		}
+
+		poolIDKeyStr := strconv.FormatUint(pool.GetId(), 10)
+
+		if err := memoryTx.Set(namespaces.sqsPoolModel, poolIDKeyStr, serializedSQSPoolModel, 0); err != nil {
+			return err
+		}
+
+		if err := memoryTx.Set(namespaces.chainPoolModel, poolIDKeyStr, serializedChainPoolModel, 0); err != nil {
+			return err
+		}
+
+		// Write concentrated tick model
+		if pool.GetType() == poolmanagertypes.Concentrated {
+			tickModel, err := pool.GetTickModel()
+			if err != nil {
+				// Skip pool
+				continue
+			}
+
+			serializedTickModel, err := json.Marshal(tickModel)
+			if err != nil {
+				return err
+			}
+
+			if err := memoryTx.Set(namespaces.concentratedTicks, poolIDKeyStr, serializedTickModel, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ClearAllPools implements mvc.PoolsRepository.
+func (r *memoryPoolsRepo) ClearAllPools(ctx context.Context, tx mvc.Tx) error {
+	return r.deleteNamespaces(tx, latestNamespaces)
+}
+
+// DeletePoolsUpToHeight implements mvc.PoolsRepository.
+// The heights to delete are looked up in the height index as of the start of the transaction.
+func (r *memoryPoolsRepo) DeletePoolsUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for heightStr := range memoryTx.GetAll(historicalHeightsNamespace) {
+		storedHeight, err := strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if storedHeight > height {
+			continue
+		}
+
+		if err := r.deleteNamespaces(tx, historicalNamespaces(storedHeight)); err != nil {
+			return err
+		}
+
+		if err := memoryTx.Delete(historicalHeightsNamespace, heightStr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteNamespaces deletes all pools in the given namespaces.
+func (r *memoryPoolsRepo) deleteNamespaces(tx mvc.Tx, namespaces poolNamespaces) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range []string{namespaces.sqsPoolModel, namespaces.chainPoolModel, namespaces.concentratedTicks} {
+		if err := memoryTx.DeleteNamespace(namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// poolNamespaces are the namespaces of the pool state at a single height.
+type poolNamespaces struct {
+	sqsPoolModel      string
+	chainPoolModel    string
+	concentratedTicks string
+}
+
+// latestNamespaces are the namespaces of the latest pool state.
+var latestNamespaces = poolNamespaces{
+	sqsPoolModel:      sqsPoolModelNamespace,
+	chainPoolModel:    chainPoolModelNamespace,
+	concentratedTicks: concentratedTicksNamespace,
+}
+
+// historicalNamespaces returns the namespaces of the historical pool state at the given height.
+func historicalNamespaces(height uint64) poolNamespaces {
+	return poolNamespaces{
+		sqsPoolModel:      fmt.Sprintf("%s/%d", sqsPoolModelNamespace, height),
+		chainPoolModel:    fmt.Sprintf("%s/%d", chainPoolModelNamespace, height),
+		concentratedTicks: fmt.Sprintf("%s/%d", concentratedTicksNamespace, height),
+	}
+}
+
+// getNamespaces returns the namespaces of the historical state if a historical height is set on the context.
+// Otherwise, returns the namespaces of the latest state.
+func getNamespaces(ctx context.Context) poolNamespaces {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalNamespaces(height)
+	}
+	return latestNamespaces
+}
+
+// startMemoryTx starts a read-only transaction over the latest committed snapshot.
+func (r *memoryPoolsRepo) startMemoryTx() (memoryrepo.MemoryTx, error) {
+	return memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+}
+
+// unmarshalPool constructs a new pool from its serialized SQS and chain models.
+func (r *memoryPoolsRepo) unmarshalPool(sqsPoolModelBytes, chainPoolModelBytes []byte) (domain.PoolI, error) {
+	pool := &domain.PoolWrapper{
+		SQSModel: domain.SQSPool{},
+	}
+
+	if err := json.Unmarshal(sqsPoolModelBytes, &pool.SQSModel); err != nil {
+		return nil, err
+	}
+
+	if err := r.appCodec.UnmarshalInterfaceJSON(chainPoolModelBytes, &pool.ChainModel); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
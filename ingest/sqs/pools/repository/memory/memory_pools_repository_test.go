@@ -0,0 +1,203 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/repository/memory"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
+)
+
+type MemoryPoolsRepositoryTestSuite struct {
+	apptesting.ConcentratedKeeperTestHelper
+}
+
+func TestMemoryPoolsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MemoryPoolsRepositoryTestSuite))
+}
+
+// setupPools creates a balancer and a concentrated pool on chain and returns them wrapped as sidecar pools.
+// The concentrated pool has a tick model.
+func (s *MemoryPoolsRepositoryTestSuite) setupPools() (balancerPool, concentratedPool *domain.PoolWrapper) {
+	s.Setup()
+
+	balancerPoolID := s.PrepareBalancerPool()
+	balancerChainPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+
+	concentratedChainPool := s.PrepareConcentratedPool()
+
+	balancerPool = &domain.PoolWrapper{
+		ChainModel: balancerChainPool,
+		SQSModel: domain.SQSPool{
+			TotalValueLockedUSDC: osmomath.NewInt(100),
+			Balances:             sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(10))),
+			PoolDenoms:           []string{"bar", "baz", "foo", "uosmo"},
+			SpreadFactor:         balancerChainPool.GetSpreadFactor(s.Ctx),
+		},
+	}
+	concentratedPool = &domain.PoolWrapper{
+		ChainModel: concentratedChainPool,
+		SQSModel: domain.SQSPool{
+			TotalValueLockedUSDC: osmomath.NewInt(200),
+			Balances:             sdk.NewCoins(),
+			PoolDenoms:           []string{apptesting.ETH, apptesting.USDC},
+			SpreadFactor:         concentratedChainPool.GetSpreadFactor(s.Ctx),
+		},
+		// The pool has no positions.
+		TickModel: &domain.TickModel{
+			CurrentTickIndex: -1,
+			HasNoLiquidity:   true,
+		},
+	}
+
+	return balancerPool, concentratedPool
+}
+
+// Tests that the stored pools are only visible once the transaction is executed,
+// that they are read back as stored and that they are cleared atomically.
+func (s *MemoryPoolsRepositoryTestSuite) TestStoreAndGetPools() {
+	balancerPool, concentratedPool := s.setupPools()
+	ctx := context.Background()
+
+	txManager := memoryrepo.NewTxManager()
+	poolsRepo := memory.NewMemoryPoolsRepo(s.App.AppCodec(), txManager)
+
+	tx := txManager.StartTx()
+	s.Require().NoError(poolsRepo.StorePools(ctx, tx, []domain.PoolI{concentratedPool, balancerPool}))
+
+	// Not visible before Exec.
+	pools, err := poolsRepo.GetAllPools(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(pools)
+
+	s.Require().NoError(tx.Exec(ctx))
+
+	// Sorted by ID.
+	pools, err = poolsRepo.GetAllPools(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 2)
+	s.validatePool(balancerPool, pools[0])
+	s.validatePool(concentratedPool, pools[1])
+
+	poolsByID, err := poolsRepo.GetPools(ctx, map[uint64]struct{}{concentratedPool.GetId(): {}})
+	s.Require().NoError(err)
+	s.Require().Len(poolsByID, 1)
+	s.validatePool(concentratedPool, poolsByID[concentratedPool.GetId()])
+
+	unknownPoolID := concentratedPool.GetId() + 1
+	_, err = poolsRepo.GetPools(ctx, map[uint64]struct{}{unknownPoolID: {}})
+	s.Require().ErrorIs(err, domain.PoolNotFoundError{PoolID: unknownPoolID})
+
+	// Only the concentrated pool has a tick model.
+	tickModels, err := poolsRepo.GetTickModelForPools(ctx, []uint64{concentratedPool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(map[uint64]domain.TickModel{concentratedPool.GetId(): *concentratedPool.TickModel}, tickModels)
+
+	_, err = poolsRepo.GetTickModelForPools(ctx, []uint64{balancerPool.GetId()})
+	s.Require().ErrorIs(err, domain.ConcentratedPoolNoTickModelError{PoolId: balancerPool.GetId()})
+
+	// Clear all pools.
+	tx = txManager.StartTx()
+	s.Require().NoError(poolsRepo.ClearAllPools(ctx, tx))
+	s.Require().NoError(tx.Exec(ctx))
+
+	pools, err = poolsRepo.GetAllPools(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(pools)
+}
+
+// Tests that the pools stored at a height are only read when the height is set on the context
+// and that all heights up to the pruned height are removed once deleted.
+func (s *MemoryPoolsRepositoryTestSuite) TestStorePoolsAtHeight() {
+	const (
+		olderHistoricalHeight = uint64(8)
+		historicalHeight      = uint64(10)
+		retainedHeight        = uint64(12)
+		latestHeight          = uint64(13)
+	)
+
+	balancerPool, concentratedPool := s.setupPools()
+	ctx := context.Background()
+	historicalCtx := domain.WithHistoricalHeight(ctx, historicalHeight)
+
+	txManager := memoryrepo.NewTxManager()
+	poolsRepo := memory.NewMemoryPoolsRepo(s.App.AppCodec(), txManager)
+
+	tx := txManager.StartTx()
+	s.Require().NoError(poolsRepo.StorePoolsAtHeight(ctx, tx, olderHistoricalHeight, []domain.PoolI{balancerPool}))
+	s.Require().NoError(tx.Exec(ctx))
+
+	tx = txManager.StartTx()
+	s.Require().NoError(poolsRepo.StorePoolsAtHeight(ctx, tx, historicalHeight, []domain.PoolI{balancerPool}))
+	s.Require().NoError(poolsRepo.StorePoolsAtHeight(ctx, tx, retainedHeight, []domain.PoolI{concentratedPool}))
+	s.Require().NoError(poolsRepo.StorePools(ctx, tx, []domain.PoolI{balancerPool, concentratedPool}))
+	s.Require().NoError(tx.Exec(ctx))
+
+	// Historical state.
+	pools, err := poolsRepo.GetAllPools(historicalCtx)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 1)
+	s.validatePool(balancerPool, pools[0])
+
+	// Not ingested.
+	_, err = poolsRepo.GetAllPools(domain.WithHistoricalHeight(ctx, latestHeight))
+	s.Require().ErrorIs(err, domain.HistoricalStateNotFoundError{Height: latestHeight})
+
+	// Prune the historical state up to and including the historical height.
+	tx = txManager.StartTx()
+	s.Require().NoError(poolsRepo.DeletePoolsUpToHeight(ctx, tx, historicalHeight))
+	s.Require().NoError(tx.Exec(ctx))
+
+	for _, prunedHeight := range []uint64{olderHistoricalHeight, historicalHeight} {
+		_, err = poolsRepo.GetAllPools(domain.WithHistoricalHeight(ctx, prunedHeight))
+		s.Require().ErrorIs(err, domain.HistoricalStateNotFoundError{Height: prunedHeight})
+	}
+
+	// The retained height is unaffected.
+	pools, err = poolsRepo.GetAllPools(domain.WithHistoricalHeight(ctx, retainedHeight))
+	s.Require().NoError(err)
+	s.Require().Len(pools, 1)
+	s.Require().Equal(concentratedPool.GetId(), pools[0].GetId())
+
+	// The latest state is unaffected.
+	pools, err = poolsRepo.GetAllPools(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 2)
+}
+
+// Tests that the writes of transactions of other storage backends are rejected.
+func (s *MemoryPoolsRepositoryTestSuite) TestStorePools_InvalidTx() {
+	balancerPool, _ := s.setupPools()
+
+	poolsRepo := memory.NewMemoryPoolsRepo(s.App.AppCodec(), memoryrepo.NewTxManager())
+
+	var tx mvc.Tx = redisrepo.NewRedisTx(nil)
+	err := poolsRepo.StorePools(context.Background(), tx, []domain.PoolI{balancerPool})
+	s.Require().ErrorIs(err, domain.UnexpectedTxBackendError{ExpectedBackend: "in-memory"})
+}
+
+// validatePool validates that the actual pool read from the repository matches the expected stored pool.
+// Tick models are not returned with the pools.
+func (s *MemoryPoolsRepositoryTestSuite) validatePool(expected *domain.PoolWrapper, actual domain.PoolI) {
+	s.Require().Equal(expected.GetId(), actual.GetId())
+	s.Require().Equal(expected.GetType(), actual.GetType())
+
+	// Compare the string representations since the decimals are not guaranteed
+	// to have the same internal representation after the serialization round trip.
+	expectedSQSModel, actualSQSModel := expected.GetSQSPoolModel(), actual.GetSQSPoolModel()
+	s.Require().Equal(expectedSQSModel.TotalValueLockedUSDC.String(), actualSQSModel.TotalValueLockedUSDC.String())
+	s.Require().Equal(expectedSQSModel.Balances.String(), actualSQSModel.Balances.String())
+	s.Require().Equal(expectedSQSModel.PoolDenoms, actualSQSModel.PoolDenoms)
+	s.Require().Equal(expectedSQSModel.SpreadFactor.String(), actualSQSModel.SpreadFactor.String())
+
+	s.Require().Equal(expected.GetUnderlyingPool().String(), actual.GetUnderlyingPool().String())
+}
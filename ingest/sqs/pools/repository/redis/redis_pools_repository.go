@@ -13,6 +13,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -32,6 +33,8 @@ var (
 
 const (
 	poolsKey = "pools"
+	// historicalHeightsKey is the sorted set indexing the heights at which the historical pool state is stored.
+	historicalHeightsKey = poolsKey + "/heights"
 )
 
 // NewRedisPoolsRepo will create an implementation of pools.Repository
@@ -47,7 +50,7 @@ func NewRedisPoolsRepo(appCodec codec.Codec, repositoryManager mvc.TxManager) mv
 func (r *redisPoolsRepo) GetAllPools(ctx context.Context) ([]domain.PoolI, error) {
 	tx := r.repositoryManager.StartTx()
 
-	sqsPoolMapByIDCmd, chainPoolMapByIDCmd, err := r.requestPoolsAtomically(ctx, tx, poolsKey)
+	sqsPoolMapByIDCmd, chainPoolMapByIDCmd, err := r.requestPoolsAtomically(ctx, tx, getStoreKey(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +64,11 @@ func (r *redisPoolsRepo) GetAllPools(ctx context.Context) ([]domain.PoolI, error
 		return nil, err
 	}
 
+	// There is always at least one pool at any ingested height.
+	if height, isHistorical := domain.GetHistoricalHeightFromContext(ctx); isHistorical && len(allPools) == 0 {
+		return nil, domain.HistoricalStateNotFoundError{Height: height}
+	}
+
 	// Sort by ID
 	sort.Slice(allPools, func(i, j int) bool {
 		return allPools[i].GetId() < allPools[j].GetId()
@@ -73,7 +81,7 @@ func (r *redisPoolsRepo) GetAllPools(ctx context.Context) ([]domain.PoolI, error
 func (r *redisPoolsRepo) GetPools(ctx context.Context, poolIDs map[uint64]struct{}) (map[uint64]domain.PoolI, error) {
 	tx := r.repositoryManager.StartTx()
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -90,9 +98,10 @@ func (r *redisPoolsRepo) GetPools(ctx context.Context, poolIDs map[uint64]struct
 
 	poolCmds := make([]poolCmdsWrapper, 0, len(poolIDs))
 
+	storeKey := getStoreKey(ctx)
 	for poolID := range poolIDs {
-		sqsPoolModelCmd := pipeliner.HGet(ctx, sqsPoolModelKey(poolsKey), strconv.FormatUint(poolID, 10))
-		chainPoolModelCmd := pipeliner.HGet(ctx, chainPoolModelKey(poolsKey), strconv.FormatUint(poolID, 10))
+		sqsPoolModelCmd := pipeliner.HGet(ctx, sqsPoolModelKey(storeKey), strconv.FormatUint(poolID, 10))
+		chainPoolModelCmd := pipeliner.HGet(ctx, chainPoolModelKey(storeKey), strconv.FormatUint(poolID, 10))
 
 		poolCmds = append(poolCmds, poolCmdsWrapper{
 			sqsPoolCmd:   sqsPoolModelCmd,
@@ -134,6 +143,51 @@ func (r *redisPoolsRepo) StorePools(ctx context.Context, tx mvc.Tx, pools []doma
 	return nil
 }
 
+// StorePoolsAtHeight implements mvc.PoolsRepository.
+func (r *redisPoolsRepo) StorePoolsAtHeight(ctx context.Context, tx mvc.Tx, height uint64, pools []domain.PoolI) error {
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := pipeliner.ZAdd(ctx, historicalHeightsKey, redis.Z{Score: float64(height), Member: height}).Err(); err != nil {
+		return err
+	}
+
+	return r.addPoolsTx(ctx, tx, historicalPoolsKey(height), pools)
+}
+
+// DeletePoolsUpToHeight implements mvc.PoolsRepository.
+// The heights to delete are looked up in the committed height index.
+func (r *redisPoolsRepo) DeletePoolsUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	heights, err := redisrepo.GetHeightsUpTo(ctx, r.repositoryManager, historicalHeightsKey, height)
+	if err != nil {
+		return err
+	}
+
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, storedHeight := range heights {
+		storeKey := historicalPoolsKey(storedHeight)
+		if err := pipeliner.Del(ctx, sqsPoolModelKey(storeKey), chainPoolModelKey(storeKey), concentratedTicksModelKey(storeKey)).Err(); err != nil {
+			return err
+		}
+	}
+
+	return pipeliner.ZRemRangeByScore(ctx, historicalHeightsKey, "-inf", strconv.FormatUint(height, 10)).Err()
+}
+
 func (r *redisPoolsRepo) ClearAllPools(ctx context.Context, tx mvc.Tx) error {
 	// CFMM pools
 	if err := r.deletePoolsTx(ctx, tx, poolsKey); err != nil {
@@ -157,7 +211,7 @@ func (r *redisPoolsRepo) requestPoolsAtomically(ctx context.Context, tx mvc.Tx,
 		return nil, nil, fmt.Errorf("tx is inactive")
 	}
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -212,7 +266,7 @@ func (r *redisPoolsRepo) getPools(sqsPoolMapByID, chainPoolMapByID map[string]st
 
 // addPoolsTx pipelines the given pools at the given storeKey to be executed atomically in a transaction.
 func (r *redisPoolsRepo) addPoolsTx(ctx context.Context, tx mvc.Tx, storeKey string, pools []domain.PoolI) error {
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
@@ -269,7 +323,7 @@ func (r *redisPoolsRepo) addPoolsTx(ctx context.Context, tx mvc.Tx, storeKey str
 
 // deletePoolsTx pipelines the deletion of the pools at a given storeKey to be executed atomically in a transaction.
 func (r *redisPoolsRepo) deletePoolsTx(ctx context.Context, tx mvc.Tx, storeKey string) error {
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
@@ -301,7 +355,7 @@ func (r *redisPoolsRepo) deletePoolsTx(ctx context.Context, tx mvc.Tx, storeKey
 func (r *redisPoolsRepo) GetTickModelForPools(ctx context.Context, pools []uint64) (map[uint64]domain.TickModel, error) {
 	tx := r.repositoryManager.StartTx()
 
-	redixTx, err := tx.AsRedisTx()
+	redixTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -312,8 +366,9 @@ func (r *redisPoolsRepo) GetTickModelForPools(ctx context.Context, pools []uint6
 	}
 
 	poolTickData := make([]poolTicks, 0, len(pools))
+	storeKey := getStoreKey(ctx)
 	for _, poolID := range pools {
-		stringCmd := pipeliner.HGet(ctx, concentratedTicksModelKey(poolsKey), strconv.FormatUint(poolID, 10))
+		stringCmd := pipeliner.HGet(ctx, concentratedTicksModelKey(storeKey), strconv.FormatUint(poolID, 10))
 		poolTickData = append(poolTickData, poolTicks{
 			poolID: poolID,
 			Cmd:    stringCmd,
@@ -338,6 +393,19 @@ func (r *redisPoolsRepo) GetTickModelForPools(ctx context.Context, pools []uint6
 	return result, nil
 }
 
+// getStoreKey returns the store key of the historical state if a historical height is set on the context.
+// Otherwise, returns the store key of the latest state.
+func getStoreKey(ctx context.Context) string {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalPoolsKey(height)
+	}
+	return poolsKey
+}
+
+func historicalPoolsKey(height uint64) string {
+	return fmt.Sprintf("%s/%d", poolsKey, height)
+}
+
 func sqsPoolModelKey(storeKey string) string {
 	return fmt.Sprintf("%s/sqs", storeKey)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
@@ -15,16 +16,19 @@ type poolsUseCase struct {
 	contextTimeout         time.Duration
 	poolsRepository        mvc.PoolsRepository
 	redisRepositoryManager mvc.TxManager
+	cosmWasmConfig         domain.CosmWasmPoolRouterConfig
 }
 
 var _ mvc.PoolsUsecase = &poolsUseCase{}
 
 // NewPoolsUsecase will create a new pools use case object
-func NewPoolsUsecase(timeout time.Duration, poolsRepository mvc.PoolsRepository, redisRepositoryManager mvc.TxManager) mvc.PoolsUsecase {
+// cosmWasmConfig defines which CosmWasm pools are quoted by querying the pool contract when converted to routable pools.
+func NewPoolsUsecase(timeout time.Duration, poolsRepository mvc.PoolsRepository, redisRepositoryManager mvc.TxManager, cosmWasmConfig domain.CosmWasmPoolRouterConfig) mvc.PoolsUsecase {
 	return &poolsUseCase{
 		contextTimeout:         timeout,
 		poolsRepository:        poolsRepository,
 		redisRepositoryManager: redisRepositoryManager,
+		cosmWasmConfig:         cosmWasmConfig,
 	}
 }
 
@@ -42,7 +46,7 @@ func (p *poolsUseCase) GetAllPools(ctx context.Context) ([]domain.PoolI, error)
 }
 
 // GetRoutesFromCandidates implements mvc.PoolsUsecase.
-func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error) {
+func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error) {
 	// Get all pools
 	poolsData, err := p.poolsRepository.GetPools(ctx, candidateRoutes.UniquePoolIDs)
 	if err != nil {
@@ -64,9 +68,20 @@ func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRou
 		return nil, err
 	}
 
+	return p.ConvertCandidateRoutes(candidateRoutes, poolsData, tickModelMap, takerFeeMap, routeTakerFeeMap, tokenInDenom)
+}
+
+// ConvertCandidateRoutes implements mvc.PoolsUsecase.
+func (p *poolsUseCase) ConvertCandidateRoutes(candidateRoutes route.CandidateRoutes, poolsData map[uint64]domain.PoolI, tickModelMap map[uint64]domain.TickModel, takerFeeMap domain.TakerFeeMap, routeTakerFeeMap domain.RouteTakerFeeMap, tokenInDenom string) ([]route.RouteImpl, error) {
 	// Convert each candidate route into the actual route with all pool data
 	routes := make([]route.RouteImpl, 0, len(candidateRoutes.Routes))
 	for _, candidateRoute := range candidateRoutes.Routes {
+		// Get the taker fee override of the route, if any.
+		var routeTakerFee *osmomath.Dec
+		if numPools := len(candidateRoute.Pools); numPools > 0 {
+			routeTakerFee = routeTakerFeeMap.GetRouteTakerFee(numPools, candidateRoute.Pools[numPools-1].TokenOutDenom)
+		}
+
 		previousTokenOutDenom := tokenInDenom
 		routablePools := make([]domain.RoutablePool, 0, len(candidateRoute.Pools))
 		for _, candidatePool := range candidateRoute.Pools {
@@ -77,7 +92,7 @@ func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRou
 			}
 
 			// Get taker fee
-			takerFee := takerFeeMap.GetTakerFee(previousTokenOutDenom, candidatePool.TokenOutDenom)
+			takerFee := takerFeeMap.GetRouteHopTakerFee(pool, previousTokenOutDenom, candidatePool.TokenOutDenom, routeTakerFee)
 
 			if pool.GetType() == poolmanagertypes.Concentrated {
 				// Get tick model for concentrated pool
@@ -94,7 +109,7 @@ func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRou
 			}
 
 			// Create routable pool
-			routablePools = append(routablePools, pools.NewRoutablePool(pool, candidatePool.TokenOutDenom, takerFee))
+			routablePools = append(routablePools, pools.NewRoutablePool(pool, candidatePool.TokenOutDenom, takerFee, p.cosmWasmConfig))
 		}
 
 		routes = append(routes, route.RouteImpl{
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
@@ -84,14 +85,54 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 		}: defaultTakerFee,
 	}
 
+	// Setup a second chain pool for multi-hop routes
+	secondPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(denomTwo, defaultAmt0), sdk.NewCoin(denomThree, defaultAmt1))
+	secondBalancerPool, err := s.App.GAMMKeeper.GetPool(s.Ctx, secondPoolID)
+	s.Require().NoError(err)
+
+	secondPool := &mocks.MockRoutablePool{
+		ChainPoolModel: secondBalancerPool,
+		ID:             defaultPoolID + 1,
+	}
+
+	poolTakerFeeOverride := osmomath.MustNewDecFromStr("0.0005")
+	secondPoolWithTakerFeeOverride := &mocks.MockRoutablePool{
+		ChainPoolModel:   secondBalancerPool,
+		ID:               defaultPoolID + 1,
+		TakerFeeOverride: &poolTakerFeeOverride,
+	}
+
+	multiHopCandidateRoutes := route.CandidateRoutes{
+		Routes: []route.CandidateRoute{
+			{
+				Pools: []route.CandidatePool{
+					{
+						ID:            defaultPoolID,
+						TokenOutDenom: denomTwo,
+					},
+					{
+						ID:            defaultPoolID + 1,
+						TokenOutDenom: denomThree,
+					},
+				},
+			},
+		},
+	}
+
+	routeTakerFee := osmomath.MustNewDecFromStr("0.0001")
+	routeTakerFeeMap := domain.RouteTakerFeeMap{
+		denomThree: routeTakerFee,
+	}
+
 	tests := []struct {
 		name string
 
-		pools           []domain.PoolI
-		candidateRoutes route.CandidateRoutes
-		takerFeeMap     domain.TakerFeeMap
-		tokenInDenom    string
-		tokenOutDenom   string
+		pools            []domain.PoolI
+		candidateRoutes  route.CandidateRoutes
+		takerFeeMap      domain.TakerFeeMap
+		routeTakerFeeMap domain.RouteTakerFeeMap
+		tokenInDenom     string
+		tokenOutDenom    string
 
 		expectedError error
 
@@ -110,7 +151,7 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 			expectedRoutes: []route.RouteImpl{
 				{
 					Pools: []domain.RoutablePool{
-						pools.NewRoutablePool(defaultPool, denomTwo, defaultTakerFee),
+						pools.NewRoutablePool(defaultPool, denomTwo, defaultTakerFee, domain.CosmWasmPoolRouterConfig{}),
 					},
 				},
 			},
@@ -130,7 +171,66 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 			expectedRoutes: []route.RouteImpl{
 				{
 					Pools: []domain.RoutablePool{
-						pools.NewRoutablePool(defaultPool, denomTwo, domain.DefaultTakerFee),
+						pools.NewRoutablePool(defaultPool, denomTwo, domain.DefaultTakerFee, domain.CosmWasmPoolRouterConfig{}),
+					},
+				},
+			},
+		},
+		{
+			name:  "route taker fee is not applied to single hop route",
+			pools: validPools,
+
+			candidateRoutes:  validCandidateRoutes,
+			takerFeeMap:      validTakerFeeMap,
+			routeTakerFeeMap: domain.RouteTakerFeeMap{denomTwo: routeTakerFee},
+
+			tokenInDenom:  denomOne,
+			tokenOutDenom: denomTwo,
+
+			expectedRoutes: []route.RouteImpl{
+				{
+					Pools: []domain.RoutablePool{
+						pools.NewRoutablePool(defaultPool, denomTwo, defaultTakerFee, domain.CosmWasmPoolRouterConfig{}),
+					},
+				},
+			},
+		},
+		{
+			name:  "route taker fee is applied to every hop of multi-hop route",
+			pools: []domain.PoolI{defaultPool, secondPool},
+
+			candidateRoutes:  multiHopCandidateRoutes,
+			takerFeeMap:      validTakerFeeMap,
+			routeTakerFeeMap: routeTakerFeeMap,
+
+			tokenInDenom:  denomOne,
+			tokenOutDenom: denomThree,
+
+			expectedRoutes: []route.RouteImpl{
+				{
+					Pools: []domain.RoutablePool{
+						pools.NewRoutablePool(defaultPool, denomTwo, routeTakerFee, domain.CosmWasmPoolRouterConfig{}),
+						pools.NewRoutablePool(secondPool, denomThree, routeTakerFee, domain.CosmWasmPoolRouterConfig{}),
+					},
+				},
+			},
+		},
+		{
+			name:  "pool taker fee override takes precedence over route taker fee",
+			pools: []domain.PoolI{defaultPool, secondPoolWithTakerFeeOverride},
+
+			candidateRoutes:  multiHopCandidateRoutes,
+			takerFeeMap:      validTakerFeeMap,
+			routeTakerFeeMap: routeTakerFeeMap,
+
+			tokenInDenom:  denomOne,
+			tokenOutDenom: denomThree,
+
+			expectedRoutes: []route.RouteImpl{
+				{
+					Pools: []domain.RoutablePool{
+						pools.NewRoutablePool(defaultPool, denomTwo, routeTakerFee, domain.CosmWasmPoolRouterConfig{}),
+						pools.NewRoutablePool(secondPoolWithTakerFeeOverride, denomThree, poolTakerFeeOverride, domain.CosmWasmPoolRouterConfig{}),
 					},
 				},
 			},
@@ -153,7 +253,6 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 		},
 
 		// TODO:
-		// Valid conversion of single multi-hop route
 		// Valid conversion of two routes where one is multi hop
 	}
 
@@ -167,10 +266,10 @@ func (s *PoolsUsecaseTestSuite) TestGetRoutesFromCandidates() {
 			}
 
 			// Create pools use case
-			poolsUsecase := usecase.NewPoolsUsecase(time.Second, poolsRepository, nil)
+			poolsUsecase := usecase.NewPoolsUsecase(time.Second, poolsRepository, nil, domain.CosmWasmPoolRouterConfig{})
 
 			// System under test
-			actualRoutes, err := poolsUsecase.GetRoutesFromCandidates(context.Background(), tc.candidateRoutes, tc.takerFeeMap, tc.tokenInDenom, tc.tokenOutDenom)
+			actualRoutes, err := poolsUsecase.GetRoutesFromCandidates(context.Background(), tc.candidateRoutes, tc.takerFeeMap, tc.routeTakerFeeMap, tc.tokenInDenom, tc.tokenOutDenom)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
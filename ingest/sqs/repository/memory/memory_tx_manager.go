@@ -0,0 +1,263 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+// entry is a single value in the store.
+// A zero expiresAt means that the entry never expires.
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// isExpired returns true if the entry has expired as of now.
+func (e entry) isExpired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// state is an immutable view of the store keyed by namespace and then by key.
+// CONTRACT: once published, neither the outer nor the inner maps are mutated.
+type state map[string]map[string]entry
+
+// MemoryTxManager is a structure encapsulating creation of atomic transactions
+// against an in-memory copy-on-write store.
+// Readers never block: each transaction reads from the state snapshot taken at its start.
+// Commits are serialized and publish a new state by copying only the namespaces they touch.
+type MemoryTxManager struct {
+	commitMu sync.Mutex
+	state    atomic.Pointer[state]
+}
+
+var (
+	_ mvc.TxManager = &MemoryTxManager{}
+)
+
+// NewTxManager creates a new in-memory TxManager.
+func NewTxManager() mvc.TxManager {
+	txManager := &MemoryTxManager{}
+	txManager.state.Store(&state{})
+	return txManager
+}
+
+// StartTx implements mvc.TxManager.
+func (m *MemoryTxManager) StartTx() mvc.Tx {
+	return &memoryTx{
+		manager:  m,
+		snapshot: *m.state.Load(),
+		isActive: true,
+	}
+}
+
+// commit atomically applies the given operations on top of the latest state.
+func (m *MemoryTxManager) commit(ops []operation) {
+	if len(ops) == 0 {
+		return
+	}
+
+	m.commitMu.Lock()
+	defer m.commitMu.Unlock()
+
+	current := *m.state.Load()
+
+	// Shallow copy the namespaces. Inner maps are copied lazily on first write.
+	next := make(state, len(current))
+	for namespace, values := range current {
+		next[namespace] = values
+	}
+	copiedNamespaces := make(map[string]struct{})
+
+	now := time.Now()
+	for _, op := range ops {
+		switch op.kind {
+		case operationClearAll:
+			next = make(state)
+			copiedNamespaces = make(map[string]struct{})
+		case operationDeleteNamespace:
+			delete(next, op.namespace)
+			delete(copiedNamespaces, op.namespace)
+		case operationSet, operationDelete:
+			if _, ok := copiedNamespaces[op.namespace]; !ok {
+				values := next[op.namespace]
+				valuesCopy := make(map[string]entry, len(values)+1)
+				for key, value := range values {
+					valuesCopy[key] = value
+				}
+				next[op.namespace] = valuesCopy
+				copiedNamespaces[op.namespace] = struct{}{}
+			}
+
+			if op.kind == operationDelete {
+				delete(next[op.namespace], op.key)
+				continue
+			}
+
+			newEntry := entry{value: op.value}
+			if op.expiry > 0 {
+				newEntry.expiresAt = now.Add(op.expiry)
+			}
+			next[op.namespace][op.key] = newEntry
+		}
+	}
+
+	m.state.Store(&next)
+}
+
+type operationKind int
+
+const (
+	operationSet operationKind = iota
+	operationDelete
+	operationDeleteNamespace
+	operationClearAll
+)
+
+// operation is a buffered write of a transaction.
+type operation struct {
+	kind      operationKind
+	namespace string
+	key       string
+	value     []byte
+	expiry    time.Duration
+}
+
+// MemoryTx is an in-memory transaction.
+// Reads observe the snapshot of the store taken when the transaction was started.
+// Writes are buffered and become visible to readers atomically on Exec.
+type MemoryTx interface {
+	mvc.Tx
+
+	// Get returns the value stored under the given key in the given namespace.
+	// Returns false if the key is not present or has expired.
+	Get(namespace, key string) ([]byte, bool)
+
+	// GetAll returns all unexpired key-value pairs in the given namespace.
+	GetAll(namespace string) map[string][]byte
+
+	// Set buffers a write of the value under the given key in the given namespace.
+	// If expiry is non-zero, the value is treated as absent once expiry elapses after commit.
+	Set(namespace, key string, value []byte, expiry time.Duration) error
+
+	// Delete buffers a deletion of the given key in the given namespace.
+	Delete(namespace, key string) error
+
+	// DeleteNamespace buffers a deletion of all keys in the given namespace.
+	DeleteNamespace(namespace string) error
+}
+
+// AsMemoryTx returns the given transaction as an in-memory transaction.
+// Returns an error if this is not an in-memory transaction.
+func AsMemoryTx(tx mvc.Tx) (MemoryTx, error) {
+	memoryTx, ok := tx.(MemoryTx)
+	if !ok {
+		return nil, domain.UnexpectedTxBackendError{ExpectedBackend: "in-memory"}
+	}
+	return memoryTx, nil
+}
+
+// memoryTx is an in-memory transaction.
+type memoryTx struct {
+	manager  *MemoryTxManager
+	snapshot state
+	ops      []operation
+	isActive bool
+}
+
+var (
+	_ MemoryTx = &memoryTx{}
+)
+
+var errNoTxInProgress = errors.New("no tx in progress")
+
+// Exec implements mvc.Tx.
+func (t *memoryTx) Exec(ctx context.Context) error {
+	if !t.isActive {
+		return errNoTxInProgress
+	}
+
+	t.manager.commit(t.ops)
+
+	t.ops = nil
+	t.snapshot = nil
+	t.isActive = false
+	return nil
+}
+
+// IsActive implements mvc.Tx.
+func (t *memoryTx) IsActive() bool {
+	return t.isActive
+}
+
+// ClearAll implements mvc.Tx.
+func (t *memoryTx) ClearAll(ctx context.Context) error {
+	return t.addOperation(operation{kind: operationClearAll})
+}
+
+// Get implements MemoryTx.
+func (t *memoryTx) Get(namespace, key string) ([]byte, bool) {
+	value, ok := t.snapshot[namespace][key]
+	if !ok || value.isExpired(time.Now()) {
+		return nil, false
+	}
+	return value.value, true
+}
+
+// GetAll implements MemoryTx.
+func (t *memoryTx) GetAll(namespace string) map[string][]byte {
+	values := t.snapshot[namespace]
+
+	now := time.Now()
+	result := make(map[string][]byte, len(values))
+	for key, value := range values {
+		if value.isExpired(now) {
+			continue
+		}
+		result[key] = value.value
+	}
+	return result
+}
+
+// Set implements MemoryTx.
+func (t *memoryTx) Set(namespace, key string, value []byte, expiry time.Duration) error {
+	return t.addOperation(operation{
+		kind:      operationSet,
+		namespace: namespace,
+		key:       key,
+		value:     value,
+		expiry:    expiry,
+	})
+}
+
+// Delete implements MemoryTx.
+func (t *memoryTx) Delete(namespace, key string) error {
+	return t.addOperation(operation{
+		kind:      operationDelete,
+		namespace: namespace,
+		key:       key,
+	})
+}
+
+// DeleteNamespace implements MemoryTx.
+func (t *memoryTx) DeleteNamespace(namespace string) error {
+	return t.addOperation(operation{
+		kind:      operationDeleteNamespace,
+		namespace: namespace,
+	})
+}
+
+// addOperation buffers the given operation to be applied on Exec.
+// Returns error if transaction is not in progress.
+func (t *memoryTx) addOperation(op operation) error {
+	if !t.isActive {
+		return errNoTxInProgress
+	}
+	t.ops = append(t.ops, op)
+	return nil
+}
@@ -0,0 +1,131 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
+)
+
+const (
+	defaultNamespace = "namespace"
+	otherNamespace   = "other"
+)
+
+func startMemoryTx(t *testing.T, txManager mvc.TxManager) memory.MemoryTx {
+	memoryTx, err := memory.AsMemoryTx(txManager.StartTx())
+	require.NoError(t, err)
+	return memoryTx
+}
+
+// Tests that writes are only visible to transactions started after commit
+// and that readers keep observing their snapshot.
+func TestMemoryTx_SnapshotIsolation(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+
+	writeTx := startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.Set(defaultNamespace, "a", []byte("1"), 0))
+	require.NoError(t, writeTx.Set(otherNamespace, "b", []byte("2"), 0))
+
+	// Not visible before Exec.
+	readerBefore := startMemoryTx(t, txManager)
+	_, ok := readerBefore.Get(defaultNamespace, "a")
+	require.False(t, ok)
+
+	require.NoError(t, writeTx.Exec(ctx))
+	require.False(t, writeTx.IsActive())
+
+	// Reader started before commit still observes the old snapshot.
+	_, ok = readerBefore.Get(defaultNamespace, "a")
+	require.False(t, ok)
+
+	readerAfter := startMemoryTx(t, txManager)
+	value, ok := readerAfter.Get(defaultNamespace, "a")
+	require.True(t, ok)
+	require.Equal(t, []byte("1"), value)
+
+	// Overwrite and delete in a new block.
+	writeTx = startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.Set(defaultNamespace, "a", []byte("3"), 0))
+	require.NoError(t, writeTx.Delete(otherNamespace, "b"))
+	require.NoError(t, writeTx.Exec(ctx))
+
+	// Previous snapshot is unaffected by the copy-on-write commit.
+	value, ok = readerAfter.Get(defaultNamespace, "a")
+	require.True(t, ok)
+	require.Equal(t, []byte("1"), value)
+	require.Len(t, readerAfter.GetAll(otherNamespace), 1)
+
+	latest := startMemoryTx(t, txManager)
+	value, ok = latest.Get(defaultNamespace, "a")
+	require.True(t, ok)
+	require.Equal(t, []byte("3"), value)
+	require.Empty(t, latest.GetAll(otherNamespace))
+}
+
+func TestMemoryTx_DeleteNamespaceAndClearAll(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+
+	writeTx := startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.Set(defaultNamespace, "a", []byte("1"), 0))
+	require.NoError(t, writeTx.Set(otherNamespace, "b", []byte("2"), 0))
+	require.NoError(t, writeTx.Exec(ctx))
+
+	// Delete namespace followed by a write to the same namespace within one transaction.
+	writeTx = startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.DeleteNamespace(defaultNamespace))
+	require.NoError(t, writeTx.Set(defaultNamespace, "c", []byte("3"), 0))
+	require.NoError(t, writeTx.Exec(ctx))
+
+	reader := startMemoryTx(t, txManager)
+	require.Equal(t, map[string][]byte{"c": []byte("3")}, reader.GetAll(defaultNamespace))
+	require.Len(t, reader.GetAll(otherNamespace), 1)
+
+	writeTx = startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.ClearAll(ctx))
+	require.NoError(t, writeTx.Exec(ctx))
+
+	reader = startMemoryTx(t, txManager)
+	require.Empty(t, reader.GetAll(defaultNamespace))
+	require.Empty(t, reader.GetAll(otherNamespace))
+}
+
+func TestMemoryTx_Expiry(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+
+	writeTx := startMemoryTx(t, txManager)
+	require.NoError(t, writeTx.Set(defaultNamespace, "expiring", []byte("1"), time.Millisecond))
+	require.NoError(t, writeTx.Set(defaultNamespace, "persistent", []byte("2"), 0))
+	require.NoError(t, writeTx.Exec(ctx))
+
+	time.Sleep(time.Millisecond * 10)
+
+	reader := startMemoryTx(t, txManager)
+	_, ok := reader.Get(defaultNamespace, "expiring")
+	require.False(t, ok)
+	require.Equal(t, map[string][]byte{"persistent": []byte("2")}, reader.GetAll(defaultNamespace))
+}
+
+func TestMemoryTx_Inactive(t *testing.T) {
+	ctx := context.Background()
+	txManager := memory.NewTxManager()
+
+	tx := txManager.StartTx()
+	require.NoError(t, tx.Exec(ctx))
+
+	require.Error(t, tx.Exec(ctx))
+	require.Error(t, tx.ClearAll(ctx))
+
+	// Transactions of other backends are rejected.
+	_, err := memory.AsMemoryTx(redisrepo.NewRedisTx(nil))
+	require.ErrorIs(t, err, domain.UnexpectedTxBackendError{ExpectedBackend: "in-memory"})
+}
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+// GetHeightsUpTo returns the committed heights less than or equal to maxHeight
+// from the sorted set index at the given key in ascending order.
+// The index members and scores are both the heights.
+func GetHeightsUpTo(ctx context.Context, txManager mvc.TxManager, indexKey string, maxHeight uint64) ([]uint64, error) {
+	tx := txManager.StartTx()
+
+	redisTx, err := AsRedisTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	heightsCmd := pipeliner.ZRangeByScore(ctx, indexKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatUint(maxHeight, 10),
+	})
+
+	if err := tx.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	heightStrs, err := heightsCmd.Result()
+	if err != nil {
+		return nil, err
+	}
+
+	heights := make([]uint64, 0, len(heightStrs))
+	for _, heightStr := range heightStrs {
+		height, err := strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, nil
+}
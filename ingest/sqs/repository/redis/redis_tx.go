@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+// RedisTx is a redis transaction.
+type RedisTx struct {
+	pipeliner redis.Pipeliner
+}
+
+var (
+	_ mvc.Tx = &RedisTx{}
+)
+
+// NewRedisTx creates a new redis transaction over the given pipeliner.
+func NewRedisTx(pipeliner redis.Pipeliner) *RedisTx {
+	return &RedisTx{
+		pipeliner: pipeliner,
+	}
+}
+
+// AsRedisTx returns the given transaction as a redis transaction.
+// Returns an error if this is not a redis transaction.
+func AsRedisTx(tx mvc.Tx) (*RedisTx, error) {
+	redisTx, ok := tx.(*RedisTx)
+	if !ok {
+		return nil, domain.UnexpectedTxBackendError{ExpectedBackend: "redis"}
+	}
+	return redisTx, nil
+}
+
+// IsActive implements mvc.Tx.
+func (rt *RedisTx) IsActive() bool {
+	return rt.pipeliner != nil
+}
+
+// Exec implements mvc.Tx.
+func (rt *RedisTx) Exec(ctx context.Context) error {
+	_, err := rt.pipeliner.Exec(ctx)
+	rt.pipeliner = nil
+	return err
+}
+
+// GetPipeliner returns a redis pipeliner for the current transaction.
+// Returns an error if transaction is not in progress.
+func (rt *RedisTx) GetPipeliner(ctx context.Context) (redis.Pipeliner, error) {
+	if !rt.IsActive() {
+		return nil, errors.New("no tx in progress")
+	}
+
+	return rt.pipeliner, nil
+}
+
+// ClearAll implements mvc.Tx.
+func (rt *RedisTx) ClearAll(ctx context.Context) error {
+	// TODO: can we make async flush here?
+	flushCmd := rt.pipeliner.FlushAll(ctx)
+
+	_, err := flushCmd.Result()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
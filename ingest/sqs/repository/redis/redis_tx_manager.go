@@ -24,5 +24,5 @@ func NewTxManager(redisClient *redis.Client) mvc.TxManager {
 
 // StartTx implements mvc.AtomicRepositoryManager.
 func (rm *RedisTxManager) StartTx() mvc.Tx {
-	return mvc.NewRedisTx(rm.client.TxPipeline())
+	return NewRedisTx(rm.client.TxPipeline())
 }
@@ -0,0 +1,162 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+)
+
+// QuoteStreamHandler represent the httphandler for streaming quotes
+type QuoteStreamHandler struct {
+	QSUsecase mvc.QuoteStreamUsecase
+	logger    log.Logger
+}
+
+const (
+	// lastEventIDHeader is set by the EventSource clients on reconnect
+	// to the id of the last received event.
+	lastEventIDHeader = "Last-Event-ID"
+
+	quoteEventName = "quote"
+)
+
+// NewQuoteStreamHandler will initialize the router/quote-stream resource endpoint
+func NewQuoteStreamHandler(e *echo.Echo, us mvc.QuoteStreamUsecase, logger log.Logger) {
+	handler := &QuoteStreamHandler{
+		QSUsecase: us,
+		logger:    logger,
+	}
+	e.GET(formatRouterResource("/quote-stream"), handler.StreamQuotes)
+}
+
+// StreamQuotes streams a fresh optimal quote for every subscribed token pair after each ingested block
+// using server-sent events.
+// Token pairs are subscribed to by repeating the tokenIn and tokenOutDenom query parameters
+// where the i-th tokenIn is paired with the i-th tokenOutDenom.
+// The event id is the block height of the quote. Clients may resume from a height
+// with either the fromHeight query parameter or the Last-Event-ID header.
+func (a *QuoteStreamHandler) StreamQuotes(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	subscriptions, err := getValidQuoteSubscriptions(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	fromHeight, err := getFromHeight(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	// Clients are identified by their IP address for the per-client topic limit.
+	updates, err := a.QSUsecase.Subscribe(ctx, c.RealIP(), subscriptions, fromHeight)
+	if err != nil {
+		return c.JSON(getQuoteStreamStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	// The updates channel is closed once the client disconnects.
+	for update := range updates {
+		data, err := json.Marshal(update)
+		if err != nil {
+			a.logger.Error("failed to marshal quote update", zap.Error(err))
+			continue
+		}
+
+		if _, err := fmt.Fprintf(response, "id: %d\nevent: %s\ndata: %s\n\n", update.Height, quoteEventName, data); err != nil {
+			// The client has disconnected. Drain until the updates channel is closed.
+			continue
+		}
+		response.Flush()
+	}
+
+	return nil
+}
+
+// getValidQuoteSubscriptions returns the quote subscriptions from server context if they are valid.
+func getValidQuoteSubscriptions(c echo.Context) ([]domain.QuoteSubscription, error) {
+	queryParams := c.QueryParams()
+	tokenInStrs := queryParams["tokenIn"]
+	tokenOutDenoms := queryParams["tokenOutDenom"]
+
+	if len(tokenInStrs) == 0 {
+		return nil, errors.New("tokenIn is required")
+	}
+
+	if len(tokenInStrs) != len(tokenOutDenoms) {
+		return nil, fmt.Errorf("number of tokenIn (%d) must match the number of tokenOutDenom (%d)", len(tokenInStrs), len(tokenOutDenoms))
+	}
+
+	subscriptions := make([]domain.QuoteSubscription, 0, len(tokenInStrs))
+	for i, tokenInStr := range tokenInStrs {
+		tokenIn, err := parseCoin(tokenInStr, "tokenIn")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tokenIn.Validate(); err != nil {
+			return nil, err
+		}
+
+		if len(tokenOutDenoms[i]) == 0 {
+			return nil, errors.New("tokenOutDenom is required")
+		}
+
+		subscriptions = append(subscriptions, domain.QuoteSubscription{
+			TokenIn:       tokenIn,
+			TokenOutDenom: tokenOutDenoms[i],
+		})
+	}
+
+	return subscriptions, nil
+}
+
+// getFromHeight returns the height to resume the stream from.
+// The fromHeight query parameter takes precedence over the Last-Event-ID header.
+// Returns zero if neither is set.
+func getFromHeight(c echo.Context) (uint64, error) {
+	fromHeightStr := c.QueryParam("fromHeight")
+	if len(fromHeightStr) == 0 {
+		fromHeightStr = c.Request().Header.Get(lastEventIDHeader)
+	}
+
+	if len(fromHeightStr) == 0 {
+		return 0, nil
+	}
+
+	fromHeight, err := strconv.ParseUint(fromHeightStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("fromHeight is invalid - must be a non-negative integer: %w", err)
+	}
+
+	return fromHeight, nil
+}
+
+// getQuoteStreamStatusCode returns the status code for the given quote stream subscription error.
+func getQuoteStreamStatusCode(err error) int {
+	switch {
+	case errors.As(err, &domain.QuoteStreamSubscriptionLimitError{}):
+		return http.StatusBadRequest
+	case errors.As(err, &domain.QuoteStreamClientTopicLimitError{}):
+		return http.StatusTooManyRequests
+	case errors.As(err, &domain.QuoteStreamTopicLimitError{}):
+		return http.StatusServiceUnavailable
+	default:
+		return getStatusCode(err)
+	}
+}
@@ -0,0 +1,195 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	routerhttp "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/delivery/http"
+)
+
+// quoteStreamUsecaseStub records the subscribe arguments and returns
+// a closed channel with the configured updates or the configured error.
+type quoteStreamUsecaseStub struct {
+	updates []domain.QuoteUpdate
+	err     error
+
+	clientID      string
+	subscriptions []domain.QuoteSubscription
+	fromHeight    uint64
+}
+
+func (s *quoteStreamUsecaseStub) NotifyBlock(height uint64) {}
+
+func (s *quoteStreamUsecaseStub) Subscribe(ctx context.Context, clientID string, subscriptions []domain.QuoteSubscription, fromHeight uint64) (<-chan domain.QuoteUpdate, error) {
+	s.clientID = clientID
+	s.subscriptions = subscriptions
+	s.fromHeight = fromHeight
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	updates := make(chan domain.QuoteUpdate, len(s.updates))
+	for _, update := range s.updates {
+		updates <- update
+	}
+	close(updates)
+
+	return updates, nil
+}
+
+// TestStreamQuotes tests the validation of the quote stream request and
+// the server-sent events written for the updates.
+func TestStreamQuotes(t *testing.T) {
+	const (
+		remoteIP   = "192.0.2.1"
+		defaultURL = "/router/quote-stream?tokenIn=1000uosmo&tokenOutDenom=uion"
+	)
+
+	var (
+		defaultSubscriptions = []domain.QuoteSubscription{{TokenIn: sdk.NewCoin("uosmo", sdk.NewInt(1000)), TokenOutDenom: "uion"}}
+		defaultUpdates       = []domain.QuoteUpdate{
+			{Height: 1, TokenIn: sdk.NewCoin("uosmo", sdk.NewInt(1000)), TokenOutDenom: "uion", Error: "no routes"},
+			{Height: 2, TokenIn: sdk.NewCoin("uosmo", sdk.NewInt(1000)), TokenOutDenom: "uion", Error: "no routes"},
+		}
+	)
+
+	testCases := map[string]struct {
+		url         string
+		lastEventID string
+		usecaseErr  error
+
+		expectedStatusCode    int
+		expectedSubscriptions []domain.QuoteSubscription
+		expectedFromHeight    uint64
+		expectedBody          string
+	}{
+		"streams updates": {
+			url: defaultURL,
+
+			expectedStatusCode:    http.StatusOK,
+			expectedSubscriptions: defaultSubscriptions,
+			expectedBody: "id: 1\nevent: quote\ndata: {\"height\":1,\"token_in\":{\"denom\":\"uosmo\",\"amount\":\"1000\"},\"token_out_denom\":\"uion\",\"error\":\"no routes\"}\n\n" +
+				"id: 2\nevent: quote\ndata: {\"height\":2,\"token_in\":{\"denom\":\"uosmo\",\"amount\":\"1000\"},\"token_out_denom\":\"uion\",\"error\":\"no routes\"}\n\n",
+		},
+		"multiple pairs": {
+			url: "/router/quote-stream?tokenIn=1000uosmo&tokenOutDenom=uion&tokenIn=5uion&tokenOutDenom=uosmo",
+
+			expectedStatusCode: http.StatusOK,
+			expectedSubscriptions: append(defaultSubscriptions, domain.QuoteSubscription{
+				TokenIn:       sdk.NewCoin("uion", sdk.NewInt(5)),
+				TokenOutDenom: "uosmo",
+			}),
+		},
+		"resumes from Last-Event-ID": {
+			url:         defaultURL,
+			lastEventID: "5",
+
+			expectedStatusCode:    http.StatusOK,
+			expectedSubscriptions: defaultSubscriptions,
+			expectedFromHeight:    5,
+		},
+		"fromHeight takes precedence over Last-Event-ID": {
+			url:         defaultURL + "&fromHeight=7",
+			lastEventID: "5",
+
+			expectedStatusCode:    http.StatusOK,
+			expectedSubscriptions: defaultSubscriptions,
+			expectedFromHeight:    7,
+		},
+		"error: missing tokenIn": {
+			url: "/router/quote-stream?tokenOutDenom=uion",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"error: mismatched number of tokenIn and tokenOutDenom": {
+			url: "/router/quote-stream?tokenIn=1000uosmo&tokenIn=5uion&tokenOutDenom=uion",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"error: invalid tokenIn": {
+			url: "/router/quote-stream?tokenIn=uosmo&tokenOutDenom=uion",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"error: invalid fromHeight": {
+			url: defaultURL + "&fromHeight=-1",
+
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"error: subscription limit": {
+			url:        defaultURL,
+			usecaseErr: domain.QuoteStreamSubscriptionLimitError{NumSubscriptions: 1, MaxSubscriptions: 0},
+
+			expectedStatusCode:    http.StatusBadRequest,
+			expectedSubscriptions: defaultSubscriptions,
+		},
+		"error: client topic limit": {
+			url:        defaultURL,
+			usecaseErr: domain.QuoteStreamClientTopicLimitError{ClientID: remoteIP, NumTopics: 2, MaxTopics: 1},
+
+			expectedStatusCode:    http.StatusTooManyRequests,
+			expectedSubscriptions: defaultSubscriptions,
+		},
+		"error: global topic limit": {
+			url:        defaultURL,
+			usecaseErr: domain.QuoteStreamTopicLimitError{NumTopics: 2, MaxTopics: 1},
+
+			expectedStatusCode:    http.StatusServiceUnavailable,
+			expectedSubscriptions: defaultSubscriptions,
+		},
+		"error: other": {
+			url:        defaultURL,
+			usecaseErr: errors.New("other"),
+
+			expectedStatusCode:    http.StatusInternalServerError,
+			expectedSubscriptions: defaultSubscriptions,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			usecase := &quoteStreamUsecaseStub{updates: defaultUpdates, err: tc.usecaseErr}
+
+			e := echo.New()
+			routerhttp.NewQuoteStreamHandler(e, usecase, &log.NoOpLogger{})
+
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			req.RemoteAddr = remoteIP + ":1234"
+			if tc.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tc.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expectedStatusCode, rec.Code)
+			require.Equal(t, tc.expectedSubscriptions, usecase.subscriptions)
+			require.Equal(t, tc.expectedFromHeight, usecase.fromHeight)
+
+			if tc.expectedSubscriptions != nil {
+				// Clients are identified by their IP address.
+				require.Equal(t, remoteIP, usecase.clientID)
+			}
+
+			if tc.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+			if tc.expectedBody != "" {
+				require.Equal(t, tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -12,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
@@ -22,6 +25,17 @@ type ResponseError struct {
 	Message string `json:"message"`
 }
 
+// BatchQuoteRequest represent a single request in the batch quote request body
+type BatchQuoteRequest struct {
+	TokenIn       string `json:"tokenIn"`
+	TokenOutDenom string `json:"tokenOutDenom"`
+}
+
+// BatchQuoteRequestBody represent the batch quote request body
+type BatchQuoteRequestBody struct {
+	Requests []BatchQuoteRequest `json:"requests"`
+}
+
 // RouterHandler  represent the httphandler for the router
 type RouterHandler struct {
 	RUsecase mvc.RouterUsecase
@@ -47,6 +61,9 @@ func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, logger log.Logger) {
 		logger:   logger,
 	}
 	e.GET(formatRouterResource("/quote"), handler.GetOptimalQuote)
+	e.GET(formatRouterResource("/quote-exact-out"), handler.GetOptimalQuoteExactAmountOut)
+	e.POST(formatRouterResource("/batch-quote"), handler.GetOptimalQuoteBatch)
+	e.GET(formatRouterResource("/swap-tx"), handler.GetSwapTx)
 	e.GET(formatRouterResource("/single-quote"), handler.GetBestSingleRouteQuote)
 	e.GET(formatRouterResource("/routes"), handler.GetCandidateRoutes)
 	e.GET(formatRouterResource("/cached-routes"), handler.GetCachedCandidateRoutes)
@@ -56,15 +73,19 @@ func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, logger log.Logger) {
 }
 
 // GetOptimalQuote will determine the optimal quote for a given tokenIn and tokenOutDenom
+// If the height query parameter is set, the quote is computed against the state ingested at that height.
 // Return the optimal quote.
 func (a *RouterHandler) GetOptimalQuote(c echo.Context) error {
-	ctx := c.Request().Context()
-
 	tokenOutDenom, tokenIn, err := getValidRoutingParameters(c)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
 	}
 
+	ctx, err := getQuoteContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
 	quote, err := a.RUsecase.GetOptimalQuote(ctx, tokenIn, tokenOutDenom)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
@@ -80,6 +101,81 @@ func (a *RouterHandler) GetOptimalQuote(c echo.Context) error {
 	return nil
 }
 
+// GetOptimalQuoteExactAmountOut will determine the optimal quote for swapping tokenInDenom
+// for exactly the given tokenOut.
+// Return the optimal quote. The quote maps directly onto MsgSplitRouteSwapExactAmountOut.
+func (a *RouterHandler) GetOptimalQuoteExactAmountOut(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tokenInDenom, tokenOut, err := getValidExactAmountOutRoutingParameters(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	quote, err := a.RUsecase.GetOptimalQuoteExactAmountOut(ctx, tokenOut, tokenInDenom)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	quote.PrepareResult()
+
+	return c.JSON(http.StatusOK, quote)
+}
+
+// GetOptimalQuoteBatch will determine the optimal quote for each of the requests in the body.
+// All quotes are computed against the same height.
+// Return the height and a result per request in the same order. Each result has either a quote or an error.
+func (a *RouterHandler) GetOptimalQuoteBatch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	requests, err := getValidBatchQuoteRequests(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	batchQuote, err := a.RUsecase.GetOptimalQuoteBatch(ctx, requests)
+	if err != nil {
+		if errors.As(err, &domain.BatchQuoteRequestLimitError{}) {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+		}
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	for _, result := range batchQuote.Results {
+		if result.Quote != nil {
+			result.Quote.PrepareResult()
+		}
+	}
+
+	return c.JSON(http.StatusOK, batchQuote)
+}
+
+// GetSwapTx returns the unsigned swap message for the optimal quote of swapping tokenIn for tokenOutDenom
+// by the sender, with the token out min amount derived from the quote and the slippage tolerance.
+func (a *RouterHandler) GetSwapTx(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tokenOutDenom, tokenIn, err := getValidRoutingParameters(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	sender, slippageTolerance, err := getValidSwapTxParameters(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	swapTx, err := a.RUsecase.GetSwapTx(ctx, tokenIn, tokenOutDenom, sender, slippageTolerance)
+	if err != nil {
+		if errors.As(err, &domain.InvalidSlippageToleranceError{}) || errors.As(err, &domain.ZeroTokenOutMinAmountError{}) {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+		}
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, swapTx)
+}
+
 // GetBestSingleRouteQuote returns the best single route quote to be done directly without a split.
 func (a *RouterHandler) GetBestSingleRouteQuote(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -101,14 +197,18 @@ func (a *RouterHandler) GetBestSingleRouteQuote(c echo.Context) error {
 
 // GetCustomQuote returns a direct custom quote. It ensures that the route contains all the pools
 // listed in the specific order, returns error if such route is not found.
+// If the height query parameter is set, the quote is computed against the state ingested at that height.
 func (a *RouterHandler) GetCustomQuote(c echo.Context) error {
-	ctx := c.Request().Context()
-
 	tokenOutDenom, tokenIn, err := getValidRoutingParameters(c)
 	if err != nil {
 		return err
 	}
 
+	ctx, err := getQuoteContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
 	poolIDsStr := c.QueryParam("poolIDs")
 	if len(poolIDsStr) == 0 {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "poolIDs is required"})
@@ -202,6 +302,11 @@ func getStatusCode(err error) int {
 	}
 
 	logrus.Error(err)
+
+	if errors.As(err, &domain.HistoricalStateNotFoundError{}) {
+		return http.StatusNotFound
+	}
+
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
@@ -214,6 +319,24 @@ func getStatusCode(err error) int {
 	}
 }
 
+// getQuoteContext returns the request context. If the height query parameter is set,
+// the returned context instructs the quote to be computed against the state ingested at that height.
+func getQuoteContext(c echo.Context) (context.Context, error) {
+	ctx := c.Request().Context()
+
+	heightStr := c.QueryParam("height")
+	if len(heightStr) == 0 {
+		return ctx, nil
+	}
+
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil || height == 0 {
+		return nil, fmt.Errorf("height (%s) is invalid - must be a positive integer", heightStr)
+	}
+
+	return domain.WithHistoricalHeight(ctx, height), nil
+}
+
 // getValidRoutingParameters returns the tokenIn and tokenOutDenom from server context if they are valid.
 func getValidRoutingParameters(c echo.Context) (string, sdk.Coin, error) {
 	tokenOutStr, tokenInStr, err := getValidTokenInTokenOutStr(c)
@@ -221,14 +344,9 @@ func getValidRoutingParameters(c echo.Context) (string, sdk.Coin, error) {
 		return "", sdk.Coin{}, err
 	}
 
-	matches := coinPattern.FindStringSubmatch(tokenInStr)
-	if len(matches) != 3 && len(matches) != 6 {
-		return "", sdk.Coin{}, errors.New("tokenIn is invalid - must be in the format amountDenom")
-	}
-
-	tokenIn := sdk.Coin{
-		Amount: sdk.MustNewDecFromStr(matches[1]).TruncateInt(),
-		Denom:  matches[2],
+	tokenIn, err := parseCoin(tokenInStr, "tokenIn")
+	if err != nil {
+		return "", sdk.Coin{}, err
 	}
 
 	if err := tokenIn.Validate(); err != nil {
@@ -237,6 +355,100 @@ func getValidRoutingParameters(c echo.Context) (string, sdk.Coin, error) {
 	return tokenOutStr, tokenIn, nil
 }
 
+// getValidExactAmountOutRoutingParameters returns the tokenInDenom and tokenOut from server context if they are valid.
+func getValidExactAmountOutRoutingParameters(c echo.Context) (string, sdk.Coin, error) {
+	tokenOutStr := c.QueryParam("tokenOut")
+	tokenInDenom := c.QueryParam("tokenInDenom")
+
+	if len(tokenOutStr) == 0 {
+		return "", sdk.Coin{}, errors.New("tokenOut is required")
+	}
+
+	if len(tokenInDenom) == 0 {
+		return "", sdk.Coin{}, errors.New("tokenInDenom is required")
+	}
+
+	tokenOut, err := parseCoin(tokenOutStr, "tokenOut")
+	if err != nil {
+		return "", sdk.Coin{}, err
+	}
+
+	if err := tokenOut.Validate(); err != nil {
+		return "", sdk.Coin{}, err
+	}
+
+	return tokenInDenom, tokenOut, nil
+}
+
+// getValidSwapTxParameters returns the sender and slippage tolerance from server context if they are valid.
+func getValidSwapTxParameters(c echo.Context) (string, osmomath.Dec, error) {
+	sender := c.QueryParam("sender")
+	if len(sender) == 0 {
+		return "", osmomath.Dec{}, errors.New("sender is required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return "", osmomath.Dec{}, fmt.Errorf("sender (%s) is invalid: %w", sender, err)
+	}
+
+	slippageToleranceStr := c.QueryParam("slippageTolerance")
+	if len(slippageToleranceStr) == 0 {
+		return "", osmomath.Dec{}, errors.New("slippageTolerance is required")
+	}
+
+	slippageTolerance, err := osmomath.NewDecFromStr(slippageToleranceStr)
+	if err != nil {
+		return "", osmomath.Dec{}, fmt.Errorf("slippageTolerance (%s) is invalid: %w", slippageToleranceStr, err)
+	}
+
+	return sender, slippageTolerance, nil
+}
+
+// getValidBatchQuoteRequests returns the batch quote requests from the request body if they are valid.
+func getValidBatchQuoteRequests(c echo.Context) ([]domain.BatchQuoteRequest, error) {
+	var body BatchQuoteRequestBody
+	if err := c.Bind(&body); err != nil {
+		return nil, err
+	}
+
+	requests := make([]domain.BatchQuoteRequest, 0, len(body.Requests))
+	for i, request := range body.Requests {
+		tokenIn, err := parseCoin(request.TokenIn, fmt.Sprintf("requests[%d].tokenIn", i))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tokenIn.Validate(); err != nil {
+			return nil, err
+		}
+
+		if len(request.TokenOutDenom) == 0 {
+			return nil, fmt.Errorf("requests[%d].tokenOutDenom is required", i)
+		}
+
+		requests = append(requests, domain.BatchQuoteRequest{
+			TokenIn:       tokenIn,
+			TokenOutDenom: request.TokenOutDenom,
+		})
+	}
+
+	return requests, nil
+}
+
+// parseCoin parses the given string into sdk.Coin where the first part is the amount and second is the denom.
+// paramName is used for formatting the error message.
+func parseCoin(coinStr string, paramName string) (sdk.Coin, error) {
+	matches := coinPattern.FindStringSubmatch(coinStr)
+	if len(matches) != 3 && len(matches) != 6 {
+		return sdk.Coin{}, fmt.Errorf("%s is invalid - must be in the format amountDenom", paramName)
+	}
+
+	return sdk.Coin{
+		Amount: sdk.MustNewDecFromStr(matches[1]).TruncateInt(),
+		Denom:  matches[2],
+	}, nil
+}
+
 func getValidTokenInTokenOutStr(c echo.Context) (tokenOutStr, tokenInStr string, err error) {
 	tokenInStr = c.QueryParam("tokenIn")
 	tokenOutStr = c.QueryParam("tokenOutDenom")
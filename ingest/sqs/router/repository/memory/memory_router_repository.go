@@ -0,0 +1,310 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+type memoryRouterRepo struct {
+	repositoryManager        mvc.TxManager
+	routerCacheExpirySeconds uint64
+}
+
+const (
+	keySeparator = "-"
+
+	takerFeeNamespace      = "router/taker-fees"
+	routeTakerFeeNamespace = "router/route-taker-fees"
+	routesNamespace        = "router/routes"
+	// historicalTakerFeeHeightsNamespace indexes the heights at which the historical taker fees are stored.
+	historicalTakerFeeHeightsNamespace = "router/taker-fee-heights"
+)
+
+var (
+	_ mvc.RouterRepository = &memoryRouterRepo{}
+)
+
+// NewMemoryRouterRepo will create an in-memory implementation of router.Repository
+func NewMemoryRouterRepo(repositoryManager mvc.TxManager, routesCacheExpirySeconds uint64) mvc.RouterRepository {
+	return &memoryRouterRepo{
+		repositoryManager:        repositoryManager,
+		routerCacheExpirySeconds: routesCacheExpirySeconds,
+	}
+}
+
+// GetAllTakerFees implements mvc.RouterRepository.
+func (r *memoryRouterRepo) GetAllTakerFees(ctx context.Context) (domain.TakerFeeMap, error) {
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap := memoryTx.GetAll(getTakerFeeNamespace(ctx))
+
+	// Parse taker fee map
+	takerFeeMap := make(domain.TakerFeeMap, len(resultMap))
+	for denomPairStr, takerFeeBytes := range resultMap {
+		takerFee, err := osmomath.NewDecFromStr(string(takerFeeBytes))
+		if err != nil {
+			return nil, err
+		}
+
+		denoms := strings.Split(denomPairStr, keySeparator)
+
+		if len(denoms) != 2 {
+			return nil, fmt.Errorf("invalid denom pair string key %s. must have 2 denoms, had (%d)", denomPairStr, len(denoms))
+		}
+
+		takerFeeMap[domain.DenomPair{
+			Denom0: denoms[0],
+			Denom1: denoms[1],
+		}] = takerFee
+	}
+
+	return takerFeeMap, nil
+}
+
+// GetTakerFee implements mvc.RouterRepository.
+func (r *memoryRouterRepo) GetTakerFee(ctx context.Context, denom0 string, denom1 string) (osmomath.Dec, error) {
+	// Ensure increasing lexicographic order.
+	if denom1 < denom0 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	takerFeeBytes, ok := memoryTx.Get(getTakerFeeNamespace(ctx), denom0+keySeparator+denom1)
+	if !ok {
+		return osmomath.Dec{}, fmt.Errorf("taker fee for denom pair (%s, %s) is not found", denom0, denom1)
+	}
+
+	return osmomath.NewDecFromStr(string(takerFeeBytes))
+}
+
+// SetTakerFee implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetTakerFee(ctx context.Context, tx mvc.Tx, denom0, denom1 string, takerFee osmomath.Dec) error {
+	// Ensure increasing lexicographic order.
+	if denom1 < denom0 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	return memoryTx.Set(takerFeeNamespace, denom0+keySeparator+denom1, []byte(takerFee.String()), 0)
+}
+
+// SetTakerFeesAtHeight implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, takerFees domain.TakerFeeMap) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := memoryTx.Set(historicalTakerFeeHeightsNamespace, strconv.FormatUint(height, 10), nil, 0); err != nil {
+		return err
+	}
+
+	namespace := historicalTakerFeeNamespace(height)
+	for denomPair, takerFee := range takerFees {
+		// Ensure increasing lexicographic order.
+		denom0, denom1 := denomPair.Denom0, denomPair.Denom1
+		if denom1 < denom0 {
+			denom0, denom1 = denom1, denom0
+		}
+
+		if err := memoryTx.Set(namespace, denom0+keySeparator+denom1, []byte(takerFee.String()), 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteTakerFeesUpToHeight implements mvc.RouterRepository.
+// The heights to delete are looked up in the height index as of the start of the transaction.
+func (r *memoryRouterRepo) DeleteTakerFeesUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for heightStr := range memoryTx.GetAll(historicalTakerFeeHeightsNamespace) {
+		storedHeight, err := strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if storedHeight > height {
+			continue
+		}
+
+		if err := memoryTx.DeleteNamespace(historicalTakerFeeNamespace(storedHeight)); err != nil {
+			return err
+		}
+
+		if err := memoryTx.DeleteNamespace(historicalRouteTakerFeeNamespace(storedHeight)); err != nil {
+			return err
+		}
+
+		if err := memoryTx.Delete(historicalTakerFeeHeightsNamespace, heightStr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllRouteTakerFees implements mvc.RouterRepository.
+func (r *memoryRouterRepo) GetAllRouteTakerFees(ctx context.Context) (domain.RouteTakerFeeMap, error) {
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap := memoryTx.GetAll(getRouteTakerFeeNamespace(ctx))
+
+	routeTakerFeeMap := make(domain.RouteTakerFeeMap, len(resultMap))
+	for tokenOutDenom, takerFeeBytes := range resultMap {
+		takerFee, err := osmomath.NewDecFromStr(string(takerFeeBytes))
+		if err != nil {
+			return nil, err
+		}
+
+		routeTakerFeeMap[tokenOutDenom] = takerFee
+	}
+
+	return routeTakerFeeMap, nil
+}
+
+// SetRouteTakerFees implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetRouteTakerFees(ctx context.Context, tx mvc.Tx, routeTakerFees domain.RouteTakerFeeMap) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	// Delete the existing overrides so that the ones removed by governance are not retained.
+	if err := memoryTx.DeleteNamespace(routeTakerFeeNamespace); err != nil {
+		return err
+	}
+
+	return setRouteTakerFees(memoryTx, routeTakerFeeNamespace, routeTakerFees)
+}
+
+// SetRouteTakerFeesAtHeight implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetRouteTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, routeTakerFees domain.RouteTakerFeeMap) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := memoryTx.Set(historicalTakerFeeHeightsNamespace, strconv.FormatUint(height, 10), nil, 0); err != nil {
+		return err
+	}
+
+	return setRouteTakerFees(memoryTx, historicalRouteTakerFeeNamespace(height), routeTakerFees)
+}
+
+// SetRoutesTx implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetRoutesTx(ctx context.Context, tx mvc.Tx, denom0, denom1 string, routes route.CandidateRoutes) error {
+	memoryTx, err := memoryrepo.AsMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	routesBytes, err := json.Marshal(routes)
+	if err != nil {
+		return err
+	}
+
+	routeCacheExpiryDuration := time.Second * time.Duration(r.routerCacheExpirySeconds)
+
+	return memoryTx.Set(routesNamespace, denom0+keySeparator+denom1, routesBytes, routeCacheExpiryDuration)
+}
+
+// SetRoutes implements mvc.RouterRepository.
+func (r *memoryRouterRepo) SetRoutes(ctx context.Context, denom0, denom1 string, routes route.CandidateRoutes) error {
+	// Create transaction
+	tx := r.repositoryManager.StartTx()
+
+	// Set routes
+	if err := r.SetRoutesTx(ctx, tx, denom0, denom1, routes); err != nil {
+		return err
+	}
+
+	// Execute transaction.
+	return tx.Exec(ctx)
+}
+
+// GetRoutes implements mvc.RouterRepository.
+func (r *memoryRouterRepo) GetRoutes(ctx context.Context, denom0, denom1 string) (route.CandidateRoutes, error) {
+	memoryTx, err := memoryrepo.AsMemoryTx(r.repositoryManager.StartTx())
+	if err != nil {
+		return route.CandidateRoutes{}, err
+	}
+
+	routesBytes, ok := memoryTx.Get(routesNamespace, denom0+keySeparator+denom1)
+	if !ok {
+		return route.CandidateRoutes{}, nil
+	}
+
+	// Parse routes
+	var routes route.CandidateRoutes
+	if err := json.Unmarshal(routesBytes, &routes); err != nil {
+		return route.CandidateRoutes{}, err
+	}
+
+	return routes, nil
+}
+
+// getTakerFeeNamespace returns the taker fee namespace of the historical state if a historical height is set on the context.
+// Otherwise, returns the taker fee namespace of the latest state.
+func getTakerFeeNamespace(ctx context.Context) string {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalTakerFeeNamespace(height)
+	}
+	return takerFeeNamespace
+}
+
+func historicalTakerFeeNamespace(height uint64) string {
+	return fmt.Sprintf("%s/%d", takerFeeNamespace, height)
+}
+
+// getRouteTakerFeeNamespace returns the route taker fee namespace of the historical state if a historical height is set on the context.
+// Otherwise, returns the route taker fee namespace of the latest state.
+func getRouteTakerFeeNamespace(ctx context.Context) string {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalRouteTakerFeeNamespace(height)
+	}
+	return routeTakerFeeNamespace
+}
+
+func historicalRouteTakerFeeNamespace(height uint64) string {
+	return fmt.Sprintf("%s/%d", routeTakerFeeNamespace, height)
+}
+
+// setRouteTakerFees sets the given route taker fees in the given namespace keyed by their token out denom.
+func setRouteTakerFees(memoryTx memoryrepo.MemoryTx, namespace string, routeTakerFees domain.RouteTakerFeeMap) error {
+	for tokenOutDenom, takerFee := range routeTakerFees {
+		if err := memoryTx.Set(namespace, tokenOutDenom, []byte(takerFee.String()), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
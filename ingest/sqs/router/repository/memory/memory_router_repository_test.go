@@ -0,0 +1,190 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/repository/memory"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// Tests that the taker fees stored at a height are only read when the height is set on the context
+// and that all heights up to the pruned height are removed once deleted.
+func TestMemoryRouterRepo_TakerFeesAtHeight(t *testing.T) {
+	const (
+		olderHistoricalHeight = uint64(8)
+		historicalHeight      = uint64(10)
+		retainedHeight        = uint64(12)
+		latestHeight          = uint64(13)
+
+		denomA = "uatom"
+		denomB = "uosmo"
+	)
+
+	var (
+		historicalTakerFee = osmomath.NewDecWithPrec(1, 3)
+		latestTakerFee     = osmomath.NewDecWithPrec(2, 3)
+
+		historicalCtx = domain.WithHistoricalHeight(context.Background(), historicalHeight)
+	)
+
+	txManager := memoryrepo.NewTxManager()
+	routerRepo := memory.NewMemoryRouterRepo(txManager, 0)
+
+	tx := txManager.StartTx()
+	require.NoError(t, routerRepo.SetTakerFeesAtHeight(context.Background(), tx, olderHistoricalHeight, domain.TakerFeeMap{
+		{Denom0: denomA, Denom1: denomB}: historicalTakerFee,
+	}))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	tx = txManager.StartTx()
+	require.NoError(t, routerRepo.SetTakerFeesAtHeight(context.Background(), tx, retainedHeight, domain.TakerFeeMap{
+		{Denom0: denomA, Denom1: denomB}: latestTakerFee,
+	}))
+	// Denoms are out of order to validate that they are sorted.
+	require.NoError(t, routerRepo.SetTakerFeesAtHeight(context.Background(), tx, historicalHeight, domain.TakerFeeMap{
+		{Denom0: denomB, Denom1: denomA}: historicalTakerFee,
+	}))
+	require.NoError(t, routerRepo.SetTakerFee(context.Background(), tx, denomA, denomB, latestTakerFee))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	// Latest state.
+	takerFee, err := routerRepo.GetTakerFee(context.Background(), denomA, denomB)
+	require.NoError(t, err)
+	require.Equal(t, latestTakerFee, takerFee)
+
+	// Historical state.
+	takerFee, err = routerRepo.GetTakerFee(historicalCtx, denomB, denomA)
+	require.NoError(t, err)
+	require.Equal(t, historicalTakerFee, takerFee)
+
+	takerFees, err := routerRepo.GetAllTakerFees(historicalCtx)
+	require.NoError(t, err)
+	require.Equal(t, domain.TakerFeeMap{{Denom0: denomA, Denom1: denomB}: historicalTakerFee}, takerFees)
+
+	// Not ingested.
+	takerFees, err = routerRepo.GetAllTakerFees(domain.WithHistoricalHeight(context.Background(), latestHeight))
+	require.NoError(t, err)
+	require.Empty(t, takerFees)
+
+	// Prune the historical state up to and including the historical height.
+	tx = txManager.StartTx()
+	require.NoError(t, routerRepo.DeleteTakerFeesUpToHeight(context.Background(), tx, historicalHeight))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	for _, prunedHeight := range []uint64{olderHistoricalHeight, historicalHeight} {
+		takerFees, err = routerRepo.GetAllTakerFees(domain.WithHistoricalHeight(context.Background(), prunedHeight))
+		require.NoError(t, err)
+		require.Empty(t, takerFees)
+	}
+
+	// The retained height is unaffected.
+	takerFee, err = routerRepo.GetTakerFee(domain.WithHistoricalHeight(context.Background(), retainedHeight), denomA, denomB)
+	require.NoError(t, err)
+	require.Equal(t, latestTakerFee, takerFee)
+
+	// The latest state is unaffected.
+	takerFee, err = routerRepo.GetTakerFee(context.Background(), denomA, denomB)
+	require.NoError(t, err)
+	require.Equal(t, latestTakerFee, takerFee)
+}
+
+// Tests that the route taker fees replace the previously stored ones,
+// that the ones stored at a height are only read when the height is set on the context
+// and that they are pruned along with the taker fees.
+func TestMemoryRouterRepo_RouteTakerFees(t *testing.T) {
+	const (
+		historicalHeight = uint64(10)
+		latestHeight     = uint64(11)
+
+		denomA = "uatom"
+		denomB = "uosmo"
+	)
+
+	var (
+		historicalRouteTakerFees = domain.RouteTakerFeeMap{denomA: osmomath.NewDecWithPrec(1, 3)}
+		latestRouteTakerFees     = domain.RouteTakerFeeMap{denomB: osmomath.NewDecWithPrec(2, 3)}
+
+		historicalCtx = domain.WithHistoricalHeight(context.Background(), historicalHeight)
+	)
+
+	txManager := memoryrepo.NewTxManager()
+	routerRepo := memory.NewMemoryRouterRepo(txManager, 0)
+
+	tx := txManager.StartTx()
+	require.NoError(t, routerRepo.SetRouteTakerFees(context.Background(), tx, historicalRouteTakerFees))
+	require.NoError(t, routerRepo.SetRouteTakerFeesAtHeight(context.Background(), tx, historicalHeight, historicalRouteTakerFees))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	// The override of denomA is removed, so it must not be retained.
+	tx = txManager.StartTx()
+	require.NoError(t, routerRepo.SetRouteTakerFees(context.Background(), tx, latestRouteTakerFees))
+	require.NoError(t, routerRepo.SetRouteTakerFeesAtHeight(context.Background(), tx, latestHeight, latestRouteTakerFees))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	// Latest state.
+	routeTakerFees, err := routerRepo.GetAllRouteTakerFees(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, latestRouteTakerFees, routeTakerFees)
+
+	// Historical state.
+	routeTakerFees, err = routerRepo.GetAllRouteTakerFees(historicalCtx)
+	require.NoError(t, err)
+	require.Equal(t, historicalRouteTakerFees, routeTakerFees)
+
+	// Prune the historical state up to and including the historical height.
+	tx = txManager.StartTx()
+	require.NoError(t, routerRepo.DeleteTakerFeesUpToHeight(context.Background(), tx, historicalHeight))
+	require.NoError(t, tx.Exec(context.Background()))
+
+	routeTakerFees, err = routerRepo.GetAllRouteTakerFees(historicalCtx)
+	require.NoError(t, err)
+	require.Empty(t, routeTakerFees)
+
+	// The latest height is unaffected.
+	routeTakerFees, err = routerRepo.GetAllRouteTakerFees(domain.WithHistoricalHeight(context.Background(), latestHeight))
+	require.NoError(t, err)
+	require.Equal(t, latestRouteTakerFees, routeTakerFees)
+}
+
+// Tests that the routes are read back as stored, that missing routes are empty
+// and that the writes of transactions of other storage backends are rejected.
+func TestMemoryRouterRepo_Routes(t *testing.T) {
+	const (
+		denomA = "uatom"
+		denomB = "uosmo"
+	)
+
+	ctx := context.Background()
+	routerRepo := memory.NewMemoryRouterRepo(memoryrepo.NewTxManager(), 0)
+
+	routes, err := routerRepo.GetRoutes(ctx, denomA, denomB)
+	require.NoError(t, err)
+	require.Empty(t, routes.Routes)
+
+	expectedRoutes := route.CandidateRoutes{
+		Routes: []route.CandidateRoute{
+			{Pools: []route.CandidatePool{{ID: 1, TokenOutDenom: denomB}}},
+		},
+		UniquePoolIDs: map[uint64]struct{}{1: {}},
+	}
+	require.NoError(t, routerRepo.SetRoutes(ctx, denomA, denomB, expectedRoutes))
+
+	routes, err = routerRepo.GetRoutes(ctx, denomA, denomB)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoutes, routes)
+
+	// Routes are directional.
+	routes, err = routerRepo.GetRoutes(ctx, denomB, denomA)
+	require.NoError(t, err)
+	require.Empty(t, routes.Routes)
+
+	err = routerRepo.SetRoutesTx(ctx, redisrepo.NewRedisTx(nil), denomA, denomB, expectedRoutes)
+	require.ErrorIs(t, err, domain.UnexpectedTxBackendError{ExpectedBackend: "in-memory"})
+}
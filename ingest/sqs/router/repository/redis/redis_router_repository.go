@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
 )
 
@@ -24,9 +26,13 @@ type redisRouterRepo struct {
 const (
 	keySeparator = "-"
 
-	routerPrefix   = "r" + keySeparator
-	takerFeePrefix = routerPrefix + "tf" + keySeparator
-	routesPrefix   = routerPrefix + "r" + keySeparator
+	routerPrefix        = "r" + keySeparator
+	takerFeePrefix      = routerPrefix + "tf" + keySeparator
+	routeTakerFeePrefix = routerPrefix + "rtf" + keySeparator
+	routesPrefix        = routerPrefix + "r" + keySeparator
+
+	// takerFeeHeightsKey is the sorted set indexing the heights at which the historical taker fees are stored.
+	takerFeeHeightsKey = routerPrefix + "tfh"
 )
 
 var (
@@ -45,7 +51,7 @@ func NewRedisRouterRepo(repositoryManager mvc.TxManager, routesCacheExpirySecond
 func (r *redisRouterRepo) GetAllTakerFees(ctx context.Context) (domain.TakerFeeMap, error) {
 	tx := r.repositoryManager.StartTx()
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +61,7 @@ func (r *redisRouterRepo) GetAllTakerFees(ctx context.Context) (domain.TakerFeeM
 		return nil, err
 	}
 
-	result := pipeliner.HGetAll(ctx, takerFeePrefix)
+	result := pipeliner.HGetAll(ctx, getTakerFeeKey(ctx))
 
 	_, err = pipeliner.Exec(ctx)
 	if err != nil {
@@ -103,7 +109,7 @@ func (r *redisRouterRepo) GetTakerFee(ctx context.Context, denom0 string, denom1
 
 	tx := r.repositoryManager.StartTx()
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return osmomath.Dec{}, err
 	}
@@ -113,7 +119,7 @@ func (r *redisRouterRepo) GetTakerFee(ctx context.Context, denom0 string, denom1
 		return osmomath.Dec{}, err
 	}
 
-	result := pipeliner.HGet(ctx, takerFeePrefix, denom0+keySeparator+denom1)
+	result := pipeliner.HGet(ctx, getTakerFeeKey(ctx), denom0+keySeparator+denom1)
 
 	_, err = pipeliner.Exec(ctx)
 	if err != nil {
@@ -135,7 +141,7 @@ func (r *redisRouterRepo) SetTakerFee(ctx context.Context, tx mvc.Tx, denom0, de
 		denom0, denom1 = denom1, denom0
 	}
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
@@ -152,9 +158,152 @@ func (r *redisRouterRepo) SetTakerFee(ctx context.Context, tx mvc.Tx, denom0, de
 	return nil
 }
 
+// SetTakerFeesAtHeight implements mvc.RouterRepository.
+func (r *redisRouterRepo) SetTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, takerFees domain.TakerFeeMap) error {
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(takerFees) == 0 {
+		return nil
+	}
+
+	if err := pipeliner.ZAdd(ctx, takerFeeHeightsKey, redis.Z{Score: float64(height), Member: height}).Err(); err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, 2*len(takerFees))
+	for denomPair, takerFee := range takerFees {
+		// Ensure increasing lexicographic order.
+		denom0, denom1 := denomPair.Denom0, denomPair.Denom1
+		if denom1 < denom0 {
+			denom0, denom1 = denom1, denom0
+		}
+
+		values = append(values, denom0+keySeparator+denom1, takerFee.String())
+	}
+
+	return pipeliner.HSet(ctx, historicalTakerFeeKey(height), values...).Err()
+}
+
+// DeleteTakerFeesUpToHeight implements mvc.RouterRepository.
+// The heights to delete are looked up in the committed height index.
+func (r *redisRouterRepo) DeleteTakerFeesUpToHeight(ctx context.Context, tx mvc.Tx, height uint64) error {
+	heights, err := redisrepo.GetHeightsUpTo(ctx, r.repositoryManager, takerFeeHeightsKey, height)
+	if err != nil {
+		return err
+	}
+
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, storedHeight := range heights {
+		if err := pipeliner.Del(ctx, historicalTakerFeeKey(storedHeight), historicalRouteTakerFeeKey(storedHeight)).Err(); err != nil {
+			return err
+		}
+	}
+
+	return pipeliner.ZRemRangeByScore(ctx, takerFeeHeightsKey, "-inf", strconv.FormatUint(height, 10)).Err()
+}
+
+// GetAllRouteTakerFees implements mvc.RouterRepository.
+func (r *redisRouterRepo) GetAllRouteTakerFees(ctx context.Context) (domain.RouteTakerFeeMap, error) {
+	tx := r.repositoryManager.StartTx()
+
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := pipeliner.HGetAll(ctx, getRouteTakerFeeKey(ctx))
+
+	_, err = pipeliner.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resultMap, err := result.Result()
+	if err != nil {
+		return nil, err
+	}
+
+	routeTakerFeeMap := make(domain.RouteTakerFeeMap, len(resultMap))
+	for tokenOutDenom, takerFeeStr := range resultMap {
+		takerFee, err := osmomath.NewDecFromStr(takerFeeStr)
+		if err != nil {
+			return nil, err
+		}
+
+		routeTakerFeeMap[tokenOutDenom] = takerFee
+	}
+
+	return routeTakerFeeMap, nil
+}
+
+// SetRouteTakerFees implements mvc.RouterRepository.
+func (r *redisRouterRepo) SetRouteTakerFees(ctx context.Context, tx mvc.Tx, routeTakerFees domain.RouteTakerFeeMap) error {
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Delete the existing overrides so that the ones removed by governance are not retained.
+	if err := pipeliner.Del(ctx, routeTakerFeePrefix).Err(); err != nil {
+		return err
+	}
+
+	if len(routeTakerFees) == 0 {
+		return nil
+	}
+
+	return pipeliner.HSet(ctx, routeTakerFeePrefix, routeTakerFeeValues(routeTakerFees)...).Err()
+}
+
+// SetRouteTakerFeesAtHeight implements mvc.RouterRepository.
+func (r *redisRouterRepo) SetRouteTakerFeesAtHeight(ctx context.Context, tx mvc.Tx, height uint64, routeTakerFees domain.RouteTakerFeeMap) error {
+	redisTx, err := redisrepo.AsRedisTx(tx)
+	if err != nil {
+		return err
+	}
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(routeTakerFees) == 0 {
+		return nil
+	}
+
+	if err := pipeliner.ZAdd(ctx, takerFeeHeightsKey, redis.Z{Score: float64(height), Member: height}).Err(); err != nil {
+		return err
+	}
+
+	return pipeliner.HSet(ctx, historicalRouteTakerFeeKey(height), routeTakerFeeValues(routeTakerFees)...).Err()
+}
+
 // SetRoutesTx implements mvc.RouterRepository.
 func (r *redisRouterRepo) SetRoutesTx(ctx context.Context, tx mvc.Tx, denom0, denom1 string, routes route.CandidateRoutes) error {
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return err
 	}
@@ -201,7 +350,7 @@ func (r *redisRouterRepo) GetRoutes(ctx context.Context, denom0, denom1 string)
 	// Create transaction
 	tx := r.repositoryManager.StartTx()
 
-	redisTx, err := tx.AsRedisTx()
+	redisTx, err := redisrepo.AsRedisTx(tx)
 	if err != nil {
 		return route.CandidateRoutes{}, err
 	}
@@ -232,6 +381,42 @@ func (r *redisRouterRepo) GetRoutes(ctx context.Context, denom0, denom1 string)
 	return routes, nil
 }
 
+// getTakerFeeKey returns the taker fee key of the historical state if a historical height is set on the context.
+// Otherwise, returns the taker fee key of the latest state.
+func getTakerFeeKey(ctx context.Context) string {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalTakerFeeKey(height)
+	}
+	return takerFeePrefix
+}
+
+func historicalTakerFeeKey(height uint64) string {
+	return fmt.Sprintf("%s%d", takerFeePrefix, height)
+}
+
+// getRouteTakerFeeKey returns the route taker fee key of the historical state if a historical height is set on the context.
+// Otherwise, returns the route taker fee key of the latest state.
+func getRouteTakerFeeKey(ctx context.Context) string {
+	if height, ok := domain.GetHistoricalHeightFromContext(ctx); ok {
+		return historicalRouteTakerFeeKey(height)
+	}
+	return routeTakerFeePrefix
+}
+
+func historicalRouteTakerFeeKey(height uint64) string {
+	return fmt.Sprintf("%s%d", routeTakerFeePrefix, height)
+}
+
+// routeTakerFeeValues returns the token out denoms and taker fees of the given route taker fees
+// as alternating hash fields and values.
+func routeTakerFeeValues(routeTakerFees domain.RouteTakerFeeMap) []interface{} {
+	values := make([]interface{}, 0, 2*len(routeTakerFees))
+	for tokenOutDenom, takerFee := range routeTakerFees {
+		values = append(values, tokenOutDenom, takerFee.String())
+	}
+	return values
+}
+
 func getRoutesPrefixByDenoms(denom0, denom1 string) string {
 	return routesPrefix + denom0 + keySeparator + denom1
 }
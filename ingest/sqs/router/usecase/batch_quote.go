@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// maxBatchQuoteSnapshotAttempts is the maximum number of attempts to load the pool state
+// at a consistent height before giving up. The height may only change when a new block is ingested
+// while the state is being loaded.
+const maxBatchQuoteSnapshotAttempts = 3
+
+// errBatchQuoteHeightChanged is returned when a block is ingested while the pool state for a batch is being loaded.
+var errBatchQuoteHeightChanged = errors.New("height changed while loading batch quote state")
+
+// batchQuoteSnapshot is the pool state shared by all quotes in a batch.
+type batchQuoteSnapshot struct {
+	height uint64
+	router *Router
+
+	pools          map[uint64]domain.PoolI
+	tickModelMap   map[uint64]domain.TickModel
+	takerFees      domain.TakerFeeMap
+	routeTakerFees domain.RouteTakerFeeMap
+
+	// candidateRoutes and candidateRouteErrors are keyed by the token in and token out denoms.
+	candidateRoutes      map[[2]string]route.CandidateRoutes
+	candidateRouteErrors map[[2]string]error
+}
+
+// GetOptimalQuoteBatch implements mvc.RouterUsecase.
+// The pool state is loaded once for all requests and the candidate routes are computed once per denom pair.
+// To guarantee that all quotes are computed against the same height, the latest height is read before and after
+// loading the state. If a block is ingested in between, the state is reloaded up to maxBatchQuoteSnapshotAttempts times.
+// Unlike GetOptimalQuote, the ranked route cache is not read since the cached routes may reference pools outside of the loaded state.
+// Returns error if:
+// - the number of requests is zero or exceeds the configured limit
+// - fails to load the pool state at a consistent height
+func (r *routerUseCaseImpl) GetOptimalQuoteBatch(ctx context.Context, requests []domain.BatchQuoteRequest) (domain.BatchQuote, error) {
+	if len(requests) == 0 || len(requests) > r.config.MaxBatchQuoteRequests {
+		return domain.BatchQuote{}, domain.BatchQuoteRequestLimitError{
+			NumRequests: len(requests),
+			MaxRequests: r.config.MaxBatchQuoteRequests,
+		}
+	}
+
+	var (
+		snapshot batchQuoteSnapshot
+		err      error
+	)
+	for attempt := 1; attempt <= maxBatchQuoteSnapshotAttempts; attempt++ {
+		snapshot, err = r.loadBatchQuoteSnapshot(ctx, requests)
+		if err == nil {
+			break
+		}
+
+		if !errors.Is(err, errBatchQuoteHeightChanged) {
+			return domain.BatchQuote{}, err
+		}
+
+		r.logger.Debug("reloading batch quote state", zap.Int("attempt", attempt))
+	}
+	if err != nil {
+		return domain.BatchQuote{}, domain.BatchQuoteInconsistentHeightError{Attempts: maxBatchQuoteSnapshotAttempts}
+	}
+
+	results := make([]domain.BatchQuoteResult, 0, len(requests))
+	for _, request := range requests {
+		result := domain.BatchQuoteResult{
+			TokenIn:       request.TokenIn,
+			TokenOutDenom: request.TokenOutDenom,
+		}
+
+		quote, err := r.getOptimalQuoteFromSnapshot(snapshot, request.TokenIn, request.TokenOutDenom)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Quote = quote
+		}
+
+		results = append(results, result)
+	}
+
+	return domain.BatchQuote{
+		Height:  snapshot.height,
+		Results: results,
+	}, nil
+}
+
+// loadBatchQuoteSnapshot loads the pool state and computes the candidate routes for all unique denom pairs in the requests.
+// Failures to compute candidate routes are recorded per denom pair rather than returned.
+// Returns errBatchQuoteHeightChanged if the latest height changed while loading.
+// Returns error if fails to read the height, pools, taker fees, route taker fees or tick models.
+func (r *routerUseCaseImpl) loadBatchQuoteSnapshot(ctx context.Context, requests []domain.BatchQuoteRequest) (batchQuoteSnapshot, error) {
+	heightBefore, err := r.chainInfoRepo.GetLatestHeight(ctx)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	allPools, err := r.poolsUsecase.GetAllPools(ctx)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	takerFees, err := r.routerRepository.GetAllTakerFees(ctx)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	router := WithSortedPools(r.initializeRouter(), allPools)
+
+	snapshot := batchQuoteSnapshot{
+		router:               router,
+		takerFees:            takerFees,
+		routeTakerFees:       routeTakerFees,
+		candidateRoutes:      make(map[[2]string]route.CandidateRoutes),
+		candidateRouteErrors: make(map[[2]string]error),
+	}
+
+	// Compute candidate routes once per denom pair and collect the pools across all of them.
+	uniquePoolIDs := make(map[uint64]struct{})
+	for _, request := range requests {
+		denoms := [2]string{request.TokenIn.Denom, request.TokenOutDenom}
+		if _, ok := snapshot.candidateRoutes[denoms]; ok {
+			continue
+		}
+		if _, ok := snapshot.candidateRouteErrors[denoms]; ok {
+			continue
+		}
+
+		candidateRoutes, err := r.handleCandidateRoutes(ctx, router, request.TokenIn.Denom, request.TokenOutDenom)
+		if err != nil {
+			snapshot.candidateRouteErrors[denoms] = err
+			continue
+		}
+
+		snapshot.candidateRoutes[denoms] = candidateRoutes
+		for poolID := range candidateRoutes.UniquePoolIDs {
+			uniquePoolIDs[poolID] = struct{}{}
+		}
+	}
+
+	snapshot.pools = make(map[uint64]domain.PoolI, len(uniquePoolIDs))
+	concentratedPoolIDs := make([]uint64, 0)
+	for _, pool := range allPools {
+		if _, ok := uniquePoolIDs[pool.GetId()]; !ok {
+			continue
+		}
+
+		snapshot.pools[pool.GetId()] = pool
+		if pool.GetType() == poolmanagertypes.Concentrated {
+			concentratedPoolIDs = append(concentratedPoolIDs, pool.GetId())
+		}
+	}
+
+	snapshot.tickModelMap, err = r.poolsUsecase.GetTickModelMap(ctx, concentratedPoolIDs)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	heightAfter, err := r.chainInfoRepo.GetLatestHeight(ctx)
+	if err != nil {
+		return batchQuoteSnapshot{}, err
+	}
+
+	// The pools, tick models and height are written in the same transaction by the ingester.
+	// As a result, the state is consistent if the height did not change.
+	if heightBefore != heightAfter {
+		return batchQuoteSnapshot{}, fmt.Errorf("%w: (%d) to (%d)", errBatchQuoteHeightChanged, heightBefore, heightAfter)
+	}
+
+	snapshot.height = heightAfter
+
+	return snapshot, nil
+}
+
+// getOptimalQuoteFromSnapshot returns the optimal quote for the given token in and token out denom
+// by ranking the candidate routes of the snapshot against its pool state.
+// Returns error if:
+// - the candidate routes for the denom pair failed to be computed
+// - fails to convert the candidate routes to routes
+// - fails to estimate the direct quotes
+// - fails to compute the split quote
+func (r *routerUseCaseImpl) getOptimalQuoteFromSnapshot(snapshot batchQuoteSnapshot, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error) {
+	denoms := [2]string{tokenIn.Denom, tokenOutDenom}
+	if err, ok := snapshot.candidateRouteErrors[denoms]; ok {
+		return nil, err
+	}
+
+	routes, err := r.poolsUsecase.ConvertCandidateRoutes(snapshot.candidateRoutes[denoms], snapshot.pools, snapshot.tickModelMap, snapshot.takerFees, snapshot.routeTakerFees, tokenIn.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	topSingleRouteQuote, rankedRoutes, err := estimateDirectQuote(snapshot.router, routes, tokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rankedRoutes) == 0 {
+		return nil, fmt.Errorf("no ranked routes found")
+	}
+
+	rankedRoutes = filterDuplicatePoolIDRoutes(rankedRoutes)
+
+	return r.selectOptimalQuote(snapshot.router, topSingleRouteQuote, rankedRoutes, tokenIn)
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	chaininforepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/memory"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/cache"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	poolsusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
+	routerusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+)
+
+// chainInfoRepositoryIncrementingMock returns a greater height on every read
+// as if a block was ingested in between.
+type chainInfoRepositoryIncrementingMock struct {
+	mvc.ChainInfoRepository
+	height uint64
+}
+
+func (c *chainInfoRepositoryIncrementingMock) GetLatestHeight(ctx context.Context) (uint64, error) {
+	c.height++
+	return c.height, nil
+}
+
+// Validates that the batch quotes are equal to the individual optimal quotes,
+// that they are computed at the latest height and that failures are reported per request.
+func (s *RouterTestSuite) TestGetOptimalQuoteBatch_Mainnet() {
+	const (
+		defaultHeight = uint64(100)
+		unknownDenom  = "unknown"
+	)
+
+	var (
+		defaultAmountIn = osmomath.NewInt(5_000_000)
+	)
+
+	config := defaultRouterConfig
+	config.MaxPoolsPerRoute = 5
+	config.MaxRoutes = 10
+	config.MaxBatchQuoteRequests = 4
+
+	router, tickMap, takerFeeMap := s.setupMainnetRouter(config)
+
+	// Store the latest height.
+	txManager := memoryrepo.NewTxManager()
+	chainInfoRepository := chaininforepo.NewChainInfoRepo(txManager)
+	tx := txManager.StartTx()
+	s.Require().NoError(chainInfoRepository.StoreLatestHeight(context.Background(), tx, defaultHeight))
+	s.Require().NoError(tx.Exec(context.Background()))
+
+	routerRepositoryMock := mocks.RedisRouterRepositoryMock{
+		TakerFees: takerFeeMap,
+	}
+	poolsRepositoryMock := mocks.RedisPoolsRepositoryMock{
+		Pools:     router.GetSortedPools(),
+		TickModel: tickMap,
+	}
+	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil, domain.CosmWasmPoolRouterConfig{})
+
+	routerUsecase := routerusecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, chainInfoRepository, config, &log.NoOpLogger{}, cache.New())
+
+	requests := []domain.BatchQuoteRequest{
+		{TokenIn: sdk.NewCoin(UOSMO, defaultAmountIn), TokenOutDenom: UION},
+		{TokenIn: sdk.NewCoin(USDT, defaultAmountIn), TokenOutDenom: ATOM},
+		// Same pair with a different amount shares the candidate routes.
+		{TokenIn: sdk.NewCoin(UOSMO, defaultAmountIn.MulRaw(10)), TokenOutDenom: UION},
+		{TokenIn: sdk.NewCoin(UOSMO, defaultAmountIn), TokenOutDenom: unknownDenom},
+	}
+
+	// System under test
+	batchQuote, err := routerUsecase.GetOptimalQuoteBatch(context.Background(), requests)
+	s.Require().NoError(err)
+
+	s.Require().Equal(defaultHeight, batchQuote.Height)
+	s.Require().Len(batchQuote.Results, len(requests))
+
+	for i, result := range batchQuote.Results {
+		s.Require().Equal(requests[i].TokenIn, result.TokenIn)
+		s.Require().Equal(requests[i].TokenOutDenom, result.TokenOutDenom)
+
+		if requests[i].TokenOutDenom == unknownDenom {
+			s.Require().NotEmpty(result.Error)
+			s.Require().Nil(result.Quote)
+			continue
+		}
+
+		s.Require().Empty(result.Error)
+
+		expectedQuote, err := routerUsecase.GetOptimalQuote(context.Background(), requests[i].TokenIn, requests[i].TokenOutDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedQuote.GetAmountOut(), result.Quote.GetAmountOut())
+	}
+
+	// Too many requests.
+	_, err = routerUsecase.GetOptimalQuoteBatch(context.Background(), append(requests, requests[0]))
+	s.Require().ErrorIs(err, domain.BatchQuoteRequestLimitError{NumRequests: len(requests) + 1, MaxRequests: config.MaxBatchQuoteRequests})
+
+	// No requests.
+	_, err = routerUsecase.GetOptimalQuoteBatch(context.Background(), []domain.BatchQuoteRequest{})
+	s.Require().ErrorIs(err, domain.BatchQuoteRequestLimitError{NumRequests: 0, MaxRequests: config.MaxBatchQuoteRequests})
+
+	// The height changes while loading the state on every attempt.
+	routerUsecase = routerusecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, &chainInfoRepositoryIncrementingMock{}, config, &log.NoOpLogger{}, cache.New())
+	_, err = routerUsecase.GetOptimalQuoteBatch(context.Background(), requests[:1])
+	s.Require().ErrorIs(err, domain.BatchQuoteInconsistentHeightError{Attempts: 3})
+}
@@ -14,39 +14,57 @@ import (
 type split struct {
 	routeIncrements []int16
 	amountOut       osmomath.Int
+	// netAmountOut is the amount out less the gas cost of all routes
+	// valued in the token out. Equals amountOut if gas is not accounted for.
+	netAmountOut osmomath.Int
+	// isSet is true once the split assigns an increment to every route.
+	isSet bool
+}
+
+// routeEstimate is the memoized estimate of swapping an increment of the token in over a route.
+type routeEstimate struct {
+	amountOut osmomath.Int
+	gas       uint64
 }
 
 const totalIncrements = uint8(10)
 
+// GetSplitQuote returns the quote that splits the given token in across the given routes.
+// If the router has a gas price set, the split maximizes the amount out less the gas cost of
+// all routes valued in the token out. Otherwise, it maximizes the amount out.
 func (r *Router) GetSplitQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (domain.Quote, error) {
 	// Routes must be non-empty
 	if len(routes) == 0 {
 		return nil, errors.New("no routes")
 	}
+
+	gasPriceInTokenOut := r.getGasPriceInTokenOut(routes, tokenIn.Denom)
+
 	// If only one route, return the best single route quote
 	if len(routes) == 1 {
 		route := routes[0]
-		coinOut, err := route.CalculateTokenOutByTokenIn(tokenIn)
+		estimate, err := estimateRoute(route, tokenIn, gasPriceInTokenOut.IsPositive())
 		if err != nil {
 			return nil, err
 		}
 
 		quote := &quoteImpl{
 			AmountIn:  tokenIn,
-			AmountOut: coinOut.Amount,
+			AmountOut: estimate.amountOut,
 			Route: []domain.SplitRoute{&RouteWithOutAmount{
-				RouteImpl: route,
-				OutAmount: coinOut.Amount,
-				InAmount:  tokenIn.Amount,
+				RouteImpl:   route,
+				OutAmount:   estimate.amountOut,
+				InAmount:    tokenIn.Amount,
+				GasEstimate: estimate.gas,
 			}},
 		}
 
 		return quote, nil
 	}
 
-	memo := make([]map[uint8]osmomath.Int, len(routes))
+	memo := make([]map[uint8]routeEstimate, len(routes))
 	for i := range memo {
-		memo[i] = make(map[uint8]osmomath.Int, totalIncrements)
+		memo[i] = make(map[uint8]routeEstimate, totalIncrements)
 	}
 
 	routeIncrements := make([]int16, len(routes))
@@ -57,9 +75,10 @@ func (r *Router) GetSplitQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (doma
 	initialEmptySplit := split{
 		routeIncrements: routeIncrements,
 		amountOut:       osmomath.ZeroInt(),
+		netAmountOut:    osmomath.ZeroInt(),
 	}
 
-	bestSplit, err := r.findSplit(memo, routes, 0, tokenIn, totalIncrements, initialEmptySplit, initialEmptySplit)
+	bestSplit, err := r.findSplit(memo, routes, 0, tokenIn, totalIncrements, gasPriceInTokenOut, initialEmptySplit, initialEmptySplit)
 	if err != nil {
 		return nil, err
 	}
@@ -76,10 +95,11 @@ func (r *Router) GetSplitQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (doma
 			return nil, fmt.Errorf("best increment for route %d is negative", currentRouteIndex)
 		}
 
-		currentRouteAmtOut, ok := memo[currentRouteIndex][uint8(currentRouteIncrement)]
+		currentRouteEstimate, ok := memo[currentRouteIndex][uint8(currentRouteIncrement)]
 		if currentRouteIncrement > 0 && !ok {
 			return nil, fmt.Errorf("route %d not found in memo", currentRouteIndex)
 		}
+		currentRouteAmtOut := currentRouteEstimate.amountOut
 
 		inAmount := tokenIn.Amount.ToLegacyDec().Mul(sdk.NewDec(int64(currentRouteIncrement))).Quo(sdk.NewDec(int64(totalIncrements))).TruncateInt()
 		outAmount := currentRouteAmtOut
@@ -99,9 +119,10 @@ func (r *Router) GetSplitQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (doma
 		}
 
 		resultRoutes = append(resultRoutes, &RouteWithOutAmount{
-			RouteImpl: currentRoute,
-			InAmount:  inAmount,
-			OutAmount: currentRouteAmtOut,
+			RouteImpl:   currentRoute,
+			InAmount:    inAmount,
+			OutAmount:   currentRouteAmtOut,
+			GasEstimate: currentRouteEstimate.gas,
 		})
 
 		totalIncrementsInSplits += uint8(currentRouteIncrement)
@@ -129,7 +150,8 @@ func (r *Router) GetSplitQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (doma
 
 // Recurrence relation:
 // // findSplit(currentIncrement, currentRoute) = max(estimate(currentRoute, tokeInAmt * currentIncrement / totalIncrements) + OptimalSplit(remainingIncrement - currentIncrement, remaining_routes[1:]))
-func (r *Router) findSplit(memo []map[uint8]osmomath.Int, routes []route.RouteImpl, currentRouteIndex uint8, tokenIn sdk.Coin, remainingIncrements uint8, bestSplitSoFar, currentSplit split) (split, error) {
+// where the estimate is the amount out less the gas cost valued in the token out.
+func (r *Router) findSplit(memo []map[uint8]routeEstimate, routes []route.RouteImpl, currentRouteIndex uint8, tokenIn sdk.Coin, remainingIncrements uint8, gasPriceInTokenOut osmomath.Dec, bestSplitSoFar, currentSplit split) (split, error) {
 	// Current route index must be within range
 	if currentRouteIndex >= uint8(len(routes)) {
 		return split{}, fmt.Errorf("current route index (%d) is out of range (%d)", currentRouteIndex, len(routes))
@@ -143,18 +165,27 @@ func (r *Router) findSplit(memo []map[uint8]osmomath.Int, routes []route.RouteIm
 		currentIncrement := remainingIncrements
 
 		// Attempt to get memoized value.
-		currentAmtOut, err := getAmountOut(currentRoute, currentRouteIndex, memo, currentIncrement, tokenInAmountDec, tokenIn.Denom)
+		currentEstimate, err := getAmountOut(currentRoute, currentRouteIndex, memo, currentIncrement, tokenInAmountDec, tokenIn.Denom, gasPriceInTokenOut.IsPositive())
 		if err != nil {
 			// Note that we should always return bestSplitSoFar if there is an error
 			// since we silently skip the failing splits and want to preserve the context about bestSplitSoFar
 			return bestSplitSoFar, err
 		}
 
-		currentSplit.amountOut = currentSplit.amountOut.Add(currentAmtOut)
+		currentSplit.amountOut = currentSplit.amountOut.Add(currentEstimate.amountOut)
+		currentSplit.netAmountOut = currentSplit.netAmountOut.Add(getNetAmountOut(currentEstimate.amountOut, currentEstimate.gas, gasPriceInTokenOut))
 
-		if currentSplit.amountOut.GT(bestSplitSoFar.amountOut) {
+		// With gas accounted for, the net amount out of every split may be negative.
+		// As a result, the first complete split is always preferred over the initial empty split.
+		isBetter := currentSplit.netAmountOut.GT(bestSplitSoFar.netAmountOut) && currentSplit.amountOut.IsPositive()
+		if !bestSplitSoFar.isSet && gasPriceInTokenOut.IsPositive() {
+			isBetter = currentSplit.amountOut.IsPositive()
+		}
+
+		if isBetter {
 			// update current split with the increment of the current route.
 			currentSplit.routeIncrements[currentRouteIndex] = int16(currentIncrement)
+			currentSplit.isSet = true
 			return currentSplit, nil
 		}
 
@@ -163,7 +194,7 @@ func (r *Router) findSplit(memo []map[uint8]osmomath.Int, routes []route.RouteIm
 
 	// TODO: start from highest and exit early
 	for currentIncrement := uint8(0); currentIncrement <= remainingIncrements; currentIncrement++ {
-		currentAmtOut, err := getAmountOut(currentRoute, currentRouteIndex, memo, currentIncrement, tokenInAmountDec, tokenIn.Denom)
+		currentEstimate, err := getAmountOut(currentRoute, currentRouteIndex, memo, currentIncrement, tokenInAmountDec, tokenIn.Denom, gasPriceInTokenOut.IsPositive())
 		if err != nil {
 			continue
 		}
@@ -172,11 +203,12 @@ func (r *Router) findSplit(memo []map[uint8]osmomath.Int, routes []route.RouteIm
 		currentSplitCopy := split{}
 		currentSplitCopy.routeIncrements = make([]int16, len(currentSplit.routeIncrements))
 		copy(currentSplitCopy.routeIncrements, currentSplit.routeIncrements)
-		currentSplitCopy.amountOut = currentSplit.amountOut.Add(currentAmtOut)
+		currentSplitCopy.amountOut = currentSplit.amountOut.Add(currentEstimate.amountOut)
+		currentSplitCopy.netAmountOut = currentSplit.netAmountOut.Add(getNetAmountOut(currentEstimate.amountOut, currentEstimate.gas, gasPriceInTokenOut))
 		currentSplitCopy.routeIncrements[currentRouteIndex] = int16(currentIncrement)
 
 		// Recurse
-		bestSplitSoFar, err = r.findSplit(memo, routes, currentRouteIndex+1, tokenIn, remainingIncrements-currentIncrement, bestSplitSoFar, currentSplitCopy)
+		bestSplitSoFar, err = r.findSplit(memo, routes, currentRouteIndex+1, tokenIn, remainingIncrements-currentIncrement, gasPriceInTokenOut, bestSplitSoFar, currentSplitCopy)
 		if err != nil {
 			continue
 		}
@@ -186,34 +218,52 @@ func (r *Router) findSplit(memo []map[uint8]osmomath.Int, routes []route.RouteIm
 }
 
 // getAmountOut returns the amount out for the given route and increment.
+// If withGas is true, the estimate also includes the gas of swapping the increment over the route.
 // If the result is already present in the memo, it returns the memoized value.
 // Otherwise, it calculates the amount out and memoizes it by mutating the memo.
 // Returns error if the amount out cannot be calculated.
 // Otherwise, returns nil.
-func getAmountOut(route route.RouteImpl, memoRouteIndex uint8, memo []map[uint8]osmomath.Int, currentIncrement uint8, totalAmountIn osmomath.Dec, tokenInDenom string) (amtOut osmomath.Int, err error) {
+func getAmountOut(route route.RouteImpl, memoRouteIndex uint8, memo []map[uint8]routeEstimate, currentIncrement uint8, totalAmountIn osmomath.Dec, tokenInDenom string, withGas bool) (estimate routeEstimate, err error) {
 	if currentIncrement == 0 {
-		zeroResult := osmomath.ZeroInt()
+		zeroResult := routeEstimate{amountOut: osmomath.ZeroInt()}
 		memo[memoRouteIndex][currentIncrement] = zeroResult
 		return zeroResult, nil
 	}
 
-	currentAmtOut, ok := memo[memoRouteIndex][currentIncrement]
+	currentEstimate, ok := memo[memoRouteIndex][currentIncrement]
 
 	currentRatio := osmomath.NewDec(int64(currentIncrement)).Quo(osmomath.NewDec(int64(totalIncrements)))
 	currentTokenAmountIn := currentRatio.MulMut(totalAmountIn)
 	amtIn := currentTokenAmountIn.TruncateInt()
 
 	if !ok {
-		coinOut, err := route.CalculateTokenOutByTokenIn(sdk.NewCoin(tokenInDenom, amtIn))
+		currentEstimate, err = estimateRoute(route, sdk.NewCoin(tokenInDenom, amtIn), withGas)
 		if err != nil {
-			return osmomath.Int{}, err
+			return routeEstimate{}, err
 		}
 
-		currentAmtOut = coinOut.Amount
-
 		// Memoize
-		memo[memoRouteIndex][currentIncrement] = currentAmtOut
+		memo[memoRouteIndex][currentIncrement] = currentEstimate
+	}
+
+	return currentEstimate, nil
+}
+
+// estimateRoute returns the amount out of swapping the given token in over the route.
+// If withGas is true, the estimate also includes the gas of the swap. Otherwise, the gas is zero.
+// Returns error if the amount out cannot be calculated.
+func estimateRoute(route route.RouteImpl, tokenIn sdk.Coin, withGas bool) (routeEstimate, error) {
+	if !withGas {
+		coinOut, err := route.CalculateTokenOutByTokenIn(tokenIn)
+		if err != nil {
+			return routeEstimate{}, err
+		}
+		return routeEstimate{amountOut: coinOut.Amount}, nil
 	}
 
-	return currentAmtOut, nil
+	coinOut, gas, err := route.CalculateTokenOutByTokenInWithGas(tokenIn)
+	if err != nil {
+		return routeEstimate{}, err
+	}
+	return routeEstimate{amountOut: coinOut.Amount, gas: gas}, nil
 }
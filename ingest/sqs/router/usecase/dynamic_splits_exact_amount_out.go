@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// splitExactAmountOut is the exact amount out equivalent of split.
+// It tracks the total amount in required across the split routes.
+type splitExactAmountOut struct {
+	routeIncrements []int16
+	amountIn        osmomath.Int
+}
+
+// GetSplitQuoteExactAmountOut returns the quote that splits the given token out
+// across the given routes so that the total amount in is minimized.
+// Similar to GetSplitQuote, the token out is split into totalIncrements increments.
+// Any remainder of token out lost due to truncation when splitting is assigned to the
+// route with the largest increment so that the routes sum up to exactly the token out.
+// Returns error if:
+// - routes are empty
+// - no valid split is found
+// - fails to estimate the amount in for the final split
+func (r *Router) GetSplitQuoteExactAmountOut(routes []route.RouteImpl, tokenOut sdk.Coin, tokenInDenom string) (domain.Quote, error) {
+	// Routes must be non-empty
+	if len(routes) == 0 {
+		return nil, errors.New("no routes")
+	}
+	// If only one route, return the best single route quote
+	if len(routes) == 1 {
+		route := routes[0]
+		coinIn, err := route.CalculateTokenInByTokenOut(tokenOut, tokenInDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		quote := &quoteExactAmountOutImpl{
+			AmountIn:  coinIn,
+			AmountOut: tokenOut,
+			Route: []domain.SplitRoute{&RouteWithOutAmount{
+				RouteImpl: route,
+				OutAmount: tokenOut.Amount,
+				InAmount:  coinIn.Amount,
+			}},
+		}
+
+		return quote, nil
+	}
+
+	memo := make([]map[uint8]osmomath.Int, len(routes))
+	for i := range memo {
+		memo[i] = make(map[uint8]osmomath.Int, totalIncrements)
+	}
+
+	routeIncrements := make([]int16, len(routes))
+	for j := range routes {
+		routeIncrements[j] = -1
+	}
+
+	initialEmptySplit := splitExactAmountOut{
+		routeIncrements: routeIncrements,
+		amountIn:        osmomath.ZeroInt(),
+	}
+
+	// Note that the best split so far starts with a nil amount in
+	// to signify that no valid split has been found yet.
+	bestSplit, err := r.findSplitExactAmountOut(memo, routes, 0, tokenOut, tokenInDenom, totalIncrements, splitExactAmountOut{}, initialEmptySplit)
+	if err != nil {
+		return nil, err
+	}
+
+	if bestSplit.amountIn.IsNil() {
+		return nil, errors.New("no valid split found")
+	}
+
+	// Find the route with the largest increment to assign the remainder of token out to.
+	largestIncrementRouteIndex := 0
+	for i, currentRouteIncrement := range bestSplit.routeIncrements {
+		if currentRouteIncrement > bestSplit.routeIncrements[largestIncrementRouteIndex] {
+			largestIncrementRouteIndex = i
+		}
+	}
+
+	totalIncrementsInSplits := uint8(0)
+	splitRoutes := make([]*RouteWithOutAmount, 0, len(routes))
+	var largestIncrementSplitRoute *RouteWithOutAmount
+	totalAmountOutFromSplits := osmomath.ZeroInt()
+	for i, currentRouteIncrement := range bestSplit.routeIncrements {
+		currentRouteIndex := uint8(i)
+
+		if currentRouteIncrement < 0 {
+			return nil, fmt.Errorf("best increment for route %d is negative", currentRouteIndex)
+		}
+
+		if currentRouteIncrement == 0 {
+			continue
+		}
+
+		outAmount := tokenOut.Amount.ToLegacyDec().Mul(sdk.NewDec(int64(currentRouteIncrement))).Quo(sdk.NewDec(int64(totalIncrements))).TruncateInt()
+
+		totalIncrementsInSplits += uint8(currentRouteIncrement)
+		totalAmountOutFromSplits = totalAmountOutFromSplits.Add(outAmount)
+
+		splitRoute := &RouteWithOutAmount{
+			RouteImpl: routes[i],
+			OutAmount: outAmount,
+		}
+		splitRoutes = append(splitRoutes, splitRoute)
+
+		if i == largestIncrementRouteIndex {
+			largestIncrementSplitRoute = splitRoute
+		}
+	}
+
+	// This may happen if one of the routes is consistently failing for all increments.
+	if totalIncrementsInSplits != totalIncrements {
+		return nil, fmt.Errorf("total increments (%d) does not match expected total increments (%d)", totalIncrementsInSplits, totalIncrements)
+	}
+
+	// Assign the truncation remainder to the route with the largest increment.
+	largestIncrementSplitRoute.OutAmount = largestIncrementSplitRoute.OutAmount.Add(tokenOut.Amount.Sub(totalAmountOutFromSplits))
+
+	resultRoutes := make([]domain.SplitRoute, 0, len(splitRoutes))
+	totalAmountInFromSplits := osmomath.ZeroInt()
+	for _, splitRoute := range splitRoutes {
+		coinIn, err := splitRoute.CalculateTokenInByTokenOut(sdk.NewCoin(tokenOut.Denom, splitRoute.OutAmount), tokenInDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		splitRoute.InAmount = coinIn.Amount
+		totalAmountInFromSplits = totalAmountInFromSplits.Add(coinIn.Amount)
+
+		resultRoutes = append(resultRoutes, splitRoute)
+	}
+
+	quote := &quoteExactAmountOutImpl{
+		AmountIn:  sdk.NewCoin(tokenInDenom, totalAmountInFromSplits),
+		AmountOut: tokenOut,
+		Route:     resultRoutes,
+	}
+
+	return quote, nil
+}
+
+// findSplitExactAmountOut is the exact amount out equivalent of findSplit.
+// Recurrence relation:
+// findSplitExactAmountOut(currentIncrement, currentRoute) = min(estimate(currentRoute, tokenOutAmt * currentIncrement / totalIncrements) + findSplitExactAmountOut(remainingIncrement - currentIncrement, remaining_routes[1:]))
+func (r *Router) findSplitExactAmountOut(memo []map[uint8]osmomath.Int, routes []route.RouteImpl, currentRouteIndex uint8, tokenOut sdk.Coin, tokenInDenom string, remainingIncrements uint8, bestSplitSoFar, currentSplit splitExactAmountOut) (splitExactAmountOut, error) {
+	// Current route index must be within range
+	if currentRouteIndex >= uint8(len(routes)) {
+		return splitExactAmountOut{}, fmt.Errorf("current route index (%d) is out of range (%d)", currentRouteIndex, len(routes))
+	}
+
+	tokenOutAmountDec := tokenOut.Amount.ToLegacyDec()
+	currentRoute := routes[currentRouteIndex]
+
+	// Base case: if this is the last route, it must provide all the remaining tokenOut
+	if currentRouteIndex == uint8(len(routes))-1 {
+		currentIncrement := remainingIncrements
+
+		// Attempt to get memoized value.
+		currentAmtIn, err := getAmountIn(currentRoute, currentRouteIndex, memo, currentIncrement, tokenOutAmountDec, tokenOut.Denom, tokenInDenom)
+		if err != nil {
+			// Note that we should always return bestSplitSoFar if there is an error
+			// since we silently skip the failing splits and want to preserve the context about bestSplitSoFar
+			return bestSplitSoFar, err
+		}
+
+		currentSplit.amountIn = currentSplit.amountIn.Add(currentAmtIn)
+
+		if bestSplitSoFar.amountIn.IsNil() || currentSplit.amountIn.LT(bestSplitSoFar.amountIn) {
+			// update current split with the increment of the current route.
+			currentSplit.routeIncrements[currentRouteIndex] = int16(currentIncrement)
+			return currentSplit, nil
+		}
+
+		return bestSplitSoFar, nil
+	}
+
+	for currentIncrement := uint8(0); currentIncrement <= remainingIncrements; currentIncrement++ {
+		currentAmtIn, err := getAmountIn(currentRoute, currentRouteIndex, memo, currentIncrement, tokenOutAmountDec, tokenOut.Denom, tokenInDenom)
+		if err != nil {
+			continue
+		}
+
+		currentSplitCopy := splitExactAmountOut{}
+		currentSplitCopy.routeIncrements = make([]int16, len(currentSplit.routeIncrements))
+		copy(currentSplitCopy.routeIncrements, currentSplit.routeIncrements)
+		currentSplitCopy.amountIn = currentSplit.amountIn.Add(currentAmtIn)
+		currentSplitCopy.routeIncrements[currentRouteIndex] = int16(currentIncrement)
+
+		// Recurse
+		bestSplitSoFar, err = r.findSplitExactAmountOut(memo, routes, currentRouteIndex+1, tokenOut, tokenInDenom, remainingIncrements-currentIncrement, bestSplitSoFar, currentSplitCopy)
+		if err != nil {
+			continue
+		}
+	}
+
+	return bestSplitSoFar, nil
+}
+
+// getAmountIn returns the amount in for the given route and increment of the total amount out.
+// If the result is already present in the memo, it returns the memoized value.
+// Otherwise, it calculates the amount in and memoizes it by mutating the memo.
+// Returns error if the amount in cannot be calculated.
+// Otherwise, returns nil.
+func getAmountIn(route route.RouteImpl, memoRouteIndex uint8, memo []map[uint8]osmomath.Int, currentIncrement uint8, totalAmountOut osmomath.Dec, tokenOutDenom, tokenInDenom string) (amtIn osmomath.Int, err error) {
+	if currentIncrement == 0 {
+		zeroResult := osmomath.ZeroInt()
+		memo[memoRouteIndex][currentIncrement] = zeroResult
+		return zeroResult, nil
+	}
+
+	currentAmtIn, ok := memo[memoRouteIndex][currentIncrement]
+
+	if !ok {
+		currentRatio := osmomath.NewDec(int64(currentIncrement)).Quo(osmomath.NewDec(int64(totalIncrements)))
+		amtOut := currentRatio.MulMut(totalAmountOut).TruncateInt()
+
+		coinIn, err := route.CalculateTokenInByTokenOut(sdk.NewCoin(tokenOutDenom, amtOut), tokenInDenom)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		currentAmtIn = coinIn.Amount
+
+		// Memoize
+		memo[memoRouteIndex][currentIncrement] = currentAmtIn
+	}
+
+	return currentAmtIn, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
 )
@@ -34,6 +35,10 @@ func (r *routerUseCaseImpl) HandleRoutes(ctx context.Context, router *Router, to
 	return r.handleCandidateRoutes(ctx, router, tokenInDenom, tokenOutDenom)
 }
 
+func (r *Router) GetGasPriceInTokenOut(routes []route.RouteImpl, tokenInDenom string) osmomath.Dec {
+	return r.getGasPriceInTokenOut(routes, tokenInDenom)
+}
+
 func (r *Router) EstimateAndRankSingleRouteQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (domain.Quote, []RouteWithOutAmount, error) {
 	return r.estimateAndRankSingleRouteQuote(routes, tokenIn)
 }
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// gasPriceDenom is the denom that the gas price is configured in.
+const gasPriceDenom = "uosmo"
+
+// getGasPriceInTokenOut returns the price of a single unit of gas denominated in the token out
+// of the given routes.
+// The gas price in uosmo is converted into the token out by finding a denom along a route whose uosmo
+// price is known and converting that denom into the token out with the spot prices of the remaining
+// pools of the route. Each route is walked backwards from the token out to the token in so that the
+// conversion spans as few pools as possible. See getUOSMOPrice for how the uosmo price of a denom is found.
+// Returns zero if the gas price is not set or cannot be converted into the token out.
+// In that case, routes are ranked by the amount out only. A failed conversion is logged as a warning.
+// CONTRACT: all routes are non-empty and have the same token out denom.
+func (r *Router) getGasPriceInTokenOut(routes []route.RouteImpl, tokenInDenom string) osmomath.Dec {
+	if r.gasPriceUOSMO.IsNil() || !r.gasPriceUOSMO.IsPositive() || len(routes) == 0 {
+		return osmomath.ZeroDec()
+	}
+
+	tokenOutDenom := routes[0].GetTokenOutDenom()
+
+	for _, route := range routes {
+		pools := route.GetPools()
+
+		// Token out per denom where denom is the token out of pools[i].
+		denom := tokenOutDenom
+		tokenOutPerDenom := osmomath.OneDec()
+		for i := len(pools) - 1; i >= -1; i-- {
+			// Denom per uosmo.
+			if uosmoPrice, ok := r.getUOSMOPrice(routes, denom); ok {
+				return r.gasPriceUOSMO.Mul(uosmoPrice).MulMut(tokenOutPerDenom)
+			}
+
+			if i < 0 {
+				break
+			}
+
+			// Step back over pools[i] to its token in.
+			poolTokenInDenom := tokenInDenom
+			if i > 0 {
+				poolTokenInDenom = pools[i-1].GetTokenOutDenom()
+			}
+
+			// Denom per pool token in.
+			spotPrice, err := pools[i].CalcSpotPrice(poolTokenInDenom, denom)
+			if err != nil {
+				r.logger.Debug("failed to compute route spot price for gas", zap.Uint64("pool_id", pools[i].GetId()), zap.Error(err))
+				break
+			}
+
+			tokenOutPerDenom.MulMut(spotPrice.Dec())
+			denom = poolTokenInDenom
+		}
+	}
+
+	r.logger.Warn("failed to convert gas price into token out, ranking routes without gas", zap.String("token_in_denom", tokenInDenom), zap.String("token_out_denom", tokenOutDenom))
+	return osmomath.ZeroDec()
+}
+
+// getUOSMOPrice returns the amount of the given denom per uosmo and true if it can be found.
+// The price is the spot price of the first pool in the routes that has both uosmo and the denom.
+// Returns one if the denom is uosmo.
+// Returns false if no pool in the routes has both denoms or their spot price fails to compute.
+func (r *Router) getUOSMOPrice(routes []route.RouteImpl, denom string) (osmomath.Dec, bool) {
+	if denom == gasPriceDenom {
+		return osmomath.OneDec(), true
+	}
+
+	for _, route := range routes {
+		for _, pool := range route.GetPools() {
+			if !hasDenoms(pool, gasPriceDenom, denom) {
+				continue
+			}
+
+			spotPrice, err := pool.CalcSpotPrice(gasPriceDenom, denom)
+			if err != nil {
+				r.logger.Debug("failed to compute uosmo spot price for gas", zap.Uint64("pool_id", pool.GetId()), zap.Error(err))
+				continue
+			}
+
+			return spotPrice.Dec(), true
+		}
+	}
+
+	return osmomath.Dec{}, false
+}
+
+// getNetAmountOut returns the amount out less the cost of the given gas valued in the token out.
+// The gas cost is rounded up. As a result, the net amount out may be negative.
+func getNetAmountOut(amountOut osmomath.Int, gas uint64, gasPriceInTokenOut osmomath.Dec) osmomath.Int {
+	if gas == 0 || !gasPriceInTokenOut.IsPositive() {
+		return amountOut
+	}
+
+	gasCost := gasPriceInTokenOut.MulInt64(int64(gas)).Ceil().TruncateInt()
+	return amountOut.Sub(gasCost)
+}
+
+// getQuoteGasEstimate returns the sum of the gas estimates over all routes in the quote.
+func getQuoteGasEstimate(quote domain.Quote) uint64 {
+	var gas uint64
+	for _, route := range quote.GetRoute() {
+		gas += route.GetGasEstimate()
+	}
+	return gas
+}
+
+// hasDenoms returns true if the pool contains both of the given denoms.
+func hasDenoms(pool domain.RoutablePool, denomA, denomB string) bool {
+	var hasA, hasB bool
+	for _, denom := range pool.GetPoolDenoms() {
+		hasA = hasA || denom == denomA
+		hasB = hasB || denom == denomB
+	}
+	return hasA && hasB
+}
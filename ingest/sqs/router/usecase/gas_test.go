@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	routerusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// Validates that routes are ranked by the amount out less the gas cost when the gas price is set
+// and by the amount out otherwise.
+// The route with the highest amount out is the most expensive in gas so that the order flips
+// once the gas is accounted for.
+func (s *RouterTestSuite) TestEstimateAndRankSingleRouteQuote_Gas() {
+	var (
+		tokenIn = sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))
+
+		cosmWasmPool = &mocks.MockRoutablePool{
+			Denoms:               []string{UOSMO, DenomTwo},
+			TotalValueLockedUSDC: osmomath.NewInt(10),
+			PoolType:             poolmanagertypes.CosmWasm,
+			TokenOutDenom:        DenomTwo,
+			TakerFee:             osmomath.ZeroDec(),
+			SpreadFactor:         osmomath.ZeroDec(),
+		}
+
+		// Higher amount out and higher gas.
+		expensiveRoute = WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{
+			mocks.WithMockedTokenOut(mocks.WithGasEstimate(mocks.WithPoolID(cosmWasmPool, defaultPoolID), 500_000), sdk.NewCoin(DenomTwo, osmomath.NewInt(1_000_000))),
+		})
+
+		// Lower amount out and lower gas.
+		cheapRoute = WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{
+			mocks.WithMockedTokenOut(mocks.WithGasEstimate(mocks.WithPoolID(cosmWasmPool, defaultPoolID+1), 100_000), sdk.NewCoin(DenomTwo, osmomath.NewInt(990_000))),
+		})
+	)
+
+	tests := map[string]struct {
+		gasPriceUOSMO osmomath.Dec
+
+		expectedRankedPoolIDs []uint64
+		expectedGasEstimate   uint64
+	}{
+		"gas price is not set": {
+			gasPriceUOSMO: osmomath.ZeroDec(),
+
+			expectedRankedPoolIDs: []uint64{defaultPoolID, defaultPoolID + 1},
+		},
+		"gas cost of the expensive route outweighs its higher amount out": {
+			// The spot price of the CosmWasm mock is one. As a result,
+			// the net amounts out are 1_000_000 - 50_000 and 990_000 - 10_000.
+			gasPriceUOSMO: osmomath.NewDecWithPrec(1, 1),
+
+			expectedRankedPoolIDs: []uint64{defaultPoolID + 1, defaultPoolID},
+			expectedGasEstimate:   100_000,
+		},
+		"gas cost is too low to change the order": {
+			// The net amounts out are 1_000_000 - 5_000 and 990_000 - 1_000.
+			gasPriceUOSMO: osmomath.NewDecWithPrec(1, 2),
+
+			expectedRankedPoolIDs: []uint64{defaultPoolID, defaultPoolID + 1},
+			expectedGasEstimate:   500_000,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			router := routerusecase.NewRouter([]uint64{}, 0, 0, 0, 0, 0, &log.NoOpLogger{})
+			router = routerusecase.WithGasPrice(router, tc.gasPriceUOSMO)
+
+			// System under test
+			quote, rankedRoutes, err := router.EstimateAndRankSingleRouteQuote([]route.RouteImpl{expensiveRoute, cheapRoute}, tokenIn)
+			s.Require().NoError(err)
+
+			s.Require().Len(rankedRoutes, len(tc.expectedRankedPoolIDs))
+			for i, expectedPoolID := range tc.expectedRankedPoolIDs {
+				s.Require().Equal(expectedPoolID, rankedRoutes[i].GetPools()[0].GetId())
+			}
+
+			// The amount out of the quote is not reduced by the gas cost.
+			s.Require().Equal(rankedRoutes[0].OutAmount, quote.GetAmountOut())
+			s.Require().Equal(tc.expectedGasEstimate, quote.GetRoute()[0].GetGasEstimate())
+		})
+	}
+}
+
+// Validates that the gas price is converted into the token out through the uosmo price
+// of a denom along the route when no pool has both uosmo and the token out.
+func (s *RouterTestSuite) TestGetGasPriceInTokenOut() {
+	s.Setup()
+
+	gasPriceUOSMO := osmomath.NewDecWithPrec(1, 1)
+
+	// Two DenomTwo per uosmo.
+	uosmoPoolID := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin(DenomOne, osmomath.NewInt(1_000_000)),
+		sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)),
+		sdk.NewCoin(DenomTwo, osmomath.NewInt(2_000_000)),
+	)
+	uosmoPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, uosmoPoolID)
+	s.Require().NoError(err)
+
+	// Three DenomThree per DenomTwo.
+	noUOSMOPoolID := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin(DenomTwo, osmomath.NewInt(1_000_000)),
+		sdk.NewCoin(DenomThree, osmomath.NewInt(3_000_000)),
+	)
+	noUOSMOPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, noUOSMOPoolID)
+	s.Require().NoError(err)
+
+	var (
+		uosmoRoutablePool   = mocks.WithPoolID(mocks.WithChainPoolModel(mocks.WithTokenOutDenom(DefaultMockPool, DenomTwo), uosmoPool), uosmoPoolID)
+		noUOSMORoutablePool = mocks.WithPoolID(mocks.WithChainPoolModel(mocks.WithTokenOutDenom(DefaultMockPool, DenomThree), noUOSMOPool), noUOSMOPoolID)
+	)
+	uosmoRoutablePool.Denoms = []string{DenomOne, UOSMO, DenomTwo}
+	noUOSMORoutablePool.Denoms = []string{DenomTwo, DenomThree}
+
+	tests := map[string]struct {
+		routes       []route.RouteImpl
+		tokenInDenom string
+
+		expectedGasPriceInTokenOut osmomath.Dec
+	}{
+		"uosmo pool has the token out": {
+			routes:       []route.RouteImpl{WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{uosmoRoutablePool})},
+			tokenInDenom: DenomOne,
+
+			expectedGasPriceInTokenOut: gasPriceUOSMO.MulInt64(2),
+		},
+		"uosmo price of an intermediate denom is converted into the token out": {
+			routes:       []route.RouteImpl{WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{uosmoRoutablePool, noUOSMORoutablePool})},
+			tokenInDenom: DenomOne,
+
+			expectedGasPriceInTokenOut: gasPriceUOSMO.MulInt64(2 * 3),
+		},
+		"no pool with uosmo": {
+			routes:       []route.RouteImpl{WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{noUOSMORoutablePool})},
+			tokenInDenom: DenomTwo,
+
+			expectedGasPriceInTokenOut: osmomath.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			router := routerusecase.NewRouter([]uint64{}, 0, 0, 0, 0, 0, &log.NoOpLogger{})
+			router = routerusecase.WithGasPrice(router, gasPriceUOSMO)
+
+			gasPriceInTokenOut := router.GetGasPriceInTokenOut(tc.routes, tc.tokenInDenom)
+
+			s.Require().Equal(tc.expectedGasPriceInTokenOut.String(), gasPriceInTokenOut.String())
+		})
+	}
+}
@@ -32,6 +32,8 @@ func (r *Router) getBestSingleRouteQuote(tokenIn sdk.Coin, routes []route.RouteI
 }
 
 // Returns best quote as well as all routes sorted by amount out and error if any.
+// If the router has a gas price set, the routes are sorted by the amount out less the gas cost
+// valued in the token out. The amount out of the quote and routes is not reduced by the gas cost.
 // CONTRACT: router repository must be set on the router.
 // CONTRACT: pools repository must be set on the router
 func (r *Router) estimateAndRankSingleRouteQuote(routes []route.RouteImpl, tokenIn sdk.Coin) (quote domain.Quote, sortedRoutesByAmtOut []RouteWithOutAmount, err error) {
@@ -39,29 +41,35 @@ func (r *Router) estimateAndRankSingleRouteQuote(routes []route.RouteImpl, token
 		return nil, nil, errors.New("no routes were provided")
 	}
 
+	gasPriceInTokenOut := r.getGasPriceInTokenOut(routes, tokenIn.Denom)
+
 	routesWithAmountOut := make([]RouteWithOutAmount, 0, len(routes))
 
 	for _, route := range routes {
-		directRouteTokenOut, err := route.CalculateTokenOutByTokenIn(tokenIn)
+		directRouteEstimate, err := estimateRoute(route, tokenIn, gasPriceInTokenOut.IsPositive())
 		if err != nil {
 			r.logger.Debug("skipping single route due to error in estimate", zap.Error(err))
 			continue
 		}
 
-		if directRouteTokenOut.Amount.IsNil() {
-			directRouteTokenOut.Amount = osmomath.ZeroInt()
+		if directRouteEstimate.amountOut.IsNil() {
+			directRouteEstimate.amountOut = osmomath.ZeroInt()
 		}
 
 		routesWithAmountOut = append(routesWithAmountOut, RouteWithOutAmount{
-			RouteImpl: route,
-			InAmount:  tokenIn.Amount,
-			OutAmount: directRouteTokenOut.Amount,
+			RouteImpl:   route,
+			InAmount:    tokenIn.Amount,
+			OutAmount:   directRouteEstimate.amountOut,
+			GasEstimate: directRouteEstimate.gas,
 		})
 	}
 
-	// Sort by amount out in descending order
+	// Sort by amount out net of the gas cost in descending order.
+	// If gas is not accounted for, the net amount out equals the amount out.
 	sort.Slice(routesWithAmountOut, func(i, j int) bool {
-		return routesWithAmountOut[i].OutAmount.GT(routesWithAmountOut[j].OutAmount)
+		netAmountOutI := getNetAmountOut(routesWithAmountOut[i].OutAmount, routesWithAmountOut[i].GasEstimate, gasPriceInTokenOut)
+		netAmountOutJ := getNetAmountOut(routesWithAmountOut[j].OutAmount, routesWithAmountOut[j].GasEstimate, gasPriceInTokenOut)
+		return netAmountOutI.GT(netAmountOutJ)
 	})
 
 	bestRoute := routesWithAmountOut[0]
@@ -75,6 +83,55 @@ func (r *Router) estimateAndRankSingleRouteQuote(routes []route.RouteImpl, token
 	return finalQuote, routesWithAmountOut, nil
 }
 
+// estimateAndRankSingleRouteQuoteExactAmountOut is the exact amount out equivalent of estimateAndRankSingleRouteQuote.
+// Returns best quote as well as all routes sorted by amount in (ascending) and error if any.
+// Routes that fail to estimate the amount in (e.g. due to insufficient liquidity) are skipped.
+func (r *Router) estimateAndRankSingleRouteQuoteExactAmountOut(routes []route.RouteImpl, tokenOut sdk.Coin, tokenInDenom string) (quote domain.Quote, sortedRoutesByAmtIn []RouteWithOutAmount, err error) {
+	if len(routes) == 0 {
+		return nil, nil, errors.New("no routes were provided")
+	}
+
+	routesWithAmountIn := make([]RouteWithOutAmount, 0, len(routes))
+
+	for _, route := range routes {
+		directRouteTokenIn, err := route.CalculateTokenInByTokenOut(tokenOut, tokenInDenom)
+		if err != nil {
+			r.logger.Debug("skipping single route due to error in exact out estimate", zap.Error(err))
+			continue
+		}
+
+		if directRouteTokenIn.Amount.IsNil() || !directRouteTokenIn.Amount.IsPositive() {
+			r.logger.Debug("skipping single route due to non-positive amount in", zap.Stringer("amount_in", directRouteTokenIn))
+			continue
+		}
+
+		routesWithAmountIn = append(routesWithAmountIn, RouteWithOutAmount{
+			RouteImpl: route,
+			InAmount:  directRouteTokenIn.Amount,
+			OutAmount: tokenOut.Amount,
+		})
+	}
+
+	if len(routesWithAmountIn) == 0 {
+		return nil, nil, errors.New("no route can provide the requested token out")
+	}
+
+	// Sort by amount in in ascending order
+	sort.Slice(routesWithAmountIn, func(i, j int) bool {
+		return routesWithAmountIn[i].InAmount.LT(routesWithAmountIn[j].InAmount)
+	})
+
+	bestRoute := routesWithAmountIn[0]
+
+	finalQuote := &quoteExactAmountOutImpl{
+		AmountIn:  sdk.NewCoin(tokenInDenom, bestRoute.InAmount),
+		AmountOut: tokenOut,
+		Route:     []domain.SplitRoute{&bestRoute},
+	}
+
+	return finalQuote, routesWithAmountIn, nil
+}
+
 // validateAndFilterRoutes validates all routes. Specifically:
 // - all routes have at least one pool.
 // - all routes have the same final token out denom.
@@ -200,6 +257,9 @@ type RouteWithOutAmount struct {
 	route.RouteImpl
 	OutAmount osmomath.Int "json:\"out_amount\""
 	InAmount  osmomath.Int "json:\"in_amount\""
+	// GasEstimate is the gas estimate of swapping the in amount over the route.
+	// Only set if the router accounts for gas.
+	GasEstimate uint64 "json:\"-\""
 }
 
 var _ domain.SplitRoute = &RouteWithOutAmount{}
@@ -214,6 +274,11 @@ func (r RouteWithOutAmount) GetAmountOut() math.Int {
 	return r.OutAmount
 }
 
+// GetGasEstimate implements domain.SplitRoute.
+func (r RouteWithOutAmount) GetGasEstimate() uint64 {
+	return r.GasEstimate
+}
+
 type Split struct {
 	Routes          []domain.SplitRoute
 	CurrentTotalOut osmomath.Int
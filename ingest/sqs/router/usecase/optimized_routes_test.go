@@ -18,8 +18,11 @@ import (
 	poolsusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
 	routerusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/routertesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -264,6 +267,88 @@ func (s *RouterTestSuite) TestGetBestSplitRoutesQuote() {
 	}
 }
 
+// This test validates that we are able to split an exact amount out over multiple routes.
+// The token out is split over two balancer pools with X and 2X liquidity.
+// We assert that the split routes add up to exactly the token out, that the more liquid pool
+// is assigned the larger amount out, and that the amount in of every split route matches
+// the chain's CalcInAmtGivenOut with the taker fee added on top.
+func (s *RouterTestSuite) TestGetSplitQuoteExactAmountOut() {
+	s.Setup()
+
+	xLiquidity := sdk.NewCoins(
+		sdk.NewCoin(DenomOne, sdk.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(DenomTwo, sdk.NewInt(2_000_000_000_000)),
+	)
+
+	// X Liquidity
+	defaultBalancerPoolID := s.PrepareBalancerPoolWithCoins(xLiquidity...)
+	// 2X liquidity
+	secondBalancerPoolID := s.PrepareBalancerPoolWithCoins(coinutil.MulRaw(xLiquidity, 2)...)
+
+	chainPools := map[uint64]poolmanagertypes.PoolI{}
+	routes := make([]route.RouteImpl, 0, 2)
+	for _, poolID := range []uint64{defaultBalancerPoolID, secondBalancerPoolID} {
+		chainPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolID)
+		s.Require().NoError(err)
+		chainPools[poolID] = chainPool
+
+		mockPool := mocks.WithPoolID(mocks.WithChainPoolModel(DefaultMockPool, chainPool), poolID)
+		routes = append(routes, WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{
+			pools.NewRoutablePool(mockPool, DenomOne, DefaultTakerFee, domain.CosmWasmPoolRouterConfig{}),
+		}))
+	}
+
+	tokenOut := sdk.NewCoin(DenomOne, sdk.NewInt(50_000_000_000))
+
+	logger, err := log.NewLogger(false, "", "")
+	s.Require().NoError(err)
+	r := routerusecase.NewRouter([]uint64{}, 0, 0, 0, 10, 0, logger)
+
+	quote, err := r.GetSplitQuoteExactAmountOut(routes, tokenOut, DenomTwo)
+	s.Require().NoError(err)
+	s.Require().Equal(tokenOut.Amount, quote.GetAmountOut())
+
+	splitRoutes := quote.GetRoute()
+	s.Require().Len(splitRoutes, 2)
+
+	actualTotalOutFromSplits := osmomath.ZeroInt()
+	expectedTotalIn := osmomath.ZeroInt()
+	amountOutByPoolID := map[uint64]osmomath.Int{}
+	for _, splitRoute := range splitRoutes {
+		routePools := splitRoute.GetPools()
+		s.Require().Len(routePools, 1)
+		poolID := routePools[0].GetId()
+
+		cfmmPool, ok := chainPools[poolID].(gammtypes.CFMMPoolI)
+		s.Require().True(ok)
+
+		splitTokenOut := sdk.NewCoin(DenomOne, splitRoute.GetAmountOut())
+		expectedTokenIn, err := cfmmPool.CalcInAmtGivenOut(s.Ctx, sdk.NewCoins(splitTokenOut), DenomTwo, cfmmPool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+		expectedTokenIn, _ = poolmanager.CalcTakerFeeExactOut(expectedTokenIn, DefaultTakerFee)
+
+		s.Require().Equal(expectedTokenIn.Amount, splitRoute.GetAmountIn())
+
+		actualTotalOutFromSplits = actualTotalOutFromSplits.Add(splitRoute.GetAmountOut())
+		expectedTotalIn = expectedTotalIn.Add(expectedTokenIn.Amount)
+		amountOutByPoolID[poolID] = splitRoute.GetAmountOut()
+	}
+
+	// The split routes add up to exactly the token out and the amount in is the sum of the split amounts in.
+	s.Require().Equal(tokenOut.Amount, actualTotalOutFromSplits)
+	s.Require().Equal(sdk.NewCoin(DenomTwo, expectedTotalIn), quote.GetAmountIn())
+
+	// The pool with more liquidity is assigned the larger amount out.
+	s.Require().True(amountOutByPoolID[secondBalancerPoolID].GT(amountOutByPoolID[defaultBalancerPoolID]))
+
+	// The split requires no more token in than the best single route.
+	for _, singleRoute := range routes {
+		singleRouteTokenIn, err := singleRoute.CalculateTokenInByTokenOut(tokenOut, DenomTwo)
+		s.Require().NoError(err)
+		s.Require().True(quote.GetAmountIn().Amount.LTE(singleRouteTokenIn.Amount))
+	}
+}
+
 // This test ensures strict route validation.
 // See individual test cases for details.
 func (s *RouterTestSuite) TestValidateAndFilterRoutes() {
@@ -704,10 +789,10 @@ func (s *RouterTestSuite) TestGetCustomQuote_Mainnet_UOSMOUION() {
 		Pools:     router.GetSortedPools(),
 		TickModel: tickMap,
 	}
-	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil)
+	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil, domain.CosmWasmPoolRouterConfig{})
 	routerusecase.WithPoolsUsecase(router, poolsUsecase)
 
-	routerUsecase := routerusecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, config, &log.NoOpLogger{}, cache.New())
+	routerUsecase := routerusecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, nil, config, &log.NoOpLogger{}, cache.New())
 
 	// This pool ID is second best: https://app.osmosis.zone/pool/2
 	// The top one is https://app.osmosis.zone/pool/1110 which is not selected
@@ -740,7 +825,7 @@ func (s *RouterTestSuite) constructRoutesFromMainnetPools(router *routerusecase.
 	candidateRoutes, err := router.GetCandidateRoutes(tokenInDenom, tokenOutDenom)
 	s.Require().NoError(err)
 
-	routes, err := poolsUsecase.GetRoutesFromCandidates(context.Background(), candidateRoutes, takerFeeMap, tokenInDenom, tokenOutDenom)
+	routes, err := poolsUsecase.GetRoutesFromCandidates(context.Background(), candidateRoutes, takerFeeMap, domain.RouteTakerFeeMap{}, tokenInDenom, tokenOutDenom)
 	s.Require().NoError(err)
 
 	return routes
@@ -758,10 +843,10 @@ func (s *RouterTestSuite) setupRouterAndPoolsUsecase(router *routerusecase.Route
 		Pools:     router.GetSortedPools(),
 		TickModel: tickMap,
 	}
-	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil)
+	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil, domain.CosmWasmPoolRouterConfig{})
 	routerusecase.WithPoolsUsecase(router, poolsUsecase)
 
-	routerUsecase := usecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, defaultRouterConfig, &log.NoOpLogger{}, cache)
+	routerUsecase := usecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, nil, defaultRouterConfig, &log.NoOpLogger{}, cache)
 
 	return routerUsecase, poolsUsecase
 }
@@ -4,5 +4,11 @@ type (
 	RoutableCFMMPoolImpl         = routableBalancerPoolImpl
 	RoutableConcentratedPoolImpl = routableConcentratedPoolImpl
 	RoutableTransmuterPoolImpl   = routableTransmuterPoolImpl
+	RoutableCosmWasmPoolImpl     = routableCosmWasmPoolImpl
 	RoutableResultPoolImpl       = routableResultPoolImpl
 )
+
+const (
+	ConcentratedSwapGas         = concentratedSwapGas
+	ConcentratedTickCrossingGas = concentratedTickCrossingGas
+)
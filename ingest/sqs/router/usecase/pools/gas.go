@@ -0,0 +1,18 @@
+package pools
+
+// Gas estimates of swapping in a single pool.
+// The sidecar query server cannot simulate swaps. As a result, these
+// are upper bounds observed on mainnet rather than exact values.
+const (
+	balancerSwapGas   uint64 = 60_000
+	stableswapSwapGas uint64 = 80_000
+	// concentratedSwapGas is the gas of a concentrated swap that stays within the current bucket.
+	concentratedSwapGas uint64 = 100_000
+	// concentratedTickCrossingGas is the additional gas of crossing an initialized tick
+	// in a concentrated swap.
+	concentratedTickCrossingGas uint64 = 30_000
+	transmuterSwapGas           uint64 = 150_000
+	// cosmWasmSwapGas is the gas of swapping in a general CosmWasm pool.
+	// It is the most expensive pool type since the swap logic is arbitrary contract code.
+	cosmWasmSwapGas uint64 = 300_000
+)
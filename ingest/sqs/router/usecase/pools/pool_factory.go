@@ -11,8 +11,10 @@ import (
 )
 
 // NewRoutablePool creates a new RoutablePool.
+// CosmWasm pools with a code ID allowlisted in cosmWasmConfig are quoted by querying the pool contract.
+// All other CosmWasm pools are treated as transmuter pools.
 // Panics if pool is of invalid type or if does not contain tick data when a concentrated pool.
-func NewRoutablePool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.Dec) domain.RoutablePool {
+func NewRoutablePool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.Dec, cosmWasmConfig domain.CosmWasmPoolRouterConfig) domain.RoutablePool {
 	poolType := pool.GetType()
 	chainPool := pool.GetUnderlyingPool()
 	if poolType == poolmanagertypes.Concentrated {
@@ -30,11 +32,20 @@ func NewRoutablePool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.
 			panic(err)
 		}
 
+		// The ingested spread factor is the effective one, accounting for
+		// the dynamic spread factor. Fall back to the static pool spread
+		// factor for pools ingested without it.
+		spreadFactor := pool.GetSQSPoolModel().SpreadFactor
+		if spreadFactor.IsNil() {
+			spreadFactor = concentratedPool.SpreadFactor
+		}
+
 		return &routableConcentratedPoolImpl{
 			ChainPool:     concentratedPool,
 			TickModel:     tickModel,
 			TokenOutDenom: tokenOutDenom,
 			TakerFee:      takerFee,
+			SpreadFactor:  spreadFactor,
 		}
 	}
 
@@ -68,6 +79,18 @@ func NewRoutablePool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.
 
 		sqsPoolModel := pool.GetSQSPoolModel().SpreadFactor
 
+		if cosmWasmConfig.IsGeneralCosmWasmCodeID(cosmwasmPool.CodeId) {
+			return &routableCosmWasmPoolImpl{
+				ChainPool:     cosmwasmPool,
+				Balances:      pool.GetSQSPoolModel().Balances,
+				TokenOutDenom: tokenOutDenom,
+				TakerFee:      takerFee,
+				SpreadFactor:  sqsPoolModel,
+				querier:       cosmWasmConfig.Querier,
+				queryGasLimit: cosmWasmConfig.QueryGasLimit,
+			}
+		}
+
 		return &routableTransmuterPoolImpl{
 			ChainPool:     cosmwasmPool,
 			Balances:      pool.GetSQSPoolModel().Balances,
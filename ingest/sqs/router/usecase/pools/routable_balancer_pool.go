@@ -33,6 +33,25 @@ func (r *routableBalancerPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin)
 	return tokenOut, nil
 }
 
+// CalculateTokenInByTokenOut implements RoutablePool.
+func (r *routableBalancerPoolImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	tokenIn, err := r.ChainPool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(tokenOut), tokenInDenom, r.GetSpreadFactor())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return tokenIn, nil
+}
+
+// CalculateTokenOutByTokenInWithGas implements domain.RoutablePool.
+func (r *routableBalancerPoolImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	tokenOut, err := r.CalculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	return tokenOut, balancerSwapGas, nil
+}
+
 // GetTokenOutDenom implements RoutablePool.
 func (r *routableBalancerPoolImpl) GetTokenOutDenom() string {
 	return r.TokenOutDenom
@@ -50,6 +69,13 @@ func (r *routableBalancerPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (toke
 	return tokenInAfterTakerFee
 }
 
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+// Charges the taker fee on top of the given token in and returns the token in after the fee has been added.
+func (r *routableBalancerPoolImpl) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactOut(tokenIn, r.TakerFee)
+	return tokenInAfterTakerFee
+}
+
 // GetTakerFee implements domain.RoutablePool.
 func (r *routableBalancerPoolImpl) GetTakerFee() math.LegacyDec {
 	return r.TakerFee
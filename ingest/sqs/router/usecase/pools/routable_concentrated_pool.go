@@ -23,6 +23,7 @@ type routableConcentratedPoolImpl struct {
 	TickModel     *domain.TickModel       "json:\"tick_model\""
 	TokenOutDenom string                  "json:\"token_out_denom\""
 	TakerFee      osmomath.Dec            "json:\"taker_fee\""
+	SpreadFactor  osmomath.Dec            "json:\"spread_factor\""
 }
 
 // GetPoolDenoms implements domain.RoutablePool.
@@ -42,7 +43,7 @@ func (r *routableConcentratedPoolImpl) GetId() uint64 {
 
 // GetSpreadFactor implements domain.RoutablePool.
 func (r *routableConcentratedPoolImpl) GetSpreadFactor() math.LegacyDec {
-	return r.ChainPool.SpreadFactor
+	return r.SpreadFactor
 }
 
 // GetTakerFee implements domain.RoutablePool.
@@ -60,42 +61,30 @@ func (r *routableConcentratedPoolImpl) GetTakerFee() math.LegacyDec {
 // - the current sqrt price is zero
 // - rans out of ticks during swap (token in is too high for liquidity in the pool)
 func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error) {
-	concentratedPool := r.ChainPool
-	tickModel := r.TickModel
-
-	if tickModel == nil {
-		return sdk.Coin{}, domain.ConcentratedPoolNoTickModelError{
-			PoolId: r.ChainPool.Id,
-		}
-	}
-
-	// Ensure pool has liquidity.
-	if tickModel.HasNoLiquidity {
-		return sdk.Coin{}, domain.ConcentratedNoLiquidityError{
-			PoolId: concentratedPool.Id,
-		}
-	}
-
-	// Ensure that the current bucket is within the available bucket range.
-	currentBucketIndex := tickModel.CurrentTickIndex
+	tokenOut, _, err := r.calculateTokenOutByTokenIn(tokenIn)
+	return tokenOut, err
+}
 
-	if currentBucketIndex < 0 || currentBucketIndex >= int64(len(tickModel.Ticks)) {
-		return sdk.Coin{}, domain.ConcentratedCurrentTickNotWithinBucketError{
-			PoolId:             concentratedPool.Id,
-			CurrentBucketIndex: currentBucketIndex,
-			TotalBuckets:       int64(len(tickModel.Ticks)),
-		}
+// CalculateTokenOutByTokenInWithGas implements domain.RoutablePool.
+// The estimate grows with the number of initialized ticks crossed by the swap.
+func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	tokenOut, ticksCrossed, err := r.calculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, 0, err
 	}
+	return tokenOut, concentratedSwapGas + ticksCrossed*concentratedTickCrossingGas, nil
+}
 
-	currentBucket := tickModel.Ticks[currentBucketIndex]
+// calculateTokenOutByTokenIn calculates the amount of token out given the amount of token in
+// and returns it together with the number of initialized ticks crossed by the swap.
+// See CalculateTokenOutByTokenIn for the failure cases.
+func (r *routableConcentratedPoolImpl) calculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	concentratedPool := r.ChainPool
+	tickModel := r.TickModel
 
-	isCurrentTickWithinBucket := concentratedPool.IsCurrentTickInRange(currentBucket.LowerTick, currentBucket.UpperTick)
-	if !isCurrentTickWithinBucket {
-		return sdk.Coin{}, domain.ConcentratedCurrentTickAndBucketMismatchError{
-			CurrentTick: concentratedPool.CurrentTick,
-			LowerTick:   currentBucket.LowerTick,
-			UpperTick:   currentBucket.UpperTick,
-		}
+	currentBucketIndex, err := r.validateTickModel()
+	if err != nil {
+		return sdk.Coin{}, 0, err
 	}
 
 	// Set the appropriate token out denom.
@@ -106,7 +95,7 @@ func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Co
 	}
 
 	// Initialize the swap strategy.
-	swapStrategy := swapstrategy.New(isZeroForOne, osmomath.ZeroBigDec(), &storetypes.KVStoreKey{}, concentratedPool.SpreadFactor)
+	swapStrategy := swapstrategy.New(isZeroForOne, osmomath.ZeroBigDec(), &storetypes.KVStoreKey{}, r.SpreadFactor)
 
 	var (
 		// Swap state
@@ -114,26 +103,23 @@ func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Co
 
 		amountRemainingIn = tokenIn.Amount.ToLegacyDec()
 		amountOutTotal    = osmomath.ZeroDec()
-	)
 
-	if currentSqrtPrice.IsZero() {
-		return sdk.Coin{}, domain.ConcentratedZeroCurrentSqrtPriceError{
-			PoolId: concentratedPool.Id,
-		}
-	}
+		// Number of buckets the swap computed over.
+		bucketsVisited uint64
+	)
 
 	// Compute swap over all buckets.
 	for amountRemainingIn.GT(osmomath.ZeroDec()) {
 		if currentBucketIndex >= int64(len(tickModel.Ticks)) || currentBucketIndex < 0 {
 			// This happens when there is not enough liquidity in the pool to complete the swap
 			// for a given amount of token in.
-			return sdk.Coin{}, domain.ConcentratedNotEnoughLiquidityToCompleteSwapError{
+			return sdk.Coin{}, 0, domain.ConcentratedNotEnoughLiquidityToCompleteSwapError{
 				PoolId:   concentratedPool.Id,
 				AmountIn: sdk.NewCoins(tokenIn).String(),
 			}
 		}
 
-		currentBucket = tickModel.Ticks[currentBucketIndex]
+		currentBucket := tickModel.Ticks[currentBucketIndex]
 
 		// Compute the next initialized tick index depending on the swap direction.
 		// Zero for one - in the lower tick direction.
@@ -150,7 +136,7 @@ func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Co
 		// Get the sqrt price for the next initialized tick index.
 		sqrtPriceTarget, err := clmath.TickToSqrtPrice(nextInitializedTickIndex)
 		if err != nil {
-			return sdk.Coin{}, err
+			return sdk.Coin{}, 0, err
 		}
 
 		// Compute the swap within current bucket
@@ -162,10 +148,154 @@ func (r *routableConcentratedPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Co
 
 		// Update current sqrt price
 		currentSqrtPrice = sqrtPriceNext
+		bucketsVisited++
+	}
+
+	// Every bucket past the current one is entered by crossing an initialized tick.
+	var ticksCrossed uint64
+	if bucketsVisited > 0 {
+		ticksCrossed = bucketsVisited - 1
 	}
 
 	// Return the total amount out.
-	return sdk.NewCoin(tokenOutDenom, amountOutTotal.TruncateInt()), nil
+	return sdk.NewCoin(tokenOutDenom, amountOutTotal.TruncateInt()), ticksCrossed, nil
+}
+
+// CalculateTokenInByTokenOut implements domain.RoutablePool.
+// It calculates the amount of token in given the amount of token out for a concentrated liquidity pool.
+// Fails if:
+// - the underlying chain pool set on the routable pool is not of concentrated type
+// - fails to retrieve the tick model for the pool
+// - the current tick is not within the specified current bucket range
+// - tick model has no liquidity flag set
+// - the current sqrt price is zero
+// - rans out of ticks during swap (token out is too high for liquidity in the pool)
+func (r *routableConcentratedPoolImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	concentratedPool := r.ChainPool
+	tickModel := r.TickModel
+
+	currentBucketIndex, err := r.validateTickModel()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Swapping zero for one means that token zero is in and token one is out.
+	isZeroForOne := tokenOut.Denom == concentratedPool.Token1
+
+	// Initialize the swap strategy.
+	swapStrategy := swapstrategy.New(isZeroForOne, osmomath.ZeroBigDec(), &storetypes.KVStoreKey{}, r.SpreadFactor)
+
+	var (
+		// Swap state
+		currentSqrtPrice = concentratedPool.GetCurrentSqrtPrice()
+
+		amountRemainingOut = tokenOut.Amount.ToLegacyDec()
+		amountInTotal      = osmomath.ZeroDec()
+	)
+
+	// Compute swap over all buckets.
+	// Similarly to the chain logic, the swap stops once the remaining amount out is at most
+	// the smallest dec so that the precision dust does not spill over into the next bucket.
+	for amountRemainingOut.GT(osmomath.SmallestDec()) {
+		if currentBucketIndex >= int64(len(tickModel.Ticks)) || currentBucketIndex < 0 {
+			// This happens when there is not enough liquidity in the pool to complete the swap
+			// for a given amount of token out.
+			return sdk.Coin{}, domain.ConcentratedNotEnoughLiquidityToCompleteSwapError{
+				PoolId:   concentratedPool.Id,
+				AmountIn: sdk.NewCoins(tokenOut).String(),
+			}
+		}
+
+		currentBucket := tickModel.Ticks[currentBucketIndex]
+
+		// Compute the next initialized tick index depending on the swap direction.
+		// Zero for one - in the lower tick direction.
+		// One for zero - in the upper tick direction.
+		var nextInitializedTickIndex int64
+		if isZeroForOne {
+			nextInitializedTickIndex = currentBucket.LowerTick
+			currentBucketIndex--
+		} else {
+			nextInitializedTickIndex = currentBucket.UpperTick
+			currentBucketIndex++
+		}
+
+		// Get the sqrt price for the next initialized tick index.
+		sqrtPriceTarget, err := clmath.TickToSqrtPrice(nextInitializedTickIndex)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		// Compute the swap within current bucket
+		sqrtPriceNext, amountOutConsumed, amountInComputed, spreadRewardChargeTotal := swapStrategy.ComputeSwapWithinBucketInGivenOut(currentSqrtPrice, sqrtPriceTarget, currentBucket.LiquidityAmount, amountRemainingOut)
+
+		// Update swap state for next iteration
+		amountRemainingOut = amountRemainingOut.SubMut(amountOutConsumed)
+		amountInTotal = amountInTotal.AddMut(amountInComputed).AddMut(spreadRewardChargeTotal)
+
+		// Update current sqrt price
+		currentSqrtPrice = sqrtPriceNext
+	}
+
+	// Return the total amount in, rounded up to match the chain behavior.
+	return sdk.NewCoin(tokenInDenom, amountInTotal.Ceil().TruncateInt()), nil
+}
+
+// validateTickModel validates that the tick model is set on the pool and that the current
+// tick is within the current bucket.
+// Returns the current bucket index on success.
+// Returns error if:
+// - the tick model is not set
+// - tick model has no liquidity flag set
+// - the current bucket index is out of range
+// - the current tick is not within the current bucket range
+// - the current sqrt price is zero
+func (r *routableConcentratedPoolImpl) validateTickModel() (int64, error) {
+	concentratedPool := r.ChainPool
+	tickModel := r.TickModel
+
+	if tickModel == nil {
+		return 0, domain.ConcentratedPoolNoTickModelError{
+			PoolId: concentratedPool.Id,
+		}
+	}
+
+	// Ensure pool has liquidity.
+	if tickModel.HasNoLiquidity {
+		return 0, domain.ConcentratedNoLiquidityError{
+			PoolId: concentratedPool.Id,
+		}
+	}
+
+	// Ensure that the current bucket is within the available bucket range.
+	currentBucketIndex := tickModel.CurrentTickIndex
+
+	if currentBucketIndex < 0 || currentBucketIndex >= int64(len(tickModel.Ticks)) {
+		return 0, domain.ConcentratedCurrentTickNotWithinBucketError{
+			PoolId:             concentratedPool.Id,
+			CurrentBucketIndex: currentBucketIndex,
+			TotalBuckets:       int64(len(tickModel.Ticks)),
+		}
+	}
+
+	currentBucket := tickModel.Ticks[currentBucketIndex]
+
+	isCurrentTickWithinBucket := concentratedPool.IsCurrentTickInRange(currentBucket.LowerTick, currentBucket.UpperTick)
+	if !isCurrentTickWithinBucket {
+		return 0, domain.ConcentratedCurrentTickAndBucketMismatchError{
+			CurrentTick: concentratedPool.CurrentTick,
+			LowerTick:   currentBucket.LowerTick,
+			UpperTick:   currentBucket.UpperTick,
+		}
+	}
+
+	if concentratedPool.GetCurrentSqrtPrice().IsZero() {
+		return 0, domain.ConcentratedZeroCurrentSqrtPriceError{
+			PoolId: concentratedPool.Id,
+		}
+	}
+
+	return currentBucketIndex, nil
 }
 
 // GetTokenOutDenom implements RoutablePool.
@@ -186,6 +316,13 @@ func (r *routableConcentratedPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (
 	return tokenInAfterTakerFee
 }
 
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+// Charges the taker fee on top of the given token in and returns the token in after the fee has been added.
+func (r *routableConcentratedPoolImpl) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactOut(tokenIn, r.GetTakerFee())
+	return tokenInAfterTakerFee
+}
+
 // SetTokenOutDenom implements domain.RoutablePool.
 func (r *routableConcentratedPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
 	r.TokenOutDenom = tokenOutDenom
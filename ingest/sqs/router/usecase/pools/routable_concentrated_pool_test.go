@@ -85,12 +85,75 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_Concentrated_Succ
 					PoolDenoms:            []string{"foo", "bar"},
 				},
 			}
-			routablePool := pools.NewRoutablePool(poolWrapper, tc.TokenOutDenom, noTakerFee)
+			routablePool := pools.NewRoutablePool(poolWrapper, tc.TokenOutDenom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
 
 			tokenOut, err := routablePool.CalculateTokenOutByTokenIn(tc.TokenIn)
 
 			s.Require().NoError(err)
 			s.Require().Equal(tc.ExpectedTokenOut.String(), tokenOut.String())
+
+			// The gas estimate comes from the same swap computation as the token out.
+			tokenOutWithGas, gas, err := routablePool.CalculateTokenOutByTokenInWithGas(tc.TokenIn)
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOut, tokenOutWithGas)
+			s.Require().GreaterOrEqual(gas, pools.ConcentratedSwapGas)
+			s.Require().Zero((gas - pools.ConcentratedSwapGas) % pools.ConcentratedTickCrossingGas)
+		})
+	}
+}
+
+// Tests the CalculateTokenInByTokenOut method of the RoutableConcentratedPoolImpl struct
+// when the pool is concentrated.
+//
+// It reuses the pool setups of the chain swap vectors, quoting the expected token out of each vector
+// and validating that the amount in matches the chain's CalcInAmtGivenOut exactly.
+func (s *RoutablePoolTestSuite) TestCalculateTokenInByTokenOut_Concentrated_SuccessChainVectors() {
+	tests := apptesting.SwapOutGivenInCases
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			// Note: router quote tests do not have the concept of slippage protection.
+			if strings.Contains(name, "slippage protection") {
+				s.T().Skip("no slippage protection in router quote tests")
+			}
+
+			s.SetupAndFundSwapTest()
+			concentratedPool := s.PreparePoolWithCustSpread(tc.SpreadFactor)
+			// add default position
+			s.SetupDefaultPosition(concentratedPool.GetId())
+			s.SetupSecondPosition(tc, concentratedPool)
+
+			// Refetch the pool
+			concentratedPool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, concentratedPool.GetId())
+			s.Require().NoError(err)
+
+			// Get liquidity for full range
+			ticks, currentTickIndex, err := s.App.ConcentratedLiquidityKeeper.GetTickLiquidityForFullRange(s.Ctx, concentratedPool.GetId())
+			s.Require().NoError(err)
+
+			poolWrapper := &domain.PoolWrapper{
+				ChainModel: concentratedPool,
+				TickModel: &domain.TickModel{
+					Ticks:            ticks,
+					CurrentTickIndex: currentTickIndex,
+					HasNoLiquidity:   false,
+				},
+				SQSModel: domain.SQSPool{
+					TotalValueLockedUSDC:  osmomath.NewInt(100),
+					TotalValueLockedError: "",
+					Balances:              sdk.Coins{},
+					PoolDenoms:            []string{"foo", "bar"},
+				},
+			}
+			routablePool := pools.NewRoutablePool(poolWrapper, tc.ExpectedTokenOut.Denom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
+
+			expectedTokenIn, err := s.App.ConcentratedLiquidityKeeper.CalcInAmtGivenOut(s.Ctx, concentratedPool, tc.ExpectedTokenOut, tc.TokenIn.Denom, tc.SpreadFactor)
+			s.Require().NoError(err)
+
+			tokenIn, err := routablePool.CalculateTokenInByTokenOut(tc.ExpectedTokenOut, tc.TokenIn.Denom)
+
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenIn.String(), tokenIn.String())
 		})
 	}
 }
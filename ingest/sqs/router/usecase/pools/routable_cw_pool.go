@@ -0,0 +1,160 @@
+package pools
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/json"
+	"github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/cosmwasm/msg"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+var _ domain.RoutablePool = &routableCosmWasmPoolImpl{}
+
+// routableCosmWasmPoolImpl is a routable pool for generic CosmWasm pools.
+// Unlike the transmuter, the swap math is not known to the router.
+// Instead, quotes are computed by running the pool contract's queries
+// against the state snapshot captured at ingest time.
+type routableCosmWasmPoolImpl struct {
+	ChainPool     *cwpoolmodel.CosmWasmPool "json:\"pool\""
+	Balances      sdk.Coins                 "json:\"balances\""
+	TokenOutDenom string                    "json:\"token_out_denom\""
+	TakerFee      osmomath.Dec              "json:\"taker_fee\""
+	SpreadFactor  osmomath.Dec              "json:\"spread_factor\""
+
+	querier       domain.CosmWasmPoolQuerier
+	queryGasLimit uint64
+}
+
+// GetId implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) GetId() uint64 {
+	return r.ChainPool.PoolId
+}
+
+// GetPoolDenoms implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) GetPoolDenoms() []string {
+	return r.Balances.Denoms()
+}
+
+// GetType implements domain.RoutablePool.
+func (*routableCosmWasmPoolImpl) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.CosmWasm
+}
+
+// GetSpreadFactor implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) GetSpreadFactor() math.LegacyDec {
+	return r.SpreadFactor
+}
+
+// CalculateTokenOutByTokenIn implements domain.RoutablePool.
+// It calculates the amount of token out given the amount of token in by running
+// the calc_out_amt_given_in query against the pool contract.
+// Returns error if the query fails or exceeds the gas limit.
+func (r *routableCosmWasmPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error) {
+	request := msg.NewCalcOutAmtGivenInRequest(tokenIn, r.TokenOutDenom, r.SpreadFactor)
+
+	var response msg.CalcOutAmtGivenInResponse
+	if err := r.query(request, &response); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return response.TokenOut, nil
+}
+
+// CalculateTokenInByTokenOut implements domain.RoutablePool.
+// It calculates the amount of token in given the amount of token out by running
+// the calc_in_amt_given_out query against the pool contract.
+// Returns error if the query fails or exceeds the gas limit.
+func (r *routableCosmWasmPoolImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	request := msg.NewCalcInAmtGivenOutRequest(tokenInDenom, tokenOut, r.SpreadFactor)
+
+	var response msg.CalcInAmtGivenOutResponse
+	if err := r.query(request, &response); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return response.TokenIn, nil
+}
+
+// CalculateTokenOutByTokenInWithGas implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	tokenOut, err := r.CalculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	return tokenOut, cosmWasmSwapGas, nil
+}
+
+// GetTokenOutDenom implements RoutablePool.
+func (r *routableCosmWasmPoolImpl) GetTokenOutDenom() string {
+	return r.TokenOutDenom
+}
+
+// String implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) String() string {
+	return fmt.Sprintf("pool (%d), pool type (%d), code id (%d), pool denoms (%v), token out (%s)", r.ChainPool.PoolId, poolmanagertypes.CosmWasm, r.ChainPool.CodeId, r.GetPoolDenoms(), r.TokenOutDenom)
+}
+
+// ChargeTakerFeeExactIn implements domain.RoutablePool.
+// Charges the taker fee for the given token in and returns the token in after the fee has been charged.
+func (r *routableCosmWasmPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactIn(tokenIn, r.TakerFee)
+	return tokenInAfterTakerFee
+}
+
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+// Charges the taker fee on top of the given token in and returns the token in after the fee has been added.
+func (r *routableCosmWasmPoolImpl) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactOut(tokenIn, r.TakerFee)
+	return tokenInAfterTakerFee
+}
+
+// GetTakerFee implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) GetTakerFee() math.LegacyDec {
+	return r.TakerFee
+}
+
+// SetTokenOutDenom implements domain.RoutablePool.
+func (r *routableCosmWasmPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
+	r.TokenOutDenom = tokenOutDenom
+}
+
+// CalcSpotPrice implements domain.RoutablePool.
+// It runs the spot_price query against the pool contract.
+func (r *routableCosmWasmPoolImpl) CalcSpotPrice(baseDenom string, quoteDenom string) (osmomath.BigDec, error) {
+	request := msg.SpotPriceQueryMsg{
+		SpotPrice: msg.SpotPrice{
+			QuoteAssetDenom: quoteDenom,
+			BaseAssetDenom:  baseDenom,
+		},
+	}
+
+	var response msg.SpotPriceQueryMsgResponse
+	if err := r.query(request, &response); err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return osmomath.NewBigDecFromStr(response.SpotPrice)
+}
+
+// query runs the given request against the pool contract and unmarshals the result into response.
+// Returns error if the request fails to be marshalled, the query fails or the response fails to be unmarshalled.
+func (r *routableCosmWasmPoolImpl) query(request any, response any) error {
+	requestBz, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	responseBz, err := r.querier.QuerySmart(r.ChainPool.ContractAddress, requestBz, r.queryGasLimit)
+	if err != nil {
+		return domain.CosmWasmPoolQueryError{PoolId: r.GetId(), Err: err}
+	}
+
+	return json.Unmarshal(responseBz, response)
+}
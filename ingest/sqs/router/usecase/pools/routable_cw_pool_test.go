@@ -0,0 +1,130 @@
+package pools_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/querier"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
+)
+
+// Tests that allowlisted CosmWasm pools are quoted by querying the contract
+// against the state snapshot and that the gas cap is enforced.
+// Uses the transmuter contract since its swap math is known.
+func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_CosmWasm() {
+	defaultAmount := DefaultAmt0
+	defaultBalances := sdk.NewCoins(sdk.NewCoin(USDC, defaultAmount), sdk.NewCoin(ETH, defaultAmount))
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		isAllowlisted bool
+		skipSnapshot  bool
+		skipCommit    bool
+		gasLimit      uint64
+
+		expectedTokenOut sdk.Coin
+		expectError      bool
+	}{
+		"allowlisted: quoted by contract": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.QuoRaw(2)),
+			tokenOutDenom: ETH,
+			isAllowlisted: true,
+			gasLimit:      1_000_000,
+
+			expectedTokenOut: sdk.NewCoin(ETH, defaultAmount.QuoRaw(2)),
+		},
+		"not allowlisted: falls back to transmuter": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.QuoRaw(2)),
+			tokenOutDenom: ETH,
+			gasLimit:      1_000_000,
+
+			expectedTokenOut: sdk.NewCoin(ETH, defaultAmount.QuoRaw(2)),
+		},
+		"error: contract errors on insufficient liquidity": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.Add(osmomath.OneInt())),
+			tokenOutDenom: ETH,
+			isAllowlisted: true,
+			gasLimit:      1_000_000,
+
+			expectError: true,
+		},
+		"error: gas limit exceeded": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.QuoRaw(2)),
+			tokenOutDenom: ETH,
+			isAllowlisted: true,
+			gasLimit:      1,
+
+			expectError: true,
+		},
+		"error: snapshot is not set": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.QuoRaw(2)),
+			tokenOutDenom: ETH,
+			isAllowlisted: true,
+			skipSnapshot:  true,
+			gasLimit:      1_000_000,
+
+			expectError: true,
+		},
+		"error: ingested height is not committed": {
+			tokenIn:       sdk.NewCoin(USDC, defaultAmount.QuoRaw(2)),
+			tokenOutDenom: ETH,
+			isAllowlisted: true,
+			skipCommit:    true,
+			gasLimit:      1_000_000,
+
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			cosmwasmPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{tc.tokenIn.Denom, tc.tokenOutDenom})
+			s.JoinTransmuterPool(s.TestAccs[0], cosmwasmPool.GetId(), defaultBalances)
+
+			// Ingest the current block.
+			cosmWasmPoolQuerier := querier.NewCosmWasmSnapshotQuerier(s.App.WasmKeeper, s.App.CommitMultiStore())
+			if !tc.skipSnapshot {
+				s.Require().NoError(cosmWasmPoolQuerier.UpdateSnapshot(s.Ctx))
+			}
+
+			// Commit so that the ingested height is part of the committed state.
+			if !tc.skipCommit {
+				s.Commit()
+			}
+
+			cosmWasmConfig := domain.CosmWasmPoolRouterConfig{
+				GeneralCosmWasmCodeIDs: map[uint64]struct{}{},
+				QueryGasLimit:          tc.gasLimit,
+				Querier:                cosmWasmPoolQuerier,
+			}
+			if tc.isAllowlisted {
+				cosmWasmConfig.GeneralCosmWasmCodeIDs[cosmwasmPool.GetCodeId()] = struct{}{}
+			}
+
+			mock := &mocks.MockRoutablePool{ChainPoolModel: cosmwasmPool.AsSerializablePool(), Balances: defaultBalances, PoolType: cosmwasmPool.GetType()}
+			routablePool := pools.NewRoutablePool(mock, tc.tokenOutDenom, noTakerFee, cosmWasmConfig)
+
+			if tc.isAllowlisted {
+				_, ok := routablePool.(*pools.RoutableCosmWasmPoolImpl)
+				s.Require().True(ok)
+			} else {
+				_, ok := routablePool.(*pools.RoutableTransmuterPoolImpl)
+				s.Require().True(ok)
+			}
+
+			tokenOut, err := routablePool.CalculateTokenOutByTokenIn(tc.tokenIn)
+
+			if tc.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedTokenOut, tokenOut)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -85,7 +86,7 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_CFMM() {
 			s.Require().NoError(err)
 
 			mock := &mocks.MockRoutablePool{ChainPoolModel: pool, PoolType: tc.poolType}
-			routablePool := pools.NewRoutablePool(mock, tc.tokenOutDenom, noTakerFee)
+			routablePool := pools.NewRoutablePool(mock, tc.tokenOutDenom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
 
 			tokenOut, err := routablePool.CalculateTokenOutByTokenIn(tc.tokenIn)
 
@@ -102,3 +103,55 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_CFMM() {
 		})
 	}
 }
+
+// Test exact amount out quote logic over a specific pool that is of CFMM type.
+// CFMM pools are balancer and stableswap.
+func (s *RoutablePoolTestSuite) TestCalculateTokenInByTokenOut_CFMM() {
+	tests := map[string]struct {
+		tokenOut     sdk.Coin
+		tokenInDenom string
+		poolType     poolmanagertypes.PoolType
+		expectError  error
+	}{
+		"balancer pool - valid calculation": {
+			tokenOut:     sdk.NewCoin("foo", sdk.NewInt(100)),
+			tokenInDenom: "bar",
+			poolType:     poolmanagertypes.Balancer,
+		},
+		"stableswap pool - valid calculation": {
+			tokenOut:     sdk.NewCoin("foo", sdk.NewInt(100)),
+			tokenInDenom: "bar",
+			poolType:     poolmanagertypes.Stableswap,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			poolID := s.CreatePoolFromType(tc.poolType)
+			pool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolID)
+			s.Require().NoError(err)
+
+			mock := &mocks.MockRoutablePool{ChainPoolModel: pool, PoolType: tc.poolType}
+			routablePool := pools.NewRoutablePool(mock, tc.tokenOut.Denom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
+
+			tokenIn, err := routablePool.CalculateTokenInByTokenOut(tc.tokenOut, tc.tokenInDenom)
+
+			if tc.expectError != nil {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The quote must match the chain's exact amount out calculation.
+			cfmmPool, ok := pool.(gammtypes.CFMMPoolI)
+			s.Require().True(ok)
+			expectedTokenIn, err := cfmmPool.CalcInAmtGivenOut(s.Ctx, sdk.NewCoins(tc.tokenOut), tc.tokenInDenom, pool.GetSpreadFactor(s.Ctx))
+			s.Require().NoError(err)
+
+			s.Require().Equal(expectedTokenIn, tokenIn)
+			s.Require().True(tokenIn.IsPositive())
+		})
+	}
+}
@@ -28,6 +28,7 @@ type routableResultPoolImpl struct {
 	SpreadFactor  osmomath.Dec              "json:\"spread_factor\""
 	TokenOutDenom string                    "json:\"token_out_denom\""
 	TakerFee      osmomath.Dec              "json:\"taker_fee\""
+	TokenInDenom  string                    "json:\"token_in_denom,omitempty\""
 }
 
 // NewRoutableResultPool returns the new routable result pool with the given parameters.
@@ -41,6 +42,19 @@ func NewRoutableResultPool(ID uint64, poolType poolmanagertypes.PoolType, spread
 	}
 }
 
+// NewExactAmountOutRoutableResultPool returns the new routable result pool for exact amount out quotes.
+// In addition to the parameters of NewRoutableResultPool, it sets the token in denom of the pool.
+func NewExactAmountOutRoutableResultPool(ID uint64, poolType poolmanagertypes.PoolType, spreadFactor osmomath.Dec, tokenInDenom, tokenOutDenom string, takerFee osmomath.Dec) domain.RoutablePool {
+	return &routableResultPoolImpl{
+		ID:            ID,
+		Type:          poolType,
+		SpreadFactor:  spreadFactor,
+		TokenInDenom:  tokenInDenom,
+		TokenOutDenom: tokenOutDenom,
+		TakerFee:      takerFee,
+	}
+}
+
 // GetId implements domain.RoutablePool.
 func (r *routableResultPoolImpl) GetId() uint64 {
 	return r.ID
@@ -95,6 +109,16 @@ func (r *routableResultPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (s
 	return sdk.Coin{}, errors.New("not implemented")
 }
 
+// CalculateTokenInByTokenOut implements RoutablePool.
+func (r *routableResultPoolImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	return sdk.Coin{}, errors.New("not implemented")
+}
+
+// CalculateTokenOutByTokenInWithGas implements RoutablePool.
+func (r *routableResultPoolImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	return sdk.Coin{}, 0, errors.New("not implemented")
+}
+
 // GetTokenOutDenom implements RoutablePool.
 func (r *routableResultPoolImpl) GetTokenOutDenom() string {
 	return r.TokenOutDenom
@@ -112,6 +136,13 @@ func (r *routableResultPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (tokenI
 	return tokenInAfterTakerFee
 }
 
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+// Charges the taker fee on top of the given token in and returns the token in after the fee has been added.
+func (r *routableResultPoolImpl) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactOut(tokenIn, r.TakerFee)
+	return tokenInAfterTakerFee
+}
+
 // GetTakerFee implements domain.RoutablePool.
 func (r *routableResultPoolImpl) GetTakerFee() math.LegacyDec {
 	return r.TakerFee
@@ -122,6 +153,11 @@ func (r *routableResultPoolImpl) GetBalances() sdk.Coins {
 	return r.Balances
 }
 
+// GetTokenInDenom implements domain.RoutableResultPool.
+func (r *routableResultPoolImpl) GetTokenInDenom() string {
+	return r.TokenInDenom
+}
+
 // SetTokenOutDenom implements domain.RoutablePool.
 func (r *routableResultPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
 	r.TokenOutDenom = tokenOutDenom
@@ -74,6 +74,48 @@ func (r *routableTransmuterPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin
 	return sdk.NewCoin(r.TokenOutDenom, tokenIn.Amount), nil
 }
 
+// CalculateTokenInByTokenOut implements domain.RoutablePool.
+// It calculates the amount of token in given the amount of token out for a transmuter pool.
+// Transmuter pool allows no slippage swaps. It just returns the same amount of token in as token out
+// Returns error if:
+// - the underlying chain pool set on the routable pool is not of transmuter type
+// - the token out amount is greater than the balance of the token out
+// - the token out amount is greater than the balance of the token in
+func (r *routableTransmuterPoolImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	poolType := r.GetType()
+
+	// Ensure that the pool is cosmwasm
+	if poolType != poolmanagertypes.CosmWasm {
+		return sdk.Coin{}, domain.InvalidPoolTypeError{PoolType: int32(poolType)}
+	}
+
+	balances := r.Balances
+
+	// Validate token out balance
+	if err := validateBalance(tokenOut.Amount, balances, tokenOut.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Validate token in balance
+	if err := validateBalance(tokenOut.Amount, balances, tokenInDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// No slippage swaps - just return the same amount of token in as token out
+	// as long as there is enough liquidity in the pool.
+	return sdk.NewCoin(tokenInDenom, tokenOut.Amount), nil
+}
+
+// CalculateTokenOutByTokenInWithGas implements domain.RoutablePool.
+// Transmuter swaps are a fixed 1:1 exchange and cheaper than general CosmWasm pools.
+func (r *routableTransmuterPoolImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (sdk.Coin, uint64, error) {
+	tokenOut, err := r.CalculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	return tokenOut, transmuterSwapGas, nil
+}
+
 // GetTokenOutDenom implements RoutablePool.
 func (r *routableTransmuterPoolImpl) GetTokenOutDenom() string {
 	return r.TokenOutDenom
@@ -90,6 +132,12 @@ func (r *routableTransmuterPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (in
 	return tokenIn
 }
 
+// ChargeTakerFeeExactOut implements domain.RoutablePool.
+// Returns tokenInAmount and does not charge any fee for transmuter pools.
+func (r *routableTransmuterPoolImpl) ChargeTakerFeeExactOut(tokenIn sdk.Coin) (inAmountAfterFee sdk.Coin) {
+	return tokenIn
+}
+
 // validateBalance validates that the balance of the denom to validate is greater than the token in amount.
 // Returns nil on success, error otherwise.
 func validateBalance(tokenInAmount osmomath.Int, balances sdk.Coins, denomToValidate string) error {
@@ -62,7 +62,7 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_Transmuter() {
 			poolType := cosmwasmPool.GetType()
 
 			mock := &mocks.MockRoutablePool{ChainPoolModel: cosmwasmPool.AsSerializablePool(), Balances: tc.balances, PoolType: poolType}
-			routablePool := pools.NewRoutablePool(mock, tc.tokenOutDenom, noTakerFee)
+			routablePool := pools.NewRoutablePool(mock, tc.tokenOutDenom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
 
 			// Overwrite pool type for edge case testing
 			if tc.isInvalidPoolType {
@@ -83,3 +83,69 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_Transmuter() {
 		})
 	}
 }
+
+// Tests no slippage exact amount out quotes and validation edge cases around transmuter pools.
+func (s *RoutablePoolTestSuite) TestCalculateTokenInByTokenOut_Transmuter() {
+	defaultAmount := DefaultAmt0
+	defaultBalances := sdk.NewCoins(sdk.NewCoin(USDC, defaultAmount), sdk.NewCoin(ETH, defaultAmount))
+
+	tests := map[string]struct {
+		tokenOut     sdk.Coin
+		tokenInDenom string
+		balances     sdk.Coins
+		expectError  error
+	}{
+		"valid transmuter quote": {
+			tokenOut:     sdk.NewCoin(ETH, defaultAmount),
+			tokenInDenom: USDC,
+			balances:     defaultBalances,
+		},
+		"error: token out is larger than balance of token out": {
+			tokenOut:     sdk.NewCoin(ETH, defaultAmount.Add(osmomath.OneInt())),
+			tokenInDenom: USDC,
+			balances:     defaultBalances,
+
+			expectError: domain.TransmuterInsufficientBalanceError{
+				Denom:         ETH,
+				BalanceAmount: defaultAmount.String(),
+				Amount:        defaultAmount.Add(osmomath.OneInt()).String(),
+			},
+		},
+		"error: token out is larger than balance of token in": {
+			tokenOut:     sdk.NewCoin(ETH, defaultAmount),
+			tokenInDenom: USDC,
+
+			// Make token in amount 1 smaller than the default amount
+			balances: sdk.NewCoins(sdk.NewCoin(USDC, defaultAmount.Sub(osmomath.OneInt())), sdk.NewCoin(ETH, defaultAmount)),
+
+			expectError: domain.TransmuterInsufficientBalanceError{
+				Denom:         USDC,
+				BalanceAmount: defaultAmount.Sub(osmomath.OneInt()).String(),
+				Amount:        defaultAmount.String(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			cosmwasmPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{tc.tokenInDenom, tc.tokenOut.Denom})
+
+			mock := &mocks.MockRoutablePool{ChainPoolModel: cosmwasmPool.AsSerializablePool(), Balances: tc.balances, PoolType: cosmwasmPool.GetType()}
+			routablePool := pools.NewRoutablePool(mock, tc.tokenOut.Denom, noTakerFee, domain.CosmWasmPoolRouterConfig{})
+
+			tokenIn, err := routablePool.CalculateTokenInByTokenOut(tc.tokenOut, tc.tokenInDenom)
+
+			if tc.expectError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expectError)
+				return
+			}
+			s.Require().NoError(err)
+
+			// No slippage swaps on success
+			s.Require().Equal(sdk.NewCoin(tc.tokenInDenom, tc.tokenOut.Amount), tokenIn)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+)
+
+// quoteExactAmountOutImpl is a quote for swapping the computed amount in
+// for an exact amount out.
+// The output maps directly onto poolmanager's MsgSplitRouteSwapExactAmountOut:
+// - AmountIn is the token in max amount before slippage
+// - AmountOut denom is the token out denom
+// - each route's pools contain the pool ID and token in denom
+// - each route's out amount is the token out amount of the split route
+type quoteExactAmountOutImpl struct {
+	AmountIn     sdk.Coin            "json:\"amount_in\""
+	AmountOut    sdk.Coin            "json:\"amount_out\""
+	Route        []domain.SplitRoute "json:\"route\""
+	EffectiveFee osmomath.Dec        "json:\"effective_fee\""
+	PriceImpact  osmomath.Dec        "json:\"price_impact\""
+}
+
+var _ domain.Quote = &quoteExactAmountOutImpl{}
+
+// PrepareResult implements domain.Quote.
+// PrepareResult mutates the quote to prepare
+// it with the data formatted for output to the client.
+// Specifically:
+// It strips away unnecessary fields from each pool in the route
+// and sets the token in denom on each pool.
+// Computes an effective spread factor from all routes.
+//
+// Returns the updated route and the effective spread factor.
+func (q *quoteExactAmountOutImpl) PrepareResult() ([]domain.SplitRoute, osmomath.Dec) {
+	totalAmountOut := q.AmountOut.Amount.ToLegacyDec()
+	totalFeeAcrossRoutes := osmomath.ZeroDec()
+
+	totalSpotPriceInOverOut := osmomath.ZeroDec()
+	totalEffectiveSpotPriceInOverOut := osmomath.ZeroDec()
+
+	for i, route := range q.Route {
+		routeTotalFee := osmomath.ZeroDec()
+		routeAmountOutFraction := route.GetAmountOut().ToLegacyDec().Quo(totalAmountOut)
+
+		// Calculate the spread factor across pools in the route
+		for _, pool := range route.GetPools() {
+			poolSpreadFactor := pool.GetSpreadFactor()
+			poolTakerFee := pool.GetTakerFee()
+
+			totalPoolFee := poolSpreadFactor.Add(poolTakerFee)
+
+			routeTotalFee.AddMut(
+				//  (1 - routeSpreadFactor) * poolSpreadFactor
+				osmomath.OneDec().SubMut(routeTotalFee).MulTruncateMut(totalPoolFee),
+			)
+		}
+
+		// Update the spread factor pro-rated by the amount out
+		totalFeeAcrossRoutes.AddMut(routeTotalFee.MulMut(routeAmountOutFraction))
+
+		routeTokenOut := sdk.NewCoin(q.AmountOut.Denom, route.GetAmountOut())
+		routeSpotPriceInOverOut, effectiveSpotPriceInOverOut, err := q.Route[i].PrepareResultPoolsExactAmountOut(routeTokenOut, q.AmountIn.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		totalSpotPriceInOverOut = totalSpotPriceInOverOut.AddMut(routeSpotPriceInOverOut.MulMut(routeAmountOutFraction))
+		totalEffectiveSpotPriceInOverOut = totalEffectiveSpotPriceInOverOut.AddMut(effectiveSpotPriceInOverOut.MulMut(routeAmountOutFraction))
+	}
+
+	// Calculate price impact
+	if !totalSpotPriceInOverOut.IsZero() {
+		q.PriceImpact = totalEffectiveSpotPriceInOverOut.Quo(totalSpotPriceInOverOut).SubMut(one)
+	}
+
+	q.EffectiveFee = totalFeeAcrossRoutes
+
+	return q.Route, q.EffectiveFee
+}
+
+// GetAmountIn implements domain.Quote.
+func (q *quoteExactAmountOutImpl) GetAmountIn() sdk.Coin {
+	return q.AmountIn
+}
+
+// GetAmountOut implements domain.Quote.
+func (q *quoteExactAmountOutImpl) GetAmountOut() osmomath.Int {
+	return q.AmountOut.Amount
+}
+
+// GetRoute implements domain.Quote.
+func (q *quoteExactAmountOutImpl) GetRoute() []domain.SplitRoute {
+	return q.Route
+}
+
+// GetEffectiveSpreadFactor implements domain.Quote.
+func (q *quoteExactAmountOutImpl) GetEffectiveSpreadFactor() osmomath.Dec {
+	return q.EffectiveFee
+}
+
+// GetPriceImpact implements domain.Quote.
+func (q *quoteExactAmountOutImpl) GetPriceImpact() osmomath.Dec {
+	return q.PriceImpact
+}
+
+// String implements domain.Quote.
+func (q *quoteExactAmountOutImpl) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("Quote: %s in for %s out \n", q.AmountIn, q.AmountOut))
+
+	for _, route := range q.Route {
+		builder.WriteString(route.String())
+	}
+
+	return builder.String()
+}
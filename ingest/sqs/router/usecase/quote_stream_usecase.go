@@ -0,0 +1,376 @@
+package usecase
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+)
+
+var _ mvc.QuoteStreamUsecase = &quoteStreamUseCaseImpl{}
+
+// quoteStreamUseCaseImpl recomputes the quotes for all subscribed token pairs
+// once per committed block and pushes them to the subscribers.
+//
+// Subscribers of the same token pair share a single topic so that each quote is computed
+// once per block regardless of the number of subscribers.
+// Each topic retains the updates of the last ResumeWindowBlocks blocks for resuming from a height.
+// Topics without subscribers keep being computed until they fall out of the resume window.
+type quoteStreamUseCaseImpl struct {
+	contextTimeout time.Duration
+	routerUsecase  mvc.RouterUsecase
+	config         domain.QuoteStreamConfig
+	logger         log.Logger
+
+	// latestNotifiedHeight is the latest height that NotifyBlock was called with.
+	latestNotifiedHeight atomic.Uint64
+	// blockNotifications signals the run loop that a new block has been committed.
+	// Buffered with size one so that notifications arriving while the quotes are being
+	// computed are conflated into one.
+	blockNotifications chan struct{}
+
+	// mu protects all fields below as well as the topics and their subscribers.
+	mu sync.Mutex
+	// processedHeight is the latest height that the quotes were computed at.
+	processedHeight uint64
+	topics          map[quoteTopicKey]*quoteTopic
+	// clientTopics is the number of connections of each client subscribed to each topic.
+	clientTopics map[string]map[quoteTopicKey]int
+}
+
+// quoteTopicKey uniquely identifies a quote topic.
+type quoteTopicKey struct {
+	tokenIn       string
+	tokenOutDenom string
+}
+
+// quoteTopic holds the subscribers and the retained updates of a single token pair.
+type quoteTopic struct {
+	subscription domain.QuoteSubscription
+	subscribers  map[*quoteSubscriber]struct{}
+	// history is the retained updates in ascending height order.
+	history []domain.QuoteUpdate
+	// lastActiveHeight is the latest height at which the topic had subscribers.
+	lastActiveHeight uint64
+}
+
+// quoteSubscriber is a single connection subscribed to one or more topics.
+type quoteSubscriber struct {
+	updates chan domain.QuoteUpdate
+}
+
+// NewQuoteStreamUsecase returns a new quote stream use case and starts computing
+// quotes on block notifications until ctx is done.
+// The buffer size and the resume window are raised to at least one.
+func NewQuoteStreamUsecase(ctx context.Context, timeout time.Duration, routerUsecase mvc.RouterUsecase, config domain.QuoteStreamConfig, logger log.Logger) mvc.QuoteStreamUsecase {
+	if config.BufferSize < 1 {
+		config.BufferSize = 1
+	}
+	if config.ResumeWindowBlocks < 1 {
+		config.ResumeWindowBlocks = 1
+	}
+
+	us := &quoteStreamUseCaseImpl{
+		contextTimeout: timeout,
+		routerUsecase:  routerUsecase,
+		config:         config,
+		logger:         logger,
+
+		blockNotifications: make(chan struct{}, 1),
+		topics:             make(map[quoteTopicKey]*quoteTopic),
+		clientTopics:       make(map[string]map[quoteTopicKey]int),
+	}
+
+	go us.run(ctx)
+
+	return us
+}
+
+// NotifyBlock implements mvc.QuoteStreamUsecase.
+func (q *quoteStreamUseCaseImpl) NotifyBlock(height uint64) {
+	q.latestNotifiedHeight.Store(height)
+
+	select {
+	case q.blockNotifications <- struct{}{}:
+	default:
+		// A notification is already pending. The run loop picks up the latest height.
+	}
+}
+
+// Subscribe implements mvc.QuoteStreamUsecase.
+func (q *quoteStreamUseCaseImpl) Subscribe(ctx context.Context, clientID string, subscriptions []domain.QuoteSubscription, fromHeight uint64) (<-chan domain.QuoteUpdate, error) {
+	if len(subscriptions) == 0 || len(subscriptions) > q.config.MaxSubscriptionsPerConnection {
+		return nil, domain.QuoteStreamSubscriptionLimitError{
+			NumSubscriptions: len(subscriptions),
+			MaxSubscriptions: q.config.MaxSubscriptionsPerConnection,
+		}
+	}
+
+	// Deduplicate the subscriptions within the same connection.
+	keys := make([]quoteTopicKey, 0, len(subscriptions))
+	subscriptionByKey := make(map[quoteTopicKey]domain.QuoteSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		key := quoteTopicKey{tokenIn: subscription.TokenIn.String(), tokenOutDenom: subscription.TokenOutDenom}
+		if _, ok := subscriptionByKey[key]; ok {
+			continue
+		}
+		subscriptionByKey[key] = subscription
+		keys = append(keys, key)
+	}
+
+	subscriber := &quoteSubscriber{
+		updates: make(chan domain.QuoteUpdate, q.config.BufferSize),
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := q.validateTopicLimits(clientID, keys); err != nil {
+		return nil, err
+	}
+
+	clientTopics, ok := q.clientTopics[clientID]
+	if !ok {
+		clientTopics = make(map[quoteTopicKey]int, len(keys))
+		q.clientTopics[clientID] = clientTopics
+	}
+
+	subscribedTopics := make([]*quoteTopic, 0, len(keys))
+	for _, key := range keys {
+		topic, ok := q.topics[key]
+		if !ok {
+			topic = &quoteTopic{
+				subscription: subscriptionByKey[key],
+				subscribers:  make(map[*quoteSubscriber]struct{}),
+			}
+			q.topics[key] = topic
+		}
+
+		topic.subscribers[subscriber] = struct{}{}
+		topic.lastActiveHeight = q.processedHeight
+		subscribedTopics = append(subscribedTopics, topic)
+		clientTopics[key]++
+
+		q.replay(topic, subscriber, fromHeight)
+
+		// Compute the quote for the topic right away instead of waiting for the next block.
+		if q.processedHeight > 0 && topic.latestHeight() < q.processedHeight {
+			go q.computeAndPublish(ctx, topic, q.processedHeight)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		for _, topic := range subscribedTopics {
+			delete(topic.subscribers, subscriber)
+		}
+
+		for _, key := range keys {
+			clientTopics[key]--
+			if clientTopics[key] == 0 {
+				delete(clientTopics, key)
+			}
+		}
+		if len(clientTopics) == 0 {
+			delete(q.clientTopics, clientID)
+		}
+
+		// Closed under the lock so that no publish can race with it.
+		close(subscriber.updates)
+	}()
+
+	return subscriber.updates, nil
+}
+
+// validateTopicLimits returns error if subscribing the client to the given topics
+// would exceed either the global or the per-client topic limit.
+// Idle topics retained for resuming count towards the global limit since their quotes
+// keep being computed until they are evicted.
+// CONTRACT: q.mu is held.
+func (q *quoteStreamUseCaseImpl) validateTopicLimits(clientID string, keys []quoteTopicKey) error {
+	numTopics := len(q.topics)
+	numClientTopics := len(q.clientTopics[clientID])
+	for _, key := range keys {
+		if _, ok := q.topics[key]; !ok {
+			numTopics++
+		}
+		if _, ok := q.clientTopics[clientID][key]; !ok {
+			numClientTopics++
+		}
+	}
+
+	if numTopics > q.config.MaxTopics {
+		return domain.QuoteStreamTopicLimitError{
+			NumTopics: numTopics,
+			MaxTopics: q.config.MaxTopics,
+		}
+	}
+
+	if numClientTopics > q.config.MaxTopicsPerClient {
+		return domain.QuoteStreamClientTopicLimitError{
+			ClientID:  clientID,
+			NumTopics: numClientTopics,
+			MaxTopics: q.config.MaxTopicsPerClient,
+		}
+	}
+
+	return nil
+}
+
+// replay pushes the retained updates of the topic to the subscriber.
+// If fromHeight is zero, only the latest update is pushed.
+// Otherwise, all retained updates with height greater than fromHeight are pushed.
+// CONTRACT: q.mu is held.
+func (q *quoteStreamUseCaseImpl) replay(topic *quoteTopic, subscriber *quoteSubscriber, fromHeight uint64) {
+	if len(topic.history) == 0 {
+		return
+	}
+
+	if fromHeight == 0 {
+		subscriber.push(topic.history[len(topic.history)-1])
+		return
+	}
+
+	for _, update := range topic.history {
+		if update.Height > fromHeight {
+			subscriber.push(update)
+		}
+	}
+}
+
+// run computes the quotes for all topics on every block notification until ctx is done.
+func (q *quoteStreamUseCaseImpl) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.blockNotifications:
+			q.processBlock(ctx, q.latestNotifiedHeight.Load())
+		}
+	}
+}
+
+// processBlock evicts the idle topics and computes the quotes for the remaining topics
+// at the given height with bounded concurrency.
+func (q *quoteStreamUseCaseImpl) processBlock(ctx context.Context, height uint64) {
+	q.mu.Lock()
+	if height <= q.processedHeight {
+		q.mu.Unlock()
+		return
+	}
+	q.processedHeight = height
+
+	topics := make([]*quoteTopic, 0, len(q.topics))
+	for key, topic := range q.topics {
+		if len(topic.subscribers) > 0 {
+			topic.lastActiveHeight = height
+		} else if height-topic.lastActiveHeight > uint64(q.config.ResumeWindowBlocks) {
+			delete(q.topics, key)
+			continue
+		}
+
+		topics = append(topics, topic)
+	}
+	q.mu.Unlock()
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, runtime.NumCPU())
+	)
+	for _, topic := range topics {
+		semaphore <- struct{}{}
+		wg.Add(1)
+
+		go func(topic *quoteTopic) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			q.computeAndPublish(ctx, topic, height)
+		}(topic)
+	}
+
+	wg.Wait()
+}
+
+// computeAndPublish computes the optimal quote for the topic and publishes it
+// to all of the topic's subscribers.
+// Quote errors are published to the subscribers rather than terminating the stream.
+func (q *quoteStreamUseCaseImpl) computeAndPublish(ctx context.Context, topic *quoteTopic, height uint64) {
+	ctx, cancel := context.WithTimeout(ctx, q.contextTimeout)
+	defer cancel()
+
+	update := domain.QuoteUpdate{
+		Height:        height,
+		TokenIn:       topic.subscription.TokenIn,
+		TokenOutDenom: topic.subscription.TokenOutDenom,
+	}
+
+	quote, err := q.routerUsecase.GetOptimalQuote(ctx, topic.subscription.TokenIn, topic.subscription.TokenOutDenom)
+	if err != nil {
+		q.logger.Debug("failed to compute streamed quote", zap.Uint64("height", height), zap.Stringer("token_in", topic.subscription.TokenIn), zap.String("token_out_denom", topic.subscription.TokenOutDenom), zap.Error(err))
+		update.Error = err.Error()
+	} else {
+		// Prepared once and shared by all subscribers.
+		quote.PrepareResult()
+		update.Quote = quote
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// A quote for this or a later height has already been published.
+	if height <= topic.latestHeight() {
+		return
+	}
+
+	topic.history = append(topic.history, update)
+	if len(topic.history) > q.config.ResumeWindowBlocks {
+		topic.history = topic.history[len(topic.history)-q.config.ResumeWindowBlocks:]
+	}
+
+	for subscriber := range topic.subscribers {
+		subscriber.push(update)
+	}
+}
+
+// latestHeight returns the height of the latest retained update.
+// Returns zero if there are no retained updates.
+// CONTRACT: the quote stream mutex is held.
+func (t *quoteTopic) latestHeight() uint64 {
+	if len(t.history) == 0 {
+		return 0
+	}
+	return t.history[len(t.history)-1].Height
+}
+
+// push pushes the update to the subscriber without blocking.
+// If the subscriber's buffer is full, the oldest pending update is dropped
+// so that slow consumers always observe the most recent quotes.
+// CONTRACT: the quote stream mutex is held.
+func (s *quoteSubscriber) push(update domain.QuoteUpdate) {
+	for {
+		select {
+		case s.updates <- update:
+			return
+		default:
+		}
+
+		select {
+		case <-s.updates:
+		default:
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+)
+
+// routerUsecaseStub returns a quote with the amount out equal to the amount in.
+type routerUsecaseStub struct {
+	mvc.RouterUsecase
+}
+
+func (*routerUsecaseStub) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error) {
+	return &usecase.QuoteImpl{AmountIn: tokenIn, AmountOut: tokenIn.Amount}, nil
+}
+
+const defaultClientID = "127.0.0.1"
+
+var (
+	defaultQuoteSubscription = domain.QuoteSubscription{
+		TokenIn:       sdk.NewCoin("uosmo", sdk.NewInt(1_000_000)),
+		TokenOutDenom: "uion",
+	}
+	otherQuoteSubscription = domain.QuoteSubscription{
+		TokenIn:       sdk.NewCoin("uion", sdk.NewInt(1_000_000)),
+		TokenOutDenom: "uosmo",
+	}
+)
+
+// Tests that the number of subscriptions per connection is validated.
+func (s *RouterTestSuite) TestQuoteStream_SubscriptionLimit() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	quoteStreamUsecase := usecase.NewQuoteStreamUsecase(ctx, time.Second, &routerUsecaseStub{}, domain.QuoteStreamConfig{
+		MaxSubscriptionsPerConnection: 2,
+		MaxTopics:                     1,
+		MaxTopicsPerClient:            1,
+		BufferSize:                    1,
+		ResumeWindowBlocks:            1,
+	}, &log.NoOpLogger{})
+
+	_, err := quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{}, 0)
+	s.Require().ErrorIs(err, domain.QuoteStreamSubscriptionLimitError{NumSubscriptions: 0, MaxSubscriptions: 2})
+
+	_, err = quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription, defaultQuoteSubscription, defaultQuoteSubscription}, 0)
+	s.Require().ErrorIs(err, domain.QuoteStreamSubscriptionLimitError{NumSubscriptions: 3, MaxSubscriptions: 2})
+
+	_, err = quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription, defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+}
+
+// Tests that the number of distinct topics is limited both globally and per client
+// across all of the client's connections.
+func (s *RouterTestSuite) TestQuoteStream_TopicLimits() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	quoteStreamUsecase := usecase.NewQuoteStreamUsecase(ctx, time.Second, &routerUsecaseStub{}, domain.QuoteStreamConfig{
+		MaxSubscriptionsPerConnection: 2,
+		MaxTopics:                     2,
+		MaxTopicsPerClient:            1,
+		BufferSize:                    1,
+		ResumeWindowBlocks:            1,
+	}, &log.NoOpLogger{})
+
+	// Both subscriptions are new to the client.
+	_, err := quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription, otherQuoteSubscription}, 0)
+	s.Require().ErrorIs(err, domain.QuoteStreamClientTopicLimitError{ClientID: defaultClientID, NumTopics: 2, MaxTopics: 1})
+
+	clientCtx, clientCancel := context.WithCancel(ctx)
+	_, err = quoteStreamUsecase.Subscribe(clientCtx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	// Another connection of the same client to the same topic does not count towards the limit.
+	_, err = quoteStreamUsecase.Subscribe(clientCtx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	// Another connection of the same client to a different topic does.
+	_, err = quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{otherQuoteSubscription}, 0)
+	s.Require().ErrorIs(err, domain.QuoteStreamClientTopicLimitError{ClientID: defaultClientID, NumTopics: 2, MaxTopics: 1})
+
+	// Other clients are not affected by the per-client limit.
+	_, err = quoteStreamUsecase.Subscribe(ctx, "other", []domain.QuoteSubscription{otherQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	// Global limit is reached.
+	thirdQuoteSubscription := domain.QuoteSubscription{TokenIn: sdk.NewCoin("uatom", sdk.NewInt(1)), TokenOutDenom: "uosmo"}
+	_, err = quoteStreamUsecase.Subscribe(ctx, "third", []domain.QuoteSubscription{thirdQuoteSubscription}, 0)
+	s.Require().ErrorIs(err, domain.QuoteStreamTopicLimitError{NumTopics: 3, MaxTopics: 2})
+
+	// Subscribing to an existing topic is allowed at the global limit.
+	_, err = quoteStreamUsecase.Subscribe(ctx, "third", []domain.QuoteSubscription{otherQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	// Once all connections of the client are closed, the client may subscribe to a different topic.
+	clientCancel()
+	s.Require().Eventually(func() bool {
+		_, err := quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{otherQuoteSubscription}, 0)
+		return err == nil
+	}, time.Second, time.Millisecond)
+}
+
+// Tests that quotes are pushed on every block notification and that
+// a subscriber may resume from a height within the resume window.
+func (s *RouterTestSuite) TestQuoteStream_NotifyAndResume() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	quoteStreamUsecase := usecase.NewQuoteStreamUsecase(ctx, time.Second, &routerUsecaseStub{}, domain.QuoteStreamConfig{
+		MaxSubscriptionsPerConnection: 1,
+		MaxTopics:                     1,
+		MaxTopicsPerClient:            1,
+		BufferSize:                    10,
+		ResumeWindowBlocks:            2,
+	}, &log.NoOpLogger{})
+
+	subscriberCtx, subscriberCancel := context.WithCancel(ctx)
+	updates, err := quoteStreamUsecase.Subscribe(subscriberCtx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	for height := uint64(1); height <= 3; height++ {
+		quoteStreamUsecase.NotifyBlock(height)
+
+		update := s.receiveQuoteUpdate(updates)
+		s.Require().Equal(height, update.Height)
+		s.Require().Empty(update.Error)
+		s.Require().Equal(defaultQuoteSubscription.TokenIn, update.TokenIn)
+		s.Require().Equal(defaultQuoteSubscription.TokenOutDenom, update.TokenOutDenom)
+		s.Require().Equal(defaultQuoteSubscription.TokenIn.Amount, update.Quote.GetAmountOut())
+	}
+
+	// Disconnect and validate that the channel is closed.
+	subscriberCancel()
+	s.Require().Eventually(func() bool {
+		_, ok := <-updates
+		return !ok
+	}, time.Second, time.Millisecond)
+
+	// Resume from height 1. Height 1 is outside of the resume window.
+	updates, err = quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), s.receiveQuoteUpdate(updates).Height)
+	s.Require().Equal(uint64(3), s.receiveQuoteUpdate(updates).Height)
+
+	// Without a height, only the latest update is replayed.
+	updates, err = quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), s.receiveQuoteUpdate(updates).Height)
+	s.Require().Empty(updates)
+}
+
+// Tests that a slow subscriber does not block the stream and observes the latest quote
+// once its buffer is full.
+func (s *RouterTestSuite) TestQuoteStream_Backpressure() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	quoteStreamUsecase := usecase.NewQuoteStreamUsecase(ctx, time.Second, &routerUsecaseStub{}, domain.QuoteStreamConfig{
+		MaxSubscriptionsPerConnection: 1,
+		MaxTopics:                     1,
+		MaxTopicsPerClient:            1,
+		BufferSize:                    1,
+		ResumeWindowBlocks:            10,
+	}, &log.NoOpLogger{})
+
+	slowUpdates, err := quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	// Used to wait for each block to be processed.
+	syncUpdates, err := quoteStreamUsecase.Subscribe(ctx, defaultClientID, []domain.QuoteSubscription{defaultQuoteSubscription}, 0)
+	s.Require().NoError(err)
+
+	for height := uint64(1); height <= 3; height++ {
+		quoteStreamUsecase.NotifyBlock(height)
+		s.Require().Equal(height, s.receiveQuoteUpdate(syncUpdates).Height)
+	}
+
+	s.Require().Len(slowUpdates, 1)
+	s.Require().Equal(uint64(3), s.receiveQuoteUpdate(slowUpdates).Height)
+}
+
+// receiveQuoteUpdate receives the next quote update, failing the test
+// if none is received within a second.
+func (s *RouterTestSuite) receiveQuoteUpdate(updates <-chan domain.QuoteUpdate) domain.QuoteUpdate {
+	select {
+	case update, ok := <-updates:
+		s.Require().True(ok)
+		return update
+	case <-time.After(time.Second):
+		s.FailNow("timed out waiting for quote update")
+		return domain.QuoteUpdate{}
+	}
+}
@@ -128,11 +128,13 @@ func (s *RouterTestSuite) TestPrepareResult() {
 							domain.NewPool(poolOne, poolOne.GetSpreadFactor(sdk.Context{}), poolOneBalances),
 							USDT,
 							takerFeeOne,
+							domain.CosmWasmPoolRouterConfig{},
 						),
 						pools.NewRoutablePool(
 							domain.NewPool(poolTwo, poolTwo.GetSpreadFactor(sdk.Context{}), poolTwoBalances),
 							USDC,
 							takerFeeTwo,
+							domain.CosmWasmPoolRouterConfig{},
 						),
 					},
 				},
@@ -149,6 +151,7 @@ func (s *RouterTestSuite) TestPrepareResult() {
 							domain.NewPool(poolThree, poolThree.GetSpreadFactor(sdk.Context{}), poolThreeBalances),
 							USDC,
 							takerFeeThree,
+							domain.CosmWasmPoolRouterConfig{},
 						),
 					},
 				},
@@ -69,13 +69,63 @@ func (r *RouteImpl) PrepareResultPools(tokenIn sdk.Coin) (osmomath.Dec, osmomath
 	return routeSpotPriceInOverOut, effectiveSpotPriceInOverOut, nil
 }
 
+// PrepareResultPoolsExactAmountOut implements domain.Route.
+// Strips away unnecessary fields from each pool in the route,
+// leaving only the data needed by client. In addition to the fields
+// returned by PrepareResultPools, it sets the token in denom on each pool.
+// Note that it mutates the route.
+// Returns spot price before swap and the effective spot price
+func (r *RouteImpl) PrepareResultPoolsExactAmountOut(tokenOut sdk.Coin, tokenInDenom string) (osmomath.Dec, osmomath.Dec, error) {
+	var (
+		routeSpotPriceInOverOut     = osmomath.OneDec()
+		effectiveSpotPriceInOverOut = osmomath.OneDec()
+	)
+
+	// Iterate from the last pool to the first since the token out is known.
+	for i := len(r.Pools) - 1; i >= 0; i-- {
+		pool := r.Pools[i]
+
+		poolTokenInDenom := r.getPoolTokenInDenom(i, tokenInDenom)
+
+		// Compute spot price before swap.
+		spotPriceInOverOut, err := pool.CalcSpotPrice(tokenOut.Denom, poolTokenInDenom)
+		if err != nil {
+			return osmomath.Dec{}, osmomath.Dec{}, err
+		}
+
+		tokenIn, err := pool.CalculateTokenInByTokenOut(tokenOut, poolTokenInDenom)
+		if err != nil {
+			return osmomath.Dec{}, osmomath.Dec{}, err
+		}
+
+		// Update effective spot price
+		effectiveSpotPriceInOverOut.MulMut(tokenIn.Amount.ToLegacyDec().QuoMut(tokenOut.Amount.ToLegacyDec()))
+
+		// Note, in the future we may want to increase the precision of the spot price
+		routeSpotPriceInOverOut.MulMut(spotPriceInOverOut.Dec())
+
+		r.Pools[i] = pools.NewExactAmountOutRoutableResultPool(
+			pool.GetId(),
+			pool.GetType(),
+			pool.GetSpreadFactor(),
+			poolTokenInDenom,
+			pool.GetTokenOutDenom(),
+			pool.GetTakerFee(),
+		)
+
+		// Charge taker fee
+		tokenOut = pool.ChargeTakerFeeExactOut(tokenIn)
+	}
+	return routeSpotPriceInOverOut, effectiveSpotPriceInOverOut, nil
+}
+
 // GetPools implements Route.
 func (r *RouteImpl) GetPools() []domain.RoutablePool {
 	return r.Pools
 }
 
-func (r *RouteImpl) AddPool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.Dec) {
-	r.Pools = append(r.Pools, pools.NewRoutablePool(pool, tokenOutDenom, takerFee))
+func (r *RouteImpl) AddPool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.Dec, cosmWasmConfig domain.CosmWasmPoolRouterConfig) {
+	r.Pools = append(r.Pools, pools.NewRoutablePool(pool, tokenOutDenom, takerFee, cosmWasmConfig))
 }
 
 // CalculateTokenOutByTokenIn implements Route.
@@ -103,6 +153,74 @@ func (r *RouteImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (tokenOut sdk.C
 	return tokenOut, nil
 }
 
+// CalculateTokenOutByTokenInWithGas implements Route.
+// The gas of each pool is estimated on the token in to the pool after the taker fee is charged
+// by the same computation that produces the pool's token out.
+func (r *RouteImpl) CalculateTokenOutByTokenInWithGas(tokenIn sdk.Coin) (tokenOut sdk.Coin, gas uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tokenOut = sdk.Coin{}
+			gas = 0
+			err = fmt.Errorf("error when calculating out by in with gas in route: %v", r)
+		}
+	}()
+
+	for _, pool := range r.Pools {
+		// Charge taker fee
+		tokenIn = pool.ChargeTakerFeeExactIn(tokenIn)
+
+		var poolGas uint64
+		tokenOut, poolGas, err = pool.CalculateTokenOutByTokenInWithGas(tokenIn)
+		if err != nil {
+			return sdk.Coin{}, 0, err
+		}
+
+		gas += poolGas
+
+		tokenIn = tokenOut
+	}
+
+	return tokenOut, gas, nil
+}
+
+// CalculateTokenInByTokenOut implements Route.
+// Iterates over the pools in reverse order, estimating the token in
+// of each pool from the token out of the next pool.
+func (r *RouteImpl) CalculateTokenInByTokenOut(tokenOut sdk.Coin, tokenInDenom string) (tokenIn sdk.Coin, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tokenIn = sdk.Coin{}
+			err = fmt.Errorf("error when calculating in by out in route: %v", r)
+		}
+	}()
+
+	for i := len(r.Pools) - 1; i >= 0; i-- {
+		pool := r.Pools[i]
+
+		tokenIn, err = pool.CalculateTokenInByTokenOut(tokenOut, r.getPoolTokenInDenom(i, tokenInDenom))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		// Charge taker fee
+		tokenIn = pool.ChargeTakerFeeExactOut(tokenIn)
+
+		tokenOut = tokenIn
+	}
+
+	return tokenIn, nil
+}
+
+// getPoolTokenInDenom returns the token in denom of the pool at the given index.
+// The token in denom of the first pool is the route token in denom.
+// For all other pools, it is the token out denom of the previous pool.
+func (r *RouteImpl) getPoolTokenInDenom(poolIndex int, routeTokenInDenom string) string {
+	if poolIndex == 0 {
+		return routeTokenInDenom
+	}
+	return r.Pools[poolIndex-1].GetTokenOutDenom()
+}
+
 // String implements domain.Route.
 func (r *RouteImpl) String() string {
 	var strBuilder strings.Builder
@@ -33,6 +33,11 @@ type Router struct {
 
 	poolsUsecase mvc.PoolsUsecase
 
+	// The price of a single unit of gas in uosmo.
+	// Routes are ranked and split by the amount out after the gas cost is deducted.
+	// Gas is not accounted for if zero.
+	gasPriceUOSMO osmomath.Dec
+
 	// The logger.
 	logger log.Logger
 }
@@ -69,6 +74,7 @@ func NewRouter(preferredPoolIDs []uint64, maxHops, maxRoutes, maxSplitRoutes, ma
 		maxSplitIterations: maxSplitIterations,
 		maxSplitRoutes:     maxSplitRoutes,
 		minOSMOTVL:         minOSMOTVL,
+		gasPriceUOSMO:      osmomath.ZeroDec(),
 	}
 }
 
@@ -207,3 +213,9 @@ func sortPools(pools []domain.PoolI, totalTVL osmomath.Int, preferredPoolIDsMap
 	}
 	return pools
 }
+
+// WithGasPrice instruments router by setting the gas price in uosmo on it and returns the router.
+func WithGasPrice(router *Router, gasPriceUOSMO osmomath.Dec) *Router {
+	router.gasPriceUOSMO = gasPriceUOSMO
+	return router
+}
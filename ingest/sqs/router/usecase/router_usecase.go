@@ -26,9 +26,14 @@ type routerUseCaseImpl struct {
 	contextTimeout   time.Duration
 	routerRepository mvc.RouterRepository
 	poolsUsecase     mvc.PoolsUsecase
+	chainInfoRepo    mvc.ChainInfoRepository
 	config           domain.RouterConfig
 	logger           log.Logger
 
+	// gasPriceUOSMO is the gas price parsed from the config.
+	// Zero if not configured.
+	gasPriceUOSMO osmomath.Dec
+
 	rankedRouteCache *cache.Cache
 }
 
@@ -60,13 +65,23 @@ func init() {
 }
 
 // NewRouterUsecase will create a new pools use case object
-func NewRouterUsecase(timeout time.Duration, routerRepository mvc.RouterRepository, poolsUsecase mvc.PoolsUsecase, config domain.RouterConfig, logger log.Logger, rankedRouteCache *cache.Cache) mvc.RouterUsecase {
+// chainInfoRepo is used for reading the height that the batch quotes are computed at.
+func NewRouterUsecase(timeout time.Duration, routerRepository mvc.RouterRepository, poolsUsecase mvc.PoolsUsecase, chainInfoRepo mvc.ChainInfoRepository, config domain.RouterConfig, logger log.Logger, rankedRouteCache *cache.Cache) mvc.RouterUsecase {
+	gasPriceUOSMO, err := config.GetGasPriceUOSMO()
+	if err != nil {
+		// The config is validated on startup so this should never happen.
+		logger.Error("invalid gas price, routes are ranked without gas", zap.Error(err))
+		gasPriceUOSMO = osmomath.ZeroDec()
+	}
+
 	return &routerUseCaseImpl{
 		contextTimeout:   timeout,
 		routerRepository: routerRepository,
 		poolsUsecase:     poolsUsecase,
+		chainInfoRepo:    chainInfoRepo,
 		config:           config,
 		logger:           logger,
+		gasPriceUOSMO:    gasPriceUOSMO,
 
 		rankedRouteCache: rankedRouteCache,
 	}
@@ -87,7 +102,16 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 	// This is used for caching ranked routes as these might differ depending on the amount swapped in.
 	tokenInOrderOfMagnitude := osmomath.OrderOfMagnitude(tokenIn.Amount.ToLegacyDec())
 
-	rankedRoutesData, hasRankedRoutesInCache := r.rankedRouteCache.Get(formatRankedRouteCacheKey(tokenIn.Denom, tokenOutDenom, tokenInOrderOfMagnitude))
+	// The ranked routes are cached against the latest state only.
+	_, isHistorical := domain.GetHistoricalHeightFromContext(ctx)
+
+	var (
+		rankedRoutesData       interface{}
+		hasRankedRoutesInCache bool
+	)
+	if !isHistorical {
+		rankedRoutesData, hasRankedRoutesInCache = r.rankedRouteCache.Get(formatRankedRouteCacheKey(tokenIn.Denom, tokenOutDenom, tokenInOrderOfMagnitude))
+	}
 
 	var (
 		rankedRoutes        []route.RouteImpl
@@ -146,7 +170,7 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 		// Update ranked routes with filtered ranked routes
 		rankedRoutes = filterDuplicatePoolIDRoutes(rankedRoutes)
 
-		if len(rankedRoutes) > 0 {
+		if len(rankedRoutes) > 0 && !isHistorical {
 			// Convert ranked routes back to candidate for caching
 			candidateRoutes = convertRankedToCandidateRoutes(rankedRoutes)
 
@@ -155,6 +179,15 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 		}
 	}
 
+	return r.selectOptimalQuote(router, topSingleRouteQuote, rankedRoutes, tokenIn)
+}
+
+// selectOptimalQuote returns the better of the top single route quote and
+// the split quote across the ranked routes by the amount out net of the gas cost.
+// Returns error if:
+// - fails to compute the split quote
+// - the optimal quote has no tokens out
+func (r *routerUseCaseImpl) selectOptimalQuote(router *Router, topSingleRouteQuote domain.Quote, rankedRoutes []route.RouteImpl, tokenIn sdk.Coin) (domain.Quote, error) {
 	if len(rankedRoutes) == 1 {
 		return topSingleRouteQuote, nil
 	}
@@ -169,8 +202,14 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 
 	finalQuote := topSingleRouteQuote
 
+	// The quotes are compared by the amount out less the gas cost valued in the token out.
+	// If the router does not account for gas, the gas price is zero and the amounts out are compared.
+	gasPriceInTokenOut := router.getGasPriceInTokenOut(rankedRoutes, tokenIn.Denom)
+	topSplitNetAmountOut := getNetAmountOut(topSplitQuote.GetAmountOut(), getQuoteGasEstimate(topSplitQuote), gasPriceInTokenOut)
+	topSingleRouteNetAmountOut := getNetAmountOut(topSingleRouteQuote.GetAmountOut(), getQuoteGasEstimate(topSingleRouteQuote), gasPriceInTokenOut)
+
 	// If the split route quote is better than the single route quote, return the split route quote
-	if topSplitQuote.GetAmountOut().GT(topSingleRouteQuote.GetAmountOut()) {
+	if topSplitNetAmountOut.GT(topSingleRouteNetAmountOut) {
 		routes := topSplitQuote.GetRoute()
 
 		r.logger.Debug("split route selected", zap.Int("route_count", len(routes)))
@@ -190,6 +229,88 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 	return finalQuote, nil
 }
 
+// GetOptimalQuoteExactAmountOut returns the optimal quote for swapping the minimum amount of tokenInDenom
+// for exactly the given tokenOut by estimating the optimal route(s) through pools on the osmosis network.
+// Similarly to GetOptimalQuote, the candidate routes are retrieved from cache if present.
+// The routes are ranked by the amount in in increasing order and the best single route quote
+// is compared against the best split quote.
+// Returns error if:
+// - fails to retrieve candidate routes
+// - fails to estimate direct quotes for ranked routes
+// - fails to compute the split quote
+func (r *routerUseCaseImpl) GetOptimalQuoteExactAmountOut(ctx context.Context, tokenOut sdk.Coin, tokenInDenom string) (domain.Quote, error) {
+	router := r.initializeRouter()
+
+	candidateRoutes, err := r.handleCandidateRoutes(ctx, router, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		r.logger.Error("error handling routes", zap.Error(err))
+		return nil, err
+	}
+
+	takerFees, err := r.routerRepository.GetAllTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, routeTakerFees, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	topSingleRouteQuote, routesSortedByAmtIn, err := router.estimateAndRankSingleRouteQuoteExactAmountOut(routes, tokenOut, tokenInDenom)
+	if err != nil {
+		r.logger.Error("error getting top routes", zap.Error(err))
+		return nil, err
+	}
+
+	rankedRoutes := make([]route.RouteImpl, 0, len(routesSortedByAmtIn))
+	for _, routeWithAmountIn := range routesSortedByAmtIn {
+		rankedRoutes = append(rankedRoutes, routeWithAmountIn.RouteImpl)
+	}
+
+	// Update ranked routes with filtered ranked routes
+	rankedRoutes = filterDuplicatePoolIDRoutes(rankedRoutes)
+
+	// If split routes are disabled, return the top single route quote.
+	// If there are more routes than the max split routes, keep only the top routes.
+	if r.config.MaxSplitRoutes == 0 {
+		return topSingleRouteQuote, nil
+	} else if len(rankedRoutes) > r.config.MaxSplitRoutes {
+		rankedRoutes = rankedRoutes[:r.config.MaxSplitRoutes]
+	}
+
+	if len(rankedRoutes) == 1 {
+		return topSingleRouteQuote, nil
+	}
+
+	// Compute split route quote
+	topSplitQuote, err := router.GetSplitQuoteExactAmountOut(rankedRoutes, tokenOut, tokenInDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	finalQuote := topSingleRouteQuote
+
+	// If the split route quote requires less token in than the single route quote, return the split route quote
+	if topSplitQuote.GetAmountIn().Amount.LT(topSingleRouteQuote.GetAmountIn().Amount) {
+		routes := topSplitQuote.GetRoute()
+
+		r.logger.Debug("split route selected", zap.Int("route_count", len(routes)))
+		for _, route := range routes {
+			r.logger.Debug("route", zap.Stringer("route", route))
+		}
+
+		finalQuote = topSplitQuote
+	}
+
+	return finalQuote, nil
+}
+
 // filterDuplicatePoolIDRoutes filters routes that contain duplicate pool IDs.
 // CONTRACT: rankedRoutes are sorted in decreasing order by amount out
 // from first to last.
@@ -251,7 +372,12 @@ func (r *routerUseCaseImpl) rankRoutesByDirectQuote(ctx context.Context, router
 		return nil, nil, err
 	}
 
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, tokenIn.Denom, tokenOutDenom)
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, routeTakerFees, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -310,7 +436,12 @@ func (r *routerUseCaseImpl) GetBestSingleRouteQuote(ctx context.Context, tokenIn
 		return nil, err
 	}
 
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, tokenIn.Denom, tokenOutDenom)
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, routeTakerFees, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -333,7 +464,12 @@ func (r *routerUseCaseImpl) GetCustomQuote(ctx context.Context, tokenIn sdk.Coin
 		return nil, err
 	}
 
-	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, tokenIn.Denom, tokenOutDenom)
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, candidateRoutes, takerFees, routeTakerFees, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +544,11 @@ func (r *routerUseCaseImpl) GetTakerFee(ctx context.Context, poolID uint64) ([]d
 		return []domain.TakerFeeForPair{}, err
 	}
 
+	routeTakerFees, err := r.routerRepository.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		return []domain.TakerFeeForPair{}, err
+	}
+
 	pool, err := r.poolsUsecase.GetPool(ctx, poolID)
 	if err != nil {
 		return []domain.TakerFeeForPair{}, err
@@ -415,6 +556,12 @@ func (r *routerUseCaseImpl) GetTakerFee(ctx context.Context, poolID uint64) ([]d
 
 	poolDenoms := pool.GetPoolDenoms()
 
+	// The taker fee override of the pool takes precedence over the route taker fees.
+	var multihopTakerFees domain.RouteTakerFeeMap
+	if pool.GetSQSPoolModel().TakerFeeOverride == nil && len(routeTakerFees) > 0 {
+		multihopTakerFees = routeTakerFees
+	}
+
 	result := make([]domain.TakerFeeForPair, 0)
 
 	for i := range poolDenoms {
@@ -422,12 +569,13 @@ func (r *routerUseCaseImpl) GetTakerFee(ctx context.Context, poolID uint64) ([]d
 			denom0 := poolDenoms[i]
 			denom1 := poolDenoms[j]
 
-			takerFee := takerFees.GetTakerFee(denom0, denom1)
+			takerFee := takerFees.GetPoolTakerFee(pool, denom0, denom1)
 
 			result = append(result, domain.TakerFeeForPair{
-				Denom0:   denom0,
-				Denom1:   denom1,
-				TakerFee: takerFee,
+				Denom0:            denom0,
+				Denom1:            denom1,
+				TakerFee:          takerFee,
+				MultihopTakerFees: multihopTakerFees,
 			})
 		}
 	}
@@ -458,12 +606,15 @@ func (r *routerUseCaseImpl) initializeRouter() *Router {
 	router := NewRouter([]uint64{}, r.config.MaxPoolsPerRoute, r.config.MaxRoutes, r.config.MaxSplitRoutes, r.config.MaxSplitIterations, r.config.MinOSMOLiquidity, r.logger)
 	router = WithRouterRepository(router, r.routerRepository)
 	router = WithPoolsUsecase(router, r.poolsUsecase)
+	router = WithGasPrice(router, r.gasPriceUOSMO)
 
 	return router
 }
 
 // handleCandidateRoutes attempts to retrieve candidate routes from the cache. If no routes are cached, it will
 // compute, persist in cache and return them.
+// If the router already has sorted pools, they are used for computing the routes instead of reading all pools.
+// If a historical height is set on the context, the cache is neither read nor written since it reflects the latest state.
 // Returns routes on success
 // Errors if:
 // - there is an error retrieving routes from cache
@@ -472,8 +623,11 @@ func (r *routerUseCaseImpl) initializeRouter() *Router {
 func (r *routerUseCaseImpl) handleCandidateRoutes(ctx context.Context, router *Router, tokenInDenom, tokenOutDenom string) (candidateRoutes route.CandidateRoutes, err error) {
 	r.logger.Debug("getting routes")
 
+	_, isHistorical := domain.GetHistoricalHeightFromContext(ctx)
+	isRouteCacheEnabled := r.config.RouteCacheEnabled && !isHistorical
+
 	// Check cache for routes if enabled
-	if r.config.RouteCacheEnabled {
+	if isRouteCacheEnabled {
 		candidateRoutes, err = r.routerRepository.GetRoutes(ctx, tokenInDenom, tokenOutDenom)
 		if err != nil {
 			return route.CandidateRoutes{}, err
@@ -494,12 +648,16 @@ func (r *routerUseCaseImpl) handleCandidateRoutes(ctx context.Context, router *R
 		cacheMisses.WithLabelValues(requestURLPath, candidateRouteCacheLabel, tokenInDenom, tokenOutDenom).Inc()
 
 		r.logger.Debug("calculating routes")
-		allPools, err := r.poolsUsecase.GetAllPools(ctx)
-		if err != nil {
-			return route.CandidateRoutes{}, err
+
+		// Pools may be preloaded by the caller to share them between multiple searches.
+		if router.GetSortedPools() == nil {
+			allPools, err := r.poolsUsecase.GetAllPools(ctx)
+			if err != nil {
+				return route.CandidateRoutes{}, err
+			}
+			r.logger.Debug("retrieved pools", zap.Int("num_pools", len(allPools)))
+			router = WithSortedPools(router, allPools)
 		}
-		r.logger.Debug("retrieved pools", zap.Int("num_pools", len(allPools)))
-		router = WithSortedPools(router, allPools)
 
 		candidateRoutes, err = router.GetCandidateRoutes(tokenInDenom, tokenOutDenom)
 		if err != nil {
@@ -509,7 +667,7 @@ func (r *routerUseCaseImpl) handleCandidateRoutes(ctx context.Context, router *R
 		r.logger.Info("calculated routes", zap.Int("num_routes", len(candidateRoutes.Routes)))
 
 		// Persist routes
-		if len(candidateRoutes.Routes) > 0 && r.config.RouteCacheEnabled {
+		if len(candidateRoutes.Routes) > 0 && isRouteCacheEnabled {
 			r.logger.Debug("persisting routes", zap.Int("num_routes", len(candidateRoutes.Routes)))
 			if err := r.routerRepository.SetRoutes(ctx, tokenInDenom, tokenOutDenom, candidateRoutes); err != nil {
 				return route.CandidateRoutes{}, err
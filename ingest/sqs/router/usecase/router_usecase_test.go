@@ -160,7 +160,7 @@ func (s *RouterTestSuite) TestHandleRoutes() {
 				Pools: tc.repositoryPools,
 			}
 
-			routerUseCase := usecase.NewRouterUsecase(defaultTimeoutDuration, routerRepositoryMock, poolsUseCaseMock, domain.RouterConfig{
+			routerUseCase := usecase.NewRouterUsecase(defaultTimeoutDuration, routerRepositoryMock, poolsUseCaseMock, nil, domain.RouterConfig{
 				RouteCacheEnabled: !tc.isCacheDisabled,
 			}, &log.NoOpLogger{}, cache.New())
 
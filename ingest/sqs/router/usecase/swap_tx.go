@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// swapTxBaseGas is the gas estimate of a swap transaction excluding the swaps in each pool.
+// It covers the ante handler and the signature verification of a single signer.
+const swapTxBaseGas uint64 = 100_000
+
+// swapMsg is a poolmanager swap message that can be signed in legacy amino JSON mode.
+type swapMsg interface {
+	sdk.Msg
+	GetSignBytes() []byte
+}
+
+// GetSwapTx implements mvc.RouterUsecase.
+// The quote is prepared for output before it is returned.
+// Returns error if:
+// - the slippage tolerance is negative or greater than or equal to one
+// - fails to compute the optimal quote
+// - fails to estimate the gas of the quote routes
+// - the token out min amount truncates to zero
+// - the message fails basic validation, e.g. the sender is not a valid address
+func (r *routerUseCaseImpl) GetSwapTx(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, sender string, slippageTolerance osmomath.Dec) (domain.SwapTx, error) {
+	if slippageTolerance.IsNegative() || slippageTolerance.GTE(one) {
+		return domain.SwapTx{}, domain.InvalidSlippageToleranceError{SlippageTolerance: slippageTolerance}
+	}
+
+	quote, err := r.GetOptimalQuote(ctx, tokenIn, tokenOutDenom)
+	if err != nil {
+		return domain.SwapTx{}, err
+	}
+
+	// Estimate gas before the quote is prepared since preparing strips the pool state
+	// that the gas estimate depends on.
+	gasEstimate, err := estimateSwapGas(quote)
+	if err != nil {
+		return domain.SwapTx{}, err
+	}
+
+	quote.PrepareResult()
+
+	tokenOutMinAmount := quote.GetAmountOut().ToLegacyDec().MulTruncate(one.Sub(slippageTolerance)).TruncateInt()
+	if !tokenOutMinAmount.IsPositive() {
+		return domain.SwapTx{}, domain.ZeroTokenOutMinAmountError{AmountOut: quote.GetAmountOut(), SlippageTolerance: slippageTolerance}
+	}
+
+	msg := buildSwapMsg(sender, quote, tokenOutMinAmount)
+	if err := msg.ValidateBasic(); err != nil {
+		return domain.SwapTx{}, err
+	}
+
+	protoBytes, err := proto.Marshal(msg)
+	if err != nil {
+		return domain.SwapTx{}, err
+	}
+
+	return domain.SwapTx{
+		Quote:             quote,
+		TypeURL:           sdk.MsgTypeURL(msg),
+		AminoJSON:         msg.GetSignBytes(),
+		Proto:             protoBytes,
+		TokenOutMinAmount: tokenOutMinAmount,
+		GasEstimate:       gasEstimate,
+	}, nil
+}
+
+// buildSwapMsg returns MsgSwapExactAmountIn if the quote has a single route.
+// Otherwise, returns MsgSplitRouteSwapExactAmountIn with one split route per quote route.
+// CONTRACT: the quote is prepared for output so that each pool has its token out denom set.
+func buildSwapMsg(sender string, quote domain.Quote, tokenOutMinAmount osmomath.Int) swapMsg {
+	routes := quote.GetRoute()
+
+	if len(routes) == 1 {
+		return &poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            sender,
+			Routes:            convertToSwapAmountInRoutes(routes[0].GetPools()),
+			TokenIn:           quote.GetAmountIn(),
+			TokenOutMinAmount: tokenOutMinAmount,
+		}
+	}
+
+	splitRoutes := make([]poolmanagertypes.SwapAmountInSplitRoute, 0, len(routes))
+	for _, route := range routes {
+		splitRoutes = append(splitRoutes, poolmanagertypes.SwapAmountInSplitRoute{
+			Pools:         convertToSwapAmountInRoutes(route.GetPools()),
+			TokenInAmount: route.GetAmountIn(),
+		})
+	}
+
+	return &poolmanagertypes.MsgSplitRouteSwapExactAmountIn{
+		Sender:            sender,
+		Routes:            splitRoutes,
+		TokenInDenom:      quote.GetAmountIn().Denom,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+}
+
+// convertToSwapAmountInRoutes converts the pools of a route to poolmanager swap amount in routes.
+func convertToSwapAmountInRoutes(pools []domain.RoutablePool) []poolmanagertypes.SwapAmountInRoute {
+	swapRoutes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(pools))
+	for _, pool := range pools {
+		swapRoutes = append(swapRoutes, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        pool.GetId(),
+			TokenOutDenom: pool.GetTokenOutDenom(),
+		})
+	}
+	return swapRoutes
+}
+
+// estimateSwapGas returns the gas estimate of swapping over all routes in the quote.
+// CONTRACT: the quote is not yet prepared for output.
+func estimateSwapGas(quote domain.Quote) (uint64, error) {
+	gas := swapTxBaseGas
+	tokenInDenom := quote.GetAmountIn().Denom
+	for _, route := range quote.GetRoute() {
+		_, routeGas, err := route.CalculateTokenOutByTokenInWithGas(sdk.NewCoin(tokenInDenom, route.GetAmountIn()))
+		if err != nil {
+			return 0, err
+		}
+		gas += routeGas
+	}
+	return gas, nil
+}
@@ -0,0 +1,150 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/cache"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	poolsusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
+	routerusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// Validates that the swap message matches the optimal quote and that
+// the token out min amount accounts for the slippage tolerance.
+func (s *RouterTestSuite) TestGetSwapTx_Mainnet() {
+	var (
+		defaultSender            = sdk.AccAddress([]byte("sender______________")).String()
+		defaultSlippageTolerance = osmomath.NewDecWithPrec(1, 2)
+	)
+
+	config := defaultRouterConfig
+	config.MaxPoolsPerRoute = 5
+	config.MaxRoutes = 10
+
+	router, tickMap, takerFeeMap := s.setupMainnetRouter(config)
+
+	routerRepositoryMock := mocks.RedisRouterRepositoryMock{
+		TakerFees: takerFeeMap,
+	}
+	poolsRepositoryMock := mocks.RedisPoolsRepositoryMock{
+		Pools:     router.GetSortedPools(),
+		TickModel: tickMap,
+	}
+	poolsUsecase := poolsusecase.NewPoolsUsecase(time.Hour, &poolsRepositoryMock, nil, domain.CosmWasmPoolRouterConfig{})
+
+	routerUsecase := routerusecase.NewRouterUsecase(time.Hour, &routerRepositoryMock, poolsUsecase, nil, config, &log.NoOpLogger{}, cache.New())
+
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		slippageTolerance osmomath.Dec
+
+		expectedError error
+	}{
+		"small amount": {
+			tokenIn:           sdk.NewCoin(UOSMO, osmomath.NewInt(5_000_000)),
+			tokenOutDenom:     UION,
+			slippageTolerance: defaultSlippageTolerance,
+		},
+		"large amount": {
+			tokenIn:           sdk.NewCoin(USDT, osmomath.NewInt(1_000_000_000_000)),
+			tokenOutDenom:     ATOM,
+			slippageTolerance: defaultSlippageTolerance,
+		},
+		"zero slippage tolerance": {
+			tokenIn:           sdk.NewCoin(UOSMO, osmomath.NewInt(5_000_000)),
+			tokenOutDenom:     UION,
+			slippageTolerance: osmomath.ZeroDec(),
+		},
+		"negative slippage tolerance": {
+			tokenIn:           sdk.NewCoin(UOSMO, osmomath.NewInt(5_000_000)),
+			tokenOutDenom:     UION,
+			slippageTolerance: osmomath.NewDecWithPrec(-1, 2),
+
+			expectedError: domain.InvalidSlippageToleranceError{SlippageTolerance: osmomath.NewDecWithPrec(-1, 2)},
+		},
+		"slippage tolerance of one": {
+			tokenIn:           sdk.NewCoin(UOSMO, osmomath.NewInt(5_000_000)),
+			tokenOutDenom:     UION,
+			slippageTolerance: osmomath.OneDec(),
+
+			expectedError: domain.InvalidSlippageToleranceError{SlippageTolerance: osmomath.OneDec()},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			// System under test
+			swapTx, err := routerUsecase.GetSwapTx(context.Background(), tc.tokenIn, tc.tokenOutDenom, defaultSender, tc.slippageTolerance)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedTokenOutMinAmount := swapTx.Quote.GetAmountOut().ToLegacyDec().MulTruncate(osmomath.OneDec().Sub(tc.slippageTolerance)).TruncateInt()
+			s.Require().Equal(expectedTokenOutMinAmount, swapTx.TokenOutMinAmount)
+			s.Require().True(swapTx.TokenOutMinAmount.LTE(swapTx.Quote.GetAmountOut()))
+			s.Require().NotEmpty(swapTx.AminoJSON)
+			s.Require().Greater(swapTx.GasEstimate, uint64(0))
+
+			routes := swapTx.Quote.GetRoute()
+
+			// Decode the message and validate it against the quote.
+			if len(routes) == 1 {
+				s.Require().Equal(sdk.MsgTypeURL(&poolmanagertypes.MsgSwapExactAmountIn{}), swapTx.TypeURL)
+
+				var msg poolmanagertypes.MsgSwapExactAmountIn
+				s.Require().NoError(proto.Unmarshal(swapTx.Proto, &msg))
+
+				s.Require().Equal(defaultSender, msg.Sender)
+				s.Require().Equal(tc.tokenIn, msg.TokenIn)
+				s.Require().Equal(swapTx.TokenOutMinAmount, msg.TokenOutMinAmount)
+				s.validateSwapAmountInRoutes(routes[0], msg.Routes, tc.tokenOutDenom)
+				return
+			}
+
+			s.Require().Equal(sdk.MsgTypeURL(&poolmanagertypes.MsgSplitRouteSwapExactAmountIn{}), swapTx.TypeURL)
+
+			var msg poolmanagertypes.MsgSplitRouteSwapExactAmountIn
+			s.Require().NoError(proto.Unmarshal(swapTx.Proto, &msg))
+
+			s.Require().Equal(defaultSender, msg.Sender)
+			s.Require().Equal(tc.tokenIn.Denom, msg.TokenInDenom)
+			s.Require().Equal(swapTx.TokenOutMinAmount, msg.TokenOutMinAmount)
+			s.Require().Len(msg.Routes, len(routes))
+
+			totalTokenInAmount := osmomath.ZeroInt()
+			for i, splitRoute := range msg.Routes {
+				s.Require().Equal(routes[i].GetAmountIn(), splitRoute.TokenInAmount)
+				s.validateSwapAmountInRoutes(routes[i], splitRoute.Pools, tc.tokenOutDenom)
+				totalTokenInAmount = totalTokenInAmount.Add(splitRoute.TokenInAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalTokenInAmount)
+		})
+	}
+}
+
+// validateSwapAmountInRoutes validates that the swap routes match the pools of the quote route
+// and that the last pool swaps into the token out denom.
+func (s *RouterTestSuite) validateSwapAmountInRoutes(route domain.SplitRoute, swapRoutes []poolmanagertypes.SwapAmountInRoute, tokenOutDenom string) {
+	pools := route.GetPools()
+	s.Require().Len(swapRoutes, len(pools))
+
+	for i, pool := range pools {
+		s.Require().Equal(pool.GetId(), swapRoutes[i].PoolId)
+		s.Require().Equal(pool.GetTokenOutDenom(), swapRoutes[i].TokenOutDenom)
+	}
+
+	s.Require().Equal(tokenOutDenom, swapRoutes[len(swapRoutes)-1].TokenOutDenom)
+}
@@ -14,7 +14,8 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
-	chainInfoRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/redis"
+	chainInfoMemoryRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/memory"
+	chainInfoRedisRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/redis"
 	chainInfoUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/usecase"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/cache"
@@ -22,9 +23,12 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/middleware"
 	poolsHttpDelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/delivery/http"
+	poolsMemoryRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/repository/memory"
 	poolsRedisRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/repository/redis"
 	poolsUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
+	memoryrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/memory"
 	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
+	routerMemoryRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/repository/memory"
 	routerRedisRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/repository/redis"
 	tokensUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/tokens/usecase"
 
@@ -43,6 +47,7 @@ type SideCarQueryServer interface {
 	GetChainInfoRepository() mvc.ChainInfoRepository
 	GetRouterRepository() mvc.RouterRepository
 	GetTokensUseCase() domain.TokensUsecase
+	GetQuoteStreamUsecase() mvc.QuoteStreamUsecase
 	GetLogger() log.Logger
 }
 
@@ -52,6 +57,7 @@ type sideCarQueryServer struct {
 	chainInfoRepository mvc.ChainInfoRepository
 	routerRepository    mvc.RouterRepository
 	tokensUseCase       domain.TokensUsecase
+	quoteStreamUsecase  mvc.QuoteStreamUsecase
 	logger              log.Logger
 }
 
@@ -60,6 +66,11 @@ func (sqs *sideCarQueryServer) GetTokensUseCase() domain.TokensUsecase {
 	return sqs.tokensUseCase
 }
 
+// GetQuoteStreamUsecase implements SideCarQueryServer.
+func (sqs *sideCarQueryServer) GetQuoteStreamUsecase() mvc.QuoteStreamUsecase {
+	return sqs.quoteStreamUsecase
+}
+
 // GetPoolsRepository implements SideCarQueryServer.
 func (sqs *sideCarQueryServer) GetPoolsRepository() mvc.PoolsRepository {
 	return sqs.poolsRepository
@@ -85,7 +96,11 @@ func (sqs *sideCarQueryServer) GetLogger() log.Logger {
 }
 
 // NewSideCarQueryServer creates a new sidecar query server (SQS).
-func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfig, dbHost, dbPort, sideCarQueryServerAddress, grpcAddress string, useCaseTimeoutDuration int, logger log.Logger) (SideCarQueryServer, error) {
+// quoteStreamConfig configures the streaming of quotes after every ingested block.
+// cosmWasmPoolQuerier is used for quoting the general CosmWasm pools allowlisted in the router config.
+// storageType selects the storage backend. Either StorageTypeRedis or StorageTypeMemory.
+// An empty storageType defaults to StorageTypeRedis.
+func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfig, quoteStreamConfig domain.QuoteStreamConfig, cosmWasmPoolQuerier domain.CosmWasmPoolQuerier, storageType, dbHost, dbPort, sideCarQueryServerAddress, grpcAddress string, useCaseTimeoutDuration int, logger log.Logger) (SideCarQueryServer, error) {
 	// Handle SIGINT and SIGTERM signals to initiate shutdown
 	exitChan := make(chan os.Signal, 1)
 	signal.Notify(exitChan, os.Interrupt, syscall.SIGTERM)
@@ -118,37 +133,64 @@ func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfi
 		os.Exit(0)
 	}()
 
-	// Create redis client and ensure that it is up.
-	redisAddress := fmt.Sprintf("%s:%s", dbHost, dbPort)
-	logger.Info("Pinging redis", zap.String("redis_address", redisAddress))
-	redisClient := redis.NewClient(&redis.Options{
-		Addr:     redisAddress,
-		Password: "", // no password set
-		DB:       0,  // use default DB
-	})
-	redisStatus := redisClient.Ping(ctx)
-	_, err := redisStatus.Result()
-	if err != nil {
-		return nil, err
-	}
+	var (
+		txManager           mvc.TxManager
+		poolsRepository     mvc.PoolsRepository
+		routerRepository    mvc.RouterRepository
+		chainInfoRepository mvc.ChainInfoRepository
+
+		// Empty for the in-memory storage.
+		redisAddress string
+	)
+
+	switch storageType {
+	case StorageTypeMemory:
+		logger.Info("Using in-memory storage")
+
+		txManager = memoryrepo.NewTxManager()
+		poolsRepository = poolsMemoryRepository.NewMemoryPoolsRepo(appCodec, txManager)
+		routerRepository = routerMemoryRepository.NewMemoryRouterRepo(txManager, routerConfig.RouteCacheExpirySeconds)
+		chainInfoRepository = chainInfoMemoryRepository.NewChainInfoRepo(txManager)
+	case StorageTypeRedis, "":
+		// Create redis client and ensure that it is up.
+		redisAddress = fmt.Sprintf("%s:%s", dbHost, dbPort)
+		logger.Info("Pinging redis", zap.String("redis_address", redisAddress))
+		redisClient := redis.NewClient(&redis.Options{
+			Addr:     redisAddress,
+			Password: "", // no password set
+			DB:       0,  // use default DB
+		})
+		redisStatus := redisClient.Ping(ctx)
+		_, err := redisStatus.Result()
+		if err != nil {
+			return nil, err
+		}
 
-	// Creare repository manager
-	redisTxManager := redisrepo.NewTxManager(redisClient)
+		// Creare repository manager
+		txManager = redisrepo.NewTxManager(redisClient)
+		poolsRepository = poolsRedisRepository.NewRedisPoolsRepo(appCodec, txManager)
+		routerRepository = routerRedisRepository.NewRedisRouterRepo(txManager, routerConfig.RouteCacheExpirySeconds)
+		chainInfoRepository = chainInfoRedisRepository.NewChainInfoRepo(txManager)
+	default:
+		return nil, fmt.Errorf("unsupported storage type (%s), must be one of (%s, %s)", storageType, StorageTypeRedis, StorageTypeMemory)
+	}
 
-	// Initialize pools repository, usecase and HTTP handler
-	poolsRepository := poolsRedisRepository.NewRedisPoolsRepo(appCodec, redisTxManager)
+	// Initialize pools usecase and HTTP handler
 	timeoutContext := time.Duration(useCaseTimeoutDuration) * time.Second
-	poolsUseCase := poolsUseCase.NewPoolsUsecase(timeoutContext, poolsRepository, redisTxManager)
+	cosmWasmPoolRouterConfig := domain.NewCosmWasmPoolRouterConfig(routerConfig, cosmWasmPoolQuerier)
+	poolsUseCase := poolsUseCase.NewPoolsUsecase(timeoutContext, poolsRepository, txManager, cosmWasmPoolRouterConfig)
 	poolsHttpDelivery.NewPoolsHandler(e, poolsUseCase)
 
-	// Initialize router repository, usecase and HTTP handler
-	routerRepository := routerRedisRepository.NewRedisRouterRepo(redisTxManager, routerConfig.RouteCacheExpirySeconds)
-	routerUsecase := routerUseCase.NewRouterUsecase(timeoutContext, routerRepository, poolsUseCase, routerConfig, logger, cache.New())
+	// Initialize router usecase and HTTP handler
+	routerUsecase := routerUseCase.NewRouterUsecase(timeoutContext, routerRepository, poolsUseCase, chainInfoRepository, routerConfig, logger, cache.New())
 	routerHttpDelivery.NewRouterHandler(e, routerUsecase, logger)
 
+	// Initialize quote stream usecase and HTTP handler
+	quoteStreamUsecase := routerUseCase.NewQuoteStreamUsecase(ctx, timeoutContext, routerUsecase, quoteStreamConfig, logger)
+	routerHttpDelivery.NewQuoteStreamHandler(e, quoteStreamUsecase, logger)
+
 	// Initialize system handler
-	chainInfoRepository := chainInfoRepository.NewChainInfoRepo(redisTxManager)
-	chainInfoUseCase := chainInfoUseCase.NewChainInfoUsecase(timeoutContext, chainInfoRepository, redisTxManager)
+	chainInfoUseCase := chainInfoUseCase.NewChainInfoUsecase(timeoutContext, chainInfoRepository, txManager)
 	systemhttpdelivery.NewSystemHandler(e, redisAddress, grpcAddress, logger, chainInfoUseCase)
 
 	// Initialized tokens usecase
@@ -157,7 +199,7 @@ func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfi
 	// Start server in a separate goroutine
 	go func() {
 		logger.Info("Starting sidecar query server", zap.String("address", sideCarQueryServerAddress))
-		err = e.Start(sideCarQueryServerAddress)
+		err := e.Start(sideCarQueryServerAddress)
 		if err != nil {
 			panic(err)
 		}
@@ -165,18 +207,19 @@ func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfi
 
 	go func() {
 		logger.Info("Starting profiling server")
-		err = http.ListenAndServe("localhost:6061", nil)
+		err := http.ListenAndServe("localhost:6061", nil)
 		if err != nil {
 			panic(err)
 		}
 	}()
 
 	return &sideCarQueryServer{
-		txManager:           redisTxManager,
+		txManager:           txManager,
 		poolsRepository:     poolsRepository,
 		chainInfoRepository: chainInfoRepository,
 		routerRepository:    routerRepository,
 		tokensUseCase:       tokensUseCase,
+		quoteStreamUsecase:  quoteStreamUsecase,
 		logger:              logger,
 	}, nil
 }
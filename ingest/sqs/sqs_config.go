@@ -13,6 +13,7 @@ import (
 	sqslog "github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/common"
 	redispoolsingester "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/ingester/redis"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/querier"
 )
 
 // Config defines the config for the sidecar query server.
@@ -20,6 +21,10 @@ type Config struct {
 	// IsEnabled defines if the sidecar query server is enabled.
 	IsEnabled bool `mapstructure:"enabled"`
 
+	// StorageType defines the storage backend. Either "redis" or "memory".
+	// Host and port are ignored for the in-memory storage.
+	StorageType string `mapstructure:"db-type"`
+
 	// Storage defines the storage host and port.
 	StorageHost string `mapstructure:"db-host"`
 	StoragePort string `mapstructure:"db-port"`
@@ -37,15 +42,26 @@ type Config struct {
 
 	// Router encapsulates the router config.
 	Router *domain.RouterConfig `mapstructure:"router"`
+
+	// QuoteStream encapsulates the quote streaming config.
+	QuoteStream *domain.QuoteStreamConfig `mapstructure:"quote-stream"`
 }
 
 const groupOptName = "osmosis-sqs"
 
+const (
+	// StorageTypeRedis is the storage type for the Redis backend.
+	StorageTypeRedis = "redis"
+	// StorageTypeMemory is the storage type for the in-memory copy-on-write backend.
+	StorageTypeMemory = "memory"
+)
+
 // DefaultConfig defines the default config for the sidecar query server.
 var DefaultConfig = Config{
 
 	IsEnabled: false,
 
+	StorageType: StorageTypeRedis,
 	StorageHost: "localhost",
 	StoragePort: "6379",
 
@@ -68,6 +84,21 @@ var DefaultConfig = Config{
 		RouteUpdateHeightInterval: 0,
 		RouteCacheEnabled:         false,
 		RouteCacheExpirySeconds:   600, // 10 minutes
+		GeneralCosmWasmCodeIDs:    []uint64{},
+		CosmWasmQueryGasLimit:     1_000_000,
+		MaxBatchQuoteRequests:     50,
+		// Disabled by default.
+		HistoricalStateRetentionBlocks: 0,
+		// Gas is not accounted for by default.
+		GasPriceUOSMO: "",
+	},
+
+	QuoteStream: &domain.QuoteStreamConfig{
+		MaxSubscriptionsPerConnection: 10,
+		MaxTopics:                     1000,
+		MaxTopicsPerClient:            50,
+		BufferSize:                    16,
+		ResumeWindowBlocks:            100,
 	},
 }
 
@@ -84,6 +115,7 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 	return Config{
 		IsEnabled: isEnabled,
 
+		StorageType: osmoutils.ParseString(opts, groupOptName, "db-type"),
 		StorageHost: osmoutils.ParseString(opts, groupOptName, "db-host"),
 		StoragePort: osmoutils.ParseString(opts, groupOptName, "db-port"),
 
@@ -114,6 +146,28 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 			RouteCacheEnabled: osmoutils.ParseBool(opts, groupOptName, "route-cache-enabled", false),
 
 			RouteCacheExpirySeconds: uint64(osmoutils.ParseInt(opts, groupOptName, "route-cache-expiry-seconds")),
+
+			GeneralCosmWasmCodeIDs: osmoutils.ParseUint64Slice(opts, groupOptName, "general-cosmwasm-code-ids"),
+
+			CosmWasmQueryGasLimit: uint64(osmoutils.ParseInt(opts, groupOptName, "cosmwasm-query-gas-limit")),
+
+			MaxBatchQuoteRequests: osmoutils.ParseInt(opts, groupOptName, "max-batch-quote-requests"),
+
+			HistoricalStateRetentionBlocks: uint64(osmoutils.ParseInt(opts, groupOptName, "historical-state-retention-blocks")),
+
+			GasPriceUOSMO: osmoutils.ParseString(opts, groupOptName, "gas-price-uosmo"),
+		},
+
+		QuoteStream: &domain.QuoteStreamConfig{
+			MaxSubscriptionsPerConnection: osmoutils.ParseInt(opts, groupOptName, "quote-stream-max-subscriptions-per-connection"),
+
+			MaxTopics: osmoutils.ParseInt(opts, groupOptName, "quote-stream-max-topics"),
+
+			MaxTopicsPerClient: osmoutils.ParseInt(opts, groupOptName, "quote-stream-max-topics-per-client"),
+
+			BufferSize: osmoutils.ParseInt(opts, groupOptName, "quote-stream-buffer-size"),
+
+			ResumeWindowBlocks: osmoutils.ParseInt(opts, groupOptName, "quote-stream-resume-window-blocks"),
 		},
 	}
 }
@@ -127,10 +181,21 @@ func (c Config) Initialize(appCodec codec.Codec, keepers common.SQSIngestKeepers
 	}
 	logger.Info("Starting sidecar query server")
 
+	if _, err := c.Router.GetGasPriceUOSMO(); err != nil {
+		return nil, fmt.Errorf("invalid gas price: %s", err)
+	}
+
+	// Create the querier for quoting general CosmWasm pools.
+	// It is shared between the ingester that captures state snapshots and the router that quotes against them.
+	cosmWasmPoolQuerier := querier.NewCosmWasmSnapshotQuerier(keepers.WasmKeeper, keepers.CommitMultiStore)
+
 	// Create sidecar query server
 	sidecarQueryServer, err := NewSideCarQueryServer(
 		appCodec,
 		*c.Router,
+		*c.QuoteStream,
+		cosmWasmPoolQuerier,
+		c.StorageType,
 		c.StorageHost,
 		c.StoragePort,
 		c.ServerAddress,
@@ -144,14 +209,15 @@ func (c Config) Initialize(appCodec codec.Codec, keepers common.SQSIngestKeepers
 	txManager := sidecarQueryServer.GetTxManager()
 
 	// Create pools ingester
-	poolsIngester := redispoolsingester.NewPoolIngester(sidecarQueryServer.GetPoolsRepository(), sidecarQueryServer.GetRouterRepository(), sidecarQueryServer.GetTokensUseCase(), txManager, *c.Router, keepers)
+	poolsIngester := redispoolsingester.NewPoolIngester(sidecarQueryServer.GetPoolsRepository(), sidecarQueryServer.GetRouterRepository(), sidecarQueryServer.GetTokensUseCase(), txManager, *c.Router, keepers, cosmWasmPoolQuerier)
 	poolsIngester.SetLogger(sidecarQueryServer.GetLogger())
 
 	chainInfoingester := redischaininfoingester.NewChainInfoIngester(sidecarQueryServer.GetChainInfoRepository(), txManager)
 	chainInfoingester.SetLogger(sidecarQueryServer.GetLogger())
 
 	// Create sqs ingester that encapsulates all ingesters.
-	sqsIngester := NewSidecarQueryServerIngester(poolsIngester, chainInfoingester, txManager)
+	// Quote subscribers are notified after every committed block.
+	sqsIngester := NewSidecarQueryServerIngester(poolsIngester, chainInfoingester, txManager, sidecarQueryServer.GetQuoteStreamUsecase())
 
 	return sqsIngester, nil
 }
@@ -39,6 +39,7 @@ type JsonResponse struct {
 const heightTolerance = 10
 
 // NewSystemHandler will initialize the /debug/ppof resources endpoint
+// If redisAddress is empty, the storage is assumed to be in-memory and the Redis health check is skipped.
 func NewSystemHandler(e *echo.Echo, redisAddress, grpcAddress string, logger log.Logger, us mvc.ChainInfoUsecase) {
 	handler := &SystemHandler{
 		logger:       logger,
@@ -94,7 +95,7 @@ func (h *SystemHandler) GetHealthStatus(c echo.Context) error {
 	// Errors if the height has not beein updated for more than 30 seconds
 	latestStoreHeight, err := h.CIUsecase.GetLatestHeight(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to get latest height from Redis: %s", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to get latest height from storage: %s", err))
 	}
 
 	// Check if the node is catching up. Error if so.
@@ -107,6 +108,18 @@ func (h *SystemHandler) GetHealthStatus(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusServiceUnavailable, fmt.Sprintf("Node is not synced, chain height (%d), store height (%d), tolerance (%d)", latestChainHeight, latestStoreHeight, heightTolerance))
 	}
 
+	storageStatus := map[string]string{
+		"grpc_gateway_status": "running",
+		"chain_latest_height": fmt.Sprint(latestChainHeight),
+		"store_latest_height": fmt.Sprint(latestStoreHeight),
+	}
+
+	// In-memory storage has no external dependency to check.
+	if h.redisAddress == "" {
+		storageStatus["memory_storage_status"] = "running"
+		return c.JSON(http.StatusOK, storageStatus)
+	}
+
 	// Check Redis status
 	rdb := redis.NewClient(&redis.Options{
 		Addr: h.redisAddress,
@@ -118,10 +131,6 @@ func (h *SystemHandler) GetHealthStatus(c echo.Context) error {
 	}
 
 	// Return combined status
-	return c.JSON(http.StatusOK, map[string]string{
-		"grpc_gateway_status": "running",
-		"redis_status":        "running",
-		"chain_latest_height": fmt.Sprint(latestChainHeight),
-		"store_latest_height": fmt.Sprint(latestStoreHeight),
-	})
+	storageStatus["redis_status"] = "running"
+	return c.JSON(http.StatusOK, storageStatus)
 }
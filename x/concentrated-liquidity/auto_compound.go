@@ -0,0 +1,326 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// SetPositionAutoCompound enables or disables the auto-compounding of the spread rewards and incentives
+// of the given position.
+// Returns error if:
+// - the position does not exist
+// - the sender is not the owner of the position
+// - auto-compounding is being enabled for a position with an active underlying lock
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, enabled bool) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.validatePositionOwner(ctx, position, sender); err != nil {
+		return err
+	}
+
+	if enabled {
+		// Positions with an underlying lock cannot be added to in place as that
+		// would break the accounting of the lock.
+		positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		if positionHasActiveUnderlyingLock {
+			return types.AutoCompoundLockedPositionError{PositionId: positionId, LockId: lockId}
+		}
+
+		k.setPositionAutoCompound(ctx, positionId)
+	} else {
+		k.deletePositionAutoCompound(ctx, positionId)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetPositionAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	})
+
+	return nil
+}
+
+// IsPositionAutoCompound returns true if auto-compounding is enabled for the given position. False otherwise.
+func (k Keeper) IsPositionAutoCompound(ctx sdk.Context, positionId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyAutoCompoundPosition(positionId))
+}
+
+// GetAllAutoCompoundPositionIds returns the IDs of all positions with auto-compounding enabled.
+func (k Keeper) GetAllAutoCompoundPositionIds(ctx sdk.Context) ([]uint64, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.AutoCompoundPositionPrefix, parsePositionIdFromBz)
+}
+
+// CompoundAutoCompoundPositions compounds the spread rewards and incentives of the positions with
+// auto-compounding enabled. At most MaxAutoCompoundPositionsPerEpoch positions are compounded per call,
+// resuming after the last position compounded by the previous call and starting over once all
+// positions have been reached.
+// A position that fails to compound is skipped and its rewards remain claimable so that a single
+// position cannot halt the epoch.
+func (k Keeper) CompoundAutoCompoundPositions(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.MaxAutoCompoundPositionsPerEpoch == 0 {
+		return nil
+	}
+
+	positionIds, reachedEnd := k.getAutoCompoundPositionIdsAfter(ctx, k.getAutoCompoundCursor(ctx), params.MaxAutoCompoundPositionsPerEpoch)
+
+	for _, positionId := range positionIds {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.compoundPosition(cacheCtx, positionId, params.AutoCompoundMaxSlippage)
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to auto-compound position", "position_id", positionId, "error", err.Error())
+		}
+	}
+
+	if reachedEnd || len(positionIds) == 0 {
+		k.setAutoCompoundCursor(ctx, 0)
+	} else {
+		k.setAutoCompoundCursor(ctx, positionIds[len(positionIds)-1])
+	}
+
+	return nil
+}
+
+// getAutoCompoundPositionIdsAfter returns up to limit IDs of positions with auto-compounding enabled
+// that are greater than the given position ID, in ascending order.
+// The returned boolean is true if there are no further flagged positions after the returned ones.
+func (k Keeper) getAutoCompoundPositionIdsAfter(ctx sdk.Context, afterPositionId uint64, limit uint64) ([]uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundPositionPrefix)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(afterPositionId+1), nil)
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(positionIds)) == limit {
+			return positionIds, false
+		}
+		positionIds = append(positionIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return positionIds, true
+}
+
+// getAutoCompoundCursor returns the ID of the last position compounded by the previous epoch,
+// or zero if the next epoch starts from the first position.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAutoCompoundCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setAutoCompoundCursor sets the ID of the last position compounded by the current epoch.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundCursor, sdk.Uint64ToBigEndian(positionId))
+}
+
+// compoundPosition collects the spread rewards and incentives of the given position and adds them
+// to the position in place, keeping its position ID and join time.
+// The collected tokens of the pool are first swapped in the pool of the position so that their
+// ratio matches the ratio of the position at the current price.
+// Collected tokens that are not one of the pool's tokens, as well as any amount that cannot be
+// converted to liquidity, remain with the owner of the position.
+// Returns error if:
+// - the position does not exist
+// - the position has an active underlying lock
+// - the swap slips by more than maxSlippage
+// - collecting the rewards, swapping or updating the position fails
+func (k Keeper) compoundPosition(ctx sdk.Context, positionId uint64, maxSlippage osmomath.Dec) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	positionHasActiveUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	// The rewards of a tokenized position are compounded for the holder of its NFT.
+	owner, _, err := k.getPositionOwner(ctx, position)
+	if err != nil {
+		return err
+	}
+	positionAddress, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return err
+	}
+
+	spreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return err
+	}
+	incentives, _, err := k.collectIncentives(ctx, owner, positionId)
+	if err != nil {
+		return err
+	}
+	rewards := spreadRewards.Add(incentives...)
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	amount0, amount1 := rewards.AmountOf(pool.GetToken0()), rewards.AmountOf(pool.GetToken1())
+	if amount0.IsZero() && amount1.IsZero() {
+		return nil
+	}
+
+	amount0, amount1, err = k.swapToPositionRatio(ctx, owner, pool, position, amount0, amount1, osmomath.OneInt(), maxSlippage)
+	if err != nil {
+		return err
+	}
+
+	// Refetch the pool since the swap moves the current price.
+	pool, err = k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return err
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return nil
+	}
+
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, positionAddress, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
+	if err != nil {
+		return err
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0, updateData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return err
+	}
+
+	tokensAdded := sdk.Coins{}
+	if updateData.Amount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), updateData.Amount0))
+	}
+	if updateData.Amount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), updateData.Amount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	event := &liquidityChangeEvent{
+		eventType:      types.TypeEvtCompoundPosition,
+		positionId:     positionId,
+		sender:         owner,
+		poolId:         position.PoolId,
+		lowerTick:      position.LowerTick,
+		upperTick:      position.UpperTick,
+		joinTime:       position.JoinTime,
+		liquidityDelta: liquidityDelta,
+		actualAmount0:  updateData.Amount0,
+		actualAmount1:  updateData.Amount1,
+	}
+	event.emit(ctx)
+
+	return nil
+}
+
+// swapToPositionRatio swaps the excess of one of the given amounts for the other token in the pool of the position
+// so that the ratio between the amounts matches the ratio of the tokens held by the position at the current price.
+// The swap is routed through the poolmanager so that the taker fee applies as for any other swap.
+// Returns the amounts of token0 and token1 after the swap.
+// Returns error if the swap returns less than tokenOutMinAmount or less than the value of the token in
+// at the spot price before the swap reduced by maxSlippage. A maxSlippage of one disables the latter.
+func (k Keeper) swapToPositionRatio(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1, tokenOutMinAmount osmomath.Int, maxSlippage osmomath.Dec) (osmomath.Int, osmomath.Int, error) {
+	positionAmount0, positionAmount1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// The price of token0 denominated in token1.
+	spotPrice, err := pool.SpotPrice(ctx, pool.GetToken1(), pool.GetToken0())
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	price := spotPrice.Dec()
+	if price.IsZero() {
+		return amount0, amount1, nil
+	}
+
+	// Value both the position and the rewards in token1 and split the rewards
+	// such that the share of token1 matches the share of the position.
+	positionValue := positionAmount0.Mul(price).Add(positionAmount1)
+	if positionValue.IsZero() {
+		return amount0, amount1, nil
+	}
+	rewardsValue := amount0.ToLegacyDec().Mul(price).Add(amount1.ToLegacyDec())
+	targetAmount1 := rewardsValue.Mul(positionAmount1).Quo(positionValue)
+
+	var tokenIn sdk.Coin
+	var tokenOutDenom string
+	if amount1.ToLegacyDec().GT(targetAmount1) {
+		tokenIn = sdk.NewCoin(pool.GetToken1(), amount1.ToLegacyDec().Sub(targetAmount1).TruncateInt())
+		tokenOutDenom = pool.GetToken0()
+	} else {
+		tokenIn = sdk.NewCoin(pool.GetToken0(), targetAmount1.Sub(amount1.ToLegacyDec()).Quo(price).TruncateInt())
+		tokenOutDenom = pool.GetToken1()
+	}
+
+	if tokenIn.IsZero() {
+		return amount0, amount1, nil
+	}
+
+	// Bound the amount out by the spot price before the swap, as the rewards are swapped
+	// without the owner being able to choose a minimum.
+	expectedTokenOut := tokenIn.Amount.ToLegacyDec().Mul(price)
+	if tokenIn.Denom == pool.GetToken1() {
+		expectedTokenOut = tokenIn.Amount.ToLegacyDec().Quo(price)
+	}
+	spotPriceTokenOutMinAmount := expectedTokenOut.Mul(osmomath.OneDec().Sub(maxSlippage)).TruncateInt()
+	if spotPriceTokenOutMinAmount.GT(tokenOutMinAmount) {
+		tokenOutMinAmount = spotPriceTokenOutMinAmount
+	}
+
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}
+	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, owner, route, tokenIn, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if tokenIn.Denom == pool.GetToken0() {
+		return amount0.Sub(tokenIn.Amount), amount1.Add(tokenOutAmount), nil
+	}
+	return amount0.Add(tokenOutAmount), amount1.Sub(tokenIn.Amount), nil
+}
+
+// setPositionAutoCompound flags the given position for auto-compounding.
+func (k Keeper) setPositionAutoCompound(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundPosition(positionId), sdk.Uint64ToBigEndian(positionId))
+}
+
+// deletePositionAutoCompound removes the auto-compounding flag of the given position, if any.
+func (k Keeper) deletePositionAutoCompound(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundPosition(positionId))
+}
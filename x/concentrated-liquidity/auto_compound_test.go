@@ -0,0 +1,219 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	err := clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[2], positionId, true)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: positionId, Address: s.TestAccs[2].String()})
+	s.Require().False(clKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+	s.Require().True(clKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+	positionIds, err := clKeeper.GetAllAutoCompoundPositionIds(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{positionId}, positionIds)
+
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, false))
+	s.Require().False(clKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+
+	// The flag is removed once the position is withdrawn.
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, DefaultLiquidityAmt)
+	s.Require().NoError(err)
+	s.Require().False(clKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+}
+
+func (s *KeeperTestSuite) TestCompoundAutoCompoundPositions() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	compoundPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	otherPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, compoundPositionId, true))
+	positionBefore, err := clKeeper.GetPosition(s.Ctx, compoundPositionId)
+	s.Require().NoError(err)
+
+	// Swap within the range of the positions to accrue spread rewards in USDC only.
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+	s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+	_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+
+	rewardsBefore, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, compoundPositionId)
+	s.Require().NoError(err)
+	s.Require().False(rewardsBefore.IsZero())
+
+	// Epochs other than the auto-compound epoch are no-ops.
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1))
+	liquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, compoundPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.Liquidity, liquidity)
+
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 1))
+
+	// The rewards are added to the same position without changing its join time.
+	positionAfter, err := clKeeper.GetPosition(s.Ctx, compoundPositionId)
+	s.Require().NoError(err)
+	s.Require().True(positionAfter.Liquidity.GT(positionBefore.Liquidity))
+	s.Require().Equal(positionBefore.JoinTime, positionAfter.JoinTime)
+	s.Require().True(clKeeper.IsPositionAutoCompound(s.Ctx, compoundPositionId))
+
+	claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, compoundPositionId)
+	s.Require().NoError(err)
+	s.Require().True(claimable.IsZero())
+
+	// Only the amount lost to the spread factor of the rebalancing swap is left with the owner.
+	ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	maxLeftover := rewardsBefore.AmountOf(USDC).QuoRaw(20)
+	for _, coin := range ownerBalanceAfter.Sub(ownerBalanceBefore...) {
+		s.Require().True(coin.Amount.LT(maxLeftover), coin.String())
+	}
+
+	// Positions without auto-compounding keep their rewards claimable.
+	otherLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.Liquidity, otherLiquidity)
+	claimable, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, otherPositionId)
+	s.Require().NoError(err)
+	s.Require().False(claimable.IsZero())
+}
+
+// TestCompoundAutoCompoundPositionsPerEpochLimit tests that at most MaxAutoCompoundPositionsPerEpoch
+// positions are compounded per epoch and that the following epoch resumes with the remaining positions.
+func (s *KeeperTestSuite) TestCompoundAutoCompoundPositionsPerEpochLimit() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.MaxAutoCompoundPositionsPerEpoch = 2
+	clKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionIds := make([]uint64, 3)
+	for i := range positionIds {
+		positionIds[i] = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+		s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionIds[i], true))
+	}
+
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+	s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+	_, _, _, err := clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+
+	requireCompounded := func(expectedCompounded ...bool) {
+		for i, positionId := range positionIds {
+			claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedCompounded[i], claimable.IsZero(), "position %d", positionId)
+		}
+	}
+
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 1))
+	requireCompounded(true, true, false)
+
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 2))
+	requireCompounded(true, true, true)
+
+	// Zero pauses auto-compounding.
+	params.MaxAutoCompoundPositionsPerEpoch = 0
+	clKeeper.SetParams(s.Ctx, params)
+	s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+	_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 3))
+	requireCompounded(false, false, false)
+
+	// The epoch after the last position starts over from the first one.
+	params.MaxAutoCompoundPositionsPerEpoch = 2
+	clKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 4))
+	requireCompounded(true, true, false)
+}
+
+// TestCompoundAutoCompoundPositionsMaxSlippage tests that a position is not compounded if the swap
+// rebalancing its rewards slips by more than AutoCompoundMaxSlippage.
+func (s *KeeperTestSuite) TestCompoundAutoCompoundPositionsMaxSlippage() {
+	tests := map[string]struct {
+		maxSlippage      osmomath.Dec
+		expectCompounded bool
+	}{
+		"slippage covers the spread factor": {
+			maxSlippage:      osmomath.MustNewDecFromStr("0.03"),
+			expectCompounded: true,
+		},
+		"slippage below the spread factor": {
+			maxSlippage:      osmomath.MustNewDecFromStr("0.005"),
+			expectCompounded: false,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			params := clKeeper.GetParams(s.Ctx)
+			params.AutoCompoundMaxSlippage = tc.maxSlippage
+			clKeeper.SetParams(s.Ctx, params)
+
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+			s.SetupDefaultPosition(pool.GetId())
+			owner := s.TestAccs[1]
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+			positionBefore, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+
+			// Spread rewards accrue in USDC only, so half of them is swapped for ETH.
+			swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+			s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+			_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+			s.Require().NoError(err)
+
+			s.Require().NoError(clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoCompoundEpochIdentifier, 1))
+
+			positionAfter, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectCompounded, positionAfter.Liquidity.GT(positionBefore.Liquidity))
+			s.Require().Equal(tc.expectCompounded, claimable.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundGenesis() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+
+	exported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]uint64{positionId}, exported.AutoCompoundPositionIds)
+
+	s.SetupTest()
+	s.App.ConcentratedLiquidityKeeper.InitGenesis(s.Ctx, *exported)
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+}
@@ -8,6 +8,7 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagDynamicSpreadFactorRecords = "dynamic-spread-factor-records"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickLiquidityChanges)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickLiquiditySnapshot)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +148,23 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetTickLiquidityChanges() (*osmocli.QueryDescriptor, *queryproto.TickLiquidityChangesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "tick-liquidity-changes",
+		Short: "Query the liquidity net of the ticks of a pool that changed after a height",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} tick-liquidity-changes 1 1000
+
+[poolid] [from height]`,
+	}, &queryproto.TickLiquidityChangesRequest{}
+}
+
+func GetTickLiquiditySnapshot() (*osmocli.QueryDescriptor, *queryproto.TickLiquiditySnapshotRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "tick-liquidity-snapshot",
+		Short: "Query the binary snapshot of the initialized ticks of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} tick-liquidity-snapshot 1`,
+	}, &queryproto.TickLiquiditySnapshotRequest{}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -31,6 +32,13 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimRangeOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
+	osmocli.AddTxCmd(txCmd, NewRebalancePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewTokenizePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewRedeemPositionNFTCmd)
 	return txCmd
 }
 
@@ -103,6 +111,63 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewPlaceRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-range-order",
+		Short:   "place a single sided range order that is withdrawn once a swap fully crosses its range",
+		Long:    "the range must be above the current tick when providing token0 and at or below the current tick when providing token1",
+		Example: "osmosisd tx concentratedliquidity place-range-order 1 69082 70000 10000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceRangeOrder{}
+}
+
+func NewCancelRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-range-order",
+		Short:   "cancel a range order that is not filled yet",
+		Example: "osmosisd tx concentratedliquidity cancel-range-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelRangeOrder{}
+}
+
+func NewClaimRangeOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimRangeOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-range-order",
+		Short:   "claim the tokens of a filled range order",
+		Example: "osmosisd tx concentratedliquidity claim-range-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimRangeOrder{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound",
+		Short:   "enable or disable the auto-compounding of the spread rewards and incentives of a position",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 1 true --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func NewRebalancePositionCmd() (*osmocli.TxCliDesc, *types.MsgRebalancePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "rebalance-position",
+		Short:   "move the liquidity of a position to a new tick range, optionally swapping to the ratio of the new range",
+		Example: "osmosisd tx concentratedliquidity rebalance-position 1 \"[-69082]\" 69082 true 0 0 0 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRebalancePosition{}
+}
+
+func NewTokenizePositionCmd() (*osmocli.TxCliDesc, *types.MsgTokenizePosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "tokenize-position",
+		Short:   "transfer a position to the position NFT contract, which mints an NFT representing it to the sender",
+		Example: "osmosisd tx concentratedliquidity tokenize-position 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgTokenizePosition{}
+}
+
+func NewRedeemPositionNFTCmd() (*osmocli.TxCliDesc, *types.MsgRedeemPositionNFT) {
+	return &osmocli.TxCliDesc{
+		Use:     "redeem-position-nft",
+		Short:   "burn the NFT representing a tokenized position held by the sender and transfer the position to the sender",
+		Example: "osmosisd tx concentratedliquidity redeem-position-nft 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRedeemPositionNFT{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -196,6 +261,55 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+// NewDynamicSpreadFactorProposal implements a command handler for the dynamic spread factor proposal
+func NewDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a dynamic spread factor proposal",
+		Long: strings.TrimSpace(`Submit a proposal enabling, updating or disabling the dynamic spread factor of pools.
+
+Passing in FlagDynamicSpreadFactorRecords separated by commas would be parsed automatically to dynamic spread factor records
+of pool id, enabled, min spread factor, max spread factor, volatility multiplier and volatility window.
+Ex) --dynamic-spread-factor-records=1,true,0.0005,0.01,0.5,1h,2,false,0,0,0,0s ->
+[(poolId 1, enabled, minSpreadFactor 0.05%, maxSpreadFactor 1%, volatilityMultiplier 0.5, volatilityWindow 1h), (poolId 2, disabled)]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDynamicSpreadFactorRecordsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagDynamicSpreadFactorRecords, "", "The dynamic spread factor records array")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -323,3 +437,83 @@ func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 
 	return finalPoolRecords, nil
 }
+
+func parseDynamicSpreadFactorRecordsArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := parseDynamicSpreadFactorRecords(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.DynamicSpreadFactorProposal{
+		Title:       title,
+		Description: description,
+		Records:     records,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorRecords(cmd *cobra.Command) ([]types.DynamicSpreadFactorRecord, error) {
+	recordsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(recordsStr, ",")
+
+	if len(fields)%6 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorRecords must be a list of poolId, enabled, minSpreadFactor, maxSpreadFactor, volatilityMultiplier and volatilityWindow")
+	}
+
+	records := []types.DynamicSpreadFactorRecord{}
+	i := 0
+	for i < len(fields) {
+		poolId, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		enabled, err := strconv.ParseBool(fields[i+1])
+		if err != nil {
+			return nil, err
+		}
+		minSpreadFactor, err := osmomath.NewDecFromStr(fields[i+2])
+		if err != nil {
+			return nil, err
+		}
+		maxSpreadFactor, err := osmomath.NewDecFromStr(fields[i+3])
+		if err != nil {
+			return nil, err
+		}
+		volatilityMultiplier, err := osmomath.NewDecFromStr(fields[i+4])
+		if err != nil {
+			return nil, err
+		}
+		volatilityWindow, err := time.ParseDuration(fields[i+5])
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, types.DynamicSpreadFactorRecord{
+			PoolId:               poolId,
+			Enabled:              enabled,
+			MinSpreadFactor:      minSpreadFactor,
+			MaxSpreadFactor:      maxSpreadFactor,
+			VolatilityMultiplier: volatilityMultiplier,
+			VolatilityWindow:     volatilityWindow,
+		})
+
+		// increase counter by the next 6
+		i = i + 6
+	}
+
+	return records, nil
+}
@@ -91,6 +91,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) TickLiquiditySnapshot(grpcCtx context.Context,
+	req *queryproto.TickLiquiditySnapshotRequest,
+) (*queryproto.TickLiquiditySnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TickLiquiditySnapshot(ctx, *req)
+}
+
+func (q Querier) TickLiquidityChanges(grpcCtx context.Context,
+	req *queryproto.TickLiquidityChangesRequest,
+) (*queryproto.TickLiquidityChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TickLiquidityChanges(ctx, *req)
+}
+
 func (q Querier) NumNextInitializedTicks(grpcCtx context.Context,
 	req *queryproto.NumNextInitializedTicksRequest,
 ) (*queryproto.NumNextInitializedTicksResponse, error) {
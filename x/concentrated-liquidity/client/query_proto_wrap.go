@@ -10,6 +10,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	clquery "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
 
 // Querier defines a wrapper around the x/concentrated-liquidity keeper providing gRPC method
@@ -318,3 +319,39 @@ func (q Querier) NumNextInitializedTicks(ctx sdk.Context, req clquery.NumNextIni
 
 	return &clquery.NumNextInitializedTicksResponse{LiquidityDepths: liquidityDepths, CurrentLiquidity: pool.GetLiquidity(), CurrentTick: pool.GetCurrentTick()}, nil
 }
+
+// TickLiquidityChanges returns the latest liquidity net of every tick of the pool that changed after the given height,
+// along with the height of the query to be used as the from height of the next query.
+func (q Querier) TickLiquidityChanges(ctx sdk.Context, req clquery.TickLiquidityChangesRequest) (*clquery.TickLiquidityChangesResponse, error) {
+	changes, err := q.Keeper.GetTickLiquidityChanges(ctx, req.PoolId, req.FromHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clquery.TickLiquidityChangesResponse{
+		Changes:          changes,
+		Height:           ctx.BlockHeight(),
+		CurrentTick:      pool.GetCurrentTick(),
+		CurrentLiquidity: pool.GetLiquidity(),
+	}, nil
+}
+
+// TickLiquiditySnapshot returns all initialized ticks of the pool in the compact binary tick snapshot format.
+func (q Querier) TickLiquiditySnapshot(ctx sdk.Context, req clquery.TickLiquiditySnapshotRequest) (*clquery.TickLiquiditySnapshotResponse, error) {
+	snapshot, err := q.Keeper.GetTickSnapshot(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := types.EncodeTickSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clquery.TickLiquiditySnapshotResponse{Snapshot: bz, Height: snapshot.Height}, nil
+}
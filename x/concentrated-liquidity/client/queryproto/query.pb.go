@@ -1631,6 +1631,279 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
+// =============================== TickLiquidityChanges
+type TickLiquidityChange struct {
+	TickIndex int64 `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	// liquidity_net is the liquidity net of the tick after the change. Zero
+	// indicates that the tick is no longer initialized.
+	LiquidityNet cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_net" yaml:"liquidity_net"`
+	// height is the height of the last change of the tick.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *TickLiquidityChange) Reset()         { *m = TickLiquidityChange{} }
+func (m *TickLiquidityChange) String() string { return proto.CompactTextString(m) }
+func (*TickLiquidityChange) ProtoMessage()    {}
+func (*TickLiquidityChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
+}
+func (m *TickLiquidityChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquidityChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquidityChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquidityChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquidityChange.Merge(m, src)
+}
+func (m *TickLiquidityChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquidityChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquidityChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquidityChange proto.InternalMessageInfo
+
+func (m *TickLiquidityChange) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *TickLiquidityChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TickLiquidityChangesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// from_height is the height after which the changes are returned.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty" yaml:"from_height"`
+}
+
+func (m *TickLiquidityChangesRequest) Reset()         { *m = TickLiquidityChangesRequest{} }
+func (m *TickLiquidityChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*TickLiquidityChangesRequest) ProtoMessage()    {}
+func (*TickLiquidityChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
+}
+func (m *TickLiquidityChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquidityChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquidityChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquidityChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquidityChangesRequest.Merge(m, src)
+}
+func (m *TickLiquidityChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquidityChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquidityChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquidityChangesRequest proto.InternalMessageInfo
+
+func (m *TickLiquidityChangesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TickLiquidityChangesRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+type TickLiquidityChangesResponse struct {
+	// changes are sorted by tick index in ascending order.
+	Changes []TickLiquidityChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// height is the height of the query, to be used as the from height of the
+	// next query.
+	Height           int64                       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	CurrentTick      int64                       `protobuf:"varint,3,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	CurrentLiquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=current_liquidity,json=currentLiquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_liquidity" yaml:"current_liquidity"`
+}
+
+func (m *TickLiquidityChangesResponse) Reset()         { *m = TickLiquidityChangesResponse{} }
+func (m *TickLiquidityChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*TickLiquidityChangesResponse) ProtoMessage()    {}
+func (*TickLiquidityChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *TickLiquidityChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquidityChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquidityChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquidityChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquidityChangesResponse.Merge(m, src)
+}
+func (m *TickLiquidityChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquidityChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquidityChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquidityChangesResponse proto.InternalMessageInfo
+
+func (m *TickLiquidityChangesResponse) GetChanges() []TickLiquidityChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *TickLiquidityChangesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TickLiquidityChangesResponse) GetCurrentTick() int64 {
+	if m != nil {
+		return m.CurrentTick
+	}
+	return 0
+}
+
+// =============================== TickLiquiditySnapshot
+type TickLiquiditySnapshotRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *TickLiquiditySnapshotRequest) Reset()         { *m = TickLiquiditySnapshotRequest{} }
+func (m *TickLiquiditySnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*TickLiquiditySnapshotRequest) ProtoMessage()    {}
+func (*TickLiquiditySnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *TickLiquiditySnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquiditySnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquiditySnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquiditySnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquiditySnapshotRequest.Merge(m, src)
+}
+func (m *TickLiquiditySnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquiditySnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquiditySnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquiditySnapshotRequest proto.InternalMessageInfo
+
+func (m *TickLiquiditySnapshotRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type TickLiquiditySnapshotResponse struct {
+	// snapshot is the tick map of the pool at the height of the query in the
+	// compact binary tick snapshot format.
+	Snapshot []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty" yaml:"snapshot"`
+	Height   int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+}
+
+func (m *TickLiquiditySnapshotResponse) Reset()         { *m = TickLiquiditySnapshotResponse{} }
+func (m *TickLiquiditySnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*TickLiquiditySnapshotResponse) ProtoMessage()    {}
+func (*TickLiquiditySnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *TickLiquiditySnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquiditySnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquiditySnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquiditySnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquiditySnapshotResponse.Merge(m, src)
+}
+func (m *TickLiquiditySnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquiditySnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquiditySnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickLiquiditySnapshotResponse proto.InternalMessageInfo
+
+func (m *TickLiquiditySnapshotResponse) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *TickLiquiditySnapshotResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
@@ -1664,6 +1937,11 @@ func init() {
 	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
 	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
 	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*TickLiquidityChange)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityChange")
+	proto.RegisterType((*TickLiquidityChangesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityChangesRequest")
+	proto.RegisterType((*TickLiquidityChangesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityChangesResponse")
+	proto.RegisterType((*TickLiquiditySnapshotRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquiditySnapshotRequest")
+	proto.RegisterType((*TickLiquiditySnapshotResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquiditySnapshotResponse")
 }
 
 func init() {
@@ -1671,153 +1949,168 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2335 bytes of a gzipped FileDescriptorProto
+	// 2562 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4f, 0x39, 0x1f, 0x9b, 0x79, 0x71, 0xbe, 0xca, 0x8e, 0xed, 0x4c, 0x92, 0x99, 0x6c, 0xfd,
-	0xff, 0x61, 0x2d, 0x92, 0xcc, 0x90, 0x2f, 0x42, 0xbe, 0x36, 0xeb, 0xb1, 0xd7, 0xd1, 0x68, 0x1d,
-	0xaf, 0xd3, 0x89, 0x01, 0x71, 0xa0, 0xb7, 0xa7, 0xbb, 0x3c, 0x6e, 0x4d, 0x4f, 0xd7, 0xb8, 0xbb,
-	0x3a, 0x89, 0x59, 0x22, 0xad, 0x76, 0x8f, 0x48, 0xb0, 0x88, 0x2b, 0x42, 0x42, 0x5c, 0xd0, 0x8a,
-	0x23, 0x17, 0xb8, 0x20, 0x38, 0xa0, 0x88, 0xc3, 0x6a, 0x25, 0x84, 0x84, 0xf6, 0x30, 0x0b, 0x09,
-	0x07, 0xa4, 0x05, 0x24, 0xcc, 0x85, 0x23, 0xea, 0xea, 0xaa, 0x9e, 0x9e, 0x71, 0x8f, 0xdd, 0x33,
-	0x36, 0x27, 0x4e, 0x33, 0xd5, 0xaf, 0xde, 0x7b, 0xbf, 0xf7, 0x51, 0xaf, 0xeb, 0xbd, 0x19, 0xb8,
-	0xc4, 0xfc, 0x26, 0xf3, 0x6d, 0xbf, 0x6c, 0x32, 0xd7, 0xa4, 0x2e, 0xf7, 0x0c, 0x4e, 0x2d, 0xc7,
-	0x5e, 0x0b, 0x6c, 0xcb, 0xe6, 0xeb, 0xe5, 0xc7, 0x97, 0x6a, 0x94, 0x1b, 0x97, 0xca, 0x6b, 0x01,
-	0xf5, 0xd6, 0x4b, 0x2d, 0x8f, 0x71, 0x86, 0xcf, 0x49, 0x96, 0x52, 0x2a, 0x4b, 0x49, 0xb2, 0xe4,
-	0xc7, 0xeb, 0xac, 0xce, 0x04, 0x47, 0x39, 0xfc, 0x16, 0x31, 0xe7, 0xbf, 0xb8, 0xb5, 0xbe, 0x96,
-	0xe1, 0x19, 0x4d, 0x5f, 0xee, 0xbd, 0x9a, 0x0d, 0x1b, 0xb7, 0xcd, 0x46, 0xd5, 0x5d, 0x51, 0x1a,
-	0x0a, 0xa6, 0x60, 0x2b, 0xd7, 0x0c, 0x9f, 0xc6, 0x7b, 0x4c, 0x66, 0xbb, 0x0a, 0x41, 0x92, 0x2e,
-	0xec, 0x8a, 0x77, 0xb5, 0x8c, 0xba, 0xed, 0x1a, 0xdc, 0x66, 0x6a, 0xef, 0xe9, 0x3a, 0x63, 0x75,
-	0x87, 0x96, 0x8d, 0x96, 0x5d, 0x36, 0x5c, 0x97, 0x71, 0x41, 0x54, 0xf8, 0x4e, 0x4a, 0xaa, 0x58,
-	0xd5, 0x82, 0x95, 0xb2, 0xe1, 0xae, 0x2b, 0x52, 0xa4, 0x44, 0x8f, 0xec, 0x8f, 0x16, 0x92, 0x54,
-	0xec, 0xe5, 0xe2, 0x76, 0x93, 0xfa, 0xdc, 0x68, 0xb6, 0x94, 0x01, 0xbd, 0x1b, 0xac, 0xc0, 0x4b,
-	0x82, 0xca, 0xe8, 0x96, 0x16, 0xf3, 0xed, 0x04, 0xd7, 0xed, 0x6c, 0x5c, 0xb6, 0x20, 0xda, 0x8f,
-	0xa9, 0xee, 0x51, 0x93, 0x79, 0x56, 0xc4, 0x4d, 0x7e, 0x81, 0x60, 0x7c, 0xd9, 0xa7, 0xde, 0x92,
-	0x14, 0xea, 0x6b, 0x74, 0x2d, 0xa0, 0x3e, 0xc7, 0x17, 0xe0, 0x15, 0xc3, 0xb2, 0x3c, 0xea, 0xfb,
-	0x53, 0xe8, 0x2c, 0x9a, 0xce, 0x55, 0xf0, 0x46, 0xbb, 0x78, 0x64, 0xdd, 0x68, 0x3a, 0x37, 0x89,
-	0x24, 0x10, 0x4d, 0x6d, 0xc1, 0xe7, 0xe1, 0x95, 0x16, 0x63, 0x8e, 0x6e, 0x5b, 0x53, 0x23, 0x67,
-	0xd1, 0xf4, 0xbe, 0xe4, 0x6e, 0x49, 0x20, 0xda, 0x81, 0xf0, 0x5b, 0xd5, 0xc2, 0xf3, 0x00, 0x9d,
-	0x80, 0x4c, 0xed, 0x3d, 0x8b, 0xa6, 0x0f, 0x5d, 0xfe, 0x42, 0x49, 0xfa, 0x32, 0x8c, 0x5e, 0x29,
-	0xca, 0x4a, 0x09, 0xbd, 0xb4, 0x64, 0xd4, 0xa9, 0x84, 0xa5, 0x25, 0x38, 0xc9, 0x6f, 0x10, 0x9c,
-	0xe8, 0xc1, 0xee, 0xb7, 0x98, 0xeb, 0x53, 0xfc, 0x0e, 0xe4, 0x94, 0x97, 0x42, 0xf8, 0x7b, 0xa7,
-	0x0f, 0x5d, 0xbe, 0x5d, 0xca, 0x94, 0xdd, 0xa5, 0xf9, 0xc0, 0x71, 0x94, 0xc0, 0x8a, 0x47, 0x8d,
-	0x86, 0xc5, 0x9e, 0xb8, 0x95, 0x7d, 0xcf, 0xdb, 0xc5, 0x3d, 0x5a, 0x47, 0x28, 0xbe, 0xd7, 0x65,
-	0xc3, 0x88, 0xb0, 0xe1, 0xb5, 0x6d, 0x6d, 0x88, 0xe0, 0x75, 0x19, 0xb1, 0x08, 0x63, 0xb1, 0xba,
-	0xf5, 0xaa, 0xa5, 0xdc, 0x7f, 0x1d, 0x0e, 0x29, 0x65, 0xa1, 0x53, 0x91, 0x70, 0xea, 0xc4, 0x46,
-	0xbb, 0x88, 0x95, 0x53, 0x63, 0x22, 0xd1, 0x40, 0xad, 0xaa, 0x16, 0x79, 0x0c, 0xe3, 0xdd, 0xf2,
-	0xa4, 0x4b, 0xbe, 0x09, 0x07, 0xd5, 0x2e, 0x21, 0x6d, 0x77, 0x3c, 0x12, 0xcb, 0x24, 0x5f, 0x85,
-	0xd1, 0x25, 0xc6, 0x9c, 0x38, 0x7f, 0xe6, 0x53, 0x1c, 0x34, 0x4c, 0x90, 0xbf, 0x87, 0xe0, 0xb0,
-	0x14, 0x2c, 0x2d, 0xb9, 0x06, 0xfb, 0xc3, 0x44, 0x52, 0x81, 0x1d, 0x2f, 0x45, 0xc7, 0xaa, 0xa4,
-	0x8e, 0x55, 0x69, 0xc6, 0x5d, 0xaf, 0xe4, 0x7e, 0xf7, 0xf3, 0x8b, 0xfb, 0x43, 0xbe, 0xaa, 0x16,
-	0xed, 0xde, 0xbd, 0x88, 0x1d, 0x85, 0xc3, 0x4b, 0xa2, 0x9a, 0x49, 0xb8, 0x64, 0x19, 0x8e, 0xa8,
-	0x07, 0x12, 0xe2, 0x2c, 0x1c, 0x88, 0x0a, 0x9e, 0x74, 0xf5, 0xb9, 0x6d, 0x5c, 0x1d, 0xb1, 0x4b,
-	0x9f, 0x4a, 0x56, 0xf2, 0x11, 0x82, 0x63, 0x8f, 0x6c, 0xb3, 0xb1, 0xa0, 0xb6, 0x2d, 0x52, 0x8e,
-	0xdf, 0x81, 0xc3, 0x31, 0x9b, 0xee, 0x52, 0x2e, 0x0f, 0xe7, 0xad, 0x90, 0xf3, 0xd3, 0x76, 0xf1,
-	0x54, 0x64, 0x8f, 0x6f, 0x35, 0x4a, 0x36, 0x2b, 0x37, 0x0d, 0xbe, 0x5a, 0x5a, 0xa0, 0x75, 0xc3,
-	0x5c, 0x9f, 0xa3, 0xe6, 0x46, 0xbb, 0x38, 0x1e, 0x25, 0x4f, 0x97, 0x04, 0xa2, 0x8d, 0x3a, 0x49,
-	0x0d, 0x57, 0x01, 0xc2, 0xc2, 0xab, 0xdb, 0xae, 0x45, 0x9f, 0x0a, 0x3f, 0xed, 0xad, 0x9c, 0xd8,
-	0x68, 0x17, 0x8f, 0x47, 0xbc, 0x1d, 0x1a, 0xd1, 0x72, 0x51, 0x85, 0x0e, 0xbf, 0xff, 0x1d, 0xc1,
-	0x64, 0x0c, 0x74, 0x8e, 0xb6, 0xf8, 0xea, 0xd7, 0x6c, 0xbe, 0xaa, 0x19, 0x6e, 0x9d, 0xe2, 0x15,
-	0x38, 0xd6, 0xd1, 0x68, 0x34, 0x59, 0xe0, 0xee, 0x0a, 0xec, 0xa3, 0xf1, 0x7a, 0x46, 0xc8, 0x0c,
-	0x91, 0x3b, 0xec, 0x09, 0xf5, 0xf4, 0x10, 0xd6, 0x66, 0xe4, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x11,
-	0x7a, 0x37, 0xe4, 0x0a, 0x5a, 0x2d, 0xc5, 0xb5, 0xb7, 0x97, 0xab, 0x43, 0x23, 0x5a, 0x4e, 0x2c,
-	0x42, 0x2e, 0xf2, 0xd9, 0x08, 0x14, 0x92, 0x81, 0xa9, 0xba, 0x73, 0xb6, 0x47, 0xcd, 0x30, 0x41,
-	0xd4, 0x09, 0x48, 0xd4, 0x44, 0xb4, 0x6d, 0x4d, 0x2c, 0xc1, 0x41, 0xce, 0x1a, 0xd4, 0xd5, 0xed,
-	0x28, 0x37, 0x73, 0x95, 0xb1, 0x8d, 0x76, 0xf1, 0xa8, 0xf4, 0xb9, 0xa4, 0x10, 0xed, 0x15, 0xf1,
-	0xb5, 0xea, 0x86, 0xa8, 0x7d, 0x6e, 0x78, 0xbc, 0x0f, 0xea, 0x0e, 0x8d, 0x68, 0x39, 0xb1, 0x10,
-	0xb6, 0xde, 0x80, 0xd1, 0xc0, 0xa7, 0xba, 0x19, 0x48, 0x6b, 0xf7, 0x9d, 0x45, 0xd3, 0x07, 0x2b,
-	0x93, 0x1b, 0xed, 0xe2, 0x98, 0xb4, 0x36, 0x41, 0x25, 0x1a, 0x04, 0x3e, 0x9d, 0x0d, 0x62, 0x37,
-	0xd5, 0x58, 0xe0, 0x5a, 0x11, 0xe3, 0xfe, 0x5e, 0x85, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x91, 0x54,
-	0xe8, 0x32, 0x5d, 0x3c, 0x9b, 0x3a, 0x90, 0xa6, 0x50, 0x51, 0x23, 0x85, 0x8b, 0xac, 0x22, 0x16,
-	0x3f, 0xde, 0x0b, 0xc5, 0xbe, 0x1e, 0x96, 0xe7, 0x6c, 0x35, 0x99, 0x59, 0x56, 0x98, 0x75, 0xaa,
-	0x2a, 0x5c, 0xcf, 0x58, 0xdc, 0x7a, 0x0f, 0x98, 0x3c, 0x83, 0x9d, 0xdc, 0x12, 0xb9, 0xec, 0xe3,
-	0x57, 0x61, 0xd4, 0x0c, 0x3c, 0x8f, 0xba, 0x3c, 0x91, 0x5d, 0xda, 0x21, 0xf9, 0x4c, 0xd8, 0xea,
-	0xc0, 0x71, 0xb5, 0x25, 0xe6, 0x16, 0x91, 0xc9, 0x55, 0xee, 0x66, 0xcb, 0xf3, 0xa9, 0xc8, 0x27,
-	0x9b, 0xa4, 0x10, 0xed, 0x98, 0x7c, 0x16, 0x43, 0xc5, 0xef, 0x23, 0xc0, 0x6a, 0xa3, 0xbf, 0xe6,
-	0x71, 0xbd, 0xe5, 0xd9, 0x26, 0x15, 0x11, 0xcd, 0x55, 0x1e, 0x49, 0x7d, 0xe5, 0xba, 0xcd, 0x57,
-	0x83, 0x5a, 0xc9, 0x64, 0xcd, 0xb2, 0xf4, 0xc7, 0x45, 0xc7, 0xa8, 0xf9, 0x6a, 0x21, 0x3e, 0x05,
-	0x8c, 0x8a, 0x5d, 0x8f, 0x30, 0x9c, 0xec, 0xc6, 0xd0, 0x11, 0xdd, 0x01, 0xf1, 0x70, 0xcd, 0xe3,
-	0x4b, 0xe2, 0xd1, 0x5b, 0x70, 0x3a, 0x46, 0xb4, 0x14, 0x9d, 0x0c, 0x71, 0xe4, 0x87, 0x39, 0x02,
-	0xe4, 0x57, 0x08, 0xce, 0xf4, 0x91, 0x26, 0xc3, 0x5d, 0x83, 0x5c, 0xc7, 0xb3, 0x51, 0x9c, 0x5f,
-	0xcf, 0x18, 0xe7, 0x3e, 0xb5, 0x49, 0xbd, 0xd8, 0x63, 0x06, 0x7c, 0x13, 0x46, 0x6b, 0x81, 0xd9,
-	0xa0, 0xbc, 0xab, 0x00, 0x26, 0x32, 0x36, 0x49, 0x25, 0xda, 0xa1, 0x68, 0x19, 0x15, 0xc1, 0xaf,
-	0xc3, 0x99, 0x59, 0xc7, 0xb0, 0x9b, 0x46, 0xcd, 0xa1, 0x0f, 0x5b, 0x1e, 0x35, 0x2c, 0x8d, 0x3e,
-	0x31, 0x3c, 0xcb, 0xdf, 0xf1, 0x5b, 0xfd, 0x47, 0x08, 0x0a, 0xfd, 0x44, 0x4b, 0xe7, 0x7c, 0x1b,
-	0xa6, 0x4c, 0xb5, 0x43, 0xf7, 0xc5, 0x16, 0xdd, 0x8b, 0xf6, 0x48, 0x5f, 0x9d, 0xec, 0x7a, 0xdb,
-	0x29, 0xcf, 0xcc, 0x32, 0xdb, 0xad, 0xbc, 0x16, 0xba, 0x61, 0xa3, 0x5d, 0x2c, 0xca, 0xe8, 0xf7,
-	0x11, 0x44, 0xb4, 0x09, 0x33, 0x15, 0x05, 0x59, 0x86, 0x7c, 0x8c, 0xaf, 0xaa, 0xae, 0x9a, 0x3b,
-	0xb7, 0xfb, 0x83, 0x11, 0x38, 0x95, 0x2a, 0x57, 0x1a, 0xbd, 0x06, 0xe3, 0x1d, 0xac, 0xf1, 0x15,
-	0x37, 0x83, 0xc1, 0xff, 0x27, 0x0d, 0x3e, 0xd5, 0x6b, 0x70, 0x47, 0x08, 0xd1, 0xc6, 0xcc, 0xcd,
-	0xaa, 0x43, 0x95, 0x2b, 0xcc, 0x5b, 0xa1, 0x36, 0xa7, 0x56, 0x52, 0xe5, 0xc8, 0x80, 0x2a, 0xd3,
-	0x84, 0x10, 0x6d, 0x2c, 0x7e, 0xdc, 0x51, 0x49, 0x16, 0xe0, 0x4c, 0x78, 0x95, 0x99, 0x31, 0xcd,
-	0xa0, 0x19, 0x38, 0x06, 0x67, 0x5e, 0x4f, 0x5e, 0x0d, 0x74, 0xce, 0x7e, 0x3d, 0x02, 0x85, 0x7e,
-	0xe2, 0xa4, 0x5b, 0x3f, 0x44, 0x70, 0xaa, 0x2b, 0xf2, 0x7a, 0xdd, 0x63, 0x4f, 0xf8, 0xaa, 0x5e,
-	0x77, 0x58, 0xcd, 0x70, 0xa4, 0x7b, 0x4f, 0xa7, 0xda, 0x3a, 0x47, 0x4d, 0x61, 0xee, 0x95, 0xd0,
-	0xdc, 0x8f, 0x3e, 0x2b, 0x9e, 0x4f, 0xd4, 0x20, 0xd9, 0xa1, 0x45, 0x1f, 0x17, 0x7d, 0xab, 0x51,
-	0xe6, 0xeb, 0x2d, 0xea, 0x2b, 0x1e, 0x5f, 0x9b, 0xf2, 0x13, 0x59, 0x75, 0x4f, 0xe8, 0xbc, 0x27,
-	0x54, 0xe2, 0xef, 0x20, 0x18, 0x0f, 0x5a, 0x61, 0x4b, 0xd5, 0x83, 0x25, 0xf2, 0xfb, 0xd5, 0x8c,
-	0x75, 0x60, 0x59, 0x88, 0x78, 0xe4, 0x19, 0x66, 0x83, 0x7a, 0xbd, 0x21, 0x49, 0x93, 0x4f, 0x34,
-	0x1c, 0x3d, 0x4e, 0xa2, 0x21, 0x1f, 0x20, 0x28, 0x84, 0xf5, 0x29, 0xe1, 0x43, 0x29, 0x73, 0xa8,
-	0x98, 0x0c, 0x79, 0xe9, 0xfa, 0x7c, 0x04, 0x8a, 0x7d, 0x51, 0xc8, 0x50, 0x3e, 0x47, 0x70, 0x23,
-	0x35, 0x94, 0xac, 0x25, 0xce, 0x19, 0xd5, 0x2d, 0xf5, 0x5a, 0xd5, 0xd9, 0x8a, 0xee, 0x18, 0x3e,
-	0xd7, 0xb9, 0x67, 0x3c, 0xa6, 0x9e, 0xff, 0xdf, 0x0c, 0xf4, 0xe5, 0xcd, 0x81, 0x7e, 0x5b, 0x02,
-	0x8a, 0x5f, 0xf3, 0x6f, 0xaf, 0x2c, 0x18, 0x3e, 0x7f, 0xa4, 0xc0, 0xe0, 0x67, 0x70, 0x54, 0x46,
-	0x88, 0x4b, 0x2b, 0x77, 0x14, 0xfc, 0x82, 0x0c, 0xfe, 0x44, 0x57, 0xf0, 0x95, 0x68, 0xa2, 0x1d,
-	0x09, 0x92, 0xdb, 0x7d, 0xf2, 0x5d, 0x04, 0x93, 0xf1, 0xa1, 0xd4, 0x44, 0x13, 0x3d, 0x5c, 0xb0,
-	0x77, 0xab, 0x35, 0xfa, 0x18, 0xc1, 0xd4, 0x66, 0x40, 0x32, 0xee, 0x36, 0x1c, 0xef, 0x6d, 0xf9,
-	0x55, 0x59, 0xfc, 0x72, 0x46, 0x77, 0xf5, 0xc8, 0x96, 0xef, 0xca, 0x63, 0x76, 0x8f, 0xca, 0xdd,
-	0xeb, 0xac, 0xde, 0x43, 0x70, 0x7e, 0x76, 0xfe, 0xfe, 0x7d, 0xd1, 0xb7, 0x59, 0x0b, 0xb6, 0xdb,
-	0x98, 0xf7, 0x58, 0x73, 0x36, 0x01, 0x32, 0xa2, 0x28, 0xaf, 0x3f, 0x80, 0xf1, 0xa4, 0x05, 0x7a,
-	0x77, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2, 0x8b, 0x68, 0xd8, 0xdc, 0x24, 0x99, 0xd8, 0x70, 0x21,
-	0x1b, 0x02, 0xe9, 0xe6, 0x1b, 0x30, 0x6a, 0xae, 0x34, 0x9b, 0x3d, 0xaa, 0x13, 0xd7, 0x85, 0x24,
-	0x95, 0x68, 0x10, 0x2e, 0xa5, 0xaa, 0xfb, 0x70, 0x66, 0xd9, 0xa7, 0xde, 0xb2, 0x5b, 0x63, 0xae,
-	0x65, 0xbb, 0xf5, 0x9d, 0x8d, 0x60, 0xc8, 0x4f, 0x10, 0x14, 0xfa, 0xc9, 0x93, 0x60, 0xdf, 0x43,
-	0x90, 0x8f, 0x47, 0x18, 0xfa, 0x13, 0x9b, 0xaf, 0xea, 0x2d, 0xea, 0xd9, 0xcc, 0xd2, 0x1d, 0x66,
-	0x36, 0x64, 0x76, 0xdc, 0xc9, 0x98, 0x1d, 0x4a, 0x7c, 0x78, 0x97, 0x5a, 0x12, 0x52, 0x16, 0x98,
-	0xd9, 0x90, 0x49, 0x32, 0x19, 0xab, 0xe9, 0x26, 0x93, 0x3c, 0x4c, 0xdd, 0xa3, 0xfc, 0x11, 0xe3,
-	0x86, 0x13, 0x5f, 0xc9, 0x54, 0x1f, 0xfd, 0x7d, 0x04, 0x27, 0x53, 0x88, 0x12, 0x3c, 0x87, 0xa3,
-	0x3c, 0xa4, 0xe8, 0xbd, 0x57, 0xc0, 0x2d, 0x5e, 0xb9, 0x5f, 0x92, 0xa5, 0x69, 0x3a, 0x43, 0x69,
-	0x8a, 0xea, 0xd2, 0x11, 0xde, 0xa5, 0x9d, 0x6c, 0x20, 0x28, 0x2c, 0x06, 0xcd, 0x45, 0xfa, 0x94,
-	0x57, 0x5d, 0x9b, 0xdb, 0x86, 0x63, 0x7f, 0x8b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x17, 0x8e,
-	0xa8, 0x6e, 0x4e, 0xb7, 0xa8, 0xcb, 0x9a, 0xb2, 0xdb, 0x3b, 0xb9, 0xd1, 0x2e, 0x9e, 0xe8, 0xee,
-	0xf6, 0x22, 0x3a, 0xd1, 0x46, 0x65, 0xcf, 0x37, 0x17, 0x2e, 0x71, 0x0d, 0xf2, 0x6e, 0xd0, 0xd4,
-	0x5d, 0xfa, 0x34, 0xbc, 0x83, 0xc6, 0x88, 0x44, 0x57, 0xe2, 0x8b, 0x76, 0x63, 0x5f, 0xe5, 0xdc,
-	0x46, 0xbb, 0xf8, 0x6a, 0x24, 0xac, 0xff, 0x5e, 0xa2, 0x4d, 0xba, 0xe9, 0x86, 0x91, 0x1f, 0x8e,
-	0x40, 0xb1, 0xaf, 0xd1, 0xff, 0xf3, 0xad, 0xd7, 0xe5, 0x7f, 0xe6, 0x61, 0xff, 0x83, 0xb0, 0xa2,
-	0xe1, 0x9f, 0x22, 0x10, 0x43, 0x26, 0x1f, 0x5f, 0xc9, 0x7c, 0x6a, 0x3a, 0x33, 0xb2, 0xfc, 0xd5,
-	0xc1, 0x98, 0x22, 0xcf, 0x93, 0xab, 0xef, 0xff, 0xfe, 0x2f, 0x3f, 0x18, 0x29, 0xe1, 0x0b, 0xe5,
-	0xac, 0xf3, 0xe2, 0x10, 0xe0, 0xcf, 0x10, 0x1c, 0x88, 0xc6, 0x4c, 0x38, 0xb3, 0xda, 0xe4, 0x94,
-	0x2b, 0x7f, 0x6d, 0x40, 0x2e, 0x89, 0xf6, 0x9a, 0x40, 0x5b, 0xc6, 0x17, 0xb3, 0xa2, 0x8d, 0x30,
-	0x7e, 0x8c, 0xe0, 0x70, 0xd7, 0x6c, 0x17, 0xdf, 0xca, 0xfa, 0x92, 0x4f, 0x99, 0x66, 0xe7, 0x6f,
-	0x0f, 0xc7, 0x2c, 0x6d, 0xa8, 0x08, 0x1b, 0x6e, 0xe3, 0x9b, 0xe5, 0xc1, 0x26, 0xf4, 0x7e, 0xf9,
-	0x5d, 0x59, 0x9d, 0x9f, 0xe1, 0xcf, 0x11, 0x9c, 0x48, 0xed, 0x6e, 0xf1, 0xec, 0xa0, 0x2d, 0x6c,
-	0x4a, 0xa7, 0x9d, 0x9f, 0xdb, 0x99, 0x10, 0x69, 0xe8, 0x3d, 0x61, 0xe8, 0x0c, 0xbe, 0x9b, 0xd1,
-	0xd0, 0x4e, 0x05, 0x50, 0x43, 0x32, 0xdd, 0x13, 0x36, 0xfd, 0x2b, 0x39, 0x0e, 0xec, 0x1e, 0xde,
-	0xe0, 0x37, 0x07, 0x85, 0x9a, 0x3a, 0x5e, 0xcb, 0xcf, 0xef, 0x54, 0x8c, 0xb4, 0xb9, 0x2a, 0x6c,
-	0x9e, 0xc5, 0x33, 0x03, 0xdb, 0xec, 0x8a, 0x31, 0x40, 0xe7, 0xfe, 0x8c, 0xff, 0x81, 0x60, 0x22,
-	0xbd, 0x4b, 0xc7, 0x59, 0xe3, 0xb3, 0xe5, 0xfc, 0x20, 0xff, 0xe6, 0x0e, 0xa5, 0x0c, 0x19, 0xe6,
-	0x7e, 0xe3, 0x00, 0xfc, 0x67, 0x04, 0x63, 0x29, 0xed, 0x39, 0x9e, 0x19, 0x14, 0xe7, 0xa6, 0x91,
-	0x41, 0xbe, 0xb2, 0x13, 0x11, 0xd2, 0xce, 0x59, 0x61, 0xe7, 0x1d, 0x7c, 0x6b, 0x60, 0x3b, 0x3b,
-	0x2d, 0x39, 0xfe, 0x2d, 0x82, 0xd1, 0xe4, 0x2f, 0x2a, 0xf8, 0xe6, 0x80, 0x17, 0xa4, 0xc4, 0xcf,
-	0x3a, 0xf9, 0x5b, 0x43, 0xf1, 0x4a, 0x73, 0xee, 0x08, 0x73, 0xae, 0xe3, 0x6b, 0x03, 0x96, 0x21,
-	0xbd, 0xb6, 0xae, 0xdb, 0x16, 0xfe, 0x2b, 0x82, 0x89, 0xf4, 0xbe, 0x3f, 0x73, 0x76, 0x6e, 0x39,
-	0x85, 0xc8, 0x9c, 0x9d, 0x5b, 0x0f, 0x1f, 0xc8, 0x8c, 0x30, 0xf3, 0x16, 0xbe, 0x31, 0xc0, 0xfb,
-	0x4d, 0x37, 0x42, 0x79, 0x71, 0x5e, 0xfe, 0x01, 0xc1, 0xb1, 0xde, 0xce, 0x08, 0xbf, 0x3e, 0x5c,
-	0xdb, 0x13, 0x9b, 0x77, 0x77, 0x68, 0x7e, 0x69, 0xd8, 0x1b, 0xc2, 0xb0, 0x9b, 0xf8, 0x2b, 0xe5,
-	0xe1, 0x7e, 0xb2, 0xf5, 0xf1, 0xdf, 0x10, 0x4c, 0xf6, 0x69, 0xf8, 0x33, 0x97, 0xd5, 0xad, 0xc7,
-	0x16, 0x99, 0xcb, 0xea, 0x36, 0x73, 0x87, 0x81, 0xdf, 0x99, 0xe2, 0xe5, 0x11, 0x45, 0x51, 0xb5,
-	0xe0, 0xf8, 0x97, 0x23, 0xf0, 0xff, 0x59, 0xba, 0x31, 0xac, 0x65, 0x2d, 0x16, 0xd9, 0x9b, 0xcb,
-	0xfc, 0xc3, 0x5d, 0x95, 0x29, 0xbd, 0x62, 0x0b, 0xaf, 0x98, 0xd8, 0xc8, 0x5a, 0x91, 0x12, 0xdd,
-	0xa3, 0xee, 0xd8, 0x6e, 0x43, 0x5f, 0xf1, 0x58, 0x53, 0x4f, 0x32, 0x95, 0xdf, 0x4d, 0xeb, 0x6e,
-	0x9f, 0xe1, 0x7f, 0x23, 0x98, 0x48, 0xef, 0x07, 0x33, 0x1f, 0xf7, 0x2d, 0xdb, 0xd3, 0xcc, 0xc7,
-	0x7d, 0xeb, 0xa6, 0x94, 0x3c, 0x10, 0x2e, 0x79, 0x0b, 0x57, 0x33, 0xba, 0x24, 0xf0, 0xa9, 0xa7,
-	0x07, 0x4a, 0x9e, 0x9e, 0x76, 0xd7, 0xfa, 0x14, 0xc1, 0xf1, 0x4d, 0x8d, 0x24, 0xce, 0x7a, 0x7e,
-	0xfb, 0xf5, 0xa7, 0xf9, 0x37, 0x86, 0x17, 0x30, 0xe4, 0xa1, 0xa8, 0x53, 0xae, 0xf7, 0x34, 0xbd,
-	0xe2, 0x6a, 0xd5, 0xa7, 0x39, 0xcb, 0x5c, 0x03, 0xb6, 0xee, 0x68, 0x33, 0xd7, 0x80, 0x6d, 0x7a,
-	0xc4, 0x81, 0xaf, 0x56, 0xfd, 0x9b, 0xd5, 0xca, 0xea, 0xf3, 0x17, 0x05, 0xf4, 0xc9, 0x8b, 0x02,
-	0xfa, 0xd3, 0x8b, 0x02, 0xfa, 0xf0, 0x65, 0x61, 0xcf, 0x27, 0x2f, 0x0b, 0x7b, 0xfe, 0xf8, 0xb2,
-	0xb0, 0xe7, 0x1b, 0x8b, 0xdb, 0xfd, 0xc6, 0xf5, 0xf8, 0xf2, 0xa5, 0xf2, 0xd3, 0x2e, 0xcd, 0x17,
-	0x3b, 0xaa, 0x4d, 0xc7, 0xa6, 0x2e, 0x8f, 0xfe, 0x2e, 0x14, 0xfd, 0x81, 0xe0, 0x80, 0xf8, 0xb8,
-	0xf2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x97, 0xdd, 0xc0, 0x41, 0x25, 0x00, 0x00,
+	0xf5, 0x4f, 0x8d, 0x13, 0x27, 0xf3, 0xec, 0xc4, 0x49, 0xd9, 0xb1, 0x9d, 0x49, 0x32, 0x93, 0xad,
+	0xff, 0x3f, 0xac, 0x21, 0xc9, 0x0c, 0xf9, 0x22, 0xc4, 0xf9, 0xf4, 0xd8, 0x71, 0xb0, 0xe2, 0x78,
+	0x9d, 0x4e, 0x02, 0x68, 0x0f, 0xf4, 0xf6, 0x74, 0x97, 0x67, 0x5a, 0x33, 0xd3, 0x3d, 0xee, 0xae,
+	0x4e, 0x62, 0x42, 0xa4, 0xd5, 0xee, 0x11, 0x09, 0x16, 0x71, 0x45, 0x48, 0x88, 0x0b, 0x5a, 0x71,
+	0xe4, 0x02, 0x17, 0x04, 0x07, 0x88, 0x38, 0x2c, 0x2b, 0x21, 0xa4, 0xd5, 0x1e, 0x66, 0x21, 0x41,
+	0x08, 0xb1, 0xc0, 0xc1, 0x5c, 0x38, 0xa2, 0xae, 0xae, 0xea, 0xe9, 0x19, 0xf7, 0x38, 0x3d, 0x33,
+	0xde, 0x13, 0x27, 0xbb, 0xfa, 0xd5, 0xfb, 0xf8, 0xbd, 0xf7, 0xea, 0x75, 0xbd, 0xd7, 0x03, 0x67,
+	0x6d, 0xb7, 0x6e, 0xbb, 0xa6, 0x5b, 0xd0, 0x6d, 0x4b, 0xa7, 0x16, 0x73, 0x34, 0x46, 0x8d, 0x9a,
+	0xb9, 0xee, 0x99, 0x86, 0xc9, 0x36, 0x0a, 0x8f, 0xce, 0x96, 0x28, 0xd3, 0xce, 0x16, 0xd6, 0x3d,
+	0xea, 0x6c, 0xe4, 0x1b, 0x8e, 0xcd, 0x6c, 0x7c, 0x52, 0xb0, 0xe4, 0x63, 0x59, 0xf2, 0x82, 0x25,
+	0x33, 0x51, 0xb6, 0xcb, 0x36, 0xe7, 0x28, 0xf8, 0xff, 0x05, 0xcc, 0x99, 0x2f, 0x6c, 0xaf, 0xaf,
+	0xa1, 0x39, 0x5a, 0xdd, 0x15, 0x7b, 0x2f, 0x24, 0xb3, 0x8d, 0x99, 0x7a, 0x75, 0xc9, 0x5a, 0x93,
+	0x1a, 0xb2, 0x3a, 0x67, 0x2b, 0x94, 0x34, 0x97, 0x86, 0x7b, 0x74, 0xdb, 0xb4, 0xa4, 0x05, 0x51,
+	0x3a, 0xc7, 0x15, 0xee, 0x6a, 0x68, 0x65, 0xd3, 0xd2, 0x98, 0x69, 0xcb, 0xbd, 0xc7, 0xca, 0xb6,
+	0x5d, 0xae, 0xd1, 0x82, 0xd6, 0x30, 0x0b, 0x9a, 0x65, 0xd9, 0x8c, 0x13, 0xa5, 0x7d, 0x47, 0x04,
+	0x95, 0xaf, 0x4a, 0xde, 0x5a, 0x41, 0xb3, 0x36, 0x24, 0x29, 0x50, 0xa2, 0x06, 0xf8, 0x83, 0x85,
+	0x20, 0xe5, 0x3a, 0xb9, 0x98, 0x59, 0xa7, 0x2e, 0xd3, 0xea, 0x0d, 0x09, 0xa0, 0x73, 0x83, 0xe1,
+	0x39, 0x51, 0xa3, 0x12, 0xba, 0xa5, 0x61, 0xbb, 0x66, 0x84, 0xeb, 0x6a, 0x32, 0x2e, 0x93, 0x13,
+	0xcd, 0x47, 0x54, 0x75, 0xa8, 0x6e, 0x3b, 0x46, 0xc0, 0x4d, 0x7e, 0x8e, 0x60, 0xe2, 0xa1, 0x4b,
+	0x9d, 0x55, 0x21, 0xd4, 0x55, 0xe8, 0xba, 0x47, 0x5d, 0x86, 0x4f, 0xc3, 0x5e, 0xcd, 0x30, 0x1c,
+	0xea, 0xba, 0xd3, 0xe8, 0x04, 0x9a, 0x49, 0x17, 0xf1, 0x66, 0x33, 0x77, 0x60, 0x43, 0xab, 0xd7,
+	0x66, 0x89, 0x20, 0x10, 0x45, 0x6e, 0xc1, 0xa7, 0x60, 0x6f, 0xc3, 0xb6, 0x6b, 0xaa, 0x69, 0x4c,
+	0xa7, 0x4e, 0xa0, 0x99, 0xdd, 0xd1, 0xdd, 0x82, 0x40, 0x94, 0x61, 0xff, 0xbf, 0x25, 0x03, 0x2f,
+	0x02, 0xb4, 0x02, 0x32, 0x3d, 0x74, 0x02, 0xcd, 0x8c, 0x9c, 0xfb, 0x5c, 0x5e, 0xf8, 0xd2, 0x8f,
+	0x5e, 0x3e, 0xc8, 0x4a, 0x61, 0x7a, 0x7e, 0x55, 0x2b, 0x53, 0x61, 0x96, 0x12, 0xe1, 0x24, 0xbf,
+	0x46, 0x70, 0xb8, 0xc3, 0x76, 0xb7, 0x61, 0x5b, 0x2e, 0xc5, 0x6f, 0x41, 0x5a, 0x7a, 0xc9, 0x37,
+	0x7f, 0x68, 0x66, 0xe4, 0xdc, 0xd5, 0x7c, 0xa2, 0xec, 0xce, 0x2f, 0x7a, 0xb5, 0x9a, 0x14, 0x58,
+	0x74, 0xa8, 0x56, 0x35, 0xec, 0xc7, 0x56, 0x71, 0xf7, 0xf3, 0x66, 0x6e, 0x97, 0xd2, 0x12, 0x8a,
+	0x6f, 0xb7, 0x61, 0x48, 0x71, 0x0c, 0xaf, 0xbf, 0x12, 0x43, 0x60, 0x5e, 0x1b, 0x88, 0x15, 0x18,
+	0x0f, 0xd5, 0x6d, 0x2c, 0x19, 0xd2, 0xfd, 0x97, 0x60, 0x44, 0x2a, 0xf3, 0x9d, 0x8a, 0xb8, 0x53,
+	0x27, 0x37, 0x9b, 0x39, 0x2c, 0x9d, 0x1a, 0x12, 0x89, 0x02, 0x72, 0xb5, 0x64, 0x90, 0x47, 0x30,
+	0xd1, 0x2e, 0x4f, 0xb8, 0xe4, 0x1b, 0xb0, 0x4f, 0xee, 0xe2, 0xd2, 0x76, 0xc6, 0x23, 0xa1, 0x4c,
+	0xf2, 0x55, 0x18, 0x5d, 0xb5, 0xed, 0x5a, 0x98, 0x3f, 0x8b, 0x31, 0x0e, 0xea, 0x27, 0xc8, 0xdf,
+	0x45, 0xb0, 0x5f, 0x08, 0x16, 0x48, 0x2e, 0xc2, 0x1e, 0x3f, 0x91, 0x64, 0x60, 0x27, 0xf2, 0xc1,
+	0xb1, 0xca, 0xcb, 0x63, 0x95, 0x9f, 0xb3, 0x36, 0x8a, 0xe9, 0xdf, 0xfd, 0xec, 0xcc, 0x1e, 0x9f,
+	0x6f, 0x49, 0x09, 0x76, 0xef, 0x5c, 0xc4, 0xc6, 0x60, 0xff, 0x2a, 0xaf, 0x66, 0xc2, 0x5c, 0xf2,
+	0x10, 0x0e, 0xc8, 0x07, 0xc2, 0xc4, 0x79, 0x18, 0x0e, 0x0a, 0x9e, 0x70, 0xf5, 0xc9, 0x57, 0xb8,
+	0x3a, 0x60, 0x17, 0x3e, 0x15, 0xac, 0xe4, 0x7d, 0x04, 0x07, 0x1f, 0x98, 0x7a, 0x75, 0x59, 0x6e,
+	0x5b, 0xa1, 0x0c, 0xbf, 0x05, 0xfb, 0x43, 0x36, 0xd5, 0xa2, 0x4c, 0x1c, 0xce, 0x2b, 0x3e, 0xe7,
+	0xc7, 0xcd, 0xdc, 0xd1, 0x00, 0x8f, 0x6b, 0x54, 0xf3, 0xa6, 0x5d, 0xa8, 0x6b, 0xac, 0x92, 0x5f,
+	0xa6, 0x65, 0x4d, 0xdf, 0x58, 0xa0, 0xfa, 0x66, 0x33, 0x37, 0x11, 0x24, 0x4f, 0x9b, 0x04, 0xa2,
+	0x8c, 0xd6, 0xa2, 0x1a, 0x2e, 0x00, 0xf8, 0x85, 0x57, 0x35, 0x2d, 0x83, 0x3e, 0xe1, 0x7e, 0x1a,
+	0x2a, 0x1e, 0xde, 0x6c, 0xe6, 0x0e, 0x05, 0xbc, 0x2d, 0x1a, 0x51, 0xd2, 0x41, 0x85, 0xf6, 0xff,
+	0xff, 0x27, 0x82, 0xa9, 0xd0, 0xd0, 0x05, 0xda, 0x60, 0x95, 0xaf, 0x99, 0xac, 0xa2, 0x68, 0x56,
+	0x99, 0xe2, 0x35, 0x38, 0xd8, 0xd2, 0xa8, 0xd5, 0x6d, 0xcf, 0xda, 0x11, 0xb3, 0xc7, 0xc2, 0xf5,
+	0x1c, 0x97, 0xe9, 0x5b, 0x5e, 0xb3, 0x1f, 0x53, 0x47, 0xf5, 0xcd, 0xda, 0x6a, 0x79, 0x8b, 0x46,
+	0x94, 0x34, 0x5f, 0xf8, 0xde, 0xf5, 0xb9, 0xbc, 0x46, 0x43, 0x72, 0x0d, 0x75, 0x72, 0xb5, 0x68,
+	0x44, 0x49, 0xf3, 0x85, 0xcf, 0x45, 0x3e, 0x49, 0x41, 0x36, 0x1a, 0x98, 0x25, 0x6b, 0xc1, 0x74,
+	0xa8, 0xee, 0x27, 0x88, 0x3c, 0x01, 0x91, 0x9a, 0x88, 0x5e, 0x59, 0x13, 0xf3, 0xb0, 0x8f, 0xd9,
+	0x55, 0x6a, 0xa9, 0x66, 0x90, 0x9b, 0xe9, 0xe2, 0xf8, 0x66, 0x33, 0x37, 0x26, 0x7c, 0x2e, 0x28,
+	0x44, 0xd9, 0xcb, 0xff, 0x5d, 0xb2, 0x7c, 0xab, 0x5d, 0xa6, 0x39, 0xac, 0x8b, 0xd5, 0x2d, 0x1a,
+	0x51, 0xd2, 0x7c, 0xc1, 0xb1, 0x5e, 0x86, 0x51, 0xcf, 0xa5, 0xaa, 0xee, 0x09, 0xb4, 0xbb, 0x4f,
+	0xa0, 0x99, 0x7d, 0xc5, 0xa9, 0xcd, 0x66, 0x6e, 0x5c, 0xa0, 0x8d, 0x50, 0x89, 0x02, 0x9e, 0x4b,
+	0xe7, 0xbd, 0xd0, 0x4d, 0x25, 0xdb, 0xb3, 0x8c, 0x80, 0x71, 0x4f, 0xa7, 0xc2, 0x16, 0x8d, 0x28,
+	0x69, 0xbe, 0x88, 0x2a, 0xb4, 0x6c, 0x95, 0x3f, 0x9b, 0x1e, 0x8e, 0x53, 0x28, 0xa9, 0x81, 0xc2,
+	0x15, 0xbb, 0xc8, 0x17, 0x3f, 0x1a, 0x82, 0x5c, 0x57, 0x0f, 0x8b, 0x73, 0x56, 0x89, 0x66, 0x96,
+	0xe1, 0x67, 0x9d, 0xac, 0x0a, 0x97, 0x12, 0x16, 0xb7, 0xce, 0x03, 0x26, 0xce, 0xe0, 0x58, 0xad,
+	0x2d, 0x97, 0x5d, 0xfc, 0x1a, 0x8c, 0xea, 0x9e, 0xe3, 0x50, 0x8b, 0x45, 0xb2, 0x4b, 0x19, 0x11,
+	0xcf, 0x38, 0xd6, 0x1a, 0x1c, 0x92, 0x5b, 0x42, 0x6e, 0x1e, 0x99, 0x74, 0xf1, 0x46, 0xb2, 0x3c,
+	0x9f, 0x0e, 0x7c, 0xb2, 0x45, 0x0a, 0x51, 0x0e, 0x8a, 0x67, 0xa1, 0xa9, 0xf8, 0x1d, 0x04, 0x58,
+	0x6e, 0x74, 0xd7, 0x1d, 0xa6, 0x36, 0x1c, 0x53, 0xa7, 0x3c, 0xa2, 0xe9, 0xe2, 0x03, 0xa1, 0xaf,
+	0x50, 0x36, 0x59, 0xc5, 0x2b, 0xe5, 0x75, 0xbb, 0x5e, 0x10, 0xfe, 0x38, 0x53, 0xd3, 0x4a, 0xae,
+	0x5c, 0xf0, 0xbf, 0xdc, 0x8c, 0xa2, 0x59, 0x0e, 0x6c, 0x38, 0xd2, 0x6e, 0x43, 0x4b, 0x74, 0xcb,
+	0x88, 0xfb, 0xeb, 0x0e, 0x5b, 0xe5, 0x8f, 0xee, 0xc0, 0xb1, 0xd0, 0xa2, 0xd5, 0xe0, 0x64, 0xf0,
+	0x23, 0xdf, 0xcf, 0x11, 0x20, 0xbf, 0x44, 0x70, 0xbc, 0x8b, 0x34, 0x11, 0xee, 0x12, 0xa4, 0x5b,
+	0x9e, 0x0d, 0xe2, 0x7c, 0x3d, 0x61, 0x9c, 0xbb, 0xd4, 0x26, 0xf9, 0x62, 0x0f, 0x19, 0xf0, 0x2c,
+	0x8c, 0x96, 0x3c, 0xbd, 0x4a, 0x59, 0x5b, 0x01, 0x8c, 0x64, 0x6c, 0x94, 0x4a, 0x94, 0x91, 0x60,
+	0x19, 0x14, 0xc1, 0xaf, 0xc3, 0xf1, 0xf9, 0x9a, 0x66, 0xd6, 0xb5, 0x52, 0x8d, 0xde, 0x6f, 0x38,
+	0x54, 0x33, 0x14, 0xfa, 0x58, 0x73, 0x0c, 0x77, 0xe0, 0xb7, 0xfa, 0x0f, 0x11, 0x64, 0xbb, 0x89,
+	0x16, 0xce, 0xf9, 0x16, 0x4c, 0xeb, 0x72, 0x87, 0xea, 0xf2, 0x2d, 0xaa, 0x13, 0xec, 0x11, 0xbe,
+	0x3a, 0xd2, 0xf6, 0xb6, 0x93, 0x9e, 0x99, 0xb7, 0x4d, 0xab, 0xf8, 0xba, 0xef, 0x86, 0xcd, 0x66,
+	0x2e, 0x27, 0xa2, 0xdf, 0x45, 0x10, 0x51, 0x26, 0xf5, 0x58, 0x2b, 0xc8, 0x43, 0xc8, 0x84, 0xf6,
+	0x2d, 0xc9, 0xab, 0xe6, 0xe0, 0xb8, 0xdf, 0x4d, 0xc1, 0xd1, 0x58, 0xb9, 0x02, 0xf4, 0x3a, 0x4c,
+	0xb4, 0x6c, 0x0d, 0xaf, 0xb8, 0x09, 0x00, 0xff, 0x9f, 0x00, 0x7c, 0xb4, 0x13, 0x70, 0x4b, 0x08,
+	0x51, 0xc6, 0xf5, 0xad, 0xaa, 0x7d, 0x95, 0x6b, 0xb6, 0xb3, 0x46, 0x4d, 0x46, 0x8d, 0xa8, 0xca,
+	0x54, 0x8f, 0x2a, 0xe3, 0x84, 0x10, 0x65, 0x3c, 0x7c, 0xdc, 0x52, 0x49, 0x96, 0xe1, 0xb8, 0x7f,
+	0x95, 0x99, 0xd3, 0x75, 0xaf, 0xee, 0xd5, 0x34, 0x66, 0x3b, 0x1d, 0x79, 0xd5, 0xd3, 0x39, 0xfb,
+	0x55, 0x0a, 0xb2, 0xdd, 0xc4, 0x09, 0xb7, 0xbe, 0x87, 0xe0, 0x68, 0x5b, 0xe4, 0xd5, 0xb2, 0x63,
+	0x3f, 0x66, 0x15, 0xb5, 0x5c, 0xb3, 0x4b, 0x5a, 0x4d, 0xb8, 0xf7, 0x58, 0x2c, 0xd6, 0x05, 0xaa,
+	0x73, 0xb8, 0xe7, 0x7d, 0xb8, 0xef, 0x7f, 0x92, 0x3b, 0x15, 0xa9, 0x41, 0xc1, 0x7e, 0xf1, 0xe7,
+	0x8c, 0x6b, 0x54, 0x0b, 0x6c, 0xa3, 0x41, 0x5d, 0xc9, 0xe3, 0x2a, 0xd3, 0x6e, 0x24, 0xab, 0x6e,
+	0x73, 0x9d, 0xb7, 0xb9, 0x4a, 0xfc, 0x6d, 0x04, 0x13, 0x5e, 0x83, 0x99, 0x75, 0xda, 0x61, 0x4b,
+	0xe0, 0xf7, 0x0b, 0x09, 0xeb, 0xc0, 0x43, 0x2e, 0xe2, 0x81, 0xa3, 0xe9, 0x55, 0xea, 0x74, 0x86,
+	0x24, 0x4e, 0x3e, 0x51, 0x70, 0xf0, 0x38, 0x6a, 0x0d, 0x79, 0x17, 0x41, 0xd6, 0xaf, 0x4f, 0x11,
+	0x1f, 0x0a, 0x99, 0x7d, 0xc5, 0xa4, 0xcf, 0x4b, 0xd7, 0xa7, 0x29, 0xc8, 0x75, 0xb5, 0x42, 0x84,
+	0xf2, 0x39, 0x82, 0xcb, 0xb1, 0xa1, 0xb4, 0x1b, 0xfc, 0x9c, 0x51, 0xd5, 0x90, 0xaf, 0x55, 0xd5,
+	0x5e, 0x53, 0x6b, 0x9a, 0xcb, 0x54, 0xe6, 0x68, 0x8f, 0xa8, 0xe3, 0x7e, 0x96, 0x81, 0x3e, 0xb7,
+	0x35, 0xd0, 0x6f, 0x08, 0x83, 0xc2, 0xd7, 0xfc, 0x1b, 0x6b, 0xcb, 0x9a, 0xcb, 0x1e, 0x48, 0x63,
+	0xf0, 0x33, 0x18, 0x13, 0x11, 0x62, 0x02, 0xe5, 0x40, 0xc1, 0xcf, 0x8a, 0xe0, 0x4f, 0xb6, 0x05,
+	0x5f, 0x8a, 0x26, 0xca, 0x01, 0x2f, 0xba, 0xdd, 0x25, 0xdf, 0x41, 0x30, 0x15, 0x1e, 0x4a, 0x85,
+	0x37, 0xd1, 0xfd, 0x05, 0x7b, 0xa7, 0x5a, 0xa3, 0x0f, 0x10, 0x4c, 0x6f, 0x35, 0x48, 0xc4, 0xdd,
+	0x84, 0x43, 0x9d, 0x2d, 0xbf, 0x2c, 0x8b, 0x5f, 0x4a, 0xe8, 0xae, 0x0e, 0xd9, 0xe2, 0x5d, 0x79,
+	0xd0, 0xec, 0x50, 0xb9, 0x73, 0x9d, 0xd5, 0xdb, 0x08, 0x4e, 0xcd, 0x2f, 0xde, 0xbd, 0xcb, 0xfb,
+	0x36, 0x63, 0xd9, 0xb4, 0xaa, 0x8b, 0x8e, 0x5d, 0x9f, 0x8f, 0x18, 0x19, 0x50, 0xa4, 0xd7, 0xef,
+	0xc1, 0x44, 0x14, 0x81, 0xda, 0x1e, 0x82, 0x5c, 0xa4, 0xbc, 0xc7, 0xec, 0x22, 0x0a, 0xd6, 0xb7,
+	0x48, 0x26, 0x26, 0x9c, 0x4e, 0x66, 0x81, 0x70, 0xf3, 0x65, 0x18, 0xd5, 0xd7, 0xea, 0xf5, 0x0e,
+	0xd5, 0x91, 0xeb, 0x42, 0x94, 0x4a, 0x14, 0xf0, 0x97, 0x42, 0xd5, 0x5d, 0x38, 0xee, 0x4f, 0x2f,
+	0x1e, 0x5a, 0x25, 0xdb, 0x32, 0x4c, 0xab, 0x3c, 0xd8, 0x08, 0x86, 0xfc, 0x18, 0x41, 0xb6, 0x9b,
+	0x3c, 0x61, 0xec, 0xdb, 0x08, 0x32, 0xe1, 0x08, 0x43, 0x7d, 0x6c, 0xb2, 0x8a, 0xda, 0xa0, 0x8e,
+	0x69, 0x1b, 0x6a, 0xcd, 0xd6, 0xab, 0x22, 0x3b, 0xae, 0x25, 0xcc, 0x0e, 0x29, 0xde, 0xbf, 0x4b,
+	0xad, 0x72, 0x29, 0xcb, 0xb6, 0x5e, 0x15, 0x49, 0x32, 0x15, 0xaa, 0x69, 0x27, 0x93, 0x0c, 0x4c,
+	0xdf, 0xa6, 0xec, 0x81, 0xcd, 0xb4, 0x5a, 0x78, 0x25, 0x93, 0x7d, 0xf4, 0xf7, 0x10, 0x1c, 0x89,
+	0x21, 0x0a, 0xe3, 0x19, 0x8c, 0x31, 0x9f, 0xa2, 0x76, 0x5e, 0x01, 0xb7, 0x79, 0xe5, 0x7e, 0x51,
+	0x94, 0xa6, 0x99, 0x04, 0xa5, 0x29, 0xa8, 0x4b, 0x07, 0x58, 0x9b, 0x76, 0xb2, 0x89, 0x20, 0xbb,
+	0xe2, 0xd5, 0x57, 0xe8, 0x13, 0xb6, 0x64, 0x99, 0xcc, 0xd4, 0x6a, 0xe6, 0x37, 0x29, 0xef, 0x6d,
+	0xfa, 0x3b, 0xfb, 0x37, 0xe0, 0x80, 0xec, 0xe6, 0x54, 0x83, 0x5a, 0x76, 0x5d, 0x74, 0x7b, 0x47,
+	0x36, 0x9b, 0xb9, 0xc3, 0xed, 0xdd, 0x5e, 0x40, 0x27, 0xca, 0xa8, 0xe8, 0xf9, 0x16, 0xfc, 0x25,
+	0x2e, 0x41, 0xc6, 0xf2, 0xea, 0xaa, 0x45, 0x9f, 0xf8, 0x77, 0xd0, 0xd0, 0x22, 0xde, 0x95, 0xb8,
+	0xbc, 0xdd, 0xd8, 0x5d, 0x3c, 0xb9, 0xd9, 0xcc, 0xbd, 0x16, 0x08, 0xeb, 0xbe, 0x97, 0x28, 0x53,
+	0x56, 0x3c, 0x30, 0xf2, 0x83, 0x14, 0xe4, 0xba, 0x82, 0xfe, 0x9f, 0x6f, 0xbd, 0xc8, 0x47, 0x08,
+	0xc6, 0xdb, 0x8c, 0x9f, 0xaf, 0xf0, 0x39, 0x47, 0xfb, 0x4b, 0x1c, 0x25, 0x7b, 0x89, 0x6f, 0x9d,
+	0xe8, 0xa4, 0x76, 0x7a, 0xa2, 0xf3, 0x79, 0x18, 0xae, 0x50, 0xb3, 0x5c, 0x61, 0x62, 0x4e, 0x70,
+	0x68, 0xb3, 0x99, 0xdb, 0x1f, 0xf0, 0x05, 0xcf, 0x89, 0x22, 0x36, 0xf8, 0xf7, 0x9a, 0xa3, 0x31,
+	0xd0, 0xfa, 0xcb, 0xf5, 0x4b, 0x30, 0xb2, 0xe6, 0xd8, 0x75, 0x55, 0x28, 0x0f, 0x6e, 0x35, 0x91,
+	0x5b, 0x7f, 0x84, 0x48, 0x14, 0xf0, 0x57, 0x5f, 0x09, 0x16, 0xbf, 0x4f, 0xc1, 0xb1, 0x78, 0x2b,
+	0x44, 0xf2, 0xbd, 0x09, 0x7b, 0xf5, 0xe0, 0x91, 0xc8, 0xb9, 0xd9, 0x7e, 0x72, 0x2e, 0x90, 0x2a,
+	0xd2, 0x4e, 0x0a, 0x8c, 0x78, 0x2b, 0xf5, 0x0a, 0x6f, 0xf9, 0xbd, 0x62, 0x5b, 0x66, 0x0e, 0x75,
+	0xf6, 0x8a, 0x51, 0x2a, 0x49, 0x90, 0xb2, 0xbb, 0x3f, 0xab, 0x94, 0xbd, 0xd3, 0xe1, 0xd0, 0xfb,
+	0x96, 0xd6, 0x70, 0x2b, 0x36, 0xeb, 0xab, 0x81, 0x78, 0x0a, 0xc7, 0xbb, 0x08, 0x13, 0xe1, 0x29,
+	0xc0, 0x3e, 0x57, 0x3c, 0xe3, 0xe2, 0x46, 0xa3, 0xc3, 0x2c, 0x49, 0x21, 0x4a, 0xb8, 0xa9, 0x07,
+	0x9f, 0x9f, 0xfb, 0x6d, 0x16, 0xf6, 0xdc, 0xf3, 0xaf, 0x13, 0xf8, 0x27, 0x08, 0xf8, 0x84, 0xd7,
+	0xc5, 0xe7, 0x13, 0xbf, 0xb2, 0x5a, 0x03, 0xea, 0xcc, 0x85, 0xde, 0x98, 0x02, 0x68, 0xe4, 0xc2,
+	0x3b, 0x7f, 0xf8, 0xcb, 0xf7, 0x53, 0x79, 0x7c, 0xba, 0x90, 0xf4, 0x63, 0x8d, 0x6f, 0xe0, 0x4f,
+	0x11, 0x0c, 0x07, 0x33, 0x5e, 0x9c, 0x58, 0x6d, 0x74, 0xc4, 0x9c, 0xb9, 0xd8, 0x23, 0x97, 0xb0,
+	0xf6, 0x22, 0xb7, 0xb6, 0x80, 0xcf, 0x24, 0xb5, 0x36, 0xb0, 0xf1, 0x03, 0x04, 0xfb, 0xdb, 0x3e,
+	0xac, 0xe0, 0x2b, 0x49, 0x6f, 0xd8, 0x31, 0x9f, 0x92, 0x32, 0x57, 0xfb, 0x63, 0x16, 0x18, 0x8a,
+	0x1c, 0xc3, 0x55, 0x3c, 0x5b, 0xe8, 0xed, 0xf3, 0x98, 0x5b, 0x78, 0x2a, 0xae, 0x46, 0xcf, 0xf0,
+	0xa7, 0x08, 0x0e, 0xc7, 0x8e, 0x96, 0xf0, 0x7c, 0xaf, 0xf3, 0xa3, 0x98, 0x31, 0x57, 0x66, 0x61,
+	0x30, 0x21, 0x02, 0xe8, 0x6d, 0x0e, 0x74, 0x0e, 0xdf, 0x48, 0x08, 0x34, 0x7c, 0xa2, 0xca, 0x09,
+	0xb5, 0xea, 0x70, 0x4c, 0xff, 0x8e, 0xce, 0xe2, 0xdb, 0x27, 0xa7, 0xf8, 0x56, 0xaf, 0xa6, 0xc6,
+	0xce, 0xb6, 0x33, 0x8b, 0x83, 0x8a, 0x11, 0x98, 0x97, 0x38, 0xe6, 0x79, 0x3c, 0xd7, 0x33, 0x66,
+	0x8b, 0xcf, 0xe0, 0x5a, 0xcd, 0x2b, 0xfe, 0x17, 0x82, 0xc9, 0xf8, 0x11, 0x19, 0x4e, 0x1a, 0x9f,
+	0x6d, 0x87, 0x77, 0x99, 0x5b, 0x03, 0x4a, 0xe9, 0x33, 0xcc, 0xdd, 0x66, 0x71, 0xf8, 0xcf, 0x08,
+	0xc6, 0x63, 0x66, 0x63, 0x78, 0xae, 0x57, 0x3b, 0xb7, 0xcc, 0xeb, 0x32, 0xc5, 0x41, 0x44, 0x08,
+	0x9c, 0xf3, 0x1c, 0xe7, 0x35, 0x7c, 0xa5, 0x67, 0x9c, 0xad, 0x79, 0x18, 0xfe, 0x0d, 0xf2, 0x3f,
+	0x2b, 0xb6, 0x3e, 0x67, 0xe2, 0xd9, 0x1e, 0xbb, 0x93, 0xc8, 0x37, 0xd5, 0xcc, 0x95, 0xbe, 0x78,
+	0x05, 0x9c, 0x6b, 0x1c, 0xce, 0x25, 0x7c, 0xb1, 0xc7, 0x32, 0xa4, 0x96, 0x36, 0x54, 0xd3, 0xc0,
+	0x7f, 0x43, 0x30, 0x19, 0x3f, 0x74, 0x4b, 0x9c, 0x9d, 0xdb, 0x8e, 0x00, 0x33, 0xb7, 0x06, 0x94,
+	0x22, 0x60, 0xce, 0x71, 0x98, 0x57, 0xf0, 0xe5, 0x1e, 0xde, 0x6f, 0xaa, 0xe6, 0xcb, 0x0b, 0xf3,
+	0xf2, 0x8f, 0x08, 0x0e, 0x76, 0x8e, 0x25, 0xf0, 0xf5, 0xfe, 0x66, 0x0e, 0x21, 0xbc, 0x1b, 0x7d,
+	0xf3, 0x0b, 0x60, 0x37, 0x39, 0xb0, 0x59, 0xfc, 0xe5, 0x42, 0x7f, 0xbf, 0x97, 0x70, 0xf1, 0x3f,
+	0x10, 0x4c, 0x75, 0x99, 0xb6, 0x25, 0x2e, 0xab, 0xdb, 0xcf, 0x0c, 0x33, 0x8b, 0x83, 0x8a, 0xe9,
+	0xf3, 0x9d, 0xc9, 0x5f, 0x1e, 0x41, 0x14, 0xe5, 0xfc, 0x0b, 0xff, 0x22, 0x05, 0xff, 0x9f, 0x64,
+	0x14, 0x82, 0x95, 0xa4, 0xc5, 0x22, 0xf9, 0x64, 0x27, 0x73, 0x7f, 0x47, 0x65, 0x0a, 0xaf, 0x98,
+	0xdc, 0x2b, 0x3a, 0xd6, 0x92, 0x56, 0xa4, 0xc8, 0xe8, 0x46, 0xad, 0x99, 0x56, 0x55, 0xe5, 0x1d,
+	0x4b, 0x94, 0xa9, 0xf0, 0x34, 0x6e, 0xb4, 0xf4, 0x0c, 0xff, 0x07, 0xc1, 0x64, 0xfc, 0x30, 0x26,
+	0xf1, 0x71, 0xdf, 0x76, 0x36, 0x94, 0xb9, 0x35, 0xa0, 0x14, 0xe1, 0x92, 0x7b, 0xdc, 0x25, 0x77,
+	0xf0, 0x52, 0x42, 0x97, 0x78, 0x2e, 0x75, 0x54, 0x4f, 0xca, 0x53, 0xe3, 0xee, 0x5a, 0x1f, 0x23,
+	0x38, 0xb4, 0x65, 0x8a, 0x83, 0x93, 0x9e, 0xdf, 0x6e, 0xc3, 0xa1, 0xcc, 0xcd, 0xfe, 0x05, 0xf4,
+	0x79, 0x28, 0xca, 0x94, 0xa9, 0x1d, 0x13, 0x27, 0x7e, 0xb5, 0xea, 0x32, 0x19, 0x49, 0x5c, 0x03,
+	0xb6, 0x1f, 0x27, 0x65, 0x16, 0x07, 0x15, 0xd3, 0xe7, 0xd5, 0xaa, 0xfb, 0xa4, 0x08, 0xff, 0x15,
+	0xc1, 0x44, 0x5c, 0x3f, 0x8e, 0x8b, 0xfd, 0xb7, 0xdd, 0x21, 0xde, 0xf9, 0x81, 0x64, 0x08, 0xb0,
+	0xb7, 0x38, 0xd8, 0x1b, 0xf8, 0x5a, 0x2f, 0x05, 0x2f, 0x7c, 0xac, 0xca, 0xde, 0xff, 0xef, 0x08,
+	0x0e, 0xc7, 0xb6, 0xb6, 0xb8, 0x2f, 0x2b, 0x3b, 0xba, 0xec, 0xcc, 0xc2, 0x60, 0x42, 0x04, 0xd6,
+	0x45, 0x8e, 0xf5, 0x26, 0xbe, 0xde, 0x1f, 0x56, 0xd9, 0x74, 0x17, 0x2b, 0xcf, 0x5f, 0x64, 0xd1,
+	0x87, 0x2f, 0xb2, 0xe8, 0x4f, 0x2f, 0xb2, 0xe8, 0xbd, 0x97, 0xd9, 0x5d, 0x1f, 0xbe, 0xcc, 0xee,
+	0xfa, 0xe8, 0x65, 0x76, 0xd7, 0x9b, 0x2b, 0xaf, 0xfa, 0xd9, 0xc0, 0xa3, 0x73, 0x67, 0x0b, 0x4f,
+	0xda, 0xd4, 0x9e, 0x69, 0xe9, 0xd5, 0x6b, 0x26, 0xb5, 0x58, 0xf0, 0x0b, 0xcc, 0xe0, 0x37, 0x59,
+	0xc3, 0xfc, 0xcf, 0xf9, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xd4, 0x9d, 0x10, 0x94, 0x2a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1872,6 +2165,14 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// TickLiquidityChanges returns the latest liquidity net of every tick of the
+	// given pool that changed after the given height. Clients can keep a tick
+	// map up to date by applying the changes and querying again from the
+	// returned height.
+	TickLiquidityChanges(ctx context.Context, in *TickLiquidityChangesRequest, opts ...grpc.CallOption) (*TickLiquidityChangesResponse, error)
+	// TickLiquiditySnapshot returns all initialized ticks of the given pool
+	// encoded in the compact binary tick snapshot format.
+	TickLiquiditySnapshot(ctx context.Context, in *TickLiquiditySnapshotRequest, opts ...grpc.CallOption) (*TickLiquiditySnapshotResponse, error)
 }
 
 type queryClient struct {
@@ -2017,6 +2318,24 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) TickLiquidityChanges(ctx context.Context, in *TickLiquidityChangesRequest, opts ...grpc.CallOption) (*TickLiquidityChangesResponse, error) {
+	out := new(TickLiquidityChangesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickLiquidityChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TickLiquiditySnapshot(ctx context.Context, in *TickLiquiditySnapshotRequest, opts ...grpc.CallOption) (*TickLiquiditySnapshotResponse, error) {
+	out := new(TickLiquiditySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickLiquiditySnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2059,6 +2378,14 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// TickLiquidityChanges returns the latest liquidity net of every tick of the
+	// given pool that changed after the given height. Clients can keep a tick
+	// map up to date by applying the changes and querying again from the
+	// returned height.
+	TickLiquidityChanges(context.Context, *TickLiquidityChangesRequest) (*TickLiquidityChangesResponse, error)
+	// TickLiquiditySnapshot returns all initialized ticks of the given pool
+	// encoded in the compact binary tick snapshot format.
+	TickLiquiditySnapshot(context.Context, *TickLiquiditySnapshotRequest) (*TickLiquiditySnapshotResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2110,6 +2437,12 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) TickLiquidityChanges(ctx context.Context, req *TickLiquidityChangesRequest) (*TickLiquidityChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickLiquidityChanges not implemented")
+}
+func (*UnimplementedQueryServer) TickLiquiditySnapshot(ctx context.Context, req *TickLiquiditySnapshotRequest) (*TickLiquiditySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickLiquiditySnapshot not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2385,6 +2718,42 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TickLiquidityChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickLiquidityChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickLiquidityChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickLiquidityChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickLiquidityChanges(ctx, req.(*TickLiquidityChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TickLiquiditySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickLiquiditySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickLiquiditySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickLiquiditySnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickLiquiditySnapshot(ctx, req.(*TickLiquiditySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2449,6 +2818,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "TickLiquidityChanges",
+			Handler:    _Query_TickLiquidityChanges_Handler,
+		},
+		{
+			MethodName: "TickLiquiditySnapshot",
+			Handler:    _Query_TickLiquiditySnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3700,41 +4077,237 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TickLiquidityChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TickLiquidityChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TickLiquidityChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquidityChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquidityChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquidityChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquiditySnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquiditySnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquiditySnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquiditySnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquiditySnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquiditySnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Positions) > 0 {
@@ -4215,6 +4788,89 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	return n
 }
 
+func (m *TickLiquidityChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *TickLiquidityChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *TickLiquidityChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquiditySnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *TickLiquiditySnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7369,6 +8025,544 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TickLiquidityChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, TickLiquidityChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquiditySnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquiditySnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquiditySnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquiditySnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquiditySnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquiditySnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
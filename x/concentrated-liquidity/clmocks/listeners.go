@@ -10,6 +10,7 @@ type ConcentratedLiquidityListenerMock struct {
 	AfterConcentratedPoolCreatedCallCount    int
 	AfterInitialPoolPositionCreatedCallCount int
 	AfterLastPoolPositionRemovedCallCount    int
+	AfterPoolPositionWithdrawnCallCount      int
 	AfterConcentratedPoolSwapCallCount       int
 }
 
@@ -27,6 +28,10 @@ func (l *ConcentratedLiquidityListenerMock) AfterLastPoolPositionRemoved(ctx sdk
 	l.AfterLastPoolPositionRemovedCallCount += 1
 }
 
+func (l *ConcentratedLiquidityListenerMock) AfterPoolPositionWithdrawn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.AfterPoolPositionWithdrawnCallCount += 1
+}
+
 func (l *ConcentratedLiquidityListenerMock) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.AfterConcentratedPoolSwapCallCount += 1
 }
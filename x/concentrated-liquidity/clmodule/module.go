@@ -124,8 +124,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock performs a no-op.
+// EndBlock prunes the incentive tick ranges without live incentive records.
+// Pruning errors are logged rather than halting the chain, the tick ranges are pruned by the following blocks.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if err := am.keeper.PruneIncentiveTickRanges(ctx); err != nil {
+		ctx.Logger().Error("failed to prune incentive tick ranges", "error", err.Error())
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,122 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+// SetDynamicSpreadFactorRecords enables, updates or disables the dynamic spread factor of the pools of the given records.
+// Returns error if:
+// - a record is invalid
+// - the pool of a record does not exist
+func (k Keeper) SetDynamicSpreadFactorRecords(ctx sdk.Context, records []types.DynamicSpreadFactorRecord) error {
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if _, err := k.getPoolById(ctx, record.PoolId); err != nil {
+			return err
+		}
+
+		if !record.Enabled {
+			k.deleteDynamicSpreadFactorRecord(ctx, record.PoolId)
+			continue
+		}
+
+		k.setDynamicSpreadFactorRecord(ctx, record)
+	}
+	return nil
+}
+
+// GetDynamicSpreadFactorRecord returns the dynamic spread factor record of the given pool and true if
+// the pool has a dynamic spread factor. False otherwise.
+func (k Keeper) GetDynamicSpreadFactorRecord(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorRecord, bool, error) {
+	record := types.DynamicSpreadFactorRecord{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorRecord(poolId), &record)
+	if err != nil {
+		return types.DynamicSpreadFactorRecord{}, false, err
+	}
+	return record, found, nil
+}
+
+// GetAllDynamicSpreadFactorRecords returns the dynamic spread factor records of all pools with a dynamic spread factor.
+func (k Keeper) GetAllDynamicSpreadFactorRecords(ctx sdk.Context) ([]types.DynamicSpreadFactorRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorRecordPrefix, func(bz []byte) (types.DynamicSpreadFactorRecord, error) {
+		record := types.DynamicSpreadFactorRecord{}
+		if err := k.cdc.Unmarshal(bz, &record); err != nil {
+			return types.DynamicSpreadFactorRecord{}, err
+		}
+		return record, nil
+	})
+}
+
+// GetDynamicSpreadFactor returns the current dynamic spread factor of the given pool and true
+// if the pool has a dynamic spread factor. False otherwise.
+//
+// The volatility that drives the spread factor is read from the geometric TWAP accumulators of x/twap
+// over the volatility window of the pool. Since the accumulators are only updated at the end of blocks,
+// swaps within the current block do not affect the spread factor charged by the following swaps of
+// the block. If the TWAP history does not cover the whole window yet, i.e. the pool has no records at
+// the start of the window or the geometric variance accumulator was populated after it, the volatility
+// is considered zero so that swaps are charged the min spread factor. Any other error is returned.
+func (k Keeper) GetDynamicSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error) {
+	record, found, err := k.GetDynamicSpreadFactorRecord(ctx, poolId)
+	if err != nil || !found {
+		return osmomath.Dec{}, false, err
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, false, err
+	}
+
+	volatility, err := k.twapKeeper.GetGeometricVolatilityToNow(ctx, poolId, pool.GetToken0(), pool.GetToken1(), ctx.BlockTime().Add(-record.VolatilityWindow))
+	if errors.As(err, &twaptypes.TimeTooOldError{}) || errors.As(err, &twaptypes.StartTimeBeforeVarianceAccumulatorStartError{}) {
+		volatility = osmomath.ZeroDec()
+	} else if err != nil {
+		return osmomath.Dec{}, false, err
+	}
+
+	return record.SpreadFactor(volatility), true, nil
+}
+
+// GetEffectiveSpreadFactor returns the spread factor charged for swaps in the given pool.
+// That is, the dynamic spread factor if the pool has one, and the spread factor of the pool otherwise.
+// Swaps, quotes and simulations must report this spread factor rather than the spread factor of the pool.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return k.getSwapSpreadFactor(ctx, poolId, pool.GetSpreadFactor(ctx))
+}
+
+// getSwapSpreadFactor returns the spread factor to charge for a swap in the given pool.
+// That is, the dynamic spread factor if the pool has one, and the given spread factor otherwise.
+func (k Keeper) getSwapSpreadFactor(ctx sdk.Context, poolId uint64, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+	dynamicSpreadFactor, found, err := k.GetDynamicSpreadFactor(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if !found {
+		return spreadFactor, nil
+	}
+	return dynamicSpreadFactor, nil
+}
+
+// setDynamicSpreadFactorRecord stores the given dynamic spread factor record.
+func (k Keeper) setDynamicSpreadFactorRecord(ctx sdk.Context, record types.DynamicSpreadFactorRecord) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorRecord(record.PoolId), &record)
+}
+
+// deleteDynamicSpreadFactorRecord removes the dynamic spread factor record of the given pool.
+func (k Keeper) deleteDynamicSpreadFactorRecord(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactorRecord(poolId))
+}
@@ -0,0 +1,179 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+func (s *KeeperTestSuite) defaultDynamicSpreadFactorRecord(poolId uint64) types.DynamicSpreadFactorRecord {
+	return types.DynamicSpreadFactorRecord{
+		PoolId:               poolId,
+		Enabled:              true,
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.0005"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: osmomath.OneDec(),
+		VolatilityWindow:     time.Hour,
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactorRecords() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	record := s.defaultDynamicSpreadFactorRecord(pool.GetId())
+
+	_, found, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	nonExistentPoolRecord := s.defaultDynamicSpreadFactorRecord(pool.GetId() + 1)
+	err = clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{nonExistentPoolRecord})
+	s.Require().Error(err)
+
+	invalidRecord := record
+	invalidRecord.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02")
+	err = clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{invalidRecord})
+	s.Require().ErrorIs(err, types.DynamicSpreadFactorBoundsError{PoolId: pool.GetId(), MinSpreadFactor: invalidRecord.MinSpreadFactor, MaxSpreadFactor: invalidRecord.MaxSpreadFactor})
+
+	// Without TWAP history covering the volatility window, the min spread factor applies.
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{record}))
+	spreadFactor, found, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(record.MinSpreadFactor, spreadFactor)
+
+	effectiveSpreadFactor, err := clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(record.MinSpreadFactor, effectiveSpreadFactor)
+
+	// Disabling removes the record, so the spread factor of the pool applies again.
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{{PoolId: pool.GetId()}}))
+	_, found, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	effectiveSpreadFactor, err = clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(pool.GetSpreadFactor(s.Ctx), effectiveSpreadFactor)
+}
+
+// swapAndWriteTwapRecords swaps the given token in the pool, writes the TWAP records of the block
+// and advances the block time by the given duration.
+func (s *KeeperTestSuite) swapAndWriteTwapRecords(pool types.ConcentratedPoolExtension, tokenIn sdk.Coin, tokenOutDenom string, blockDuration time.Duration) {
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool.GetId(), tokenIn, tokenOutDenom, osmomath.OneInt())
+	s.Require().NoError(err)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(blockDuration))
+}
+
+func (s *KeeperTestSuite) TestDynamicSpreadFactorSwap() {
+	s.SetupTest()
+	fixedSpreadFactor := osmomath.MustNewDecFromStr("0.003")
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, fixedSpreadFactor)
+	s.SetupDefaultPosition(pool.GetId())
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	record := s.defaultDynamicSpreadFactorRecord(pool.GetId())
+	tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+
+	fixedQuote, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, fixedSpreadFactor)
+	s.Require().NoError(err)
+	minQuote, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, record.MinSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().True(minQuote.Amount.GT(fixedQuote.Amount))
+
+	// Once enabled, quotes charge the dynamic spread factor regardless of the given spread factor.
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{record}))
+	dynamicQuote, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, fixedSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().Equal(minQuote, dynamicQuote)
+
+	// Swaps moving the price back and forth within the volatility window increase the spread factor.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(record.VolatilityWindow))
+	s.swapAndWriteTwapRecords(pool, tokenIn, ETH, record.VolatilityWindow/6)
+	s.swapAndWriteTwapRecords(pool, sdk.NewCoin(ETH, osmomath.NewInt(100_000)), USDC, record.VolatilityWindow/6)
+
+	volatility, err := s.App.TwapKeeper.GetGeometricVolatilityToNow(s.Ctx, pool.GetId(), ETH, USDC, s.Ctx.BlockTime().Add(-record.VolatilityWindow))
+	s.Require().NoError(err)
+	s.Require().True(volatility.IsPositive())
+
+	spreadFactor, found, err := clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(record.SpreadFactor(volatility), spreadFactor)
+	s.Require().True(spreadFactor.GT(record.MinSpreadFactor))
+
+	effectiveSpreadFactor, err := clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(spreadFactor, effectiveSpreadFactor)
+
+	// Swaps charge and report the dynamic spread factor.
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	dynamicQuote, err = clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, fixedSpreadFactor)
+	s.Require().NoError(err)
+	expectedQuote, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, ETH, spreadFactor)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedQuote, dynamicQuote)
+
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, tokenOut, _, err := clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[1], pool, tokenIn, ETH, fixedSpreadFactor, osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+	s.Require().Equal(dynamicQuote, tokenOut)
+	s.AssertEventEmitted(s.Ctx, gammtypes.TypeEvtTokenSwapped, 1)
+	reportedSpreadFactors := []string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != gammtypes.TypeEvtTokenSwapped {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == gammtypes.AttributeKeySwapFee {
+				reportedSpreadFactors = append(reportedSpreadFactors, attribute.Value)
+			}
+		}
+	}
+	s.Require().Equal([]string{spreadFactor.String()}, reportedSpreadFactors)
+
+	// Raising the multiplier caps the spread factor at the max.
+	record.VolatilityMultiplier = osmomath.NewDec(1_000_000)
+	s.Require().NoError(clKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{record}))
+	spreadFactor, _, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(record.MaxSpreadFactor, spreadFactor)
+
+	// A window starting before the variance accumulator was populated falls back to the min spread factor.
+	s.App.TwapKeeper.SetGeometricVarianceAccumulatorStartTime(s.Ctx, s.Ctx.BlockTime())
+	spreadFactor, _, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(record.MinSpreadFactor, spreadFactor)
+
+	// Other errors deriving the volatility are returned.
+	s.App.TwapKeeper.SetGeometricVarianceAccumulatorStartTime(s.Ctx, time.Time{})
+	s.App.TwapKeeper.DeleteMostRecentRecord(s.Ctx, twaptypes.TwapRecord{PoolId: pool.GetId(), Asset0Denom: ETH, Asset1Denom: USDC})
+	_, _, err = clKeeper.GetDynamicSpreadFactor(s.Ctx, pool.GetId())
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestDynamicSpreadFactorGenesis() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	record := s.defaultDynamicSpreadFactorRecord(pool.GetId())
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactorRecords(s.Ctx, []types.DynamicSpreadFactorRecord{record}))
+
+	exported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.DynamicSpreadFactorRecord{record}, exported.DynamicSpreadFactorRecords)
+
+	s.SetupTest()
+	s.App.ConcentratedLiquidityKeeper.InitGenesis(s.Ctx, *exported)
+	exportedAgain := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(exported.DynamicSpreadFactorRecords, exportedAgain.DynamicSpreadFactorRecords)
+}
@@ -344,3 +344,11 @@ func (k Keeper) CallPoolActionListener(ctx sdk.Context, msgBz []byte, poolId uin
 func (k Keeper) GetPoolHookContract(ctx sdk.Context, poolId uint64, actionPrefix string) string {
 	return k.getPoolHookContract(ctx, poolId, actionPrefix)
 }
+
+func (k Keeper) DecrementRangeOrderTickCount(ctx sdk.Context, poolId uint64, tickIndex int64, zeroForOne bool) error {
+	return k.decrementRangeOrderTickCount(ctx, poolId, tickIndex, zeroForOne)
+}
+
+func (k Keeper) IsIncentiveTickRangePruning(ctx sdk.Context, poolId uint64, tickRange types.TickRange) bool {
+	return k.isIncentiveTickRangePruning(ctx, poolId, tickRange)
+}
@@ -68,6 +68,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// set incentive tick ranges
+		for _, incentiveTickRangeData := range poolData.IncentiveTickRanges {
+			if err := k.initIncentiveTickRangeGenesis(ctx, incentiveTickRangeData); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// set positions for pool
@@ -101,6 +108,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set range orders, indexing the unfilled orders by their fill tick
+	for _, rangeOrder := range genState.RangeOrders {
+		k.setRangeOrder(ctx, rangeOrder)
+		if rangeOrder.Filled {
+			continue
+		}
+
+		pool, err := k.getPoolById(ctx, rangeOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		k.setRangeOrderTickIndex(ctx, rangeOrder, rangeOrder.DenomIn == pool.GetToken1())
+	}
+
+	// set auto-compounding positions
+	for _, positionId := range genState.AutoCompoundPositionIds {
+		k.setPositionAutoCompound(ctx, positionId)
+	}
+
+	// set dynamic spread factor records
+	for _, record := range genState.DynamicSpreadFactorRecords {
+		k.setDynamicSpreadFactorRecord(ctx, record)
+	}
+
+	// set the NFT records of tokenized positions
+	for _, record := range genState.PositionNftRecords {
+		k.setPositionNFTRecord(ctx, record)
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 }
@@ -152,6 +188,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			panic(err)
 		}
 
+		incentiveTickRanges, err := k.exportIncentiveTickRangesGenesis(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
 		incentivesAccumObject := make([]genesis.AccumObject, len(incentivesAccum))
 		for i, incentiveAccum := range incentivesAccum {
 			incentiveAccumTotalShares := incentiveAccum.GetTotalShares()
@@ -172,6 +213,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 			SpreadRewardAccumulator: spreadRewardAccumObject,
 			IncentivesAccumulators:  incentivesAccumObject,
 			IncentiveRecords:        incentiveRecordsForPool,
+			IncentiveTickRanges:     incentiveTickRanges,
 		})
 	}
 
@@ -230,12 +272,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	rangeOrders, err := k.GetAllRangeOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	autoCompoundPositionIds, err := k.GetAllAutoCompoundPositionIds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactorRecords, err := k.GetAllDynamicSpreadFactorRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionNFTRecords, err := k.GetAllPositionNFTRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
-		Params:                k.GetParams(ctx),
-		PoolData:              poolData,
-		PositionData:          positionData,
-		NextPositionId:        k.GetNextPositionId(ctx),
-		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		Params:                     k.GetParams(ctx),
+		PoolData:                   poolData,
+		PositionData:               positionData,
+		NextPositionId:             k.GetNextPositionId(ctx),
+		NextIncentiveRecordId:      k.GetNextIncentiveRecordId(ctx),
+		RangeOrders:                rangeOrders,
+		AutoCompoundPositionIds:    autoCompoundPositionIds,
+		DynamicSpreadFactorRecords: dynamicSpreadFactorRecords,
+		PositionNftRecords:         positionNFTRecords,
 	}
 }
 
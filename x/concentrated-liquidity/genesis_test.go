@@ -37,6 +37,7 @@ var (
 			AuthorizedQuoteDenoms:        []string{ETH, USDC},
 			BalancerSharesRewardDiscount: types.DefaultBalancerSharesDiscount,
 			AuthorizedUptimes:            types.DefaultAuthorizedUptimes,
+			AutoCompoundMaxSlippage:      types.DefaultAutoCompoundMaxSlippage,
 		},
 		PoolData:              []genesis.PoolData{},
 		NextIncentiveRecordId: 2,
@@ -30,6 +30,11 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleDynamicSpreadFactorProposal handles a dynamic spread factor proposal to the corresponding keeper method.
+func (k Keeper) HandleDynamicSpreadFactorProposal(ctx sdk.Context, p *types.DynamicSpreadFactorProposal) error {
+	return k.SetDynamicSpreadFactorRecords(ctx, p.Records)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
@@ -37,6 +42,8 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.DynamicSpreadFactorProposal:
+			return k.HandleDynamicSpreadFactorProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
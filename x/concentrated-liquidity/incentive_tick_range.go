@@ -0,0 +1,838 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/swapstrategy"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
+)
+
+// An incentive tick range tracks the liquidity of the positions of a pool whose range sits within a tick range, so that the incentive
+// records restricted to the tick range are only emitted to that liquidity. It mirrors the uptime accounting of the pool: the qualifying
+// positions have records in the uptime accumulators of the tick range, and their ticks are tracked separately from the ticks of the
+// pool with the qualifying liquidity and the uptime growth of the tick range only.
+//
+// A pool has at most types.MaxIncentiveTickRangesPerPool incentive tick ranges. The qualifying positions join a tick range the next
+// time they are updated or collect their incentives, so that creating a tick range does not iterate over the positions of the pool.
+// The ticks of the tick ranges are indexed by tick index so that crossing a tick only updates the tick ranges that have it initialized.
+// Once a tick range has no live incentive records left, it is flagged for pruning: its positions are removed from it at the end of
+// the following blocks, their accrued incentives being moved to their records in the uptime accumulators of the pool, and it is
+// deleted once it has no positions left.
+
+// GetIncentiveTickRange returns the incentive tick range of the given pool id bounded by the given tick range.
+// Returns error if the incentive tick range does not exist.
+func (k Keeper) GetIncentiveTickRange(ctx sdk.Context, poolId uint64, tickRange types.TickRange) (types.IncentiveTickRange, error) {
+	incentiveTickRange := types.IncentiveTickRange{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRange(poolId, tickRange.LowerTick, tickRange.UpperTick), &incentiveTickRange)
+	if err != nil {
+		return types.IncentiveTickRange{}, err
+	}
+	if !found {
+		return types.IncentiveTickRange{}, types.IncentiveTickRangeNotFoundError{PoolId: poolId, LowerTick: tickRange.LowerTick, UpperTick: tickRange.UpperTick}
+	}
+
+	return incentiveTickRange, nil
+}
+
+// ValidateIncentiveTickRange returns an error if the given tick range cannot restrict the incentives of the given pool id,
+// i.e. if its ticks are not a valid range for the tick spacing of the pool, or if the pool already has the maximum number
+// of incentive tick ranges and the tick range is not one of them.
+func (k Keeper) ValidateIncentiveTickRange(ctx sdk.Context, poolId uint64, tickRange types.TickRange) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), tickRange.LowerTick, tickRange.UpperTick); err != nil {
+		return err
+	}
+
+	return k.validateIncentiveTickRangeLimit(ctx, poolId, tickRange)
+}
+
+// validateIncentiveTickRangeLimit returns an error if the given pool id already has the maximum number of incentive tick ranges
+// and the incentive tick range bounded by the given tick range is not one of them.
+func (k Keeper) validateIncentiveTickRangeLimit(ctx sdk.Context, poolId uint64, tickRange types.TickRange) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.KeyIncentiveTickRange(poolId, tickRange.LowerTick, tickRange.UpperTick)) {
+		return nil
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyIncentiveTickRangesPrefixByPoolId(poolId))
+	defer iterator.Close()
+
+	numIncentiveTickRanges := 0
+	for ; iterator.Valid(); iterator.Next() {
+		numIncentiveTickRanges++
+		if numIncentiveTickRanges >= types.MaxIncentiveTickRangesPerPool {
+			return types.IncentiveTickRangeLimitError{PoolId: poolId, MaxIncentiveTickRanges: types.MaxIncentiveTickRangesPerPool}
+		}
+	}
+
+	return nil
+}
+
+// GetAllIncentiveTickRangesForPool returns all of the incentive tick ranges of the given pool id.
+func (k Keeper) GetAllIncentiveTickRangesForPool(ctx sdk.Context, poolId uint64) ([]types.IncentiveTickRange, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRangesPrefixByPoolId(poolId), parseIncentiveTickRange)
+}
+
+func (k Keeper) setIncentiveTickRange(ctx sdk.Context, incentiveTickRange types.IncentiveTickRange) {
+	tickRange := incentiveTickRange.TickRange
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRange(incentiveTickRange.PoolId, tickRange.LowerTick, tickRange.UpperTick), &incentiveTickRange)
+}
+
+// getQualifyingIncentiveTickRanges returns the incentive tick ranges of the given pool id that the positions with the given range qualify for.
+func (k Keeper) getQualifyingIncentiveTickRanges(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) ([]types.IncentiveTickRange, error) {
+	incentiveTickRanges, err := k.GetAllIncentiveTickRangesForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	qualifyingTickRanges := []types.IncentiveTickRange{}
+	for _, incentiveTickRange := range incentiveTickRanges {
+		if incentiveTickRange.TickRange.ContainsRange(lowerTick, upperTick) {
+			qualifyingTickRanges = append(qualifyingTickRanges, incentiveTickRange)
+		}
+	}
+
+	return qualifyingTickRanges, nil
+}
+
+// GetIncentiveTickRangeUptimeAccumulators gets the uptime accumulator objects of the incentive tick range of the given pool id
+// bounded by the given tick range, one for every supported uptime.
+// Returns error if the accumulators do not exist.
+func (k Keeper) GetIncentiveTickRangeUptimeAccumulators(ctx sdk.Context, poolId uint64, tickRange types.TickRange) ([]*accum.AccumulatorObject, error) {
+	accums := make([]*accum.AccumulatorObject, len(types.SupportedUptimes))
+	for uptimeIndex := range types.SupportedUptimes {
+		acc, err := accum.GetAccumulator(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRangeUptimeAccumulator(poolId, tickRange.LowerTick, tickRange.UpperTick, uint64(uptimeIndex)))
+		if err != nil {
+			return []*accum.AccumulatorObject{}, err
+		}
+
+		accums[uptimeIndex] = acc
+	}
+
+	return accums, nil
+}
+
+// getIncentiveTickRangeTickInfo returns the tick info of the given tick of the incentive tick range of the given pool id bounded by
+// the given tick range, and whether the tick is initialized.
+func (k Keeper) getIncentiveTickRangeTickInfo(ctx sdk.Context, poolId uint64, tickRange types.TickRange, tickIndex int64) (model.IncentiveTickRangeTickInfo, bool, error) {
+	tickInfo := model.IncentiveTickRangeTickInfo{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRangeTick(poolId, tickRange.LowerTick, tickRange.UpperTick, tickIndex), &tickInfo)
+	return tickInfo, found, err
+}
+
+// getOrInitIncentiveTickRangeTickInfo returns the tick info of the given tick of the incentive tick range of the given pool id bounded
+// by the given tick range. If the tick is not initialized, the uptime trackers of the returned tick info are set to their initial
+// values given the current tick and the values of the uptime accumulators of the tick range, like the ticks of the pool.
+func (k Keeper) getOrInitIncentiveTickRangeTickInfo(ctx sdk.Context, poolId uint64, tickRange types.TickRange, currentTick, tickIndex int64, uptimeAccumulatorValues []sdk.DecCoins) (model.IncentiveTickRangeTickInfo, error) {
+	tickInfo, found, err := k.getIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tickIndex)
+	if err != nil {
+		return model.IncentiveTickRangeTickInfo{}, err
+	}
+	if found {
+		return tickInfo, nil
+	}
+
+	// By convention, all of the uptime growth to date has occurred below the tick
+	initialUptimeTrackers := make([]model.UptimeTracker, len(uptimeAccumulatorValues))
+	for uptimeIndex, uptimeAccumulatorValue := range uptimeAccumulatorValues {
+		if currentTick >= tickIndex {
+			initialUptimeTrackers[uptimeIndex] = model.UptimeTracker{UptimeGrowthOutside: uptimeAccumulatorValue}
+		} else {
+			initialUptimeTrackers[uptimeIndex] = model.UptimeTracker{UptimeGrowthOutside: emptyCoins}
+		}
+	}
+
+	return model.IncentiveTickRangeTickInfo{LiquidityGross: osmomath.ZeroDec(), LiquidityNet: osmomath.ZeroDec(), UptimeTrackers: model.UptimeTrackers{List: initialUptimeTrackers}}, nil
+}
+
+// setIncentiveTickRangeTickInfo sets the tick info of the given tick of the given incentive tick range and indexes the tick range
+// by the tick.
+func (k Keeper) setIncentiveTickRangeTickInfo(ctx sdk.Context, poolId uint64, tickRange types.TickRange, tickIndex int64, tickInfo *model.IncentiveTickRangeTickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyIncentiveTickRangeTick(poolId, tickRange.LowerTick, tickRange.UpperTick, tickIndex), tickInfo)
+	store.Set(types.KeyIncentiveTickRangeByTick(poolId, tickIndex, tickRange.LowerTick, tickRange.UpperTick), []byte{1})
+}
+
+// deleteIncentiveTickRangeTickInfo deletes the tick info of the given tick of the given incentive tick range along with its index entry.
+func (k Keeper) deleteIncentiveTickRangeTickInfo(ctx sdk.Context, poolId uint64, tickRange types.TickRange, tickIndex int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyIncentiveTickRangeTick(poolId, tickRange.LowerTick, tickRange.UpperTick, tickIndex))
+	store.Delete(types.KeyIncentiveTickRangeByTick(poolId, tickIndex, tickRange.LowerTick, tickRange.UpperTick))
+}
+
+// getIncentiveTickRangesByTick returns the tick ranges of the incentive tick ranges of the given pool id that have the given tick initialized.
+func (k Keeper) getIncentiveTickRangesByTick(ctx sdk.Context, poolId uint64, tickIndex int64) ([]types.TickRange, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRangesByTickPrefix(poolId, tickIndex), parseTickRangeFromKeySuffix)
+}
+
+// GetAllIncentiveTickRangeTicks returns all of the initialized ticks of the incentive tick range of the given pool id bounded by
+// the given tick range, ordered by tick index.
+func (k Keeper) GetAllIncentiveTickRangeTicks(ctx sdk.Context, poolId uint64, tickRange types.TickRange) ([]genesis.IncentiveTickRangeTick, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyIncentiveTickRangeTickPrefix(poolId, tickRange.LowerTick, tickRange.UpperTick), parseIncentiveTickRangeTick)
+}
+
+// initIncentiveTickRangeIfNotExists creates the incentive tick range of the given pool id bounded by the given tick range if it
+// does not exist yet, along with its uptime accumulators. An existing incentive tick range that is flagged for pruning is kept.
+// The existing positions of the pool that qualify for the tick range are not added to it, they join it the next time they are
+// updated or collect their incentives.
+// Returns error if the pool already has the maximum number of incentive tick ranges.
+// CONTRACT: the uptime accumulators of the pool are synced to the current block time.
+func (k Keeper) initIncentiveTickRangeIfNotExists(ctx sdk.Context, poolId uint64, tickRange types.TickRange) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.KeyIncentiveTickRange(poolId, tickRange.LowerTick, tickRange.UpperTick)) {
+		k.deleteIncentiveTickRangePruning(ctx, poolId, tickRange)
+		return nil
+	}
+
+	if err := k.validateIncentiveTickRangeLimit(ctx, poolId, tickRange); err != nil {
+		return err
+	}
+
+	// The uptime accumulators of a pruned tick range are left in state without positions, so they are reused.
+	for uptimeIndex := range types.SupportedUptimes {
+		accumName := types.KeyIncentiveTickRangeUptimeAccumulator(poolId, tickRange.LowerTick, tickRange.UpperTick, uint64(uptimeIndex))
+		if _, err := accum.GetAccumulator(store, accumName); err == nil {
+			continue
+		}
+
+		if err := accum.MakeAccumulator(store, accumName); err != nil {
+			return err
+		}
+	}
+
+	k.setIncentiveTickRange(ctx, types.IncentiveTickRange{
+		PoolId:    poolId,
+		TickRange: tickRange,
+		Liquidity: osmomath.ZeroDec(),
+	})
+
+	return nil
+}
+
+// initOrUpdatePositionIncentiveTickRanges either initializes or updates the given position in all of the incentive tick ranges
+// of the given pool id that it qualifies for, given its liquidity after the update and the liquidity delta of the update.
+// CONTRACT: the uptime accumulators of the pool are synced to the current block time.
+// CONTRACT: this is called before the liquidity of the pool is updated for the position.
+func (k Keeper) initOrUpdatePositionIncentiveTickRanges(ctx sdk.Context, poolId uint64, liquidity osmomath.Dec, lowerTick, upperTick int64, liquidityDelta osmomath.Dec, positionId uint64) error {
+	incentiveTickRanges, err := k.getQualifyingIncentiveTickRanges(ctx, poolId, lowerTick, upperTick)
+	if err != nil {
+		return err
+	}
+	if len(incentiveTickRanges) == 0 {
+		return nil
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	for _, incentiveTickRange := range incentiveTickRanges {
+		err := k.initOrUpdatePositionIncentiveTickRange(ctx, pool, incentiveTickRange, positionId, lowerTick, upperTick, liquidity, liquidityDelta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initOrUpdatePositionIncentiveTickRange either initializes or updates the given position in the given incentive tick range.
+// It updates the ticks of the position in the tick range, the qualifying liquidity of the tick range if the position is in range,
+// and the records of the position in the uptime accumulators of the tick range.
+// A position that has not joined the tick range yet joins it with all of its liquidity, unless the tick range is flagged for pruning.
+// CONTRACT: the position qualifies for the incentive tick range.
+// CONTRACT: the uptime accumulators of the incentive tick range are synced to the current block time.
+func (k Keeper) initOrUpdatePositionIncentiveTickRange(ctx sdk.Context, pool types.ConcentratedPoolExtension, incentiveTickRange types.IncentiveTickRange, positionId uint64, lowerTick, upperTick int64, liquidity, liquidityDelta osmomath.Dec) error {
+	poolId := pool.GetId()
+	tickRange := incentiveTickRange.TickRange
+	currentTick := pool.GetCurrentTick()
+
+	uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+	if err != nil {
+		return err
+	}
+
+	if !uptimeAccumulators[0].HasPosition(string(types.KeyPositionId(positionId))) {
+		if liquidity.IsZero() || k.isIncentiveTickRangePruning(ctx, poolId, tickRange) {
+			return nil
+		}
+		liquidityDelta = liquidity
+	}
+	uptimeAccumulatorValues := make([]sdk.DecCoins, len(uptimeAccumulators))
+	for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+		uptimeAccumulatorValues[uptimeIndex] = uptimeAccumulator.GetValue()
+	}
+
+	lowerTickInfo, err := k.initOrUpdateIncentiveTickRangeTick(ctx, poolId, tickRange, currentTick, lowerTick, liquidityDelta, false, uptimeAccumulatorValues)
+	if err != nil {
+		return err
+	}
+	upperTickInfo, err := k.initOrUpdateIncentiveTickRangeTick(ctx, poolId, tickRange, currentTick, upperTick, liquidityDelta, true, uptimeAccumulatorValues)
+	if err != nil {
+		return err
+	}
+
+	// The qualifying liquidity of the tick range is only updated if the position is active
+	if pool.IsCurrentTickInRange(lowerTick, upperTick) {
+		incentiveTickRange.Liquidity = incentiveTickRange.Liquidity.Add(liquidityDelta)
+		k.setIncentiveTickRange(ctx, incentiveTickRange)
+	}
+
+	uptimeGrowthInside, err := calcUptimeGrowthInsideRange(currentTick, lowerTick, upperTick, uptimeAccumulatorValues, getUptimeTrackerValues(lowerTickInfo.UptimeTrackers.List), getUptimeTrackerValues(upperTickInfo.UptimeTrackers.List))
+	if err != nil {
+		return err
+	}
+	uptimeGrowthOutside, err := osmoutils.SubDecCoinArrays(uptimeAccumulatorValues, uptimeGrowthInside)
+	if err != nil {
+		return err
+	}
+
+	return initOrUpdatePositionInUptimeAccumulators(uptimeAccumulators, positionId, liquidity, liquidityDelta, uptimeGrowthInside, uptimeGrowthOutside)
+}
+
+// initOrUpdateIncentiveTickRangeTick updates the liquidity of the given tick of the given incentive tick range like initOrUpdateTick
+// does for the ticks of the pool, initializing it if needed. Ticks without qualifying liquidity are removed from state.
+// Returns the updated tick info.
+func (k Keeper) initOrUpdateIncentiveTickRangeTick(ctx sdk.Context, poolId uint64, tickRange types.TickRange, currentTick, tickIndex int64, liquidityDelta osmomath.Dec, upper bool, uptimeAccumulatorValues []sdk.DecCoins) (model.IncentiveTickRangeTickInfo, error) {
+	tickInfo, err := k.getOrInitIncentiveTickRangeTickInfo(ctx, poolId, tickRange, currentTick, tickIndex, uptimeAccumulatorValues)
+	if err != nil {
+		return model.IncentiveTickRangeTickInfo{}, err
+	}
+
+	tickInfo.LiquidityGross = tickInfo.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(liquidityDelta)
+	}
+
+	if tickInfo.LiquidityGross.IsZero() && tickInfo.LiquidityNet.IsZero() {
+		k.deleteIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tickIndex)
+	} else {
+		k.setIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tickIndex, &tickInfo)
+	}
+
+	return tickInfo, nil
+}
+
+// crossIncentiveTickRangesTick crosses the given tick for all of the incentive tick ranges of the given pool id that have it
+// initialized. Like crossTick and the swap do for the pool, it flips the uptime trackers of the tick and updates the qualifying
+// liquidity of the tick range with the liquidity net of the tick, signed for the direction of the swap.
+// CONTRACT: the uptime accumulators of the pool are synced to the current block time.
+func (k Keeper) crossIncentiveTickRangesTick(ctx sdk.Context, poolId uint64, tickIndex int64, strategy swapstrategy.SwapStrategy) error {
+	tickRanges, err := k.getIncentiveTickRangesByTick(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	for _, tickRange := range tickRanges {
+		incentiveTickRange, err := k.GetIncentiveTickRange(ctx, poolId, tickRange)
+		if err != nil {
+			return err
+		}
+
+		tickInfo, found, err := k.getIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tickIndex)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return types.TickNotFoundError{Tick: tickIndex}
+		}
+
+		uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+		if err != nil {
+			return err
+		}
+		for uptimeIndex := range uptimeAccumulators {
+			tickInfo.UptimeTrackers.List[uptimeIndex].UptimeGrowthOutside = uptimeAccumulators[uptimeIndex].GetValue().Sub(tickInfo.UptimeTrackers.List[uptimeIndex].UptimeGrowthOutside)
+		}
+		k.setIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tickIndex, &tickInfo)
+
+		incentiveTickRange.Liquidity = incentiveTickRange.Liquidity.Add(strategy.SetLiquidityDeltaSign(tickInfo.LiquidityNet))
+		k.setIncentiveTickRange(ctx, incentiveTickRange)
+	}
+
+	return nil
+}
+
+// updateIncentiveTickRangeUptimeAccumulators emits the given incentive records restricted to a tick range to the uptime accumulators
+// of their incentive tick range over the given time elapsed, dividing them by the qualifying liquidity of the tick range.
+// The records of tick ranges without qualifying liquidity are left unchanged. The tick ranges without live incentive records left
+// are flagged for pruning.
+// Returns the updated incentive records.
+func (k Keeper) updateIncentiveTickRangeUptimeAccumulators(ctx sdk.Context, poolId uint64, timeElapsedSec osmomath.Dec, restrictedIncentiveRecords []types.IncentiveRecord) ([]types.IncentiveRecord, error) {
+	incentiveTickRanges, err := k.GetAllIncentiveTickRangesForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedIncentiveRecords := make([]types.IncentiveRecord, 0, len(restrictedIncentiveRecords))
+	for _, incentiveTickRange := range incentiveTickRanges {
+		incentiveRecords := []types.IncentiveRecord{}
+		for _, incentiveRecord := range restrictedIncentiveRecords {
+			if *incentiveRecord.IncentiveRecordBody.TickRange == incentiveTickRange.TickRange {
+				incentiveRecords = append(incentiveRecords, incentiveRecord)
+			}
+		}
+
+		if len(incentiveRecords) == 0 {
+			k.setIncentiveTickRangePruning(ctx, poolId, incentiveTickRange.TickRange)
+			continue
+		}
+
+		// If there is no share to be incentivized for the tick range, we leave its records unchanged
+		qualifyingLiquidity := incentiveTickRange.Liquidity
+		if qualifyingLiquidity.LT(osmomath.OneDec()) {
+			continue
+		}
+
+		uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, incentiveTickRange.TickRange)
+		if err != nil {
+			return nil, err
+		}
+
+		for uptimeIndex := range uptimeAccumulators {
+			incentivesToAddToCurAccum, updatedRecords, err := calcAccruedIncentivesForAccum(ctx, types.SupportedUptimes[uptimeIndex], qualifyingLiquidity, timeElapsedSec, incentiveRecords)
+			if err != nil {
+				return nil, err
+			}
+
+			uptimeAccumulators[uptimeIndex].AddToAccumulator(incentivesToAddToCurAccum)
+			incentiveRecords = updatedRecords
+		}
+
+		// Records are deleted from state once they have emitted all of their incentives.
+		hasLiveIncentiveRecords := false
+		for _, incentiveRecord := range incentiveRecords {
+			if incentiveRecord.IncentiveRecordBody.RemainingCoin.Amount.IsPositive() {
+				hasLiveIncentiveRecords = true
+				break
+			}
+		}
+		if !hasLiveIncentiveRecords {
+			k.setIncentiveTickRangePruning(ctx, poolId, incentiveTickRange.TickRange)
+		}
+
+		updatedIncentiveRecords = append(updatedIncentiveRecords, incentiveRecords...)
+	}
+
+	return updatedIncentiveRecords, nil
+}
+
+// claimPositionIncentiveTickRange claims the incentives of the given position from the uptime accumulators of the given incentive
+// tick range, forfeiting the incentives of the uptimes that are longer than the age of the position.
+// A position that has not joined the tick range yet joins it instead, and has nothing to claim.
+// CONTRACT: the position qualifies for the incentive tick range.
+// CONTRACT: the uptime accumulators of the incentive tick range are synced to the current block time.
+func (k Keeper) claimPositionIncentiveTickRange(ctx sdk.Context, incentiveTickRange types.IncentiveTickRange, position model.Position, positionAge time.Duration) (sdk.Coins, sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	tickRange := incentiveTickRange.TickRange
+	uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, position.PoolId, tickRange)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	if !uptimeAccumulators[0].HasPosition(string(types.KeyPositionId(position.PositionId))) {
+		err := k.initOrUpdatePositionIncentiveTickRange(ctx, pool, incentiveTickRange, position.PositionId, position.LowerTick, position.UpperTick, position.Liquidity, position.Liquidity)
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	uptimeAccumulatorValues := make([]sdk.DecCoins, len(uptimeAccumulators))
+	for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+		uptimeAccumulatorValues[uptimeIndex] = uptimeAccumulator.GetValue()
+	}
+
+	currentTick := pool.GetCurrentTick()
+	lowerTickInfo, err := k.getOrInitIncentiveTickRangeTickInfo(ctx, position.PoolId, tickRange, currentTick, position.LowerTick, uptimeAccumulatorValues)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	upperTickInfo, err := k.getOrInitIncentiveTickRangeTickInfo(ctx, position.PoolId, tickRange, currentTick, position.UpperTick, uptimeAccumulatorValues)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	uptimeGrowthInside, err := calcUptimeGrowthInsideRange(currentTick, position.LowerTick, position.UpperTick, uptimeAccumulatorValues, getUptimeTrackerValues(lowerTickInfo.UptimeTrackers.List), getUptimeTrackerValues(upperTickInfo.UptimeTrackers.List))
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	uptimeGrowthOutside, err := osmoutils.SubDecCoinArrays(uptimeAccumulatorValues, uptimeGrowthInside)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	return claimPositionUptimeAccumulators(uptimeAccumulators, position.PositionId, positionAge, uptimeGrowthOutside)
+}
+
+// isIncentiveTickRangePruning returns true if the incentive tick range of the given pool id bounded by the given tick range is
+// flagged for pruning. False otherwise.
+func (k Keeper) isIncentiveTickRangePruning(ctx sdk.Context, poolId uint64, tickRange types.TickRange) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyIncentiveTickRangePruning(poolId, tickRange.LowerTick, tickRange.UpperTick))
+}
+
+// setIncentiveTickRangePruning flags the incentive tick range of the given pool id bounded by the given tick range for pruning.
+func (k Keeper) setIncentiveTickRangePruning(ctx sdk.Context, poolId uint64, tickRange types.TickRange) {
+	ctx.KVStore(k.storeKey).Set(types.KeyIncentiveTickRangePruning(poolId, tickRange.LowerTick, tickRange.UpperTick), []byte{1})
+}
+
+// deleteIncentiveTickRangePruning clears the pruning flag of the incentive tick range of the given pool id bounded by the given tick range.
+func (k Keeper) deleteIncentiveTickRangePruning(ctx sdk.Context, poolId uint64, tickRange types.TickRange) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyIncentiveTickRangePruning(poolId, tickRange.LowerTick, tickRange.UpperTick))
+}
+
+// PruneIncentiveTickRanges prunes the incentive tick ranges that are flagged for pruning. Their positions are removed from them,
+// the incentives they accrued in them being moved to their records in the uptime accumulators of the pool so that they remain
+// claimable with the same forfeiting rules, and the tick ranges without positions left are deleted.
+// At most MaxIncentiveTickRangePositionsPrunedPerBlock positions are removed per call, the remaining ones are removed by the
+// following calls. A tick range that fails to be pruned is unflagged so that it neither halts the chain nor consumes the budget
+// of the other tick ranges. It is flagged again the next time the uptime accumulators of its pool are updated.
+func (k Keeper) PruneIncentiveTickRanges(ctx sdk.Context) error {
+	remaining := types.MaxIncentiveTickRangePositionsPrunedPerBlock
+	incentiveTickRangesToPrune, err := k.getIncentiveTickRangesToPrune(ctx, remaining)
+	if err != nil {
+		return err
+	}
+
+	for _, incentiveTickRange := range incentiveTickRangesToPrune {
+		poolId, tickRange := incentiveTickRange.PoolId, incentiveTickRange.TickRange
+
+		numPositionsRemoved := uint64(0)
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			positionIds, err := k.getIncentiveTickRangePositionIds(cacheCtx, poolId, tickRange, remaining)
+			if err != nil {
+				return err
+			}
+
+			for _, positionId := range positionIds {
+				if err := k.removePositionFromIncentiveTickRange(cacheCtx, poolId, tickRange, positionId); err != nil {
+					return err
+				}
+			}
+			numPositionsRemoved = uint64(len(positionIds))
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().Error("failed to prune incentive tick range", "pool_id", poolId, "lower_tick", tickRange.LowerTick, "upper_tick", tickRange.UpperTick, "error", err.Error())
+			k.deleteIncentiveTickRangePruning(ctx, poolId, tickRange)
+			remaining--
+			if remaining == 0 {
+				return nil
+			}
+			continue
+		}
+
+		// The tick range may have more positions left if the limit was reached.
+		if numPositionsRemoved >= remaining {
+			return nil
+		}
+
+		k.deleteIncentiveTickRange(ctx, poolId, tickRange)
+		remaining -= numPositionsRemoved + 1
+		if remaining == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// getIncentiveTickRangesToPrune returns up to limit incentive tick ranges that are flagged for pruning.
+// Flags of incentive tick ranges that no longer exist are deleted.
+func (k Keeper) getIncentiveTickRangesToPrune(ctx sdk.Context, limit uint64) ([]types.IncentiveTickRange, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.IncentiveTickRangePruningPrefix)
+	keys := [][]byte{}
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	incentiveTickRanges := make([]types.IncentiveTickRange, 0, len(keys))
+	for _, key := range keys {
+		poolId := sdk.BigEndianToUint64(key[len(types.IncentiveTickRangePruningPrefix) : len(types.IncentiveTickRangePruningPrefix)+8])
+		tickRange, err := parseTickRangeFromKeySuffix(key, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		incentiveTickRange, err := k.GetIncentiveTickRange(ctx, poolId, tickRange)
+		if errors.As(err, &types.IncentiveTickRangeNotFoundError{}) {
+			store.Delete(key)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		incentiveTickRanges = append(incentiveTickRanges, incentiveTickRange)
+	}
+
+	return incentiveTickRanges, nil
+}
+
+// getIncentiveTickRangePositionIds returns up to limit ids of the positions that have joined the incentive tick range of the given
+// pool id bounded by the given tick range. Only the records of the uptime accumulators of the tick range are iterated.
+func (k Keeper) getIncentiveTickRangePositionIds(ctx sdk.Context, poolId uint64, tickRange types.TickRange, limit uint64) ([]uint64, error) {
+	accumName := types.KeyIncentiveTickRangeUptimeAccumulator(poolId, tickRange.LowerTick, tickRange.UpperTick, 0)
+	positionPrefix := accum.FormatPositionPrefixKey(accumName, "")
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), positionPrefix)
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid() && uint64(len(positionIds)) < limit; iterator.Next() {
+		positionName := iterator.Key()[len(positionPrefix):]
+		positionId, err := strconv.ParseUint(string(positionName[len(types.PositionIdPrefix):]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		positionIds = append(positionIds, positionId)
+	}
+
+	return positionIds, nil
+}
+
+// removePositionFromIncentiveTickRange removes the given position from the incentive tick range of the given pool id bounded by the
+// given tick range, and adds the incentives it accrued in the uptime accumulators of the tick range to the unclaimed rewards of its
+// records in the uptime accumulators of the pool.
+// CONTRACT: the incentive tick range has no live incentive records.
+func (k Keeper) removePositionFromIncentiveTickRange(ctx sdk.Context, poolId uint64, tickRange types.TickRange, positionId uint64) error {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	incentiveTickRange, err := k.GetIncentiveTickRange(ctx, poolId, tickRange)
+	if err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+	if err != nil {
+		return err
+	}
+	positionName := string(types.KeyPositionId(positionId))
+	liquidity, err := uptimeAccumulators[0].GetPositionSize(positionName)
+	if err != nil {
+		return err
+	}
+
+	// Removing all of the liquidity of the position moves its accrued incentives to the unclaimed rewards of its records.
+	err = k.initOrUpdatePositionIncentiveTickRange(ctx, pool, incentiveTickRange, positionId, position.LowerTick, position.UpperTick, osmomath.ZeroDec(), liquidity.Neg())
+	if err != nil {
+		return err
+	}
+
+	// The uptime accumulators are refetched since their total shares were updated.
+	uptimeAccumulators, err = k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+	if err != nil {
+		return err
+	}
+	poolUptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	for uptimeIndex, uptimeAccumulator := range uptimeAccumulators {
+		if !uptimeAccumulator.HasPosition(positionName) {
+			continue
+		}
+
+		accruedIncentives, err := uptimeAccumulator.DeletePosition(positionName)
+		if err != nil {
+			return err
+		}
+		if accruedIncentives.IsZero() {
+			continue
+		}
+
+		if err := poolUptimeAccumulators[uptimeIndex].AddToUnclaimedRewards(positionName, accruedIncentives); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteIncentiveTickRange deletes the incentive tick range of the given pool id bounded by the given tick range along with its
+// pruning flag. Its uptime accumulators are left in state to be reused if the tick range is created again.
+// CONTRACT: the incentive tick range has no positions left, so it has no initialized ticks.
+func (k Keeper) deleteIncentiveTickRange(ctx sdk.Context, poolId uint64, tickRange types.TickRange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyIncentiveTickRange(poolId, tickRange.LowerTick, tickRange.UpperTick))
+	store.Delete(types.KeyIncentiveTickRangePruning(poolId, tickRange.LowerTick, tickRange.UpperTick))
+}
+
+// initIncentiveTickRangeGenesis sets the given incentive tick range in state along with its ticks, uptime accumulators and the
+// records of its qualifying positions in them.
+func (k Keeper) initIncentiveTickRangeGenesis(ctx sdk.Context, incentiveTickRangeData genesis.IncentiveTickRangeData) error {
+	incentiveTickRange := incentiveTickRangeData.IncentiveTickRange
+	poolId, tickRange := incentiveTickRange.PoolId, incentiveTickRange.TickRange
+	k.setIncentiveTickRange(ctx, incentiveTickRange)
+
+	for _, tick := range incentiveTickRangeData.Ticks {
+		tick := tick
+		k.setIncentiveTickRangeTickInfo(ctx, poolId, tickRange, tick.TickIndex, &tick.Info)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, uptimeAccum := range incentiveTickRangeData.UptimeAccumulators {
+		err := accum.MakeAccumulatorWithValueAndShare(store, uptimeAccum.Name, uptimeAccum.AccumContent.AccumValue, uptimeAccum.AccumContent.TotalShares)
+		if err != nil {
+			return err
+		}
+	}
+
+	uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+	if err != nil {
+		return err
+	}
+	for _, positionData := range incentiveTickRangeData.Positions {
+		positionName := string(types.KeyPositionId(positionData.PositionId))
+		for uptimeIndex, uptimeRecord := range positionData.UptimeAccumRecords {
+			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
+		}
+	}
+
+	return nil
+}
+
+// exportIncentiveTickRangesGenesis returns the incentive tick ranges of the given pool id along with their ticks, uptime
+// accumulators and the records of their qualifying positions in them.
+func (k Keeper) exportIncentiveTickRangesGenesis(ctx sdk.Context, poolId uint64) ([]genesis.IncentiveTickRangeData, error) {
+	incentiveTickRanges, err := k.GetAllIncentiveTickRangesForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	if len(incentiveTickRanges) == 0 {
+		return nil, nil
+	}
+
+	positionIds := k.getPositionIdsForPool(ctx, poolId)
+
+	incentiveTickRangesData := make([]genesis.IncentiveTickRangeData, 0, len(incentiveTickRanges))
+	for _, incentiveTickRange := range incentiveTickRanges {
+		tickRange := incentiveTickRange.TickRange
+		ticks, err := k.GetAllIncentiveTickRangeTicks(ctx, poolId, tickRange)
+		if err != nil {
+			return nil, err
+		}
+
+		uptimeAccumulators, err := k.GetIncentiveTickRangeUptimeAccumulators(ctx, poolId, tickRange)
+		if err != nil {
+			return nil, err
+		}
+		uptimeAccumObjects := make([]genesis.AccumObject, len(uptimeAccumulators))
+		for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+			uptimeAccumObjects[uptimeIndex] = genesis.AccumObject{
+				Name: uptimeAccum.GetName(),
+				AccumContent: &accum.AccumulatorContent{
+					AccumValue:  uptimeAccum.GetValue(),
+					TotalShares: uptimeAccum.GetTotalShares(),
+				},
+			}
+		}
+
+		positions := []genesis.IncentiveTickRangePositionData{}
+		for _, positionId := range positionIds {
+			positionName := string(types.KeyPositionId(positionId))
+			if !uptimeAccumulators[0].HasPosition(positionName) {
+				continue
+			}
+
+			uptimeAccumRecords := make([]accum.Record, len(uptimeAccumulators))
+			for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+				uptimeAccumRecords[uptimeIndex], err = uptimeAccum.GetPosition(positionName)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			positions = append(positions, genesis.IncentiveTickRangePositionData{
+				PositionId:         positionId,
+				UptimeAccumRecords: uptimeAccumRecords,
+			})
+		}
+
+		incentiveTickRangesData = append(incentiveTickRangesData, genesis.IncentiveTickRangeData{
+			IncentiveTickRange: incentiveTickRange,
+			Ticks:              ticks,
+			UptimeAccumulators: uptimeAccumObjects,
+			Positions:          positions,
+		})
+	}
+
+	return incentiveTickRangesData, nil
+}
+
+// splitIncentiveRecordsByTickRange splits the given incentive records into the unrestricted records and the records restricted to a tick range.
+func splitIncentiveRecordsByTickRange(incentiveRecords []types.IncentiveRecord) (unrestrictedRecords []types.IncentiveRecord, restrictedRecords []types.IncentiveRecord) {
+	unrestrictedRecords = make([]types.IncentiveRecord, 0, len(incentiveRecords))
+	for _, incentiveRecord := range incentiveRecords {
+		if incentiveRecord.IncentiveRecordBody.TickRange != nil {
+			restrictedRecords = append(restrictedRecords, incentiveRecord)
+		} else {
+			unrestrictedRecords = append(unrestrictedRecords, incentiveRecord)
+		}
+	}
+
+	return unrestrictedRecords, restrictedRecords
+}
+
+func parseIncentiveTickRange(bz []byte) (types.IncentiveTickRange, error) {
+	incentiveTickRange := types.IncentiveTickRange{}
+	if err := proto.Unmarshal(bz, &incentiveTickRange); err != nil {
+		return types.IncentiveTickRange{}, err
+	}
+	return incentiveTickRange, nil
+}
+
+// parseTickRangeFromKeySuffix parses the tick range from the trailing lower and upper tick bytes of the key.
+func parseTickRangeFromKeySuffix(key []byte, _ []byte) (types.TickRange, error) {
+	lowerTick, err := types.TickIndexFromBytes(key[len(key)-18 : len(key)-9])
+	if err != nil {
+		return types.TickRange{}, err
+	}
+	upperTick, err := types.TickIndexFromBytes(key[len(key)-9:])
+	if err != nil {
+		return types.TickRange{}, err
+	}
+
+	return types.TickRange{LowerTick: lowerTick, UpperTick: upperTick}, nil
+}
+
+// parseIncentiveTickRangeTick parses the tick index from the trailing bytes of the key and the tick info from the value.
+func parseIncentiveTickRangeTick(key []byte, value []byte) (genesis.IncentiveTickRangeTick, error) {
+	tickIndex, err := types.TickIndexFromBytes(key[len(key)-9:])
+	if err != nil {
+		return genesis.IncentiveTickRangeTick{}, err
+	}
+
+	tickInfo := model.IncentiveTickRangeTickInfo{}
+	if err := proto.Unmarshal(value, &tickInfo); err != nil {
+		return genesis.IncentiveTickRangeTick{}, err
+	}
+
+	return genesis.IncentiveTickRangeTick{TickIndex: tickIndex, Info: tickInfo}, nil
+}
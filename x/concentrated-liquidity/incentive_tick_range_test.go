@@ -0,0 +1,310 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+var defaultIncentiveTickRange = types.TickRange{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick}
+
+// setupIncentiveTickRangeTest creates a pool with a tick range incentive emitting 1 USDC per second restricted to the default
+// tick range, then a position within the default tick range and a full range position.
+// Returns the ids of the narrow and full range positions.
+func (s *KeeperTestSuite) setupIncentiveTickRangeTest() (uint64, uint64) {
+	pool := s.prepareIncentiveTickRangeTestPool()
+
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1000000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err := s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+
+	narrowPositionId, fullRangePositionId := s.createIncentiveTickRangeTestPositions(pool.GetId())
+	return narrowPositionId, fullRangePositionId
+}
+
+// prepareIncentiveTickRangeTestPool resets the suite with the default authorized uptimes and creates a pool.
+func (s *KeeperTestSuite) prepareIncentiveTickRangeTestPool() types.ConcentratedPoolExtension {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1, 1).UTC())
+
+	clParams := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	clParams.AuthorizedUptimes = DefaultAuthorizedUptimes
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, clParams)
+
+	return s.PrepareConcentratedPool()
+}
+
+// createIncentiveTickRangeTestPositions creates a position within the default tick range and a full range position in the
+// given pool. Returns their ids.
+func (s *KeeperTestSuite) createIncentiveTickRangeTestPositions(poolId uint64) (uint64, uint64) {
+	s.FundAcc(s.TestAccs[0], DefaultCoins.Add(DefaultCoins...))
+
+	narrowPosition, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, poolId, s.TestAccs[0], DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	fullRangePosition, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePosition(s.Ctx, poolId, s.TestAccs[0], DefaultCoins)
+	s.Require().NoError(err)
+
+	return narrowPosition.ID, fullRangePosition.ID
+}
+
+// TestIncentiveTickRange_OnlyQualifyingLiquidityEarns tests that the incentives restricted to a tick range are only emitted
+// to the positions whose range sits within it, and that they are emitted over the qualifying liquidity only.
+func (s *KeeperTestSuite) TestIncentiveTickRange_OnlyQualifyingLiquidityEarns() {
+	narrowPositionId, fullRangePositionId := s.setupIncentiveTickRangeTest()
+
+	// Only the narrow position qualifies for the tick range.
+	narrowPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, narrowPositionId)
+	s.Require().NoError(err)
+	incentiveTickRange, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().Equal(narrowPosition.Liquidity, incentiveTickRange.Liquidity)
+
+	s.AddBlockTime(100 * time.Second)
+
+	// The full range position does not earn any of the restricted incentives.
+	collected, forfeited, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], fullRangePositionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+	s.Require().True(forfeited.IsZero())
+
+	// Since the incentives are emitted over the liquidity of the narrow position only, it earns all of them.
+	// Note that up to 1 unit may be truncated.
+	collected, forfeited, err = s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], narrowPositionId)
+	s.Require().NoError(err)
+	s.Require().True(forfeited.IsZero())
+	s.Require().True(collected.AmountOf(USDC).GTE(osmomath.NewInt(99)))
+	s.Require().True(collected.AmountOf(USDC).LTE(osmomath.NewInt(100)))
+
+	// The global uptime accumulators are left untouched by the restricted incentives.
+	uptimeAccumulators, err := s.App.ConcentratedLiquidityKeeper.GetUptimeAccumulators(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	for _, uptimeAccumulator := range uptimeAccumulators {
+		s.Require().True(uptimeAccumulator.GetValue().IsZero())
+	}
+}
+
+// TestIncentiveTickRange_CrossTick tests that the qualifying liquidity of a tick range is updated as swaps cross the
+// ticks of its positions, and that no incentives are emitted while there is no qualifying liquidity in range.
+func (s *KeeperTestSuite) TestIncentiveTickRange_CrossTick() {
+	narrowPositionId, _ := s.setupIncentiveTickRangeTest()
+
+	// Swap enough USDC in to move the price above the upper tick of the narrow position.
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(6000000000))))
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, sdk.NewCoin(USDC, osmomath.NewInt(6000000000)), ETH, osmomath.OneInt(), DefaultZeroSpreadFactor)
+	s.Require().NoError(err)
+
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Greater(pool.GetCurrentTick(), DefaultUpperTick)
+
+	incentiveTickRange, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().True(incentiveTickRange.Liquidity.IsZero())
+
+	s.AddBlockTime(100 * time.Second)
+
+	// No incentives are emitted to the narrow position while it is out of range, so they remain in the record.
+	collected, _, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], narrowPositionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+
+	incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetAllIncentiveRecordsForPool(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Len(incentiveRecords, 1)
+	s.Require().Equal(osmomath.NewDec(1000000), incentiveRecords[0].IncentiveRecordBody.RemainingCoin.Amount)
+}
+
+// TestIncentiveTickRange_Genesis tests that incentive tick ranges are exported and imported along with their ticks,
+// uptime accumulators and qualifying position records.
+func (s *KeeperTestSuite) TestIncentiveTickRange_Genesis() {
+	narrowPositionId, _ := s.setupIncentiveTickRangeTest()
+	s.AddBlockTime(100 * time.Second)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, defaultPoolId))
+
+	exported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.PoolData, 1)
+	incentiveTickRanges := exported.PoolData[0].IncentiveTickRanges
+	s.Require().Len(incentiveTickRanges, 1)
+	s.Require().Equal(defaultIncentiveTickRange, incentiveTickRanges[0].IncentiveTickRange.TickRange)
+	s.Require().Len(incentiveTickRanges[0].Ticks, 2)
+	s.Require().Len(incentiveTickRanges[0].UptimeAccumulators, len(types.SupportedUptimes))
+	s.Require().Len(incentiveTickRanges[0].Positions, 1)
+	s.Require().Equal(narrowPositionId, incentiveTickRanges[0].Positions[0].PositionId)
+
+	blockTime := s.Ctx.BlockTime()
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.ConcentratedLiquidityKeeper.InitGenesis(s.Ctx, *exported)
+
+	reexported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(reexported.PoolData, 1)
+	s.Require().Equal(incentiveTickRanges, reexported.PoolData[0].IncentiveTickRanges)
+}
+
+// TestIncentiveTickRange_ExistingPositionsJoinLazily tests that the positions that exist when an incentive tick range is created
+// are not added to it, that the restricted incentives are not emitted until a qualifying position joins it, and that a passive
+// qualifying position joins it when it collects its incentives.
+func (s *KeeperTestSuite) TestIncentiveTickRange_ExistingPositionsJoinLazily() {
+	pool := s.prepareIncentiveTickRangeTestPool()
+	narrowPositionId, fullRangePositionId := s.createIncentiveTickRangeTestPositions(pool.GetId())
+
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1000000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err := s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+
+	incentiveTickRange, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().True(incentiveTickRange.Liquidity.IsZero())
+
+	// The passive narrow position has not joined the tick range, so none of the restricted incentives are emitted
+	// and they remain in the record.
+	s.AddBlockTime(100 * time.Second)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, defaultPoolId))
+	incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetAllIncentiveRecordsForPool(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Len(incentiveRecords, 1)
+	s.Require().Equal(osmomath.NewDec(1000000), incentiveRecords[0].IncentiveRecordBody.RemainingCoin.Amount)
+
+	// Collecting the incentives of a position that does not qualify leaves the tick range unchanged.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], fullRangePositionId)
+	s.Require().NoError(err)
+	incentiveTickRange, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().True(incentiveTickRange.Liquidity.IsZero())
+
+	// The first collect of the narrow position only joins the tick range with all of its liquidity, it then earns all
+	// of the incentives.
+	collected, _, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], narrowPositionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero())
+
+	narrowPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, narrowPositionId)
+	s.Require().NoError(err)
+	incentiveTickRange, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().Equal(narrowPosition.Liquidity, incentiveTickRange.Liquidity)
+
+	s.AddBlockTime(100 * time.Second)
+
+	collected, _, err = s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], narrowPositionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.AmountOf(USDC).GTE(osmomath.NewInt(99)))
+	s.Require().True(collected.AmountOf(USDC).LTE(osmomath.NewInt(100)))
+}
+
+// TestIncentiveTickRange_Limit tests that a pool cannot have more than the maximum number of incentive tick ranges,
+// and that incentives can still be restricted to its existing tick ranges.
+func (s *KeeperTestSuite) TestIncentiveTickRange_Limit() {
+	pool := s.prepareIncentiveTickRangeTestPool()
+
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(USDC, incentiveCoin.Amount.MulRaw(types.MaxIncentiveTickRangesPerPool+1))))
+
+	createTickRangeIncentive := func(tickRange types.TickRange) error {
+		_, err := s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, tickRange)
+		return err
+	}
+
+	for i := 0; i < types.MaxIncentiveTickRangesPerPool; i++ {
+		s.Require().NoError(createTickRangeIncentive(types.TickRange{LowerTick: DefaultLowerTick - int64(i)*int64(DefaultTickSpacing), UpperTick: DefaultUpperTick}))
+	}
+
+	newTickRange := types.TickRange{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick + int64(DefaultTickSpacing)}
+	expectedErr := types.IncentiveTickRangeLimitError{PoolId: pool.GetId(), MaxIncentiveTickRanges: types.MaxIncentiveTickRangesPerPool}
+	s.Require().ErrorIs(createTickRangeIncentive(newTickRange), expectedErr)
+	s.Require().ErrorIs(s.App.ConcentratedLiquidityKeeper.ValidateIncentiveTickRange(s.Ctx, pool.GetId(), newTickRange), expectedErr)
+
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.ValidateIncentiveTickRange(s.Ctx, pool.GetId(), defaultIncentiveTickRange))
+	s.Require().NoError(createTickRangeIncentive(defaultIncentiveTickRange))
+}
+
+// TestIncentiveTickRange_Prune tests that an incentive tick range without live incentive records is pruned, and that the
+// incentives its positions accrued in it remain claimable.
+func (s *KeeperTestSuite) TestIncentiveTickRange_Prune() {
+	narrowPositionId, _ := s.setupIncentiveTickRangeTest()
+
+	// Emit all of the incentives of the record.
+	s.AddBlockTime(2000000 * time.Second)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, defaultPoolId))
+	incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetAllIncentiveRecordsForPool(s.Ctx, defaultPoolId)
+	s.Require().NoError(err)
+	s.Require().Empty(incentiveRecords)
+
+	// The tick range is only deleted once its positions are removed from it.
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.PruneIncentiveTickRanges(s.Ctx))
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().ErrorIs(err, types.IncentiveTickRangeNotFoundError{PoolId: defaultPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick})
+	ticks, err := s.App.ConcentratedLiquidityKeeper.GetAllIncentiveTickRangeTicks(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	s.Require().Empty(ticks)
+
+	// The incentives accrued by the narrow position in the tick range are claimed from the uptime accumulators of the pool.
+	// Note that up to 1 unit may be truncated.
+	collected, forfeited, err := s.App.ConcentratedLiquidityKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], narrowPositionId)
+	s.Require().NoError(err)
+	s.Require().True(forfeited.IsZero())
+	s.Require().True(collected.AmountOf(USDC).GTE(osmomath.NewInt(999999)))
+	s.Require().True(collected.AmountOf(USDC).LTE(osmomath.NewInt(1000000)))
+
+	// The tick range can be created again, reusing its uptime accumulators.
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+	_, err = s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, defaultPoolId, s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().NoError(err)
+}
+
+// TestIncentiveTickRange_PruneFailure tests that an incentive tick range that fails to be pruned is unflagged, so that it
+// does not prevent the other tick ranges from being pruned in the same block.
+func (s *KeeperTestSuite) TestIncentiveTickRange_PruneFailure() {
+	pool := s.prepareIncentiveTickRangeTestPool()
+
+	// The wider tick range is iterated first when pruning.
+	widerTickRange := types.TickRange{LowerTick: DefaultLowerTick - 100*int64(DefaultTickSpacing), UpperTick: DefaultUpperTick}
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1000000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin, incentiveCoin))
+	for _, tickRange := range []types.TickRange{defaultIncentiveTickRange, widerTickRange} {
+		_, err := s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, tickRange)
+		s.Require().NoError(err)
+	}
+
+	s.createIncentiveTickRangeTestPositions(pool.GetId())
+	s.FundAcc(s.TestAccs[0], DefaultCoins)
+	widerPosition, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[0], DefaultCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), widerTickRange.LowerTick, widerTickRange.UpperTick)
+	s.Require().NoError(err)
+
+	// Emit all of the incentives of the records, flagging both tick ranges for pruning.
+	s.AddBlockTime(2000000 * time.Second)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, defaultPoolId))
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsIncentiveTickRangePruning(s.Ctx, defaultPoolId, defaultIncentiveTickRange))
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsIncentiveTickRangePruning(s.Ctx, defaultPoolId, widerTickRange))
+
+	// Removing the position that only qualifies for the wider tick range makes its pruning fail.
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.DeletePosition(s.Ctx, widerPosition.ID, s.TestAccs[0], defaultPoolId))
+
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.PruneIncentiveTickRanges(s.Ctx))
+
+	// The wider tick range is left as is and unflagged, while the default tick range is pruned.
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, widerTickRange)
+	s.Require().NoError(err)
+	s.Require().False(s.App.ConcentratedLiquidityKeeper.IsIncentiveTickRangePruning(s.Ctx, defaultPoolId, widerTickRange))
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, defaultPoolId, defaultIncentiveTickRange)
+	s.Require().ErrorIs(err, types.IncentiveTickRangeNotFoundError{PoolId: defaultPoolId, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick})
+
+	// The wider tick range is flagged again the next time the uptime accumulators of the pool are updated.
+	s.AddBlockTime(time.Second)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, defaultPoolId))
+	s.Require().True(s.App.ConcentratedLiquidityKeeper.IsIncentiveTickRangePruning(s.Ctx, defaultPoolId, widerTickRange))
+}
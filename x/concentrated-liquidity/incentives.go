@@ -180,6 +180,10 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 		return err
 	}
 
+	// Incentive records restricted to a tick range are emitted to the uptime accumulators of their
+	// incentive tick range instead, which only account for the qualifying liquidity.
+	poolIncentiveRecords, restrictedIncentiveRecords := splitIncentiveRecordsByTickRange(poolIncentiveRecords)
+
 	// We optimistically assume that all liquidity on the active tick qualifies and handle
 	// uptime-related checks in forfeiting logic.
 
@@ -202,8 +206,14 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 		}
 	}
 
+	// The incentive tick ranges are updated even without restricted incentive records so that the ones without live records are pruned.
+	restrictedIncentiveRecords, err = k.updateIncentiveTickRangeUptimeAccumulators(ctx, poolId, timeElapsedSec, restrictedIncentiveRecords)
+	if err != nil {
+		return err
+	}
+
 	// Update pool incentive records and LastLiquidityUpdate time in state to reflect emitted incentives
-	err = k.setMultipleIncentiveRecords(ctx, poolIncentiveRecords)
+	err = k.setMultipleIncentiveRecords(ctx, append(poolIncentiveRecords, restrictedIncentiveRecords...))
 	if err != nil {
 		return err
 	}
@@ -434,11 +444,17 @@ func (k Keeper) GetUptimeGrowthInsideRange(ctx sdk.Context, poolId uint64, lower
 		return []sdk.DecCoins{}, err
 	}
 
+	lowerTickUptimeValues := getUptimeTrackerValues(lowerTickInfo.UptimeTrackers.List)
+	upperTickUptimeValues := getUptimeTrackerValues(upperTickInfo.UptimeTrackers.List)
+	return calcUptimeGrowthInsideRange(currentTick, lowerTick, upperTick, globalUptimeValues, lowerTickUptimeValues, upperTickUptimeValues)
+}
+
+// calcUptimeGrowthInsideRange calculates the uptime growth within the given tick range for all supported uptimes
+// from the given global uptime accumulator values and the uptime trackers of the lower and upper ticks.
+func calcUptimeGrowthInsideRange(currentTick, lowerTick, upperTick int64, globalUptimeValues, lowerTickUptimeValues, upperTickUptimeValues []sdk.DecCoins) ([]sdk.DecCoins, error) {
 	// Calculate uptime growth between lower and upper ticks
 	// Note that we regard "within range" to mean [lowerTick, upperTick),
 	// inclusive of lowerTick and exclusive of upperTick.
-	lowerTickUptimeValues := getUptimeTrackerValues(lowerTickInfo.UptimeTrackers.List)
-	upperTickUptimeValues := getUptimeTrackerValues(upperTickInfo.UptimeTrackers.List)
 	// If current tick is below range, we subtract uptime growth of upper tick from that of lower tick
 	if currentTick < lowerTick {
 		// Note: SafeSub with negative accumulation is possible if upper tick is initialized first
@@ -520,6 +536,12 @@ func (k Keeper) initOrUpdatePositionUptimeAccumulators(ctx sdk.Context, poolId u
 		return err
 	}
 
+	return initOrUpdatePositionInUptimeAccumulators(uptimeAccumulators, positionId, liquidity, liquidityDelta, globalUptimeGrowthInsideRange, globalUptimeGrowthOutsideRange)
+}
+
+// initOrUpdatePositionInUptimeAccumulators either initializes or updates the records of the given position in the given uptime
+// accumulators, one for every supported uptime, given the uptime growth inside and outside of the position's range.
+func initOrUpdatePositionInUptimeAccumulators(uptimeAccumulators []*accum.AccumulatorObject, positionId uint64, liquidity, liquidityDelta osmomath.Dec, uptimeGrowthInsideRange, uptimeGrowthOutsideRange []sdk.DecCoins) error {
 	// Loop through uptime accums for all supported uptimes on the pool and init or update position's records
 	positionName := string(types.KeyPositionId(positionId))
 	for uptimeIndex, curUptimeAccum := range uptimeAccumulators {
@@ -533,23 +555,23 @@ func (k Keeper) initOrUpdatePositionUptimeAccumulators(ctx sdk.Context, poolId u
 				return types.NonPositiveLiquidityForNewPositionError{LiquidityDelta: liquidityDelta, PositionId: positionId}
 			}
 
-			// Since the position should only be entitled to uptime growth within its range, we checkpoint globalUptimeGrowthInsideRange as
+			// Since the position should only be entitled to uptime growth within its range, we checkpoint uptimeGrowthInsideRange as
 			// its accumulator's init value. During the claiming (or, equivalently, position updating) process, we ensure that incentives are
 			// not overpaid.
-			err = curUptimeAccum.NewPositionIntervalAccumulation(positionName, liquidity, globalUptimeGrowthInsideRange[uptimeIndex], emptyOptions)
+			err := curUptimeAccum.NewPositionIntervalAccumulation(positionName, liquidity, uptimeGrowthInsideRange[uptimeIndex], emptyOptions)
 			if err != nil {
 				return err
 			}
 		} else {
 			// Prep accum since we claim rewards first under the hood before any update (otherwise we would overpay)
-			err := updatePositionToInitValuePlusGrowthOutside(curUptimeAccum, positionName, globalUptimeGrowthOutsideRange[uptimeIndex])
+			err := updatePositionToInitValuePlusGrowthOutside(curUptimeAccum, positionName, uptimeGrowthOutsideRange[uptimeIndex])
 			if err != nil {
 				return err
 			}
 
 			// Note that even though "unclaimed rewards" accrue in the accumulator prior to reaching minUptime, since position withdrawal
 			// and incentive collection are only allowed when current time is past minUptime these rewards are not accessible until then.
-			err = curUptimeAccum.UpdatePositionIntervalAccumulation(positionName, liquidityDelta, globalUptimeGrowthInsideRange[uptimeIndex])
+			err = curUptimeAccum.UpdatePositionIntervalAccumulation(positionName, liquidityDelta, uptimeGrowthInsideRange[uptimeIndex])
 			if err != nil {
 				return err
 			}
@@ -679,6 +701,35 @@ func (k Keeper) prepareClaimAllIncentivesForPosition(ctx sdk.Context, positionId
 		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
+	collectedIncentivesForPosition, forfeitedIncentivesForPosition, err := claimPositionUptimeAccumulators(uptimeAccumulators, positionId, positionAge, uptimeGrowthOutside)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	// Claim the incentives restricted to the tick ranges the position qualifies for.
+	incentiveTickRanges, err := k.getQualifyingIncentiveTickRanges(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	for _, incentiveTickRange := range incentiveTickRanges {
+		collectedIncentivesForTickRange, forfeitedIncentivesForTickRange, err := k.claimPositionIncentiveTickRange(ctx, incentiveTickRange, position, positionAge)
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, err
+		}
+
+		collectedIncentivesForPosition = collectedIncentivesForPosition.Add(collectedIncentivesForTickRange...)
+		forfeitedIncentivesForPosition = forfeitedIncentivesForPosition.Add(forfeitedIncentivesForTickRange...)
+	}
+
+	return collectedIncentivesForPosition, forfeitedIncentivesForPosition, nil
+}
+
+// claimPositionUptimeAccumulators claims the incentives of the given position from the given uptime accumulators, one for every
+// supported uptime, given the uptime growth outside of the position's range. The incentives of the uptimes that are longer than the
+// age of the position are forfeited.
+//
+// Returns the collected and forfeited incentives, or error if there is an issue that arises while claiming.
+func claimPositionUptimeAccumulators(uptimeAccumulators []*accum.AccumulatorObject, positionId uint64, positionAge time.Duration, uptimeGrowthOutside []sdk.DecCoins) (sdk.Coins, sdk.Coins, error) {
 	// Create a variable to hold the name of the position.
 	positionName := string(types.KeyPositionId(positionId))
 
@@ -735,11 +786,9 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
-	if sender.String() != position.Address {
-		return sdk.Coins{}, sdk.Coins{}, types.NotPositionOwnerError{
-			PositionId: positionId,
-			Address:    sender.String(),
-		}
+	// Incentive collector must be the owner of the position.
+	if _, err := k.validatePositionOwner(ctx, position, sender); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
 	// Claim all incentives for the position.
@@ -800,11 +849,32 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 // - other internal database or math errors.
 // WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
 func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
+	return k.createIncentive(ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime, nil)
+}
+
+// CreateTickRangeIncentive creates an incentive record in state for the given pool that is restricted to the positions whose
+// range sits within the given tick range. The incentives are only emitted to the qualifying liquidity.
+//
+// Returns error under the same conditions as CreateIncentive, or if the tick range is not valid for the pool.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) CreateTickRangeIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration, tickRange types.TickRange) (types.IncentiveRecord, error) {
+	return k.createIncentive(ctx, poolId, sender, incentiveCoin, emissionRate, startTime, minUptime, &tickRange)
+}
+
+// createIncentive creates an incentive record in state for the given pool, restricted to the given tick range if it is not nil.
+// The incentive tick range is created if this is the first incentive record restricted to it.
+func (k Keeper) createIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration, tickRange *types.TickRange) (types.IncentiveRecord, error) {
 	pool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return types.IncentiveRecord{}, err
 	}
 
+	if tickRange != nil {
+		if err := validateTickRangeIsValid(pool.GetTickSpacing(), tickRange.LowerTick, tickRange.UpperTick); err != nil {
+			return types.IncentiveRecord{}, err
+		}
+	}
+
 	// checks if the Coin has a non-negative amount and the denom is valid.
 	if !incentiveCoin.IsValid() || incentiveCoin.IsZero() {
 		return types.IncentiveRecord{}, types.InvalidIncentiveCoinError{PoolId: poolId, IncentiveCoin: incentiveCoin}
@@ -851,6 +921,14 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		return types.IncentiveRecord{}, err
 	}
 
+	// Start tracking the qualifying liquidity of the tick range once the accumulators are synced,
+	// so that only the liquidity of qualifying positions is incentivized from now on.
+	if tickRange != nil {
+		if err := k.initIncentiveTickRangeIfNotExists(ctx, poolId, *tickRange); err != nil {
+			return types.IncentiveRecord{}, err
+		}
+	}
+
 	// Get an ID unique to this incentive record
 	incentiveRecordId := k.GetNextIncentiveRecordId(ctx)
 	k.SetNextIncentiveRecordId(ctx, incentiveRecordId+1)
@@ -859,6 +937,7 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		RemainingCoin: sdk.NewDecCoinFromCoin(incentiveCoin),
 		EmissionRate:  emissionRate,
 		StartTime:     startTime,
+		TickRange:     tickRange,
 	}
 
 	// Set up incentive record to put in state
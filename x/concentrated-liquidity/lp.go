@@ -214,6 +214,7 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // is undefined. When the last position is removed by calling this method, the current sqrt price and current
 // tick of the pool are set to zero. Lastly, if the tick being withdrawn from is now empty due to the withdrawal,
 // it is deleted from state.
+// The owner of a tokenized position is the holder of its NFT, and withdrawing the full position burns the NFT.
 // Returns error if
 // - the provided owner does not own the position being withdrawn
 // - there is no position in the given tick ranges
@@ -230,8 +231,14 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	}
 
 	// Check if the provided owner owns the position being withdrawn.
-	if owner.String() != position.Address {
-		return osmomath.Int{}, osmomath.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	// A tokenized position is owned by the holder of its NFT, while it is stored under the NFT contract.
+	isTokenized, err := k.validatePositionOwner(ctx, position, owner)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	positionAddress, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
 	}
 
 	// Defense in depth, requestedLiquidityAmountToWithdraw should always be a value that is GE than 0.
@@ -275,12 +282,21 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	// The spread rewards accrued by a position younger than the minimum age are forfeited as soon as liquidity is removed
+	// from it. Otherwise, just-in-time liquidity could withdraw most of its liquidity right after a swap and claim the
+	// spread rewards left in the position once it is old enough.
+	if requestedLiquidityAmountToWithdraw.IsPositive() && k.isPositionBelowSpreadRewardMinAge(ctx, positionId) {
+		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
 	// Calculate the change in liquidity for the pool based on the requested amount to withdraw.
 	// This amount is negative because that liquidity is being withdrawn from the pool.
 	liquidityDelta := requestedLiquidityAmountToWithdraw.Neg()
 
 	// Update the position in the pool based on the provided tick range and liquidity delta.
-	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, positionAddress, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
@@ -303,9 +319,17 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
 
-		if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
+		// The NFT representing a tokenized position is burned along with the position.
+		if isTokenized {
+			if err := k.burnPositionNFT(ctx, positionAddress, positionId, owner); err != nil {
+				return osmomath.Int{}, osmomath.Int{}, err
+			}
+		}
+
+		if err := k.deletePosition(ctx, positionId, positionAddress, position.PoolId); err != nil {
 			return osmomath.Int{}, osmomath.Int{}, err
 		}
+		k.deletePositionJoinHeight(ctx, positionId)
 
 		anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
 		if err != nil {
@@ -358,6 +382,8 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	}
 	event.emit(ctx)
 
+	k.listeners.AfterPoolPositionWithdrawn(ctx, owner, position.PoolId)
+
 	// Trigger after hook for WithdrawPosition.
 	// If no contract is set, this will be a no-op.
 	err = k.AfterWithdrawPosition(ctx, position.PoolId, owner, positionId, requestedLiquidityAmountToWithdraw)
@@ -376,6 +402,7 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 // Note that these field indicates the min amount corresponding to the total liquidity of the position,
 // not only for the liquidity amount that is being added.
 // Uses amounts withdrawn from the original position if provided min amount is zero.
+// If the position is tokenized, the new position is tokenized as well, minting a new NFT to the owner.
 // Returns error if
 // - Withdrawing full position fails
 // - Creating new position with added liquidity fails
@@ -388,8 +415,9 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 	}
 
 	// Check if the provided owner owns the position being added to.
-	if owner.String() != position.Address {
-		return 0, osmomath.Int{}, osmomath.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	isTokenized, err := k.validatePositionOwner(ctx, position, owner)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
 	// if one of the liquidity is negative, or both liquidity being added is zero, error
@@ -448,6 +476,13 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	// Withdrawing a tokenized position burned its NFT, so the new position is tokenized in its place.
+	if isTokenized {
+		if err := k.TokenizePosition(ctx, owner, newPositionData.ID); err != nil {
+			return 0, osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
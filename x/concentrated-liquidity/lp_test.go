@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/clmocks"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -748,6 +749,9 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 				expectedAfterLastPoolPositionRemovedCallCount = 1
 			}
 			s.validateListenerCallCount(0, 0, expectedAfterLastPoolPositionRemovedCallCount, 0)
+			mockListener, ok := concentratedLiquidityKeeper.GetListenersUnsafe()[0].(*clmocks.ConcentratedLiquidityListenerMock)
+			s.Require().True(ok)
+			s.Require().Equal(1, mockListener.AfterPoolPositionWithdrawnCallCount)
 
 			// Dumb sanity-check that creating a position with the same liquidity amount after fully removing it does not error.
 			// This is to be more thoroughly tested separately.
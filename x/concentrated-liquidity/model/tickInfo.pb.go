@@ -56,6 +56,13 @@ type TickInfo struct {
 	// because we need the ability to serialize and deserialize the
 	// container easily for events when crossing a tick.
 	UptimeTrackers UptimeTrackers `protobuf:"bytes,4,opt,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
+	// range_orders_zero_for_one is the number of unfilled range orders that
+	// are filled by a zero for one swap crossing this tick. It lets swaps
+	// detect range orders to settle from the tick info they already read.
+	RangeOrdersZeroForOne uint64 `protobuf:"varint,5,opt,name=range_orders_zero_for_one,json=rangeOrdersZeroForOne,proto3" json:"range_orders_zero_for_one,omitempty" yaml:"range_orders_zero_for_one"`
+	// range_orders_one_for_zero is the number of unfilled range orders that
+	// are filled by a one for zero swap crossing this tick.
+	RangeOrdersOneForZero uint64 `protobuf:"varint,6,opt,name=range_orders_one_for_zero,json=rangeOrdersOneForZero,proto3" json:"range_orders_one_for_zero,omitempty" yaml:"range_orders_one_for_zero"`
 }
 
 func (m *TickInfo) Reset()         { *m = TickInfo{} }
@@ -105,6 +112,70 @@ func (m *TickInfo) GetUptimeTrackers() UptimeTrackers {
 	return UptimeTrackers{}
 }
 
+func (m *TickInfo) GetRangeOrdersZeroForOne() uint64 {
+	if m != nil {
+		return m.RangeOrdersZeroForOne
+	}
+	return 0
+}
+
+func (m *TickInfo) GetRangeOrdersOneForZero() uint64 {
+	if m != nil {
+		return m.RangeOrdersOneForZero
+	}
+	return 0
+}
+
+// IncentiveTickRangeTickInfo is the tick info of a tick of an incentive tick
+// range. It only accounts for the liquidity of the positions qualifying for
+// the incentive records restricted to the tick range, and for the uptime
+// growth of the uptime accumulators of the tick range.
+type IncentiveTickRangeTickInfo struct {
+	LiquidityGross cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	LiquidityNet   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_net" yaml:"liquidity_net"`
+	UptimeTrackers UptimeTrackers              `protobuf:"bytes,3,opt,name=uptime_trackers,json=uptimeTrackers,proto3" json:"uptime_trackers" yaml:"uptime_trackers"`
+}
+
+func (m *IncentiveTickRangeTickInfo) Reset()         { *m = IncentiveTickRangeTickInfo{} }
+func (m *IncentiveTickRangeTickInfo) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTickRangeTickInfo) ProtoMessage()    {}
+func (*IncentiveTickRangeTickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a875fae329cc9559, []int{1}
+}
+func (m *IncentiveTickRangeTickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTickRangeTickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTickRangeTickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTickRangeTickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTickRangeTickInfo.Merge(m, src)
+}
+func (m *IncentiveTickRangeTickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTickRangeTickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTickRangeTickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTickRangeTickInfo proto.InternalMessageInfo
+
+func (m *IncentiveTickRangeTickInfo) GetUptimeTrackers() UptimeTrackers {
+	if m != nil {
+		return m.UptimeTrackers
+	}
+	return UptimeTrackers{}
+}
+
 type UptimeTrackers struct {
 	List []UptimeTracker `protobuf:"bytes,1,rep,name=list,proto3" json:"list" yaml:"list"`
 }
@@ -113,7 +184,7 @@ func (m *UptimeTrackers) Reset()         { *m = UptimeTrackers{} }
 func (m *UptimeTrackers) String() string { return proto.CompactTextString(m) }
 func (*UptimeTrackers) ProtoMessage()    {}
 func (*UptimeTrackers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a875fae329cc9559, []int{1}
+	return fileDescriptor_a875fae329cc9559, []int{2}
 }
 func (m *UptimeTrackers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +228,7 @@ func (m *UptimeTracker) Reset()         { *m = UptimeTracker{} }
 func (m *UptimeTracker) String() string { return proto.CompactTextString(m) }
 func (*UptimeTracker) ProtoMessage()    {}
 func (*UptimeTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a875fae329cc9559, []int{2}
+	return fileDescriptor_a875fae329cc9559, []int{3}
 }
 func (m *UptimeTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +266,7 @@ func (m *UptimeTracker) GetUptimeGrowthOutside() github_com_cosmos_cosmos_sdk_ty
 
 func init() {
 	proto.RegisterType((*TickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.TickInfo")
+	proto.RegisterType((*IncentiveTickRangeTickInfo)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveTickRangeTickInfo")
 	proto.RegisterType((*UptimeTrackers)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeTrackers")
 	proto.RegisterType((*UptimeTracker)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeTracker")
 }
@@ -204,42 +276,48 @@ func init() {
 }
 
 var fileDescriptor_a875fae329cc9559 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x1b, 0xb7, 0x88, 0xa6, 0x6e, 0x17, 0xb2, 0xab, 0xd4, 0x55, 0xd2, 0x12, 0x10, 0x0a,
-	0xd2, 0x84, 0x76, 0xd7, 0x83, 0x8a, 0x97, 0x58, 0x58, 0x84, 0xc5, 0x85, 0x50, 0x2f, 0xa2, 0xc4,
-	0xe9, 0x64, 0xda, 0x0e, 0x4d, 0x32, 0x31, 0xef, 0xa5, 0x6b, 0x2f, 0xde, 0xbc, 0x7b, 0xf3, 0xe8,
-	0xdd, 0x4f, 0xd2, 0xe3, 0x1e, 0xc5, 0x43, 0x95, 0xf6, 0x1b, 0xf8, 0x09, 0x24, 0xc9, 0xa4, 0xb5,
-	0xc5, 0xc3, 0x22, 0xec, 0xa9, 0x9d, 0x97, 0xf7, 0xfe, 0xff, 0x1f, 0xef, 0x9f, 0x8c, 0x7a, 0x2c,
-	0x20, 0x10, 0xc0, 0xc1, 0xa2, 0x22, 0xa4, 0x2c, 0xc4, 0x98, 0x20, 0xf3, 0x7c, 0xfe, 0x3e, 0xe1,
-	0x1e, 0xc7, 0xa9, 0x35, 0x69, 0xf7, 0x19, 0x92, 0xb6, 0x85, 0x9c, 0x8e, 0x5f, 0x84, 0x03, 0x61,
-	0x46, 0xb1, 0x40, 0xa1, 0x3d, 0x90, 0x53, 0xe6, 0x3f, 0xa7, 0x4c, 0x39, 0x75, 0x78, 0x97, 0x66,
-	0x7d, 0x6e, 0x36, 0x64, 0xe5, 0x87, 0x5c, 0xe1, 0xf0, 0x60, 0x28, 0x86, 0x22, 0xaf, 0xa7, 0xff,
-	0x64, 0x55, 0xcf, 0x7b, 0xac, 0x3e, 0x01, 0xb6, 0xf2, 0xa6, 0x82, 0x87, 0xf9, 0x73, 0xe3, 0x6b,
-	0x59, 0xbd, 0xd1, 0x93, 0x28, 0xda, 0x40, 0xdd, 0x5b, 0x59, 0xba, 0xc3, 0x58, 0x00, 0xd4, 0x94,
-	0x86, 0xd2, 0xbc, 0x69, 0x3f, 0x9b, 0xcd, 0xeb, 0xa5, 0x1f, 0xf3, 0xfa, 0xbd, 0x5c, 0x0d, 0xbc,
-	0xb1, 0xc9, 0x85, 0x15, 0x10, 0x1c, 0x99, 0xa7, 0x6c, 0x48, 0xe8, 0xb4, 0xcb, 0xe8, 0xef, 0x79,
-	0xfd, 0xce, 0x94, 0x04, 0xfe, 0x13, 0x63, 0x4b, 0xc3, 0x70, 0xaa, 0xab, 0xca, 0x49, 0x5a, 0xd0,
-	0xde, 0xa9, 0xbb, 0xeb, 0x9e, 0x90, 0x61, 0xed, 0x5a, 0xe6, 0xf2, 0xf4, 0x72, 0x2e, 0x07, 0xdb,
-	0x2e, 0x21, 0x43, 0xc3, 0xb9, 0xb5, 0x3a, 0xbf, 0x64, 0xa8, 0xcd, 0x14, 0xf5, 0x31, 0x44, 0x31,
-	0x23, 0x9e, 0x1b, 0xb3, 0x73, 0x12, 0x7b, 0x29, 0xca, 0x39, 0x8e, 0x5c, 0x11, 0x45, 0x02, 0x38,
-	0x32, 0xd7, 0xe3, 0x31, 0xa3, 0xc8, 0x45, 0xe8, 0x8a, 0x81, 0xeb, 0x13, 0x40, 0x17, 0x63, 0x32,
-	0x61, 0x31, 0x10, 0xbf, 0xb6, 0xd3, 0xd8, 0x69, 0x56, 0x3a, 0xf7, 0x4d, 0xb9, 0xdf, 0x74, 0x77,
-	0x45, 0x02, 0x66, 0x97, 0xd1, 0xe7, 0x82, 0x87, 0xf6, 0x51, 0x0a, 0xfb, 0xed, 0x67, 0xfd, 0xe1,
-	0x90, 0xe3, 0x28, 0xe9, 0x9b, 0x54, 0x04, 0x32, 0x0f, 0xf9, 0xd3, 0x02, 0x6f, 0x6c, 0xe1, 0x34,
-	0x62, 0x50, 0xcc, 0x80, 0xd3, 0xc9, 0x99, 0x9c, 0x0c, 0xe9, 0x24, 0x23, 0x3a, 0x93, 0x40, 0xdd,
-	0x82, 0xe7, 0x6c, 0x70, 0x4a, 0x00, 0x7b, 0x05, 0x8c, 0xf6, 0x51, 0xdd, 0x4b, 0x22, 0xe4, 0x01,
-	0x4b, 0x01, 0xe9, 0x98, 0xc5, 0x50, 0x2b, 0x37, 0x94, 0x66, 0xa5, 0xf3, 0xc8, 0xbc, 0xd4, 0x3b,
-	0x63, 0xbe, 0xca, 0xa6, 0x7b, 0x72, 0xd8, 0xd6, 0x53, 0xf0, 0x75, 0x58, 0x5b, 0xda, 0x86, 0x53,
-	0x4d, 0x36, 0xfa, 0x0d, 0xa1, 0x56, 0x37, 0x15, 0xb4, 0xb7, 0x6a, 0xd9, 0xe7, 0x80, 0x35, 0x25,
-	0x5b, 0xd3, 0xf1, 0xff, 0x60, 0xd8, 0xfb, 0x92, 0xa2, 0x52, 0x84, 0x09, 0x68, 0x38, 0x99, 0xac,
-	0xf1, 0x45, 0x51, 0x77, 0x37, 0x9a, 0xb5, 0x4f, 0x8a, 0x7a, 0x5b, 0x72, 0x16, 0x31, 0x26, 0x08,
-	0xdc, 0x63, 0x12, 0xe1, 0x0a, 0x92, 0xda, 0xcf, 0xfd, 0x64, 0x46, 0xb9, 0x9b, 0xfd, 0x66, 0xb6,
-	0xd0, 0x95, 0x8b, 0x85, 0xae, 0xfc, 0x5a, 0xe8, 0xca, 0xe7, 0xa5, 0x5e, 0xba, 0x58, 0xea, 0xa5,
-	0xef, 0x4b, 0xbd, 0xf4, 0xda, 0xfe, 0x4b, 0x5b, 0xae, 0xa3, 0xe5, 0x93, 0x3e, 0x14, 0x07, 0x6b,
-	0xd2, 0x69, 0x5b, 0x1f, 0x36, 0xae, 0x84, 0xd6, 0xfa, 0x4e, 0x08, 0x84, 0xc7, 0xfc, 0xfe, 0xf5,
-	0xec, 0x8b, 0x3c, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x33, 0xcc, 0xb2, 0x41, 0x04, 0x00,
-	0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0xf2, 0x96, 0xff, 0x7f, 0x11, 0x48, 0x16, 0x30, 0x0b, 0x9a, 0x6d, 0xb3, 0xd1, 0xa4,
+	0x89, 0x61, 0x37, 0x14, 0x3c, 0xa8, 0xf1, 0x52, 0x09, 0x84, 0x84, 0xd8, 0x64, 0x83, 0x17, 0xa2,
+	0xae, 0xd3, 0xdd, 0xa7, 0x65, 0xd2, 0xed, 0x3c, 0x75, 0x66, 0x5a, 0xac, 0x07, 0x6f, 0xde, 0xbd,
+	0xf9, 0x1d, 0xfc, 0x24, 0x1c, 0x39, 0x1a, 0x0f, 0xc5, 0x40, 0xe2, 0x07, 0xe0, 0x13, 0x98, 0x9d,
+	0x9d, 0x2d, 0x14, 0xd1, 0x10, 0x13, 0x3d, 0x78, 0x6a, 0x67, 0xe6, 0xf7, 0x96, 0x67, 0x9e, 0x67,
+	0xc7, 0x5c, 0x47, 0xd1, 0x46, 0x41, 0x85, 0x1f, 0x21, 0x8b, 0x80, 0x49, 0x4e, 0x24, 0xc4, 0x09,
+	0x7d, 0xdd, 0xa5, 0x31, 0x95, 0x7d, 0xbf, 0xb7, 0x5a, 0x07, 0x49, 0x56, 0x7d, 0x49, 0xa3, 0xd6,
+	0x36, 0x6b, 0xa0, 0xd7, 0xe1, 0x28, 0xd1, 0xba, 0xab, 0x59, 0xde, 0x95, 0x2c, 0x4f, 0xb3, 0x96,
+	0x97, 0x22, 0x85, 0x0b, 0x15, 0xc9, 0xcf, 0x16, 0x99, 0xc2, 0xf2, 0x42, 0x13, 0x9b, 0x98, 0xed,
+	0xa7, 0xff, 0xf4, 0xae, 0x93, 0x61, 0xfc, 0x3a, 0x11, 0x30, 0xf4, 0x8e, 0x90, 0xb2, 0xec, 0xdc,
+	0x3d, 0x9e, 0x34, 0xff, 0xdb, 0xd5, 0x51, 0xac, 0x86, 0x39, 0x37, 0xb4, 0x0c, 0x9b, 0x1c, 0x85,
+	0xb0, 0x8d, 0x92, 0x51, 0xfe, 0xbf, 0xfa, 0xf8, 0x70, 0x50, 0x2c, 0x7c, 0x19, 0x14, 0x6f, 0x65,
+	0x6a, 0x22, 0x6e, 0x79, 0x14, 0xfd, 0x36, 0x91, 0xfb, 0xde, 0x0e, 0x34, 0x49, 0xd4, 0xdf, 0x80,
+	0xe8, 0x6c, 0x50, 0xbc, 0xd9, 0x27, 0xed, 0xe4, 0xa1, 0x7b, 0x49, 0xc3, 0x0d, 0x66, 0x87, 0x3b,
+	0x5b, 0xe9, 0x86, 0xf5, 0xca, 0x9c, 0x39, 0xc7, 0x30, 0x90, 0xf6, 0x98, 0x72, 0x79, 0x74, 0x3d,
+	0x97, 0x85, 0xcb, 0x2e, 0x0c, 0xa4, 0x1b, 0xdc, 0x18, 0xae, 0x9f, 0x82, 0xb4, 0x0e, 0x0d, 0xf3,
+	0x81, 0xe8, 0x70, 0x20, 0x71, 0xc8, 0xe1, 0x80, 0xf0, 0x38, 0x8d, 0x72, 0x20, 0xf7, 0x43, 0xec,
+	0x74, 0x50, 0x50, 0x09, 0x61, 0x4c, 0x39, 0x44, 0x92, 0x22, 0x0b, 0xb1, 0x11, 0x26, 0x44, 0xc8,
+	0x50, 0x72, 0xd2, 0x03, 0x2e, 0x48, 0x62, 0x8f, 0x97, 0xc6, 0xcb, 0xd3, 0x95, 0xdb, 0x9e, 0xae,
+	0x6f, 0x5a, 0xbb, 0xfc, 0x06, 0xbc, 0x0d, 0x88, 0x9e, 0x20, 0x65, 0xd5, 0xb5, 0x34, 0xec, 0xa7,
+	0xe3, 0xe2, 0xbd, 0x26, 0x95, 0xfb, 0xdd, 0xba, 0x17, 0x61, 0x5b, 0xdf, 0x87, 0xfe, 0x59, 0x11,
+	0x71, 0xcb, 0x97, 0xfd, 0x0e, 0x88, 0x9c, 0x23, 0x82, 0x4a, 0x96, 0x29, 0x50, 0x91, 0xb6, 0x54,
+	0xa2, 0x9a, 0x0e, 0xb4, 0x91, 0xe7, 0xa9, 0x35, 0x76, 0x88, 0x90, 0xbb, 0x79, 0x18, 0xeb, 0x9d,
+	0x39, 0xd7, 0xed, 0x48, 0xda, 0x86, 0x34, 0x60, 0xd4, 0x02, 0x2e, 0xec, 0x89, 0x92, 0x51, 0x9e,
+	0xae, 0xdc, 0xf7, 0xae, 0xd5, 0x33, 0xde, 0x33, 0xc5, 0xde, 0xd5, 0xe4, 0xaa, 0x93, 0x06, 0x3f,
+	0xbf, 0xac, 0x4b, 0xda, 0x6e, 0x30, 0xdb, 0x1d, 0xc1, 0x5b, 0x2f, 0xcd, 0x25, 0x4e, 0x58, 0x13,
+	0x42, 0xe4, 0x31, 0x70, 0x11, 0xbe, 0x05, 0x8e, 0x61, 0x03, 0x79, 0x88, 0x0c, 0xec, 0xc9, 0x92,
+	0x51, 0x9e, 0xa8, 0xde, 0x39, 0x1b, 0x14, 0x4b, 0x99, 0xdc, 0x4f, 0xa1, 0x6e, 0xb0, 0xa8, 0xce,
+	0x6a, 0xea, 0x68, 0x0f, 0x38, 0x6e, 0x22, 0xaf, 0x31, 0xf8, 0x41, 0x1f, 0x19, 0x28, 0x4e, 0x4a,
+	0xb6, 0xa7, 0x7e, 0xa9, 0x7f, 0x11, 0x3a, 0xaa, 0x5f, 0x63, 0xb0, 0x89, 0x3c, 0x75, 0x71, 0xbf,
+	0x8d, 0x99, 0xcb, 0xdb, 0xaa, 0x3e, 0xb4, 0x07, 0x69, 0xab, 0x07, 0x29, 0xec, 0x1f, 0xec, 0xf9,
+	0x2b, 0x1a, 0x65, 0xfc, 0x2f, 0x36, 0x8a, 0x8b, 0xe6, 0xec, 0xa8, 0x82, 0xf5, 0xc2, 0x9c, 0x48,
+	0xa8, 0x90, 0xb6, 0xa1, 0xe6, 0x69, 0xfd, 0x77, 0x62, 0x54, 0xe7, 0x75, 0x8a, 0xe9, 0xbc, 0x02,
+	0x42, 0xba, 0x81, 0x92, 0x75, 0x3f, 0x1a, 0xe6, 0xcc, 0x08, 0xd8, 0x7a, 0x6f, 0x98, 0x8b, 0x3a,
+	0x67, 0x3e, 0xef, 0x5d, 0x29, 0x68, 0x0c, 0xb6, 0xf1, 0xa7, 0x46, 0x7a, 0x3e, 0xf3, 0xd3, 0xc3,
+	0x9c, 0xb9, 0x55, 0x9f, 0x1f, 0x9e, 0x38, 0xc6, 0xd1, 0x89, 0x63, 0x7c, 0x3d, 0x71, 0x8c, 0x0f,
+	0xa7, 0x4e, 0xe1, 0xe8, 0xd4, 0x29, 0x7c, 0x3e, 0x75, 0x0a, 0x7b, 0xd5, 0x0b, 0xda, 0xba, 0x1c,
+	0x2b, 0x09, 0xa9, 0x8b, 0x7c, 0xe1, 0xf7, 0x2a, 0xab, 0xfe, 0x9b, 0x91, 0xb7, 0x63, 0xe5, 0xfc,
+	0xf1, 0x68, 0x63, 0x0c, 0x49, 0x7d, 0x4a, 0x7d, 0xba, 0xd7, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff,
+	0x3f, 0x4f, 0x98, 0x98, 0x6a, 0x06, 0x00, 0x00,
 }
 
 func (m *TickInfo) Marshal() (dAtA []byte, err error) {
@@ -262,6 +340,16 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RangeOrdersOneForZero != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.RangeOrdersOneForZero))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RangeOrdersZeroForOne != 0 {
+		i = encodeVarintTickInfo(dAtA, i, uint64(m.RangeOrdersZeroForOne))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.UptimeTrackers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -309,6 +397,59 @@ func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IncentiveTickRangeTickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveTickRangeTickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveTickRangeTickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UptimeTrackers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTickInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *UptimeTrackers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -412,6 +553,27 @@ func (m *TickInfo) Size() (n int) {
 	}
 	l = m.UptimeTrackers.Size()
 	n += 1 + l + sovTickInfo(uint64(l))
+	if m.RangeOrdersZeroForOne != 0 {
+		n += 1 + sovTickInfo(uint64(m.RangeOrdersZeroForOne))
+	}
+	if m.RangeOrdersOneForZero != 0 {
+		n += 1 + sovTickInfo(uint64(m.RangeOrdersOneForZero))
+	}
+	return n
+}
+
+func (m *IncentiveTickRangeTickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
+	l = m.UptimeTrackers.Size()
+	n += 1 + l + sovTickInfo(uint64(l))
 	return n
 }
 
@@ -615,6 +777,195 @@ func (m *TickInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrdersZeroForOne", wireType)
+			}
+			m.RangeOrdersZeroForOne = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeOrdersZeroForOne |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrdersOneForZero", wireType)
+			}
+			m.RangeOrdersOneForZero = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeOrdersOneForZero |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTickInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveTickRangeTickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTickInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveTickRangeTickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveTickRangeTickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTickInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTickInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UptimeTrackers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTickInfo(dAtA[iNdEx:])
@@ -237,3 +237,206 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// PlaceRangeOrder creates a single sided position that is withdrawn automatically once a swap fully crosses its range.
+func (server msgServer) PlaceRangeOrder(goCtx context.Context, msg *types.MsgPlaceRangeOrder) (*types.MsgPlaceRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionData, err := server.keeper.PlaceRangeOrder(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick, msg.TokenProvided)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: place range order event is emitted in keeper.PlaceRangeOrder(...)
+
+	return &types.MsgPlaceRangeOrderResponse{PositionId: positionData.ID, LiquidityCreated: positionData.Liquidity, LowerTick: positionData.LowerTick, UpperTick: positionData.UpperTick}, nil
+}
+
+// CancelRangeOrder withdraws the position of an unfilled range order and sends the tokens to the sender.
+func (server msgServer) CancelRangeOrder(goCtx context.Context, msg *types.MsgCancelRangeOrder) (*types.MsgCancelRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.CancelRangeOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: cancel range order event is emitted in keeper.CancelRangeOrder(...)
+
+	return &types.MsgCancelRangeOrderResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
+// ClaimRangeOrder sends the tokens of a filled range order to the sender.
+func (server msgServer) ClaimRangeOrder(goCtx context.Context, msg *types.MsgClaimRangeOrder) (*types.MsgClaimRangeOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.ClaimRangeOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: claim range order event is emitted in keeper.ClaimRangeOrder(...)
+
+	return &types.MsgClaimRangeOrderResponse{TokensOut: tokensOut}, nil
+}
+
+// SetPositionAutoCompound enables or disables the auto-compounding of the rewards of a position.
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetPositionAutoCompound(ctx, sender, msg.PositionId, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: set position auto compound event is emitted in keeper.SetPositionAutoCompound(...)
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
+
+// RebalancePosition moves the liquidity of a position to a new tick range.
+func (server msgServer) RebalancePosition(goCtx context.Context, msg *types.MsgRebalancePosition) (*types.MsgRebalancePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.TokenOutMinAmount.IsNil() {
+		msg.TokenOutMinAmount = osmomath.ZeroInt()
+	}
+	if msg.TokenMinAmount0.IsNil() {
+		msg.TokenMinAmount0 = osmomath.ZeroInt()
+	}
+	if msg.TokenMinAmount1.IsNil() {
+		msg.TokenMinAmount1 = osmomath.ZeroInt()
+	}
+
+	positionData, err := server.keeper.RebalancePosition(ctx, sender, msg.PositionId, msg.LowerTick, msg.UpperTick, msg.Swap, msg.TokenOutMinAmount, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: rebalance position event is emitted in keeper.RebalancePosition(...)
+
+	return &types.MsgRebalancePositionResponse{
+		PositionId:       positionData.ID,
+		Amount0:          positionData.Amount0,
+		Amount1:          positionData.Amount1,
+		LiquidityCreated: positionData.Liquidity,
+		LowerTick:        positionData.LowerTick,
+		UpperTick:        positionData.UpperTick,
+	}, nil
+}
+
+// TokenizePosition transfers a position to the position NFT contract, which mints an NFT representing it.
+func (server msgServer) TokenizePosition(goCtx context.Context, msg *types.MsgTokenizePosition) (*types.MsgTokenizePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.TokenizePosition(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: tokenize position event is emitted in keeper.TokenizePosition(...)
+
+	return &types.MsgTokenizePositionResponse{}, nil
+}
+
+// RedeemPositionNFT burns the NFT representing a tokenized position and transfers the position to the holder of the NFT.
+func (server msgServer) RedeemPositionNFT(goCtx context.Context, msg *types.MsgRedeemPositionNFT) (*types.MsgRedeemPositionNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.RedeemPositionNFT(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: redeem position NFT event is emitted in keeper.RedeemPositionNFT(...)
+
+	return &types.MsgRedeemPositionNFTResponse{}, nil
+}
@@ -55,6 +55,8 @@ func (k Keeper) initOrUpdatePosition(
 	joinTime time.Time,
 	positionId uint64,
 ) (err error) {
+	isNewPosition := !k.hasPosition(ctx, positionId)
+
 	liquidity, err := k.getOrInitPosition(ctx, positionId)
 	if err != nil {
 		return err
@@ -72,10 +74,20 @@ func (k Keeper) initOrUpdatePosition(
 		return err
 	}
 
+	err = k.initOrUpdatePositionIncentiveTickRanges(ctx, poolId, liquidity, lowerTick, upperTick, liquidityDelta, positionId)
+	if err != nil {
+		return err
+	}
+
 	err = k.SetPosition(ctx, poolId, owner, lowerTick, upperTick, joinTime, liquidity, positionId, noUnderlyingLockId)
 	if err != nil {
 		return err
 	}
+
+	// Record the height at which the position was created to enforce the minimum age for claiming spread rewards.
+	if isNewPosition {
+		k.setPositionJoinHeight(ctx, positionId, ctx.BlockHeight())
+	}
 	return nil
 }
 
@@ -100,6 +112,22 @@ func (k Keeper) HasAnyPositionForPool(ctx sdk.Context, poolId uint64) (bool, err
 	return osmoutils.HasAnyAtPrefix(store, poolPositionKey, parse)
 }
 
+// getPositionIdsForPool returns the ids of all of the positions of the given pool, in ascending order.
+func (k Keeper) getPositionIdsForPool(ctx sdk.Context, poolId uint64) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPoolPosition(poolId))
+	defer iterator.Close()
+
+	positionIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		// The position id is big endian encoded at the end of the key
+		key := iterator.Key()
+		positionIds = append(positionIds, sdk.BigEndianToUint64(key[len(key)-uint64Bytes:]))
+	}
+
+	return positionIds
+}
+
 // GetAllPositionsForPoolId gets all the position for a specific poolId and store prefix.
 func (k Keeper) GetAllPositionIdsForPoolId(ctx sdk.Context, prefix []byte, poolId uint64) ([]uint64, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -389,6 +417,12 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the auto-compounding flag of the position (if it exists).
+	k.deletePositionAutoCompound(ctx, positionId)
+
+	// Remove the NFT record of the position (if it exists).
+	k.deletePositionNFTRecord(ctx, positionId)
+
 	return nil
 }
 
@@ -0,0 +1,295 @@
+package concentrated_liquidity
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// TokenizePosition transfers the given position from its owner to the position NFT contract and
+// instructs the contract to mint an NFT representing the position to the owner.
+// While tokenized, the position is stored under the contract but owned by the holder of the NFT, so transferring
+// the NFT, including over IBC, transfers the position. The holder can withdraw from, add to, rebalance and collect
+// the rewards of the position without redeeming it, or redeem it with RedeemPositionNFT.
+// Outstanding spread rewards and incentives are collected for the owner before the transfer.
+// Since the position keeps its ID and join time, its uptime and spread reward accumulator
+// records are left intact.
+// Returns error if:
+// - the position NFT contract is not set
+// - the sender is not the owner of the position
+// - the position has an active underlying lock
+// - the position is the last position in its pool
+// - the contract fails to mint the NFT
+func (k Keeper) TokenizePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) error {
+	contract := k.GetParams(ctx).PositionNftContract
+	if contract == "" {
+		return types.PositionNFTContractNotSetError{}
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.transferPositions(ctx, []uint64{positionId}, owner, contractAddress); err != nil {
+		return err
+	}
+	k.setPositionNFTRecord(ctx, types.PositionNFTRecord{PositionId: positionId, ContractAddress: contract})
+
+	msg := types.MintPositionNFTMsg{
+		TokenId:   types.PositionNFTTokenId(positionId),
+		Owner:     owner,
+		PoolId:    position.PoolId,
+		LowerTick: position.LowerTick,
+		UpperTick: position.UpperTick,
+		Liquidity: position.Liquidity,
+	}
+	msgBz, err := json.Marshal(types.MintPositionNFTSudoMsg{MintPositionNFT: msg})
+	if err != nil {
+		return err
+	}
+	if err := k.sudoPositionNFTContract(ctx, contractAddress, msgBz); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTokenizePosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, contract),
+		),
+	})
+
+	return nil
+}
+
+// RedeemPositionNFT instructs the contract that minted the NFT representing the given position
+// to burn it, and transfers the position from the contract to the sender.
+// The sender must be the holder of the NFT on Osmosis as returned by GetPositionNFTHolder.
+// NFTs transferred over IBC through ICS-721 are escrowed by the ICS-721 contract on Osmosis,
+// so they have to be transferred back to Osmosis before the position can be redeemed or otherwise used.
+// Spread rewards and incentives accrued while the position was tokenized are left in the position,
+// so that the sender can collect them.
+// Returns error if:
+// - the position is not tokenized
+// - the position has an active underlying lock
+// - the sender does not hold the NFT
+// - the contract fails to burn the NFT
+func (k Keeper) RedeemPositionNFT(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) error {
+	record, found, err := k.GetPositionNFTRecord(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.PositionNotTokenizedError{PositionId: positionId}
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(record.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	// If the position has an active underlying lock, we cannot transfer it.
+	positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	holder, err := k.GetPositionNFTHolder(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if !holder.Equals(sender) {
+		return types.PositionNFTNotHeldError{PositionId: positionId, Address: sender.String(), Holder: holder.String()}
+	}
+
+	if err := k.burnPositionNFT(ctx, contractAddress, positionId, sender); err != nil {
+		return err
+	}
+
+	// Unlike transferPositions, the rewards are not collected for the contract before the transfer.
+	// Deleting the position also removes its NFT record.
+	if err := k.deletePosition(ctx, positionId, contractAddress, position.PoolId); err != nil {
+		return err
+	}
+	if err := k.SetPosition(ctx, position.PoolId, sender, position.LowerTick, position.UpperTick, position.JoinTime, position.Liquidity, positionId, 0); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRedeemPositionNFT,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, record.ContractAddress),
+		),
+	})
+
+	return nil
+}
+
+// GetPositionNFTHolder returns the holder of the NFT representing the given position, as returned
+// by the CW721 owner_of query of the contract that minted it. This is the owner of the position,
+// while the position itself is stored under the contract.
+// Returns error if:
+// - the position is not tokenized
+// - the contract fails the query or returns an invalid holder
+func (k Keeper) GetPositionNFTHolder(ctx sdk.Context, positionId uint64) (sdk.AccAddress, error) {
+	record, found, err := k.GetPositionNFTRecord(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.PositionNotTokenizedError{PositionId: positionId}
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(record.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	queryBz, err := json.Marshal(types.PositionNFTOwnerOfQueryMsg{OwnerOf: types.PositionNFTOwnerOfQuery{TokenId: types.PositionNFTTokenId(positionId)}})
+	if err != nil {
+		return nil, err
+	}
+
+	var responseBz []byte
+	err = k.callPositionNFTContract(ctx, func(ctx sdk.Context) (err error) {
+		responseBz, err = k.wasmKeeper.QuerySmart(ctx, contractAddress, queryBz)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := types.PositionNFTOwnerOfResponse{}
+	if err := json.Unmarshal(responseBz, &response); err != nil {
+		return nil, err
+	}
+	return sdk.AccAddressFromBech32(response.Owner)
+}
+
+// getPositionOwner returns the owner of the given position and true if the position is tokenized.
+// The owner of a tokenized position is the holder of its NFT as returned by GetPositionNFTHolder,
+// so that transferring the NFT transfers the position. Otherwise, it is the address of the position.
+func (k Keeper) getPositionOwner(ctx sdk.Context, position model.Position) (sdk.AccAddress, bool, error) {
+	_, isTokenized, err := k.GetPositionNFTRecord(ctx, position.PositionId)
+	if err != nil {
+		return nil, false, err
+	}
+	if isTokenized {
+		holder, err := k.GetPositionNFTHolder(ctx, position.PositionId)
+		return holder, true, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	return owner, false, err
+}
+
+// validatePositionOwner returns true if the given position is tokenized.
+// Returns error if the given sender is not the owner of the position as returned by getPositionOwner.
+func (k Keeper) validatePositionOwner(ctx sdk.Context, position model.Position, sender sdk.AccAddress) (bool, error) {
+	owner, isTokenized, err := k.getPositionOwner(ctx, position)
+	if err != nil {
+		return false, err
+	}
+	if owner.Equals(sender) {
+		return isTokenized, nil
+	}
+	if isTokenized {
+		return true, types.PositionNFTNotHeldError{PositionId: position.PositionId, Address: sender.String(), Holder: owner.String()}
+	}
+	return false, types.NotPositionOwnerError{PositionId: position.PositionId, Address: sender.String()}
+}
+
+// burnPositionNFT instructs the given position NFT contract to burn the NFT representing the given position,
+// which must be held by the given holder.
+func (k Keeper) burnPositionNFT(ctx sdk.Context, contractAddress sdk.AccAddress, positionId uint64, holder sdk.AccAddress) error {
+	msg := types.BurnPositionNFTMsg{
+		TokenId: types.PositionNFTTokenId(positionId),
+		Owner:   holder,
+	}
+	msgBz, err := json.Marshal(types.BurnPositionNFTSudoMsg{BurnPositionNFT: msg})
+	if err != nil {
+		return err
+	}
+	return k.sudoPositionNFTContract(ctx, contractAddress, msgBz)
+}
+
+// sudoPositionNFTContract sends the given sudo message to the given position NFT contract.
+func (k Keeper) sudoPositionNFTContract(ctx sdk.Context, contractAddress sdk.AccAddress, msgBz []byte) error {
+	return k.callPositionNFTContract(ctx, func(ctx sdk.Context) error {
+		_, err := k.contractKeeper.Sudo(ctx, contractAddress, msgBz)
+		return err
+	})
+}
+
+// callPositionNFTContract executes the given call to the position NFT contract with its gas limited to the
+// hook gas limit, so that the contract cannot consume an unbounded amount of gas from the message that
+// triggered the call. The gas used by the call is then consumed from the given context.
+func (k Keeper) callPositionNFTContract(ctx sdk.Context, call func(ctx sdk.Context) error) (err error) {
+	gasLimit := k.GetParams(ctx).HookGasLimit
+	childCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(gasLimit, "Track CL position NFT contract call gas")
+			err = types.PositionNFTContractOutOfGasError{GasLimit: gasLimit}
+		}
+	}()
+
+	err = call(childCtx)
+	ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumed(), "Track CL position NFT contract call gas")
+	return err
+}
+
+// GetPositionNFTRecord returns the NFT record of the given position and true if the position is tokenized.
+// Returns false otherwise.
+func (k Keeper) GetPositionNFTRecord(ctx sdk.Context, positionId uint64) (types.PositionNFTRecord, bool, error) {
+	record := types.PositionNFTRecord{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionNFTRecord(positionId), &record)
+	if err != nil {
+		return types.PositionNFTRecord{}, false, err
+	}
+	return record, found, nil
+}
+
+// GetAllPositionNFTRecords returns the NFT records of all tokenized positions.
+func (k Keeper) GetAllPositionNFTRecords(ctx sdk.Context) ([]types.PositionNFTRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionNFTRecordPrefix, func(bz []byte) (types.PositionNFTRecord, error) {
+		record := types.PositionNFTRecord{}
+		if err := k.cdc.Unmarshal(bz, &record); err != nil {
+			return types.PositionNFTRecord{}, err
+		}
+		return record, nil
+	})
+}
+
+// setPositionNFTRecord stores the given position NFT record.
+func (k Keeper) setPositionNFTRecord(ctx sdk.Context, record types.PositionNFTRecord) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionNFTRecord(record.PositionId), &record)
+}
+
+// deletePositionNFTRecord removes the NFT record of the given position, if any.
+func (k Keeper) deletePositionNFTRecord(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionNFTRecord(positionId))
+}
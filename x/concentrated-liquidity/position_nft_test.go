@@ -0,0 +1,297 @@
+package concentrated_liquidity_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// mockPositionNFTContract is a contract and wasm keeper that mimics a position NFT contract
+// by tracking the holders of the NFTs it mints. Each sudo call consumes the given amount of gas.
+type mockPositionNFTContract struct {
+	holders map[string]string
+	sudoGas uint64
+}
+
+func (c *mockPositionNFTContract) QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	query := types.PositionNFTOwnerOfQueryMsg{}
+	if err := json.Unmarshal(queryMsg, &query); err != nil {
+		return nil, err
+	}
+
+	holder, ok := c.holders[query.OwnerOf.TokenId]
+	if !ok {
+		return nil, fmt.Errorf("token (%s) not found", query.OwnerOf.TokenId)
+	}
+	return json.Marshal(types.PositionNFTOwnerOfResponse{Owner: holder})
+}
+
+func (c *mockPositionNFTContract) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(c.sudoGas, "mock position NFT contract")
+
+	sudoMsg := struct {
+		MintPositionNFT *types.MintPositionNFTMsg `json:"mint_position_nft"`
+		BurnPositionNFT *types.BurnPositionNFTMsg `json:"burn_position_nft"`
+	}{}
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case sudoMsg.MintPositionNFT != nil:
+		c.holders[sudoMsg.MintPositionNFT.TokenId] = sudoMsg.MintPositionNFT.Owner.String()
+	case sudoMsg.BurnPositionNFT != nil:
+		tokenId := sudoMsg.BurnPositionNFT.TokenId
+		if c.holders[tokenId] != sudoMsg.BurnPositionNFT.Owner.String() {
+			return nil, fmt.Errorf("token (%s) is not held by (%s)", tokenId, sudoMsg.BurnPositionNFT.Owner)
+		}
+		delete(c.holders, tokenId)
+	default:
+		return nil, fmt.Errorf("unknown sudo message")
+	}
+	return nil, nil
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemPosition() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+	s.SetupDefaultPosition(pool.GetId())
+	owner, holder := s.TestAccs[1], s.TestAccs[2]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+	swap := func() {
+		s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+		_, _, _, err := clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+		s.Require().NoError(err)
+	}
+
+	// Positions cannot be tokenized until the contract is set.
+	err := clKeeper.TokenizePosition(s.Ctx, owner, positionId)
+	s.Require().ErrorIs(err, types.PositionNFTContractNotSetError{})
+
+	contract := s.TestAccs[4]
+	params := clKeeper.GetParams(s.Ctx)
+	params.PositionNftContract = contract.String()
+	clKeeper.SetParams(s.Ctx, params)
+	nftContract := &mockPositionNFTContract{holders: map[string]string{}}
+	clKeeper.SetContractKeeper(nftContract)
+	clKeeper.SetWasmKeeper(nftContract)
+
+	// Only the owner can tokenize the position.
+	err = clKeeper.TokenizePosition(s.Ctx, holder, positionId)
+	s.Require().Error(err)
+
+	swap()
+	positionBefore, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+	s.Require().NoError(clKeeper.TokenizePosition(s.Ctx, owner, positionId))
+
+	// The position is owned by the contract, which minted the NFT to the owner.
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(contract.String(), position.Address)
+	s.Require().Equal(positionBefore.JoinTime, position.JoinTime)
+	s.Require().Equal(positionBefore.Liquidity, position.Liquidity)
+	s.Require().Equal(owner.String(), nftContract.holders[types.PositionNFTTokenId(positionId)])
+
+	record, found, err := clKeeper.GetPositionNFTRecord(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.PositionNFTRecord{PositionId: positionId, ContractAddress: contract.String()}, record)
+
+	// The rewards accrued before tokenizing are collected for the owner.
+	s.Require().False(s.App.BankKeeper.GetAllBalances(s.Ctx, owner).IsEqual(ownerBalanceBefore))
+	claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(claimable.IsZero())
+
+	// The tokenized position keeps accruing rewards.
+	swap()
+	claimable, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(claimable.IsZero())
+
+	// The NFT is transferred to the holder, after which the owner can no longer redeem the position.
+	nftContract.holders[types.PositionNFTTokenId(positionId)] = holder.String()
+	nftHolder, err := clKeeper.GetPositionNFTHolder(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(holder, nftHolder)
+	err = clKeeper.RedeemPositionNFT(s.Ctx, owner, positionId)
+	s.Require().ErrorIs(err, types.PositionNFTNotHeldError{PositionId: positionId, Address: owner.String(), Holder: holder.String()})
+
+	// While the NFT is escrowed by the ICS-721 contract, the position cannot be redeemed.
+	ics721Escrow := s.TestAccs[3]
+	nftContract.holders[types.PositionNFTTokenId(positionId)] = ics721Escrow.String()
+	err = clKeeper.RedeemPositionNFT(s.Ctx, holder, positionId)
+	s.Require().ErrorIs(err, types.PositionNFTNotHeldError{PositionId: positionId, Address: holder.String(), Holder: ics721Escrow.String()})
+	nftContract.holders[types.PositionNFTTokenId(positionId)] = holder.String()
+
+	s.Require().NoError(clKeeper.RedeemPositionNFT(s.Ctx, holder, positionId))
+
+	// The holder owns the position with the rewards accrued while it was tokenized.
+	position, err = clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(holder.String(), position.Address)
+	s.Require().Equal(positionBefore.JoinTime, position.JoinTime)
+	s.Require().NotContains(nftContract.holders, types.PositionNFTTokenId(positionId))
+
+	_, found, err = clKeeper.GetPositionNFTRecord(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	collected, err := clKeeper.CollectSpreadRewards(s.Ctx, holder, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(claimable, collected)
+
+	// Positions that are not tokenized cannot be redeemed.
+	err = clKeeper.RedeemPositionNFT(s.Ctx, holder, positionId)
+	s.Require().ErrorIs(err, types.PositionNotTokenizedError{PositionId: positionId})
+}
+
+// TestTokenizedPositionFollowsNFTHolder tests that the holder of the NFT representing a tokenized position
+// owns the position, so that the new holder of a transferred NFT can use the position without redeeming it.
+func (s *KeeperTestSuite) TestTokenizedPositionFollowsNFTHolder() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+	s.SetupDefaultPosition(pool.GetId())
+	owner, holder := s.TestAccs[1], s.TestAccs[2]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	contract := s.TestAccs[4]
+	params := clKeeper.GetParams(s.Ctx)
+	params.PositionNftContract = contract.String()
+	clKeeper.SetParams(s.Ctx, params)
+	nftContract := &mockPositionNFTContract{holders: map[string]string{}}
+	clKeeper.SetContractKeeper(nftContract)
+	clKeeper.SetWasmKeeper(nftContract)
+	s.Require().NoError(clKeeper.TokenizePosition(s.Ctx, owner, positionId))
+
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+	s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+	_, _, _, err := clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+
+	// The NFT is transferred to the holder, after which the previous owner can no longer use the position.
+	nftContract.holders[types.PositionNFTTokenId(positionId)] = holder.String()
+	notHeldErr := types.PositionNFTNotHeldError{PositionId: positionId, Address: owner.String(), Holder: holder.String()}
+	_, err = clKeeper.CollectSpreadRewards(s.Ctx, owner, positionId)
+	s.Require().ErrorIs(err, notHeldErr)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, osmomath.OneDec())
+	s.Require().ErrorIs(err, notHeldErr)
+
+	// Neither can the contract the position is stored under.
+	_, err = clKeeper.CollectSpreadRewards(s.Ctx, contract, positionId)
+	s.Require().ErrorIs(err, types.PositionNFTNotHeldError{PositionId: positionId, Address: contract.String(), Holder: holder.String()})
+
+	// The holder collects the rewards of the position.
+	claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(claimable.IsZero())
+	collected, err := clKeeper.CollectSpreadRewards(s.Ctx, holder, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(claimable, collected)
+
+	// The holder withdraws part of the position, which stays tokenized.
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	halfLiquidity := position.Liquidity.QuoInt64(2)
+	holderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, holder)
+	amount0, amount1, err := clKeeper.WithdrawPosition(s.Ctx, holder, positionId, halfLiquidity)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		holderBalanceBefore.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)),
+		s.App.BankKeeper.GetAllBalances(s.Ctx, holder),
+	)
+
+	position, err = clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(contract.String(), position.Address)
+	s.Require().Equal(halfLiquidity, position.Liquidity)
+	_, found, err := clKeeper.GetPositionNFTRecord(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(holder.String(), nftContract.holders[types.PositionNFTTokenId(positionId)])
+
+	// Adding to the position replaces its NFT with one for the new position.
+	s.FundAcc(holder, DefaultCoins)
+	newPositionId, _, _, err := clKeeper.AddToPosition(s.Ctx, holder, positionId, DefaultAmt0, DefaultAmt1, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().NotContains(nftContract.holders, types.PositionNFTTokenId(positionId))
+	s.Require().Equal(holder.String(), nftContract.holders[types.PositionNFTTokenId(newPositionId)])
+	position, err = clKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(contract.String(), position.Address)
+
+	// Withdrawing the full position burns its NFT.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, holder, newPositionId, position.Liquidity)
+	s.Require().NoError(err)
+	s.Require().NotContains(nftContract.holders, types.PositionNFTTokenId(newPositionId))
+	_, err = clKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().Error(err)
+	_, found, err = clKeeper.GetPositionNFTRecord(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestPositionNFTRecordsGenesis() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	params := clKeeper.GetParams(s.Ctx)
+	params.PositionNftContract = s.TestAccs[4].String()
+	clKeeper.SetParams(s.Ctx, params)
+	clKeeper.SetContractKeeper(&mockPositionNFTContract{holders: map[string]string{}})
+	s.Require().NoError(clKeeper.TokenizePosition(s.Ctx, s.TestAccs[1], positionId))
+
+	exported := clKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.PositionNFTRecord{{PositionId: positionId, ContractAddress: s.TestAccs[4].String()}}, exported.PositionNftRecords)
+
+	s.SetupTest()
+	clKeeper = s.App.ConcentratedLiquidityKeeper
+	clKeeper.InitGenesis(s.Ctx, *exported)
+
+	records, err := clKeeper.GetAllPositionNFTRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported.PositionNftRecords, records)
+}
+
+func (s *KeeperTestSuite) TestPositionNFTContractGasLimit() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	params := clKeeper.GetParams(s.Ctx)
+	params.PositionNftContract = s.TestAccs[4].String()
+	clKeeper.SetParams(s.Ctx, params)
+	nftContract := &mockPositionNFTContract{holders: map[string]string{}, sudoGas: params.HookGasLimit + 1}
+	clKeeper.SetContractKeeper(nftContract)
+	clKeeper.SetWasmKeeper(nftContract)
+
+	// A contract call exceeding the gas limit fails the tokenization and its gas limit is charged.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	gasConsumedBefore := cacheCtx.GasMeter().GasConsumed()
+	err := clKeeper.TokenizePosition(cacheCtx, owner, positionId)
+	s.Require().ErrorIs(err, types.PositionNFTContractOutOfGasError{GasLimit: params.HookGasLimit})
+	s.Require().GreaterOrEqual(cacheCtx.GasMeter().GasConsumed()-gasConsumedBefore, params.HookGasLimit)
+
+	// Contract calls within the gas limit succeed and their gas is charged.
+	nftContract.sudoGas = params.HookGasLimit / 2
+	gasConsumedBefore = s.Ctx.GasMeter().GasConsumed()
+	s.Require().NoError(clKeeper.TokenizePosition(s.Ctx, owner, positionId))
+	s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasConsumedBefore, nftContract.sudoGas)
+}
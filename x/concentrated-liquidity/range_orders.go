@@ -0,0 +1,444 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// GetRangeOrderEscrowAddress returns the address that owns the positions backing the range orders of the given pool.
+// It also holds the tokens of the filled range orders of the pool until they are claimed.
+func GetRangeOrderEscrowAddress(poolId uint64) sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(types.ModuleName, types.RangeOrderEscrowPrefix, sdk.Uint64ToBigEndian(poolId))
+}
+
+// PlaceRangeOrder creates a single sided position from the given token in the given tick range and records it as a range order.
+// The position is owned by the range order escrow address of the pool so that it can only be withdrawn through the range order.
+// Orders providing token0 must have a range entirely above the current tick and are filled once a swap crosses the upper tick.
+// Orders providing token1 must have a range at or below the current tick and are filled once a swap crosses the lower tick.
+// Any amount of the provided token that is not used by the position is refunded to the owner.
+// Returns error if:
+// - the pool does not exist
+// - the provided token is not one of the pool's tokens
+// - the range is not single sided at the current tick
+// - creating the position fails
+// - the order is worth less than the minimum notional in the pool's token1
+// - the fill tick already has the maximum number of unfilled orders in the order's direction
+func (k Keeper) PlaceRangeOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, tokenProvided sdk.Coin) (CreatePositionData, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if tokenProvided.Denom != pool.GetToken0() && tokenProvided.Denom != pool.GetToken1() {
+		return CreatePositionData{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenProvided.Denom}
+	}
+
+	// A position is active when the current tick is in [lowerTick, upperTick).
+	// Hence, it only holds token0 if the current tick is below the lower tick
+	// and only holds token1 if the current tick is at or above the upper tick.
+	currentTick := pool.GetCurrentTick()
+	isToken0 := tokenProvided.Denom == pool.GetToken0()
+	if (isToken0 && currentTick >= lowerTick) || (!isToken0 && currentTick < upperTick) {
+		return CreatePositionData{}, types.InvalidRangeOrderTicksError{DenomIn: tokenProvided.Denom, LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick}
+	}
+
+	escrowAddress := GetRangeOrderEscrowAddress(poolId)
+	if err := k.bankKeeper.SendCoins(ctx, owner, escrowAddress, sdk.NewCoins(tokenProvided)); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, escrowAddress, sdk.NewCoins(tokenProvided), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The canonical ticks of the position are used since the provided ticks might be rounded.
+	amountUsed, fillTick := positionData.Amount1, positionData.LowerTick
+	if isToken0 {
+		amountUsed, fillTick = positionData.Amount0, positionData.UpperTick
+	}
+
+	if refund := tokenProvided.Amount.Sub(amountUsed); refund.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, owner, sdk.NewCoins(sdk.NewCoin(tokenProvided.Denom, refund))); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	// The notional of an order providing token0 is the token1 it is filled for,
+	// i.e. the token1 held by the position once the price is above its range.
+	notional := amountUsed
+	if isToken0 {
+		notional, err = rangeOrderFilledAmount1(positionData)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+	minNotional := k.GetParams(ctx).RangeOrderMinNotional.AmountOf(pool.GetToken1())
+	if notional.LT(minNotional) {
+		return CreatePositionData{}, types.RangeOrderBelowMinNotionalError{
+			Notional:    sdk.NewCoin(pool.GetToken1(), notional),
+			MinNotional: sdk.NewCoin(pool.GetToken1(), minNotional),
+		}
+	}
+
+	rangeOrder := types.RangeOrder{
+		PositionId: positionData.ID,
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		DenomIn:    tokenProvided.Denom,
+		FillTick:   fillTick,
+	}
+	k.setRangeOrder(ctx, rangeOrder)
+	k.setRangeOrderTickIndex(ctx, rangeOrder, !isToken0)
+	if err := k.incrementRangeOrderTickCount(ctx, poolId, fillTick, !isToken0); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPlaceRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionData.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenomIn, tokenProvided.Denom),
+			sdk.NewAttribute(types.AttributeKeyFillTick, strconv.FormatInt(fillTick, 10)),
+		),
+	})
+
+	return positionData, nil
+}
+
+// CancelRangeOrder withdraws the position backing an unfilled range order and sends the withdrawn tokens,
+// together with the collected spread rewards and incentives, to the owner.
+// Returns the amounts of token0 and token1 withdrawn from the position.
+// Returns error if:
+// - the range order does not exist
+// - the sender is not the owner of the range order
+// - the range order is already filled
+// - withdrawing the position fails
+func (k Keeper) CancelRangeOrder(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (osmomath.Int, osmomath.Int, error) {
+	rangeOrder, err := k.GetRangeOrder(ctx, positionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if rangeOrder.Owner != sender.String() {
+		return osmomath.Int{}, osmomath.Int{}, types.NotRangeOrderOwnerError{PositionId: positionId, Address: sender.String()}
+	}
+
+	if rangeOrder.Filled {
+		return osmomath.Int{}, osmomath.Int{}, types.RangeOrderFilledError{PositionId: positionId}
+	}
+
+	pool, err := k.getPoolById(ctx, rangeOrder.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	zeroForOne := rangeOrder.DenomIn == pool.GetToken1()
+	// N.B. the count is updated prior to withdrawing since the fill tick may be removed
+	// once the position is withdrawn.
+	if err := k.decrementRangeOrderTickCount(ctx, rangeOrder.PoolId, rangeOrder.FillTick, zeroForOne); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	amount0, amount1, rewards, err := k.withdrawRangeOrderPosition(ctx, rangeOrder)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	tokensOut := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1)).Add(rewards...)
+	if !tokensOut.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, GetRangeOrderEscrowAddress(rangeOrder.PoolId), sender, tokensOut); err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
+	k.deleteRangeOrderTickIndex(ctx, rangeOrder, zeroForOne)
+	k.deleteRangeOrder(ctx, positionId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(rangeOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+		),
+	})
+
+	return amount0, amount1, nil
+}
+
+// ClaimRangeOrder sends the tokens of a filled range order from the range order escrow address of the pool
+// to the owner and removes the range order from state.
+// Returns error if:
+// - the range order does not exist
+// - the sender is not the owner of the range order
+// - the range order is not filled yet
+func (k Keeper) ClaimRangeOrder(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	rangeOrder, err := k.GetRangeOrder(ctx, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if rangeOrder.Owner != sender.String() {
+		return sdk.Coins{}, types.NotRangeOrderOwnerError{PositionId: positionId, Address: sender.String()}
+	}
+
+	if !rangeOrder.Filled {
+		return sdk.Coins{}, types.RangeOrderNotFilledError{PositionId: positionId}
+	}
+
+	if !rangeOrder.TokensOut.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, GetRangeOrderEscrowAddress(rangeOrder.PoolId), sender, rangeOrder.TokensOut); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
+	k.deleteRangeOrder(ctx, positionId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtClaimRangeOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(rangeOrder.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, rangeOrder.TokensOut.String()),
+		),
+	})
+
+	return rangeOrder.TokensOut, nil
+}
+
+// settleRangeOrders withdraws the positions backing the range orders of the pool that are filled by a swap
+// in the given direction crossing the given ticks. The withdrawn tokens, together with the collected spread rewards
+// and incentives, are kept in the range order escrow address of the pool until the owner claims them.
+//
+// CONTRACT: the swap crossing the ticks is applied to the pool so that the positions are out of range
+// and hold only the token that the orders are bought with.
+func (k Keeper) settleRangeOrders(ctx sdk.Context, poolId uint64, zeroForOne bool, ticksCrossed []int64) error {
+	if len(ticksCrossed) == 0 {
+		return nil
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, tick := range ticksCrossed {
+		positionIds, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyRangeOrdersByTick(poolId, zeroForOne, tick), parsePositionIdFromBz)
+		if err != nil {
+			return err
+		}
+
+		// All orders at the tick are settled. The count is reset prior to withdrawing
+		// since the tick may be removed once the positions are withdrawn.
+		tickInfo, err := k.GetTickInfo(ctx, poolId, tick)
+		if err != nil {
+			return err
+		}
+		setRangeOrderCount(&tickInfo, zeroForOne, 0)
+		k.SetTickInfo(ctx, poolId, tick, &tickInfo)
+
+		for _, positionId := range positionIds {
+			rangeOrder, err := k.GetRangeOrder(ctx, positionId)
+			if err != nil {
+				return err
+			}
+
+			amount0, amount1, rewards, err := k.withdrawRangeOrderPosition(ctx, rangeOrder)
+			if err != nil {
+				return err
+			}
+
+			k.deleteRangeOrderTickIndex(ctx, rangeOrder, zeroForOne)
+
+			rangeOrder.Filled = true
+			rangeOrder.TokensOut = sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1)).Add(rewards...)
+			k.setRangeOrder(ctx, rangeOrder)
+
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtFillRangeOrder,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyFillTick, strconv.FormatInt(tick, 10)),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, rangeOrder.TokensOut.String()),
+				),
+			})
+		}
+	}
+
+	return nil
+}
+
+// withdrawRangeOrderPosition collects the spread rewards and incentives of the position backing the range order
+// and withdraws its full liquidity into the range order escrow address of the pool.
+// Returns the amounts of token0 and token1 withdrawn and the collected rewards.
+func (k Keeper) withdrawRangeOrderPosition(ctx sdk.Context, rangeOrder types.RangeOrder) (osmomath.Int, osmomath.Int, sdk.Coins, error) {
+	escrowAddress := GetRangeOrderEscrowAddress(rangeOrder.PoolId)
+
+	position, err := k.GetPosition(ctx, rangeOrder.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coins{}, err
+	}
+
+	// Rewards are collected prior to withdrawing so that the collected amounts are known.
+	// Withdrawing the full position collects them otherwise.
+	spreadRewards, err := k.collectSpreadRewards(ctx, escrowAddress, rangeOrder.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coins{}, err
+	}
+
+	incentives, _, err := k.collectIncentives(ctx, escrowAddress, rangeOrder.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coins{}, err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, escrowAddress, rangeOrder.PositionId, position.Liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, sdk.Coins{}, err
+	}
+
+	return amount0, amount1, spreadRewards.Add(incentives...), nil
+}
+
+// rangeOrderFilledAmount1 returns the amount of token1 that the position backing an order providing token0
+// holds once the price is above its range, rounded down.
+func rangeOrderFilledAmount1(positionData CreatePositionData) (osmomath.Int, error) {
+	sqrtPriceLower, err := math.TickToSqrtPrice(positionData.LowerTick)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	sqrtPriceUpper, err := math.TickToSqrtPrice(positionData.UpperTick)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return math.CalcAmount1Delta(osmomath.BigDecFromDec(positionData.Liquidity), sqrtPriceLower, sqrtPriceUpper, false).Dec().TruncateInt(), nil
+}
+
+// rangeOrderCount returns the number of unfilled range orders that are filled
+// by a swap in the given direction crossing the tick.
+func rangeOrderCount(tickInfo *model.TickInfo, zeroForOne bool) uint64 {
+	if zeroForOne {
+		return tickInfo.RangeOrdersZeroForOne
+	}
+	return tickInfo.RangeOrdersOneForZero
+}
+
+// setRangeOrderCount sets the number of unfilled range orders that are filled
+// by a swap in the given direction crossing the tick.
+func setRangeOrderCount(tickInfo *model.TickInfo, zeroForOne bool, count uint64) {
+	if zeroForOne {
+		tickInfo.RangeOrdersZeroForOne = count
+	} else {
+		tickInfo.RangeOrdersOneForZero = count
+	}
+}
+
+// incrementRangeOrderTickCount increments the number of unfilled range orders that are filled by a swap
+// in the given direction crossing the given tick.
+// Returns error if the count exceeds the maximum number of range orders per tick.
+func (k Keeper) incrementRangeOrderTickCount(ctx sdk.Context, poolId uint64, tickIndex int64, zeroForOne bool) error {
+	tickInfo, err := k.GetTickInfo(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	count := rangeOrderCount(&tickInfo, zeroForOne) + 1
+	if maxCount := k.GetParams(ctx).MaxRangeOrdersPerTick; count > maxCount {
+		return types.RangeOrderTickFullError{PoolId: poolId, TickIndex: tickIndex, MaxRangeOrders: maxCount}
+	}
+
+	setRangeOrderCount(&tickInfo, zeroForOne, count)
+	k.SetTickInfo(ctx, poolId, tickIndex, &tickInfo)
+	return nil
+}
+
+// decrementRangeOrderTickCount decrements the number of unfilled range orders that are filled by a swap
+// in the given direction crossing the given tick.
+func (k Keeper) decrementRangeOrderTickCount(ctx sdk.Context, poolId uint64, tickIndex int64, zeroForOne bool) error {
+	tickInfo, err := k.GetTickInfo(ctx, poolId, tickIndex)
+	if err != nil {
+		return err
+	}
+
+	count := rangeOrderCount(&tickInfo, zeroForOne)
+	if count == 0 {
+		return types.RangeOrderTickCountUnderflowError{PoolId: poolId, TickIndex: tickIndex}
+	}
+
+	setRangeOrderCount(&tickInfo, zeroForOne, count-1)
+	k.SetTickInfo(ctx, poolId, tickIndex, &tickInfo)
+	return nil
+}
+
+// GetRangeOrder returns the range order backed by the given position id.
+// Returns error if the range order does not exist.
+func (k Keeper) GetRangeOrder(ctx sdk.Context, positionId uint64) (types.RangeOrder, error) {
+	rangeOrder := types.RangeOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyRangeOrder(positionId), &rangeOrder)
+	if err != nil {
+		return types.RangeOrder{}, err
+	}
+	if !found {
+		return types.RangeOrder{}, types.RangeOrderNotFoundError{PositionId: positionId}
+	}
+	return rangeOrder, nil
+}
+
+// GetAllRangeOrders returns all range orders, including the filled orders that are not claimed yet.
+func (k Keeper) GetAllRangeOrders(ctx sdk.Context) ([]types.RangeOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.RangeOrderPrefix, func(bz []byte) (types.RangeOrder, error) {
+		rangeOrder := types.RangeOrder{}
+		if err := k.cdc.Unmarshal(bz, &rangeOrder); err != nil {
+			return types.RangeOrder{}, err
+		}
+		return rangeOrder, nil
+	})
+}
+
+// setRangeOrder stores the given range order.
+func (k Keeper) setRangeOrder(ctx sdk.Context, rangeOrder types.RangeOrder) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyRangeOrder(rangeOrder.PositionId), &rangeOrder)
+}
+
+// deleteRangeOrder removes the range order backed by the given position id.
+func (k Keeper) deleteRangeOrder(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyRangeOrder(positionId))
+}
+
+// setRangeOrderTickIndex indexes the unfilled range order by its fill tick and the direction of the swaps that fill it.
+// Orders providing token1 are filled by zero for one swaps and orders providing token0 by one for zero swaps.
+func (k Keeper) setRangeOrderTickIndex(ctx sdk.Context, rangeOrder types.RangeOrder, zeroForOne bool) {
+	key := types.KeyRangeOrderByTick(rangeOrder.PoolId, zeroForOne, rangeOrder.FillTick, rangeOrder.PositionId)
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(rangeOrder.PositionId))
+}
+
+// deleteRangeOrderTickIndex removes the fill tick index of the range order.
+func (k Keeper) deleteRangeOrderTickIndex(ctx sdk.Context, rangeOrder types.RangeOrder, zeroForOne bool) {
+	key := types.KeyRangeOrderByTick(rangeOrder.PoolId, zeroForOne, rangeOrder.FillTick, rangeOrder.PositionId)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// parsePositionIdFromBz parses a big endian encoded position id.
+func parsePositionIdFromBz(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, types.ErrValueParse
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
@@ -0,0 +1,428 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+var (
+	// range above the default current tick, i.e. prices in [5001, 5002].
+	rangeOrderToken0LowerTick = DefaultCurrTick + 1000
+	rangeOrderToken0UpperTick = DefaultCurrTick + 2000
+	// range below the default current tick, i.e. prices in [4999, 4999.5].
+	rangeOrderToken1LowerTick = DefaultCurrTick - 1000
+	rangeOrderToken1UpperTick = DefaultCurrTick - 500
+
+	rangeOrderToken0 = sdk.NewCoin(ETH, osmomath.NewInt(100_000))
+	rangeOrderToken1 = sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+)
+
+// setupRangeOrderPool creates a pool with no spread factor and the default position.
+func (s *KeeperTestSuite) setupRangeOrderPool() types.ConcentratedPoolExtension {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.ZeroDec())
+	s.SetupDefaultPosition(pool.GetId())
+	return pool
+}
+
+// placeRangeOrder funds the owner and places a range order.
+func (s *KeeperTestSuite) placeRangeOrder(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, tokenProvided sdk.Coin) uint64 {
+	s.FundAcc(owner, sdk.NewCoins(tokenProvided))
+	positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceRangeOrder(s.Ctx, poolId, owner, lowerTick, upperTick, tokenProvided)
+	s.Require().NoError(err)
+	return positionData.ID
+}
+
+func (s *KeeperTestSuite) TestPlaceRangeOrder() {
+	tests := map[string]struct {
+		lowerTick     int64
+		upperTick     int64
+		tokenProvided sdk.Coin
+
+		expectedFillTick int64
+		expectedError    error
+	}{
+		"token0 above the current tick": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+
+			expectedFillTick: rangeOrderToken0UpperTick,
+		},
+		"token1 below the current tick": {
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     rangeOrderToken1UpperTick,
+			tokenProvided: rangeOrderToken1,
+
+			expectedFillTick: rangeOrderToken1LowerTick,
+		},
+		"token1 with upper tick at the current tick": {
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     DefaultCurrTick,
+			tokenProvided: rangeOrderToken1,
+
+			expectedFillTick: rangeOrderToken1LowerTick,
+		},
+		"error: token0 with lower tick at the current tick": {
+			lowerTick:     DefaultCurrTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+
+			expectedError: types.InvalidRangeOrderTicksError{DenomIn: ETH, LowerTick: DefaultCurrTick, UpperTick: rangeOrderToken0UpperTick, CurrentTick: DefaultCurrTick},
+		},
+		"error: token0 below the current tick": {
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     rangeOrderToken1UpperTick,
+			tokenProvided: rangeOrderToken0,
+
+			expectedError: types.InvalidRangeOrderTicksError{DenomIn: ETH, LowerTick: rangeOrderToken1LowerTick, UpperTick: rangeOrderToken1UpperTick, CurrentTick: DefaultCurrTick},
+		},
+		"error: token1 above the current tick": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken1,
+
+			expectedError: types.InvalidRangeOrderTicksError{DenomIn: USDC, LowerTick: rangeOrderToken0LowerTick, UpperTick: rangeOrderToken0UpperTick, CurrentTick: DefaultCurrTick},
+		},
+		"error: denom not in pool": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: sdk.NewCoin("foo", osmomath.NewInt(100_000)),
+
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: "foo"},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.setupRangeOrderPool()
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenProvided))
+
+			positionData, err := s.App.ConcentratedLiquidityKeeper.PlaceRangeOrder(s.Ctx, pool.GetId(), owner, tc.lowerTick, tc.upperTick, tc.tokenProvided)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			rangeOrder, err := s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(types.RangeOrder{
+				PositionId: positionData.ID,
+				PoolId:     pool.GetId(),
+				Owner:      owner.String(),
+				DenomIn:    tc.tokenProvided.Denom,
+				FillTick:   tc.expectedFillTick,
+			}, rangeOrder)
+
+			// The position is owned by the escrow address so that the owner cannot withdraw it directly.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(cl.GetRangeOrderEscrowAddress(pool.GetId()).String(), position.Address)
+
+			_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionData.ID, position.Liquidity)
+			s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: positionData.ID, Address: owner.String()})
+
+			// The position is single sided and the unused amount is refunded.
+			usedAmount := positionData.Amount0
+			if tc.tokenProvided.Denom == USDC {
+				s.Require().True(positionData.Amount0.IsZero())
+				usedAmount = positionData.Amount1
+			} else {
+				s.Require().True(positionData.Amount1.IsZero())
+			}
+			s.Require().Equal(tc.tokenProvided.Amount.Sub(usedAmount), s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenProvided.Denom).Amount)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, cl.GetRangeOrderEscrowAddress(pool.GetId())).IsZero())
+		})
+	}
+}
+
+// TestPlaceRangeOrderLimits tests the minimum notional of range orders and the maximum
+// number of unfilled range orders per tick and direction.
+func (s *KeeperTestSuite) TestPlaceRangeOrderLimits() {
+	tests := map[string]struct {
+		minNotional           sdk.Coins
+		maxRangeOrdersPerTick uint64
+		lowerTick             int64
+		upperTick             int64
+		tokenProvided         sdk.Coin
+
+		expectedError error
+	}{
+		"token1 order at the minimum notional": {
+			minNotional:   sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(99_000_000))),
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     rangeOrderToken1UpperTick,
+			tokenProvided: rangeOrderToken1,
+		},
+		"token0 order valued at the token1 it is filled for": {
+			// 100_000 ETH at prices in [5001, 5002]
+			minNotional:   sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(500_000_000))),
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+		},
+		"minimum notional in another denom does not apply": {
+			minNotional:   sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000_000_000))),
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+		},
+		"error: token1 order below the minimum notional": {
+			minNotional:   sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(100_000_001))),
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     rangeOrderToken1UpperTick,
+			tokenProvided: rangeOrderToken1,
+
+			expectedError: types.RangeOrderBelowMinNotionalError{},
+		},
+		"error: token0 order below the minimum notional": {
+			minNotional:   sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(600_000_000))),
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+
+			expectedError: types.RangeOrderBelowMinNotionalError{},
+		},
+		"error: fill tick already has the maximum number of orders": {
+			maxRangeOrdersPerTick: 1,
+			lowerTick:             rangeOrderToken0LowerTick,
+			upperTick:             rangeOrderToken0UpperTick,
+			tokenProvided:         rangeOrderToken0,
+
+			expectedError: types.RangeOrderTickFullError{},
+		},
+		"orders filled at other ticks are not counted": {
+			maxRangeOrdersPerTick: 1,
+			lowerTick:             rangeOrderToken0LowerTick,
+			upperTick:             rangeOrderToken0UpperTick + 1000,
+			tokenProvided:         rangeOrderToken0,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			params.RangeOrderMinNotional = tc.minNotional
+			if tc.maxRangeOrdersPerTick != 0 {
+				params.MaxRangeOrdersPerTick = tc.maxRangeOrdersPerTick
+			}
+			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
+			pool := s.setupRangeOrderPool()
+			owner := s.TestAccs[1]
+
+			// An existing order filled by a one for zero swap crossing rangeOrderToken0UpperTick.
+			s.placeRangeOrder(pool.GetId(), s.TestAccs[2], rangeOrderToken0LowerTick, rangeOrderToken0UpperTick, sdk.NewCoin(ETH, osmomath.NewInt(1_000_000)))
+
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenProvided))
+			_, err := s.App.ConcentratedLiquidityKeeper.PlaceRangeOrder(s.Ctx, pool.GetId(), owner, tc.lowerTick, tc.upperTick, tc.tokenProvided)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// TestRangeOrderTickCount tests that the number of unfilled range orders is tracked
+// in the tick info of the fill tick as orders are placed, cancelled and filled.
+func (s *KeeperTestSuite) TestRangeOrderTickCount() {
+	s.SetupTest()
+	pool := s.setupRangeOrderPool()
+	owner := s.TestAccs[1]
+
+	requireCounts := func(tickIndex int64, expectedZeroForOne, expectedOneForZero uint64) {
+		tickInfo, err := s.App.ConcentratedLiquidityKeeper.GetTickInfo(s.Ctx, pool.GetId(), tickIndex)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedZeroForOne, tickInfo.RangeOrdersZeroForOne)
+		s.Require().Equal(expectedOneForZero, tickInfo.RangeOrdersOneForZero)
+	}
+
+	cancelledPositionId := s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken0LowerTick, rangeOrderToken0UpperTick, rangeOrderToken0)
+	s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken0LowerTick, rangeOrderToken0UpperTick, rangeOrderToken0)
+	s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken1LowerTick, rangeOrderToken1UpperTick, rangeOrderToken1)
+	requireCounts(rangeOrderToken0UpperTick, 0, 2)
+	requireCounts(rangeOrderToken1LowerTick, 1, 0)
+	// Ticks that do not fill orders have no count.
+	requireCounts(rangeOrderToken0LowerTick, 0, 0)
+
+	_, _, err := s.App.ConcentratedLiquidityKeeper.CancelRangeOrder(s.Ctx, owner, cancelledPositionId)
+	s.Require().NoError(err)
+	requireCounts(rangeOrderToken0UpperTick, 0, 1)
+
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(swapTokenIn))
+	_, _, _, err = s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[2], pool, swapTokenIn, ETH, osmomath.ZeroDec(), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+	requireCounts(rangeOrderToken0UpperTick, 0, 0)
+	requireCounts(rangeOrderToken1LowerTick, 1, 0)
+
+	// Decrementing a count that is already zero is an error.
+	err = s.App.ConcentratedLiquidityKeeper.DecrementRangeOrderTickCount(s.Ctx, pool.GetId(), rangeOrderToken0UpperTick, false)
+	s.Require().ErrorIs(err, types.RangeOrderTickCountUnderflowError{PoolId: pool.GetId(), TickIndex: rangeOrderToken0UpperTick})
+	requireCounts(rangeOrderToken0UpperTick, 0, 0)
+}
+
+func (s *KeeperTestSuite) TestRangeOrderFill() {
+	tests := map[string]struct {
+		lowerTick     int64
+		upperTick     int64
+		tokenProvided sdk.Coin
+		swapTokenIn   sdk.Coin
+
+		expectFilled bool
+	}{
+		"token0 order filled by one for zero swap": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+			swapTokenIn:   sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000)),
+
+			expectFilled: true,
+		},
+		"token1 order filled by zero for one swap": {
+			lowerTick:     rangeOrderToken1LowerTick,
+			upperTick:     rangeOrderToken1UpperTick,
+			tokenProvided: rangeOrderToken1,
+			swapTokenIn:   sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+
+			expectFilled: true,
+		},
+		"token0 order not filled by swap away from it": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+			swapTokenIn:   sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+		},
+		"token0 order not filled by swap that does not cross its range": {
+			lowerTick:     rangeOrderToken0LowerTick,
+			upperTick:     rangeOrderToken0UpperTick,
+			tokenProvided: rangeOrderToken0,
+			swapTokenIn:   sdk.NewCoin(USDC, osmomath.NewInt(1_000)),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.setupRangeOrderPool()
+			owner := s.TestAccs[1]
+			escrowAddress := cl.GetRangeOrderEscrowAddress(pool.GetId())
+
+			positionId := s.placeRangeOrder(pool.GetId(), owner, tc.lowerTick, tc.upperTick, tc.tokenProvided)
+
+			tokenOutDenom := ETH
+			if tc.swapTokenIn.Denom == ETH {
+				tokenOutDenom = USDC
+			}
+			s.FundAcc(s.TestAccs[2], sdk.NewCoins(tc.swapTokenIn))
+			_, _, _, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[2], pool, tc.swapTokenIn, tokenOutDenom, osmomath.ZeroDec(), osmomath.ZeroBigDec())
+			s.Require().NoError(err)
+
+			rangeOrder, err := s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectFilled, rangeOrder.Filled)
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			if !tc.expectFilled {
+				s.Require().NoError(err)
+				s.Require().True(rangeOrder.TokensOut.Empty())
+
+				_, err = s.App.ConcentratedLiquidityKeeper.ClaimRangeOrder(s.Ctx, owner, positionId)
+				s.Require().ErrorIs(err, types.RangeOrderNotFilledError{PositionId: positionId})
+				return
+			}
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+
+			// The order is fully converted into the other token which is held in escrow.
+			s.Require().Len(rangeOrder.TokensOut, 1)
+			s.Require().Equal(tokenOutDenom == ETH, rangeOrder.TokensOut[0].Denom == USDC)
+			s.Require().True(rangeOrder.TokensOut[0].Amount.IsPositive())
+			s.Require().Equal(rangeOrder.TokensOut, s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress))
+
+			_, _, err = s.App.ConcentratedLiquidityKeeper.CancelRangeOrder(s.Ctx, owner, positionId)
+			s.Require().ErrorIs(err, types.RangeOrderFilledError{PositionId: positionId})
+
+			_, err = s.App.ConcentratedLiquidityKeeper.ClaimRangeOrder(s.Ctx, s.TestAccs[2], positionId)
+			s.Require().ErrorIs(err, types.NotRangeOrderOwnerError{PositionId: positionId, Address: s.TestAccs[2].String()})
+
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			tokensOut, err := s.App.ConcentratedLiquidityKeeper.ClaimRangeOrder(s.Ctx, owner, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(rangeOrder.TokensOut, tokensOut)
+			s.Require().Equal(balanceBefore.Add(tokensOut...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress).IsZero())
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.RangeOrderNotFoundError{PositionId: positionId})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelRangeOrder() {
+	s.SetupTest()
+	pool := s.setupRangeOrderPool()
+	owner := s.TestAccs[1]
+
+	positionId := s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken0LowerTick, rangeOrderToken0UpperTick, rangeOrderToken0)
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH)
+
+	_, _, err := s.App.ConcentratedLiquidityKeeper.CancelRangeOrder(s.Ctx, s.TestAccs[2], positionId)
+	s.Require().ErrorIs(err, types.NotRangeOrderOwnerError{PositionId: positionId, Address: s.TestAccs[2].String()})
+
+	amount0, amount1, err := s.App.ConcentratedLiquidityKeeper.CancelRangeOrder(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().True(amount1.IsZero())
+	s.Require().Equal(balanceBefore.Amount.Add(amount0), s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount)
+
+	// The withdrawn amount is rounded down by at most one unit.
+	s.Require().True(rangeOrderToken0.Amount.Sub(amount0).LTE(osmomath.OneInt()))
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetRangeOrder(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.RangeOrderNotFoundError{PositionId: positionId})
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+
+	// A swap crossing the range of the cancelled order does not fail.
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(swapTokenIn))
+	_, _, _, err = s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[2], pool, swapTokenIn, ETH, osmomath.ZeroDec(), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRangeOrderGenesis() {
+	s.SetupTest()
+	pool := s.setupRangeOrderPool()
+	owner := s.TestAccs[1]
+
+	filledPositionId := s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken0LowerTick, rangeOrderToken0UpperTick, rangeOrderToken0)
+	unfilledPositionId := s.placeRangeOrder(pool.GetId(), owner, rangeOrderToken1LowerTick, rangeOrderToken1UpperTick, rangeOrderToken1)
+
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(swapTokenIn))
+	_, _, _, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[2], pool, swapTokenIn, ETH, osmomath.ZeroDec(), osmomath.ZeroBigDec())
+	s.Require().NoError(err)
+
+	exported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.RangeOrders, 2)
+	s.Require().Equal(filledPositionId, exported.RangeOrders[0].PositionId)
+	s.Require().True(exported.RangeOrders[0].Filled)
+	s.Require().Equal(unfilledPositionId, exported.RangeOrders[1].PositionId)
+	s.Require().False(exported.RangeOrders[1].Filled)
+
+	// Reinitialize from the exported state and validate that the range orders are restored.
+	s.SetupTest()
+	s.App.ConcentratedLiquidityKeeper.InitGenesis(s.Ctx, *exported)
+	s.Require().Equal(exported.RangeOrders, s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx).RangeOrders)
+}
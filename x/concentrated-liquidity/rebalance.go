@@ -0,0 +1,203 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// RebalancePosition moves the full liquidity of the given position to the new tick range in a single step.
+// It withdraws the position, optionally swaps the withdrawn tokens through the pool of the position so that
+// their ratio matches the new tick range at the current price, and recreates the position in the new tick range.
+//
+// The rebalanced position keeps its position ID, but its join time is reset to the current block time since
+// its liquidity is new to the new tick range. It therefore has to qualify for the incentive uptimes again,
+// and incentives for uptimes it does not yet qualify for are forfeited. The spread rewards and incentives
+// accrued in the old tick range are claimed to the owner since the accumulators are tracked per tick range.
+// Auto-compounding stays enabled if it was, and a tokenized position is tokenized again with a new NFT.
+//
+// Tokens that cannot be converted to liquidity in the new tick range remain with the owner.
+// Returns error if:
+// - the position does not exist or the owner does not own it
+// - the position has an active underlying lock
+// - the new tick range is invalid or is the tick range of the position
+// - the position is the last position in the pool
+// - the swap returns less than tokenOutMinAmount
+// - the amounts of the rebalanced position are less than the given minimums
+func (k Keeper) RebalancePosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, swap bool, tokenOutMinAmount, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	isTokenized, err := k.validatePositionOwner(ctx, position, owner)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// Positions with an underlying lock are handled in the superfluid module.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if positionHasUnderlyingLock {
+		return CreatePositionData{}, types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	if amount0Min.IsNegative() {
+		return CreatePositionData{}, types.NotPositiveRequireAmountError{Amount: amount0Min.String()}
+	}
+	if amount1Min.IsNegative() {
+		return CreatePositionData{}, types.NotPositiveRequireAmountError{Amount: amount1Min.String()}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// If multiple ticks can represent the same spot price, ensure we are using the largest of those ticks.
+	lowerTick, upperTick, err = roundTickToCanonicalPriceTick(lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, pool.GetTickSpacing())
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if lowerTick == position.LowerTick && upperTick == position.UpperTick {
+		return CreatePositionData{}, types.RebalanceSameRangeError{PositionId: positionId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	isAutoCompound := k.IsPositionAutoCompound(ctx, positionId)
+	joinHeight, hasJoinHeight := k.getPositionJoinHeight(ctx, positionId)
+
+	// Withdrawing the full position claims its spread rewards and incentives and
+	// removes its records from the accumulators of the old tick range.
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if !anyPositionsRemainingInPool {
+		return CreatePositionData{}, types.RebalanceLastPositionInPoolError{PoolId: position.PoolId, PositionId: positionId}
+	}
+
+	// Refetch the pool since the withdrawal modifies its liquidity.
+	pool, err = k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if swap {
+		newPosition := position
+		newPosition.LowerTick = lowerTick
+		newPosition.UpperTick = upperTick
+		amount0, amount1, err = k.swapToPositionRatio(ctx, owner, pool, newPosition, amount0, amount1, tokenOutMinAmount, osmomath.OneDec())
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+
+		// Refetch the pool since the swap moves the current price.
+		pool, err = k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+
+	tokensProvided := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	err = k.BeforeCreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return CreatePositionData{}, types.ErrZeroLiquidity
+	}
+
+	// Recreate the position under the same position ID, joining the new tick range now.
+	joinTime := ctx.BlockTime()
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, lowerTick, upperTick, liquidityDelta, joinTime, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if updateData.Amount0.LT(amount0Min) {
+		return CreatePositionData{}, types.InsufficientLiquidityCreatedError{Actual: updateData.Amount0, Minimum: amount0Min, IsTokenZero: true}
+	}
+	if updateData.Amount1.LT(amount1Min) {
+		return CreatePositionData{}, types.InsufficientLiquidityCreatedError{Actual: updateData.Amount1, Minimum: amount1Min}
+	}
+
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0, updateData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	tokensAdded := sdk.Coins{}
+	if updateData.Amount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), updateData.Amount0))
+	}
+	if updateData.Amount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), updateData.Amount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	// Withdrawing the position removed its join height, auto-compounding flag and NFT.
+	// A tokenized position is tokenized again, minting an NFT with its new tick range to the owner.
+	// The join height is restored so that rebalancing does not restart the spread reward minimum age.
+	if isTokenized {
+		if err := k.TokenizePosition(ctx, owner, positionId); err != nil {
+			return CreatePositionData{}, err
+		}
+	}
+	if hasJoinHeight {
+		k.setPositionJoinHeight(ctx, positionId, joinHeight)
+	} else {
+		k.deletePositionJoinHeight(ctx, positionId)
+	}
+	if isAutoCompound {
+		k.setPositionAutoCompound(ctx, positionId)
+	}
+
+	event := &liquidityChangeEvent{
+		eventType:      types.TypeEvtRebalancePosition,
+		positionId:     positionId,
+		sender:         owner,
+		poolId:         position.PoolId,
+		lowerTick:      lowerTick,
+		upperTick:      upperTick,
+		joinTime:       joinTime,
+		liquidityDelta: liquidityDelta,
+		actualAmount0:  updateData.Amount0,
+		actualAmount1:  updateData.Amount1,
+	}
+	event.emit(ctx)
+
+	err = k.AfterCreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	return CreatePositionData{
+		ID:        positionId,
+		Amount0:   updateData.Amount0,
+		Amount1:   updateData.Amount1,
+		Liquidity: liquidityDelta,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestRebalancePosition() {
+	const (
+		newLowerTick = int64(30000000)
+		newUpperTick = int64(32000000)
+		// A range above the current tick that only holds token0.
+		aboveLowerTick = int64(31600000)
+		aboveUpperTick = int64(31700000)
+	)
+
+	tests := map[string]struct {
+		lowerTick         int64
+		upperTick         int64
+		swap              bool
+		tokenOutMinAmount osmomath.Int
+		amount0Min        osmomath.Int
+		sender            int
+		lastPosition      bool
+		expectedErr       string
+	}{
+		"wider range without swap": {
+			lowerTick: newLowerTick,
+			upperTick: newUpperTick,
+		},
+		"wider range with swap": {
+			lowerTick: newLowerTick,
+			upperTick: newUpperTick,
+			swap:      true,
+		},
+		"range above current tick with swap": {
+			lowerTick: aboveLowerTick,
+			upperTick: aboveUpperTick,
+			swap:      true,
+		},
+		"error: not owner": {
+			lowerTick:   newLowerTick,
+			upperTick:   newUpperTick,
+			sender:      2,
+			expectedErr: "is not the owner of position ID (2)",
+		},
+		"error: same range": {
+			lowerTick:   DefaultLowerTick,
+			upperTick:   DefaultUpperTick,
+			expectedErr: types.RebalanceSameRangeError{PositionId: 2, LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick}.Error(),
+		},
+		"error: last position in pool": {
+			lowerTick:    newLowerTick,
+			upperTick:    newUpperTick,
+			lastPosition: true,
+			expectedErr:  types.RebalanceLastPositionInPoolError{PoolId: 1, PositionId: 1}.Error(),
+		},
+		"error: swap returns less than token out min amount": {
+			lowerTick:         aboveLowerTick,
+			upperTick:         aboveUpperTick,
+			swap:              true,
+			tokenOutMinAmount: osmomath.NewInt(1_000_000_000_000_000_000),
+			expectedErr:       "is lesser than min amount",
+		},
+		"error: amount0 less than min": {
+			lowerTick:   newLowerTick,
+			upperTick:   newUpperTick,
+			amount0Min:  DefaultAmt0.MulRaw(2),
+			expectedErr: "insufficient amount of token 0 created",
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			owner := s.TestAccs[1]
+			if !tc.lastPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, owner, positionId, true))
+
+			positionBefore, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+			if tc.tokenOutMinAmount.IsNil() {
+				tc.tokenOutMinAmount = osmomath.ZeroInt()
+			}
+			if tc.amount0Min.IsNil() {
+				tc.amount0Min = osmomath.ZeroInt()
+			}
+
+			sender := owner
+			if tc.sender != 0 {
+				sender = s.TestAccs[tc.sender]
+			}
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			positionData, err := clKeeper.RebalancePosition(s.Ctx, sender, positionId, tc.lowerTick, tc.upperTick, tc.swap, tc.tokenOutMinAmount, tc.amount0Min, osmomath.ZeroInt())
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The position keeps its ID and auto-compounding flag, and joins the new range now.
+			positionAfter, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(positionId, positionData.ID)
+			s.Require().Equal(tc.lowerTick, positionAfter.LowerTick)
+			s.Require().Equal(tc.upperTick, positionAfter.UpperTick)
+			s.Require().Equal(positionData.Liquidity, positionAfter.Liquidity)
+			s.Require().True(positionAfter.JoinTime.Equal(s.Ctx.BlockTime()))
+			s.Require().True(positionAfter.JoinTime.After(positionBefore.JoinTime))
+			s.Require().True(clKeeper.IsPositionAutoCompound(s.Ctx, positionId))
+
+			userPositions, err := clKeeper.GetUserPositions(s.Ctx, owner, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Len(userPositions, 1)
+
+			ownerBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			leftover := ownerBalanceAfter.Sub(ownerBalanceBefore...)
+			if !tc.swap {
+				// The withdrawn tokens that do not fit the ratio of the new range are left with the owner.
+				s.Require().False(leftover.IsZero())
+			}
+
+			if tc.lowerTick == aboveLowerTick {
+				// All of token1 is swapped for token0 which is the only token of the range.
+				s.Require().True(positionData.Amount1.IsZero())
+				s.Require().True(positionData.Amount0.GT(DefaultAmt0))
+				s.Require().True(leftover.AmountOf(USDC).IsZero(), leftover.String())
+			}
+		})
+	}
+}
+
+// TestRebalancePosition_SpreadRewardMinPositionAge tests that rebalancing keeps the height the position
+// was created at, so that it neither restarts nor bypasses the spread reward minimum position age.
+func (s *KeeperTestSuite) TestRebalancePosition_SpreadRewardMinPositionAge() {
+	const (
+		minAgeBlocks = 10
+		newLowerTick = int64(30000000)
+		newUpperTick = int64(32000000)
+	)
+
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+	params := clKeeper.GetParams(s.Ctx)
+	params.SpreadRewardMinPositionAgeBlocks = minAgeBlocks
+	clKeeper.SetParams(s.Ctx, params)
+
+	startHeight := s.Ctx.BlockHeight()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	swap := func() {
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		tokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+		s.FundAcc(s.TestAccs[3], sdk.NewCoins(tokenIn))
+		_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, tokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+		s.Require().NoError(err)
+	}
+
+	// Rebalancing the position while it is young keeps it young.
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + minAgeBlocks/2)
+	_, err := clKeeper.RebalancePosition(s.Ctx, owner, positionId, newLowerTick, newUpperTick, false, osmomath.ZeroInt(), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	swap()
+	collected, err := clKeeper.CollectSpreadRewards(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{}, collected)
+
+	// The minimum age is counted from the creation of the position rather than from the rebalance.
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + minAgeBlocks)
+	swap()
+	claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(claimable.IsZero())
+
+	collected, err = clKeeper.CollectSpreadRewards(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(claimable, collected)
+}
+
+// TestRebalancePosition_IncentiveUptimeForfeiture tests that rebalancing resets the join time of the position,
+// so that incentives for uptimes the position qualified for in the old range are forfeited in the new range
+// until it qualifies again.
+func (s *KeeperTestSuite) TestRebalancePosition_IncentiveUptimeForfeiture() {
+	const (
+		newLowerTick = int64(30000000)
+		newUpperTick = int64(32000000)
+	)
+	minUptime := time.Hour * 24
+
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	params := clKeeper.GetParams(s.Ctx)
+	params.AuthorizedUptimes = []time.Duration{time.Nanosecond, minUptime}
+	clKeeper.SetParams(s.Ctx, params)
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	incentiveCoin := sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(incentiveCoin))
+	err := clKeeper.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{{
+		PoolId: pool.GetId(),
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromCoin(incentiveCoin),
+			EmissionRate:  osmomath.NewDec(1),
+			StartTime:     s.Ctx.BlockTime(),
+		},
+		MinUptime: minUptime,
+	}})
+	s.Require().NoError(err)
+
+	// The position qualifies for the uptime in its old range before it is rebalanced.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(minUptime))
+	_, err = clKeeper.RebalancePosition(s.Ctx, owner, positionId, newLowerTick, newUpperTick, false, osmomath.ZeroInt(), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(position.JoinTime.Equal(s.Ctx.BlockTime()))
+
+	// Incentives accrued in the new range before the position qualifies for the uptime again are forfeited.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	collected, forfeited, err := clKeeper.CollectIncentives(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().True(collected.IsZero(), collected.String())
+	s.Require().False(forfeited.IsZero())
+
+	// Once the position qualifies again, the incentives are collected.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(minUptime))
+	collected, forfeited, err = clKeeper.CollectIncentives(s.Ctx, owner, positionId)
+	s.Require().NoError(err)
+	s.Require().False(collected.IsZero())
+	s.Require().True(forfeited.IsZero(), forfeited.String())
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
 
@@ -160,11 +161,8 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 	}
 
 	// Spread reward collector must be the owner of the position.
-	if sender.String() != position.Address {
-		return sdk.Coins{}, types.NotPositionOwnerError{
-			PositionId: positionId,
-			Address:    sender.String(),
-		}
+	if _, err := k.validatePositionOwner(ctx, position, sender); err != nil {
+		return sdk.Coins{}, err
 	}
 
 	// Get the amount of spread rewards that the position is eligible to claim.
@@ -179,11 +177,32 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 		return sdk.Coins{}, nil
 	}
 
-	// Send the claimed spread rewards from the pool's address to the owner's address.
 	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
+
+	// If the position is younger than the minimum age, the spread rewards are forfeited to the remaining
+	// in-range liquidity. This prevents just-in-time liquidity from capturing the spread rewards of a swap.
+	if k.isPositionBelowSpreadRewardMinAge(ctx, positionId) {
+		if err := k.redistributeForfeitedSpreadRewards(ctx, pool, position, spreadRewardsClaimed); err != nil {
+			return sdk.Coins{}, err
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtCollectSpreadRewards,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+				sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.Coins{}.String()),
+				sdk.NewAttribute(types.AttributeKeyForfeitedTokens, spreadRewardsClaimed.String()),
+			),
+		})
+
+		return sdk.Coins{}, nil
+	}
+
+	// Send the claimed spread rewards from the pool's address to the owner's address.
 	if err := k.bankKeeper.SendCoins(ctx, pool.GetSpreadRewardsAddress(), sender, spreadRewardsClaimed); err != nil {
 		return sdk.Coins{}, err
 	}
@@ -201,6 +220,80 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 	return spreadRewardsClaimed, nil
 }
 
+// redistributeForfeitedSpreadRewards adds the given spread rewards forfeited by the given position to the spread reward
+// accumulator of the pool per unit of the remaining liquidity in range at the current tick, so that they are shared pro rata
+// by the other in-range positions. If there is no such liquidity, the forfeited spread rewards are sent to the community pool,
+// like forfeited incentives.
+func (k Keeper) redistributeForfeitedSpreadRewards(ctx sdk.Context, pool types.ConcentratedPoolExtension, position model.Position, forfeitedSpreadRewards sdk.Coins) error {
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, pool.GetId())
+	if err != nil {
+		return err
+	}
+
+	// The forfeiting position must not earn back a share of its own forfeited spread rewards. If it is still in range,
+	// its liquidity is excluded from the remaining in-range liquidity and its accumulator value is advanced below.
+	positionKey := types.KeySpreadRewardPositionAccumulator(position.PositionId)
+	isPositionInRange := spreadRewardAccumulator.HasPosition(positionKey) && pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick)
+	remainingInRangeLiquidity := pool.GetLiquidity()
+	if isPositionInRange {
+		remainingInRangeLiquidity = remainingInRangeLiquidity.Sub(position.Liquidity)
+	}
+
+	if !remainingInRangeLiquidity.IsPositive() {
+		return k.communityPoolKeeper.FundCommunityPool(ctx, forfeitedSpreadRewards, pool.GetSpreadRewardsAddress())
+	}
+
+	// The truncation dust remains in the spread rewards address of the pool.
+	forfeitedPerUnitLiquidity := sdk.NewDecCoinsFromCoins(forfeitedSpreadRewards...).QuoDecTruncate(remainingInRangeLiquidity)
+	spreadRewardAccumulator.AddToAccumulator(forfeitedPerUnitLiquidity)
+
+	if isPositionInRange {
+		positionRecord, err := spreadRewardAccumulator.GetPosition(positionKey)
+		if err != nil {
+			return err
+		}
+		return spreadRewardAccumulator.SetPositionIntervalAccumulation(positionKey, positionRecord.AccumValuePerShare.Add(forfeitedPerUnitLiquidity...))
+	}
+	return nil
+}
+
+// isPositionBelowSpreadRewardMinAge returns true if fewer blocks than the spread reward minimum position age
+// have passed since the given position was created. False otherwise, or if the position has no recorded join height.
+func (k Keeper) isPositionBelowSpreadRewardMinAge(ctx sdk.Context, positionId uint64) bool {
+	minAgeBlocks := k.GetParams(ctx).SpreadRewardMinPositionAgeBlocks
+	if minAgeBlocks == 0 {
+		return false
+	}
+
+	joinHeight, found := k.getPositionJoinHeight(ctx, positionId)
+	if !found {
+		return false
+	}
+
+	positionAgeBlocks := ctx.BlockHeight() - joinHeight
+	return positionAgeBlocks < int64(minAgeBlocks)
+}
+
+// getPositionJoinHeight returns the height at which the given position was created and true
+// if it is recorded. False otherwise.
+func (k Keeper) getPositionJoinHeight(ctx sdk.Context, positionId uint64) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPositionJoinHeight(positionId))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// setPositionJoinHeight records the given height as the height at which the given position was created.
+func (k Keeper) setPositionJoinHeight(ctx sdk.Context, positionId uint64, joinHeight int64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPositionJoinHeight(positionId), sdk.Uint64ToBigEndian(uint64(joinHeight)))
+}
+
+// deletePositionJoinHeight removes the join height of the given position, if any.
+func (k Keeper) deletePositionJoinHeight(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionJoinHeight(positionId))
+}
+
 // GetClaimableSpreadRewards returns the amount of spread rewards that a position is eligible to claim.
 //
 // Returns error if:
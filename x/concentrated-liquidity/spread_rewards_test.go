@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
@@ -1481,6 +1482,134 @@ func (s *KeeperTestSuite) TestFunctional_SpreadRewards_LP() {
 // CollectAndAssertSpreadRewards collects spread rewards from a given pool for all positions and verifies that the total spread rewards collected match the expected total spread rewards.
 // The method also checks that if the ticks that were active during the swap lie within the range of a position, then the position's spread reward accumulators
 // are not empty. The total spread rewards collected are compared to the expected total spread rewards within an additive tolerance defined by an error tolerance struct.
+func (s *KeeperTestSuite) TestCollectSpreadRewards_MinPositionAge() {
+	const minAgeBlocks = 10
+	swapTokenIn := sdk.NewCoin(USDC, osmomath.NewInt(100_000_000))
+	swap := func(pool types.ConcentratedPoolExtension) {
+		s.FundAcc(s.TestAccs[3], sdk.NewCoins(swapTokenIn))
+		_, _, _, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[3], pool, swapTokenIn, ETH, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+		s.Require().NoError(err)
+	}
+	setMinAge := func() {
+		params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+		params.SpreadRewardMinPositionAgeBlocks = minAgeBlocks
+		s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+	}
+
+	s.Run("forfeited spread rewards are redistributed to the remaining in-range liquidity", func() {
+		s.SetupTest()
+		clKeeper := s.App.ConcentratedLiquidityKeeper
+		pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+		setMinAge()
+
+		startHeight := s.Ctx.BlockHeight()
+		oldPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+
+		// The young position is created once the old position is old enough.
+		s.Ctx = s.Ctx.WithBlockHeight(startHeight + minAgeBlocks)
+		owner := s.TestAccs[1]
+		youngPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+		swap(pool)
+		oldClaimableBefore, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, oldPositionId)
+		s.Require().NoError(err)
+		youngClaimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().False(youngClaimable.IsZero())
+
+		ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+		collected, err := clKeeper.CollectSpreadRewards(s.Ctx, owner, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.Coins{}, collected)
+		s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+		// The young position does not earn back any of its forfeited spread rewards.
+		claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().True(claimable.IsZero())
+
+		// The old position receives the forfeited spread rewards, up to truncation.
+		oldClaimableAfter, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, oldPositionId)
+		s.Require().NoError(err)
+		gained := oldClaimableAfter.Sub(oldClaimableBefore...)
+		s.Require().True(gained.AmountOf(USDC).LTE(youngClaimable.AmountOf(USDC)))
+		s.Require().True(youngClaimable.AmountOf(USDC).Sub(gained.AmountOf(USDC)).LTE(osmomath.OneInt()))
+
+		// Once the young position is old enough, its spread rewards can be collected.
+		s.Ctx = s.Ctx.WithBlockHeight(startHeight + 2*minAgeBlocks)
+		swap(pool)
+		youngClaimable, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().False(youngClaimable.IsZero())
+
+		collected, err = clKeeper.CollectSpreadRewards(s.Ctx, owner, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(youngClaimable, collected)
+	})
+
+	s.Run("forfeited spread rewards are sent to the community pool without remaining in-range liquidity", func() {
+		s.SetupTest()
+		clKeeper := s.App.ConcentratedLiquidityKeeper
+		pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+		setMinAge()
+
+		owner := s.TestAccs[1]
+		positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+		swap(pool)
+		claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().False(claimable.IsZero())
+
+		communityPoolAddress := s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName)
+		communityPoolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, communityPoolAddress)
+
+		// Withdrawing the position collects its spread rewards.
+		_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, DefaultLiquidityAmt)
+		s.Require().NoError(err)
+
+		communityPoolBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, communityPoolAddress)
+		s.Require().Equal(claimable, communityPoolBalanceAfter.Sub(communityPoolBalanceBefore...))
+	})
+
+	s.Run("partially withdrawing a young position forfeits its spread rewards", func() {
+		s.SetupTest()
+		clKeeper := s.App.ConcentratedLiquidityKeeper
+		pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.01"))
+		setMinAge()
+
+		startHeight := s.Ctx.BlockHeight()
+		oldPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+		s.Ctx = s.Ctx.WithBlockHeight(startHeight + minAgeBlocks)
+		owner := s.TestAccs[1]
+		youngPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+		swap(pool)
+		youngClaimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().False(youngClaimable.IsZero())
+		oldClaimableBefore, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, oldPositionId)
+		s.Require().NoError(err)
+
+		// Withdrawing most of the liquidity right after the swap forfeits the spread rewards to the old position.
+		_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, youngPositionId, DefaultLiquidityAmt.Sub(osmomath.OneDec()))
+		s.Require().NoError(err)
+
+		claimable, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().True(claimable.IsZero())
+		oldClaimableAfter, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, oldPositionId)
+		s.Require().NoError(err)
+		s.Require().True(oldClaimableAfter.IsAllGT(oldClaimableBefore))
+
+		// The forfeited spread rewards cannot be claimed once the position is old enough.
+		s.Ctx = s.Ctx.WithBlockHeight(startHeight + 2*minAgeBlocks)
+		collected, err := clKeeper.CollectSpreadRewards(s.Ctx, owner, youngPositionId)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.Coins{}, collected)
+	})
+}
+
 func (s *KeeperTestSuite) CollectAndAssertSpreadRewards(ctx sdk.Context, poolId uint64, totalSpreadRewards sdk.Coins, positionIds [][]uint64, activeTicks [][]int64, expectedSpreadRewardDenoms [][]string, positions Positions) {
 	var totalSpreadRewardsCollected sdk.Coins
 	// Claim full range position spread rewards across all four accounts
@@ -57,6 +57,11 @@ type SwapState struct {
 	// global spread reward growth
 	globalSpreadRewardGrowth osmomath.Dec
 
+	// Ticks crossed while calculating swap that fill range orders.
+	// Initialized to empty.
+	// Updated each time a tick with range orders is crossed.
+	rangeOrderTicksCrossed []int64
+
 	swapStrategy swapstrategy.SwapStrategy
 }
 
@@ -66,6 +71,11 @@ type SwapResult struct {
 	AmountIn      osmomath.Int
 	AmountOut     osmomath.Int
 	SpreadRewards osmomath.Dec
+	// SpreadFactor is the spread factor applied by the swap, which is the
+	// dynamic spread factor for pools that have one enabled.
+	SpreadFactor osmomath.Dec
+	// RangeOrderTicksCrossed are the ticks crossed by the swap that fill range orders.
+	RangeOrderTicksCrossed []int64
 }
 
 // swapNoProgressLimit is the maximum number of iterations that can be performed
@@ -90,9 +100,10 @@ func newSwapState(specifiedAmount osmomath.Int, p types.ConcentratedPoolExtensio
 }
 
 type SwapDetails struct {
-	Sender   sdk.AccAddress
-	TokenIn  sdk.Coin
-	TokenOut sdk.Coin
+	Sender       sdk.AccAddress
+	TokenIn      sdk.Coin
+	TokenOut     sdk.Coin
+	SpreadFactor osmomath.Dec
 }
 
 type PoolUpdates struct {
@@ -258,7 +269,12 @@ func (k Keeper) swapOutAmtGivenIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
+	// Withdraws the range orders filled by the swap now that their positions are out of range.
+	if err := k.settleRangeOrders(ctx, pool.GetId(), getZeroForOne(tokenIn.Denom, pool.GetToken0()), swapResult.RangeOrderTicksCrossed); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -290,7 +306,12 @@ func (k *Keeper) swapInAmtGivenOut(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
+	// Withdraws the range orders filled by the swap now that their positions are out of range.
+	if err := k.settleRangeOrders(ctx, pool.GetId(), getZeroForOne(tokenIn.Denom, pool.GetToken0()), swapResult.RangeOrderTicksCrossed); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -388,6 +409,12 @@ func (k Keeper) computeOutAmtGivenIn(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
+	// Pools with a dynamic spread factor charge it instead of the given spread factor.
+	spreadFactor, err = k.getSwapSpreadFactor(ctx, poolId, spreadFactor)
+	if err != nil {
+		return SwapResult{}, PoolUpdates{}, err
+	}
+
 	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInMin.Denom, priceLimit)
 	if err != nil {
 		return SwapResult{}, PoolUpdates{}, err
@@ -493,9 +520,11 @@ func (k Keeper) computeOutAmtGivenIn(
 	ctx.Logger().Debug("final amount out", amountOut)
 
 	return SwapResult{
-		AmountIn:      amountIn,
-		AmountOut:     amountOut,
-		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		AmountIn:               amountIn,
+		AmountOut:              amountOut,
+		SpreadRewards:          swapState.globalSpreadRewardGrowth,
+		SpreadFactor:           spreadFactor,
+		RangeOrderTicksCrossed: swapState.rangeOrderTicksCrossed,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -516,6 +545,12 @@ func (k Keeper) computeInAmtGivenOut(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
+	// Pools with a dynamic spread factor charge it instead of the given spread factor.
+	spreadFactor, err = k.getSwapSpreadFactor(ctx, poolId, spreadFactor)
+	if err != nil {
+		return SwapResult{}, PoolUpdates{}, err
+	}
+
 	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
 	if err != nil {
 		return SwapResult{}, PoolUpdates{}, err
@@ -615,9 +650,11 @@ func (k Keeper) computeInAmtGivenOut(
 	ctx.Logger().Debug("final amount out", amountOut)
 
 	return SwapResult{
-		AmountIn:      amountIn,
-		AmountOut:     amountOut,
-		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		AmountIn:               amountIn,
+		AmountOut:              amountOut,
+		SpreadRewards:          swapState.globalSpreadRewardGrowth,
+		SpreadFactor:           spreadFactor,
+		RangeOrderTicksCrossed: swapState.rangeOrderTicksCrossed,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -652,6 +689,16 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 		return swapState, err
 	}
 
+	if err := k.crossIncentiveTickRangesTick(ctx, p.GetId(), nextInitializedTick, strategy); err != nil {
+		return swapState, err
+	}
+
+	// Record the crossed tick if it fills range orders so that they are settled once the swap is applied.
+	// The number of orders is tracked in the tick info to avoid an extra store read per crossed tick.
+	if rangeOrderCount(&nextInitializedTickInfo, strategy.ZeroForOne()) > 0 {
+		swapState.rangeOrderTicksCrossed = append(swapState.rangeOrderTicksCrossed, nextInitializedTick)
+	}
+
 	// Move next tick iterator to the next tick as the tick is crossed.
 	nextTickIter.Next()
 
@@ -736,7 +783,7 @@ func (k Keeper) updatePoolForSwap(
 
 	// TODO: move this to poolmanager and remove from here.
 	// Also, remove from gamm.
-	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
+	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut}, swapDetails.SpreadFactor)
 
 	return err
 }
@@ -864,8 +911,13 @@ func (k Keeper) ComputeMaxInAmtGivenMaxTicksCrossed(
 		tokenOutDenom = p.GetToken0()
 	}
 
+	spreadFactor, err := k.getSwapSpreadFactor(cacheCtx, poolId, p.GetSpreadFactor(cacheCtx))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
 	// Setup the swap strategy
-	swapStrategy, _, err := k.setupSwapStrategy(p, p.GetSpreadFactor(cacheCtx), tokenInDenom, osmomath.ZeroBigDec())
+	swapStrategy, _, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, osmomath.ZeroBigDec())
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
@@ -68,6 +68,10 @@ func (k Keeper) initOrUpdateTick(ctx sdk.Context, poolId uint64, currentTick int
 	}
 
 	k.SetTickInfo(ctx, poolId, tickIndex, &tickInfo)
+
+	// Record the new liquidity net for clients tracking the ticks of the pool incrementally.
+	// Ticks that became empty are recorded with a zero liquidity net ahead of their removal.
+	k.recordTickChange(ctx, poolId, tickIndex, tickInfo.LiquidityNet)
 	return tickIsEmpty, nil
 }
 
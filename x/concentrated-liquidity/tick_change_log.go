@@ -0,0 +1,149 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// GetTickLiquidityChanges returns the latest liquidity net of every tick of the given pool that changed
+// after the given height, sorted by tick index in ascending order.
+// A zero liquidity net indicates that the tick is no longer initialized.
+// Returns error if:
+// - the pool does not exist
+// - the changes since the given height are no longer retained
+func (k Keeper) GetTickLiquidityChanges(ctx sdk.Context, poolId uint64, fromHeight int64) ([]queryproto.TickLiquidityChange, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	oldestHeight := oldestRetainedTickChangeHeight(ctx)
+	if fromHeight+1 < oldestHeight {
+		return nil, types.TickChangeLogPrunedError{PoolId: poolId, FromHeight: fromHeight, OldestHeight: oldestHeight}
+	}
+
+	startHeight := fromHeight + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyTickChangeLogByHeight(poolId, startHeight), sdk.PrefixEndBytes(types.KeyTickChangeLogPrefixByPoolId(poolId)))
+	defer iterator.Close()
+
+	// Changes are iterated in the order of their heights so that later changes of a tick overwrite earlier ones.
+	changesByTick := make(map[int64]queryproto.TickLiquidityChange)
+	for ; iterator.Valid(); iterator.Next() {
+		change, err := parseTickChangeFromKeyAndValue(iterator.Key(), iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		changesByTick[change.TickIndex] = change
+	}
+
+	changes := make([]queryproto.TickLiquidityChange, 0, len(changesByTick))
+	for _, change := range changesByTick {
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].TickIndex < changes[j].TickIndex })
+
+	return changes, nil
+}
+
+// GetTickSnapshot returns the tick map of the given pool at the current height.
+// Ticks with zero liquidity net are omitted.
+func (k Keeper) GetTickSnapshot(ctx sdk.Context, poolId uint64) (types.TickSnapshot, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.TickSnapshot{}, err
+	}
+
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return types.TickSnapshot{}, err
+	}
+
+	snapshot := types.TickSnapshot{
+		PoolId:           poolId,
+		Height:           ctx.BlockHeight(),
+		CurrentTick:      pool.GetCurrentTick(),
+		CurrentLiquidity: pool.GetLiquidity(),
+		Ticks:            make([]types.TickLiquidity, 0, len(ticks)),
+	}
+	for _, tick := range ticks {
+		// Ticks with zero liquidity net do not affect swaps, and are reported as removed by the tick change log.
+		if tick.Info.LiquidityNet.IsZero() {
+			continue
+		}
+		snapshot.Ticks = append(snapshot.Ticks, types.TickLiquidity{TickIndex: tick.TickIndex, LiquidityNet: tick.Info.LiquidityNet})
+	}
+
+	return snapshot, nil
+}
+
+// recordTickChange records the liquidity net of the given tick after it changed at the current height
+// in the tick change log of the pool. Recording a change also prunes the changes of the pool
+// that are older than the retention window.
+func (k Keeper) recordTickChange(ctx sdk.Context, poolId uint64, tickIndex int64, liquidityNet osmomath.Dec) {
+	k.pruneTickChangeLog(ctx, poolId)
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSetDec(store, types.KeyTickChange(poolId, ctx.BlockHeight(), tickIndex), liquidityNet)
+}
+
+// pruneTickChangeLog deletes the changes of the given pool that happened before the oldest retained height.
+func (k Keeper) pruneTickChangeLog(ctx sdk.Context, poolId uint64) {
+	oldestHeight := oldestRetainedTickChangeHeight(ctx)
+	if oldestHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyTickChangeLogPrefixByPoolId(poolId), types.KeyTickChangeLogByHeight(poolId, oldestHeight))
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// oldestRetainedTickChangeHeight returns the oldest height for which tick changes are retained.
+func oldestRetainedTickChangeHeight(ctx sdk.Context) int64 {
+	return ctx.BlockHeight() - types.TickChangeLogRetentionBlocks + 1
+}
+
+// parseTickChangeFromKeyAndValue parses a tick change from its tick change log key and value.
+func parseTickChangeFromKeyAndValue(key, value []byte) (queryproto.TickLiquidityChange, error) {
+	// prefix (1 byte) || pool id (8 bytes) || height (8 bytes) || tick index (9 bytes)
+	heightOffset := len(types.TickChangeLogPrefix) + 8
+	tickOffset := heightOffset + 8
+	if len(key) != tickOffset+9 {
+		return queryproto.TickLiquidityChange{}, fmt.Errorf("invalid tick change log key length (%d)", len(key))
+	}
+
+	tickIndex, err := types.TickIndexFromBytes(key[tickOffset:])
+	if err != nil {
+		return queryproto.TickLiquidityChange{}, err
+	}
+
+	liquidityNet := sdk.DecProto{}
+	if err := liquidityNet.Unmarshal(value); err != nil {
+		return queryproto.TickLiquidityChange{}, err
+	}
+
+	return queryproto.TickLiquidityChange{
+		TickIndex:    tickIndex,
+		LiquidityNet: liquidityNet.Dec,
+		Height:       int64(sdk.BigEndianToUint64(key[heightOffset:tickOffset])),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package concentrated_liquidity_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestGetTickLiquidityChanges() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Two positions over the same range are created at the first height.
+	startHeight := s.Ctx.BlockHeight()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	changes, err := clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight-1)
+	s.Require().NoError(err)
+	s.Require().Equal([]queryproto.TickLiquidityChange{
+		{TickIndex: DefaultLowerTick, LiquidityNet: DefaultLiquidityAmt.MulInt64(2), Height: startHeight},
+		{TickIndex: DefaultUpperTick, LiquidityNet: DefaultLiquidityAmt.MulInt64(2).Neg(), Height: startHeight},
+	}, changes)
+
+	changes, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight)
+	s.Require().NoError(err)
+	s.Require().Empty(changes)
+
+	snapshot, err := clKeeper.GetTickSnapshot(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	// One of the positions is withdrawn at the next height.
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + 1)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, DefaultLiquidityAmt)
+	s.Require().NoError(err)
+
+	// A position over a new range is created at the height after.
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + 2)
+	newLowerTick, newUpperTick := DefaultLowerTick-100, DefaultUpperTick+100
+	newLiquidity, _ := s.SetupPosition(pool.GetId(), owner, DefaultCoins, newLowerTick, newUpperTick, false)
+
+	changes, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight)
+	s.Require().NoError(err)
+	s.Require().Equal([]queryproto.TickLiquidityChange{
+		{TickIndex: newLowerTick, LiquidityNet: newLiquidity, Height: startHeight + 2},
+		{TickIndex: DefaultLowerTick, LiquidityNet: DefaultLiquidityAmt, Height: startHeight + 1},
+		{TickIndex: DefaultUpperTick, LiquidityNet: DefaultLiquidityAmt.Neg(), Height: startHeight + 1},
+		{TickIndex: newUpperTick, LiquidityNet: newLiquidity.Neg(), Height: startHeight + 2},
+	}, changes)
+
+	// Applying the changes to the old snapshot yields the current snapshot.
+	updatedPool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	tickChanges := make([]types.TickLiquidity, 0, len(changes))
+	for _, change := range changes {
+		tickChanges = append(tickChanges, types.TickLiquidity{TickIndex: change.TickIndex, LiquidityNet: change.LiquidityNet})
+	}
+	s.Require().NoError(snapshot.ApplyChanges(s.Ctx.BlockHeight(), updatedPool.GetCurrentTick(), updatedPool.GetLiquidity(), tickChanges))
+
+	currentSnapshot, err := clKeeper.GetTickSnapshot(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(currentSnapshot, snapshot)
+
+	// The full tick map survives a binary round trip.
+	bz, err := types.EncodeTickSnapshot(currentSnapshot)
+	s.Require().NoError(err)
+	decoded, err := types.DecodeTickSnapshot(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(len(currentSnapshot.Ticks), len(decoded.Ticks))
+	for i, tick := range currentSnapshot.Ticks {
+		s.Require().Equal(tick.TickIndex, decoded.Ticks[i].TickIndex)
+		s.Require().True(tick.LiquidityNet.Equal(decoded.Ticks[i].LiquidityNet))
+	}
+}
+
+func (s *KeeperTestSuite) TestTickChangeLogPruning() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+
+	startHeight := s.Ctx.BlockHeight()
+	s.SetupDefaultPosition(pool.GetId())
+
+	// Changes are queryable until they fall out of the retention window.
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + types.TickChangeLogRetentionBlocks - 1)
+	changes, err := clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight-1)
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + types.TickChangeLogRetentionBlocks)
+	_, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight-1)
+	s.Require().ErrorIs(err, types.TickChangeLogPrunedError{PoolId: pool.GetId(), FromHeight: startHeight - 1, OldestHeight: startHeight + 1})
+
+	// Recording a new change prunes the changes out of the retention window.
+	pruneHeight := s.Ctx.BlockHeight()
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+	changes, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight)
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+	for _, change := range changes {
+		s.Require().Equal(pruneHeight, change.Height)
+	}
+
+	iterator := store.Iterator(types.KeyTickChangeLogPrefixByPoolId(pool.GetId()), types.KeyTickChangeLogByHeight(pool.GetId(), pruneHeight))
+	defer iterator.Close()
+	s.Require().False(iterator.Valid())
+
+	// Querying from a height older than the retention window still fails.
+	_, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId(), startHeight-1)
+	s.Require().Error(err)
+
+	// Changes of nonexistent pools cannot be queried.
+	_, err = clKeeper.GetTickLiquidityChanges(s.Ctx, pool.GetId()+1, startHeight)
+	s.Require().Error(err)
+
+	// Only ticks with non-zero liquidity net are part of the snapshot.
+	snapshot, err := clKeeper.GetTickSnapshot(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(snapshot.Ticks, 2)
+	for _, tick := range snapshot.Ticks {
+		s.Require().False(tick.LiquidityNet.IsZero())
+	}
+	s.Require().True(snapshot.CurrentLiquidity.Equal(DefaultLiquidityAmt.Mul(osmomath.NewDec(2))))
+}
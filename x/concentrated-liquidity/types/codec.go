@@ -19,10 +19,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceRangeOrder{}, "osmosis/cl-place-range-order", nil)
+	cdc.RegisterConcrete(&MsgCancelRangeOrder{}, "osmosis/cl-cancel-range-order", nil)
+	cdc.RegisterConcrete(&MsgClaimRangeOrder{}, "osmosis/cl-claim-range-order", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-position-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgRebalancePosition{}, "osmosis/cl-rebalance-position", nil)
+	cdc.RegisterConcrete(&MsgTokenizePosition{}, "osmosis/cl-tokenize-position", nil)
+	cdc.RegisterConcrete(&MsgRedeemPositionNFT{}, "osmosis/cl-redeem-position-nft", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&DynamicSpreadFactorProposal{}, "osmosis/cl-dynamic-spread-factor-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +47,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgPlaceRangeOrder{},
+		&MsgCancelRangeOrder{},
+		&MsgClaimRangeOrder{},
+		&MsgSetPositionAutoCompound{},
+		&MsgRebalancePosition{},
+		&MsgTokenizePosition{},
+		&MsgRedeemPositionNFT{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&DynamicSpreadFactorProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
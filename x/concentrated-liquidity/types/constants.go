@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
@@ -28,6 +30,17 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// Identifier of the epoch at the end of which the positions
+	// with auto-compounding enabled are compounded.
+	AutoCompoundEpochIdentifier = "day"
+	// Number of blocks for which the tick change log of a pool is retained.
+	// Clients that are further behind must resync from a full tick snapshot.
+	TickChangeLogRetentionBlocks int64 = 1000
+	// Maximum number of incentive tick ranges of a pool. Every tick range is updated whenever the uptime
+	// accumulators of the pool are updated, so their number is bounded.
+	MaxIncentiveTickRangesPerPool = 10
+	// Maximum number of positions removed from the incentive tick ranges that are pruned at the end of a block.
+	MaxIncentiveTickRangePositionsPrunedPerBlock uint64 = 100
 )
 
 var (
@@ -69,4 +82,20 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+
+	// By default, range orders in OSMO and USDC quoted pools must be worth at least 1 OSMO or 1 USDC.
+	DefaultRangeOrderMinNotional = sdk.NewCoins(
+		sdk.NewInt64Coin("uosmo", 1_000_000),
+		sdk.NewInt64Coin("ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", 1_000_000), // USDC
+	)
+	// Settling a range order withdraws a position, so the number of orders a single tick
+	// crossing can settle is bounded to keep the gas cost of swaps predictable.
+	DefaultMaxRangeOrdersPerTick = uint64(100)
+
+	// Compounding a position costs about as much as collecting its rewards, swapping and adding to it,
+	// so the number of positions compounded at the end of an epoch is bounded.
+	DefaultMaxAutoCompoundPositionsPerEpoch = uint64(500)
+	// The rebalancing swap of the rewards is small relative to the liquidity of the pools
+	// that are worth compounding in, so 3% covers the largest spread factor and taker fee.
+	DefaultAutoCompoundMaxSlippage = osmomath.MustNewDecFromStr("0.03")
 )
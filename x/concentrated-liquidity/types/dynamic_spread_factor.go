@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate returns an error if the dynamic spread factor record is invalid.
+// The bounds of a disabled record are not validated since they are ignored.
+func (r DynamicSpreadFactorRecord) Validate() error {
+	if r.PoolId == 0 {
+		return fmt.Errorf("pool id cannot be zero")
+	}
+
+	if !r.Enabled {
+		return nil
+	}
+
+	for _, spreadFactor := range []osmomath.Dec{r.MinSpreadFactor, r.MaxSpreadFactor} {
+		if spreadFactor.IsNil() || spreadFactor.IsNegative() || spreadFactor.GTE(osmomath.OneDec()) {
+			return InvalidSpreadFactorError{ActualSpreadFactor: spreadFactor}
+		}
+	}
+
+	if r.MinSpreadFactor.GT(r.MaxSpreadFactor) {
+		return DynamicSpreadFactorBoundsError{PoolId: r.PoolId, MinSpreadFactor: r.MinSpreadFactor, MaxSpreadFactor: r.MaxSpreadFactor}
+	}
+
+	if r.VolatilityMultiplier.IsNil() || r.VolatilityMultiplier.IsNegative() {
+		return NegativeVolatilityMultiplierError{PoolId: r.PoolId, VolatilityMultiplier: r.VolatilityMultiplier}
+	}
+
+	if r.VolatilityWindow <= 0 {
+		return NonPositiveVolatilityWindowError{PoolId: r.PoolId, VolatilityWindow: r.VolatilityWindow}
+	}
+
+	return nil
+}
+
+// SpreadFactor returns the spread factor for the given volatility.
+// That is, min spread factor + volatility multiplier * volatility, capped at the max spread factor.
+func (r DynamicSpreadFactorRecord) SpreadFactor(volatility osmomath.Dec) osmomath.Dec {
+	spreadFactor := r.MinSpreadFactor.Add(r.VolatilityMultiplier.Mul(volatility))
+	if spreadFactor.GT(r.MaxSpreadFactor) {
+		return r.MaxSpreadFactor
+	}
+	return spreadFactor
+}
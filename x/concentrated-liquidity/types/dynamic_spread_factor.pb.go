@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSpreadFactorRecord configures the dynamic spread factor of a pool.
+// When enabled, swaps in the pool are charged
+// min_spread_factor + volatility_multiplier * volatility, bounded by
+// min_spread_factor and max_spread_factor, instead of the fixed spread factor
+// of the pool. The volatility is the time weighted standard deviation of
+// log_{2} of the spot price of the pool over the volatility window, as
+// derived from the geometric TWAP accumulators of x/twap.
+type DynamicSpreadFactorRecord struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// enabled is false to return the pool to its fixed spread factor.
+	// The remaining fields are ignored in that case.
+	Enabled         bool                        `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	MinSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_spread_factor,json=minSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spread_factor" yaml:"min_spread_factor"`
+	MaxSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	// volatility_multiplier converts the volatility of the pool into the spread
+	// factor added on top of min_spread_factor.
+	VolatilityMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	// volatility_window is the period up to the current block over which the
+	// volatility is measured. It must not exceed the record history keep
+	// period of x/twap. The min_spread_factor applies while the pool has no
+	// TWAP records covering the whole window.
+	VolatilityWindow time.Duration `protobuf:"bytes,6,opt,name=volatility_window,json=volatilityWindow,proto3,stdduration" json:"volatility_window" yaml:"volatility_window"`
+}
+
+func (m *DynamicSpreadFactorRecord) Reset()         { *m = DynamicSpreadFactorRecord{} }
+func (m *DynamicSpreadFactorRecord) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorRecord) ProtoMessage()    {}
+func (*DynamicSpreadFactorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{0}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorRecord.Merge(m, src)
+}
+func (m *DynamicSpreadFactorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorRecord proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DynamicSpreadFactorRecord) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicSpreadFactorRecord) GetVolatilityWindow() time.Duration {
+	if m != nil {
+		return m.VolatilityWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSpreadFactorRecord)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto", fileDescriptor_81bebf9355d0ef5b)
+}
+
+var fileDescriptor_81bebf9355d0ef5b = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x5a, 0xbb, 0x1a, 0x41, 0xdd, 0xb0, 0x42, 0x76, 0x95, 0xa4, 0x04, 0x85, 0x82,
+	0xee, 0x0c, 0x5d, 0x6f, 0x7b, 0x11, 0x6b, 0x11, 0x04, 0xbd, 0xc4, 0x83, 0x20, 0x42, 0x99, 0xcc,
+	0xcc, 0x66, 0x87, 0x9d, 0xe4, 0xc5, 0x64, 0xd2, 0x4d, 0xbe, 0x85, 0x47, 0x8f, 0x7e, 0x12, 0xcf,
+	0x7b, 0xdc, 0xa3, 0x78, 0xa8, 0xd2, 0x5e, 0x3c, 0xef, 0x27, 0x90, 0x4e, 0x52, 0xdb, 0xda, 0x3d,
+	0xc8, 0xde, 0x66, 0xde, 0x7b, 0xf3, 0xff, 0xcd, 0x1f, 0xfe, 0xcf, 0x7a, 0x01, 0x79, 0x0c, 0xb9,
+	0xcc, 0x09, 0x83, 0x84, 0x89, 0x44, 0x67, 0x54, 0x0b, 0xae, 0xe4, 0xa7, 0x42, 0x72, 0xa9, 0x2b,
+	0x32, 0xee, 0x87, 0x42, 0xd3, 0x3e, 0xe1, 0x55, 0x42, 0x63, 0xc9, 0x46, 0x79, 0x9a, 0x09, 0xca,
+	0x47, 0x47, 0x94, 0x69, 0xc8, 0x70, 0x9a, 0x81, 0x06, 0xfb, 0x71, 0x23, 0x81, 0x2f, 0x95, 0xc0,
+	0x8d, 0xc4, 0xde, 0x4e, 0x04, 0x11, 0x98, 0x17, 0x64, 0x7e, 0xaa, 0x1f, 0xef, 0xb9, 0x11, 0x40,
+	0xa4, 0x04, 0x31, 0xb7, 0xb0, 0x38, 0x22, 0xbc, 0xc8, 0xa8, 0x96, 0x90, 0xd4, 0x7d, 0xff, 0x5b,
+	0xdb, 0xda, 0x1d, 0xd6, 0xf0, 0x77, 0x86, 0xfd, 0xca, 0xa0, 0x03, 0xc1, 0x20, 0xe3, 0xf6, 0x13,
+	0x6b, 0x2b, 0x05, 0x50, 0x23, 0xc9, 0x1d, 0xd4, 0x45, 0xbd, 0xf6, 0xc0, 0xbe, 0x98, 0x78, 0x77,
+	0x2a, 0x1a, 0xab, 0x43, 0xbf, 0x69, 0xf8, 0x41, 0x67, 0x7e, 0x7a, 0xcd, 0xed, 0xa7, 0xd6, 0x96,
+	0x48, 0x68, 0xa8, 0x04, 0x77, 0xae, 0x75, 0x51, 0xef, 0xe6, 0xea, 0x70, 0xd3, 0xf0, 0x83, 0xc5,
+	0x88, 0x7d, 0x62, 0x6d, 0xc7, 0x32, 0x59, 0x37, 0xec, 0x5c, 0xef, 0xa2, 0xde, 0xad, 0xc1, 0xf3,
+	0xb3, 0x89, 0xd7, 0xfa, 0x31, 0xf1, 0x1e, 0x30, 0xe3, 0x3c, 0xe7, 0x27, 0x58, 0x02, 0x89, 0xa9,
+	0x3e, 0xc6, 0x6f, 0x44, 0x44, 0x59, 0x35, 0x14, 0xec, 0x62, 0xe2, 0x39, 0xb5, 0xf4, 0x86, 0x8a,
+	0x1f, 0xdc, 0x8d, 0x65, 0xb2, 0xea, 0xc6, 0xc0, 0x68, 0xf9, 0x0f, 0xac, 0x7d, 0x15, 0x18, 0x2d,
+	0x37, 0x61, 0xb4, 0x5c, 0x83, 0x95, 0xd6, 0xfd, 0x31, 0x28, 0xaa, 0xa5, 0x92, 0xba, 0x1a, 0xc5,
+	0x85, 0xd2, 0x32, 0x55, 0x52, 0x64, 0xce, 0x0d, 0x03, 0x7c, 0xf9, 0x7f, 0xc0, 0x87, 0x35, 0xf0,
+	0x52, 0x25, 0x3f, 0xd8, 0x59, 0xd6, 0xdf, 0xfe, 0x2d, 0xdb, 0xca, 0xda, 0x5e, 0x99, 0x3f, 0x95,
+	0x09, 0x87, 0x53, 0xa7, 0xd3, 0x45, 0xbd, 0xdb, 0x07, 0xbb, 0xb8, 0x0e, 0x02, 0x5e, 0x04, 0x01,
+	0x0f, 0x9b, 0x20, 0x0c, 0x1e, 0xcd, 0x3f, 0xb4, 0xb4, 0xb8, 0xa1, 0xe0, 0x7f, 0xf9, 0xe9, 0xa1,
+	0xe0, 0xde, 0xb2, 0xfe, 0xde, 0x94, 0x0f, 0xdb, 0xbf, 0xbf, 0x7a, 0x68, 0xf0, 0xf1, 0x6c, 0xea,
+	0xa2, 0xf3, 0xa9, 0x8b, 0x7e, 0x4d, 0x5d, 0xf4, 0x79, 0xe6, 0xb6, 0xce, 0x67, 0x6e, 0xeb, 0xfb,
+	0xcc, 0x6d, 0x7d, 0x18, 0x44, 0x52, 0x1f, 0x17, 0x21, 0x66, 0x10, 0x93, 0x26, 0xc2, 0xfb, 0x8a,
+	0x86, 0xf9, 0xe2, 0x42, 0xc6, 0x07, 0x7d, 0x52, 0xae, 0x2d, 0xc6, 0xfe, 0x72, 0x33, 0x74, 0x95,
+	0x8a, 0x3c, 0xec, 0x98, 0xef, 0x3e, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xa5, 0xc0, 0xbb,
+	0x47, 0x03, 0x00, 0x00,
+}
+
+func (this *DynamicSpreadFactorRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorRecord)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.MinSpreadFactor.Equal(that1.MinSpreadFactor) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if !this.VolatilityMultiplier.Equal(that1.VolatilityMultiplier) {
+		return false
+	}
+	if this.VolatilityWindow != that1.VolatilityWindow {
+		return false
+	}
+	return true
+}
+func (m *DynamicSpreadFactorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VolatilityWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VolatilityWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSpreadFactor.Size()
+		i -= size
+		if _, err := m.MinSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSpreadFactor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSpreadFactor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSpreadFactorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.PoolId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VolatilityWindow)
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func sovDynamicSpreadFactor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSpreadFactor(x uint64) (n int) {
+	return sovDynamicSpreadFactor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSpreadFactorRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VolatilityWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSpreadFactor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSpreadFactor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSpreadFactor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSpreadFactor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSpreadFactor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSpreadFactor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSpreadFactor = fmt.Errorf("proto: unexpected end of group")
+)
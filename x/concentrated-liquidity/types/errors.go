@@ -930,3 +930,200 @@ type InvalidActionPrefixError struct {
 func (e InvalidActionPrefixError) Error() string {
 	return fmt.Sprintf("invalid action prefix (%s). Valid actions: %s", e.ActionPrefix, e.ValidActions)
 }
+
+type RangeOrderNotFoundError struct {
+	PositionId uint64
+}
+
+func (e RangeOrderNotFoundError) Error() string {
+	return fmt.Sprintf("range order backed by position ID (%d) not found", e.PositionId)
+}
+
+type RangeOrderFilledError struct {
+	PositionId uint64
+}
+
+func (e RangeOrderFilledError) Error() string {
+	return fmt.Sprintf("range order backed by position ID (%d) is already filled", e.PositionId)
+}
+
+type RangeOrderNotFilledError struct {
+	PositionId uint64
+}
+
+func (e RangeOrderNotFilledError) Error() string {
+	return fmt.Sprintf("range order backed by position ID (%d) is not filled yet", e.PositionId)
+}
+
+type NotRangeOrderOwnerError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e NotRangeOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of the range order backed by position ID (%d)", e.Address, e.PositionId)
+}
+
+type InvalidRangeOrderTicksError struct {
+	DenomIn     string
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+}
+
+func (e InvalidRangeOrderTicksError) Error() string {
+	return fmt.Sprintf("range order providing (%s) with lower tick (%d) and upper tick (%d) is not single sided at current tick (%d)", e.DenomIn, e.LowerTick, e.UpperTick, e.CurrentTick)
+}
+
+type RangeOrderBelowMinNotionalError struct {
+	Notional    sdk.Coin
+	MinNotional sdk.Coin
+}
+
+func (e RangeOrderBelowMinNotionalError) Error() string {
+	return fmt.Sprintf("range order notional (%s) is below the minimum notional (%s)", e.Notional, e.MinNotional)
+}
+
+type RangeOrderTickFullError struct {
+	PoolId         uint64
+	TickIndex      int64
+	MaxRangeOrders uint64
+}
+
+func (e RangeOrderTickFullError) Error() string {
+	return fmt.Sprintf("tick (%d) of pool (%d) already has the maximum number of range orders (%d) in this direction", e.TickIndex, e.PoolId, e.MaxRangeOrders)
+}
+
+type RangeOrderTickCountUnderflowError struct {
+	PoolId    uint64
+	TickIndex int64
+}
+
+func (e RangeOrderTickCountUnderflowError) Error() string {
+	return fmt.Sprintf("tick (%d) of pool (%d) has no range orders in this direction to decrement", e.TickIndex, e.PoolId)
+}
+
+type AutoCompoundLockedPositionError struct {
+	PositionId uint64
+	LockId     uint64
+}
+
+func (e AutoCompoundLockedPositionError) Error() string {
+	return fmt.Sprintf("cannot enable auto-compounding for position ID (%d) as it has an active underlying lock ID (%d)", e.PositionId, e.LockId)
+}
+
+type DynamicSpreadFactorBoundsError struct {
+	PoolId          uint64
+	MinSpreadFactor osmomath.Dec
+	MaxSpreadFactor osmomath.Dec
+}
+
+func (e DynamicSpreadFactorBoundsError) Error() string {
+	return fmt.Sprintf("min spread factor (%s) of the dynamic spread factor of pool ID (%d) is greater than its max spread factor (%s)", e.MinSpreadFactor, e.PoolId, e.MaxSpreadFactor)
+}
+
+type NegativeVolatilityMultiplierError struct {
+	PoolId               uint64
+	VolatilityMultiplier osmomath.Dec
+}
+
+func (e NegativeVolatilityMultiplierError) Error() string {
+	return fmt.Sprintf("volatility multiplier (%s) of the dynamic spread factor of pool ID (%d) is negative", e.VolatilityMultiplier, e.PoolId)
+}
+
+type NonPositiveVolatilityWindowError struct {
+	PoolId           uint64
+	VolatilityWindow time.Duration
+}
+
+func (e NonPositiveVolatilityWindowError) Error() string {
+	return fmt.Sprintf("volatility window (%s) of the dynamic spread factor of pool ID (%d) must be positive", e.VolatilityWindow, e.PoolId)
+}
+
+type RebalanceSameRangeError struct {
+	PositionId uint64
+	LowerTick  int64
+	UpperTick  int64
+}
+
+func (e RebalanceSameRangeError) Error() string {
+	return fmt.Sprintf("position ID (%d) is already in the tick range [%d, %d)", e.PositionId, e.LowerTick, e.UpperTick)
+}
+
+type RebalanceLastPositionInPoolError struct {
+	PoolId     uint64
+	PositionId uint64
+}
+
+func (e RebalanceLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("cannot rebalance a position if it is the last position in the pool. Pool id (%d), position ID (%d)", e.PoolId, e.PositionId)
+}
+
+type TickChangeLogPrunedError struct {
+	PoolId       uint64
+	FromHeight   int64
+	OldestHeight int64
+}
+
+func (e TickChangeLogPrunedError) Error() string {
+	return fmt.Sprintf("tick changes of pool ID (%d) since height (%d) are pruned, the oldest retained height is (%d), resync from a tick snapshot", e.PoolId, e.FromHeight, e.OldestHeight)
+}
+
+type InvalidTickSnapshotError struct {
+	Reason string
+}
+
+func (e InvalidTickSnapshotError) Error() string {
+	return fmt.Sprintf("invalid tick snapshot: %s", e.Reason)
+}
+
+type PositionNFTContractNotSetError struct{}
+
+func (e PositionNFTContractNotSetError) Error() string {
+	return "positions cannot be tokenized, the position NFT contract is not set"
+}
+
+type PositionNotTokenizedError struct {
+	PositionId uint64
+}
+
+func (e PositionNotTokenizedError) Error() string {
+	return fmt.Sprintf("position ID (%d) is not tokenized", e.PositionId)
+}
+
+type PositionNFTNotHeldError struct {
+	PositionId uint64
+	Address    string
+	Holder     string
+}
+
+func (e PositionNFTNotHeldError) Error() string {
+	return fmt.Sprintf("NFT of position ID (%d) is not held by (%s), holder (%s)", e.PositionId, e.Address, e.Holder)
+}
+
+type PositionNFTContractOutOfGasError struct {
+	GasLimit uint64
+}
+
+func (e PositionNFTContractOutOfGasError) Error() string {
+	return fmt.Sprintf("a single position NFT contract call cannot exceed %d gas", e.GasLimit)
+}
+
+type IncentiveTickRangeNotFoundError struct {
+	PoolId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e IncentiveTickRangeNotFoundError) Error() string {
+	return fmt.Sprintf("incentive tick range not found. pool id (%d), lower tick (%d), upper tick (%d)", e.PoolId, e.LowerTick, e.UpperTick)
+}
+
+type IncentiveTickRangeLimitError struct {
+	PoolId                 uint64
+	MaxIncentiveTickRanges int
+}
+
+func (e IncentiveTickRangeLimitError) Error() string {
+	return fmt.Sprintf("pool id (%d) already has the maximum number of incentive tick ranges (%d)", e.PoolId, e.MaxIncentiveTickRanges)
+}
@@ -13,6 +13,15 @@ const (
 	TypeEvtMoveRewards               = "move_rewards"
 	TypeEvtCrossTick                 = "cross_tick"
 	TypeEvtTransferPositions         = "transfer_positions"
+	TypeEvtPlaceRangeOrder           = "place_range_order"
+	TypeEvtFillRangeOrder            = "fill_range_order"
+	TypeEvtCancelRangeOrder          = "cancel_range_order"
+	TypeEvtClaimRangeOrder           = "claim_range_order"
+	TypeEvtSetPositionAutoCompound   = "set_position_auto_compound"
+	TypeEvtCompoundPosition          = "compound_position"
+	TypeEvtRebalancePosition         = "rebalance_position"
+	TypeEvtTokenizePosition          = "tokenize_position"
+	TypeEvtRedeemPositionNFT         = "redeem_position_nft"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -48,4 +57,8 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyDenomIn                                            = "denom_in"
+	AttributeKeyFillTick                                           = "fill_tick"
+	AttributeKeyEnabled                                            = "enabled"
+	AttributeKeyContract                                           = "contract"
 )
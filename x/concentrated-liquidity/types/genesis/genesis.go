@@ -25,5 +25,10 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, record := range gs.DynamicSpreadFactorRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -105,6 +105,8 @@ type PoolData struct {
 	IncentivesAccumulators  []AccumObject `protobuf:"bytes,4,rep,name=incentives_accumulators,json=incentivesAccumulators,proto3" json:"incentives_accumulators" yaml:"incentives_accumulator"`
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// incentive tick ranges of the incentive records restricted to a tick range
+	IncentiveTickRanges []IncentiveTickRangeData `protobuf:"bytes,6,rep,name=incentive_tick_ranges,json=incentiveTickRanges,proto3" json:"incentive_tick_ranges"`
 }
 
 func (m *PoolData) Reset()         { *m = PoolData{} }
@@ -175,6 +177,192 @@ func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
 	return nil
 }
 
+func (m *PoolData) GetIncentiveTickRanges() []IncentiveTickRangeData {
+	if m != nil {
+		return m.IncentiveTickRanges
+	}
+	return nil
+}
+
+// IncentiveTickRangeData represents an incentive tick range of a pool along
+// with its ticks, uptime accumulators and the records of its positions for
+// genesis state.
+type IncentiveTickRangeData struct {
+	IncentiveTickRange types1.IncentiveTickRange        `protobuf:"bytes,1,opt,name=incentive_tick_range,json=incentiveTickRange,proto3" json:"incentive_tick_range" yaml:"incentive_tick_range"`
+	Ticks              []IncentiveTickRangeTick         `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+	UptimeAccumulators []AccumObject                    `protobuf:"bytes,3,rep,name=uptime_accumulators,json=uptimeAccumulators,proto3" json:"uptime_accumulators" yaml:"uptime_accumulators"`
+	Positions          []IncentiveTickRangePositionData `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions" yaml:"positions"`
+}
+
+func (m *IncentiveTickRangeData) Reset()         { *m = IncentiveTickRangeData{} }
+func (m *IncentiveTickRangeData) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTickRangeData) ProtoMessage()    {}
+func (*IncentiveTickRangeData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+}
+func (m *IncentiveTickRangeData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTickRangeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTickRangeData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTickRangeData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTickRangeData.Merge(m, src)
+}
+func (m *IncentiveTickRangeData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTickRangeData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTickRangeData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTickRangeData proto.InternalMessageInfo
+
+func (m *IncentiveTickRangeData) GetIncentiveTickRange() types1.IncentiveTickRange {
+	if m != nil {
+		return m.IncentiveTickRange
+	}
+	return types1.IncentiveTickRange{}
+}
+
+func (m *IncentiveTickRangeData) GetTicks() []IncentiveTickRangeTick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *IncentiveTickRangeData) GetUptimeAccumulators() []AccumObject {
+	if m != nil {
+		return m.UptimeAccumulators
+	}
+	return nil
+}
+
+func (m *IncentiveTickRangeData) GetPositions() []IncentiveTickRangePositionData {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// IncentiveTickRangeTick contains the tick index of a tick of an incentive
+// tick range along with its tick info.
+type IncentiveTickRangeTick struct {
+	TickIndex int64                            `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	Info      model.IncentiveTickRangeTickInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info" yaml:"tick_info"`
+}
+
+func (m *IncentiveTickRangeTick) Reset()         { *m = IncentiveTickRangeTick{} }
+func (m *IncentiveTickRangeTick) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTickRangeTick) ProtoMessage()    {}
+func (*IncentiveTickRangeTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+}
+func (m *IncentiveTickRangeTick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTickRangeTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTickRangeTick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTickRangeTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTickRangeTick.Merge(m, src)
+}
+func (m *IncentiveTickRangeTick) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTickRangeTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTickRangeTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTickRangeTick proto.InternalMessageInfo
+
+func (m *IncentiveTickRangeTick) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *IncentiveTickRangeTick) GetInfo() model.IncentiveTickRangeTickInfo {
+	if m != nil {
+		return m.Info
+	}
+	return model.IncentiveTickRangeTickInfo{}
+}
+
+// IncentiveTickRangePositionData contains the records of a position in the
+// uptime accumulators of an incentive tick range.
+type IncentiveTickRangePositionData struct {
+	PositionId         uint64         `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	UptimeAccumRecords []accum.Record `protobuf:"bytes,2,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records"`
+}
+
+func (m *IncentiveTickRangePositionData) Reset()         { *m = IncentiveTickRangePositionData{} }
+func (m *IncentiveTickRangePositionData) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTickRangePositionData) ProtoMessage()    {}
+func (*IncentiveTickRangePositionData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
+}
+func (m *IncentiveTickRangePositionData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTickRangePositionData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTickRangePositionData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTickRangePositionData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTickRangePositionData.Merge(m, src)
+}
+func (m *IncentiveTickRangePositionData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTickRangePositionData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTickRangePositionData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTickRangePositionData proto.InternalMessageInfo
+
+func (m *IncentiveTickRangePositionData) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *IncentiveTickRangePositionData) GetUptimeAccumRecords() []accum.Record {
+	if m != nil {
+		return m.UptimeAccumRecords
+	}
+	return nil
+}
+
 type PositionData struct {
 	Position                *model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
@@ -186,7 +374,7 @@ func (m *PositionData) Reset()         { *m = PositionData{} }
 func (m *PositionData) String() string { return proto.CompactTextString(m) }
 func (*PositionData) ProtoMessage()    {}
 func (*PositionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{2}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{5}
 }
 func (m *PositionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,13 +440,21 @@ type GenesisState struct {
 	PositionData          []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
 	NextPositionId        uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
 	NextIncentiveRecordId uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	// range orders, including filled orders that are not claimed yet.
+	RangeOrders []types1.RangeOrder `protobuf:"bytes,6,rep,name=range_orders,json=rangeOrders,proto3" json:"range_orders"`
+	// IDs of the positions that have auto-compounding enabled.
+	AutoCompoundPositionIds []uint64 `protobuf:"varint,7,rep,packed,name=auto_compound_position_ids,json=autoCompoundPositionIds,proto3" json:"auto_compound_position_ids,omitempty" yaml:"auto_compound_position_ids"`
+	// dynamic spread factor records of the pools with a dynamic spread factor.
+	DynamicSpreadFactorRecords []types1.DynamicSpreadFactorRecord `protobuf:"bytes,8,rep,name=dynamic_spread_factor_records,json=dynamicSpreadFactorRecords,proto3" json:"dynamic_spread_factor_records"`
+	// records of the positions that are tokenized as NFTs.
+	PositionNftRecords []types1.PositionNFTRecord `protobuf:"bytes,10,rep,name=position_nft_records,json=positionNftRecords,proto3" json:"position_nft_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,6 +518,34 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRangeOrders() []types1.RangeOrder {
+	if m != nil {
+		return m.RangeOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundPositionIds() []uint64 {
+	if m != nil {
+		return m.AutoCompoundPositionIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicSpreadFactorRecords() []types1.DynamicSpreadFactorRecord {
+	if m != nil {
+		return m.DynamicSpreadFactorRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionNftRecords() []types1.PositionNFTRecord {
+	if m != nil {
+		return m.PositionNftRecords
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -332,7 +556,7 @@ func (m *AccumObject) Reset()         { *m = AccumObject{} }
 func (m *AccumObject) String() string { return proto.CompactTextString(m) }
 func (*AccumObject) ProtoMessage()    {}
 func (*AccumObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{7}
 }
 func (m *AccumObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,6 +602,9 @@ func (m *AccumObject) GetAccumContent() *accum.AccumulatorContent {
 func init() {
 	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
 	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
+	proto.RegisterType((*IncentiveTickRangeData)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveTickRangeData")
+	proto.RegisterType((*IncentiveTickRangeTick)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveTickRangeTick")
+	proto.RegisterType((*IncentiveTickRangePositionData)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveTickRangePositionData")
 	proto.RegisterType((*PositionData)(nil), "osmosis.concentratedliquidity.v1beta1.PositionData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.concentratedliquidity.v1beta1.GenesisState")
 	proto.RegisterType((*AccumObject)(nil), "osmosis.concentratedliquidity.v1beta1.AccumObject")
@@ -388,62 +615,84 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xee, 0x34, 0x69, 0x69, 0x9d, 0xec, 0xd2, 0xb5, 0xba, 0x74, 0xb6, 0x68, 0x93, 0xe0, 0x55,
-	0xa5, 0x02, 0xea, 0x8c, 0x9a, 0xae, 0x38, 0x20, 0x2e, 0x9d, 0xe5, 0x43, 0x01, 0x09, 0x2a, 0xb3,
-	0x5c, 0xf8, 0x0a, 0xce, 0xd8, 0x0d, 0x66, 0x67, 0xc6, 0xc3, 0xd8, 0x29, 0xcd, 0x95, 0x5f, 0x80,
-	0x38, 0xf1, 0x23, 0x38, 0x22, 0x71, 0xe6, 0xb6, 0x42, 0x1c, 0xf6, 0xc8, 0x29, 0x42, 0xed, 0x3f,
-	0xc8, 0x2f, 0x40, 0xe3, 0x8f, 0x64, 0x52, 0xba, 0x90, 0x72, 0xb3, 0xf3, 0xbc, 0xcf, 0xf3, 0x3e,
-	0xf6, 0xfb, 0xbe, 0x9e, 0x80, 0x23, 0x21, 0x53, 0x21, 0xb9, 0x0c, 0x63, 0x91, 0xc5, 0x2c, 0x53,
-	0x05, 0x51, 0x8c, 0x26, 0xfc, 0xdb, 0x11, 0xa7, 0x5c, 0x8d, 0xc3, 0xb3, 0xc3, 0x01, 0x53, 0xe4,
-	0x30, 0x1c, 0xb2, 0x8c, 0x49, 0x2e, 0x83, 0xbc, 0x10, 0x4a, 0xc0, 0x3d, 0x4b, 0x0a, 0xae, 0x25,
-	0x05, 0x96, 0xb4, 0xbb, 0x3d, 0x14, 0x43, 0xa1, 0x19, 0x61, 0xb9, 0x32, 0xe4, 0xdd, 0x7b, 0xb1,
-	0x66, 0xf7, 0x0d, 0x60, 0x36, 0x16, 0x6a, 0x99, 0x5d, 0x38, 0x20, 0x92, 0xcd, 0x52, 0xc7, 0x82,
-	0x67, 0x8e, 0x3a, 0x14, 0x62, 0x98, 0xb0, 0x50, 0xef, 0x06, 0xa3, 0xd3, 0x90, 0x64, 0x63, 0x0b,
-	0xbd, 0xe2, 0xce, 0x41, 0xe2, 0x78, 0x94, 0xce, 0xc8, 0x7a, 0x67, 0x43, 0x5e, 0xfb, 0xf7, 0xa3,
-	0xe6, 0xa4, 0x20, 0xa9, 0x73, 0xf2, 0x70, 0xb9, 0x6b, 0xc9, 0x85, 0xe4, 0x8a, 0x8b, 0xec, 0x66,
-	0x2c, 0xc5, 0xe3, 0x27, 0xbd, 0xec, 0xd4, 0x5d, 0xc8, 0x5b, 0xcb, 0xb1, 0xb8, 0x06, 0xf9, 0x19,
-	0xeb, 0x17, 0x2c, 0x16, 0x05, 0x35, 0x6c, 0xf4, 0x87, 0x07, 0x36, 0xde, 0x1d, 0x25, 0xc9, 0x63,
-	0x1e, 0x3f, 0x81, 0xaf, 0x83, 0x17, 0x72, 0x21, 0x92, 0x3e, 0xa7, 0xbe, 0xd7, 0xf1, 0xf6, 0xeb,
-	0x11, 0x9c, 0x4e, 0xda, 0xb7, 0xc7, 0x24, 0x4d, 0xde, 0x44, 0x16, 0x40, 0x78, 0xbd, 0x5c, 0xf5,
-	0x28, 0x7c, 0x08, 0x40, 0xe9, 0xa4, 0xcf, 0x33, 0xca, 0xce, 0xfd, 0xd5, 0x8e, 0xb7, 0x5f, 0x8b,
-	0xee, 0x4e, 0x27, 0xed, 0x3b, 0x26, 0x7e, 0x8e, 0x21, 0xbc, 0x69, 0x2c, 0x53, 0x76, 0x0e, 0xbf,
-	0x00, 0x75, 0x9e, 0x9d, 0x0a, 0xbf, 0xd6, 0xf1, 0xf6, 0x1b, 0xdd, 0x30, 0x58, 0xaa, 0x15, 0x82,
-	0xc7, 0xf6, 0xc8, 0x91, 0xff, 0x74, 0xd2, 0x5e, 0x99, 0x4e, 0xda, 0x5b, 0x0b, 0x49, 0x4e, 0x05,
-	0xc2, 0x5a, 0x16, 0xfd, 0x5a, 0x07, 0x1b, 0x27, 0x42, 0x24, 0x6f, 0x13, 0x45, 0xe0, 0x11, 0xa8,
-	0x97, 0x5e, 0xf5, 0x59, 0x1a, 0xdd, 0xed, 0xc0, 0x94, 0x3f, 0x70, 0xe5, 0x0f, 0x8e, 0xb3, 0x71,
-	0xb4, 0xf9, 0xfb, 0x2f, 0x07, 0x6b, 0x25, 0xa3, 0x87, 0x75, 0x30, 0xfc, 0x0c, 0xac, 0x95, 0xaa,
-	0xd2, 0x5f, 0xed, 0xd4, 0x6e, 0xe0, 0xd0, 0xdd, 0x61, 0xb4, 0x6d, 0x1d, 0x36, 0xe7, 0x0e, 0x25,
-	0xc2, 0x46, 0x13, 0xfe, 0xe4, 0x81, 0x7b, 0x32, 0x2f, 0x18, 0xa1, 0xfd, 0x82, 0x7d, 0x47, 0x0a,
-	0xda, 0xd7, 0x1d, 0x36, 0x4a, 0x88, 0x12, 0x85, 0xbd, 0x93, 0xee, 0x92, 0x19, 0x8f, 0x4b, 0xe6,
-	0x47, 0x83, 0x6f, 0x58, 0xac, 0xa2, 0x7d, 0x9b, 0xb4, 0x63, 0x92, 0x3e, 0x37, 0x05, 0xc2, 0x3b,
-	0x06, 0xc3, 0x1a, 0x3a, 0x9e, 0x23, 0xf0, 0x47, 0x0f, 0xec, 0xcc, 0x7a, 0x44, 0x56, 0x49, 0xd2,
-	0xaf, 0xeb, 0xab, 0xf8, 0x3f, 0xc6, 0xf6, 0xac, 0xb1, 0xfb, 0xc6, 0xd8, 0xf5, 0x09, 0x10, 0x7e,
-	0x69, 0x0e, 0x54, 0x3c, 0x49, 0xc8, 0xc1, 0x9d, 0xab, 0x7d, 0x2b, 0xfd, 0x35, 0xed, 0xe6, 0x8d,
-	0x25, 0xdd, 0xf4, 0x1c, 0x1f, 0x6b, 0x7a, 0x54, 0x2f, 0x1d, 0xe1, 0x2d, 0xbe, 0xf8, 0xb3, 0x44,
-	0xbf, 0xad, 0x82, 0xe6, 0x89, 0x9d, 0x47, 0xdd, 0x3d, 0x1f, 0x80, 0x0d, 0x37, 0x9f, 0xb6, 0x83,
-	0x96, 0xed, 0x05, 0x27, 0x83, 0x67, 0x02, 0xe5, 0x64, 0x25, 0xa2, 0xec, 0x55, 0xaa, 0x27, 0x65,
-	0x61, 0xb2, 0x2c, 0x80, 0xf0, 0x7a, 0xb9, 0xea, 0x51, 0xf8, 0x15, 0xd8, 0xbd, 0xa6, 0x82, 0xf6,
-	0xfc, 0xb6, 0x4b, 0xee, 0xcf, 0xbc, 0x98, 0x37, 0xca, 0xe5, 0x5e, 0x38, 0xe5, 0x3f, 0x8b, 0x6d,
-	0x60, 0xf8, 0x09, 0xd8, 0x1e, 0xe5, 0x8a, 0xa7, 0x6c, 0x41, 0xda, 0x15, 0x7a, 0x29, 0x6d, 0x68,
-	0x04, 0x2a, 0xaa, 0x12, 0xfd, 0x5c, 0x03, 0xcd, 0xf7, 0xcc, 0x53, 0xff, 0xb1, 0x22, 0x8a, 0xc1,
-	0x47, 0x60, 0xdd, 0xbc, 0x8b, 0xf6, 0x06, 0xf7, 0xfe, 0xe3, 0x06, 0x4f, 0x74, 0xb0, 0xcd, 0x60,
-	0xa9, 0x10, 0x83, 0x4d, 0xfd, 0xf8, 0x50, 0xa2, 0xc8, 0x0d, 0xa7, 0xd2, 0x3d, 0x05, 0x56, 0x71,
-	0x23, 0x77, 0x4f, 0xc3, 0x97, 0xe0, 0x96, 0xab, 0x8d, 0xd1, 0xad, 0x69, 0xdd, 0xa3, 0x1b, 0x56,
-	0xb8, 0xa2, 0xdd, 0xcc, 0xab, 0xcd, 0xf3, 0x0e, 0xd8, 0xca, 0xd8, 0xb9, 0xea, 0xcf, 0x92, 0x70,
-	0xea, 0xd7, 0x75, 0xe1, 0x5f, 0x9e, 0x4e, 0xda, 0x3b, 0xa6, 0xf0, 0x57, 0x23, 0x10, 0xbe, 0x5d,
-	0xfe, 0xe4, 0xc4, 0x7b, 0x14, 0x7e, 0x0e, 0x7c, 0x1d, 0x74, 0x75, 0x08, 0x4a, 0xb9, 0x35, 0x2d,
-	0xf7, 0x60, 0x3a, 0x69, 0xb7, 0x2b, 0x72, 0xd7, 0x44, 0x22, 0x7c, 0xb7, 0x84, 0xae, 0x0c, 0x42,
-	0x8f, 0xa2, 0xef, 0x3d, 0xd0, 0xa8, 0x0c, 0x2b, 0x7c, 0x00, 0xea, 0x19, 0x49, 0x99, 0xae, 0xd5,
-	0x66, 0xf4, 0xe2, 0x74, 0xd2, 0x6e, 0x58, 0x65, 0x92, 0x32, 0x84, 0x35, 0x08, 0x3f, 0x04, 0xb7,
-	0x4c, 0xcf, 0xc4, 0x22, 0x53, 0x2c, 0x53, 0xba, 0x9f, 0x1b, 0xdd, 0x57, 0x9f, 0xd3, 0x33, 0x95,
-	0x71, 0x7e, 0x64, 0x08, 0xb8, 0xa9, 0x23, 0xec, 0x2e, 0xa2, 0x4f, 0x2f, 0x5a, 0xde, 0xb3, 0x8b,
-	0x96, 0xf7, 0xd7, 0x45, 0xcb, 0xfb, 0xe1, 0xb2, 0xb5, 0xf2, 0xec, 0xb2, 0xb5, 0xf2, 0xe7, 0x65,
-	0x6b, 0xe5, 0xd3, 0xf7, 0x87, 0x5c, 0x7d, 0x3d, 0x1a, 0x04, 0xb1, 0x48, 0x43, 0x2b, 0x7e, 0x90,
-	0x90, 0x81, 0x74, 0x9b, 0xf0, 0xac, 0x7b, 0x18, 0x9e, 0x2f, 0x7c, 0xf6, 0x0e, 0xe6, 0xdf, 0x3d,
-	0x35, 0xce, 0x99, 0x74, 0x7f, 0x3c, 0x06, 0xeb, 0xfa, 0xd1, 0x3f, 0xfa, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x6e, 0x5f, 0x4a, 0xb0, 0x08, 0x00, 0x00,
+	// 1225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0xeb, 0x8c, 0xdd, 0x92, 0x4e, 0xdd, 0xc6, 0x75, 0x15, 0xdb, 0xdd, 0x28,
+	0x52, 0x00, 0xc5, 0x56, 0x92, 0x8a, 0x16, 0x04, 0x12, 0x71, 0xda, 0x20, 0x17, 0x29, 0x8d, 0xa6,
+	0xe1, 0x52, 0xfe, 0x2c, 0xe3, 0xdd, 0xb1, 0x19, 0x6a, 0xef, 0x98, 0x9d, 0x71, 0x1a, 0x1f, 0xb8,
+	0x70, 0x41, 0xdc, 0x2a, 0x4e, 0xdc, 0xf8, 0x0a, 0x1c, 0xb8, 0x23, 0x6e, 0x15, 0xe2, 0xd0, 0x23,
+	0x27, 0x0b, 0x25, 0x5f, 0x00, 0xf9, 0x13, 0xa0, 0x9d, 0x99, 0x5d, 0xaf, 0x1d, 0xa7, 0x5d, 0xa7,
+	0xb7, 0x9d, 0x79, 0xf3, 0x7b, 0xff, 0xe6, 0xf7, 0xde, 0x9b, 0x05, 0xdb, 0x8c, 0x77, 0x18, 0xa7,
+	0xbc, 0x6a, 0x33, 0xd7, 0x26, 0xae, 0xf0, 0xb0, 0x20, 0x4e, 0x9b, 0x7e, 0xd7, 0xa3, 0x0e, 0x15,
+	0xfd, 0xea, 0xd1, 0x66, 0x83, 0x08, 0xbc, 0x59, 0x6d, 0x11, 0x97, 0x70, 0xca, 0x2b, 0x5d, 0x8f,
+	0x09, 0x06, 0xd7, 0x34, 0xa8, 0x32, 0x15, 0x54, 0xd1, 0xa0, 0x42, 0xae, 0xc5, 0x5a, 0x4c, 0x22,
+	0xaa, 0xfe, 0x97, 0x02, 0x17, 0x6e, 0xda, 0x12, 0x6d, 0x29, 0x81, 0x5a, 0x68, 0x51, 0x51, 0xad,
+	0xaa, 0x0d, 0xcc, 0x49, 0x68, 0xda, 0x66, 0xd4, 0x0d, 0xa0, 0x2d, 0xc6, 0x5a, 0x6d, 0x52, 0x95,
+	0xab, 0x46, 0xaf, 0x59, 0xc5, 0x6e, 0x5f, 0x8b, 0x6e, 0x07, 0x71, 0x60, 0xdb, 0xee, 0x75, 0x42,
+	0xb0, 0x5c, 0xe9, 0x23, 0xef, 0xbc, 0x3a, 0xd4, 0x2e, 0xf6, 0x70, 0x27, 0xf0, 0xe4, 0x4e, 0xbc,
+	0xb4, 0x74, 0x19, 0xa7, 0x82, 0x32, 0x77, 0x36, 0x94, 0xa0, 0xf6, 0xd3, 0xba, 0xdb, 0x0c, 0x12,
+	0xf2, 0x61, 0x3c, 0x14, 0x95, 0x42, 0x7a, 0x44, 0x2c, 0x8f, 0xd8, 0xcc, 0x73, 0x34, 0xfa, 0x6e,
+	0x3c, 0xb4, 0x87, 0xdd, 0x16, 0xb1, 0x98, 0xe7, 0x10, 0x4f, 0x03, 0x77, 0xe2, 0x01, 0x9d, 0xbe,
+	0x8b, 0x3b, 0xd4, 0xb6, 0x78, 0xd7, 0x23, 0xd8, 0xb1, 0x9a, 0xd8, 0x16, 0x2c, 0x50, 0x71, 0x6f,
+	0xb6, 0x2c, 0x59, 0x6e, 0x53, 0x28, 0xa4, 0xf9, 0xb7, 0x01, 0xd2, 0x7b, 0xbd, 0x76, 0xfb, 0x90,
+	0xda, 0x4f, 0xe1, 0xbb, 0xe0, 0x52, 0x97, 0xb1, 0xb6, 0x45, 0x9d, 0xbc, 0x51, 0x36, 0xd6, 0x93,
+	0x35, 0x38, 0x1c, 0x94, 0xae, 0xf4, 0x71, 0xa7, 0xfd, 0x81, 0xa9, 0x05, 0x26, 0x4a, 0xf9, 0x5f,
+	0x75, 0x07, 0xde, 0x01, 0xc0, 0xcf, 0x9f, 0x45, 0x5d, 0x87, 0x1c, 0xe7, 0xe7, 0xcb, 0xc6, 0x7a,
+	0xa2, 0x76, 0x7d, 0x38, 0x28, 0x5d, 0x55, 0xe7, 0x47, 0x32, 0x13, 0x2d, 0xaa, 0x44, 0x3b, 0xe4,
+	0x18, 0x7e, 0x09, 0x92, 0xd4, 0x6d, 0xb2, 0x7c, 0xa2, 0x6c, 0xac, 0x67, 0xb6, 0xaa, 0x95, 0x58,
+	0x04, 0xae, 0x1c, 0xea, 0x8b, 0xaa, 0xe5, 0x5f, 0x0c, 0x4a, 0x73, 0xc3, 0x41, 0x69, 0x69, 0xcc,
+	0x48, 0x93, 0x99, 0x48, 0xaa, 0x35, 0x7f, 0x5b, 0x00, 0xe9, 0x03, 0xc6, 0xda, 0xf7, 0xb1, 0xc0,
+	0x70, 0x1b, 0x24, 0x7d, 0x5f, 0x65, 0x2c, 0x99, 0xad, 0x5c, 0x45, 0x91, 0xb6, 0x12, 0x90, 0xb6,
+	0xb2, 0xe3, 0xf6, 0x6b, 0x8b, 0x7f, 0xfd, 0xbe, 0xb1, 0xe0, 0x23, 0xea, 0x48, 0x1e, 0x86, 0x9f,
+	0x83, 0x05, 0x5f, 0x2b, 0xcf, 0xcf, 0x97, 0x13, 0x33, 0x78, 0x18, 0xe4, 0xb0, 0x96, 0xd3, 0x1e,
+	0x66, 0x47, 0x1e, 0x72, 0x13, 0x29, 0x9d, 0xf0, 0x17, 0x03, 0xdc, 0xd4, 0xf7, 0xe7, 0x91, 0x67,
+	0xd8, 0x73, 0x2c, 0x59, 0x17, 0xbd, 0x36, 0x16, 0xcc, 0xd3, 0x39, 0xd9, 0x8a, 0x69, 0x71, 0xc7,
+	0x47, 0x3e, 0x6a, 0x7c, 0x4b, 0x6c, 0x51, 0x5b, 0xd7, 0x46, 0xcb, 0xca, 0xe8, 0xb9, 0x26, 0x4c,
+	0xb4, 0xac, 0x64, 0x48, 0x8a, 0x76, 0x46, 0x12, 0xf8, 0xb3, 0x01, 0x96, 0x43, 0x66, 0xf3, 0x28,
+	0x88, 0xe7, 0x93, 0xe5, 0xc4, 0x05, 0x1d, 0x5b, 0xd3, 0x8e, 0xad, 0x28, 0xc7, 0xa6, 0x1b, 0x30,
+	0xd1, 0x8d, 0x91, 0x20, 0xe2, 0x13, 0x87, 0x14, 0x5c, 0x9d, 0xac, 0x36, 0x9e, 0x5f, 0x90, 0xde,
+	0xbc, 0x17, 0xd3, 0x9b, 0x7a, 0x80, 0x47, 0x12, 0x5e, 0x4b, 0xfa, 0x1e, 0xa1, 0x25, 0x3a, 0xbe,
+	0xcd, 0xe1, 0x33, 0x70, 0x7d, 0x64, 0x4a, 0xf2, 0x4a, 0x56, 0x2a, 0xcf, 0xa7, 0xa4, 0xb9, 0x8f,
+	0x66, 0x35, 0xe7, 0x93, 0x01, 0xf9, 0x1a, 0x7c, 0x2a, 0x6a, 0xab, 0xd7, 0xe8, 0x19, 0x29, 0x37,
+	0x7f, 0x4d, 0x82, 0x1b, 0xd3, 0x51, 0xf0, 0xb9, 0x01, 0x72, 0xd3, 0x9c, 0xd2, 0x8c, 0x7e, 0xff,
+	0xc2, 0x3e, 0xd5, 0x56, 0xf5, 0xbd, 0xdc, 0x9a, 0xb8, 0x97, 0x88, 0x11, 0x13, 0xc1, 0xb3, 0xee,
+	0x42, 0x3a, 0x5e, 0x1e, 0x17, 0x4f, 0xcb, 0xeb, 0x8b, 0xe5, 0x47, 0x03, 0x5c, 0xeb, 0x75, 0x05,
+	0xed, 0x90, 0x71, 0x36, 0x26, 0x2e, 0xcc, 0x46, 0x53, 0x9b, 0x2b, 0x28, 0x73, 0x53, 0x94, 0x9b,
+	0x08, 0xaa, 0xdd, 0x31, 0x1a, 0x7e, 0x0f, 0x16, 0x83, 0xd6, 0x19, 0x14, 0xc3, 0x83, 0x0b, 0x07,
+	0x7e, 0xa0, 0x35, 0x49, 0x5e, 0x4c, 0xf4, 0xb3, 0xd0, 0x8a, 0x89, 0x46, 0x16, 0xcd, 0x3f, 0x8c,
+	0x69, 0x0c, 0xf1, 0x3f, 0x26, 0x9a, 0xb0, 0x11, 0xb3, 0x09, 0x33, 0xdd, 0x84, 0xe7, 0x25, 0x8d,
+	0x76, 0xde, 0xe8, 0x0e, 0xe3, 0xb5, 0x65, 0x03, 0x14, 0x5f, 0x9d, 0x09, 0x78, 0x17, 0x64, 0xc2,
+	0xf1, 0x14, 0xce, 0x9f, 0x1b, 0xc3, 0x41, 0x09, 0x8e, 0xa7, 0x46, 0xce, 0x20, 0x10, 0xac, 0xea,
+	0x0e, 0xfc, 0x0c, 0xe4, 0xa2, 0x17, 0x19, 0xb6, 0x09, 0x45, 0xd0, 0x95, 0x30, 0x38, 0x29, 0x0d,
+	0x83, 0x19, 0xeb, 0x06, 0xd1, 0x3b, 0x57, 0x02, 0x6e, 0xfe, 0x39, 0x0f, 0xb2, 0x63, 0x0e, 0x7e,
+	0x0a, 0xd2, 0x81, 0x55, 0x5d, 0x7f, 0x71, 0x67, 0x43, 0xa0, 0x06, 0x85, 0x0a, 0xfc, 0x49, 0xdb,
+	0x66, 0x7e, 0x92, 0x9c, 0xfc, 0xfc, 0xe4, 0xa4, 0xd5, 0x02, 0x13, 0xa5, 0xfc, 0xaf, 0xba, 0x03,
+	0xbf, 0x06, 0x85, 0x29, 0x1d, 0x5d, 0x07, 0xaa, 0xa7, 0x46, 0xac, 0x38, 0xcf, 0x36, 0x7f, 0x25,
+	0x3e, 0x37, 0x87, 0xc9, 0x37, 0xcb, 0xe1, 0x7f, 0x29, 0x90, 0xfd, 0x44, 0x3d, 0x58, 0x1f, 0x0b,
+	0x2c, 0x08, 0xdc, 0x05, 0x29, 0xf5, 0xba, 0xd3, 0x19, 0x5c, 0x7b, 0x4d, 0x06, 0x0f, 0xe4, 0x61,
+	0x6d, 0x41, 0x43, 0x21, 0xf2, 0xab, 0x91, 0xb5, 0x2d, 0x07, 0x0b, 0x3c, 0xe3, 0x94, 0x0e, 0x9e,
+	0x06, 0x5a, 0x63, 0xba, 0xab, 0xd7, 0xf0, 0x2b, 0x70, 0x39, 0x24, 0x98, 0xd4, 0xab, 0x9a, 0xcc,
+	0xf6, 0x8c, 0x37, 0x1c, 0xd1, 0x9d, 0xed, 0x46, 0xc9, 0xf3, 0x00, 0x2c, 0xb9, 0xe4, 0x58, 0x58,
+	0x51, 0x8a, 0x27, 0xe5, 0xc5, 0xdf, 0x1a, 0x0e, 0x4a, 0xcb, 0xea, 0xe2, 0x27, 0x4f, 0x98, 0xe8,
+	0x8a, 0xbf, 0x75, 0x30, 0xe2, 0xfa, 0x17, 0x20, 0x2f, 0x0f, 0x4d, 0x0e, 0x45, 0x5f, 0xdd, 0x82,
+	0x54, 0xb7, 0x3a, 0x1c, 0x94, 0x4a, 0x11, 0x75, 0x53, 0x4e, 0x9a, 0xe8, 0xba, 0x2f, 0x9a, 0x18,
+	0x8c, 0x75, 0x07, 0x3e, 0x01, 0xd9, 0xc8, 0xeb, 0x34, 0x98, 0x7c, 0x9b, 0x31, 0x73, 0x20, 0x4b,
+	0xfa, 0x91, 0x8f, 0xd4, 0x19, 0xc8, 0x78, 0xe1, 0x0e, 0x87, 0x0d, 0x50, 0xc0, 0x3d, 0xc1, 0x2c,
+	0x9b, 0x75, 0xba, 0xac, 0xe7, 0x3a, 0xd1, 0x38, 0x79, 0xfe, 0x52, 0x39, 0xb1, 0x9e, 0xac, 0xad,
+	0x0d, 0x07, 0xa5, 0xdb, 0xca, 0xf7, 0xf3, 0xcf, 0x9a, 0x68, 0xd9, 0x17, 0xee, 0x6a, 0xd9, 0x28,
+	0x39, 0x1c, 0xfe, 0x64, 0x80, 0x95, 0xa9, 0xaf, 0xe4, 0x90, 0xcf, 0x69, 0x19, 0xd1, 0xc7, 0x31,
+	0x23, 0xba, 0xaf, 0x74, 0x3d, 0x96, 0xaa, 0xf6, 0xa4, 0xa6, 0x31, 0xca, 0x17, 0x9c, 0xf3, 0x0e,
+	0x70, 0xd8, 0x05, 0xb9, 0xe8, 0x6b, 0x3b, 0xf4, 0x00, 0x48, 0x0f, 0xee, 0xcd, 0xc8, 0xab, 0xfd,
+	0xbd, 0xc3, 0xf1, 0x62, 0x0b, 0x74, 0xef, 0x37, 0x85, 0xb6, 0xf8, 0x30, 0x99, 0x5e, 0x5c, 0x02,
+	0xe6, 0x0f, 0x06, 0xc8, 0x44, 0x46, 0x1e, 0x5c, 0x05, 0x49, 0x17, 0x77, 0xd4, 0x8b, 0x61, 0xb1,
+	0xf6, 0xd6, 0x70, 0x50, 0xca, 0x68, 0x76, 0xe0, 0x0e, 0x31, 0x91, 0x14, 0xc2, 0x7d, 0x70, 0x59,
+	0xd5, 0xbd, 0xcd, 0x5c, 0x41, 0x5c, 0xa1, 0x07, 0xc3, 0xdb, 0xe7, 0xd4, 0x7d, 0x64, 0x36, 0xee,
+	0x2a, 0x00, 0xca, 0xca, 0x13, 0x7a, 0x55, 0x73, 0x5e, 0x9c, 0x14, 0x8d, 0x97, 0x27, 0x45, 0xe3,
+	0xdf, 0x93, 0xa2, 0xf1, 0xfc, 0xb4, 0x38, 0xf7, 0xf2, 0xb4, 0x38, 0xf7, 0xcf, 0x69, 0x71, 0xee,
+	0xc9, 0xc3, 0x16, 0x15, 0xdf, 0xf4, 0x1a, 0x15, 0x9b, 0x75, 0xaa, 0x5a, 0xf9, 0x46, 0x1b, 0x37,
+	0x78, 0xb0, 0xa8, 0x1e, 0x6d, 0x6d, 0x56, 0x8f, 0xc7, 0x7e, 0x63, 0x36, 0x46, 0xff, 0x31, 0xa2,
+	0xdf, 0x25, 0x3c, 0xf8, 0x05, 0x6e, 0xa4, 0xe4, 0x43, 0x7e, 0xfb, 0xff, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x0e, 0xe1, 0x92, 0x51, 0x3a, 0x0f, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -509,6 +758,20 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IncentiveTickRanges) > 0 {
+		for iNdEx := len(m.IncentiveTickRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveTickRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IncentiveRecords) > 0 {
 		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -576,7 +839,7 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PositionData) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveTickRangeData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -586,20 +849,20 @@ func (m *PositionData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PositionData) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveTickRangeData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveTickRangeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UptimeAccumRecords) > 0 {
-		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UptimeAccumRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -610,70 +873,10 @@ func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.SpreadRewardAccumRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.LockId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LockId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextIncentiveRecordId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.NextPositionId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPositionId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.PositionData) > 0 {
-		for iNdEx := len(m.PositionData) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UptimeAccumulators) > 0 {
+		for iNdEx := len(m.UptimeAccumulators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PositionData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UptimeAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -684,10 +887,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.PoolData) > 0 {
-		for iNdEx := len(m.PoolData) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PoolData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -699,7 +902,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.IncentiveTickRange.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -711,7 +914,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccumObject) Marshal() (dAtA []byte, err error) {
+func (m *IncentiveTickRangeTick) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -721,44 +924,319 @@ func (m *AccumObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccumObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *IncentiveTickRangeTick) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccumObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IncentiveTickRangeTick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AccumContent != nil {
-		{
-			size, err := m.AccumContent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TickIndex))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *IncentiveTickRangePositionData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveTickRangePositionData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveTickRangePositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeAccumRecords) > 0 {
+		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeAccumRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeAccumRecords) > 0 {
+		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeAccumRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.SpreadRewardAccumRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionNftRecords) > 0 {
+		for iNdEx := len(m.PositionNftRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionNftRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DynamicSpreadFactorRecords) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AutoCompoundPositionIds) > 0 {
+		dAtA9 := make([]byte, len(m.AutoCompoundPositionIds)*10)
+		var j8 int
+		for _, num := range m.AutoCompoundPositionIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintGenesis(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RangeOrders) > 0 {
+		for iNdEx := len(m.RangeOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextIncentiveRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PositionData) > 0 {
+		for iNdEx := len(m.PositionData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PoolData) > 0 {
+		for iNdEx := len(m.PoolData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccumContent != nil {
+		{
+			size, err := m.AccumContent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -811,9 +1289,76 @@ func (m *PoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
+	if len(m.IncentiveTickRanges) > 0 {
+		for _, e := range m.IncentiveTickRanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveTickRangeData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentiveTickRange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UptimeAccumulators) > 0 {
+		for _, e := range m.UptimeAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveTickRangeTick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.TickIndex))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IncentiveTickRangePositionData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.PositionId))
+	}
+	if len(m.UptimeAccumRecords) > 0 {
+		for _, e := range m.UptimeAccumRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PositionData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -864,6 +1409,31 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.RangeOrders) > 0 {
+		for _, e := range m.RangeOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundPositionIds) > 0 {
+		l = 0
+		for _, e := range m.AutoCompoundPositionIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.DynamicSpreadFactorRecords) > 0 {
+		for _, e := range m.DynamicSpreadFactorRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionNftRecords) > 0 {
+		for _, e := range m.PositionNftRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -938,11 +1508,435 @@ func (m *FullTick) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &types.Any{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, FullTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadRewardAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivesAccumulators = append(m.IncentivesAccumulators, AccumObject{})
+			if err := m.IncentivesAccumulators[len(m.IncentivesAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveTickRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveTickRanges = append(m.IncentiveTickRanges, IncentiveTickRangeData{})
+			if err := m.IncentiveTickRanges[len(m.IncentiveTickRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveTickRangeData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveTickRangeData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveTickRangeData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveTickRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveTickRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TickIndex = 0
+			m.Ticks = append(m.Ticks, IncentiveTickRangeTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeAccumulators", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -952,14 +1946,29 @@ func (m *FullTick) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeAccumulators = append(m.UptimeAccumulators, AccumObject{})
+			if err := m.UptimeAccumulators[len(m.UptimeAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -986,7 +1995,8 @@ func (m *FullTick) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, IncentiveTickRangePositionData{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1011,7 +2021,7 @@ func (m *FullTick) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolData) Unmarshal(dAtA []byte) error {
+func (m *IncentiveTickRangeTick) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1034,17 +2044,17 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolData: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveTickRangeTick: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveTickRangeTick: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
 			}
-			var msglen int
+			m.TickIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1054,31 +2064,14 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TickIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pool == nil {
-				m.Pool = &types.Any{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1105,49 +2098,65 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticks = append(m.Ticks, FullTick{})
-			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardAccumulator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveTickRangePositionData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpreadRewardAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesAccumulators", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveTickRangePositionData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveTickRangePositionData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			var msglen int
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1157,29 +2166,14 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IncentivesAccumulators = append(m.IncentivesAccumulators, AccumObject{})
-			if err := m.IncentivesAccumulators[len(m.IncentivesAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeAccumRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1206,8 +2200,8 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UptimeAccumRecords = append(m.UptimeAccumRecords, accum.Record{})
+			if err := m.UptimeAccumRecords[len(m.UptimeAccumRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1572,6 +2566,184 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeOrders = append(m.RangeOrders, types1.RangeOrder{})
+			if err := m.RangeOrders[len(m.RangeOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AutoCompoundPositionIds = append(m.AutoCompoundPositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AutoCompoundPositionIds) == 0 {
+					m.AutoCompoundPositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AutoCompoundPositionIds = append(m.AutoCompoundPositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundPositionIds", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactorRecords = append(m.DynamicSpreadFactorRecords, types1.DynamicSpreadFactorRecord{})
+			if err := m.DynamicSpreadFactorRecords[len(m.DynamicSpreadFactorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNftRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionNftRecords = append(m.PositionNftRecords, types1.PositionNFTRecord{})
+			if err := m.PositionNftRecords[len(m.PositionNftRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,16 +14,19 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeDynamicSpreadFactor             = "DynamicSpreadFactor"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeDynamicSpreadFactor)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &DynamicSpreadFactorProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +158,65 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewDynamicSpreadFactorProposal(title, description string, records []DynamicSpreadFactorRecord) govtypesv1.Content {
+	return &DynamicSpreadFactorProposal{
+		Title:       title,
+		Description: description,
+		Records:     records,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *DynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *DynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *DynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *DynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Records) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	poolIds := make(map[uint64]bool, len(p.Records))
+	for _, record := range p.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if poolIds[record.PoolId] {
+			return fmt.Errorf("duplicate record for pool id %d", record.PoolId)
+		}
+		poolIds[record.PoolId] = true
+	}
+	return nil
+}
+
+// String returns a string containing the dynamic spread factor proposal.
+func (p DynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.Records {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, Enabled: %t, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityMultiplier: %s, VolatilityWindow: %s) ",
+			record.PoolId, record.Enabled, record.MinSpreadFactor, record.MaxSpreadFactor, record.VolatilityMultiplier, record.VolatilityWindow)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Dynamic Spread Factor Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
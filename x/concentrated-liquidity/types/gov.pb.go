@@ -109,6 +109,47 @@ func (m *TickSpacingDecreaseProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TickSpacingDecreaseProposal proto.InternalMessageInfo
 
+// DynamicSpreadFactorProposal is a gov Content type for enabling, updating or
+// disabling the dynamic spread factor of pools. The proposal will fail if one
+// of the pools does not exist.
+type DynamicSpreadFactorProposal struct {
+	Title       string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Records     []DynamicSpreadFactorRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+}
+
+func (m *DynamicSpreadFactorProposal) Reset()      { *m = DynamicSpreadFactorProposal{} }
+func (*DynamicSpreadFactorProposal) ProtoMessage() {}
+func (*DynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{2}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *DynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorProposal proto.InternalMessageInfo
+
 // PoolIdToTickSpacingRecord is a struct that contains a pool id to new tick
 // spacing pair.
 type PoolIdToTickSpacingRecord struct {
@@ -120,7 +161,7 @@ func (m *PoolIdToTickSpacingRecord) Reset()         { *m = PoolIdToTickSpacingRe
 func (m *PoolIdToTickSpacingRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolIdToTickSpacingRecord) ProtoMessage()    {}
 func (*PoolIdToTickSpacingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{2}
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
 }
 func (m *PoolIdToTickSpacingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +215,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +268,7 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
+	proto.RegisterType((*DynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
@@ -236,42 +278,45 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x5b, 0x27, 0xc0, 0x25, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84, 0x14,
-	0x86, 0xda, 0xb8, 0x6c, 0x61, 0x41, 0x69, 0x85, 0x04, 0xea, 0x50, 0x99, 0x4e, 0x08, 0x29, 0x5c,
-	0xce, 0x87, 0x7b, 0x8a, 0xe3, 0xe7, 0xfa, 0xae, 0x29, 0xf9, 0x0f, 0x90, 0x60, 0x60, 0x64, 0xcc,
-	0x9f, 0xd3, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0xbf, 0x00, 0xe5, 0x2e, 0x3f, 0x9c,
-	0x42, 0x25, 0x10, 0x9b, 0xef, 0xee, 0xfb, 0xbe, 0xf7, 0x7d, 0xef, 0xf9, 0x21, 0x0f, 0x78, 0x0f,
-	0x38, 0xe3, 0x1e, 0x81, 0x84, 0xd0, 0x44, 0x64, 0x58, 0xd0, 0x30, 0x66, 0x67, 0xe7, 0x2c, 0x64,
-	0x62, 0xe0, 0xf5, 0xfd, 0x0e, 0x15, 0xd8, 0xf7, 0x22, 0xe8, 0xbb, 0x69, 0x06, 0x02, 0xcc, 0x87,
-	0x73, 0x82, 0xfb, 0x47, 0x82, 0x3b, 0x27, 0xec, 0x56, 0x22, 0x88, 0x40, 0x32, 0xbc, 0xd9, 0x97,
-	0x22, 0x3b, 0x13, 0x1d, 0x35, 0x0e, 0x32, 0x8a, 0x05, 0x3d, 0xc8, 0xb1, 0x8f, 0x16, 0xec, 0x63,
-	0x80, 0x98, 0x1f, 0x67, 0x90, 0x02, 0xc7, 0xb1, 0x59, 0x41, 0x05, 0xc1, 0x44, 0x4c, 0xab, 0x7a,
-	0x5d, 0x6f, 0xdc, 0x09, 0xd4, 0xc1, 0xac, 0xa3, 0x52, 0x48, 0x39, 0xc9, 0x58, 0x2a, 0x18, 0x24,
-	0xd5, 0x0d, 0xf9, 0x96, 0xbf, 0x32, 0xcf, 0x50, 0x39, 0x05, 0x88, 0xdb, 0x19, 0x25, 0x90, 0x85,
-	0xbc, 0xba, 0x59, 0xdf, 0x6c, 0x94, 0xf6, 0x7d, 0xf7, 0xaf, 0x8c, 0xbb, 0x33, 0x0f, 0x81, 0x64,
-	0xb6, 0x6a, 0x97, 0x23, 0x5b, 0x9b, 0x8e, 0xec, 0xfb, 0x03, 0xdc, 0x8b, 0x9b, 0x4e, 0x5e, 0xd4,
-	0x09, 0x4a, 0xe9, 0x12, 0xc8, 0x9b, 0xe5, 0x0f, 0x43, 0x5b, 0xfb, 0x32, 0xb4, 0xb5, 0x1f, 0x43,
-	0x5b, 0x77, 0x7e, 0xea, 0xa8, 0x76, 0xc2, 0x48, 0xf7, 0x55, 0x8a, 0x09, 0x4b, 0xa2, 0x43, 0x4a,
-	0x32, 0x8a, 0x39, 0xfd, 0xef, 0x60, 0x1f, 0x75, 0x64, 0x4b, 0x13, 0x2c, 0x6c, 0x0b, 0x68, 0x0b,
-	0x46, 0xba, 0x6d, 0xae, 0x6a, 0x5c, 0x0b, 0xfb, 0xec, 0x1f, 0xc2, 0xbe, 0x08, 0x4f, 0x20, 0xe7,
-	0x76, 0x9e, 0xdd, 0x98, 0x65, 0x0f, 0x76, 0xd3, 0x9b, 0x00, 0xd7, 0x33, 0x87, 0xe8, 0xc1, 0x8d,
-	0x62, 0xe6, 0x0e, 0xba, 0x35, 0xf7, 0x2d, 0x23, 0x1b, 0x41, 0x51, 0xe9, 0x9a, 0x0d, 0xb4, 0x9d,
-	0xd0, 0x8b, 0xb5, 0x24, 0x32, 0xb8, 0x11, 0xdc, 0x4d, 0xe8, 0x45, 0x4e, 0xa8, 0x69, 0xc8, 0x2a,
-	0x9f, 0x36, 0x10, 0x5a, 0x0d, 0xc8, 0x7c, 0x84, 0x8a, 0x21, 0x4d, 0xa0, 0xf7, 0x58, 0x75, 0xb2,
-	0x75, 0x6f, 0x3a, 0xb2, 0xb7, 0xd4, 0xb0, 0xd4, 0xbd, 0x13, 0xcc, 0x01, 0x4b, 0xa8, 0xaf, 0x1a,
-	0xfb, 0x1b, 0xd4, 0x5f, 0x40, 0x7d, 0xb3, 0x89, 0xca, 0x6b, 0x86, 0x36, 0x67, 0x86, 0x5a, 0x3b,
-	0xab, 0x1f, 0x21, 0xff, 0xea, 0x04, 0x25, 0xb1, 0xb2, 0x69, 0xbe, 0x45, 0x5b, 0x3c, 0xcd, 0x28,
-	0x0e, 0xdb, 0xef, 0x30, 0x11, 0x90, 0x55, 0x0b, 0xb2, 0xda, 0xd3, 0x59, 0x37, 0xbf, 0x8d, 0xec,
-	0x1a, 0x91, 0x73, 0xe1, 0x61, 0xd7, 0x65, 0xe0, 0xf5, 0xb0, 0x38, 0x75, 0x8f, 0x68, 0x84, 0xc9,
-	0xe0, 0x90, 0x92, 0xe9, 0xc8, 0xae, 0x28, 0xfd, 0x35, 0x05, 0x27, 0x28, 0xab, 0xf3, 0x73, 0x79,
-	0x54, 0x8d, 0x78, 0x69, 0xdc, 0x36, 0xb6, 0x0b, 0xad, 0x37, 0x97, 0x63, 0x4b, 0xbf, 0x1a, 0x5b,
-	0xfa, 0xf7, 0xb1, 0xa5, 0x7f, 0x9e, 0x58, 0xda, 0xd5, 0xc4, 0xd2, 0xbe, 0x4e, 0x2c, 0xed, 0x75,
-	0x2b, 0x62, 0xe2, 0xf4, 0xbc, 0xe3, 0x12, 0xe8, 0x2d, 0x36, 0x7c, 0x2f, 0xc6, 0x1d, 0xbe, 0x5c,
-	0xf7, 0xfe, 0xbe, 0xef, 0xbd, 0x5f, 0x5b, 0xfa, 0xbd, 0xd5, 0xd6, 0x8b, 0x41, 0x4a, 0x79, 0xa7,
-	0x28, 0x77, 0xf6, 0xc9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x5e, 0x54, 0x68, 0x23, 0x04,
-	0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0xd6, 0x4d, 0xe0, 0x92, 0xa2, 0x62, 0x22, 0x35, 0x24, 0x92, 0x1d, 0x59, 0x42,
+	0x0a, 0x43, 0x6d, 0x5c, 0xb6, 0xb0, 0x40, 0x1a, 0x21, 0x81, 0x3a, 0x54, 0x6e, 0x27, 0x84, 0xe4,
+	0x5e, 0xce, 0x87, 0x7b, 0x8a, 0xed, 0x73, 0x7d, 0xd7, 0x94, 0x7c, 0x03, 0x24, 0x18, 0x18, 0x19,
+	0xf3, 0x65, 0x90, 0x3a, 0x76, 0x44, 0x0c, 0x11, 0x4a, 0x16, 0x56, 0xf2, 0x09, 0x50, 0xce, 0x49,
+	0x63, 0x57, 0xa9, 0x54, 0xe8, 0x16, 0xdf, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b, 0x97, 0x07, 0x2c,
+	0xca, 0x42, 0xca, 0x08, 0xb3, 0x10, 0x8d, 0x10, 0x8e, 0x78, 0x02, 0x39, 0xf6, 0x02, 0x72, 0x7a,
+	0x46, 0x3c, 0xc2, 0x07, 0x56, 0xdf, 0xee, 0x62, 0x0e, 0x6d, 0xcb, 0xa7, 0x7d, 0x33, 0x4e, 0x28,
+	0xa7, 0xea, 0x93, 0x79, 0x82, 0xb9, 0x32, 0xc1, 0x9c, 0x27, 0xd4, 0x2a, 0x3e, 0xf5, 0xa9, 0xc8,
+	0xb0, 0x66, 0xbf, 0xd2, 0xe4, 0xda, 0xab, 0xdb, 0x55, 0xf3, 0x06, 0x11, 0x0c, 0x09, 0x72, 0x59,
+	0x9c, 0x60, 0xe8, 0xb9, 0x1f, 0x20, 0xe2, 0x34, 0x49, 0x25, 0x8c, 0x89, 0x0c, 0x9a, 0x7b, 0x09,
+	0x86, 0x1c, 0xef, 0x65, 0x34, 0xf6, 0x17, 0x1a, 0x07, 0x94, 0x06, 0xec, 0x20, 0xa1, 0x31, 0x65,
+	0x30, 0x50, 0x2b, 0x60, 0x83, 0x13, 0x1e, 0xe0, 0xaa, 0xdc, 0x90, 0x9b, 0xf7, 0x9d, 0xf4, 0x43,
+	0x6d, 0x80, 0x92, 0x87, 0x19, 0x4a, 0x48, 0xcc, 0x09, 0x8d, 0xaa, 0x6b, 0xe2, 0x2e, 0x7b, 0xa4,
+	0x9e, 0x82, 0x72, 0x4c, 0x69, 0xe0, 0x26, 0x18, 0xd1, 0xc4, 0x63, 0xd5, 0xf5, 0xc6, 0x7a, 0xb3,
+	0xb4, 0x6b, 0x9b, 0xb7, 0x62, 0x37, 0x67, 0x1e, 0x1c, 0x91, 0xd9, 0xae, 0x5f, 0x8c, 0x74, 0x69,
+	0x3a, 0xd2, 0x1f, 0x0d, 0x60, 0x18, 0xb4, 0x8c, 0xac, 0xa8, 0xe1, 0x94, 0xe2, 0xab, 0x40, 0xd6,
+	0x2a, 0x7f, 0x1a, 0xea, 0xd2, 0xb7, 0xa1, 0x2e, 0xfd, 0x1e, 0xea, 0xb2, 0xf1, 0x47, 0x06, 0xf5,
+	0x23, 0x82, 0x7a, 0x87, 0x31, 0x44, 0x24, 0xf2, 0x3b, 0x18, 0x25, 0x18, 0x32, 0x7c, 0x67, 0xb0,
+	0xcf, 0x32, 0xd0, 0x85, 0x09, 0xe2, 0xb9, 0x9c, 0xba, 0x9c, 0xa0, 0x9e, 0xcb, 0xd2, 0x1a, 0xd7,
+	0x60, 0x5f, 0xfe, 0x03, 0xec, 0x1b, 0xef, 0x88, 0x66, 0xdc, 0xce, 0xd9, 0x95, 0x19, 0xbb, 0x53,
+	0x8b, 0x6f, 0x0a, 0xb8, 0xce, 0xfc, 0x5d, 0x06, 0xf5, 0x4e, 0x3a, 0xf9, 0x43, 0x31, 0xf8, 0xd7,
+	0x62, 0xee, 0x77, 0x66, 0x3e, 0x06, 0xc5, 0xff, 0x43, 0x5b, 0x61, 0x26, 0x87, 0x56, 0x4c, 0x56,
+	0x72, 0x78, 0xe0, 0xf1, 0x8d, 0x4d, 0x51, 0xb7, 0x41, 0x71, 0xde, 0x7f, 0x81, 0xa1, 0x38, 0x85,
+	0xb4, 0x3f, 0x6a, 0x13, 0x6c, 0x45, 0xf8, 0x3c, 0x37, 0x11, 0x01, 0xa3, 0x38, 0x0f, 0x22, 0x7c,
+	0x9e, 0x11, 0x6a, 0x29, 0xa2, 0xca, 0x97, 0x35, 0x00, 0x96, 0x0f, 0x4d, 0x7d, 0x0a, 0x0a, 0x1e,
+	0x8e, 0x68, 0xf8, 0x2c, 0xed, 0x4e, 0xfb, 0xe1, 0x74, 0xa4, 0x6f, 0xa6, 0x8f, 0x2e, 0x3d, 0x37,
+	0x9c, 0x79, 0xc0, 0x55, 0xa8, 0x5d, 0x5d, 0x5b, 0x19, 0x6a, 0x2f, 0x42, 0x6d, 0xb5, 0x05, 0xca,
+	0x39, 0x43, 0xeb, 0x33, 0x43, 0xed, 0xed, 0xe5, 0x83, 0xce, 0xde, 0x1a, 0x4e, 0x89, 0x2f, 0x6d,
+	0xaa, 0xc7, 0x60, 0x33, 0xf7, 0xff, 0xad, 0x6e, 0x88, 0x6a, 0x2f, 0x66, 0xad, 0xfb, 0x39, 0xd2,
+	0xeb, 0x48, 0x0c, 0x81, 0x79, 0x3d, 0x93, 0x50, 0x2b, 0x84, 0xfc, 0xc4, 0xdc, 0xc7, 0x3e, 0x44,
+	0x83, 0x0e, 0x46, 0xd3, 0x91, 0x5e, 0x49, 0xf5, 0x73, 0x0a, 0x86, 0x53, 0x66, 0x99, 0x59, 0xa4,
+	0x8d, 0x78, 0xab, 0xdc, 0x53, 0xb6, 0x36, 0xda, 0xef, 0x2f, 0xc6, 0x9a, 0x7c, 0x39, 0xd6, 0xe4,
+	0x5f, 0x63, 0x4d, 0xfe, 0x3a, 0xd1, 0xa4, 0xcb, 0x89, 0x26, 0xfd, 0x98, 0x68, 0xd2, 0xbb, 0xb6,
+	0x4f, 0xf8, 0xc9, 0x59, 0xd7, 0x44, 0x34, 0x5c, 0x2c, 0xbb, 0x9d, 0x00, 0x76, 0xd9, 0xe2, 0xc3,
+	0xea, 0xef, 0xda, 0xd6, 0xc7, 0xdc, 0x46, 0xda, 0x59, 0xae, 0x24, 0x3e, 0x88, 0x31, 0xeb, 0x16,
+	0xc4, 0xee, 0x79, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x02, 0xaf, 0xa4, 0x61, 0x2e, 0x05, 0x00,
+	0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -344,6 +389,41 @@ func (this *TickSpacingDecreaseProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(&that1.Records[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -506,6 +586,57 @@ func (m *TickSpacingDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolIdToTickSpacingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -648,6 +779,29 @@ func (m *TickSpacingDecreaseProposal) Size() (n int) {
 	return n
 }
 
+func (m *DynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -987,6 +1141,154 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DynamicSpreadFactorRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -189,3 +190,97 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestDynamicSpreadFactorProposal_ValidateBasic(t *testing.T) {
+	baseRecord := types.DynamicSpreadFactorRecord{
+		PoolId:               1,
+		Enabled:              true,
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.0005"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: osmomath.MustNewDecFromStr("0.5"),
+		VolatilityWindow:     time.Hour,
+	}
+
+	tests := []struct {
+		name       string
+		modifyFunc func(types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord { return record },
+			expectPass: true,
+		},
+		{
+			name: "disabled record ignores bounds",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				return types.DynamicSpreadFactorRecord{PoolId: record.PoolId}
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero pool id",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.PoolId = 0
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative min spread factor",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MinSpreadFactor = osmomath.MustNewDecFromStr("-0.01")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "max spread factor of one",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MaxSpreadFactor = osmomath.OneDec()
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "min spread factor greater than max",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative volatility multiplier",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.VolatilityMultiplier = osmomath.MustNewDecFromStr("-1")
+				return record
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero volatility window",
+			modifyFunc: func(record types.DynamicSpreadFactorRecord) types.DynamicSpreadFactorRecord {
+				record.VolatilityWindow = 0
+				return record
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		records := []types.DynamicSpreadFactorRecord{test.modifyFunc(baseRecord)}
+
+		proposal := types.NewDynamicSpreadFactorProposal("title", "description", records)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+
+	// Duplicate records for the same pool are rejected.
+	proposal := types.NewDynamicSpreadFactorProposal("title", "description", []types.DynamicSpreadFactorRecord{baseRecord, baseRecord})
+	require.Error(t, proposal.ValidateBasic())
+}
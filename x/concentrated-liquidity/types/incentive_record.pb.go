@@ -117,6 +117,10 @@ type IncentiveRecordBody struct {
 	EmissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=emission_rate,json=emissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_rate" yaml:"emission_rate"`
 	// start_time is the time when the incentive starts distributing
 	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// tick_range restricts the incentive record to the positions whose range
+	// sits within it. Incentive records without a tick range are distributed to
+	// all in-range liquidity.
+	TickRange *TickRange `protobuf:"bytes,4,opt,name=tick_range,json=tickRange,proto3" json:"tick_range,omitempty" yaml:"tick_range"`
 }
 
 func (m *IncentiveRecordBody) Reset()         { *m = IncentiveRecordBody{} }
@@ -166,9 +170,133 @@ func (m *IncentiveRecordBody) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *IncentiveRecordBody) GetTickRange() *TickRange {
+	if m != nil {
+		return m.TickRange
+	}
+	return nil
+}
+
+// TickRange is a range of ticks bounding the positions that qualify for the
+// incentive records restricted to it. A position qualifies if its lower tick
+// is at least the lower tick of the range and its upper tick is at most the
+// upper tick of the range.
+type TickRange struct {
+	LowerTick int64 `protobuf:"varint,1,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,2,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *TickRange) Reset()         { *m = TickRange{} }
+func (m *TickRange) String() string { return proto.CompactTextString(m) }
+func (*TickRange) ProtoMessage()    {}
+func (*TickRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bef31b586e827443, []int{2}
+}
+func (m *TickRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickRange.Merge(m, src)
+}
+func (m *TickRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickRange proto.InternalMessageInfo
+
+func (m *TickRange) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *TickRange) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// IncentiveTickRange tracks the qualifying liquidity of the incentive records
+// of a pool that are restricted to a tick range. It is created alongside the
+// first incentive record restricted to the tick range and includes all of the
+// positions of the pool that qualify from then on.
+type IncentiveTickRange struct {
+	PoolId    uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TickRange TickRange `protobuf:"bytes,2,opt,name=tick_range,json=tickRange,proto3" json:"tick_range" yaml:"tick_range"`
+	// liquidity is the liquidity of the qualifying positions that are in range.
+	// Incentives restricted to the tick range are emitted to this liquidity only.
+	Liquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *IncentiveTickRange) Reset()         { *m = IncentiveTickRange{} }
+func (m *IncentiveTickRange) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTickRange) ProtoMessage()    {}
+func (*IncentiveTickRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bef31b586e827443, []int{3}
+}
+func (m *IncentiveTickRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTickRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTickRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTickRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTickRange.Merge(m, src)
+}
+func (m *IncentiveTickRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTickRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTickRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTickRange proto.InternalMessageInfo
+
+func (m *IncentiveTickRange) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *IncentiveTickRange) GetTickRange() TickRange {
+	if m != nil {
+		return m.TickRange
+	}
+	return TickRange{}
+}
+
 func init() {
 	proto.RegisterType((*IncentiveRecord)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecord")
 	proto.RegisterType((*IncentiveRecordBody)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordBody")
+	proto.RegisterType((*TickRange)(nil), "osmosis.concentratedliquidity.v1beta1.TickRange")
+	proto.RegisterType((*IncentiveTickRange)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveTickRange")
 }
 
 func init() {
@@ -176,43 +304,52 @@ func init() {
 }
 
 var fileDescriptor_bef31b586e827443 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x4b, 0x29, 0xea, 0xa6, 0x05, 0xe1, 0x14, 0x9a, 0x86, 0x62, 0x57, 0x16, 0x48, 0x15,
-	0x52, 0xd6, 0x4a, 0xb8, 0x05, 0x4e, 0x26, 0x97, 0x48, 0x3d, 0x59, 0x20, 0x10, 0x42, 0x32, 0x6b,
-	0xef, 0xe2, 0xae, 0x1a, 0x7b, 0x83, 0x77, 0x13, 0xe1, 0xb7, 0x28, 0x12, 0x07, 0x9e, 0x81, 0x27,
-	0xe9, 0xb1, 0x27, 0x84, 0x38, 0xa4, 0x28, 0x11, 0x2f, 0x90, 0x27, 0x40, 0xbb, 0x5e, 0xe7, 0xaf,
-	0x3d, 0x70, 0xb2, 0xbf, 0x9d, 0xf9, 0xe6, 0x9b, 0xf9, 0x76, 0x16, 0xbc, 0x64, 0x3c, 0x61, 0x9c,
-	0x72, 0x37, 0x62, 0x69, 0x44, 0x52, 0x91, 0x21, 0x41, 0x70, 0x9f, 0x7e, 0x1e, 0x52, 0x4c, 0x45,
-	0xee, 0x8e, 0x5a, 0x21, 0x11, 0xa8, 0xe5, 0x52, 0x15, 0xa4, 0x23, 0x12, 0x64, 0x24, 0x62, 0x19,
-	0x86, 0x83, 0x8c, 0x09, 0x66, 0x3e, 0xd5, 0x6c, 0x78, 0x23, 0x1b, 0x6a, 0x76, 0xe3, 0x20, 0x52,
-	0x79, 0x81, 0x22, 0xb9, 0x05, 0x28, 0x2a, 0x34, 0xf6, 0x62, 0x16, 0xb3, 0xe2, 0x5c, 0xfe, 0xe9,
-	0x53, 0x3b, 0x66, 0x2c, 0xee, 0x13, 0x57, 0xa1, 0x70, 0xf8, 0xc9, 0x15, 0x34, 0x21, 0x5c, 0xa0,
-	0x64, 0xa0, 0x13, 0xac, 0xf5, 0x04, 0x3c, 0xcc, 0x90, 0xa0, 0x2c, 0x2d, 0xe3, 0x85, 0x88, 0x1b,
-	0x22, 0x4e, 0xe6, 0x43, 0x44, 0x8c, 0xea, 0xb8, 0xf3, 0x73, 0x03, 0xdc, 0xeb, 0x95, 0x33, 0xf9,
-	0x6a, 0x24, 0xb3, 0x03, 0x76, 0x16, 0x63, 0x52, 0x5c, 0x37, 0x8e, 0x8c, 0xe3, 0x4d, 0x6f, 0x7f,
-	0x36, 0xb6, 0x6b, 0x39, 0x4a, 0xfa, 0x1d, 0x67, 0x39, 0xea, 0xf8, 0xd5, 0x39, 0xec, 0x61, 0x73,
-	0x1f, 0xdc, 0x19, 0x30, 0xd6, 0x97, 0xb4, 0x0d, 0x49, 0xf3, 0xb7, 0x24, 0xec, 0x61, 0xf3, 0x9b,
-	0x01, 0x1e, 0xac, 0x9b, 0x17, 0x84, 0x0c, 0xe7, 0xf5, 0xcd, 0x23, 0xe3, 0xb8, 0xda, 0xee, 0xc0,
-	0xff, 0xb2, 0x10, 0xae, 0x35, 0xeb, 0x31, 0x9c, 0x7b, 0x4f, 0x2e, 0xc6, 0x76, 0x65, 0x36, 0xb6,
-	0x0f, 0xd7, 0xdb, 0x5b, 0x92, 0x71, 0xfc, 0x1a, 0xbd, 0x4e, 0x35, 0xdf, 0x02, 0x90, 0xd0, 0x34,
-	0x18, 0x0e, 0xa4, 0xb1, 0xf5, 0xdb, 0xaa, 0x95, 0x03, 0x58, 0x98, 0x0a, 0x4b, 0x53, 0x61, 0x57,
-	0x9b, 0xea, 0x3d, 0xd6, 0x4a, 0xf7, 0x0b, 0xa5, 0x05, 0xd5, 0xf9, 0x7e, 0x65, 0x1b, 0xfe, 0x76,
-	0x42, 0xd3, 0x37, 0x05, 0xfe, 0xbb, 0x01, 0x6a, 0x37, 0xf4, 0x6a, 0x7e, 0x35, 0xc0, 0xdd, 0x8c,
-	0x24, 0x88, 0xa6, 0x34, 0x8d, 0x03, 0x79, 0x13, 0xca, 0xdf, 0x6a, 0xfb, 0x10, 0xea, 0x7d, 0x90,
-	0x57, 0x35, 0x1f, 0xb7, 0x4b, 0xa2, 0x57, 0x8c, 0xa6, 0xde, 0x89, 0x16, 0x7e, 0x58, 0x08, 0xaf,
-	0x56, 0xe0, 0xce, 0x8f, 0x2b, 0xfb, 0x59, 0x4c, 0xc5, 0xe9, 0x30, 0x84, 0x11, 0x4b, 0xf4, 0x66,
-	0xe9, 0x4f, 0x93, 0xe3, 0x33, 0x57, 0xe4, 0x03, 0xc2, 0xcb, 0x6a, 0xfe, 0xee, 0x9c, 0x2f, 0xa1,
-	0xf9, 0x11, 0xec, 0x92, 0x84, 0x72, 0x4e, 0x59, 0x1a, 0x48, 0xdb, 0xd5, 0xd5, 0x6d, 0x7b, 0x2f,
-	0xa4, 0xe6, 0xef, 0xb1, 0xfd, 0xa8, 0xa8, 0xc3, 0xf1, 0x19, 0xa4, 0xcc, 0x4d, 0x90, 0x38, 0x85,
-	0x27, 0x24, 0x46, 0x51, 0xde, 0x25, 0xd1, 0x6c, 0x6c, 0xef, 0x15, 0x2d, 0xad, 0x54, 0x70, 0xfc,
-	0x9d, 0x12, 0xfb, 0x48, 0x10, 0xf3, 0x1d, 0x00, 0x5c, 0xa0, 0x4c, 0x04, 0xca, 0xe6, 0x5b, 0x6a,
-	0xe0, 0xc6, 0x35, 0x9b, 0x5f, 0x97, 0xcb, 0xbd, 0xee, 0xf3, 0x82, 0xeb, 0x9c, 0x2b, 0x9f, 0xd5,
-	0x81, 0x4c, 0xf7, 0x3e, 0x5c, 0x4c, 0x2c, 0xe3, 0x72, 0x62, 0x19, 0x7f, 0x26, 0x96, 0x71, 0x3e,
-	0xb5, 0x2a, 0x97, 0x53, 0xab, 0xf2, 0x6b, 0x6a, 0x55, 0xde, 0x7b, 0x4b, 0x86, 0xe8, 0xdd, 0x6a,
-	0xf6, 0x51, 0xc8, 0x4b, 0xe0, 0x8e, 0xda, 0x2d, 0xf7, 0xcb, 0xca, 0x7b, 0x6f, 0x2e, 0x1e, 0xbc,
-	0x32, 0x2c, 0xdc, 0x52, 0xbd, 0x3d, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x91, 0xb7, 0x7d,
-	0x1e, 0x04, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xd4, 0x4e,
+	0x18, 0xdf, 0x2e, 0xfc, 0xf9, 0xa7, 0xc3, 0x8b, 0x5a, 0x40, 0x5e, 0xc4, 0x96, 0x4c, 0x34, 0x21,
+	0x1a, 0x5a, 0x41, 0x13, 0x13, 0xf4, 0x54, 0xb9, 0x90, 0x70, 0x6a, 0x20, 0x1a, 0x63, 0x52, 0xa7,
+	0xed, 0x50, 0xc6, 0xdd, 0x76, 0x6a, 0x67, 0x16, 0xdc, 0xbb, 0x1f, 0x00, 0x13, 0x0f, 0x7e, 0x06,
+	0x3f, 0x09, 0x17, 0x13, 0x4e, 0xc6, 0x78, 0x58, 0x0c, 0x7c, 0x83, 0xfd, 0x04, 0x66, 0xa6, 0xd3,
+	0x76, 0x77, 0xe1, 0x40, 0x3c, 0xb5, 0xbf, 0x79, 0x9e, 0xdf, 0xf3, 0xf2, 0x7b, 0x9e, 0x19, 0xf0,
+	0x92, 0xb2, 0x84, 0x32, 0xc2, 0x9c, 0x90, 0xa6, 0x21, 0x4e, 0x79, 0x8e, 0x38, 0x8e, 0xda, 0xe4,
+	0x63, 0x87, 0x44, 0x84, 0x77, 0x9d, 0xa3, 0x8d, 0x00, 0x73, 0xb4, 0xe1, 0x10, 0x69, 0x24, 0x47,
+	0xd8, 0xcf, 0x71, 0x48, 0xf3, 0xc8, 0xce, 0x72, 0xca, 0xa9, 0xf1, 0x50, 0xb1, 0xed, 0x6b, 0xd9,
+	0xb6, 0x62, 0x2f, 0x2f, 0x85, 0xd2, 0xcf, 0x97, 0x24, 0xa7, 0x00, 0x45, 0x84, 0xe5, 0xb9, 0x98,
+	0xc6, 0xb4, 0x38, 0x17, 0x7f, 0xea, 0xd4, 0x8a, 0x29, 0x8d, 0xdb, 0xd8, 0x91, 0x28, 0xe8, 0x1c,
+	0x38, 0x9c, 0x24, 0x98, 0x71, 0x94, 0x64, 0xca, 0xc1, 0x1c, 0x75, 0x88, 0x3a, 0x39, 0xe2, 0x84,
+	0xa6, 0xa5, 0xbd, 0x48, 0xe2, 0x04, 0x88, 0xe1, 0xaa, 0x89, 0x90, 0x12, 0x65, 0x87, 0x3f, 0x9b,
+	0xe0, 0xd6, 0x4e, 0xd9, 0x93, 0x27, 0x5b, 0x32, 0xb6, 0xc0, 0x54, 0xdd, 0x26, 0x89, 0x16, 0xb5,
+	0x55, 0x6d, 0x6d, 0xdc, 0x5d, 0xe8, 0xf7, 0xac, 0xd9, 0x2e, 0x4a, 0xda, 0x5b, 0x70, 0xd0, 0x0a,
+	0xbd, 0xc9, 0x0a, 0xee, 0x44, 0xc6, 0x02, 0xf8, 0x3f, 0xa3, 0xb4, 0x2d, 0x68, 0x4d, 0x41, 0xf3,
+	0x26, 0x04, 0xdc, 0x89, 0x8c, 0xaf, 0x1a, 0x98, 0x1f, 0x15, 0xcf, 0x0f, 0x68, 0xd4, 0x5d, 0x1c,
+	0x5f, 0xd5, 0xd6, 0x26, 0x37, 0xb7, 0xec, 0x1b, 0x49, 0x68, 0x8f, 0x14, 0xeb, 0xd2, 0xa8, 0xeb,
+	0x3e, 0x38, 0xed, 0x59, 0x8d, 0x7e, 0xcf, 0x5a, 0x19, 0x2d, 0x6f, 0x20, 0x0d, 0xf4, 0x66, 0xc9,
+	0x55, 0xaa, 0xf1, 0x1a, 0x80, 0x84, 0xa4, 0x7e, 0x27, 0x13, 0xc2, 0x2e, 0xfe, 0x27, 0x4b, 0x59,
+	0xb2, 0x0b, 0x51, 0xed, 0x52, 0x54, 0x7b, 0x5b, 0x89, 0xea, 0xde, 0x57, 0x99, 0xee, 0x14, 0x99,
+	0x6a, 0x2a, 0xfc, 0x76, 0x6e, 0x69, 0x9e, 0x9e, 0x90, 0x74, 0xbf, 0xc0, 0x3f, 0xc6, 0xc0, 0xec,
+	0x35, 0xb5, 0x1a, 0x5f, 0x34, 0x30, 0x93, 0xe3, 0x04, 0x91, 0x94, 0xa4, 0xb1, 0x2f, 0x26, 0x21,
+	0xf5, 0x9d, 0xdc, 0x5c, 0xb1, 0xd5, 0x3e, 0x88, 0x51, 0x55, 0xed, 0x6e, 0xe3, 0xf0, 0x15, 0x25,
+	0xa9, 0xbb, 0xab, 0x12, 0xdf, 0x2d, 0x12, 0x0f, 0x47, 0x60, 0xf0, 0xfb, 0xb9, 0xf5, 0x28, 0x26,
+	0xfc, 0xb0, 0x13, 0xd8, 0x21, 0x4d, 0xd4, 0x66, 0xa9, 0xcf, 0x3a, 0x8b, 0x5a, 0x0e, 0xef, 0x66,
+	0x98, 0x95, 0xd1, 0xbc, 0xe9, 0x8a, 0x2f, 0xa0, 0xf1, 0x1e, 0x4c, 0xe3, 0x84, 0x30, 0x46, 0x68,
+	0xea, 0x0b, 0xd9, 0xe5, 0xe8, 0x74, 0xf7, 0x85, 0xc8, 0xf9, 0xbb, 0x67, 0xdd, 0x2b, 0xe2, 0xb0,
+	0xa8, 0x65, 0x13, 0xea, 0x24, 0x88, 0x1f, 0xda, 0xbb, 0x38, 0x46, 0x61, 0x77, 0x1b, 0x87, 0xfd,
+	0x9e, 0x35, 0x57, 0x94, 0x34, 0x14, 0x01, 0x7a, 0x53, 0x25, 0xf6, 0x10, 0xc7, 0xc6, 0x1b, 0x00,
+	0x18, 0x47, 0x39, 0xf7, 0xa5, 0xcc, 0x63, 0xb2, 0xe1, 0xe5, 0x2b, 0x32, 0xef, 0x95, 0xcb, 0x3d,
+	0xaa, 0x73, 0xcd, 0x85, 0x27, 0x52, 0x67, 0x79, 0x20, 0xdc, 0x8d, 0x03, 0x00, 0x38, 0x09, 0x5b,
+	0x7e, 0x8e, 0xd2, 0x18, 0xab, 0x5d, 0x7a, 0x72, 0xc3, 0x5d, 0xda, 0x23, 0x61, 0xcb, 0x13, 0x3c,
+	0x77, 0xbe, 0xce, 0x55, 0x47, 0x83, 0x9e, 0xce, 0x4b, 0x0f, 0x78, 0x0c, 0xf4, 0xca, 0xdd, 0x78,
+	0x06, 0x40, 0x9b, 0x1e, 0xe3, 0xdc, 0x17, 0x76, 0x39, 0xbf, 0xb1, 0xc1, 0x10, 0xb5, 0x0d, 0x7a,
+	0xba, 0x04, 0x82, 0x2a, 0x58, 0x9d, 0x2c, 0x2b, 0x59, 0xcd, 0x51, 0x56, 0x6d, 0x83, 0x9e, 0x2e,
+	0x81, 0x60, 0xc1, 0xcf, 0x4d, 0x60, 0x54, 0x8b, 0x54, 0x97, 0xf0, 0xb8, 0xbe, 0x68, 0xc5, 0xfd,
+	0x34, 0xfa, 0x3d, 0x6b, 0xa6, 0x88, 0xa4, 0x0c, 0xb0, 0xba, 0x7c, 0x1f, 0x86, 0x44, 0x6a, 0xfe,
+	0xa3, 0x48, 0x4b, 0xc3, 0x43, 0xb9, 0x5e, 0x28, 0x63, 0x1f, 0xe8, 0x55, 0x10, 0x39, 0x69, 0xdd,
+	0x7d, 0x7e, 0xb3, 0x45, 0xba, 0xad, 0xd4, 0x2b, 0xd9, 0x42, 0xbc, 0xf2, 0xdf, 0x7d, 0x77, 0x7a,
+	0x61, 0x6a, 0x67, 0x17, 0xa6, 0xf6, 0xe7, 0xc2, 0xd4, 0x4e, 0x2e, 0xcd, 0xc6, 0xd9, 0xa5, 0xd9,
+	0xf8, 0x75, 0x69, 0x36, 0xde, 0xba, 0x03, 0x8b, 0xaf, 0x5a, 0x5a, 0x6f, 0xa3, 0x80, 0x95, 0xc0,
+	0x39, 0xda, 0xdc, 0x70, 0x3e, 0x0d, 0xbd, 0xeb, 0xeb, 0xf5, 0xc3, 0x2e, 0x2f, 0x46, 0x30, 0x21,
+	0x77, 0xf0, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x9b, 0x4d, 0xed, 0x06, 0x06, 0x00,
+	0x00,
 }
 
 func (m *IncentiveRecord) Marshal() (dAtA []byte, err error) {
@@ -286,12 +423,24 @@ func (m *IncentiveRecordBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	if m.TickRange != nil {
+		{
+			size, err := m.TickRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintIncentiveRecord(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -317,6 +466,87 @@ func (m *IncentiveRecordBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TickRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveTickRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveTickRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveTickRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TickRange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintIncentiveRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentiveRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentiveRecord(v)
 	base := offset
@@ -359,6 +589,41 @@ func (m *IncentiveRecordBody) Size() (n int) {
 	n += 1 + l + sovIncentiveRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovIncentiveRecord(uint64(l))
+	if m.TickRange != nil {
+		l = m.TickRange.Size()
+		n += 1 + l + sovIncentiveRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *TickRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LowerTick != 0 {
+		n += 1 + sovIncentiveRecord(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovIncentiveRecord(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *IncentiveTickRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIncentiveRecord(uint64(m.PoolId))
+	}
+	l = m.TickRange.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovIncentiveRecord(uint64(l))
 	return n
 }
 
@@ -651,6 +916,266 @@ func (m *IncentiveRecordBody) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TickRange == nil {
+				m.TickRange = &TickRange{}
+			}
+			if err := m.TickRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveTickRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentiveRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveTickRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveTickRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentiveRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentiveRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentiveRecord(dAtA[iNdEx:])
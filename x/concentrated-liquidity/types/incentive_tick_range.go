@@ -0,0 +1,16 @@
+package types
+
+// Validate returns an error if the lower tick of the tick range is not less than its upper tick.
+// The ticks are validated against the tick spacing of the pool when the incentive is created.
+func (r TickRange) Validate() error {
+	if r.LowerTick >= r.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: r.LowerTick, UpperTick: r.UpperTick}
+	}
+	return nil
+}
+
+// ContainsRange returns true if the range bounded by the given ticks sits within the tick range,
+// i.e. if the positions with this range qualify for the incentives restricted to the tick range.
+func (r TickRange) ContainsRange(lowerTick, upperTick int64) bool {
+	return lowerTick >= r.LowerTick && upperTick <= r.UpperTick
+}
@@ -50,6 +50,32 @@ var (
 	KeyTotalLiquidity     = []byte{0x13}
 	KeyContractHookPrefix = []byte{0x14}
 
+	RangeOrderPrefix            = []byte{0x15}
+	RangeOrderByTickIndexPrefix = []byte{0x16}
+
+	AutoCompoundPositionPrefix = []byte{0x17}
+
+	DynamicSpreadFactorRecordPrefix = []byte{0x18}
+
+	TickChangeLogPrefix = []byte{0x1A}
+
+	PositionNFTRecordPrefix = []byte{0x1B}
+
+	PositionJoinHeightPrefix = []byte{0x1C}
+
+	IncentiveTickRangePrefix                  = []byte{0x1D}
+	IncentiveTickRangeTickPrefix              = []byte{0x1E}
+	IncentiveTickRangeUptimeAccumulatorPrefix = []byte{0x1F}
+
+	KeyAutoCompoundCursor = []byte{0x20}
+
+	IncentiveTickRangeByTickPrefix  = []byte{0x21}
+	IncentiveTickRangePruningPrefix = []byte{0x22}
+
+	// RangeOrderEscrowPrefix is the prefix used to derive the address holding
+	// the positions and the filled tokens of the range orders of a pool.
+	RangeOrderEscrowPrefix = "rangeOrders"
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -302,3 +328,174 @@ func GetDenomPrefix(denom string) []byte {
 func GetPoolPrefixStoreKey(poolID uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d%s", KeyContractHookPrefix, poolID, KeySeparator))
 }
+
+// Range Order Prefix Keys
+
+// KeyRangeOrder returns the key used to store the range order backed by the given position id.
+func KeyRangeOrder(positionId uint64) []byte {
+	key := make([]byte, 0, len(RangeOrderPrefix)+uint64ByteSize)
+	key = append(key, RangeOrderPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// KeyRangeOrdersByTick returns the prefix key of the unfilled range orders of a pool that are filled
+// when a swap in the given direction crosses the given tick.
+// This key can be used to iterate over the orders to settle once the tick is crossed.
+func KeyRangeOrdersByTick(poolId uint64, zeroForOne bool, tickIndex int64) []byte {
+	directionBz := byte(0)
+	if zeroForOne {
+		directionBz = 1
+	}
+	key := make([]byte, 0, len(RangeOrderByTickIndexPrefix)+uint64ByteSize+1+9+uint64ByteSize)
+	key = append(key, RangeOrderByTickIndexPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, directionBz)
+	key = append(key, TickIndexToBytes(tickIndex)...)
+	return key
+}
+
+// KeyRangeOrderByTick returns the key used to index the unfilled range order backed by the given position id
+// by the tick that fills it.
+func KeyRangeOrderByTick(poolId uint64, zeroForOne bool, tickIndex int64, positionId uint64) []byte {
+	return append(KeyRangeOrdersByTick(poolId, zeroForOne, tickIndex), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// Auto Compound Prefix Keys
+
+// KeyAutoCompoundPosition returns the key used to flag the given position id for auto-compounding.
+func KeyAutoCompoundPosition(positionId uint64) []byte {
+	key := make([]byte, 0, len(AutoCompoundPositionPrefix)+uint64ByteSize)
+	key = append(key, AutoCompoundPositionPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// Dynamic Spread Factor Prefix Keys
+
+// KeyDynamicSpreadFactorRecord returns the key used to store the dynamic spread factor record of the given pool id.
+func KeyDynamicSpreadFactorRecord(poolId uint64) []byte {
+	key := make([]byte, 0, len(DynamicSpreadFactorRecordPrefix)+uint64ByteSize)
+	key = append(key, DynamicSpreadFactorRecordPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// Tick Change Log Prefix Keys
+
+// KeyTickChangeLogPrefixByPoolId returns the prefix of the tick change log of the given pool id.
+func KeyTickChangeLogPrefixByPoolId(poolId uint64) []byte {
+	key := make([]byte, 0, len(TickChangeLogPrefix)+uint64ByteSize)
+	key = append(key, TickChangeLogPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyTickChangeLogByHeight returns the prefix of the tick changes of the given pool id at the given height.
+// Since heights are big endian encoded, iterating from this prefix yields the changes at or after the height.
+func KeyTickChangeLogByHeight(poolId uint64, height int64) []byte {
+	return append(KeyTickChangeLogPrefixByPoolId(poolId), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// KeyTickChange returns the key used to store the liquidity net of the given tick of the given pool id
+// after it changed at the given height.
+func KeyTickChange(poolId uint64, height int64, tickIndex int64) []byte {
+	return append(KeyTickChangeLogByHeight(poolId, height), TickIndexToBytes(tickIndex)...)
+}
+
+// Position NFT Prefix Keys
+
+// KeyPositionNFTRecord returns the key used to store the NFT record of the given tokenized position id.
+func KeyPositionNFTRecord(positionId uint64) []byte {
+	key := make([]byte, 0, len(PositionNFTRecordPrefix)+uint64ByteSize)
+	key = append(key, PositionNFTRecordPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// Position Join Height Prefix Keys
+
+// KeyPositionJoinHeight returns the key used to store the height at which the given position id was created.
+func KeyPositionJoinHeight(positionId uint64) []byte {
+	key := make([]byte, 0, len(PositionJoinHeightPrefix)+uint64ByteSize)
+	key = append(key, PositionJoinHeightPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return key
+}
+
+// Incentive Tick Range Prefix Keys
+
+// KeyIncentiveTickRangesPrefixByPoolId returns the prefix of the incentive tick ranges of the given pool id.
+// This key can be used to iterate over the incentive tick ranges of the pool.
+func KeyIncentiveTickRangesPrefixByPoolId(poolId uint64) []byte {
+	key := make([]byte, 0, len(IncentiveTickRangePrefix)+uint64ByteSize)
+	key = append(key, IncentiveTickRangePrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyIncentiveTickRange returns the key used to store the incentive tick range of the given pool id bounded by the given ticks.
+func KeyIncentiveTickRange(poolId uint64, lowerTick, upperTick int64) []byte {
+	key := KeyIncentiveTickRangesPrefixByPoolId(poolId)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	key = append(key, TickIndexToBytes(upperTick)...)
+	return key
+}
+
+// KeyIncentiveTickRangeTickPrefix returns the prefix of the ticks of the incentive tick range of the given pool id
+// bounded by the given ticks. This key can be used to iterate over the ticks of the incentive tick range in order.
+func KeyIncentiveTickRangeTickPrefix(poolId uint64, lowerTick, upperTick int64) []byte {
+	key := make([]byte, 0, len(IncentiveTickRangeTickPrefix)+uint64ByteSize+9+9+9)
+	key = append(key, IncentiveTickRangeTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	key = append(key, TickIndexToBytes(upperTick)...)
+	return key
+}
+
+// KeyIncentiveTickRangeTick returns the key used to store the given tick of the incentive tick range of the given pool id
+// bounded by the given ticks.
+func KeyIncentiveTickRangeTick(poolId uint64, lowerTick, upperTick int64, tickIndex int64) []byte {
+	return append(KeyIncentiveTickRangeTickPrefix(poolId, lowerTick, upperTick), TickIndexToBytes(tickIndex)...)
+}
+
+// KeyIncentiveTickRangesByTickPrefix returns the prefix of the incentive tick ranges of the given pool id that have the given
+// tick initialized. This key can be used to iterate over the incentive tick ranges to update when the tick is crossed.
+func KeyIncentiveTickRangesByTickPrefix(poolId uint64, tickIndex int64) []byte {
+	key := make([]byte, 0, len(IncentiveTickRangeByTickPrefix)+uint64ByteSize+9+9+9)
+	key = append(key, IncentiveTickRangeByTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, TickIndexToBytes(tickIndex)...)
+	return key
+}
+
+// KeyIncentiveTickRangeByTick returns the key used to index the incentive tick range of the given pool id bounded by the
+// given ticks by one of its initialized ticks.
+func KeyIncentiveTickRangeByTick(poolId uint64, tickIndex, lowerTick, upperTick int64) []byte {
+	key := KeyIncentiveTickRangesByTickPrefix(poolId, tickIndex)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	key = append(key, TickIndexToBytes(upperTick)...)
+	return key
+}
+
+// KeyIncentiveTickRangePruning returns the key used to flag the incentive tick range of the given pool id bounded by the
+// given ticks for pruning.
+func KeyIncentiveTickRangePruning(poolId uint64, lowerTick, upperTick int64) []byte {
+	key := make([]byte, 0, len(IncentiveTickRangePruningPrefix)+uint64ByteSize+9+9)
+	key = append(key, IncentiveTickRangePruningPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	key = append(key, TickIndexToBytes(upperTick)...)
+	return key
+}
+
+// KeyIncentiveTickRangeUptimeAccumulator returns the name of the accumulator of the given uptime index of the incentive
+// tick range of the given pool id bounded by the given ticks.
+// This is guaranteed to not contain "||" so it can be used as an accumulator name.
+func KeyIncentiveTickRangeUptimeAccumulator(poolId uint64, lowerTick, upperTick int64, uptimeIndex uint64) string {
+	poolIdStr := strconv.FormatUint(poolId, base10)
+	lowerTickStr := strconv.FormatInt(lowerTick, base10)
+	upperTickStr := strconv.FormatInt(upperTick, base10)
+	uptimeIndexStr := strconv.FormatUint(uptimeIndex, base10)
+	return strings.Join([]string{string(IncentiveTickRangeUptimeAccumulatorPrefix), poolIdStr, lowerTickStr, upperTickStr, uptimeIndexStr}, "/")
+}
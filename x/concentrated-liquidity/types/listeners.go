@@ -11,6 +11,9 @@ type ConcentratedLiquidityListener interface {
 	// AfterLastPoolPositionRemoved is called after the last position is removed in a concentrated
 	// liquidity pool.
 	AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
+	// AfterPoolPositionWithdrawn is called after liquidity is withdrawn from a position in a concentrated
+	// liquidity pool.
+	AfterPoolPositionWithdrawn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
 	// AfterConcentratedPoolSwap is called after a swap in a concentrated liquidity pool.
 	AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
 }
@@ -37,6 +40,12 @@ func (l ConcentratedLiquidityListeners) AfterLastPoolPositionRemoved(ctx sdk.Con
 	}
 }
 
+func (l ConcentratedLiquidityListeners) AfterPoolPositionWithdrawn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	for i := range l {
+		l[i].AfterPoolPositionWithdrawn(ctx, sender, poolId)
+	}
+}
+
 func (l ConcentratedLiquidityListeners) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	for i := range l {
 		l[i].AfterConcentratedPoolSwap(ctx, sender, poolId, input, output)
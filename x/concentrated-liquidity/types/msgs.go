@@ -19,6 +19,13 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceRangeOrder         = "place-range-order"
+	TypeMsgCancelRangeOrder        = "cancel-range-order"
+	TypeMsgClaimRangeOrder         = "claim-range-order"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
+	TypeMsgRebalancePosition       = "rebalance-position"
+	TypeMsgTokenizePosition        = "tokenize-position"
+	TypeMsgRedeemPositionNFT       = "redeem-position-nft"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -267,3 +274,230 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceRangeOrder{}
+
+func (msg MsgPlaceRangeOrder) Route() string { return RouterKey }
+func (msg MsgPlaceRangeOrder) Type() string  { return TypeMsgPlaceRangeOrder }
+func (msg MsgPlaceRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenProvided.IsValid() {
+		return fmt.Errorf("Invalid coin (%s)", msg.TokenProvided.String())
+	}
+
+	if !msg.TokenProvided.Amount.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenProvided.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceRangeOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelRangeOrder{}
+
+func (msg MsgCancelRangeOrder) Route() string { return RouterKey }
+func (msg MsgCancelRangeOrder) Type() string  { return TypeMsgCancelRangeOrder }
+func (msg MsgCancelRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgCancelRangeOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimRangeOrder{}
+
+func (msg MsgClaimRangeOrder) Route() string { return RouterKey }
+func (msg MsgClaimRangeOrder) Type() string  { return TypeMsgClaimRangeOrder }
+func (msg MsgClaimRangeOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgClaimRangeOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimRangeOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRebalancePosition{}
+
+func (msg MsgRebalancePosition) Route() string { return RouterKey }
+func (msg MsgRebalancePosition) Type() string  { return TypeMsgRebalancePosition }
+func (msg MsgRebalancePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if msg.TokenOutMinAmount.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenOutMinAmount.String()}
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgRebalancePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRebalancePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTokenizePosition{}
+
+func (msg MsgTokenizePosition) Route() string { return RouterKey }
+func (msg MsgTokenizePosition) Type() string  { return TypeMsgTokenizePosition }
+func (msg MsgTokenizePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgTokenizePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTokenizePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRedeemPositionNFT{}
+
+func (msg MsgRedeemPositionNFT) Route() string { return RouterKey }
+func (msg MsgRedeemPositionNFT) Type() string  { return TypeMsgRedeemPositionNFT }
+func (msg MsgRedeemPositionNFT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrZeroPositionId
+	}
+
+	return nil
+}
+
+func (msg MsgRedeemPositionNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRedeemPositionNFT) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
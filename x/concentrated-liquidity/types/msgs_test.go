@@ -362,6 +362,65 @@ func TestConcentratedLiquiditySerialization(t *testing.T) {
 				PositionIds: []uint64{1, 2},
 			},
 		},
+		{
+			name: "MsgPlaceRangeOrder",
+			clMsg: &types.MsgPlaceRangeOrder{
+				PoolId:        defaultPoolId,
+				Sender:        addr1,
+				LowerTick:     int64(10000),
+				UpperTick:     int64(20000),
+				TokenProvided: sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+		},
+		{
+			name: "MsgCancelRangeOrder",
+			clMsg: &types.MsgCancelRangeOrder{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgClaimRangeOrder",
+			clMsg: &types.MsgClaimRangeOrder{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgSetPositionAutoCompound",
+			clMsg: &types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+				Enabled:    true,
+			},
+		},
+		{
+			name: "MsgRebalancePosition",
+			clMsg: &types.MsgRebalancePosition{
+				PositionId:        1,
+				Sender:            addr1,
+				LowerTick:         -100,
+				UpperTick:         100,
+				Swap:              true,
+				TokenOutMinAmount: osmomath.OneInt(),
+				TokenMinAmount0:   osmomath.OneInt(),
+				TokenMinAmount1:   osmomath.OneInt(),
+			},
+		},
+		{
+			name: "MsgTokenizePosition",
+			clMsg: &types.MsgTokenizePosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
+		{
+			name: "MsgRedeemPositionNFT",
+			clMsg: &types.MsgRedeemPositionNFT{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -434,3 +493,331 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgPlaceRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgPlaceRangeOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:        1,
+				Sender:        addr1,
+				LowerTick:     10000,
+				UpperTick:     20000,
+				TokenProvided: sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:        1,
+				Sender:        invalidAddr.String(),
+				LowerTick:     10000,
+				UpperTick:     20000,
+				TokenProvided: sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "upper tick is same as lower tick",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:        1,
+				Sender:        addr1,
+				LowerTick:     10000,
+				UpperTick:     10000,
+				TokenProvided: sdk.NewCoin("foo", osmomath.NewInt(1000)),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: types.MsgPlaceRangeOrder{
+				PoolId:        1,
+				Sender:        addr1,
+				LowerTick:     10000,
+				UpperTick:     20000,
+				TokenProvided: sdk.NewCoin("foo", osmomath.ZeroInt()),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgPlaceRangeOrder)
+	}
+}
+
+func TestMsgCancelRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCancelRangeOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCancelRangeOrder{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCancelRangeOrder{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: types.MsgCancelRangeOrder{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCancelRangeOrder)
+	}
+}
+
+func TestMsgClaimRangeOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgClaimRangeOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgClaimRangeOrder{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgClaimRangeOrder{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: types.MsgClaimRangeOrder{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgClaimRangeOrder)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+				Enabled:    true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg, disable",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+				Enabled:    true,
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: types.MsgSetPositionAutoCompound{
+				Sender:  addr1,
+				Enabled: true,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
+
+func TestMsgRebalancePosition(t *testing.T) {
+	validMsg := types.MsgRebalancePosition{
+		PositionId:        1,
+		Sender:            addr1,
+		LowerTick:         -100,
+		UpperTick:         100,
+		Swap:              true,
+		TokenOutMinAmount: osmomath.OneInt(),
+		TokenMinAmount0:   osmomath.OneInt(),
+		TokenMinAmount1:   osmomath.OneInt(),
+	}
+
+	tests := []struct {
+		name       string
+		msg        func(msg types.MsgRebalancePosition) types.MsgRebalancePosition
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        func(msg types.MsgRebalancePosition) types.MsgRebalancePosition { return msg },
+			expectPass: true,
+		},
+		{
+			name: "proper msg, no swap",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.Swap = false
+				msg.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.Sender = invalidAddr.String()
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.PositionId = 0
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "lower tick equal to upper tick",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.LowerTick = msg.UpperTick
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token out min amount",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.TokenOutMinAmount = osmomath.NewInt(-1)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token min amount 0",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.TokenMinAmount0 = osmomath.NewInt(-1)
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token min amount 1",
+			msg: func(msg types.MsgRebalancePosition) types.MsgRebalancePosition {
+				msg.TokenMinAmount1 = osmomath.NewInt(-1)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		msg := test.msg(validMsg)
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgRebalancePosition)
+	}
+}
+
+func TestMsgTokenizePosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgTokenizePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTokenizePosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgTokenizePosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: types.MsgTokenizePosition{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTokenizePosition)
+	}
+}
+
+func TestMsgRedeemPositionNFT(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgRedeemPositionNFT
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgRedeemPositionNFT{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgRedeemPositionNFT{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "position id zero",
+			msg: types.MsgRedeemPositionNFT{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgRedeemPositionNFT)
+	}
+}
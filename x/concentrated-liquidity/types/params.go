@@ -21,6 +21,12 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyPositionNFTContract                = []byte("PositionNFTContract")
+	KeySpreadRewardMinPositionAgeBlocks   = []byte("SpreadRewardMinPositionAgeBlocks")
+	KeyRangeOrderMinNotional              = []byte("RangeOrderMinNotional")
+	KeyMaxRangeOrdersPerTick              = []byte("MaxRangeOrdersPerTick")
+	KeyMaxAutoCompoundPositionsPerEpoch   = []byte("MaxAutoCompoundPositionsPerEpoch")
+	KeyAutoCompoundMaxSlippage            = []byte("AutoCompoundMaxSlippage")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -30,7 +36,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, positionNFTContract string, spreadRewardMinPositionAgeBlocks uint64, rangeOrderMinNotional sdk.Coins, maxRangeOrdersPerTick uint64, maxAutoCompoundPositionsPerEpoch uint64, autoCompoundMaxSlippage osmomath.Dec) Params {
 	return Params{
 		AuthorizedTickSpacing:               authorizedTickSpacing,
 		AuthorizedSpreadFactors:             authorizedSpreadFactors,
@@ -40,6 +46,12 @@ func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomat
 		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
 		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        hookGasLimit,
+		PositionNftContract:                 positionNFTContract,
+		SpreadRewardMinPositionAgeBlocks:    spreadRewardMinPositionAgeBlocks,
+		RangeOrderMinNotional:               rangeOrderMinNotional,
+		MaxRangeOrdersPerTick:               maxRangeOrdersPerTick,
+		MaxAutoCompoundPositionsPerEpoch:    maxAutoCompoundPositionsPerEpoch,
+		AutoCompoundMaxSlippage:             autoCompoundMaxSlippage,
 	}
 }
 
@@ -59,6 +71,12 @@ func DefaultParams() Params {
 		IsPermissionlessPoolCreationEnabled: false,
 		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
 		HookGasLimit:                        DefaultContractHookGasLimit,
+		PositionNftContract:                 "",
+		SpreadRewardMinPositionAgeBlocks:    0,
+		RangeOrderMinNotional:               DefaultRangeOrderMinNotional,
+		MaxRangeOrdersPerTick:               DefaultMaxRangeOrdersPerTick,
+		MaxAutoCompoundPositionsPerEpoch:    DefaultMaxAutoCompoundPositionsPerEpoch,
+		AutoCompoundMaxSlippage:             DefaultAutoCompoundMaxSlippage,
 	}
 }
 
@@ -88,6 +106,24 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validatePositionNFTContract(p.PositionNftContract); err != nil {
+		return err
+	}
+	if err := validateSpreadRewardMinPositionAgeBlocks(p.SpreadRewardMinPositionAgeBlocks); err != nil {
+		return err
+	}
+	if err := validateRangeOrderMinNotional(p.RangeOrderMinNotional); err != nil {
+		return err
+	}
+	if err := validateMaxRangeOrdersPerTick(p.MaxRangeOrdersPerTick); err != nil {
+		return err
+	}
+	if err := validateMaxAutoCompoundPositionsPerEpoch(p.MaxAutoCompoundPositionsPerEpoch); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundMaxSlippage(p.AutoCompoundMaxSlippage); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -102,6 +138,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyPositionNFTContract, &p.PositionNftContract, validatePositionNFTContract),
+		paramtypes.NewParamSetPair(KeySpreadRewardMinPositionAgeBlocks, &p.SpreadRewardMinPositionAgeBlocks, validateSpreadRewardMinPositionAgeBlocks),
+		paramtypes.NewParamSetPair(KeyRangeOrderMinNotional, &p.RangeOrderMinNotional, validateRangeOrderMinNotional),
+		paramtypes.NewParamSetPair(KeyMaxRangeOrdersPerTick, &p.MaxRangeOrdersPerTick, validateMaxRangeOrdersPerTick),
+		paramtypes.NewParamSetPair(KeyMaxAutoCompoundPositionsPerEpoch, &p.MaxAutoCompoundPositionsPerEpoch, validateMaxAutoCompoundPositionsPerEpoch),
+		paramtypes.NewParamSetPair(KeyAutoCompoundMaxSlippage, &p.AutoCompoundMaxSlippage, validateAutoCompoundMaxSlippage),
 	}
 }
 
@@ -260,3 +302,77 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validatePositionNFTContract validates that the position NFT contract is either empty or a valid bech32 address.
+func validatePositionNFTContract(i interface{}) error {
+	contract, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for position NFT contract: %T", i)
+	}
+
+	if contract == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return fmt.Errorf("invalid position NFT contract address (%s): %w", contract, err)
+	}
+
+	return nil
+}
+
+// validateSpreadRewardMinPositionAgeBlocks validates that the spread reward minimum position age is of type uint64.
+func validateSpreadRewardMinPositionAgeBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for spread reward minimum position age: %T", i)
+	}
+
+	return nil
+}
+
+// validateRangeOrderMinNotional validates that the range order minimum notional is a valid set of coins.
+func validateRangeOrderMinNotional(i interface{}) error {
+	minNotional, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for range order minimum notional: %T", i)
+	}
+
+	return minNotional.Validate()
+}
+
+// validateMaxRangeOrdersPerTick validates the type of the maximum number of range orders per tick.
+// Zero is valid and disables the placement of range orders.
+func validateMaxRangeOrdersPerTick(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for maximum range orders per tick: %T", i)
+	}
+
+	return nil
+}
+
+// validateMaxAutoCompoundPositionsPerEpoch validates the type of the maximum number of positions compounded per epoch.
+// Zero is valid and pauses auto-compounding.
+func validateMaxAutoCompoundPositionsPerEpoch(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for maximum auto-compound positions per epoch: %T", i)
+	}
+
+	return nil
+}
+
+// validateAutoCompoundMaxSlippage validates that the auto-compound maximum slippage is in [0, 1).
+func validateAutoCompoundMaxSlippage(i interface{}) error {
+	maxSlippage, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for auto-compound maximum slippage: %T", i)
+	}
+
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("auto-compound maximum slippage must be in [0, 1), got %s", maxSlippage)
+	}
+
+	return nil
+}
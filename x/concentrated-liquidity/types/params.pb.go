@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -64,6 +66,36 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// position_nft_contract is the address of the CW721 contract that mints the
+	// NFTs representing tokenized positions. The module must be able to call
+	// the contract through sudo. Positions cannot be tokenized if it is empty.
+	PositionNftContract string `protobuf:"bytes,9,opt,name=position_nft_contract,json=positionNftContract,proto3" json:"position_nft_contract,omitempty" yaml:"position_nft_contract"`
+	// spread_reward_min_position_age_blocks is the minimum number of blocks
+	// since the creation of a position for it to be able to claim spread
+	// rewards. Spread rewards claimed by younger positions are forfeited to the
+	// remaining in-range liquidity of the pool, which protects LPs against
+	// just-in-time liquidity. Zero disables the rule.
+	SpreadRewardMinPositionAgeBlocks uint64 `protobuf:"varint,10,opt,name=spread_reward_min_position_age_blocks,json=spreadRewardMinPositionAgeBlocks,proto3" json:"spread_reward_min_position_age_blocks,omitempty" yaml:"spread_reward_min_position_age_blocks"`
+	// range_order_min_notional is the minimum value of a range order in the
+	// token1 of its pool, per token1 denom. The value of an order providing
+	// token1 is the amount provided and the value of an order providing token0
+	// is the amount of token1 it is filled for. Orders in pools whose token1 is
+	// not listed have no minimum.
+	RangeOrderMinNotional github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=range_order_min_notional,json=rangeOrderMinNotional,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"range_order_min_notional" yaml:"range_order_min_notional"`
+	// max_range_orders_per_tick is the maximum number of unfilled range orders
+	// filled by crossing a tick in a given direction. It bounds the number of
+	// positions withdrawn when a swap crosses a single tick.
+	MaxRangeOrdersPerTick uint64 `protobuf:"varint,12,opt,name=max_range_orders_per_tick,json=maxRangeOrdersPerTick,proto3" json:"max_range_orders_per_tick,omitempty" yaml:"max_range_orders_per_tick"`
+	// max_auto_compound_positions_per_epoch is the maximum number of positions
+	// compounded at the end of an auto-compound epoch. Positions that are not
+	// reached are compounded in the following epochs, in order of position id.
+	MaxAutoCompoundPositionsPerEpoch uint64 `protobuf:"varint,13,opt,name=max_auto_compound_positions_per_epoch,json=maxAutoCompoundPositionsPerEpoch,proto3" json:"max_auto_compound_positions_per_epoch,omitempty" yaml:"max_auto_compound_positions_per_epoch"`
+	// auto_compound_max_slippage is the maximum slippage, relative to the spot
+	// price of the pool before the swap, of the swap that rebalances the rewards
+	// of a position to its ratio when compounding. It must cover the spread
+	// factor and the taker fee of the pool. Positions whose swap would exceed it
+	// are skipped and keep their rewards claimable.
+	AutoCompoundMaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=auto_compound_max_slippage,json=autoCompoundMaxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"auto_compound_max_slippage" yaml:"auto_compound_max_slippage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +173,41 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetPositionNftContract() string {
+	if m != nil {
+		return m.PositionNftContract
+	}
+	return ""
+}
+
+func (m *Params) GetSpreadRewardMinPositionAgeBlocks() uint64 {
+	if m != nil {
+		return m.SpreadRewardMinPositionAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRangeOrderMinNotional() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RangeOrderMinNotional
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRangeOrdersPerTick() uint64 {
+	if m != nil {
+		return m.MaxRangeOrdersPerTick
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundPositionsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundPositionsPerEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -150,47 +217,67 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x48, 0x41,
-	0x97, 0x62, 0x13, 0xac, 0x37, 0x3d, 0x08, 0x71, 0xb5, 0x97, 0x0a, 0x35, 0x55, 0x84, 0x22, 0x0c,
-	0xb3, 0x93, 0x69, 0x76, 0xd8, 0x24, 0x4f, 0x3a, 0x33, 0xb1, 0xae, 0xe0, 0x49, 0x04, 0x8f, 0x1e,
-	0x3c, 0xf8, 0x32, 0x7c, 0x19, 0x3d, 0xf6, 0x28, 0x1e, 0xa2, 0xb4, 0x37, 0x8f, 0xfb, 0x0a, 0x64,
-	0x33, 0x59, 0xbb, 0x6b, 0x2b, 0xee, 0x2d, 0xcf, 0xf3, 0xfd, 0x3c, 0x7f, 0xf2, 0xe5, 0x61, 0xf4,
-	0x35, 0x10, 0x29, 0x08, 0x26, 0x7c, 0x02, 0x19, 0xa1, 0x99, 0xe4, 0x58, 0xd2, 0x28, 0x61, 0xfb,
-	0x05, 0x8b, 0x98, 0x1c, 0xf8, 0x39, 0xe6, 0x38, 0x15, 0x5e, 0xce, 0x41, 0x82, 0x71, 0xa3, 0x66,
-	0xbd, 0x73, 0xd9, 0x95, 0x2b, 0x31, 0xc4, 0x50, 0x91, 0xfe, 0xe8, 0x4b, 0x15, 0xad, 0xb4, 0x48,
-	0x55, 0x85, 0x94, 0xa0, 0x82, 0x5a, 0xb2, 0x62, 0x80, 0x38, 0xa1, 0x7e, 0x15, 0x75, 0x8b, 0x3d,
-	0x3f, 0x2a, 0x38, 0x96, 0x0c, 0x32, 0xa5, 0xbb, 0x5f, 0x16, 0xf4, 0xf9, 0xed, 0x6a, 0x01, 0x63,
-	0x57, 0xbf, 0x8a, 0x0b, 0xd9, 0x03, 0xce, 0xde, 0xd0, 0x08, 0x49, 0x46, 0xfa, 0x48, 0xe4, 0x98,
-	0xb0, 0x2c, 0x36, 0x35, 0xa7, 0xd9, 0x9e, 0x0b, 0xdc, 0x61, 0x69, 0x5b, 0x03, 0x9c, 0x26, 0xf7,
-	0xdc, 0xbf, 0x80, 0x6e, 0xb8, 0x7c, 0xaa, 0x3c, 0x63, 0xa4, 0xbf, 0xa3, 0xf2, 0xc6, 0x3b, 0x4d,
-	0x6f, 0x4d, 0xd4, 0x88, 0x9c, 0x53, 0x1c, 0xa1, 0x3d, 0x4c, 0x24, 0x70, 0x61, 0xfe, 0xe7, 0x34,
-	0xdb, 0x4b, 0xc1, 0xe6, 0x61, 0x69, 0x37, 0xbe, 0x95, 0xf6, 0x35, 0xf5, 0x03, 0x22, 0xea, 0x7b,
-	0x0c, 0xfc, 0x14, 0xcb, 0x9e, 0xb7, 0x45, 0x63, 0x4c, 0x06, 0x1d, 0x4a, 0x86, 0xa5, 0xed, 0x9c,
-	0xd9, 0x60, 0xba, 0x9b, 0x1b, 0x4e, 0xfc, 0xc6, 0x4e, 0x25, 0x3d, 0x56, 0x8a, 0xf1, 0x49, 0xd3,
-	0xed, 0x2e, 0x4e, 0x70, 0x46, 0x28, 0x47, 0xa2, 0x87, 0x39, 0x15, 0x88, 0xd3, 0x03, 0xcc, 0x23,
-	0x14, 0x31, 0x41, 0xa0, 0xc8, 0xa4, 0xd9, 0x74, 0xb4, 0xf6, 0x52, 0xf0, 0x64, 0xb6, 0x5d, 0x6e,
-	0xaa, 0x5d, 0xfe, 0xd1, 0xd3, 0x0d, 0xaf, 0x8f, 0x89, 0x9d, 0x0a, 0x08, 0x2b, 0xbd, 0x53, 0xcb,
-	0x7f, 0x18, 0xbf, 0x5f, 0x80, 0xa4, 0x28, 0xa2, 0x19, 0xa4, 0xc2, 0x9c, 0xab, 0x9c, 0x39, 0xdf,
-	0xf8, 0x49, 0x70, 0xca, 0xf8, 0xa7, 0x23, 0xa1, 0x53, 0xe5, 0x8d, 0xf7, 0x9a, 0x6e, 0x4c, 0xd4,
-	0x14, 0xb9, 0x64, 0x29, 0x15, 0xe6, 0xff, 0x4e, 0xb3, 0x7d, 0x61, 0xa3, 0xe5, 0xa9, 0xeb, 0xf0,
-	0xc6, 0xd7, 0xe1, 0x75, 0xea, 0xeb, 0x08, 0xee, 0x8f, 0x0c, 0xf8, 0x59, 0xda, 0xc6, 0xf8, 0x5e,
-	0x6e, 0x43, 0xca, 0x24, 0x4d, 0x73, 0x39, 0x18, 0x96, 0x76, 0xeb, 0xcc, 0x32, 0x75, 0x63, 0xf7,
-	0xf3, 0x77, 0x5b, 0x0b, 0x2f, 0x9f, 0x0a, 0xcf, 0x55, 0xde, 0xf8, 0xa0, 0xe9, 0xb7, 0x98, 0x40,
-	0x39, 0xe5, 0x29, 0x13, 0x82, 0x41, 0x96, 0x50, 0x21, 0x50, 0x0e, 0x90, 0x20, 0xc2, 0x69, 0x35,
-	0x01, 0xd1, 0x0c, 0x77, 0x13, 0x1a, 0x99, 0xf3, 0x8e, 0xd6, 0x5e, 0x0c, 0x36, 0x86, 0xa5, 0xed,
-	0xa9, 0x39, 0x33, 0x16, 0xba, 0xe1, 0x2a, 0x13, 0xdb, 0x53, 0xe0, 0x36, 0x40, 0xf2, 0xb0, 0xc6,
-	0x1e, 0x29, 0xca, 0x78, 0xab, 0xaf, 0x16, 0x19, 0xa7, 0x42, 0x72, 0x46, 0x24, 0x8d, 0x26, 0x7a,
-	0x01, 0x47, 0x07, 0x3d, 0x26, 0x69, 0xc2, 0x84, 0x34, 0x17, 0x2a, 0xeb, 0xbd, 0x61, 0x69, 0xaf,
-	0xa9, 0x2d, 0x66, 0x28, 0x72, 0x43, 0x67, 0x92, 0xfa, 0x3d, 0x1d, 0xf8, 0x8b, 0x31, 0x62, 0x3c,
-	0xd0, 0x2f, 0xf5, 0x00, 0xfa, 0x28, 0xc6, 0x02, 0x25, 0x2c, 0x65, 0xd2, 0x5c, 0x74, 0xb4, 0xf6,
-	0x5c, 0xd0, 0x1a, 0x96, 0xf6, 0xb2, 0x9a, 0x34, 0xad, 0xbb, 0xe1, 0xc5, 0x51, 0x62, 0x13, 0x8b,
-	0xad, 0x51, 0x18, 0xbc, 0x3c, 0x3c, 0xb6, 0xb4, 0xa3, 0x63, 0x4b, 0xfb, 0x71, 0x6c, 0x69, 0x1f,
-	0x4f, 0xac, 0xc6, 0xd1, 0x89, 0xd5, 0xf8, 0x7a, 0x62, 0x35, 0x76, 0x83, 0x98, 0xc9, 0x5e, 0xd1,
-	0xf5, 0x08, 0xa4, 0x7e, 0xfd, 0x8e, 0xac, 0x27, 0xb8, 0x2b, 0xc6, 0x81, 0xff, 0x6a, 0xe3, 0x8e,
-	0xff, 0x7a, 0xea, 0x19, 0x5a, 0x3f, 0x7d, 0x87, 0xe4, 0x20, 0xa7, 0xa2, 0x3b, 0x5f, 0xdd, 0xc2,
-	0xdd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x56, 0xb5, 0x87, 0xb5, 0x04, 0x00, 0x00,
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0xbf, 0xc9, 0x37, 0x34, 0xee, 0x0f, 0x09, 0x43, 0x84, 0x37, 0x94, 0xb5, 0x71, 0xf9,
+	0xb1, 0xaa, 0x1a, 0x9b, 0x84, 0x1b, 0x1c, 0x50, 0x9d, 0x94, 0x72, 0x68, 0x4a, 0x70, 0x8a, 0x90,
+	0x2a, 0xc4, 0x68, 0x76, 0x3c, 0xf1, 0x8e, 0xd6, 0xf6, 0x73, 0x67, 0xc6, 0xed, 0x2e, 0x12, 0x07,
+	0x84, 0x90, 0x38, 0x72, 0xe0, 0xc0, 0x91, 0x1b, 0x12, 0x7f, 0x49, 0x8f, 0x3d, 0x22, 0x0e, 0x2e,
+	0x4a, 0x6e, 0x1c, 0xf7, 0x2f, 0x40, 0x9e, 0x99, 0xdd, 0xec, 0xb6, 0x89, 0xd8, 0x53, 0x32, 0xef,
+	0xf3, 0x79, 0x9f, 0xf7, 0xfc, 0xe6, 0xed, 0x67, 0xec, 0x9b, 0x20, 0x0a, 0x10, 0x4c, 0x44, 0x04,
+	0x4a, 0x42, 0x4b, 0xc9, 0xb1, 0xa4, 0x69, 0xce, 0x1e, 0xd5, 0x2c, 0x65, 0x72, 0x1c, 0x55, 0x98,
+	0xe3, 0x42, 0x84, 0x15, 0x07, 0x09, 0xce, 0x5b, 0x86, 0x1b, 0x9e, 0xcb, 0xdd, 0x7a, 0x3d, 0x83,
+	0x0c, 0x14, 0x33, 0x6a, 0xff, 0xd3, 0x49, 0x5b, 0x1d, 0xa2, 0xb2, 0x90, 0x06, 0xf4, 0xc1, 0x40,
+	0xdd, 0x0c, 0x20, 0xcb, 0x69, 0xa4, 0x4e, 0xfd, 0xfa, 0x38, 0x4a, 0x6b, 0x8e, 0x25, 0x83, 0x72,
+	0x8a, 0x6b, 0x76, 0xd4, 0xc7, 0x82, 0x46, 0x8f, 0x77, 0xfa, 0x54, 0xe2, 0x9d, 0x88, 0x00, 0x33,
+	0x78, 0xf0, 0xdb, 0x55, 0x7b, 0xfd, 0x50, 0x35, 0xe8, 0x3c, 0xb4, 0xdf, 0xc0, 0xb5, 0x1c, 0x00,
+	0x67, 0xdf, 0xd2, 0x14, 0x49, 0x46, 0x86, 0x48, 0x54, 0x98, 0xb0, 0x32, 0x73, 0x2d, 0x7f, 0xb5,
+	0xb7, 0x16, 0x07, 0x93, 0xc6, 0xeb, 0x8e, 0x71, 0x91, 0x7f, 0x14, 0x5c, 0x40, 0x0c, 0x92, 0xcd,
+	0x33, 0xe4, 0x01, 0x23, 0xc3, 0x23, 0x1d, 0x77, 0x7e, 0xb0, 0xec, 0xce, 0x5c, 0x8e, 0xa8, 0x38,
+	0xc5, 0x29, 0x3a, 0xc6, 0x44, 0x02, 0x17, 0xee, 0xff, 0xfc, 0xd5, 0xde, 0x46, 0x7c, 0xf7, 0x69,
+	0xe3, 0xad, 0xfc, 0xd5, 0x78, 0x6f, 0xea, 0x96, 0x45, 0x3a, 0x0c, 0x19, 0x44, 0x05, 0x96, 0x83,
+	0xf0, 0x1e, 0xcd, 0x30, 0x19, 0xef, 0x53, 0x32, 0x69, 0x3c, 0xff, 0xa5, 0x0e, 0x16, 0xd5, 0x82,
+	0x64, 0xee, 0x33, 0x8e, 0x14, 0xf4, 0xa9, 0x46, 0x9c, 0x5f, 0x2c, 0xdb, 0xeb, 0xe3, 0x1c, 0x97,
+	0x84, 0x72, 0x24, 0x06, 0x98, 0x53, 0x81, 0x38, 0x7d, 0x82, 0x79, 0x8a, 0x52, 0x26, 0x08, 0xd4,
+	0xa5, 0x74, 0x57, 0x7d, 0xab, 0xb7, 0x11, 0x1f, 0x2c, 0xd7, 0xcb, 0x7b, 0xba, 0x97, 0xff, 0xd0,
+	0x0c, 0x92, 0xeb, 0x53, 0xc6, 0x91, 0x22, 0x24, 0x0a, 0xdf, 0x37, 0xf0, 0x0b, 0x83, 0x7f, 0x54,
+	0x83, 0xa4, 0x28, 0xa5, 0x25, 0x14, 0xc2, 0x5d, 0x53, 0x93, 0x39, 0x7f, 0xf0, 0xf3, 0xc4, 0x85,
+	0xc1, 0x7f, 0xd1, 0x02, 0xfb, 0x2a, 0xee, 0xfc, 0x68, 0xd9, 0xce, 0x5c, 0x4e, 0x5d, 0x49, 0x56,
+	0x50, 0xe1, 0xfe, 0xdf, 0x5f, 0xed, 0x5d, 0xde, 0xed, 0x84, 0x7a, 0x7b, 0xc2, 0xe9, 0xf6, 0x84,
+	0xfb, 0x66, 0x7b, 0xe2, 0x8f, 0xdb, 0x01, 0xfc, 0xd3, 0x78, 0xce, 0x74, 0x9f, 0x6e, 0x41, 0xc1,
+	0x24, 0x2d, 0x2a, 0x39, 0x9e, 0x34, 0x5e, 0xe7, 0xa5, 0x66, 0x8c, 0x70, 0xf0, 0xeb, 0x73, 0xcf,
+	0x4a, 0x5e, 0x3d, 0x03, 0xbe, 0xd4, 0x71, 0xe7, 0x27, 0xcb, 0x7e, 0x9f, 0x09, 0x54, 0x51, 0x5e,
+	0x30, 0x21, 0x18, 0x94, 0x39, 0x15, 0x02, 0x55, 0x00, 0x39, 0x22, 0x9c, 0xaa, 0x0a, 0x88, 0x96,
+	0xb8, 0x9f, 0xd3, 0xd4, 0x5d, 0xf7, 0xad, 0xde, 0xa5, 0x78, 0x77, 0xd2, 0x78, 0xa1, 0xae, 0xb3,
+	0x64, 0x62, 0x90, 0xdc, 0x60, 0xe2, 0x70, 0x81, 0x78, 0x08, 0x90, 0xef, 0x19, 0xda, 0x1d, 0xcd,
+	0x72, 0xbe, 0xb3, 0x6f, 0xd4, 0x25, 0xa7, 0x42, 0x72, 0x46, 0x24, 0x4d, 0xe7, 0xb4, 0x80, 0xa3,
+	0x27, 0x03, 0x26, 0x69, 0xce, 0x84, 0x74, 0x5f, 0x51, 0xa3, 0x0f, 0x27, 0x8d, 0x77, 0x53, 0x77,
+	0xb1, 0x44, 0x52, 0x90, 0xf8, 0xf3, 0xac, 0x59, 0x75, 0xe0, 0x5f, 0x4d, 0x29, 0xce, 0x27, 0xf6,
+	0xb5, 0x01, 0xc0, 0x10, 0x65, 0x58, 0xa0, 0x9c, 0x15, 0x4c, 0xba, 0x97, 0x7c, 0xab, 0xb7, 0x16,
+	0x77, 0x26, 0x8d, 0xb7, 0xa9, 0x2b, 0x2d, 0xe2, 0x41, 0x72, 0xa5, 0x0d, 0xdc, 0xc5, 0xe2, 0x5e,
+	0x7b, 0x74, 0x1e, 0xd8, 0x9b, 0x15, 0x08, 0xa6, 0xbe, 0xbc, 0x3c, 0x96, 0x88, 0x40, 0x6b, 0x23,
+	0x44, 0xba, 0x1b, 0x6a, 0x75, 0xfd, 0x49, 0xe3, 0x5d, 0xd7, 0x3a, 0xe7, 0xd2, 0x82, 0xe4, 0xb5,
+	0x69, 0xfc, 0xfe, 0xb1, 0xdc, 0x33, 0x51, 0xe7, 0x7b, 0xcb, 0x7e, 0xd7, 0xfc, 0x90, 0xcc, 0xfa,
+	0x16, 0xac, 0x44, 0x33, 0x05, 0x9c, 0x51, 0xd4, 0xcf, 0x81, 0x0c, 0x85, 0x6b, 0xab, 0x76, 0x3f,
+	0x98, 0x34, 0xde, 0x2d, 0x5d, 0x66, 0xa9, 0xb4, 0x20, 0xf1, 0x35, 0x4f, 0x2f, 0xff, 0x01, 0x2b,
+	0x0f, 0x0d, 0xe9, 0x76, 0x46, 0x63, 0x45, 0x71, 0x7e, 0xb7, 0x6c, 0x97, 0xe3, 0x32, 0xa3, 0x08,
+	0x78, 0x4a, 0xb9, 0x92, 0x2a, 0xa1, 0xe5, 0xe0, 0xdc, 0xbd, 0x6c, 0x56, 0xd6, 0xd8, 0x5f, 0x6b,
+	0x68, 0xa1, 0x31, 0xb4, 0x70, 0x0f, 0x58, 0x19, 0x1f, 0xb5, 0x2b, 0x3b, 0x69, 0x3c, 0x4f, 0x77,
+	0x75, 0x91, 0x50, 0xf0, 0xc7, 0x73, 0xaf, 0x97, 0x31, 0x39, 0xa8, 0xfb, 0x21, 0x81, 0xc2, 0xd8,
+	0xa9, 0xf9, 0xb3, 0x2d, 0xd2, 0x61, 0x24, 0xc7, 0x15, 0x15, 0x4a, 0x53, 0x24, 0x9b, 0x4a, 0xe6,
+	0xf3, 0x56, 0xe5, 0x80, 0x95, 0xf7, 0x8d, 0x86, 0xf3, 0x8d, 0xdd, 0x29, 0xf0, 0x08, 0xcd, 0xd5,
+	0x50, 0x2b, 0xaa, 0xcc, 0xd0, 0xbd, 0xa2, 0x06, 0xf4, 0xce, 0x99, 0x57, 0x5d, 0x48, 0x0d, 0x92,
+	0xcd, 0x02, 0x8f, 0x92, 0x59, 0x89, 0x76, 0x7b, 0x5b, 0xdb, 0x54, 0xb7, 0xd1, 0x66, 0xe1, 0x5a,
+	0x02, 0x22, 0x50, 0x54, 0x50, 0x97, 0xe9, 0x6c, 0xac, 0x5a, 0x80, 0x56, 0x40, 0x06, 0xee, 0xd5,
+	0x17, 0x6f, 0x63, 0xa9, 0xb4, 0x20, 0xf1, 0x0b, 0x3c, 0xba, 0x5d, 0x4b, 0xd8, 0x33, 0xac, 0xe9,
+	0x6d, 0xb4, 0x2d, 0xdc, 0x69, 0x29, 0xad, 0x75, 0x6c, 0x2d, 0x0a, 0xb5, 0xd2, 0x22, 0x67, 0x55,
+	0x85, 0x33, 0xea, 0x5e, 0x53, 0xdb, 0xf6, 0xd9, 0x72, 0x46, 0xf9, 0xf6, 0xcc, 0x30, 0x2e, 0x90,
+	0xd3, 0xae, 0x3d, 0xeb, 0xe6, 0x00, 0x8f, 0x8e, 0x0c, 0x12, 0x7f, 0xfd, 0xf4, 0xa4, 0x6b, 0x3d,
+	0x3b, 0xe9, 0x5a, 0x7f, 0x9f, 0x74, 0xad, 0x9f, 0x4f, 0xbb, 0x2b, 0xcf, 0x4e, 0xbb, 0x2b, 0x7f,
+	0x9e, 0x76, 0x57, 0x1e, 0xc6, 0x73, 0xd7, 0x68, 0xde, 0xd5, 0xed, 0x1c, 0xf7, 0xc5, 0xf4, 0x10,
+	0x3d, 0xde, 0xdd, 0x89, 0x46, 0x0b, 0xcf, 0xf2, 0xf6, 0xd9, 0xbb, 0xac, 0xae, 0xb9, 0xbf, 0xae,
+	0xbc, 0xef, 0xc3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x94, 0xf0, 0x56, 0xc5, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -213,6 +300,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AutoCompoundMaxSlippage.Size()
+		i -= size
+		if _, err := m.AutoCompoundMaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.MaxAutoCompoundPositionsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundPositionsPerEpoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxRangeOrdersPerTick != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRangeOrdersPerTick))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.RangeOrderMinNotional) > 0 {
+		for iNdEx := len(m.RangeOrderMinNotional) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeOrderMinNotional[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SpreadRewardMinPositionAgeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadRewardMinPositionAgeBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PositionNftContract) > 0 {
+		i -= len(m.PositionNftContract)
+		copy(dAtA[i:], m.PositionNftContract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PositionNftContract)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -359,6 +492,27 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	l = len(m.PositionNftContract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.SpreadRewardMinPositionAgeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SpreadRewardMinPositionAgeBlocks))
+	}
+	if len(m.RangeOrderMinNotional) > 0 {
+		for _, e := range m.RangeOrderMinNotional {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRangeOrdersPerTick != 0 {
+		n += 1 + sovParams(uint64(m.MaxRangeOrdersPerTick))
+	}
+	if m.MaxAutoCompoundPositionsPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxAutoCompoundPositionsPerEpoch))
+	}
+	l = m.AutoCompoundMaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -680,6 +834,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNftContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionNftContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardMinPositionAgeBlocks", wireType)
+			}
+			m.SpreadRewardMinPositionAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadRewardMinPositionAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeOrderMinNotional", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangeOrderMinNotional = append(m.RangeOrderMinNotional, types.Coin{})
+			if err := m.RangeOrderMinNotional[len(m.RangeOrderMinNotional)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRangeOrdersPerTick", wireType)
+			}
+			m.MaxRangeOrdersPerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRangeOrdersPerTick |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundPositionsPerEpoch", wireType)
+			}
+			m.MaxAutoCompoundPositionsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundPositionsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -107,3 +107,38 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePositionNFTContract(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: "osmo14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9",
+		},
+		"empty contract": {
+			i: "",
+		},
+		"error: invalid address": {
+			i:           "osmo1invalid",
+			expectError: true,
+		},
+		"error: wrong type": {
+			i:           []byte{1},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidatePositionNFTContract(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// PositionNFTTokenId returns the token ID of the NFT representing the given position.
+func PositionNFTTokenId(positionId uint64) string {
+	return strconv.FormatUint(positionId, 10)
+}
+
+// --- Position NFT Sudo Message Wrappers ---
+
+type MintPositionNFTSudoMsg struct {
+	MintPositionNFT MintPositionNFTMsg `json:"mint_position_nft"`
+}
+
+type BurnPositionNFTSudoMsg struct {
+	BurnPositionNFT BurnPositionNFTMsg `json:"burn_position_nft"`
+}
+
+// PositionNFTOwnerOfQueryMsg is the CW721 query for the holder of an NFT.
+type PositionNFTOwnerOfQueryMsg struct {
+	OwnerOf PositionNFTOwnerOfQuery `json:"owner_of"`
+}
+
+// --- Position NFT Message structs ---
+
+// MintPositionNFTMsg instructs the position NFT contract to mint the NFT with the given token ID to the owner.
+type MintPositionNFTMsg struct {
+	TokenId   string         `json:"token_id"`
+	Owner     sdk.AccAddress `json:"owner"`
+	PoolId    uint64         `json:"pool_id"`
+	LowerTick int64          `json:"lower_tick"`
+	UpperTick int64          `json:"upper_tick"`
+	Liquidity osmomath.Dec   `json:"liquidity"`
+}
+
+// PositionNFTOwnerOfQuery queries the holder of the NFT with the given token ID.
+type PositionNFTOwnerOfQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+// PositionNFTOwnerOfResponse is the response of the CW721 owner_of query.
+type PositionNFTOwnerOfResponse struct {
+	Owner string `json:"owner"`
+}
+
+// BurnPositionNFTMsg instructs the position NFT contract to burn the NFT with the given token ID.
+// The contract must fail if the NFT is not held by the given owner.
+type BurnPositionNFTMsg struct {
+	TokenId string         `json:"token_id"`
+	Owner   sdk.AccAddress `json:"owner"`
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/range_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RangeOrder is a single sided position that is withdrawn automatically
+// once a swap fully crosses its range, so that it fills like a limit order.
+// The position backing an unfilled order is stored as a regular position
+// under the same id, owned by the range order escrow address of the pool.
+type RangeOrder struct {
+	// position_id is the id of the position backing the order.
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	PoolId     uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner      string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// denom_in is the denom that was provided to place the order.
+	DenomIn string `protobuf:"bytes,4,opt,name=denom_in,json=denomIn,proto3" json:"denom_in,omitempty" yaml:"denom_in"`
+	// fill_tick is the tick of the order's range that is crossed last
+	// by a swap moving the price away from denom_in. That is, the upper tick
+	// for orders providing token0 and the lower tick for orders providing
+	// token1.
+	FillTick int64 `protobuf:"varint,5,opt,name=fill_tick,json=fillTick,proto3" json:"fill_tick,omitempty" yaml:"fill_tick"`
+	// filled is true once a swap crossed the fill tick and the position
+	// backing the order was withdrawn.
+	Filled bool `protobuf:"varint,6,opt,name=filled,proto3" json:"filled,omitempty" yaml:"filled"`
+	// tokens_out are the tokens withdrawn when the order was filled.
+	// They are held in escrow until claimed by the owner.
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+}
+
+func (m *RangeOrder) Reset()         { *m = RangeOrder{} }
+func (m *RangeOrder) String() string { return proto.CompactTextString(m) }
+func (*RangeOrder) ProtoMessage()    {}
+func (*RangeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff37f180961f2827, []int{0}
+}
+func (m *RangeOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeOrder.Merge(m, src)
+}
+func (m *RangeOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeOrder proto.InternalMessageInfo
+
+func (m *RangeOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RangeOrder) GetDenomIn() string {
+	if m != nil {
+		return m.DenomIn
+	}
+	return ""
+}
+
+func (m *RangeOrder) GetFillTick() int64 {
+	if m != nil {
+		return m.FillTick
+	}
+	return 0
+}
+
+func (m *RangeOrder) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *RangeOrder) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RangeOrder)(nil), "osmosis.concentratedliquidity.v1beta1.RangeOrder")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/range_order.proto", fileDescriptor_ff37f180961f2827)
+}
+
+var fileDescriptor_ff37f180961f2827 = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0xd2, 0xe6, 0x67, 0xca, 0x4f, 0x3b, 0x54, 0xc8, 0x74, 0x61, 0x47, 0x96, 0x40,
+	0x46, 0x28, 0x1e, 0xa5, 0x2c, 0x2a, 0xb1, 0x34, 0x62, 0x91, 0x55, 0x25, 0x8b, 0x15, 0x42, 0xb2,
+	0x6c, 0xcf, 0x10, 0x46, 0x71, 0xe6, 0x06, 0xcf, 0xa4, 0x90, 0x15, 0xaf, 0xc0, 0x6b, 0xc0, 0x93,
+	0x74, 0xd9, 0x25, 0x2b, 0x83, 0x92, 0x37, 0xf0, 0x13, 0xa0, 0x99, 0x89, 0x9b, 0x22, 0xb1, 0xf2,
+	0x19, 0x9f, 0xfb, 0xdd, 0x3b, 0x9a, 0x7b, 0xd0, 0x05, 0xc8, 0x05, 0x48, 0x2e, 0x49, 0x01, 0xa2,
+	0x60, 0x42, 0x55, 0x99, 0x62, 0xb4, 0xe4, 0x9f, 0x57, 0x9c, 0x72, 0xb5, 0x26, 0x57, 0x93, 0x9c,
+	0xa9, 0x6c, 0x42, 0xaa, 0x4c, 0xcc, 0x58, 0x0a, 0x15, 0x65, 0x55, 0xb4, 0xac, 0x40, 0x01, 0x7e,
+	0xb6, 0x03, 0xa3, 0xff, 0x82, 0xd1, 0x0e, 0x3c, 0x3b, 0x9d, 0xc1, 0x0c, 0x0c, 0x41, 0xb4, 0xb2,
+	0xf0, 0x99, 0x57, 0x18, 0x9a, 0xe4, 0x99, 0x64, 0xb7, 0x33, 0x0a, 0xe0, 0xc2, 0xfa, 0xc1, 0x8f,
+	0x2e, 0x42, 0x89, 0x1e, 0x79, 0xa9, 0x27, 0xe2, 0x0b, 0x74, 0xb4, 0x04, 0xc9, 0x15, 0x07, 0x91,
+	0x72, 0xea, 0x3a, 0x23, 0x27, 0x3c, 0x88, 0x9f, 0x34, 0xb5, 0x8f, 0xd7, 0xd9, 0xa2, 0x7c, 0x1d,
+	0xdc, 0x31, 0x83, 0x04, 0xb5, 0xa7, 0x29, 0xc5, 0x2f, 0x51, 0x7f, 0x09, 0x50, 0x6a, 0xe8, 0x9e,
+	0x81, 0x70, 0x53, 0xfb, 0x0f, 0x5b, 0xc8, 0x18, 0x41, 0xd2, 0xd3, 0x6a, 0x4a, 0xf1, 0x73, 0x74,
+	0x08, 0x5f, 0x04, 0xab, 0xdc, 0xee, 0xc8, 0x09, 0x87, 0xf1, 0x71, 0x53, 0xfb, 0xf7, 0x6d, 0xa9,
+	0xf9, 0x1d, 0x24, 0xd6, 0xc6, 0x11, 0x1a, 0x50, 0x26, 0x60, 0x91, 0x72, 0xe1, 0x1e, 0x98, 0xd2,
+	0xc7, 0x4d, 0xed, 0x3f, 0xb2, 0xa5, 0xad, 0x13, 0x24, 0x7d, 0x23, 0xa7, 0x02, 0x4f, 0xd0, 0xf0,
+	0x23, 0x2f, 0xcb, 0x54, 0xf1, 0x62, 0xee, 0x1e, 0x8e, 0x9c, 0xb0, 0x1b, 0x9f, 0x36, 0xb5, 0x7f,
+	0x6c, 0x81, 0x5b, 0x2b, 0x48, 0x06, 0x5a, 0xbf, 0xe3, 0xc5, 0x1c, 0xbf, 0x40, 0x3d, 0xad, 0x19,
+	0x75, 0x7b, 0x23, 0x27, 0x1c, 0xc4, 0x27, 0x4d, 0xed, 0x3f, 0xd8, 0xd7, 0x33, 0x7d, 0x6b, 0x2b,
+	0xf0, 0x37, 0x84, 0x14, 0xcc, 0x99, 0x90, 0x29, 0xac, 0x94, 0xdb, 0x1f, 0x75, 0xc3, 0xa3, 0xf3,
+	0xa7, 0x91, 0x7d, 0xdf, 0x48, 0xbf, 0x6f, 0xbb, 0x8a, 0xe8, 0x0d, 0x70, 0x11, 0xbf, 0xbd, 0xae,
+	0xfd, 0x4e, 0x53, 0xfb, 0x27, 0xb6, 0xdb, 0x1e, 0x0d, 0x7e, 0xfe, 0xf6, 0xc3, 0x19, 0x57, 0x9f,
+	0x56, 0x79, 0x54, 0xc0, 0x82, 0xec, 0x36, 0x64, 0x3f, 0x63, 0x49, 0xe7, 0x44, 0xad, 0x97, 0x4c,
+	0x9a, 0x2e, 0x32, 0x19, 0x5a, 0xf0, 0x72, 0xa5, 0xe2, 0x0f, 0xd7, 0x1b, 0xcf, 0xb9, 0xd9, 0x78,
+	0xce, 0x9f, 0x8d, 0xe7, 0x7c, 0xdf, 0x7a, 0x9d, 0x9b, 0xad, 0xd7, 0xf9, 0xb5, 0xf5, 0x3a, 0xef,
+	0xe3, 0x3b, 0xed, 0x76, 0x69, 0x19, 0x97, 0x59, 0x2e, 0xdb, 0x03, 0xb9, 0x3a, 0x9f, 0x90, 0xaf,
+	0xff, 0x24, 0x6f, 0xbc, 0x8f, 0x9e, 0x19, 0x97, 0xf7, 0x4c, 0x20, 0x5e, 0xfd, 0x0d, 0x00, 0x00,
+	0xff, 0xff, 0xb3, 0x01, 0xba, 0xaf, 0xa8, 0x02, 0x00, 0x00,
+}
+
+func (m *RangeOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRangeOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FillTick != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.FillTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DenomIn) > 0 {
+		i -= len(m.DenomIn)
+		copy(dAtA[i:], m.DenomIn)
+		i = encodeVarintRangeOrder(dAtA, i, uint64(len(m.DenomIn)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRangeOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintRangeOrder(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRangeOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRangeOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RangeOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovRangeOrder(uint64(m.PositionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovRangeOrder(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRangeOrder(uint64(l))
+	}
+	l = len(m.DenomIn)
+	if l > 0 {
+		n += 1 + l + sovRangeOrder(uint64(l))
+	}
+	if m.FillTick != 0 {
+		n += 1 + sovRangeOrder(uint64(m.FillTick))
+	}
+	if m.Filled {
+		n += 2
+	}
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovRangeOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRangeOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRangeOrder(x uint64) (n int) {
+	return sovRangeOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RangeOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRangeOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillTick", wireType)
+			}
+			m.FillTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRangeOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRangeOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRangeOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRangeOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRangeOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRangeOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRangeOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRangeOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRangeOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRangeOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRangeOrder = fmt.Errorf("proto: unexpected end of group")
+)
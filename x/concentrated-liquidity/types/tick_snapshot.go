@@ -0,0 +1,217 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// TickSnapshotVersion is the version of the binary tick snapshot format.
+// It is the first byte of every encoded snapshot.
+const TickSnapshotVersion byte = 1
+
+// maxEncodedDecBytes bounds the length of an encoded decimal so that
+// decoding a malformed snapshot cannot allocate arbitrarily large integers.
+const maxEncodedDecBytes = 64
+
+// TickLiquidity is the liquidity net of an initialized tick.
+type TickLiquidity struct {
+	TickIndex    int64
+	LiquidityNet osmomath.Dec
+}
+
+// TickSnapshot is the tick map of a concentrated liquidity pool at a given height.
+// Ticks are sorted by tick index in ascending order.
+type TickSnapshot struct {
+	PoolId           uint64
+	Height           int64
+	CurrentTick      int64
+	CurrentLiquidity osmomath.Dec
+	Ticks            []TickLiquidity
+}
+
+// EncodeTickSnapshot encodes the given snapshot into the compact binary tick snapshot format:
+//
+//	version (1 byte) || pool id (uvarint) || height (varint) || current tick (varint) ||
+//	current liquidity (dec) || number of ticks (uvarint) || ticks
+//
+// The first tick index is encoded as a varint and every following tick index as the uvarint
+// distance from the previous one, each followed by the liquidity net of the tick.
+// A dec is encoded as the uvarint of its byte length shifted left by one with the sign in the
+// lowest bit, followed by the big endian bytes of the absolute value of its underlying integer.
+//
+// Returns error if the ticks are not sorted in strictly ascending order.
+func EncodeTickSnapshot(snapshot TickSnapshot) ([]byte, error) {
+	bz := []byte{TickSnapshotVersion}
+	bz = binary.AppendUvarint(bz, snapshot.PoolId)
+	bz = binary.AppendVarint(bz, snapshot.Height)
+	bz = binary.AppendVarint(bz, snapshot.CurrentTick)
+	bz = appendDec(bz, snapshot.CurrentLiquidity)
+	bz = binary.AppendUvarint(bz, uint64(len(snapshot.Ticks)))
+
+	for i, tick := range snapshot.Ticks {
+		if i == 0 {
+			bz = binary.AppendVarint(bz, tick.TickIndex)
+		} else {
+			previousTickIndex := snapshot.Ticks[i-1].TickIndex
+			if tick.TickIndex <= previousTickIndex {
+				return nil, InvalidTickSnapshotError{Reason: fmt.Sprintf("tick (%d) is not greater than the previous tick (%d)", tick.TickIndex, previousTickIndex)}
+			}
+			bz = binary.AppendUvarint(bz, uint64(tick.TickIndex-previousTickIndex))
+		}
+		bz = appendDec(bz, tick.LiquidityNet)
+	}
+
+	return bz, nil
+}
+
+// DecodeTickSnapshot decodes a snapshot encoded with EncodeTickSnapshot.
+// Returns error if the snapshot is malformed or of an unsupported version.
+func DecodeTickSnapshot(bz []byte) (TickSnapshot, error) {
+	if len(bz) == 0 {
+		return TickSnapshot{}, InvalidTickSnapshotError{Reason: "empty snapshot"}
+	}
+	if bz[0] != TickSnapshotVersion {
+		return TickSnapshot{}, InvalidTickSnapshotError{Reason: fmt.Sprintf("unsupported version (%d)", bz[0])}
+	}
+
+	d := snapshotDecoder{bz: bz[1:]}
+	snapshot := TickSnapshot{
+		PoolId:           d.uvarint(),
+		Height:           d.varint(),
+		CurrentTick:      d.varint(),
+		CurrentLiquidity: d.dec(),
+	}
+
+	numTicks := d.uvarint()
+	// Every tick takes at least two bytes, which bounds the allocation below.
+	if d.err == nil && numTicks > uint64(len(d.bz)/2) {
+		d.err = InvalidTickSnapshotError{Reason: fmt.Sprintf("number of ticks (%d) exceeds the snapshot length", numTicks)}
+	}
+
+	if d.err == nil {
+		snapshot.Ticks = make([]TickLiquidity, 0, numTicks)
+	}
+	for i := uint64(0); d.err == nil && i < numTicks; i++ {
+		var tickIndex int64
+		if i == 0 {
+			tickIndex = d.varint()
+		} else {
+			distance := d.uvarint()
+			if d.err == nil && distance == 0 {
+				d.err = InvalidTickSnapshotError{Reason: "ticks are not strictly ascending"}
+			}
+			tickIndex = snapshot.Ticks[i-1].TickIndex + int64(distance)
+		}
+		snapshot.Ticks = append(snapshot.Ticks, TickLiquidity{TickIndex: tickIndex, LiquidityNet: d.dec()})
+	}
+
+	if d.err == nil && len(d.bz) != 0 {
+		d.err = InvalidTickSnapshotError{Reason: fmt.Sprintf("(%d) trailing bytes", len(d.bz))}
+	}
+	if d.err != nil {
+		return TickSnapshot{}, d.err
+	}
+
+	return snapshot, nil
+}
+
+// ApplyChanges updates the snapshot with the tick changes that happened after its height
+// up to and including the given height, as returned by the tick liquidity changes query.
+// A change with zero liquidity net removes the tick from the snapshot.
+// Returns error if the given height is lower than the height of the snapshot.
+func (s *TickSnapshot) ApplyChanges(height int64, currentTick int64, currentLiquidity osmomath.Dec, changes []TickLiquidity) error {
+	if height < s.Height {
+		return InvalidTickSnapshotError{Reason: fmt.Sprintf("cannot apply changes at height (%d) to a snapshot at height (%d)", height, s.Height)}
+	}
+
+	liquidityNetByTick := make(map[int64]osmomath.Dec, len(s.Ticks)+len(changes))
+	for _, tick := range s.Ticks {
+		liquidityNetByTick[tick.TickIndex] = tick.LiquidityNet
+	}
+	for _, change := range changes {
+		if change.LiquidityNet.IsZero() {
+			delete(liquidityNetByTick, change.TickIndex)
+		} else {
+			liquidityNetByTick[change.TickIndex] = change.LiquidityNet
+		}
+	}
+
+	ticks := make([]TickLiquidity, 0, len(liquidityNetByTick))
+	for tickIndex, liquidityNet := range liquidityNetByTick {
+		ticks = append(ticks, TickLiquidity{TickIndex: tickIndex, LiquidityNet: liquidityNet})
+	}
+	sort.Slice(ticks, func(i, j int) bool { return ticks[i].TickIndex < ticks[j].TickIndex })
+
+	s.Height = height
+	s.CurrentTick = currentTick
+	s.CurrentLiquidity = currentLiquidity
+	s.Ticks = ticks
+	return nil
+}
+
+// appendDec appends the binary encoding of the given dec to bz.
+func appendDec(bz []byte, d osmomath.Dec) []byte {
+	i := d.BigInt()
+	abs := i.Bytes()
+	header := uint64(len(abs)) << 1
+	if i.Sign() < 0 {
+		header |= 1
+	}
+	bz = binary.AppendUvarint(bz, header)
+	return append(bz, abs...)
+}
+
+// snapshotDecoder reads the fields of an encoded snapshot in order.
+// Once an error occurs, every following read is a no-op returning a zero value.
+type snapshotDecoder struct {
+	bz  []byte
+	err error
+}
+
+func (d *snapshotDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.bz)
+	if n <= 0 {
+		d.err = InvalidTickSnapshotError{Reason: "malformed uvarint"}
+		return 0
+	}
+	d.bz = d.bz[n:]
+	return v
+}
+
+func (d *snapshotDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.bz)
+	if n <= 0 {
+		d.err = InvalidTickSnapshotError{Reason: "malformed varint"}
+		return 0
+	}
+	d.bz = d.bz[n:]
+	return v
+}
+
+func (d *snapshotDecoder) dec() osmomath.Dec {
+	header := d.uvarint()
+	if d.err != nil {
+		return osmomath.Dec{}
+	}
+	length := header >> 1
+	if length > maxEncodedDecBytes || length > uint64(len(d.bz)) {
+		d.err = InvalidTickSnapshotError{Reason: fmt.Sprintf("malformed dec of length (%d)", length)}
+		return osmomath.Dec{}
+	}
+	i := new(big.Int).SetBytes(d.bz[:length])
+	if header&1 == 1 {
+		i.Neg(i)
+	}
+	d.bz = d.bz[length:]
+	return osmomath.NewDecFromBigIntWithPrec(i, osmomath.DecPrecision)
+}
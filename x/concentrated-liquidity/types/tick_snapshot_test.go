@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func defaultTickSnapshot() types.TickSnapshot {
+	return types.TickSnapshot{
+		PoolId:           7,
+		Height:           1234,
+		CurrentTick:      -150,
+		CurrentLiquidity: osmomath.MustNewDecFromStr("1517882343.751510418088349649"),
+		Ticks: []types.TickLiquidity{
+			{TickIndex: types.MinInitializedTick, LiquidityNet: osmomath.MustNewDecFromStr("1517882343.751510418088349649")},
+			{TickIndex: -200, LiquidityNet: osmomath.MustNewDecFromStr("0.000000000000000001")},
+			{TickIndex: 0, LiquidityNet: osmomath.MustNewDecFromStr("-0.000000000000000001")},
+			{TickIndex: types.MaxTick, LiquidityNet: osmomath.MustNewDecFromStr("-1517882343.751510418088349650")},
+		},
+	}
+}
+
+func TestEncodeDecodeTickSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		snapshot types.TickSnapshot
+	}{
+		"ticks across the full range": {
+			snapshot: defaultTickSnapshot(),
+		},
+		"no ticks": {
+			snapshot: types.TickSnapshot{
+				PoolId:           1,
+				Height:           1,
+				CurrentTick:      0,
+				CurrentLiquidity: osmomath.ZeroDec(),
+				Ticks:            []types.TickLiquidity{},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bz, err := types.EncodeTickSnapshot(tc.snapshot)
+			require.NoError(t, err)
+			require.Equal(t, types.TickSnapshotVersion, bz[0])
+
+			decoded, err := types.DecodeTickSnapshot(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.snapshot.PoolId, decoded.PoolId)
+			require.Equal(t, tc.snapshot.Height, decoded.Height)
+			require.Equal(t, tc.snapshot.CurrentTick, decoded.CurrentTick)
+			require.True(t, tc.snapshot.CurrentLiquidity.Equal(decoded.CurrentLiquidity))
+			require.Len(t, decoded.Ticks, len(tc.snapshot.Ticks))
+			for i, tick := range tc.snapshot.Ticks {
+				require.Equal(t, tick.TickIndex, decoded.Ticks[i].TickIndex)
+				require.True(t, tick.LiquidityNet.Equal(decoded.Ticks[i].LiquidityNet), "expected %s, got %s", tick.LiquidityNet, decoded.Ticks[i].LiquidityNet)
+			}
+		})
+	}
+}
+
+func TestEncodeTickSnapshot_UnsortedTicks(t *testing.T) {
+	snapshot := defaultTickSnapshot()
+	snapshot.Ticks[1], snapshot.Ticks[2] = snapshot.Ticks[2], snapshot.Ticks[1]
+
+	_, err := types.EncodeTickSnapshot(snapshot)
+	require.ErrorAs(t, err, &types.InvalidTickSnapshotError{})
+}
+
+func TestDecodeTickSnapshot_ErrorCases(t *testing.T) {
+	bz, err := types.EncodeTickSnapshot(defaultTickSnapshot())
+	require.NoError(t, err)
+
+	unsupportedVersion := append([]byte{}, bz...)
+	unsupportedVersion[0] = types.TickSnapshotVersion + 1
+
+	tests := map[string][]byte{
+		"empty":               {},
+		"unsupported version": unsupportedVersion,
+		"truncated":           bz[:len(bz)-1],
+		"trailing bytes":      append(append([]byte{}, bz...), 0),
+		"only version":        {types.TickSnapshotVersion},
+	}
+
+	for name, bz := range tests {
+		bz := bz
+		t.Run(name, func(t *testing.T) {
+			_, err := types.DecodeTickSnapshot(bz)
+			require.ErrorAs(t, err, &types.InvalidTickSnapshotError{})
+		})
+	}
+}
+
+func TestTickSnapshotApplyChanges(t *testing.T) {
+	snapshot := defaultTickSnapshot()
+	newCurrentLiquidity := osmomath.NewDec(5)
+
+	err := snapshot.ApplyChanges(snapshot.Height-1, 0, newCurrentLiquidity, nil)
+	require.ErrorAs(t, err, &types.InvalidTickSnapshotError{})
+
+	err = snapshot.ApplyChanges(snapshot.Height+10, 100, newCurrentLiquidity, []types.TickLiquidity{
+		// Updated tick.
+		{TickIndex: -200, LiquidityNet: osmomath.NewDec(2)},
+		// Removed tick.
+		{TickIndex: 0, LiquidityNet: osmomath.ZeroDec()},
+		// New tick.
+		{TickIndex: 100, LiquidityNet: osmomath.NewDec(-3)},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, int64(1244), snapshot.Height)
+	require.Equal(t, int64(100), snapshot.CurrentTick)
+	require.Equal(t, newCurrentLiquidity, snapshot.CurrentLiquidity)
+	require.Equal(t, []types.TickLiquidity{
+		{TickIndex: types.MinInitializedTick, LiquidityNet: osmomath.MustNewDecFromStr("1517882343.751510418088349649")},
+		{TickIndex: -200, LiquidityNet: osmomath.NewDec(2)},
+		{TickIndex: 100, LiquidityNet: osmomath.NewDec(-3)},
+		{TickIndex: types.MaxTick, LiquidityNet: osmomath.MustNewDecFromStr("-1517882343.751510418088349650")},
+	}, snapshot.Ticks)
+
+	// The updated snapshot can still be encoded.
+	_, err = types.EncodeTickSnapshot(snapshot)
+	require.NoError(t, err)
+}
@@ -199,7 +199,7 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
-	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut, pool.GetSpreadFactor(ctx))
 	k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
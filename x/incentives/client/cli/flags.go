@@ -16,6 +16,8 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+	FlagLowerTick = "lower-tick"
+	FlagUpperTick = "upper-tick"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -27,5 +29,7 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.Int64(FlagLowerTick, 0, "Lower tick of the range that the positions incentivized by a no lock gauge must sit within, set along with the upper tick. Existing positions join the range the next time they are modified or collect their incentives")
+	fs.Int64(FlagUpperTick, 0, "Upper tick of the range that the positions incentivized by a no lock gauge must sit within, set along with the lower tick")
 	return fs
 }
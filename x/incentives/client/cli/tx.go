@@ -14,6 +14,7 @@ import (
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
@@ -40,7 +41,12 @@ func NewCreateGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-gauge [lockup_denom] [reward] [poolId] [flags]",
 		Short: "create a gauge to distribute rewards to users. For duration lock gauges set poolId = 0 and for all CL (no-lock) gauges set it to a CL poolId.",
-		Args:  cobra.ExactArgs(3),
+		Long: `create a gauge to distribute rewards to users. For duration lock gauges set poolId = 0 and for all CL (no-lock) gauges set it to a CL poolId.
+
+The rewards of a CL (no-lock) gauge can be restricted to the positions whose range sits within the tick range set by --lower-tick and --upper-tick.
+Existing positions are not added to the tick range: a qualifying position joins it the next time it is modified or collects its incentives,
+and only earns the rewards from then on. The rewards are not emitted while no qualifying position has joined, they remain in the incentive record.`,
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -125,6 +131,23 @@ func NewCreateGaugeCmd() *cobra.Command {
 				poolId,
 			)
 
+			// Only restrict the gauge to a tick range if both ticks are set, since 0 is a valid tick
+			if cmd.Flags().Changed(FlagLowerTick) || cmd.Flags().Changed(FlagUpperTick) {
+				if !cmd.Flags().Changed(FlagLowerTick) || !cmd.Flags().Changed(FlagUpperTick) {
+					return errors.New("both the lower and upper ticks must be set to restrict the gauge to a tick range")
+				}
+
+				lowerTick, err := cmd.Flags().GetInt64(FlagLowerTick)
+				if err != nil {
+					return err
+				}
+				upperTick, err := cmd.Flags().GetInt64(FlagUpperTick)
+				if err != nil {
+					return err
+				}
+				msg.TickRange = &cltypes.TickRange{LowerTick: lowerTick, UpperTick: upperTick}
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
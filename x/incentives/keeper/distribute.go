@@ -12,6 +12,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -616,20 +617,25 @@ func (k Keeper) distributeInternal(
 			emissionRate := osmomath.NewDecFromInt(remainAmountPerEpoch).QuoTruncateMut(osmomath.NewDec(currentEpoch.Duration.Milliseconds()).QuoMut(millisecondsInSecDec))
 
 			ctx.Logger().Info("distributeInternal, CreateIncentiveRecord NoLock gauge", "module", types.ModuleName, "gaugeId", gauge.Id, "poolId", pool.GetId(), "remainCoinPerEpoch", remainCoinPerEpoch, "height", ctx.BlockHeight())
-			_, err := k.clk.CreateIncentive(ctx,
-				pool.GetId(),
-				k.ak.GetModuleAddress(types.ModuleName),
-				remainCoinPerEpoch,
-				emissionRate,
-				// Use current block time as start time, NOT the gauge start time.
-				// Gauge start time should be checked whenever moving between active
-				// and inactive gauges. By the time we get here, the gauge should be active.
-				ctx.BlockTime(),
-				// Only default uptime is supported at launch.
-				types.DefaultConcentratedUptime,
-			)
+			// Use current block time as start time, NOT the gauge start time.
+			// Gauge start time should be checked whenever moving between active
+			// and inactive gauges. By the time we get here, the gauge should be active.
+			// Only default uptime is supported at launch.
+			var err error
+			if gauge.TickRange != nil {
+				// Gauges restricted to a tick range only incentivize the positions whose range sits within it.
+				_, err = k.clk.CreateTickRangeIncentive(ctx, pool.GetId(), k.ak.GetModuleAddress(types.ModuleName), remainCoinPerEpoch, emissionRate, ctx.BlockTime(), types.DefaultConcentratedUptime, *gauge.TickRange)
+			} else {
+				_, err = k.clk.CreateIncentive(ctx, pool.GetId(), k.ak.GetModuleAddress(types.ModuleName), remainCoinPerEpoch, emissionRate, ctx.BlockTime(), types.DefaultConcentratedUptime)
+			}
 
 			ctx.Logger().Info(fmt.Sprintf("distributeInternal CL for pool id %d finished", pool.GetId()))
+			if errors.As(err, &cltypes.IncentiveTickRangeLimitError{}) {
+				// The pool has no room for the tick range of the gauge. The limit is hit by the first coin already, so nothing
+				// was distributed and the gauge is left as is to be distributed once there is room.
+				ctx.Logger().Error("distributeInternal CL tick range limit reached", "gaugeId", gauge.Id, "poolId", pool.GetId(), "error", err.Error())
+				return nil, nil
+			}
 			if err != nil {
 				return nil, err
 			}
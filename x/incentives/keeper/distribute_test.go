@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
 	appParams "github.com/osmosis-labs/osmosis/v21/app/params"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	incentivetypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -589,6 +590,82 @@ func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock() {
 	}
 }
 
+// TestDistribute_ExternalIncentives_NoLock_TickRange tests that the distribution of an externally created
+// NoLock gauge restricted to a tick range creates a concentrated liquidity incentive record restricted to it.
+func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock_TickRange() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(123456789, 0))
+
+	clPool := s.PrepareConcentratedPool()
+	tickRange := cltypes.TickRange{LowerTick: -1000, UpperTick: 1000}
+	gaugeCoins := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 5000))
+	s.FundAcc(s.TestAccs[0], gaugeCoins)
+
+	gaugeId, err := s.App.IncentivesKeeper.CreateTickRangeGauge(s.Ctx, false, s.TestAccs[0], gaugeCoins, lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock}, s.Ctx.BlockTime(), 1, clPool.GetId(), tickRange)
+	s.Require().NoError(err)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(&tickRange, gauge.TickRange)
+
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+
+	// System under test.
+	totalDistributedCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().Equal(gaugeCoins.String(), totalDistributedCoins.String())
+
+	// The incentive record is restricted to the tick range of the gauge.
+	incentiveRecord, err := s.App.ConcentratedLiquidityKeeper.GetIncentiveRecord(s.Ctx, clPool.GetId(), time.Nanosecond, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(&tickRange, incentiveRecord.IncentiveRecordBody.TickRange)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, clPool.GetId(), tickRange)
+	s.Require().NoError(err)
+}
+
+// TestDistribute_ExternalIncentives_NoLock_TickRangeLimit tests that the distribution of a NoLock gauge restricted to a tick range
+// is skipped without failing the epoch nor consuming an epoch of the gauge when the pool already has the maximum number of
+// incentive tick ranges.
+func (s *KeeperTestSuite) TestDistribute_ExternalIncentives_NoLock_TickRangeLimit() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(123456789, 0))
+
+	clPool := s.PrepareConcentratedPool()
+	tickRange := cltypes.TickRange{LowerTick: -1000, UpperTick: 1000}
+	gaugeCoins := sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 5000))
+	s.FundAcc(s.TestAccs[0], gaugeCoins)
+
+	gaugeId, err := s.App.IncentivesKeeper.CreateTickRangeGauge(s.Ctx, false, s.TestAccs[0], gaugeCoins, lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock}, s.Ctx.BlockTime(), 1, clPool.GetId(), tickRange)
+	s.Require().NoError(err)
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+	s.Require().NoError(err)
+
+	// Fill the pool with incentive tick ranges other than the one of the gauge.
+	incentiveCoin := sdk.NewInt64Coin(defaultRewardDenom, 1000)
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(defaultRewardDenom, incentiveCoin.Amount.MulRaw(cltypes.MaxIncentiveTickRangesPerPool))))
+	for i := 0; i < cltypes.MaxIncentiveTickRangesPerPool; i++ {
+		_, err := s.App.ConcentratedLiquidityKeeper.CreateTickRangeIncentive(s.Ctx, clPool.GetId(), s.TestAccs[1], incentiveCoin, osmomath.OneDec(), s.Ctx.BlockTime(), time.Nanosecond, cltypes.TickRange{LowerTick: 2000, UpperTick: 3000 + int64(i)*100})
+		s.Require().NoError(err)
+	}
+
+	// System under test.
+	totalDistributedCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().True(totalDistributedCoins.IsZero())
+
+	// The gauge is left as is so that its coins are distributed once the pool has room for its tick range.
+	gauge, err = s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), gauge.FilledEpochs)
+	s.Require().True(gauge.DistributedCoins.IsZero())
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetIncentiveTickRange(s.Ctx, clPool.GetId(), tickRange)
+	s.Require().ErrorIs(err, cltypes.IncentiveTickRangeNotFoundError{PoolId: clPool.GetId(), LowerTick: tickRange.LowerTick, UpperTick: tickRange.UpperTick})
+}
+
 // TestSyntheticDistribute tests that when the distribute command is executed on a provided gauge
 // the correct amount of rewards is sent to the correct synthetic lock owners.
 func (s *KeeperTestSuite) TestSyntheticDistribute() {
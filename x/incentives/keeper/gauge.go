@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -123,6 +124,24 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 //
 // On success, returns the gauge ID.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, poolId, nil)
+}
+
+// CreateTickRangeGauge creates a lockuptypes.NoLock gauge with the given parameters whose distributions only incentivize
+// the concentrated liquidity positions of the pool whose range sits within the given tick range, e.g. a band around a peg.
+// The tick range must be valid for the tick spacing of the pool. See CreateGauge for the other requirements.
+//
+// On success, returns the gauge ID.
+func (k Keeper) CreateTickRangeGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64, tickRange cltypes.TickRange) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, poolId, &tickRange)
+}
+
+// createGauge creates a gauge with the given parameters, restricted to the given tick range if non-nil.
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64, tickRange *cltypes.TickRange) (uint64, error) {
+	if tickRange != nil && distrTo.LockQueryType != lockuptypes.NoLock {
+		return 0, fmt.Errorf("only 'no lock' type gauges can be restricted to a tick range")
+	}
+
 	if numEpochsPaidOver == types.PerpetualNumEpochsPaidOver && !isPerpetual {
 		return 0, types.ErrZeroNumEpochsPaidOver
 	}
@@ -172,6 +191,12 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 			return 0, fmt.Errorf("'no lock' type gauges must be created for concentrated pools only")
 		}
 
+		if tickRange != nil {
+			if err := k.clk.ValidateIncentiveTickRange(ctx, poolId, *tickRange); err != nil {
+				return 0, err
+			}
+		}
+
 		// Note that this is a general linking between the gauge and the pool
 		// for "NoLock" gauges. It occurs for both external and internal gauges.
 		// That being said, internal gauges have an additional linking
@@ -204,6 +229,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		TickRange:         tickRange,
 	}
 
 	// Fixed gas consumption create gauge based on the number of coins to add
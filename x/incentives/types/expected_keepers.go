@@ -49,6 +49,8 @@ type TxFeesKeeper interface {
 
 type ConcentratedLiquidityKeeper interface {
 	CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration) (cltypes.IncentiveRecord, error)
+	CreateTickRangeIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration, tickRange cltypes.TickRange) (cltypes.IncentiveRecord, error)
+	ValidateIncentiveTickRange(ctx sdk.Context, poolId uint64, tickRange cltypes.TickRange) error
 	GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
 }
 
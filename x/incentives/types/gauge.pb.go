@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types2 "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	types "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
@@ -59,6 +60,15 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// tick_range restricts the concentrated liquidity incentives created by a
+	// "NoLock" gauge to the positions whose range sits within it. If unset, the
+	// incentives are distributed to all in-range liquidity of the pool.
+	// Existing positions are not added to the tick range when it is
+	// created: a qualifying position joins it the next time it is modified or
+	// collects its incentives, and only earns from then on. The incentives are
+	// not emitted while no qualifying position has joined, they remain in the
+	// incentive record.
+	TickRange *types2.TickRange `protobuf:"bytes,9,opt,name=tick_range,json=tickRange,proto3" json:"tick_range,omitempty" yaml:"tick_range"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -150,6 +160,13 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetTickRange() *types2.TickRange {
+	if m != nil {
+		return m.TickRange
+	}
+	return nil
+}
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -203,42 +220,46 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xad, 0xdd, 0x6f, 0x3f, 0xb7, 0x43, 0xd4, 0x1a, 0x52, 0x5a, 0x89, 0xb4, 0x14,
-	0x21, 0xf5, 0x32, 0x9b, 0x16, 0x89, 0x03, 0xc7, 0x0e, 0x84, 0x26, 0x21, 0x51, 0xaa, 0x1d, 0x10,
-	0x97, 0xc8, 0x49, 0xdc, 0xcc, 0x6a, 0x92, 0x27, 0x8a, 0x9d, 0x6a, 0x7d, 0x07, 0x1c, 0x27, 0x4e,
-	0xbc, 0x06, 0x5e, 0xc9, 0x8e, 0x3b, 0x72, 0xda, 0x50, 0xfb, 0x0e, 0x78, 0x05, 0x28, 0x76, 0xa2,
-	0x56, 0xe5, 0xca, 0xc9, 0xf1, 0xf3, 0x7d, 0xfe, 0x7d, 0x3f, 0x72, 0x90, 0x03, 0x32, 0x06, 0x29,
-	0x24, 0x15, 0x89, 0xcf, 0x13, 0x25, 0x96, 0x5c, 0xd2, 0x90, 0xe5, 0x21, 0x27, 0x69, 0x06, 0x0a,
-	0x30, 0x2e, 0x75, 0xb2, 0xd5, 0xbb, 0xa7, 0x21, 0x84, 0xa0, 0x65, 0x5a, 0x7c, 0x99, 0xcc, 0xae,
-	0x13, 0x02, 0x84, 0x11, 0xa7, 0xfa, 0xe6, 0xe5, 0x73, 0x1a, 0xe4, 0x19, 0x53, 0x02, 0x92, 0x52,
-	0xef, 0xed, 0xeb, 0x4a, 0xc4, 0x5c, 0x2a, 0x16, 0xa7, 0x55, 0x03, 0x5f, 0xcf, 0xa2, 0x1e, 0x93,
-	0x9c, 0x2e, 0x47, 0x1e, 0x57, 0x6c, 0x44, 0x7d, 0x10, 0x55, 0x83, 0x4e, 0xb5, 0x6a, 0x04, 0xfe,
-	0x22, 0x4f, 0xf5, 0x61, 0xa4, 0xc1, 0xb7, 0x3a, 0x6a, 0xbc, 0x2f, 0xb6, 0xc6, 0x8f, 0xd0, 0x81,
-	0x08, 0x6c, 0xab, 0x6f, 0x0d, 0xeb, 0xb3, 0x03, 0x11, 0xe0, 0x67, 0xa8, 0x25, 0xa4, 0x9b, 0xf2,
-	0x2c, 0xe5, 0x2a, 0x67, 0x91, 0x7d, 0xd0, 0xb7, 0x86, 0xc7, 0xb3, 0xa6, 0x90, 0xd3, 0x2a, 0x84,
-	0x2f, 0xd0, 0x49, 0x20, 0xa4, 0xca, 0x84, 0x97, 0x2b, 0xee, 0x2a, 0xb0, 0x0f, 0xfb, 0xd6, 0xb0,
-	0x39, 0x76, 0x48, 0x65, 0xdd, 0xcc, 0x23, 0x9f, 0x72, 0x9e, 0xad, 0xce, 0x21, 0x09, 0x44, 0xe1,
-	0x6a, 0x52, 0xbf, 0xbd, 0xef, 0xd5, 0x66, 0xad, 0x6d, 0xe9, 0x25, 0x60, 0x86, 0x1a, 0xc5, 0xc2,
-	0xd2, 0xae, 0xf7, 0x0f, 0x87, 0xcd, 0x71, 0x87, 0x18, 0x4b, 0xa4, 0xb0, 0x44, 0x4a, 0x4b, 0xe4,
-	0x1c, 0x44, 0x32, 0x79, 0x59, 0x54, 0xff, 0x78, 0xe8, 0x0d, 0x43, 0xa1, 0xae, 0x72, 0x8f, 0xf8,
-	0x10, 0xd3, 0xd2, 0xbf, 0x39, 0xce, 0x64, 0xb0, 0xa0, 0x6a, 0x95, 0x72, 0xa9, 0x0b, 0xe4, 0xcc,
-	0x74, 0xc6, 0x9f, 0x11, 0x92, 0x8a, 0x65, 0xca, 0x2d, 0xf0, 0xd9, 0x0d, 0xbd, 0x6a, 0x97, 0x18,
-	0xb6, 0xa4, 0x62, 0x4b, 0x2e, 0x2b, 0xb6, 0x93, 0xa7, 0xc5, 0xa0, 0xdf, 0xf7, 0xbd, 0xf6, 0x8a,
-	0xc5, 0xd1, 0x9b, 0xc1, 0xb6, 0x76, 0x70, 0xf3, 0xd0, 0xb3, 0x66, 0xff, 0xeb, 0x40, 0x91, 0x8e,
-	0x29, 0x3a, 0x4d, 0xf2, 0xd8, 0xe5, 0x29, 0xf8, 0x57, 0xd2, 0x4d, 0x99, 0x08, 0x5c, 0x58, 0xf2,
-	0xcc, 0x3e, 0xd2, 0x30, 0xdb, 0x49, 0x1e, 0xbf, 0xd3, 0xd2, 0x94, 0x89, 0xe0, 0xe3, 0x92, 0x67,
-	0xf8, 0x39, 0x3a, 0x99, 0x8b, 0x28, 0xe2, 0x41, 0x59, 0x63, 0xff, 0xa7, 0x33, 0x5b, 0x26, 0x68,
-	0x92, 0xf1, 0x35, 0x6a, 0x6f, 0x11, 0x05, 0xae, 0xc1, 0x73, 0xfc, 0xef, 0xf1, 0x3c, 0xde, 0x99,
-	0xa2, 0x23, 0x83, 0xaf, 0x16, 0x7a, 0xf2, 0x01, 0xfc, 0x05, 0xf3, 0x22, 0xfe, 0xb6, 0x7c, 0x8b,
-	0xf2, 0x22, 0x99, 0x03, 0x06, 0x84, 0xa3, 0x52, 0x70, 0xab, 0x57, 0x2a, 0x6d, 0xab, 0x5c, 0x6a,
-	0x9f, 0x65, 0x55, 0x3b, 0x79, 0x51, 0xa2, 0xec, 0x18, 0x94, 0x7f, 0xb7, 0x18, 0x7c, 0x2f, 0x90,
-	0xb6, 0xa3, 0xfd, 0xa1, 0x93, 0xe9, 0xed, 0xda, 0xb1, 0xee, 0xd6, 0x8e, 0xf5, 0x6b, 0xed, 0x58,
-	0x37, 0x1b, 0xa7, 0x76, 0xb7, 0x71, 0x6a, 0x3f, 0x37, 0x4e, 0xed, 0xcb, 0xeb, 0x1d, 0x83, 0xe5,
-	0x7b, 0x3b, 0x8b, 0x98, 0x27, 0xab, 0x0b, 0x5d, 0x8e, 0x47, 0xf4, 0x7a, 0xf7, 0xef, 0xd4, 0xa6,
-	0xbd, 0x23, 0xbd, 0xde, 0xab, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xa7, 0xd3, 0x19, 0xc0,
-	0x03, 0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0xdb, 0x3e,
+	0x18, 0xc7, 0x1b, 0x28, 0xfc, 0xc0, 0xc0, 0x4f, 0xab, 0x05, 0x52, 0x40, 0x5a, 0xda, 0x75, 0x9a,
+	0xd4, 0x0b, 0x36, 0x30, 0x69, 0x87, 0x69, 0xa7, 0xb0, 0x69, 0x42, 0x9a, 0x34, 0x16, 0x71, 0x98,
+	0x76, 0x89, 0x9c, 0xd8, 0x0d, 0x56, 0x93, 0x38, 0xb3, 0x9d, 0x8a, 0xbe, 0x83, 0x1d, 0x39, 0xee,
+	0x35, 0xec, 0x95, 0x70, 0xe4, 0xb8, 0x13, 0x4c, 0xf0, 0x0e, 0x76, 0xdc, 0x69, 0x8a, 0x13, 0x37,
+	0xa8, 0xdb, 0x71, 0xa7, 0x34, 0xfe, 0x3e, 0xdf, 0xe7, 0xcf, 0xe7, 0xa9, 0x03, 0x3c, 0xa1, 0x32,
+	0xa1, 0xb8, 0xc2, 0x3c, 0x8f, 0x59, 0xae, 0xf9, 0x94, 0x29, 0x9c, 0x90, 0x32, 0x61, 0xa8, 0x90,
+	0x42, 0x0b, 0x08, 0x1b, 0x1d, 0xb5, 0xfa, 0xde, 0x76, 0x22, 0x12, 0x61, 0x64, 0x5c, 0xfd, 0xaa,
+	0x23, 0xf7, 0xbc, 0x44, 0x88, 0x24, 0x65, 0xd8, 0xbc, 0x45, 0xe5, 0x18, 0xd3, 0x52, 0x12, 0xcd,
+	0x45, 0xde, 0xe8, 0xfd, 0x45, 0x5d, 0xf3, 0x8c, 0x29, 0x4d, 0xb2, 0xc2, 0x26, 0x88, 0x4d, 0x2d,
+	0x1c, 0x11, 0xc5, 0xf0, 0xf4, 0x30, 0x62, 0x9a, 0x1c, 0xe2, 0x58, 0x70, 0x9b, 0x60, 0xd7, 0xb6,
+	0x9a, 0x8a, 0x78, 0x52, 0x16, 0xe6, 0xd1, 0x48, 0xaf, 0xac, 0x14, 0x0b, 0xd3, 0xa6, 0x24, 0x9a,
+	0xd1, 0x94, 0x7f, 0x2e, 0x39, 0xe5, 0x7a, 0x36, 0x4f, 0x36, 0x9f, 0x21, 0x94, 0x2c, 0x16, 0x92,
+	0xd6, 0xee, 0xe1, 0xaf, 0x2e, 0x58, 0x79, 0x5b, 0xcd, 0x0c, 0xff, 0x07, 0x4b, 0x9c, 0xba, 0xce,
+	0xc0, 0x19, 0x75, 0x83, 0x25, 0x4e, 0xe1, 0x13, 0xb0, 0xc9, 0x55, 0x58, 0x30, 0x59, 0x30, 0x5d,
+	0x92, 0xd4, 0x5d, 0x1a, 0x38, 0xa3, 0xb5, 0x60, 0x83, 0xab, 0x53, 0x7b, 0x04, 0x4f, 0xc0, 0x16,
+	0xe5, 0x4a, 0x4b, 0x1e, 0x95, 0x9a, 0x85, 0x5a, 0xb8, 0xcb, 0x03, 0x67, 0xb4, 0x71, 0xe4, 0x21,
+	0x0b, 0xae, 0xee, 0x16, 0x7d, 0x28, 0x99, 0x9c, 0x1d, 0x8b, 0x9c, 0xf2, 0x8a, 0x89, 0xdf, 0xbd,
+	0xba, 0xe9, 0x77, 0x82, 0xcd, 0xd6, 0x7a, 0x26, 0x20, 0x01, 0x2b, 0xd5, 0xb8, 0xca, 0xed, 0x0e,
+	0x96, 0x47, 0x1b, 0x47, 0xbb, 0xa8, 0x06, 0x82, 0x2a, 0x20, 0xa8, 0x99, 0x01, 0x1d, 0x0b, 0x9e,
+	0xfb, 0x07, 0x95, 0xfb, 0xdb, 0x6d, 0x7f, 0x94, 0x70, 0x7d, 0x5e, 0x46, 0x28, 0x16, 0x19, 0x6e,
+	0xe8, 0xd5, 0x8f, 0x7d, 0x45, 0x27, 0x58, 0xcf, 0x0a, 0xa6, 0x8c, 0x41, 0x05, 0x75, 0x66, 0xf8,
+	0x11, 0x00, 0xa5, 0x89, 0xd4, 0x61, 0x05, 0xdf, 0x5d, 0x31, 0xad, 0xee, 0xa1, 0x7a, 0x33, 0xc8,
+	0x6e, 0x06, 0x9d, 0xd9, 0xcd, 0xf8, 0x8f, 0xab, 0x42, 0x3f, 0x6f, 0xfa, 0xbd, 0x19, 0xc9, 0xd2,
+	0x97, 0xc3, 0xd6, 0x3b, 0xbc, 0xbc, 0xed, 0x3b, 0xc1, 0xba, 0x39, 0xa8, 0xc2, 0x21, 0x06, 0xdb,
+	0x79, 0x99, 0x85, 0xac, 0x10, 0xf1, 0xb9, 0x0a, 0x0b, 0xc2, 0x69, 0x28, 0xa6, 0x4c, 0xba, 0xab,
+	0x06, 0x66, 0x2f, 0x2f, 0xb3, 0x37, 0x46, 0x3a, 0x25, 0x9c, 0xbe, 0x9f, 0x32, 0x09, 0x9f, 0x82,
+	0xad, 0x31, 0x4f, 0x53, 0x46, 0x1b, 0x8f, 0xfb, 0x9f, 0x89, 0xdc, 0xac, 0x0f, 0xeb, 0x60, 0x78,
+	0x01, 0x7a, 0x2d, 0x22, 0x1a, 0xd6, 0x78, 0xd6, 0xfe, 0x3d, 0x9e, 0x47, 0x0f, 0xaa, 0x98, 0x13,
+	0x38, 0x06, 0x40, 0xf3, 0x78, 0x12, 0x4a, 0x92, 0x27, 0xcc, 0x5d, 0x37, 0xa4, 0x0e, 0xe6, 0x4b,
+	0xfd, 0xeb, 0xff, 0x6c, 0xde, 0xc4, 0x19, 0x8f, 0x27, 0x41, 0xe5, 0xf3, 0x77, 0x5a, 0x76, 0x6d,
+	0xb6, 0x61, 0xb0, 0xae, 0x6d, 0xc4, 0xf0, 0x8b, 0x03, 0x76, 0xde, 0x89, 0x78, 0x42, 0xa2, 0x94,
+	0xbd, 0x6e, 0x6e, 0x8c, 0x3a, 0xc9, 0xc7, 0x02, 0x0a, 0x00, 0xd3, 0x46, 0x08, 0xed, 0x5d, 0x52,
+	0xae, 0xd3, 0x0c, 0xbf, 0xb8, 0x33, 0xeb, 0xf5, 0x9f, 0x35, 0x2b, 0xdb, 0xad, 0xcb, 0xfe, 0x99,
+	0x62, 0xf8, 0xb5, 0x5a, 0x5d, 0x2f, 0x5d, 0x2c, 0xea, 0x9f, 0x5e, 0xdd, 0x79, 0xce, 0xf5, 0x9d,
+	0xe7, 0xfc, 0xb8, 0xf3, 0x9c, 0xcb, 0x7b, 0xaf, 0x73, 0x7d, 0xef, 0x75, 0xbe, 0xdf, 0x7b, 0x9d,
+	0x4f, 0x2f, 0x1e, 0x80, 0x6c, 0x10, 0xec, 0xa7, 0x24, 0x52, 0xf6, 0x05, 0x4f, 0x8f, 0x0e, 0xf1,
+	0xc5, 0xc3, 0x6f, 0x88, 0x81, 0x1b, 0xad, 0x9a, 0xf6, 0x9e, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff,
+	0xd2, 0xc2, 0x7f, 0x26, 0x66, 0x04, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -261,6 +282,18 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TickRange != nil {
+		{
+			size, err := m.TickRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -285,12 +318,12 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGauge(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -415,6 +448,10 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	if m.TickRange != nil {
+		l = m.TickRange.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
 	return n
 }
 
@@ -679,6 +716,42 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TickRange == nil {
+				m.TickRange = &types2.TickRange{}
+			}
+			if err := m.TickRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
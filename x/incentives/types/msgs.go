@@ -76,11 +76,21 @@ func (m MsgCreateGauge) ValidateBasic() error {
 		if m.DistributeTo.Duration != 0 {
 			return fmt.Errorf("'no lock' gauge must have duration set to 0, was (%d)", m.DistributeTo.Duration)
 		}
+
+		if m.TickRange != nil {
+			if err := m.TickRange.Validate(); err != nil {
+				return err
+			}
+		}
 	} else {
 		if m.PoolId != 0 {
 			return errors.New("pool id should not be set for duration distr condition")
 		}
 
+		if m.TickRange != nil {
+			return errors.New("tick range should only be set for no lock distr condition")
+		}
+
 		// For no lock type, the denom must be empty and we check that above.
 		if err := sdk.ValidateDenom(m.DistributeTo.Denom); err != nil {
 			return fmt.Errorf("denom should be valid for the condition, %s", err)
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 
@@ -222,6 +223,40 @@ func TestMsgCreateGauge(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "valid no lock with tick range",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = ""
+				msg.DistributeTo.Duration = 0
+				msg.PoolId = 1
+				msg.TickRange = &cltypes.TickRange{LowerTick: -100, UpperTick: 100}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid due to no lock with lower tick not less than upper tick",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.NoLock
+				msg.DistributeTo.Denom = ""
+				msg.DistributeTo.Duration = 0
+				msg.PoolId = 1
+
+				// breaks
+				msg.TickRange = &cltypes.TickRange{LowerTick: 100, UpperTick: 100}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid due to tick range set for duration gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.TickRange = &cltypes.TickRange{LowerTick: -100, UpperTick: 100}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
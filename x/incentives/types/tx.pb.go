@@ -13,6 +13,7 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types2 "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	types "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -64,6 +65,15 @@ type MsgCreateGauge struct {
 	// incentivestypes.NoLockExternalGaugeDenom(<pool-id>) so that the gauges
 	// associated with a pool can be queried by this prefix if needed.
 	PoolId uint64 `protobuf:"varint,7,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// tick_range restricts the concentrated liquidity incentives of a "NoLock"
+	// gauge to the positions of the pool whose range sits within it. It must be
+	// unset for all other gauges.
+	// Existing positions are not added to the tick range when it is
+	// created: a qualifying position joins it the next time it is modified or
+	// collects its incentives, and only earns from then on. The incentives are
+	// not emitted while no qualifying position has joined, they remain in the
+	// incentive record.
+	TickRange *types2.TickRange `protobuf:"bytes,8,opt,name=tick_range,json=tickRange,proto3" json:"tick_range,omitempty" yaml:"tick_range"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -148,6 +158,13 @@ func (m *MsgCreateGauge) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetTickRange() *types2.TickRange {
+	if m != nil {
+		return m.TickRange
+	}
+	return nil
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -415,53 +432,57 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc7, 0xe3, 0x24, 0x10, 0x98, 0xc0, 0x15, 0x58, 0xdc, 0x8b, 0xc9, 0xad, 0x9c, 0x60, 0x55,
-	0x55, 0x8a, 0x94, 0x99, 0x12, 0xa4, 0x2e, 0xd8, 0x35, 0xa8, 0xaa, 0xb2, 0x40, 0xa5, 0x16, 0x52,
-	0x25, 0xa4, 0x2a, 0x9a, 0x64, 0xa6, 0x66, 0x44, 0xec, 0xb1, 0x3c, 0xe3, 0x00, 0xaf, 0x50, 0xa9,
-	0x12, 0x6f, 0xd0, 0x7d, 0x57, 0x7d, 0x0c, 0x96, 0xa8, 0xab, 0xae, 0xa0, 0x82, 0x45, 0xf7, 0x3c,
-	0x41, 0x35, 0x63, 0x3b, 0x1f, 0x2a, 0x69, 0x36, 0xed, 0x26, 0xce, 0x9c, 0xaf, 0x9c, 0x73, 0x7e,
-	0xff, 0x8c, 0xc1, 0xff, 0x5c, 0xf8, 0x5c, 0x30, 0x81, 0x58, 0xd0, 0xa3, 0x81, 0x64, 0x03, 0x2a,
-	0x90, 0x3c, 0x83, 0x61, 0xc4, 0x25, 0x37, 0xcd, 0xd4, 0x09, 0x47, 0xce, 0xca, 0x9a, 0xc7, 0x3d,
-	0xae, 0xdd, 0x48, 0x7d, 0x4b, 0x22, 0x2b, 0xab, 0xd8, 0x67, 0x01, 0x47, 0xfa, 0x33, 0x35, 0x55,
-	0x3d, 0xce, 0xbd, 0x3e, 0x45, 0xfa, 0xd4, 0x8d, 0xdf, 0x23, 0xc9, 0x7c, 0x2a, 0x24, 0xf6, 0xc3,
-	0x34, 0xc0, 0xee, 0xe9, 0xf2, 0xa8, 0x8b, 0x05, 0x45, 0x83, 0xed, 0x2e, 0x95, 0x78, 0x1b, 0xf5,
-	0x38, 0x0b, 0x32, 0xff, 0x03, 0xad, 0x79, 0x38, 0xf6, 0x68, 0xea, 0xdf, 0xc8, 0xfc, 0x7d, 0xde,
-	0x3b, 0x89, 0x43, 0xfd, 0x48, 0x5c, 0xce, 0xd7, 0x02, 0xf8, 0x67, 0x5f, 0x78, 0x7b, 0x11, 0xc5,
-	0x92, 0xbe, 0x52, 0x39, 0xe6, 0x26, 0x58, 0x62, 0xa2, 0x13, 0xd2, 0x28, 0xa4, 0x32, 0xc6, 0x7d,
-	0xcb, 0xa8, 0x19, 0xf5, 0x05, 0xb7, 0xcc, 0xc4, 0x41, 0x66, 0x32, 0x9f, 0x80, 0x39, 0x7e, 0x1a,
-	0xd0, 0xc8, 0xca, 0xd7, 0x8c, 0xfa, 0x62, 0x6b, 0xe5, 0xfe, 0xba, 0xba, 0x74, 0x8e, 0xfd, 0xfe,
-	0xae, 0xa3, 0xcd, 0x8e, 0x9b, 0xb8, 0xcd, 0x36, 0x58, 0x26, 0x4c, 0xc8, 0x88, 0x75, 0x63, 0x49,
-	0x3b, 0x92, 0x5b, 0x85, 0x9a, 0x51, 0x2f, 0x37, 0x6d, 0x98, 0xad, 0x2b, 0x69, 0x08, 0xbe, 0x89,
-	0x69, 0x74, 0xbe, 0xc7, 0x03, 0xc2, 0x24, 0xe3, 0x41, 0xab, 0x78, 0x79, 0x5d, 0xcd, 0xb9, 0x4b,
-	0xa3, 0xd4, 0x43, 0x6e, 0x62, 0x30, 0xa7, 0x26, 0x16, 0x56, 0xb1, 0x56, 0xa8, 0x97, 0x9b, 0x1b,
-	0x30, 0xd9, 0x09, 0x54, 0x3b, 0x81, 0xe9, 0x4e, 0xe0, 0x1e, 0x67, 0x41, 0xeb, 0x99, 0xca, 0xfe,
-	0x7c, 0x53, 0xad, 0x7b, 0x4c, 0x1e, 0xc7, 0x5d, 0xd8, 0xe3, 0x3e, 0x4a, 0x17, 0x98, 0x3c, 0x1a,
-	0x82, 0x9c, 0x20, 0x79, 0x1e, 0x52, 0xa1, 0x13, 0x84, 0x9b, 0x54, 0x36, 0xdf, 0x02, 0x20, 0x24,
-	0x8e, 0x64, 0x47, 0xed, 0xdf, 0x9a, 0xd3, 0xad, 0x56, 0x60, 0x02, 0x07, 0x66, 0x70, 0xe0, 0x61,
-	0x06, 0xa7, 0xf5, 0x48, 0xfd, 0xd0, 0xfd, 0x75, 0x75, 0x25, 0x19, 0x7d, 0x48, 0xcd, 0xb9, 0xb8,
-	0xa9, 0x1a, 0xee, 0xa2, 0xae, 0xa5, 0xa2, 0x4d, 0x04, 0xd6, 0x82, 0xd8, 0xef, 0xd0, 0x90, 0xf7,
-	0x8e, 0x45, 0x27, 0xc4, 0x8c, 0x74, 0xf8, 0x80, 0x46, 0xd6, 0x7c, 0xcd, 0xa8, 0x17, 0xdd, 0xd5,
-	0x20, 0xf6, 0x5f, 0x6a, 0xd7, 0x01, 0x66, 0xe4, 0xf5, 0x80, 0x46, 0xe6, 0x3a, 0x28, 0x85, 0x9c,
-	0xf7, 0x3b, 0x8c, 0x58, 0x25, 0x1d, 0x33, 0xaf, 0x8e, 0x6d, 0xb2, 0xfb, 0xf8, 0xc3, 0x8f, 0x2f,
-	0x5b, 0xd5, 0x07, 0x70, 0xf7, 0x34, 0xc0, 0x86, 0xa6, 0xee, 0x58, 0xe0, 0xbf, 0x49, 0xa6, 0x2e,
-	0x15, 0x21, 0x0f, 0x04, 0x75, 0x6e, 0x0c, 0xb0, 0xbc, 0x2f, 0xbc, 0x17, 0x84, 0x1c, 0xf2, 0x84,
-	0xf6, 0x10, 0xa5, 0xf1, 0x7b, 0x94, 0x1b, 0x60, 0x41, 0x17, 0x57, 0x3d, 0xe5, 0x75, 0x4f, 0x25,
-	0x7d, 0x6e, 0x13, 0x93, 0x82, 0x52, 0x44, 0x4f, 0x71, 0x44, 0x84, 0x55, 0xf8, 0xf3, 0x70, 0xb2,
-	0xda, 0xd3, 0x67, 0xc7, 0x84, 0x34, 0x24, 0x4f, 0x67, 0x5f, 0x07, 0xff, 0x4e, 0x0c, 0x38, 0x1c,
-	0xfd, 0x63, 0x7e, 0x5c, 0xe9, 0x11, 0x8f, 0xc3, 0x91, 0xa6, 0x8c, 0xbf, 0xa6, 0xa9, 0x69, 0xe8,
-	0xf3, 0xd3, 0xd0, 0x0f, 0x79, 0x14, 0x66, 0xf2, 0x48, 0x25, 0x92, 0xfc, 0x25, 0x8a, 0x6e, 0x29,
-	0xd1, 0x88, 0x98, 0x2d, 0x12, 0x35, 0xbc, 0xb3, 0x33, 0x2e, 0x12, 0x65, 0xc9, 0x36, 0xa5, 0x51,
-	0x2b, 0x83, 0x42, 0x6d, 0xa4, 0xa8, 0xd5, 0xb9, 0x4d, 0x9a, 0x9f, 0xf2, 0xa0, 0xb0, 0x2f, 0x3c,
-	0xf3, 0x1d, 0x28, 0x8f, 0x5f, 0x19, 0x0e, 0xfc, 0xf5, 0xfe, 0x83, 0x93, 0x12, 0xac, 0x6c, 0xcd,
-	0x8e, 0x19, 0x76, 0x70, 0x04, 0xc0, 0x98, 0x44, 0x37, 0xa7, 0x64, 0x8e, 0x42, 0x2a, 0x4f, 0x67,
-	0x86, 0x0c, 0x6b, 0x8f, 0x5a, 0xd7, 0x1a, 0x98, 0xd1, 0xba, 0x8a, 0x99, 0xd5, 0xfa, 0xf8, 0xf2,
-	0x5a, 0x07, 0x97, 0xb7, 0xb6, 0x71, 0x75, 0x6b, 0x1b, 0xdf, 0x6f, 0x6d, 0xe3, 0xe2, 0xce, 0xce,
-	0x5d, 0xdd, 0xd9, 0xb9, 0x6f, 0x77, 0x76, 0xee, 0xe8, 0xf9, 0x98, 0x76, 0xd2, 0x7a, 0x8d, 0x3e,
-	0xee, 0x8a, 0xec, 0x80, 0x06, 0xcd, 0x6d, 0x74, 0x36, 0xf1, 0x7a, 0x51, 0x7a, 0xea, 0xce, 0xeb,
-	0xab, 0x67, 0xe7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x8c, 0x74, 0x75, 0x81, 0x06, 0x00,
-	0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x6b, 0xe3, 0x46,
+	0x18, 0xc7, 0x2d, 0xdb, 0x89, 0x93, 0x71, 0xb6, 0x6c, 0xc4, 0x6e, 0x57, 0x71, 0x8b, 0xe4, 0x15,
+	0xa5, 0xb8, 0x01, 0x4b, 0x1b, 0x2f, 0xf4, 0x10, 0x7a, 0xa9, 0x43, 0x29, 0x3e, 0x84, 0xa6, 0xc2,
+	0x50, 0x08, 0x14, 0x31, 0xd6, 0x4c, 0x94, 0xc1, 0x96, 0x46, 0x9d, 0x19, 0x39, 0xf1, 0x57, 0x28,
+	0x14, 0xf2, 0x0d, 0x7a, 0xef, 0xa9, 0xe7, 0x7e, 0x82, 0x1c, 0x73, 0xec, 0xc9, 0x29, 0xc9, 0xa1,
+	0xf7, 0x7c, 0x82, 0x32, 0xa3, 0x17, 0xdb, 0x34, 0xae, 0x2f, 0xdd, 0x8b, 0xe5, 0x99, 0xe7, 0x65,
+	0x9e, 0xe7, 0xf9, 0xff, 0x34, 0x02, 0x9f, 0x50, 0x1e, 0x51, 0x4e, 0xb8, 0x4b, 0xe2, 0x00, 0xc7,
+	0x82, 0x4c, 0x31, 0x77, 0xc5, 0xb5, 0x93, 0x30, 0x2a, 0xa8, 0xae, 0xe7, 0x46, 0x67, 0x61, 0x6c,
+	0xbd, 0x0a, 0x69, 0x48, 0x95, 0xd9, 0x95, 0xff, 0x32, 0xcf, 0xd6, 0x3e, 0x8c, 0x48, 0x4c, 0x5d,
+	0xf5, 0x9b, 0x6f, 0x59, 0x21, 0xa5, 0xe1, 0x04, 0xbb, 0x6a, 0x35, 0x4a, 0x2f, 0x5c, 0x41, 0x22,
+	0xcc, 0x05, 0x8c, 0x92, 0xdc, 0xc1, 0x0c, 0x54, 0x7a, 0x77, 0x04, 0x39, 0x76, 0xa7, 0x47, 0x23,
+	0x2c, 0xe0, 0x91, 0x1b, 0x50, 0x12, 0x17, 0xf6, 0x67, 0x4a, 0x0b, 0x61, 0x1a, 0xe2, 0xdc, 0x7e,
+	0x50, 0xd8, 0x27, 0x34, 0x18, 0xa7, 0x89, 0x7a, 0xe4, 0xa6, 0xaf, 0x0a, 0x53, 0x40, 0x55, 0x2c,
+	0x83, 0x02, 0xa3, 0x09, 0xf9, 0x29, 0x25, 0x88, 0x88, 0x59, 0x79, 0x58, 0x99, 0xd8, 0x67, 0x38,
+	0xa0, 0x0c, 0x65, 0xd1, 0xf6, 0x1f, 0x75, 0xf0, 0xd1, 0x29, 0x0f, 0x4f, 0x18, 0x86, 0x02, 0x7f,
+	0x2b, 0x4f, 0xd4, 0xdf, 0x82, 0x3d, 0xc2, 0xfd, 0x04, 0xb3, 0x04, 0x8b, 0x14, 0x4e, 0x0c, 0xad,
+	0xad, 0x75, 0x76, 0xbc, 0x26, 0xe1, 0x67, 0xc5, 0x96, 0xfe, 0x39, 0xd8, 0xa2, 0x57, 0x31, 0x66,
+	0x46, 0xb5, 0xad, 0x75, 0x76, 0xfb, 0x2f, 0x9f, 0xe6, 0xd6, 0xde, 0x0c, 0x46, 0x93, 0x63, 0x5b,
+	0x6d, 0xdb, 0x5e, 0x66, 0xd6, 0x07, 0xe0, 0x05, 0x22, 0x5c, 0x30, 0x32, 0x4a, 0x05, 0xf6, 0x05,
+	0x35, 0x6a, 0x6d, 0xad, 0xd3, 0xec, 0x99, 0x4e, 0x31, 0xec, 0xac, 0x1d, 0xe7, 0xfb, 0x14, 0xb3,
+	0xd9, 0x09, 0x8d, 0x11, 0x11, 0x84, 0xc6, 0xfd, 0xfa, 0xed, 0xdc, 0xaa, 0x78, 0x7b, 0x8b, 0xd0,
+	0x21, 0xd5, 0x21, 0xd8, 0x92, 0xf3, 0xe2, 0x46, 0xbd, 0x5d, 0xeb, 0x34, 0x7b, 0x07, 0x4e, 0x36,
+	0x51, 0x47, 0x4e, 0xd4, 0xc9, 0x9b, 0x74, 0x4e, 0x28, 0x89, 0xfb, 0xef, 0x64, 0xf4, 0x6f, 0xf7,
+	0x56, 0x27, 0x24, 0xe2, 0x32, 0x1d, 0x39, 0x01, 0x8d, 0xdc, 0x7c, 0xfc, 0xd9, 0xa3, 0xcb, 0xd1,
+	0xd8, 0x15, 0xb3, 0x04, 0x73, 0x15, 0xc0, 0xbd, 0x2c, 0xb3, 0xfe, 0x03, 0x00, 0x5c, 0x40, 0x26,
+	0x7c, 0xa9, 0x9e, 0xb1, 0xa5, 0x4a, 0x6d, 0x39, 0x99, 0xb4, 0x4e, 0x21, 0xad, 0x33, 0x2c, 0xa4,
+	0xed, 0x7f, 0x2a, 0x0f, 0x7a, 0x9a, 0x5b, 0x2f, 0xb3, 0xd6, 0x4b, 0xcd, 0xed, 0x9b, 0x7b, 0x4b,
+	0xf3, 0x76, 0x55, 0x2e, 0xe9, 0xad, 0xbb, 0xe0, 0x55, 0x9c, 0x46, 0x3e, 0x4e, 0x68, 0x70, 0xc9,
+	0xfd, 0x04, 0x12, 0xe4, 0xd3, 0x29, 0x66, 0xc6, 0x76, 0x5b, 0xeb, 0xd4, 0xbd, 0xfd, 0x38, 0x8d,
+	0xbe, 0x51, 0xa6, 0x33, 0x48, 0xd0, 0x77, 0x53, 0xcc, 0xf4, 0x37, 0xa0, 0x91, 0x50, 0x3a, 0xf1,
+	0x09, 0x32, 0x1a, 0xca, 0x67, 0x5b, 0x2e, 0x07, 0x48, 0xbf, 0x00, 0x40, 0x90, 0x60, 0xec, 0x33,
+	0x18, 0x87, 0xd8, 0xd8, 0x51, 0x25, 0xbe, 0x2b, 0xa7, 0xf9, 0x2c, 0x01, 0xe5, 0x70, 0x86, 0x24,
+	0x18, 0x7b, 0x32, 0xae, 0xff, 0xfa, 0x69, 0x6e, 0xed, 0x17, 0x45, 0x17, 0xd9, 0x6c, 0x6f, 0x57,
+	0x14, 0x1e, 0xc7, 0x9f, 0xfd, 0xfc, 0xf7, 0xef, 0x87, 0xd6, 0x33, 0x50, 0x06, 0x0a, 0x94, 0xae,
+	0x62, 0xd3, 0x36, 0xc0, 0xc7, 0xab, 0xec, 0x78, 0x98, 0x27, 0x34, 0xe6, 0xd8, 0xbe, 0xd7, 0xc0,
+	0x8b, 0x53, 0x1e, 0x7e, 0x8d, 0xd0, 0x90, 0x66, 0x54, 0x95, 0xc8, 0x68, 0xff, 0x8d, 0xcc, 0x01,
+	0xd8, 0x51, 0xc9, 0x65, 0xef, 0x55, 0xd5, 0x7b, 0x43, 0xad, 0x07, 0x48, 0xc7, 0xa0, 0xc1, 0xf0,
+	0x15, 0x64, 0x88, 0x1b, 0xb5, 0xff, 0x1f, 0x82, 0x22, 0xf7, 0xfa, 0xde, 0x21, 0x42, 0x5d, 0x41,
+	0xf3, 0xde, 0xdf, 0x80, 0xd7, 0x2b, 0x0d, 0x96, 0xad, 0xff, 0x52, 0x5d, 0x7e, 0xa3, 0x18, 0x4d,
+	0x93, 0x05, 0xbb, 0xda, 0x07, 0x63, 0x77, 0x1d, 0x62, 0xd5, 0x75, 0x88, 0x95, 0x7a, 0xd4, 0x36,
+	0xea, 0x91, 0xa3, 0x98, 0xbd, 0x7a, 0x75, 0xaf, 0x91, 0xb1, 0xc8, 0x37, 0x43, 0x22, 0x9b, 0xb7,
+	0xdf, 0x2f, 0x43, 0x22, 0x77, 0x8a, 0x49, 0x29, 0xa9, 0xe5, 0x86, 0x94, 0x5a, 0xcb, 0xa5, 0x96,
+	0xeb, 0x01, 0xea, 0xfd, 0x5a, 0x05, 0xb5, 0x53, 0x1e, 0xea, 0x3f, 0x82, 0xe6, 0xf2, 0xd5, 0x64,
+	0x3b, 0xff, 0xbe, 0xa5, 0x9d, 0x55, 0x04, 0x5b, 0x87, 0x9b, 0x7d, 0xca, 0x0a, 0xce, 0x01, 0x58,
+	0x42, 0xf4, 0xed, 0x9a, 0xc8, 0x85, 0x4b, 0xeb, 0x8b, 0x8d, 0x2e, 0x65, 0xee, 0x45, 0xe9, 0x8a,
+	0x81, 0x0d, 0xa5, 0x4b, 0x9f, 0xd6, 0xe1, 0x66, 0x9f, 0x22, 0x7d, 0xff, 0xec, 0xf6, 0xc1, 0xd4,
+	0xee, 0x1e, 0x4c, 0xed, 0xaf, 0x07, 0x53, 0xbb, 0x79, 0x34, 0x2b, 0x77, 0x8f, 0x66, 0xe5, 0xcf,
+	0x47, 0xb3, 0x72, 0xfe, 0xe5, 0x12, 0x3b, 0x79, 0xbe, 0xee, 0x04, 0x8e, 0x78, 0xb1, 0x70, 0xa7,
+	0xbd, 0x23, 0xf7, 0x7a, 0xe5, 0x23, 0x28, 0x79, 0x1a, 0x6d, 0xab, 0x2b, 0xee, 0xfd, 0x3f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x30, 0xf2, 0x04, 0x1f, 0x27, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -636,6 +657,18 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TickRange != nil {
+		{
+			size, err := m.TickRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
@@ -646,12 +679,12 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -814,20 +847,20 @@ func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.PoolIds) > 0 {
-		dAtA4 := make([]byte, len(m.PoolIds)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.PoolIds)*10)
+		var j4 int
 		for _, num := range m.PoolIds {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -928,6 +961,10 @@ func (m *MsgCreateGauge) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovTx(uint64(m.PoolId))
 	}
+	if m.TickRange != nil {
+		l = m.TickRange.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1237,6 +1274,42 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TickRange == nil {
+				m.TickRange = &types2.TickRange{}
+			}
+			if err := m.TickRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
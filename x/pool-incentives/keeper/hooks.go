@@ -78,6 +78,10 @@ func (h Hooks) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAd
 func (h Hooks) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 }
 
+// AfterPoolPositionWithdrawn is a noop.
+func (h Hooks) AfterPoolPositionWithdrawn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
 // AfterConcentratedPoolSwap is a noop.
 func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
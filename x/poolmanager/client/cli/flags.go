@@ -27,6 +27,13 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to []types.PoolTakerFee.
+	FlagPoolTakerFees = "pool-taker-fees"
+	// Will be parsed to []types.RouteTakerFee.
+	FlagRouteTakerFees = "route-taker-fees"
+
+	// takerFeeOverrideRemove is the taker fee value that removes a taker fee override.
+	takerFeeOverrideRemove = "remove"
 )
 
 type createBalancerPoolInputs struct {
@@ -100,6 +107,14 @@ func FlagSetCreatePool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetTakerFeeOverrides() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPoolTakerFees, "", "Pool ids and taker fees separated by commas, ex) 1,0.001,2,remove")
+	fs.String(FlagRouteTakerFees, "", "Route token out denoms and taker fees separated by commas, ex) uosmo,0.001,uion,remove")
+	return fs
+}
+
 func FlagSetCreateRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
@@ -33,6 +34,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSimulateSwap)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeRevenueByPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTakerFeeRevenueByDenomPair)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -69,6 +73,65 @@ func GetCmdEstimateSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.E
 	}, &queryproto.EstimateSwapExactAmountOutRequest{}
 }
 
+// GetCmdSimulateSwap returns the outcome of executing a swap of an exact amount in along the given routes.
+func GetCmdSimulateSwap() (*osmocli.QueryDescriptor, *queryproto.SimulateSwapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap",
+		Short: "Query the outcome of a swap of an exact amount in, with the details of each hop",
+		Long: `{{.Short}}
+The routes file has the same format as for split-route-swap-exact-amount-in. A single route is simulated as a multihop swap.{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap osmo1... uosmo --routes-file="./routes.json"`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+		},
+		QueryFnName: "SimulateSwap",
+	}, &queryproto.SimulateSwapRequest{}
+}
+
+// GetCmdTakerFeeRevenueByPool returns the taker fee revenues of a pool for each time bucket between the given times.
+func GetCmdTakerFeeRevenueByPool() (*osmocli.QueryDescriptor, *queryproto.TakerFeeRevenueByPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-revenue-by-pool",
+		Short: "Query the daily taker fee revenues of a pool for every denom pair between two times",
+		Long: `{{.Short}}
+Start time and end time must be unix time. An end time of 0 defaults to the current block time.{{.ExampleHeader}}
+{{.CommandPrefix}} taker-fee-revenue-by-pool 1 1667088000 0`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"EndTime": parseTakerFeeRevenueEndTime,
+		},
+	}, &queryproto.TakerFeeRevenueByPoolRequest{}
+}
+
+// GetCmdTakerFeeRevenueByDenomPair returns the taker fee revenues of a denom pair for each time bucket between the given times.
+func GetCmdTakerFeeRevenueByDenomPair() (*osmocli.QueryDescriptor, *queryproto.TakerFeeRevenueByDenomPairRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "taker-fee-revenue-by-denom-pair",
+		Short: "Query the daily taker fee revenues of a denom pair on every pool between two times",
+		Long: `{{.Short}}
+Start time and end time must be unix time. An end time of 0 defaults to the current block time.{{.ExampleHeader}}
+{{.CommandPrefix}} taker-fee-revenue-by-denom-pair uion uosmo 1667088000 1667174400`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"EndTime": parseTakerFeeRevenueEndTime,
+		},
+	}, &queryproto.TakerFeeRevenueByDenomPairRequest{}
+}
+
+// parseTakerFeeRevenueEndTime parses the end time argument of the taker fee revenue queries,
+// leaving it unset if it is 0.
+func parseTakerFeeRevenueEndTime(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	if arg == "0" {
+		return (*time.Time)(nil), osmocli.UsedArg, nil
+	}
+	endTime, err := osmocli.ParseUnixTime(arg, "EndTime")
+	if err != nil {
+		return nil, osmocli.UsedArg, err
+	}
+	return &endTime, osmocli.UsedArg, nil
+}
+
 // GetCmdNumPools return number of pools available.
 func GetCmdNumPools() (*osmocli.QueryDescriptor, *queryproto.NumPoolsRequest) {
 	return &osmocli.QueryDescriptor{
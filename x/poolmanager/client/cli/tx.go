@@ -507,6 +507,63 @@ Ex) denom-pair-taker-fee-proposal uion,uosmo,0.0016,stake,uosmo,0.005,uatom,uosm
 	return cmd
 }
 
+// NewCmdHandleTakerFeeOverrideProposal implements a command handler for taker fee override proposal
+func NewCmdHandleTakerFeeOverrideProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taker-fee-override-proposal [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a taker fee override proposal for pools and multihop routes",
+		Long: strings.TrimSpace(`Submit a taker fee override proposal for pools and multihop routes.
+
+The taker fee override of the multihop routes ending in a token out denom applies to every hop of those routes.
+The taker fee override of a pool takes precedence over it, which takes precedence over the denom pair taker fee.
+
+Passing in pool-taker-fees separated by commas would be parsed automatically to poolTakerFee records.
+Ex) --pool-taker-fees 1,0.0005,2,remove ->
+[pool 1, takerFee 0.05%]
+[pool 2, removes the override from state]
+
+Passing in route-taker-fees separated by commas would be parsed automatically to routeTakerFee records.
+Ex) --route-taker-fees uosmo,0.001,uion,remove ->
+[multihop routes ending in uosmo, takerFee 0.1%]
+[multihop routes ending in uion, removes the override from state]
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseTakerFeeOverrideFlagsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().AddFlagSet(FlagSetTakerFeeOverrides())
+
+	return cmd
+}
+
 func NewSetDenomPairTakerFeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-denom-pair-taker-fee [flags]",
@@ -616,3 +673,116 @@ func ParseDenomPairTakerFee(arg string) ([]types.DenomPairTakerFee, error) {
 
 	return finaldenomPairTakerFeeRecordsRecords, nil
 }
+
+func parseTakerFeeOverrideFlagsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolTakerFeesStr, err := cmd.Flags().GetString(FlagPoolTakerFees)
+	if err != nil {
+		return nil, err
+	}
+
+	routeTakerFeesStr, err := cmd.Flags().GetString(FlagRouteTakerFees)
+	if err != nil {
+		return nil, err
+	}
+
+	poolTakerFees := []types.PoolTakerFee{}
+	if poolTakerFeesStr != "" {
+		poolTakerFees, err = ParsePoolTakerFees(poolTakerFeesStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	routeTakerFees := []types.RouteTakerFee{}
+	if routeTakerFeesStr != "" {
+		routeTakerFees, err = ParseRouteTakerFees(routeTakerFeesStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types.NewTakerFeeOverrideProposal(title, description, poolTakerFees, routeTakerFees), nil
+}
+
+// ParsePoolTakerFees parses pool ids and taker fees separated by commas into pool taker fee records.
+// A taker fee of "remove" removes the override of the pool.
+func ParsePoolTakerFees(arg string) ([]types.PoolTakerFee, error) {
+	poolTakerFeeRecords := strings.Split(arg, ",")
+
+	if len(poolTakerFeeRecords)%2 != 0 {
+		return nil, fmt.Errorf("poolTakerFeeRecords must be a list of poolId and takerFee separated by commas")
+	}
+
+	finalPoolTakerFeeRecords := []types.PoolTakerFee{}
+	for i := 0; i < len(poolTakerFeeRecords); i += 2 {
+		poolId, err := strconv.ParseUint(poolTakerFeeRecords[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		takerFee, remove, err := parseTakerFeeOverride(poolTakerFeeRecords[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		finalPoolTakerFeeRecords = append(finalPoolTakerFeeRecords, types.PoolTakerFee{
+			PoolId:   poolId,
+			TakerFee: takerFee,
+			Remove:   remove,
+		})
+	}
+
+	return finalPoolTakerFeeRecords, nil
+}
+
+// ParseRouteTakerFees parses token out denoms and taker fees separated by commas into route taker fee records.
+// A taker fee of "remove" removes the override of the routes ending in the token out denom.
+func ParseRouteTakerFees(arg string) ([]types.RouteTakerFee, error) {
+	routeTakerFeeRecords := strings.Split(arg, ",")
+
+	if len(routeTakerFeeRecords)%2 != 0 {
+		return nil, fmt.Errorf("routeTakerFeeRecords must be a list of tokenOutDenom and takerFee separated by commas")
+	}
+
+	finalRouteTakerFeeRecords := []types.RouteTakerFee{}
+	for i := 0; i < len(routeTakerFeeRecords); i += 2 {
+		tokenOutDenom := routeTakerFeeRecords[i]
+
+		takerFee, remove, err := parseTakerFeeOverride(routeTakerFeeRecords[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		finalRouteTakerFeeRecords = append(finalRouteTakerFeeRecords, types.RouteTakerFee{
+			TokenOutDenom: tokenOutDenom,
+			TakerFee:      takerFee,
+			Remove:        remove,
+		})
+	}
+
+	return finalRouteTakerFeeRecords, nil
+}
+
+// parseTakerFeeOverride parses the taker fee of a taker fee override,
+// returning true if the override is to be removed instead.
+func parseTakerFeeOverride(takerFeeStr string) (osmomath.Dec, bool, error) {
+	if takerFeeStr == takerFeeOverrideRemove {
+		return osmomath.ZeroDec(), true, nil
+	}
+
+	takerFee, err := osmomath.NewDecFromStr(takerFeeStr)
+	if err != nil {
+		return osmomath.Dec{}, false, err
+	}
+	return takerFee, false, nil
+}
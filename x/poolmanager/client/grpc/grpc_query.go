@@ -21,6 +21,36 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TakerFeeRevenueByPool(grpcCtx context.Context,
+	req *queryproto.TakerFeeRevenueByPoolRequest,
+) (*queryproto.TakerFeeRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeRevenueByPool(ctx, *req)
+}
+
+func (q Querier) TakerFeeRevenueByDenomPair(grpcCtx context.Context,
+	req *queryproto.TakerFeeRevenueByDenomPairRequest,
+) (*queryproto.TakerFeeRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TakerFeeRevenueByDenomPair(ctx, *req)
+}
+
+func (q Querier) SimulateSwap(grpcCtx context.Context,
+	req *queryproto.SimulateSwapRequest,
+) (*queryproto.SimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SimulateSwap(ctx, *req)
+}
+
 func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
 	req *queryproto.TradingPairTakerFeeRequest,
 ) (*queryproto.TradingPairTakerFeeResponse, error) {
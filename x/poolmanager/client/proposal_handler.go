@@ -8,4 +8,5 @@ import (
 
 var (
 	DenomPairTakerFeeProposalHandler = govclient.NewProposalHandler(cli.NewCmdHandleDenomPairTakerFeeProposal)
+	TakerFeeOverrideProposalHandler  = govclient.NewProposalHandler(cli.NewCmdHandleTakerFeeOverrideProposal)
 )
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"errors"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -403,3 +406,71 @@ func (q Querier) EstimateTradeBasedOnPriceImpact(
 		return nil, status.Error(codes.Internal, "pool type not supported")
 	}
 }
+
+// SimulateSwap returns the outcome of executing a swap of an exact amount in along the given routes.
+func (q Querier) SimulateSwap(ctx sdk.Context, req queryproto.SimulateSwapRequest) (*queryproto.SimulateSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid sender address")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token in denom: %s", err.Error())
+	}
+
+	for _, route := range req.Routes {
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "token in amount of each route must be positive")
+		}
+	}
+	if err := types.ValidateSwapAmountInSplitRoute(req.Routes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The request is valid, so a failing swap is due to the current state, e.g. a pool
+	// without enough liquidity, unless the simulation failed for an internal reason.
+	response, err := q.K.SimulateSwap(ctx, sender, req.Routes, req.TokenInDenom)
+	if err != nil {
+		if errors.As(err, &types.SimulateSwapInternalError{}) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return response, nil
+}
+
+// TakerFeeRevenueByPool returns the taker fee revenues of the given pool for each time bucket between the given times.
+func (q Querier) TakerFeeRevenueByPool(ctx sdk.Context, req queryproto.TakerFeeRevenueByPoolRequest) (*queryproto.TakerFeeRevenueResponse, error) {
+	revenues, pageRes, err := q.K.GetTakerFeeRevenuesByPool(ctx, req.PoolId, req.StartTime, takerFeeRevenueEndTime(ctx, req.EndTime), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TakerFeeRevenueResponse{Revenues: revenues, Pagination: pageRes}, nil
+}
+
+// TakerFeeRevenueByDenomPair returns the taker fee revenues of the given denom pair for each time bucket between the given times.
+func (q Querier) TakerFeeRevenueByDenomPair(ctx sdk.Context, req queryproto.TakerFeeRevenueByDenomPairRequest) (*queryproto.TakerFeeRevenueResponse, error) {
+	for _, denom := range []string{req.Denom0, req.Denom1} {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	revenues, pageRes, err := q.K.GetTakerFeeRevenuesByDenomPair(ctx, req.Denom0, req.Denom1, req.StartTime, takerFeeRevenueEndTime(ctx, req.EndTime), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TakerFeeRevenueResponse{Revenues: revenues, Pagination: pageRes}, nil
+}
+
+// takerFeeRevenueEndTime returns the given end time of a taker fee revenue query,
+// defaulting to the block time if it is unset.
+func takerFeeRevenueEndTime(ctx sdk.Context, endTime *time.Time) time.Time {
+	if endTime == nil || endTime.IsZero() {
+		return ctx.BlockTime()
+	}
+	return *endTime
+}
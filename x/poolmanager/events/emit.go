@@ -5,16 +5,18 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 )
 
-func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+// EmitSwapEvent emits a swap event with the spread factor applied by the swap.
+func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
 	ctx.EventManager().EmitEvents(sdk.Events{
-		newSwapEvent(sender, poolId, input, output),
+		newSwapEvent(sender, poolId, input, output, spreadFactor),
 	})
 }
 
-func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
+func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) sdk.Event {
 	return sdk.NewEvent(
 		types.TypeEvtTokenSwapped,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -22,6 +24,7 @@ func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output
 		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
 		sdk.NewAttribute(types.AttributeKeyTokensIn, input.String()),
 		sdk.NewAttribute(types.AttributeKeyTokensOut, output.String()),
+		sdk.NewAttribute(types.AttributeKeySwapFee, spreadFactor.String()),
 	)
 }
 
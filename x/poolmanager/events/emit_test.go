@@ -36,6 +36,7 @@ func (suite *PoolManagerEventsTestSuite) TestEmitSwapEvent() {
 		poolId          uint64
 		tokensIn        sdk.Coins
 		tokensOut       sdk.Coins
+		spreadFactor    osmomath.Dec
 	}{
 		"basic valid": {
 			ctx:             suite.CreateTestContext(),
@@ -43,6 +44,7 @@ func (suite *PoolManagerEventsTestSuite) TestEmitSwapEvent() {
 			poolId:          1,
 			tokensIn:        sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(1234))),
 			tokensOut:       sdk.NewCoins(sdk.NewCoin(testDenomB, osmomath.NewInt(5678))),
+			spreadFactor:    osmomath.MustNewDecFromStr("0.003"),
 		},
 		"valid with multiple tokens in and out": {
 			ctx:             suite.CreateTestContext(),
@@ -50,6 +52,7 @@ func (suite *PoolManagerEventsTestSuite) TestEmitSwapEvent() {
 			poolId:          200,
 			tokensIn:        sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(12)), sdk.NewCoin(testDenomB, osmomath.NewInt(99))),
 			tokensOut:       sdk.NewCoins(sdk.NewCoin(testDenomC, osmomath.NewInt(88)), sdk.NewCoin(testDenomD, osmomath.NewInt(34))),
+			spreadFactor:    osmomath.ZeroDec(),
 		},
 	}
 
@@ -63,13 +66,14 @@ func (suite *PoolManagerEventsTestSuite) TestEmitSwapEvent() {
 					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
 					sdk.NewAttribute(types.AttributeKeyTokensIn, tc.tokensIn.String()),
 					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.tokensOut.String()),
+					sdk.NewAttribute(types.AttributeKeySwapFee, tc.spreadFactor.String()),
 				),
 			}
 
 			hasNoEventManager := tc.ctx.EventManager() == nil
 
 			// System under test.
-			events.EmitSwapEvent(tc.ctx, tc.testAccountAddr, tc.poolId, tc.tokensIn, tc.tokensOut)
+			events.EmitSwapEvent(tc.ctx, tc.testAccountAddr, tc.poolId, tc.tokensIn, tc.tokensOut, tc.spreadFactor)
 
 			// Assertions
 			if hasNoEventManager {
@@ -17,11 +17,31 @@ func (k Keeper) HandleDenomPairTakerFeeProposal(ctx sdk.Context, p *types.DenomP
 	return nil
 }
 
+func (k Keeper) HandleTakerFeeOverrideProposal(ctx sdk.Context, p *types.TakerFeeOverrideProposal) error {
+	for _, record := range p.PoolTakerFees {
+		if record.Remove {
+			k.DeletePoolTakerFee(ctx, record.PoolId)
+			continue
+		}
+		k.SetPoolTakerFee(ctx, record.PoolId, record.TakerFee)
+	}
+	for _, record := range p.RouteTakerFees {
+		if record.Remove {
+			k.DeleteRouteTakerFee(ctx, record.TokenOutDenom)
+			continue
+		}
+		k.SetRouteTakerFee(ctx, record.TokenOutDenom, record.TakerFee)
+	}
+	return nil
+}
+
 func NewPoolManagerProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
 		case *types.DenomPairTakerFeeProposal:
 			return k.HandleDenomPairTakerFeeProposal(ctx, c)
+		case *types.TakerFeeOverrideProposal:
+			return k.HandleTakerFeeOverrideProposal(ctx, c)
 
 		default:
 			return fmt.Errorf("unrecognized pool manager proposal content type: %T", c)
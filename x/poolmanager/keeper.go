@@ -127,6 +127,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pool and route taker fee overrides KVStore.
+	for _, poolTakerFee := range genState.PoolTakerFeeStore {
+		k.SetPoolTakerFee(ctx, poolTakerFee.PoolId, poolTakerFee.TakerFee)
+	}
+	for _, routeTakerFee := range genState.RouteTakerFeeStore {
+		k.SetRouteTakerFee(ctx, routeTakerFee.TokenOutDenom, routeTakerFee.TakerFee)
+	}
+
+	// Set the taker fee revenues KVStore.
+	for _, takerFeeRevenue := range genState.TakerFeeRevenues {
+		k.setTakerFeeRevenue(ctx, takerFeeRevenue)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -152,6 +165,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolTakerFees, err := k.GetAllPoolTakerFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	routeTakerFees, err := k.GetAllRouteTakerFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	takerFeeRevenues, err := k.GetAllTakerFeeRevenues(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -165,6 +193,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolTakerFeeStore:      poolTakerFees,
+		RouteTakerFeeStore:     routeTakerFees,
+		TakerFeeRevenues:       takerFeeRevenues,
 	}
 }
 
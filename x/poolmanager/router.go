@@ -39,12 +39,31 @@ func (k Keeper) RouteExactAmountIn(
 	route []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.routeExactAmountIn(ctx, sender, route, tokenIn, tokenOutMinAmount, nil)
+}
+
+// routeExactAmountIn implements RouteExactAmountIn.
+// If trace is not nil, the details of each hop are recorded in it.
+func (k Keeper) routeExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount osmomath.Int,
+	trace *swapTrace,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Ensure that provided route is not empty and has valid denom format.
 	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
 		return osmomath.Int{}, err
 	}
 
+	// The taker fee override of the route, if any, applies to every hop without a pool taker fee override.
+	routeTakerFee, err := k.getMultihopRouteTakerFee(ctx, len(route), route[len(route)-1].TokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Iterate through the route and execute a series of swaps through each pool.
 	for i, routeStep := range route {
 		// To prevent the multihop swap from being interrupted prematurely, we keep
@@ -54,11 +73,21 @@ func (k Keeper) RouteExactAmountIn(
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
+		if trace != nil {
+			if err := k.beginSwapTraceHop(ctx, trace, sender, routeStep, tokenIn, routeTakerFee); err != nil {
+				return osmomath.Int{}, err
+			}
+		}
+
+		tokenOutAmount, err = k.swapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount, routeTakerFee)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
 
+		if trace != nil {
+			trace.endHop(ctx, tokenOutAmount)
+		}
+
 		// Chain output of current pool as the input for the next routed pool
 		tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
 	}
@@ -86,6 +115,19 @@ func (k Keeper) SplitRouteExactAmountIn(
 	routes []types.SwapAmountInSplitRoute,
 	tokenInDenom string,
 	tokenOutMinAmount osmomath.Int,
+) (osmomath.Int, error) {
+	return k.splitRouteExactAmountIn(ctx, sender, routes, tokenInDenom, tokenOutMinAmount, nil)
+}
+
+// splitRouteExactAmountIn implements SplitRouteExactAmountIn.
+// If trace is not nil, the details of each hop are recorded in it.
+func (k Keeper) splitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount osmomath.Int,
+	trace *swapTrace,
 ) (osmomath.Int, error) {
 	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
 		return osmomath.Int{}, err
@@ -100,12 +142,13 @@ func (k Keeper) SplitRouteExactAmountIn(
 	)
 
 	for _, multihopRoute := range routes {
-		tokenOutAmount, err := k.RouteExactAmountIn(
+		tokenOutAmount, err := k.routeExactAmountIn(
 			ctx,
 			sender,
 			types.SwapAmountInRoutes(multihopRoute.Pools),
 			sdk.NewCoin(tokenInDenom, multihopRoute.TokenInAmount),
-			multihopStartTokenOutMinAmount)
+			multihopStartTokenOutMinAmount,
+			trace)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -145,6 +188,21 @@ func (k Keeper) SwapExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.swapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount, osmomath.Dec{})
+}
+
+// swapExactAmountIn implements SwapExactAmountIn.
+// If routeTakerFee is not nil, it is charged as the taker fee instead of the taker fee of the trading pair,
+// unless the pool has a taker fee override.
+func (k Keeper) swapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+	routeTakerFee osmomath.Dec,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Get the pool-specific module implementation to ensure that
 	// swaps are routed to the pool type corresponding to pool ID's pool.
@@ -165,7 +223,7 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
-	tokenInAfterSubTakerFee, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	tokenInAfterSubTakerFee, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true, poolId, routeTakerFee)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -243,6 +301,11 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		return osmomath.Int{}, err
 	}
 
+	routeTakerFee, err := k.getMultihopRouteTakerFee(ctx, len(route), route[len(route)-1].TokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	for _, routeStep := range route {
 		swapModule, err := k.GetPoolModule(ctx, routeStep.PoolId)
 		if err != nil {
@@ -257,7 +320,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenOutDenom, tokenIn.Denom)
+		takerFee, err := k.getRouteHopTakerFee(ctx, routeTakerFee, routeStep.PoolId, routeStep.TokenOutDenom, tokenIn.Denom)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -318,6 +381,12 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	}
 	insExpected[0] = tokenInMaxAmount
 
+	// The taker fee override of the route, if any, applies to every hop without a pool taker fee override.
+	routeTakerFee, err := k.getMultihopRouteTakerFee(ctx, len(route), tokenOut.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
 	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
 	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
 	// swaps on each pool.
@@ -360,7 +429,7 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+		tokenInAfterAddTakerFee, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false, routeStep.PoolId, routeTakerFee)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -528,6 +597,26 @@ func (k Keeper) GetPool(
 	return swapModule.GetPool(ctx, poolId)
 }
 
+// GetEffectiveSpreadFactor returns the spread factor charged for swaps in the given pool.
+// That is, the spread factor returned by the pool module if it implements EffectiveSpreadFactorPoolModuleI,
+// e.g. the dynamic spread factor of concentrated liquidity pools, and the spread factor of the pool otherwise.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	if effectiveSpreadFactorModule, ok := swapModule.(types.EffectiveSpreadFactorPoolModuleI); ok {
+		return effectiveSpreadFactorModule.GetEffectiveSpreadFactor(ctx, poolId)
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return pool.GetSpreadFactor(ctx), nil
+}
+
 // AllPools returns all pools sorted by their ids
 // from every pool module registered in the
 // pool manager keeper.
@@ -585,6 +674,11 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 	route []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) ([]osmomath.Int, error) {
+	routeTakerFee, err := k.getMultihopRouteTakerFee(ctx, len(route), tokenOut.Denom)
+	if err != nil {
+		return nil, err
+	}
+
 	insExpected := make([]osmomath.Int, len(route))
 	for i := len(route) - 1; i >= 0; i-- {
 		routeStep := route[i]
@@ -601,7 +695,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 
 		spreadFactor := poolI.GetSpreadFactor(ctx)
 
-		takerFee, err := k.GetTradingPairTakerFee(ctx, routeStep.TokenInDenom, tokenOut.Denom)
+		takerFee, err := k.getRouteHopTakerFee(ctx, routeTakerFee, routeStep.PoolId, routeStep.TokenInDenom, tokenOut.Denom)
 		if err != nil {
 			return nil, err
 		}
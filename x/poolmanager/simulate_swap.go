@@ -0,0 +1,163 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// swapTrace records the details of each hop of a swap executed by SimulateSwap.
+type swapTrace struct {
+	hops []queryproto.SimulatedSwapHop
+
+	// spotPrice is the spot price of the pool of the hop being executed, before the hop.
+	spotPrice osmomath.BigDec
+	// numEventsBefore is the number of events emitted before the hop being executed.
+	numEventsBefore int
+}
+
+// SimulateSwap executes a swap of an exact amount in along the given routes in a cache context and returns its outcome,
+// without committing any state. A single route is executed with RouteExactAmountIn and several routes are executed with
+// SplitRouteExactAmountIn, exactly as MsgSwapExactAmountIn and MsgSplitRouteSwapExactAmountIn would be.
+// The tokens being swapped are minted to the sender within the cache context, so that swaps can be simulated
+// for any sender regardless of its balance. The minimum amount out is one, so that the simulation does not fail
+// on price impact protection.
+//
+// Returns error if:
+// - the routes or the token in denom are invalid
+// - the swap fails
+// - the simulation panics, in which case the error is SimulateSwapInternalError
+func (k Keeper) SimulateSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+) (response *queryproto.SimulateSwapResponse, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			response = nil
+			err = types.SimulateSwapInternalError{Reason: fmt.Sprint(r)}
+		}
+	}()
+
+	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(tokenInDenom); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	tokenInAmount := osmomath.ZeroInt()
+	for _, route := range routes {
+		tokenInAmount = tokenInAmount.Add(route.TokenInAmount)
+	}
+	tokensIn := sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount))
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, tokensIn); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, sender, tokensIn); err != nil {
+		return nil, err
+	}
+
+	// The swap gets its own event manager, so that only the events it emits are returned.
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	gasConsumedBefore := cacheCtx.GasMeter().GasConsumed()
+
+	trace := &swapTrace{}
+	var tokenOutAmount osmomath.Int
+	if len(routes) == 1 {
+		tokenOutAmount, err = k.routeExactAmountIn(cacheCtx, sender, routes[0].Pools, sdk.NewCoin(tokenInDenom, routes[0].TokenInAmount), osmomath.OneInt(), trace)
+	} else {
+		tokenOutAmount, err = k.splitRouteExactAmountIn(cacheCtx, sender, routes, tokenInDenom, osmomath.OneInt(), trace)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events := cacheCtx.EventManager().Events()
+	simulatedEvents := make([]queryproto.SimulatedEvent, 0, len(events))
+	for _, event := range events {
+		simulatedEvent := queryproto.SimulatedEvent{Type: event.Type}
+		for _, attribute := range event.Attributes {
+			simulatedEvent.Attributes = append(simulatedEvent.Attributes, queryproto.SimulatedEventAttribute{Key: attribute.Key, Value: attribute.Value})
+		}
+		simulatedEvents = append(simulatedEvents, simulatedEvent)
+	}
+
+	return &queryproto.SimulateSwapResponse{
+		TokenOutAmount: tokenOutAmount,
+		Hops:           trace.hops,
+		Events:         simulatedEvents,
+		GasConsumed:    cacheCtx.GasMeter().GasConsumed() - gasConsumedBefore,
+	}, nil
+}
+
+// beginSwapTraceHop records the details of the given hop that are known before it is executed in the given trace.
+func (k Keeper) beginSwapTraceHop(ctx sdk.Context, trace *swapTrace, sender sdk.AccAddress, routeStep types.SwapAmountInRoute, tokenIn sdk.Coin, routeTakerFee osmomath.Dec) error {
+	swapModule, err := k.GetPoolModule(ctx, routeStep.PoolId)
+	if err != nil {
+		return err
+	}
+
+	spreadFactor, err := k.GetEffectiveSpreadFactor(ctx, routeStep.PoolId)
+	if err != nil {
+		return err
+	}
+
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	// The taker fee is computed the same way chargeTakerFee does.
+	takerFee := sdk.NewCoin(tokenIn.Denom, osmomath.ZeroInt())
+	if !osmoutils.Contains(k.GetParams(ctx).TakerFeeParams.ReducedFeeWhitelist, sender.String()) {
+		takerFeeRate, err := k.getRouteHopTakerFee(ctx, routeTakerFee, routeStep.PoolId, tokenIn.Denom, routeStep.TokenOutDenom)
+		if err != nil {
+			return err
+		}
+		_, takerFee = CalcTakerFeeExactIn(tokenIn, takerFeeRate)
+	}
+
+	trace.hops = append(trace.hops, queryproto.SimulatedSwapHop{
+		PoolId:       routeStep.PoolId,
+		TokenIn:      tokenIn,
+		TokenOut:     sdk.NewCoin(routeStep.TokenOutDenom, osmomath.ZeroInt()),
+		TakerFee:     takerFee,
+		SpreadFactor: spreadFactor,
+		PriceImpact:  osmomath.ZeroDec(),
+	})
+	trace.spotPrice = spotPrice
+	trace.numEventsBefore = len(ctx.EventManager().Events())
+	return nil
+}
+
+// endHop records the details of the hop being executed that are known once it is executed.
+func (t *swapTrace) endHop(ctx sdk.Context, tokenOutAmount osmomath.Int) {
+	hop := &t.hops[len(t.hops)-1]
+	hop.TokenOut = sdk.NewCoin(hop.TokenOut.Denom, tokenOutAmount)
+
+	for _, event := range ctx.EventManager().Events()[t.numEventsBefore:] {
+		if event.Type == cltypes.TypeEvtCrossTick {
+			hop.TicksCrossed++
+		}
+	}
+
+	// The execution price excludes the taker fee and the spread factor,
+	// so that the price impact only reflects the depth of the pool.
+	if t.spotPrice.IsZero() || !tokenOutAmount.IsPositive() {
+		return
+	}
+	amountInAfterFees := hop.TokenIn.Amount.Sub(hop.TakerFee.Amount).ToLegacyDec().Mul(osmomath.OneDec().Sub(hop.SpreadFactor))
+	executionPrice := osmomath.BigDecFromDec(amountInAfterFees).Quo(osmomath.BigDecFromSDKInt(tokenOutAmount))
+	hop.PriceImpact = executionPrice.Quo(t.spotPrice).Sub(osmomath.OneBigDec()).Dec()
+}
@@ -0,0 +1,138 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestSimulateSwap() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// The concentrated liquidity pool has a narrow position on top of a full range position,
+	// so that a large swap crosses the lower tick of the narrow position.
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], apptesting.ETH, apptesting.USDC, apptesting.DefaultTickSpacing, pointThreePercent)
+	positionCoins := sdk.NewCoins(apptesting.DefaultCoin0, apptesting.DefaultCoin1)
+	s.FundAcc(s.TestAccs[0], positionCoins)
+	_, err := clKeeper.CreatePosition(s.Ctx, clPool.GetId(), s.TestAccs[0], positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), apptesting.DefaultLowerTick, apptesting.DefaultUpperTick)
+	s.Require().NoError(err)
+	s.CreateFullRangePosition(clPool, positionCoins)
+	poolmanagerKeeper.SetDenomPairTakerFee(s.Ctx, apptesting.ETH, apptesting.USDC, pointOneFivePercent)
+
+	usdcOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.USDC, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+	ethOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.ETH, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+
+	route := []types.SwapAmountInRoute{
+		{PoolId: clPool.GetId(), TokenOutDenom: apptesting.USDC},
+		{PoolId: usdcOsmoPoolId, TokenOutDenom: UOSMO},
+	}
+	tokenIn := apptesting.DefaultCoin0
+	sender := s.TestAccs[1]
+
+	// The sender does not need to hold the tokens being swapped, which are minted within the simulation.
+	unfundedResponse, err := poolmanagerKeeper.SimulateSwap(s.Ctx, sender, []types.SwapAmountInSplitRoute{{Pools: route, TokenInAmount: tokenIn.Amount}}, tokenIn.Denom)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, sender).IsZero())
+	s.Require().True(unfundedResponse.TokenOutAmount.IsPositive())
+
+	_, err = poolmanagerKeeper.SimulateSwap(s.Ctx, sender, []types.SwapAmountInSplitRoute{{Pools: route, TokenInAmount: tokenIn.Amount}}, "")
+	s.Require().Error(err)
+
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+	balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+	s.Run("multihop route", func() {
+		response, err := poolmanagerKeeper.SimulateSwap(s.Ctx, sender, []types.SwapAmountInSplitRoute{{Pools: route, TokenInAmount: tokenIn.Amount}}, tokenIn.Denom)
+		s.Require().NoError(err)
+
+		// No state is committed.
+		s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+
+		s.Require().Len(response.Hops, 2)
+		clHop, balancerHop := response.Hops[0], response.Hops[1]
+		_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, pointOneFivePercent)
+		s.Require().Equal(clPool.GetId(), clHop.PoolId)
+		s.Require().Equal(tokenIn, clHop.TokenIn)
+		s.Require().Equal(expectedTakerFee, clHop.TakerFee)
+		s.Require().True(pointThreePercent.Equal(clHop.SpreadFactor))
+		s.Require().NotZero(clHop.TicksCrossed)
+		s.Require().True(clHop.PriceImpact.IsPositive())
+
+		s.Require().Equal(usdcOsmoPoolId, balancerHop.PoolId)
+		s.Require().Equal(clHop.TokenOut, balancerHop.TokenIn)
+		s.Require().Zero(balancerHop.TicksCrossed)
+		s.Require().Equal(sdk.NewCoin(UOSMO, response.TokenOutAmount), balancerHop.TokenOut)
+
+		numSwapEvents := 0
+		for _, event := range response.Events {
+			if event.Type == gammtypes.TypeEvtTokenSwapped {
+				numSwapEvents++
+			}
+		}
+		s.Require().Equal(2, numSwapEvents)
+		s.Require().NotZero(response.GasConsumed)
+		s.Require().Equal(unfundedResponse.TokenOutAmount, response.TokenOutAmount)
+
+		// The simulated swap matches the executed swap.
+		cacheCtx, _ := s.Ctx.CacheContext()
+		tokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(cacheCtx, sender, route, tokenIn, osmomath.OneInt())
+		s.Require().NoError(err)
+		s.Require().Equal(tokenOutAmount, response.TokenOutAmount)
+	})
+
+	s.Run("split route", func() {
+		routes := []types.SwapAmountInSplitRoute{
+			{Pools: route, TokenInAmount: tokenIn.Amount.QuoRaw(2)},
+			{Pools: []types.SwapAmountInRoute{{PoolId: ethOsmoPoolId, TokenOutDenom: UOSMO}}, TokenInAmount: tokenIn.Amount.QuoRaw(2)},
+		}
+		response, err := poolmanagerKeeper.SimulateSwap(s.Ctx, sender, routes, tokenIn.Denom)
+		s.Require().NoError(err)
+		s.Require().Equal(balancesBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+
+		s.Require().Len(response.Hops, 3)
+		s.Require().Equal([]uint64{clPool.GetId(), usdcOsmoPoolId, ethOsmoPoolId}, []uint64{response.Hops[0].PoolId, response.Hops[1].PoolId, response.Hops[2].PoolId})
+		s.Require().Equal(response.TokenOutAmount, response.Hops[1].TokenOut.Amount.Add(response.Hops[2].TokenOut.Amount))
+		s.Require().Equal(types.TypeMsgSplitRouteSwapExactAmountIn, response.Events[len(response.Events)-1].Type)
+
+		cacheCtx, _ := s.Ctx.CacheContext()
+		tokenOutAmount, err := poolmanagerKeeper.SplitRouteExactAmountIn(cacheCtx, sender, routes, tokenIn.Denom, osmomath.OneInt())
+		s.Require().NoError(err)
+		s.Require().Equal(tokenOutAmount, response.TokenOutAmount)
+	})
+
+	s.Run("dynamic spread factor", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		record := cltypes.DynamicSpreadFactorRecord{
+			PoolId:               clPool.GetId(),
+			Enabled:              true,
+			MinSpreadFactor:      osmomath.MustNewDecFromStr("0.0005"),
+			MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+			VolatilityMultiplier: osmomath.OneDec(),
+			VolatilityWindow:     time.Hour,
+		}
+		s.Require().NoError(clKeeper.SetDynamicSpreadFactorRecords(ctx, []cltypes.DynamicSpreadFactorRecord{record}))
+
+		response, err := poolmanagerKeeper.SimulateSwap(ctx, sender, []types.SwapAmountInSplitRoute{{Pools: route, TokenInAmount: tokenIn.Amount}}, tokenIn.Denom)
+		s.Require().NoError(err)
+
+		// The simulation reports the applied dynamic spread factor rather than the spread factor of the pool.
+		effectiveSpreadFactor, err := poolmanagerKeeper.GetEffectiveSpreadFactor(ctx, clPool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(record.MinSpreadFactor, effectiveSpreadFactor)
+		s.Require().Equal(effectiveSpreadFactor, response.Hops[0].SpreadFactor)
+		s.Require().False(pointThreePercent.Equal(response.Hops[0].SpreadFactor))
+
+		tokenOutAmount, err := poolmanagerKeeper.RouteExactAmountIn(ctx, sender, route, tokenIn, osmomath.OneInt())
+		s.Require().NoError(err)
+		s.Require().Equal(tokenOutAmount, response.TokenOutAmount)
+	})
+}
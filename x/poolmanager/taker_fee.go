@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v21/app/params"
@@ -84,6 +85,127 @@ func (k Keeper) GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (
 	return takerFee.Dec, nil
 }
 
+// GetTakerFee returns the taker fee for swapping the given denoms against the given pool.
+// The taker fee override of the pool takes precedence over the taker fee of the trading pair,
+// which falls back to the default taker fee.
+func (k Keeper) GetTakerFee(ctx sdk.Context, poolId uint64, denom0, denom1 string) (osmomath.Dec, error) {
+	takerFee, found, err := k.GetPoolTakerFee(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if found {
+		return takerFee, nil
+	}
+
+	return k.GetTradingPairTakerFee(ctx, denom0, denom1)
+}
+
+// SetPoolTakerFee sets the taker fee override for the given pool.
+func (k Keeper) SetPoolTakerFee(ctx sdk.Context, poolId uint64, takerFee osmomath.Dec) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatPoolTakerFeeKey(poolId), &types.PoolTakerFee{
+		PoolId:   poolId,
+		TakerFee: takerFee,
+	})
+}
+
+// DeletePoolTakerFee removes the taker fee override of the given pool, if any.
+func (k Keeper) DeletePoolTakerFee(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatPoolTakerFeeKey(poolId))
+}
+
+// GetPoolTakerFee returns the taker fee override of the given pool and true if it exists.
+// Returns false otherwise.
+func (k Keeper) GetPoolTakerFee(ctx sdk.Context, poolId uint64) (osmomath.Dec, bool, error) {
+	record := types.PoolTakerFee{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatPoolTakerFeeKey(poolId), &record)
+	if err != nil || !found {
+		return osmomath.Dec{}, false, err
+	}
+	return record.TakerFee, true, nil
+}
+
+// GetAllPoolTakerFees returns the taker fee overrides of all pools.
+func (k Keeper) GetAllPoolTakerFees(ctx sdk.Context) ([]types.PoolTakerFee, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PoolTakerFeePrefix, func(bz []byte) (types.PoolTakerFee, error) {
+		record := types.PoolTakerFee{}
+		if err := proto.Unmarshal(bz, &record); err != nil {
+			return types.PoolTakerFee{}, err
+		}
+		return record, nil
+	})
+}
+
+// SetRouteTakerFee sets the taker fee override for the multihop routes ending in the given token out denom.
+func (k Keeper) SetRouteTakerFee(ctx sdk.Context, tokenOutDenom string, takerFee osmomath.Dec) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatRouteTakerFeeKey(tokenOutDenom), &types.RouteTakerFee{
+		TokenOutDenom: tokenOutDenom,
+		TakerFee:      takerFee,
+	})
+}
+
+// DeleteRouteTakerFee removes the taker fee override of the multihop routes ending in the given token out denom, if any.
+func (k Keeper) DeleteRouteTakerFee(ctx sdk.Context, tokenOutDenom string) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatRouteTakerFeeKey(tokenOutDenom))
+}
+
+// GetRouteTakerFee returns the taker fee override of the multihop routes ending in the given token out denom
+// and true if it exists. Returns false otherwise.
+func (k Keeper) GetRouteTakerFee(ctx sdk.Context, tokenOutDenom string) (osmomath.Dec, bool, error) {
+	record := types.RouteTakerFee{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatRouteTakerFeeKey(tokenOutDenom), &record)
+	if err != nil || !found {
+		return osmomath.Dec{}, false, err
+	}
+	return record.TakerFee, true, nil
+}
+
+// getMultihopRouteTakerFee returns the taker fee override of a route with the given number of hops
+// ending in the given token out denom. Returns a nil Dec if the route is a single hop route
+// or if there is no override for its token out denom.
+func (k Keeper) getMultihopRouteTakerFee(ctx sdk.Context, numHops int, tokenOutDenom string) (osmomath.Dec, error) {
+	if numHops < 2 {
+		return osmomath.Dec{}, nil
+	}
+
+	takerFee, found, err := k.GetRouteTakerFee(ctx, tokenOutDenom)
+	if err != nil || !found {
+		return osmomath.Dec{}, err
+	}
+	return takerFee, nil
+}
+
+// GetAllRouteTakerFees returns the taker fee overrides of all multihop routes.
+func (k Keeper) GetAllRouteTakerFees(ctx sdk.Context) ([]types.RouteTakerFee, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.RouteTakerFeePrefix, func(bz []byte) (types.RouteTakerFee, error) {
+		record := types.RouteTakerFee{}
+		if err := proto.Unmarshal(bz, &record); err != nil {
+			return types.RouteTakerFee{}, err
+		}
+		return record, nil
+	})
+}
+
+// getRouteHopTakerFee returns the taker fee for a hop of a route given the taker fee override of the route,
+// which is nil if there is none.
+// The taker fee override of the pool of the hop takes precedence over the taker fee override of the route
+// so that a discount governance sets for a specific pool applies however the pool is routed through.
+// The taker fee override of the route takes precedence over the taker fee of the trading pair,
+// which falls back to the default taker fee.
+func (k Keeper) getRouteHopTakerFee(ctx sdk.Context, routeTakerFee osmomath.Dec, poolId uint64, denom0, denom1 string) (osmomath.Dec, error) {
+	takerFee, found, err := k.GetPoolTakerFee(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if found {
+		return takerFee, nil
+	}
+
+	if !routeTakerFee.IsNil() {
+		return routeTakerFee, nil
+	}
+	return k.GetTradingPairTakerFee(ctx, denom0, denom1)
+}
+
 // GetAllTradingPairTakerFees returns all the custom taker fees for trading pairs.
 func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTakerFee, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -110,9 +232,12 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 
 // chargeTakerFee extracts the taker fee from the given tokenIn and sends it to the appropriate
 // module account. It returns the tokenIn after the taker fee has been extracted.
+// The taker fee is the taker fee override of the given pool if there is one.
+// Otherwise, it is the given taker fee override of the route being swapped if it is not nil,
+// falling back to the taker fee of the trading pair and then to the default taker fee.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
 // In the future, we might charge a lower taker fee as opposed to no fee at all.
-func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
+func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool, poolId uint64, routeTakerFee osmomath.Dec) (sdk.Coin, error) {
 	feeCollectorForStakingRewardsName := txfeestypes.FeeCollectorForStakingRewardsName
 	feeCollectorForCommunityPoolName := txfeestypes.FeeCollectorForCommunityPoolName
 	defaultTakerFeeDenom := appparams.BaseCoinUnit
@@ -123,7 +248,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 		return tokenIn, nil
 	}
 
-	takerFee, err := k.GetTradingPairTakerFee(ctx, tokenIn.Denom, tokenOutDenom)
+	takerFee, err := k.getRouteHopTakerFee(ctx, routeTakerFee, poolId, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -142,6 +267,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 	// We determine the distributution of the taker fee based on its denom
 	// If the denom is the base denom:
 	takerFeeAmtRemaining := takerFeeCoin.Amount
+	takerFeeToCommunityPoolAmt, takerFeeToStakersAmt := osmomath.ZeroInt(), osmomath.ZeroInt()
 	if takerFeeCoin.Denom == defaultTakerFeeDenom {
 		// Community Pool:
 		if poolManagerParams.TakerFeeParams.OsmoTakerFeeDistribution.CommunityPool.GT(osmomath.ZeroDec()) {
@@ -153,6 +279,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 				return sdk.Coin{}, err
 			}
 			k.IncreaseTakerFeeTrackerForCommunityPool(ctx, osmoTakerFeeToCommunityPoolCoin)
+			takerFeeToCommunityPoolAmt = osmoTakerFeeToCommunityPoolCoin.Amount
 			takerFeeAmtRemaining = takerFeeAmtRemaining.Sub(osmoTakerFeeToCommunityPoolCoin.Amount)
 		}
 		// Staking Rewards:
@@ -165,6 +292,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 				return sdk.Coin{}, err
 			}
 			k.IncreaseTakerFeeTrackerForStakers(ctx, osmoTakerFeeToStakingRewardsCoin)
+			takerFeeToStakersAmt = osmoTakerFeeToStakingRewardsCoin.Amount
 		}
 
 		// If the denom is not the base denom:
@@ -181,6 +309,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 					return sdk.Coin{}, err
 				}
 				k.IncreaseTakerFeeTrackerForCommunityPool(ctx, nonOsmoTakerFeeToCommunityPoolCoin)
+				takerFeeToCommunityPoolAmt = nonOsmoTakerFeeToCommunityPoolCoin.Amount
 				takerFeeAmtRemaining = takerFeeAmtRemaining.Sub(nonOsmoTakerFeeToCommunityPoolCoin.Amount)
 			} else {
 				// If the non osmo denom is not a whitelisted asset, we send to the non native fee pool for community pool module account.
@@ -192,6 +321,7 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 					return sdk.Coin{}, err
 				}
 				k.IncreaseTakerFeeTrackerForCommunityPool(ctx, nonOsmoTakerFeeToCommunityPoolCoin)
+				takerFeeToCommunityPoolAmt = nonOsmoTakerFeeToCommunityPoolCoin.Amount
 				takerFeeAmtRemaining = takerFeeAmtRemaining.Sub(nonOsmoTakerFeeToCommunityPoolCoin.Amount)
 			}
 		}
@@ -204,9 +334,15 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 				return sdk.Coin{}, err
 			}
 			k.IncreaseTakerFeeTrackerForStakers(ctx, nonOsmoTakerFeeToStakingRewardsCoin)
+			takerFeeToStakersAmt = nonOsmoTakerFeeToStakingRewardsCoin.Amount
 		}
 	}
 
+	// Accumulate the taker fee revenue of the pool, so that protocol revenue can be reported per pool and denom pair.
+	if err := k.increaseTakerFeeRevenue(ctx, poolId, takerFeeCoin.Denom, tokenOutDenom, takerFeeToCommunityPoolAmt, takerFeeToStakersAmt); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	return tokenInAfterTakerFee, nil
 }
 
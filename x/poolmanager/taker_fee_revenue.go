@@ -0,0 +1,181 @@
+package poolmanager
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// takerFeeRevenueBucketStartTime returns the start time of the time bucket the given time falls in.
+func takerFeeRevenueBucketStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(types.TakerFeeRevenueBucketDuration)
+}
+
+// sortDenomPair returns the given denoms in lexicographic order.
+func sortDenomPair(denomA, denomB string) (denom0, denom1 string) {
+	denoms := []string{denomA, denomB}
+	sort.Strings(denoms)
+	return denoms[0], denoms[1]
+}
+
+// increaseTakerFeeRevenue adds the given taker fee amounts charged in the token in denom when swapping against
+// the given pool to the taker fee revenue of the denom pair in the time bucket of the current block.
+// Returns error if the stored taker fee revenue cannot be read.
+func (k Keeper) increaseTakerFeeRevenue(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, communityPool, stakers osmomath.Int) error {
+	if communityPool.IsZero() && stakers.IsZero() {
+		return nil
+	}
+
+	bucketStartTime := takerFeeRevenueBucketStartTime(ctx.BlockTime())
+	denom0, denom1 := sortDenomPair(tokenInDenom, tokenOutDenom)
+	key := types.FormatTakerFeeRevenueKey(bucketStartTime, poolId, denom0, denom1, tokenInDenom)
+
+	revenue := types.TakerFeeRevenue{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), key, &revenue)
+	if err != nil {
+		return err
+	}
+	if !found {
+		revenue = types.TakerFeeRevenue{
+			PoolId:          poolId,
+			Denom0:          denom0,
+			Denom1:          denom1,
+			Denom:           tokenInDenom,
+			BucketStartTime: bucketStartTime,
+			CommunityPool:   osmomath.ZeroInt(),
+			Stakers:         osmomath.ZeroInt(),
+			Burn:            osmomath.ZeroInt(),
+		}
+	}
+
+	revenue.CommunityPool = revenue.CommunityPool.Add(communityPool)
+	revenue.Stakers = revenue.Stakers.Add(stakers)
+	k.setTakerFeeRevenue(ctx, revenue)
+	return nil
+}
+
+// setTakerFeeRevenue stores the given taker fee revenue and indexes it by pool and by denom pair.
+func (k Keeper) setTakerFeeRevenue(ctx sdk.Context, revenue types.TakerFeeRevenue) {
+	store := ctx.KVStore(k.storeKey)
+	bucketStartTime := takerFeeRevenueBucketStartTime(revenue.BucketStartTime)
+	key := types.FormatTakerFeeRevenueKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom)
+
+	osmoutils.MustSet(store, key, &revenue)
+	store.Set(types.FormatTakerFeeRevenueByPoolKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom), key)
+	store.Set(types.FormatTakerFeeRevenueByDenomPairKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom), key)
+}
+
+// deleteTakerFeeRevenue deletes the given taker fee revenue along with its index entries.
+func (k Keeper) deleteTakerFeeRevenue(ctx sdk.Context, revenue types.TakerFeeRevenue) {
+	store := ctx.KVStore(k.storeKey)
+	bucketStartTime := takerFeeRevenueBucketStartTime(revenue.BucketStartTime)
+
+	store.Delete(types.FormatTakerFeeRevenueKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom))
+	store.Delete(types.FormatTakerFeeRevenueByPoolKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom))
+	store.Delete(types.FormatTakerFeeRevenueByDenomPairKey(bucketStartTime, revenue.PoolId, revenue.Denom0, revenue.Denom1, revenue.Denom))
+}
+
+// PruneExpiredTakerFeeRevenues deletes the taker fee revenues of the time buckets that started more than
+// the retention period before the current block. At most MaxTakerFeeRevenuesPrunedPerBlock revenues are
+// deleted per call, the remaining ones are deleted by the following calls.
+func (k Keeper) PruneExpiredTakerFeeRevenues(ctx sdk.Context) error {
+	cutoffBucketStartTime := takerFeeRevenueBucketStartTime(ctx.BlockTime().Add(-types.TakerFeeRevenueRetentionPeriod))
+	if cutoffBucketStartTime.Unix() <= 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.TakerFeeRevenuePrefix, types.FormatTakerFeeRevenueBucketPrefix(cutoffBucketStartTime))
+	expiredRevenues := []types.TakerFeeRevenue{}
+	for ; iterator.Valid() && len(expiredRevenues) < types.MaxTakerFeeRevenuesPrunedPerBlock; iterator.Next() {
+		revenue, err := parseTakerFeeRevenue(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		expiredRevenues = append(expiredRevenues, revenue)
+	}
+	iterator.Close()
+
+	for _, revenue := range expiredRevenues {
+		k.deleteTakerFeeRevenue(ctx, revenue)
+	}
+	return nil
+}
+
+// GetTakerFeeRevenuesByPool returns the taker fee revenues of the given pool for every denom pair,
+// for each time bucket from the one startTime falls in until endTime.
+func (k Keeper) GetTakerFeeRevenuesByPool(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, pagination *query.PageRequest) ([]types.TakerFeeRevenue, *query.PageResponse, error) {
+	return k.getIndexedTakerFeeRevenues(ctx, types.FormatTakerFeeRevenueByPoolPrefix(poolId), startTime, endTime, pagination)
+}
+
+// GetTakerFeeRevenuesByDenomPair returns the taker fee revenues of the given denom pair on every pool,
+// for each time bucket from the one startTime falls in until endTime.
+// The denoms may be given in any order.
+func (k Keeper) GetTakerFeeRevenuesByDenomPair(ctx sdk.Context, denomA, denomB string, startTime, endTime time.Time, pagination *query.PageRequest) ([]types.TakerFeeRevenue, *query.PageResponse, error) {
+	denom0, denom1 := sortDenomPair(denomA, denomB)
+	return k.getIndexedTakerFeeRevenues(ctx, types.FormatTakerFeeRevenueByDenomPairPrefix(denom0, denom1), startTime, endTime, pagination)
+}
+
+// GetAllTakerFeeRevenues returns the taker fee revenues of all time buckets.
+func (k Keeper) GetAllTakerFeeRevenues(ctx sdk.Context) ([]types.TakerFeeRevenue, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.TakerFeeRevenuePrefix, parseTakerFeeRevenue)
+}
+
+// getIndexedTakerFeeRevenues returns a page of the taker fee revenues referenced by the index under the given prefix,
+// for each time bucket from the one startTime falls in until endTime.
+// The index keys under the prefix must start with the time bucket and the index values are the revenue keys.
+// The index keys of the time buckets outside of the range are filtered out, the expired time buckets being pruned
+// bounds the number of index keys iterated. The page keys are relative to the index prefix.
+// Returns error if endTime is before startTime or if the pagination is invalid.
+func (k Keeper) getIndexedTakerFeeRevenues(ctx sdk.Context, indexPrefix []byte, startTime, endTime time.Time, pagination *query.PageRequest) ([]types.TakerFeeRevenue, *query.PageResponse, error) {
+	if endTime.Before(startTime) {
+		return nil, nil, types.InvalidTakerFeeRevenueTimeRangeError{StartTime: startTime, EndTime: endTime}
+	}
+
+	startKey := sdk.Uint64ToBigEndian(uint64(takerFeeRevenueBucketStartTime(startTime).Unix()))
+	endKey := sdk.Uint64ToBigEndian(uint64(takerFeeRevenueBucketStartTime(endTime).Add(types.TakerFeeRevenueBucketDuration).Unix()))
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	revenues := []types.TakerFeeRevenue{}
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		if bytes.Compare(key, startKey) < 0 || bytes.Compare(key, endKey) >= 0 {
+			return false, nil
+		}
+		if accumulate {
+			revenue := types.TakerFeeRevenue{}
+			found, err := osmoutils.Get(store, value, &revenue)
+			if err != nil {
+				return false, err
+			}
+			if !found {
+				return false, types.TakerFeeRevenueNotFoundError{Key: value}
+			}
+			revenues = append(revenues, revenue)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return revenues, pageRes, nil
+}
+
+func parseTakerFeeRevenue(bz []byte) (types.TakerFeeRevenue, error) {
+	revenue := types.TakerFeeRevenue{}
+	if err := proto.Unmarshal(bz, &revenue); err != nil {
+		return types.TakerFeeRevenue{}, err
+	}
+	return revenue, nil
+}
@@ -5,9 +5,12 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 // validates that the pool manager keeper can charge taker fees correctly.
@@ -108,7 +111,7 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 			}
 
 			// Create pool.
-			s.PrepareConcentratedPool()
+			pool := s.PrepareConcentratedPool()
 
 			// Set taker fee.
 			poolManager.SetDenomPairTakerFee(s.Ctx, tc.tokenIn.Denom, tc.tokenOutDenom, tc.takerFee)
@@ -126,7 +129,7 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 			takerFeeTrackerForCommunityPoolBefore := poolManager.GetTakerFeeTrackerForCommunityPool(s.Ctx)
 
 			// System under test.
-			tokenInAfterTakerFee, err := poolManager.ChargeTakerFee(s.Ctx, tc.tokenIn, tc.tokenOutDenom, s.TestAccs[tc.senderIndex], tc.exactIn)
+			tokenInAfterTakerFee, err := poolManager.ChargeTakerFee(s.Ctx, tc.tokenIn, tc.tokenOutDenom, s.TestAccs[tc.senderIndex], tc.exactIn, pool.GetId(), osmomath.Dec{})
 
 			// Check the taker fee tracker after the taker fee is charged.
 			takerFeeTrackerForStakersAfter := poolManager.GetTakerFeeTrackerForStakers(s.Ctx)
@@ -175,3 +178,202 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 		})
 	}
 }
+
+// validates the precedence of the taker fee overrides of pools over the taker fee overrides of routes
+// and of both over the taker fees of denom pairs and the default taker fee.
+func (s *KeeperTestSuite) TestTakerFeeOverrides() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+
+	ethOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.ETH, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+	usdcOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.USDC, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+	defaultTakerFee := poolManager.GetDefaultTakerFee(s.Ctx)
+	denomPairTakerFee := osmomath.MustNewDecFromStr("0.002")
+	poolTakerFee := osmomath.MustNewDecFromStr("0.0005")
+	poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.ETH, UOSMO, denomPairTakerFee)
+
+	// Without a pool override, the denom pair taker fee falls back to the default taker fee.
+	takerFee, err := poolManager.GetTakerFee(s.Ctx, usdcOsmoPoolId, apptesting.USDC, UOSMO)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultTakerFee, takerFee)
+	takerFee, err = poolManager.GetTakerFee(s.Ctx, ethOsmoPoolId, apptesting.ETH, UOSMO)
+	s.Require().NoError(err)
+	s.Require().Equal(denomPairTakerFee, takerFee)
+
+	// The pool override takes precedence over the denom pair taker fee.
+	poolManager.SetPoolTakerFee(s.Ctx, ethOsmoPoolId, poolTakerFee)
+	takerFee, err = poolManager.GetTakerFee(s.Ctx, ethOsmoPoolId, UOSMO, apptesting.ETH)
+	s.Require().NoError(err)
+	s.Require().Equal(poolTakerFee, takerFee)
+
+	totalTakerFees := func() sdk.Coins {
+		return poolManager.GetTakerFeeTrackerForStakers(s.Ctx).Add(poolManager.GetTakerFeeTrackerForCommunityPool(s.Ctx)...)
+	}
+	tokenIn := sdk.NewCoin(apptesting.ETH, osmomath.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn.Add(tokenIn)))
+
+	s.Run("pool override is charged on a single hop swap", func() {
+		takerFeesBefore := totalTakerFees()
+		_, err := poolManager.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []types.SwapAmountInRoute{{PoolId: ethOsmoPoolId, TokenOutDenom: UOSMO}}, tokenIn, osmomath.OneInt())
+		s.Require().NoError(err)
+
+		_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, poolTakerFee)
+		s.Require().Equal(sdk.NewCoins(expectedTakerFee), totalTakerFees().Sub(takerFeesBefore...))
+	})
+
+	s.Run("route override is charged on every hop of the route without a pool override", func() {
+		route := []types.SwapAmountInRoute{
+			{PoolId: ethOsmoPoolId, TokenOutDenom: UOSMO},
+			{PoolId: usdcOsmoPoolId, TokenOutDenom: apptesting.USDC},
+		}
+		poolManager.SetRouteTakerFee(s.Ctx, apptesting.USDC, osmomath.ZeroDec())
+
+		expectedTokenOutAmount, err := poolManager.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+		s.Require().NoError(err)
+
+		takerFeesBefore := totalTakerFees()
+		tokenOutAmount, err := poolManager.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt())
+		s.Require().NoError(err)
+
+		// The pool override of the first hop takes precedence over the route override of the second hop.
+		_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, poolTakerFee)
+		s.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+		s.Require().Equal(sdk.NewCoins(expectedTakerFee), totalTakerFees().Sub(takerFeesBefore...))
+	})
+
+	s.Run("route override is not charged on a single hop swap", func() {
+		osmoIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(osmoIn))
+
+		takerFeesBefore := totalTakerFees()
+		_, err := poolManager.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []types.SwapAmountInRoute{{PoolId: usdcOsmoPoolId, TokenOutDenom: apptesting.USDC}}, osmoIn, osmomath.OneInt())
+		s.Require().NoError(err)
+
+		_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(osmoIn, defaultTakerFee)
+		s.Require().Equal(sdk.NewCoins(expectedTakerFee), totalTakerFees().Sub(takerFeesBefore...))
+	})
+
+	s.Run("overrides are removed by proposal and exported", func() {
+		exported := poolManager.ExportGenesis(s.Ctx)
+		s.Require().Equal([]types.PoolTakerFee{{PoolId: ethOsmoPoolId, TakerFee: poolTakerFee}}, exported.PoolTakerFeeStore)
+		s.Require().Len(exported.RouteTakerFeeStore, 1)
+		s.Require().Equal(apptesting.USDC, exported.RouteTakerFeeStore[0].TokenOutDenom)
+		s.Require().True(exported.RouteTakerFeeStore[0].TakerFee.IsZero())
+
+		err := poolManager.HandleTakerFeeOverrideProposal(s.Ctx, &types.TakerFeeOverrideProposal{
+			PoolTakerFees:  []types.PoolTakerFee{{PoolId: ethOsmoPoolId, Remove: true}},
+			RouteTakerFees: []types.RouteTakerFee{{TokenOutDenom: apptesting.USDC, Remove: true}},
+		})
+		s.Require().NoError(err)
+
+		_, found, err := poolManager.GetPoolTakerFee(s.Ctx, ethOsmoPoolId)
+		s.Require().NoError(err)
+		s.Require().False(found)
+		_, found, err = poolManager.GetRouteTakerFee(s.Ctx, apptesting.USDC)
+		s.Require().NoError(err)
+		s.Require().False(found)
+	})
+}
+
+// validates that the taker fees charged on swaps are accumulated per pool, denom pair and time bucket,
+// queried page by page and pruned after the retention period.
+func (s *KeeperTestSuite) TestTakerFeeRevenue() {
+	s.SetupTest()
+	poolManager := s.App.PoolManagerKeeper
+
+	ethOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.ETH, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+	usdcOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.USDC, defaultInitPoolAmount), sdk.NewCoin(UOSMO, defaultInitPoolAmount))
+	takerFee := osmomath.MustNewDecFromStr("0.002")
+	poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.ETH, UOSMO, takerFee)
+	poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.USDC, UOSMO, takerFee)
+	tokenIn := sdk.NewCoin(apptesting.ETH, osmomath.NewInt(1_000_000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn.Add(tokenIn), sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))))
+	_, expectedTakerFee := poolmanager.CalcTakerFeeExactIn(tokenIn, takerFee)
+
+	swap := func(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+		_, err := poolManager.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, tokenIn, tokenOutDenom, osmomath.OneInt())
+		s.Require().NoError(err)
+	}
+
+	firstBucketTime := s.Ctx.BlockTime()
+	swap(ethOsmoPoolId, tokenIn, UOSMO)
+	s.Ctx = s.Ctx.WithBlockTime(firstBucketTime.Add(types.TakerFeeRevenueBucketDuration))
+	swap(ethOsmoPoolId, tokenIn, UOSMO)
+	swap(usdcOsmoPoolId, sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)), apptesting.USDC)
+
+	revenues, pageRes, err := poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 2)
+	s.Require().Nil(pageRes.NextKey)
+	s.Require().Equal(uint64(2), pageRes.Total)
+	for _, revenue := range revenues {
+		s.Require().Equal(apptesting.ETH, revenue.Denom0)
+		s.Require().Equal(UOSMO, revenue.Denom1)
+		s.Require().Equal(apptesting.ETH, revenue.Denom)
+		s.Require().Equal(expectedTakerFee.Amount, revenue.CommunityPool.Add(revenue.Stakers))
+		s.Require().True(revenue.CommunityPool.IsPositive())
+		s.Require().True(revenue.Stakers.IsPositive())
+		s.Require().True(revenue.Burn.IsZero())
+	}
+	s.Require().True(revenues[0].BucketStartTime.Before(revenues[1].BucketStartTime))
+
+	// The revenues are paginated.
+	firstPage, pageRes, err := poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, s.Ctx.BlockTime(), &query.PageRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(firstPage, 1)
+	s.Require().NotNil(pageRes.NextKey)
+	nextKey := pageRes.NextKey
+	secondPage, pageRes, err := poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, s.Ctx.BlockTime(), &query.PageRequest{Key: nextKey, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Nil(pageRes.NextKey)
+	s.Require().Equal(revenues[0], firstPage[0])
+	s.Require().Equal(revenues[1], secondPage[0])
+
+	// The page key must not be combined with an offset, and no revenue is returned past the time range.
+	_, _, err = poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, s.Ctx.BlockTime(), &query.PageRequest{Key: nextKey, Offset: 1})
+	s.Require().Error(err)
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, firstBucketTime, &query.PageRequest{Key: nextKey})
+	s.Require().NoError(err)
+	s.Require().Empty(revenues)
+
+	// The time range only includes the buckets it overlaps.
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, firstBucketTime, nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 1)
+
+	// The denom pair may be given in any order.
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByDenomPair(s.Ctx, UOSMO, apptesting.USDC, firstBucketTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 1)
+	s.Require().Equal(usdcOsmoPoolId, revenues[0].PoolId)
+	s.Require().Equal(UOSMO, revenues[0].Denom)
+
+	_, _, err = poolManager.GetTakerFeeRevenuesByDenomPair(s.Ctx, UOSMO, apptesting.USDC, s.Ctx.BlockTime(), firstBucketTime, nil)
+	s.Require().ErrorIs(err, types.InvalidTakerFeeRevenueTimeRangeError{StartTime: s.Ctx.BlockTime(), EndTime: firstBucketTime})
+
+	// The revenues are exported and imported.
+	exported := poolManager.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.TakerFeeRevenues, 3)
+	s.SetupTest()
+	poolManager = s.App.PoolManagerKeeper
+	poolManager.InitGenesis(s.Ctx, exported)
+	revenues, err = poolManager.GetAllTakerFeeRevenues(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported.TakerFeeRevenues, revenues)
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByDenomPair(s.Ctx, UOSMO, apptesting.ETH, firstBucketTime, firstBucketTime.Add(types.TakerFeeRevenueBucketDuration), nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 2)
+
+	// The first time bucket is pruned once it is older than the retention period, along with its index entries.
+	s.Ctx = s.Ctx.WithBlockTime(firstBucketTime.Add(types.TakerFeeRevenueRetentionPeriod + types.TakerFeeRevenueBucketDuration))
+	s.Require().NoError(poolManager.PruneExpiredTakerFeeRevenues(s.Ctx))
+	revenues, err = poolManager.GetAllTakerFeeRevenues(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 2)
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByPool(s.Ctx, ethOsmoPoolId, firstBucketTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 1)
+	revenues, _, err = poolManager.GetTakerFeeRevenuesByDenomPair(s.Ctx, apptesting.ETH, UOSMO, firstBucketTime, s.Ctx.BlockTime(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(revenues, 1)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -133,3 +134,28 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type InvalidTakerFeeRevenueTimeRangeError struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e InvalidTakerFeeRevenueTimeRangeError) Error() string {
+	return fmt.Sprintf("taker fee revenue end time (%s) must not be before start time (%s)", e.EndTime, e.StartTime)
+}
+
+type TakerFeeRevenueNotFoundError struct {
+	Key []byte
+}
+
+func (e TakerFeeRevenueNotFoundError) Error() string {
+	return fmt.Sprintf("taker fee revenue indexed under key %X not found", e.Key)
+}
+
+type SimulateSwapInternalError struct {
+	Reason string
+}
+
+func (e SimulateSwapInternalError) Error() string {
+	return fmt.Sprintf("function SimulateSwap failed due to internal reason: %s", e.Reason)
+}
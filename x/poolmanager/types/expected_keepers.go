@@ -25,6 +25,8 @@ type BankI interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // CommunityPoolI defines the contract needed to be fulfilled for distribution keeper.
@@ -95,6 +97,13 @@ type PoolModuleI interface {
 	GetTotalLiquidity(ctx sdk.Context) (sdk.Coins, error)
 }
 
+// EffectiveSpreadFactorPoolModuleI is implemented by pool modules whose pools may charge swaps
+// a spread factor other than the one returned by PoolI.GetSpreadFactor.
+type EffectiveSpreadFactorPoolModuleI interface {
+	// GetEffectiveSpreadFactor returns the spread factor charged for swaps in the given pool.
+	GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, error)
+}
+
 type PoolIncentivesKeeperI interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) (bool, error)
 }
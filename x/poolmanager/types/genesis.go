@@ -2,8 +2,11 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // DefaultGenesis returns the default poolmanager genesis state.
@@ -28,5 +31,36 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := validatePoolTakerFees(gs.PoolTakerFeeStore); err != nil {
+		return err
+	}
+	if err := validateRouteTakerFees(gs.RouteTakerFeeStore); err != nil {
+		return err
+	}
+	return validateTakerFeeRevenues(gs.TakerFeeRevenues)
+}
+
+func validateTakerFeeRevenues(revenues []TakerFeeRevenue) error {
+	for _, revenue := range revenues {
+		if revenue.PoolId == 0 {
+			return errors.New("taker fee revenue pool id cannot be 0")
+		}
+		for _, denom := range []string{revenue.Denom0, revenue.Denom1, revenue.Denom} {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return err
+			}
+		}
+		if revenue.Denom0 >= revenue.Denom1 {
+			return fmt.Errorf("taker fee revenue denom pair (%s, %s) of pool %d must be sorted and distinct", revenue.Denom0, revenue.Denom1, revenue.PoolId)
+		}
+		if revenue.Denom != revenue.Denom0 && revenue.Denom != revenue.Denom1 {
+			return fmt.Errorf("taker fee revenue of pool %d in %s must be charged in one of the denom pair (%s, %s)", revenue.PoolId, revenue.Denom, revenue.Denom0, revenue.Denom1)
+		}
+		for _, amount := range []osmomath.Int{revenue.CommunityPool, revenue.Stakers, revenue.Burn} {
+			if amount.IsNil() || amount.IsNegative() {
+				return fmt.Errorf("taker fee revenue amounts of pool %d in %s must not be negative", revenue.PoolId, revenue.Denom)
+			}
+		}
+	}
 	return nil
 }
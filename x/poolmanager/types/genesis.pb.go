@@ -12,16 +12,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,6 +115,9 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	PoolTakerFeeStore      []PoolTakerFee      `protobuf:"bytes,7,rep,name=pool_taker_fee_store,json=poolTakerFeeStore,proto3" json:"pool_taker_fee_store"`
+	RouteTakerFeeStore     []RouteTakerFee     `protobuf:"bytes,8,rep,name=route_taker_fee_store,json=routeTakerFeeStore,proto3" json:"route_taker_fee_store"`
+	TakerFeeRevenues       []TakerFeeRevenue   `protobuf:"bytes,9,rep,name=taker_fee_revenues,json=takerFeeRevenues,proto3" json:"taker_fee_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +195,27 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolTakerFeeStore() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFeeStore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRouteTakerFeeStore() []RouteTakerFee {
+	if m != nil {
+		return m.RouteTakerFeeStore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakerFeeRevenues() []TakerFeeRevenue {
+	if m != nil {
+		return m.TakerFeeRevenues
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -397,6 +425,97 @@ func (m *TakerFeesTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+// TakerFeeRevenue is the amount of taker fees generated by swapping a denom
+// pair against a pool during a time bucket, broken down by destination.
+type TakerFeeRevenue struct {
+	// pool_id is the id of the pool the taker fees were charged on.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// denom0 and denom1 are the denoms swapped, in lexicographic order.
+	Denom0 string `protobuf:"bytes,2,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	Denom1 string `protobuf:"bytes,3,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+	// denom is the denom the taker fees were charged in, which is the token in
+	// denom of the swaps.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// bucket_start_time is the start time of the time bucket.
+	BucketStartTime time.Time `protobuf:"bytes,5,opt,name=bucket_start_time,json=bucketStartTime,proto3,stdtime" json:"bucket_start_time" yaml:"bucket_start_time"`
+	// community_pool is the amount sent to the community pool, or to be swapped
+	// and sent to the community pool.
+	CommunityPool cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.Int" json:"community_pool" yaml:"community_pool"`
+	// stakers is the amount to be distributed to stakers.
+	Stakers cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=stakers,proto3,customtype=cosmossdk.io/math.Int" json:"stakers" yaml:"stakers"`
+	// burn is the amount burned. The taker fee distribution does not burn
+	// taker fees, so it is zero until a burn share is introduced.
+	Burn cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=burn,proto3,customtype=cosmossdk.io/math.Int" json:"burn" yaml:"burn"`
+}
+
+func (m *TakerFeeRevenue) Reset()         { *m = TakerFeeRevenue{} }
+func (m *TakerFeeRevenue) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeRevenue) ProtoMessage()    {}
+func (*TakerFeeRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+}
+func (m *TakerFeeRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeRevenue.Merge(m, src)
+}
+func (m *TakerFeeRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeRevenue proto.InternalMessageInfo
+
+func (m *TakerFeeRevenue) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TakerFeeRevenue) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *TakerFeeRevenue) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
+func (m *TakerFeeRevenue) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TakerFeeRevenue) GetBucketStartTime() time.Time {
+	if m != nil {
+		return m.BucketStartTime
+	}
+	return time.Time{}
+}
+
 // PoolVolume stores the KVStore entries for each pool's volume, which
 // is used in export/import genesis.
 type PoolVolume struct {
@@ -410,7 +529,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,6 +578,7 @@ func init() {
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
+	proto.RegisterType((*TakerFeeRevenue)(nil), "osmosis.poolmanager.v1beta1.TakerFeeRevenue")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
 }
 
@@ -467,75 +587,92 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0x62, 0x93, 0x20, 0x15, 0x09, 0xe8, 0x21, 0x9b, 0x28, 0x08, 0x54, 0xda, 0xd4, 0x89,
-	0x40, 0x2a, 0x07, 0x6b, 0xd6, 0x7e, 0xf1, 0x5a, 0x6b, 0x7b, 0x96, 0x99, 0x71, 0xfe, 0x70, 0xe0,
-	0x0b, 0x54, 0x48, 0x48, 0xbd, 0x72, 0xe6, 0xc0, 0x8d, 0x6f, 0xd1, 0x63, 0x8f, 0x88, 0xc3, 0x16,
-	0x6d, 0xce, 0x5c, 0xf6, 0x13, 0x20, 0xcf, 0xcc, 0xfe, 0x6d, 0xb2, 0x84, 0x3f, 0x3d, 0xed, 0xfa,
-	0xbd, 0xdf, 0xfb, 0xf9, 0xf7, 0xde, 0x9b, 0x37, 0xcf, 0xe8, 0x3d, 0xca, 0x53, 0xca, 0x63, 0xee,
-	0xb6, 0x29, 0x4d, 0x52, 0x92, 0x91, 0x08, 0x98, 0x7b, 0xbc, 0xd9, 0x00, 0x41, 0x36, 0xdd, 0x08,
-	0x32, 0xe0, 0x31, 0x77, 0xda, 0x8c, 0x0a, 0x8a, 0xd7, 0x35, 0xd4, 0x19, 0x81, 0x3a, 0x1a, 0xba,
-	0xf6, 0x76, 0x44, 0x23, 0x2a, 0x71, 0x6e, 0xf1, 0x4f, 0x85, 0xac, 0xdd, 0x8a, 0x28, 0x8d, 0x12,
-	0x70, 0xe5, 0x53, 0x23, 0x3f, 0x72, 0x49, 0x76, 0xd6, 0x77, 0x05, 0x92, 0xce, 0x57, 0x31, 0xea,
-	0x41, 0xbb, 0x2a, 0x93, 0x51, 0x61, 0xce, 0x88, 0x88, 0x69, 0xd6, 0xf7, 0x2b, 0xb4, 0xdb, 0x20,
-	0x1c, 0x06, 0x5a, 0x03, 0x1a, 0xf7, 0xfd, 0xce, 0xb4, 0x9c, 0x52, 0x1a, 0xe6, 0x09, 0xf8, 0x8c,
-	0xe6, 0x02, 0x34, 0xfe, 0xee, 0x34, 0xbc, 0x38, 0x55, 0x28, 0xbb, 0x77, 0x0d, 0x95, 0xf7, 0x09,
-	0x23, 0x29, 0xc7, 0xcf, 0x0c, 0x74, 0xa3, 0xc0, 0xfa, 0x01, 0x03, 0x29, 0xcc, 0x3f, 0x02, 0x30,
-	0x8d, 0x6a, 0xa9, 0x36, 0xbf, 0x75, 0xcb, 0xd1, 0xb9, 0x14, 0xea, 0xfa, 0xe5, 0x71, 0x76, 0x68,
-	0x9c, 0xd5, 0x1f, 0x3c, 0xef, 0x58, 0x33, 0xbd, 0x8e, 0x65, 0x9e, 0x91, 0x34, 0xf9, 0xd8, 0x7e,
-	0x85, 0xc1, 0xfe, 0xe5, 0xa5, 0x55, 0x8b, 0x62, 0xd1, 0xcc, 0x1b, 0x4e, 0x40, 0x53, 0x5d, 0x14,
-	0xfd, 0xb3, 0xc1, 0xc3, 0x96, 0x2b, 0xce, 0xda, 0xc0, 0x25, 0x19, 0xf7, 0x96, 0x8a, 0xf8, 0x1d,
-	0x1d, 0xbe, 0x07, 0x80, 0x8f, 0xd1, 0xb2, 0x20, 0x2d, 0x60, 0x05, 0x95, 0xdf, 0x96, 0x4a, 0xcd,
-	0x6b, 0x55, 0xa3, 0x36, 0xbf, 0xf5, 0xbe, 0x33, 0xa5, 0x75, 0xce, 0x61, 0x11, 0xb4, 0x07, 0xa0,
-	0x92, 0xab, 0x5b, 0x5a, 0xe5, 0xaa, 0x52, 0x39, 0x49, 0x69, 0x7b, 0x8b, 0x62, 0x2c, 0x00, 0x3f,
-	0x41, 0xab, 0x24, 0x17, 0x4d, 0xca, 0xe2, 0xef, 0x20, 0xf4, 0xbf, 0xcd, 0xa9, 0x00, 0x3f, 0x84,
-	0x8c, 0xa6, 0xdc, 0x2c, 0x55, 0x4b, 0xb5, 0xb9, 0xba, 0xdd, 0xeb, 0x58, 0x15, 0xc5, 0x76, 0x09,
-	0xd0, 0xf6, 0x56, 0x86, 0x9e, 0xc7, 0x85, 0x63, 0x57, 0xd9, 0x5f, 0x96, 0xd0, 0xc2, 0x67, 0xea,
-	0x14, 0x1e, 0x08, 0x22, 0x00, 0x57, 0xd1, 0x42, 0x06, 0xa7, 0xc2, 0x97, 0xc5, 0x8b, 0x43, 0xd3,
-	0xa8, 0x1a, 0xb5, 0x59, 0x0f, 0x15, 0xb6, 0x7d, 0x4a, 0x93, 0xcf, 0x43, 0xbc, 0x8d, 0xca, 0x63,
-	0xc9, 0xdf, 0x99, 0x9a, 0xbc, 0x4e, 0x7a, 0xb6, 0x48, 0xda, 0xd3, 0x81, 0xf8, 0x11, 0x9a, 0x97,
-	0xfc, 0xf2, 0x90, 0xa8, 0x2c, 0xe6, 0xb7, 0x6a, 0x53, 0x79, 0xbe, 0x94, 0xc7, 0xca, 0x2b, 0x02,
-	0x34, 0x19, 0x2a, 0x60, 0xd2, 0xc0, 0xf1, 0x37, 0x08, 0x0f, 0xea, 0xc8, 0x7d, 0xc1, 0x48, 0xd0,
-	0x02, 0x66, 0xce, 0x4a, 0x7d, 0x1b, 0x57, 0x6a, 0x0e, 0x3f, 0x54, 0x41, 0xde, 0xb2, 0x98, 0xb0,
-	0xe0, 0x2f, 0xd0, 0x82, 0x54, 0x7b, 0x4c, 0x93, 0x3c, 0x05, 0x6e, 0x5e, 0x97, 0x72, 0xdf, 0x9d,
-	0x9e, 0x36, 0xa5, 0xc9, 0x57, 0x12, 0xef, 0xc9, 0x54, 0xd5, 0x7f, 0x8e, 0xdb, 0x68, 0x4d, 0x76,
-	0xc4, 0x6f, 0x93, 0x98, 0xf9, 0xc3, 0xde, 0x73, 0x41, 0x19, 0x98, 0x65, 0xc9, 0xec, 0x4c, 0x65,
-	0x96, 0x8d, 0xdb, 0x27, 0x31, 0xeb, 0x2b, 0xd7, 0xe5, 0xb8, 0x19, 0x4e, 0x3a, 0x0e, 0x0a, 0x4e,
-	0xfb, 0x69, 0x19, 0x2d, 0x8e, 0x9f, 0x40, 0xdc, 0x40, 0x37, 0x42, 0x38, 0x22, 0x79, 0x22, 0x86,
-	0x0a, 0x64, 0xa3, 0xe7, 0xea, 0xf7, 0x0a, 0xae, 0xdf, 0x3b, 0xd6, 0xba, 0x1a, 0x0a, 0x1e, 0xb6,
-	0x9c, 0x98, 0xba, 0x29, 0x11, 0x4d, 0xe7, 0x01, 0x44, 0x24, 0x38, 0xdb, 0x85, 0xa0, 0xdb, 0xb1,
-	0x96, 0x76, 0x55, 0x7c, 0x9f, 0xd8, 0x5b, 0x0a, 0xc7, 0x0d, 0xf8, 0x27, 0x03, 0xc9, 0xfb, 0x6c,
-	0x24, 0xc7, 0x30, 0xe6, 0x82, 0xc5, 0x8d, 0xbc, 0x98, 0x27, 0x7d, 0x76, 0x3e, 0xb9, 0x52, 0x6f,
-	0x76, 0x47, 0x02, 0xf7, 0x81, 0x05, 0x90, 0x09, 0x12, 0x41, 0xbd, 0x5a, 0x68, 0xed, 0x76, 0x2c,
-	0xf3, 0x11, 0x4f, 0xe9, 0x45, 0x58, 0xcf, 0xa4, 0x97, 0x78, 0xf0, 0xcf, 0x06, 0xb2, 0x32, 0x9a,
-	0xf9, 0xd3, 0x24, 0x96, 0xfe, 0xbb, 0xc4, 0x3b, 0x5a, 0xe2, 0xfa, 0x43, 0x9a, 0x5d, 0xaa, 0x72,
-	0x3d, 0xbb, 0xdc, 0x89, 0x77, 0xd0, 0x12, 0x09, 0xd3, 0x38, 0xf3, 0x49, 0x18, 0x32, 0xe0, 0x1c,
-	0xb8, 0x39, 0x2b, 0x87, 0x7e, 0xad, 0xd7, 0xb1, 0x6e, 0xea, 0xa1, 0x1f, 0x07, 0xd8, 0xde, 0xa2,
-	0xb4, 0x6c, 0xf7, 0x0d, 0xf8, 0x57, 0x03, 0xdd, 0x0b, 0x68, 0x9a, 0xe6, 0x59, 0x2c, 0xce, 0xd4,
-	0x68, 0xab, 0x53, 0x28, 0xa8, 0xcf, 0x4f, 0x48, 0xdb, 0x2f, 0x4a, 0x71, 0xd2, 0x8c, 0x05, 0x24,
-	0x31, 0x17, 0x10, 0xfa, 0x84, 0x73, 0x10, 0xdc, 0x17, 0xd4, 0xbc, 0x2e, 0x8f, 0xc5, 0x76, 0xaf,
-	0x63, 0xdd, 0x57, 0x2f, 0xfb, 0x77, 0x3c, 0xb6, 0xe7, 0x0c, 0x02, 0x8b, 0xd9, 0x90, 0xa7, 0xf8,
-	0x90, 0x1e, 0x9c, 0x90, 0xf6, 0x43, 0x9a, 0x7d, 0x3d, 0x0c, 0xd9, 0x96, 0x11, 0x87, 0x14, 0x1f,
-	0xa2, 0x15, 0x06, 0x61, 0x1e, 0x40, 0x28, 0x3b, 0x33, 0x60, 0x95, 0x43, 0x32, 0x57, 0xaf, 0xf6,
-	0x3a, 0xd6, 0x6d, 0xa5, 0xe8, 0x42, 0x98, 0xed, 0xbd, 0xa5, 0xed, 0x7b, 0x00, 0x03, 0x7e, 0xfb,
-	0x4f, 0x03, 0x55, 0xa6, 0xf7, 0x0c, 0x1f, 0xa1, 0x25, 0x2e, 0x48, 0x2b, 0xce, 0x22, 0x9f, 0xc1,
-	0x09, 0x61, 0x21, 0xd7, 0xb3, 0x71, 0xff, 0x0a, 0xb3, 0x31, 0x6c, 0xca, 0x04, 0x87, 0xed, 0x2d,
-	0x6a, 0x8b, 0xa7, 0x0c, 0x38, 0x40, 0x8b, 0xe3, 0xb5, 0x94, 0x33, 0x31, 0x57, 0xff, 0xf4, 0x6a,
-	0xaf, 0x59, 0xb9, 0xa8, 0x1d, 0xb6, 0xf7, 0xe6, 0x58, 0x99, 0xed, 0x1f, 0x4a, 0x68, 0x79, 0xf2,
-	0x8a, 0xc3, 0xdf, 0xa3, 0x95, 0xd1, 0xdb, 0x92, 0xfa, 0x5c, 0x3e, 0xf2, 0xbf, 0xdf, 0xb0, 0x1f,
-	0x14, 0xda, 0xfe, 0xd1, 0x16, 0xc5, 0xc3, 0xeb, 0x94, 0x1e, 0xa8, 0xd7, 0xe0, 0xa7, 0x06, 0xba,
-	0x3d, 0x2e, 0xe0, 0x95, 0x42, 0xfc, 0xef, 0x3a, 0xcc, 0x11, 0x1d, 0x3b, 0xa3, 0x25, 0xc2, 0x2d,
-	0xf4, 0x4e, 0x13, 0xe2, 0xa8, 0x29, 0x7c, 0x12, 0x04, 0x34, 0xcf, 0x44, 0xd1, 0x35, 0x2e, 0x08,
-	0x13, 0xdc, 0x3f, 0x62, 0x34, 0x95, 0xf7, 0x40, 0xa9, 0x5e, 0xeb, 0x75, 0xac, 0xbb, 0xaa, 0xe6,
-	0x53, 0xe1, 0xb6, 0xb7, 0xa6, 0xfc, 0xdb, 0x03, 0xf7, 0x81, 0xf4, 0xee, 0x15, 0xce, 0x67, 0x06,
-	0x42, 0xc3, 0xdd, 0x80, 0x57, 0xd1, 0x1b, 0xe3, 0x8b, 0xb6, 0xdc, 0x56, 0x4b, 0x36, 0xd1, 0x1b,
-	0x52, 0xed, 0x9c, 0xd7, 0x51, 0x10, 0x34, 0x5c, 0x4b, 0xf5, 0xc7, 0xcf, 0xbb, 0x15, 0xe3, 0x45,
-	0xb7, 0x62, 0xfc, 0xd1, 0xad, 0x18, 0x3f, 0x9e, 0x57, 0x66, 0x5e, 0x9c, 0x57, 0x66, 0x7e, 0x3b,
-	0xaf, 0xcc, 0x3c, 0xf9, 0x68, 0x84, 0x4f, 0xdf, 0x83, 0x1b, 0x09, 0x69, 0xf0, 0xfe, 0x83, 0x7b,
-	0xbc, 0xb5, 0xe9, 0x9e, 0x8e, 0x7d, 0xd8, 0xc9, 0x97, 0x34, 0xca, 0xf2, 0xa3, 0xee, 0xc3, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xc5, 0xf0, 0xa5, 0x00, 0x0b, 0x00, 0x00,
+	// 1351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x23, 0x47, 0x8e, 0x57, 0x8e, 0x64, 0xef, 0x1b, 0x27, 0x8c, 0x9d, 0x57, 0x14, 0x98,
+	0xa0, 0x55, 0x9a, 0x84, 0x8a, 0x5d, 0x20, 0x05, 0xda, 0x06, 0xa8, 0x65, 0xc3, 0x45, 0x8a, 0x34,
+	0x71, 0x68, 0xa3, 0x05, 0x52, 0x14, 0xec, 0x8a, 0x5c, 0xcb, 0x84, 0x44, 0xae, 0xba, 0xbb, 0xb4,
+	0xe3, 0x1e, 0x7a, 0x2e, 0x10, 0x14, 0x08, 0x10, 0xa0, 0xa7, 0x9e, 0x7b, 0xe8, 0xad, 0xff, 0x22,
+	0xc7, 0x1c, 0x8b, 0x1e, 0x98, 0xc2, 0x3e, 0xf7, 0xa2, 0x5f, 0x50, 0x70, 0x77, 0x29, 0x89, 0xb4,
+	0x2d, 0xbb, 0x5f, 0x27, 0x8b, 0xf3, 0xf1, 0xcc, 0x33, 0x33, 0x3b, 0xbb, 0x63, 0x70, 0x93, 0xb0,
+	0x80, 0x30, 0x9f, 0x35, 0x7a, 0x84, 0x74, 0x03, 0x14, 0xa2, 0x36, 0xa6, 0x8d, 0xdd, 0xa5, 0x16,
+	0xe6, 0x68, 0xa9, 0xd1, 0xc6, 0x21, 0x66, 0x3e, 0xb3, 0x7a, 0x94, 0x70, 0x02, 0x17, 0x95, 0xa9,
+	0x35, 0x62, 0x6a, 0x29, 0xd3, 0x85, 0x4b, 0x6d, 0xd2, 0x26, 0xc2, 0xae, 0x91, 0xfc, 0x92, 0x2e,
+	0x0b, 0x57, 0xdb, 0x84, 0xb4, 0xbb, 0xb8, 0x21, 0xbe, 0x5a, 0xd1, 0x76, 0x03, 0x85, 0xfb, 0xa9,
+	0xca, 0x15, 0x70, 0x8e, 0xf4, 0x91, 0x1f, 0x4a, 0x55, 0xcd, 0x7b, 0x79, 0x11, 0x45, 0xdc, 0x27,
+	0xa1, 0xd2, 0x1b, 0x79, 0x3d, 0xf7, 0x03, 0xcc, 0x38, 0x0a, 0x7a, 0x29, 0x80, 0x84, 0x6b, 0xb4,
+	0x10, 0xc3, 0x83, 0x64, 0x5c, 0xe2, 0xa7, 0x00, 0xd6, 0xb8, 0xa4, 0x03, 0xe2, 0x45, 0x5d, 0xec,
+	0x50, 0x12, 0x71, 0xac, 0xec, 0x6f, 0x8c, 0xb3, 0xe7, 0xcf, 0xa4, 0x95, 0xd9, 0x3f, 0x07, 0x8a,
+	0x1b, 0x88, 0xa2, 0x80, 0xc1, 0x97, 0x1a, 0x98, 0x4b, 0x6c, 0x1d, 0x97, 0x62, 0xc1, 0xdc, 0xd9,
+	0xc6, 0x58, 0xd7, 0x6a, 0x85, 0x7a, 0x69, 0xf9, 0xaa, 0xa5, 0x92, 0x4d, 0xd8, 0xa5, 0xf5, 0xb3,
+	0x56, 0x89, 0x1f, 0x36, 0x1f, 0xbe, 0x8a, 0x8d, 0x89, 0x7e, 0x6c, 0xe8, 0xfb, 0x28, 0xe8, 0xbe,
+	0x6f, 0x1e, 0x41, 0x30, 0x7f, 0x7e, 0x63, 0xd4, 0xdb, 0x3e, 0xdf, 0x89, 0x5a, 0x96, 0x4b, 0x02,
+	0x55, 0x35, 0xf5, 0xe7, 0x0e, 0xf3, 0x3a, 0x0d, 0xbe, 0xdf, 0xc3, 0x4c, 0x80, 0x31, 0xbb, 0x92,
+	0xf8, 0xaf, 0x2a, 0xf7, 0x75, 0x8c, 0xe1, 0x2e, 0x98, 0xe5, 0xa8, 0x83, 0x69, 0x02, 0xe5, 0xf4,
+	0x04, 0x53, 0xfd, 0x5c, 0x4d, 0xab, 0x97, 0x96, 0x6f, 0x59, 0x63, 0x7a, 0x6b, 0x6d, 0x25, 0x4e,
+	0xeb, 0x18, 0xcb, 0xe4, 0x9a, 0x86, 0x62, 0x79, 0x45, 0xb2, 0xcc, 0x43, 0x9a, 0x76, 0x99, 0x67,
+	0x1c, 0xe0, 0x53, 0x70, 0x05, 0x45, 0x7c, 0x87, 0x50, 0xff, 0x1b, 0xec, 0x39, 0x5f, 0x47, 0x84,
+	0x63, 0xc7, 0xc3, 0x21, 0x09, 0x98, 0x5e, 0xa8, 0x15, 0xea, 0xd3, 0x4d, 0xb3, 0x1f, 0x1b, 0x55,
+	0x89, 0x76, 0x82, 0xa1, 0x69, 0xcf, 0x0f, 0x35, 0x4f, 0x12, 0xc5, 0x9a, 0x94, 0x7f, 0x57, 0x04,
+	0x33, 0x1f, 0xcb, 0x63, 0xba, 0xc9, 0x11, 0xc7, 0xb0, 0x06, 0x66, 0x42, 0xfc, 0x8c, 0x3b, 0xa2,
+	0x78, 0xbe, 0xa7, 0x6b, 0x35, 0xad, 0x3e, 0x69, 0x83, 0x44, 0xb6, 0x41, 0x48, 0xf7, 0x81, 0x07,
+	0x57, 0x40, 0x31, 0x93, 0xfc, 0xf5, 0xb1, 0xc9, 0xab, 0xa4, 0x27, 0x93, 0xa4, 0x6d, 0xe5, 0x08,
+	0x1f, 0x83, 0x92, 0xc0, 0x17, 0x87, 0x44, 0x66, 0x51, 0x5a, 0xae, 0x8f, 0xc5, 0xf9, 0x54, 0x1c,
+	0x2b, 0x3b, 0x71, 0x50, 0x60, 0x20, 0x31, 0x13, 0x02, 0x06, 0xbf, 0x00, 0x70, 0x50, 0x47, 0xe6,
+	0x70, 0x8a, 0xdc, 0x0e, 0xa6, 0xfa, 0xa4, 0xe0, 0x77, 0xe7, 0x4c, 0xcd, 0x61, 0x5b, 0xd2, 0xc9,
+	0x9e, 0xe5, 0x39, 0x09, 0xfc, 0x04, 0xcc, 0x08, 0xb6, 0xbb, 0xa4, 0x1b, 0x05, 0x98, 0xe9, 0xe7,
+	0x05, 0xdd, 0xb7, 0xc7, 0xa7, 0x4d, 0x48, 0xf7, 0x33, 0x61, 0x6f, 0x97, 0x7a, 0x83, 0xdf, 0x0c,
+	0xf6, 0xc0, 0x82, 0xe8, 0x88, 0xd3, 0x43, 0x3e, 0x75, 0x86, 0xbd, 0x67, 0x9c, 0x50, 0xac, 0x17,
+	0x05, 0xb2, 0x35, 0x16, 0x59, 0x34, 0x6e, 0x03, 0xf9, 0x34, 0x65, 0xae, 0xca, 0x71, 0xd9, 0xcb,
+	0x2b, 0x36, 0x13, 0x4c, 0xf8, 0x15, 0xb8, 0x24, 0xd8, 0xe7, 0x63, 0x4d, 0x89, 0x58, 0x37, 0x4f,
+	0xcd, 0x22, 0x17, 0x66, 0xae, 0x37, 0x22, 0x93, 0x11, 0x5c, 0x30, 0x2f, 0x1a, 0x79, 0x24, 0xc4,
+	0x05, 0x11, 0xe2, 0x9d, 0xb1, 0x21, 0x44, 0x03, 0x73, 0x31, 0x20, 0x1d, 0x15, 0xa6, 0x69, 0x0c,
+	0x3b, 0xec, 0x50, 0xbc, 0x8b, 0xc3, 0x08, 0x33, 0x7d, 0x5a, 0x44, 0xb8, 0x7d, 0xa6, 0x0e, 0xdb,
+	0xd2, 0x49, 0xc5, 0x98, 0xe5, 0x59, 0x31, 0x33, 0x9f, 0x17, 0x41, 0x39, 0x3b, 0xaa, 0xb0, 0x05,
+	0xe6, 0x3c, 0xbc, 0x8d, 0xa2, 0x2e, 0x1f, 0xe6, 0x26, 0x26, 0x62, 0xba, 0x79, 0x2f, 0x41, 0xf9,
+	0x2d, 0x36, 0x16, 0xe5, 0xed, 0xc1, 0xbc, 0x8e, 0xe5, 0x93, 0x46, 0x80, 0xf8, 0x8e, 0xf5, 0x10,
+	0xb7, 0x91, 0xbb, 0xbf, 0x86, 0xdd, 0x83, 0xd8, 0xa8, 0xac, 0x49, 0xff, 0x01, 0x89, 0x8a, 0x97,
+	0x15, 0xc0, 0x1f, 0x35, 0x20, 0x5e, 0x86, 0x91, 0xea, 0x79, 0x3e, 0xe3, 0xd4, 0x6f, 0x45, 0xc9,
+	0xc5, 0xa3, 0x86, 0xec, 0x83, 0x33, 0xa5, 0xb8, 0x36, 0xe2, 0xb8, 0x81, 0xa9, 0x8b, 0x43, 0x8e,
+	0xda, 0xb8, 0x59, 0x4b, 0xb8, 0x1e, 0xc4, 0x86, 0xfe, 0x98, 0x05, 0xe4, 0x38, 0x5b, 0x5b, 0x27,
+	0x27, 0x68, 0xe0, 0x4f, 0x1a, 0x30, 0x42, 0x12, 0x3a, 0xe3, 0x28, 0x16, 0xfe, 0x39, 0xc5, 0xeb,
+	0x8a, 0xe2, 0xe2, 0x23, 0x12, 0x9e, 0xc8, 0x72, 0x31, 0x3c, 0x59, 0x09, 0x57, 0x41, 0x05, 0x79,
+	0x81, 0x1f, 0x3a, 0xc8, 0xf3, 0x28, 0x66, 0x0c, 0x33, 0x7d, 0x52, 0xdc, 0x8e, 0x0b, 0xfd, 0xd8,
+	0xb8, 0xac, 0x6e, 0xc7, 0xac, 0x81, 0x69, 0x97, 0x85, 0x64, 0x25, 0x15, 0xc0, 0x5f, 0x34, 0x70,
+	0xcf, 0x25, 0x41, 0x10, 0x85, 0x3e, 0xdf, 0x97, 0x77, 0xa0, 0x1c, 0x57, 0x4e, 0x1c, 0xb6, 0x87,
+	0x7a, 0x4e, 0x52, 0x8a, 0xbd, 0x1d, 0x9f, 0xe3, 0xae, 0xcf, 0x38, 0xf6, 0x1c, 0xc4, 0x18, 0xe6,
+	0xcc, 0xe1, 0x44, 0x3f, 0x2f, 0x8e, 0xc5, 0x4a, 0x3f, 0x36, 0xee, 0xcb, 0x60, 0x7f, 0x0f, 0xc7,
+	0xb4, 0xad, 0x81, 0x63, 0x32, 0x7e, 0x62, 0xdc, 0xb7, 0xc8, 0xe6, 0x1e, 0xea, 0x3d, 0x22, 0xe1,
+	0xe7, 0x43, 0x97, 0x15, 0xe1, 0xb1, 0x45, 0xe0, 0x16, 0x98, 0xa7, 0xd8, 0x8b, 0x5c, 0xec, 0x89,
+	0xce, 0x0c, 0x50, 0xc5, 0x6d, 0x32, 0xdd, 0xac, 0xf5, 0x63, 0xe3, 0x9a, 0x64, 0x74, 0xac, 0x99,
+	0x69, 0xff, 0x4f, 0xc9, 0xd7, 0x31, 0x1e, 0xe0, 0x9b, 0x7f, 0x68, 0xa0, 0x3a, 0xbe, 0x67, 0x70,
+	0x1b, 0x54, 0x18, 0x47, 0x1d, 0x3f, 0x6c, 0x3b, 0x14, 0xef, 0x21, 0xea, 0x31, 0x35, 0x1b, 0xf7,
+	0xcf, 0x30, 0x1b, 0xc3, 0xa6, 0xe4, 0x30, 0x4c, 0xbb, 0xac, 0x24, 0xb6, 0x14, 0x40, 0x17, 0x94,
+	0xb3, 0xb5, 0x14, 0x33, 0x31, 0xdd, 0xfc, 0xf0, 0x6c, 0x61, 0xe6, 0x8f, 0x6b, 0x87, 0x69, 0x5f,
+	0xcc, 0x94, 0xd9, 0xfc, 0xbe, 0x00, 0x66, 0xf3, 0x6f, 0x01, 0xfc, 0x16, 0xcc, 0x8f, 0x3e, 0x2b,
+	0xc4, 0x61, 0xe2, 0x93, 0x9d, 0xbe, 0x8a, 0xdc, 0x4d, 0xb8, 0xfd, 0xa5, 0x75, 0x03, 0x0e, 0xdf,
+	0x1d, 0xb2, 0x29, 0xc3, 0xc0, 0xe7, 0x1a, 0xb8, 0x96, 0x25, 0x70, 0xa4, 0x10, 0xff, 0x3a, 0x0f,
+	0x7d, 0x84, 0xc7, 0xea, 0x68, 0x89, 0x60, 0x07, 0xfc, 0x7f, 0x07, 0xfb, 0xed, 0x1d, 0xee, 0x20,
+	0xd7, 0x25, 0x51, 0xc8, 0x93, 0xae, 0x31, 0x8e, 0x28, 0x67, 0xce, 0x36, 0x25, 0x81, 0xb8, 0x07,
+	0x0a, 0xcd, 0x7a, 0x3f, 0x36, 0x6e, 0xc8, 0x9a, 0x8f, 0x35, 0x37, 0xed, 0x05, 0xa9, 0x5f, 0x19,
+	0xa8, 0x37, 0x85, 0x76, 0x3d, 0x51, 0xfe, 0x30, 0x09, 0x2a, 0xb9, 0x9b, 0x1b, 0xde, 0x02, 0x53,
+	0x99, 0xb5, 0xa4, 0x09, 0xfb, 0xb1, 0x51, 0x1e, 0x59, 0xf6, 0x7c, 0xcf, 0xb4, 0x8b, 0x3d, 0xb9,
+	0xa6, 0xdc, 0x04, 0x45, 0x31, 0x72, 0x77, 0xd5, 0x69, 0x99, 0xeb, 0xc7, 0xc6, 0x45, 0x69, 0x2b,
+	0xe5, 0xa6, 0xad, 0x0c, 0x06, 0xa6, 0x4b, 0x7a, 0xe1, 0x58, 0xd3, 0xa5, 0xd4, 0x74, 0x09, 0xbe,
+	0x05, 0xce, 0x8b, 0x5f, 0x62, 0xb7, 0x98, 0x6e, 0xce, 0xf6, 0x63, 0x63, 0x66, 0xc4, 0xd2, 0xb4,
+	0xa5, 0x1a, 0x76, 0xc1, 0x5c, 0x2b, 0x72, 0x3b, 0x98, 0xcb, 0x8c, 0x9d, 0x64, 0xc5, 0x16, 0x57,
+	0x44, 0x69, 0x79, 0xc1, 0x92, 0xfb, 0xb7, 0x95, 0xee, 0xdf, 0xd6, 0x56, 0xba, 0x7f, 0x37, 0x6f,
+	0x64, 0x37, 0xd8, 0x23, 0x10, 0xe6, 0x8b, 0x37, 0x86, 0x66, 0x57, 0xa4, 0x5c, 0x54, 0x2b, 0xf1,
+	0x85, 0x5f, 0x1e, 0x99, 0x90, 0x62, 0xe6, 0x91, 0x9a, 0x3f, 0x3a, 0x21, 0x0f, 0x42, 0x7e, 0xd6,
+	0xd9, 0x80, 0x0f, 0xc0, 0x54, 0x7a, 0xf0, 0xa7, 0x04, 0x6e, 0xe3, 0x34, 0xdc, 0xf2, 0x70, 0xb4,
+	0x31, 0x65, 0xa6, 0x9d, 0xfa, 0xc3, 0x8f, 0xc0, 0x64, 0x2b, 0xa2, 0xa1, 0x7e, 0x41, 0xe0, 0xdc,
+	0x3e, 0x0d, 0xa7, 0x94, 0xd6, 0x81, 0x86, 0xa6, 0x2d, 0x3c, 0xcd, 0x97, 0x1a, 0x00, 0xc3, 0xed,
+	0x0a, 0x5e, 0xc9, 0x9d, 0x89, 0x41, 0xff, 0xbb, 0xa0, 0x34, 0xb2, 0xb5, 0xfd, 0x17, 0x93, 0x02,
+	0x86, 0x8b, 0x5d, 0xf3, 0xc9, 0xab, 0x83, 0xaa, 0xf6, 0xfa, 0xa0, 0xaa, 0xfd, 0x7e, 0x50, 0xd5,
+	0x5e, 0x1c, 0x56, 0x27, 0x5e, 0x1f, 0x56, 0x27, 0x7e, 0x3d, 0xac, 0x4e, 0x3c, 0x7d, 0x6f, 0x04,
+	0x4f, 0x3d, 0x90, 0x77, 0xba, 0xa8, 0xc5, 0xd2, 0x8f, 0xc6, 0xee, 0xf2, 0x52, 0xe3, 0x59, 0xe6,
+	0x5f, 0x23, 0x11, 0xa4, 0x55, 0x14, 0xe7, 0xe3, 0xdd, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe3,
+	0x19, 0x86, 0xa6, 0x63, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +751,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TakerFeeRevenues) > 0 {
+		for iNdEx := len(m.TakerFeeRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RouteTakerFeeStore) > 0 {
+		for iNdEx := len(m.RouteTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteTakerFeeStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for iNdEx := len(m.PoolTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFeeStore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -863,6 +1042,93 @@ func (m *TakerFeesTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Stakers.Size()
+		i -= size
+		if _, err := m.Stakers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BucketStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BucketStartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolVolume) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -972,6 +1238,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolTakerFeeStore) > 0 {
+		for _, e := range m.PoolTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RouteTakerFeeStore) > 0 {
+		for _, e := range m.RouteTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeeRevenues) > 0 {
+		for _, e := range m.TakerFeeRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1043,6 +1327,38 @@ func (m *TakerFeesTracker) Size() (n int) {
 	return n
 }
 
+func (m *TakerFeeRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BucketStartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Stakers.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *PoolVolume) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1435,61 +1751,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFeeStore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1499,29 +1765,29 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTakerFeeStore = append(m.PoolTakerFeeStore, PoolTakerFee{})
+			if err := m.PoolTakerFeeStore[len(m.PoolTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoTakerFeeDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteTakerFeeStore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1548,13 +1814,14 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RouteTakerFeeStore = append(m.RouteTakerFeeStore, RouteTakerFee{})
+			if err := m.RouteTakerFeeStore[len(m.RouteTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonOsmoTakerFeeDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRevenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1581,13 +1848,164 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NonOsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeeRevenues = append(m.TakerFeeRevenues, TakerFeeRevenue{})
+			if err := m.TakerFeeRevenues[len(m.TakerFeeRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoTakerFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonOsmoTakerFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NonOsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1957,6 +2375,306 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TakerFeeRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BucketStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stakers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,14 +9,17 @@ import (
 
 const (
 	ProposalTypeDenomPairTakerFee = "DenomPairTakerFee"
+	ProposalTypeTakerFeeOverride  = "TakerFeeOverride"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeDenomPairTakerFee)
+	govtypesv1.RegisterProposalType(ProposalTypeTakerFeeOverride)
 }
 
 var (
 	_ govtypesv1.Content = &DenomPairTakerFeeProposal{}
+	_ govtypesv1.Content = &TakerFeeOverrideProposal{}
 )
 
 // NewDenomPairTakerFeeProposal returns a new instance of a denom pair taker fee proposal struct.
@@ -66,3 +69,62 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+// NewTakerFeeOverrideProposal returns a new instance of a taker fee override proposal struct.
+func NewTakerFeeOverrideProposal(title, description string, poolRecords []PoolTakerFee, routeRecords []RouteTakerFee) govtypesv1.Content {
+	return &TakerFeeOverrideProposal{
+		Title:          title,
+		Description:    description,
+		PoolTakerFees:  poolRecords,
+		RouteTakerFees: routeRecords,
+	}
+}
+
+func (p *TakerFeeOverrideProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *TakerFeeOverrideProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *TakerFeeOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *TakerFeeOverrideProposal) ProposalType() string {
+	return ProposalTypeTakerFeeOverride
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *TakerFeeOverrideProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.PoolTakerFees) == 0 && len(p.RouteTakerFees) == 0 {
+		return fmt.Errorf("Empty taker fee overrides")
+	}
+
+	if err := validatePoolTakerFees(p.PoolTakerFees); err != nil {
+		return err
+	}
+	return validateRouteTakerFees(p.RouteTakerFees)
+}
+
+// String returns a string containing the taker fee override proposal.
+func (p TakerFeeOverrideProposal) String() string {
+	recordsStr := ""
+	for _, record := range p.PoolTakerFees {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolId: %d, TakerFee: %s, Remove: %t) ", record.PoolId, record.TakerFee.String(), record.Remove)
+	}
+	for _, record := range p.RouteTakerFees {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolIds: %v, TakerFee: %s, Remove: %t) ", record.PoolIds, record.TakerFee.String(), record.Remove)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Taker Fee Override Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
@@ -63,8 +63,50 @@ func (m *DenomPairTakerFeeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DenomPairTakerFeeProposal proto.InternalMessageInfo
 
+// TakerFeeOverrideProposal is a type for adding/removing taker fee overrides
+// for one or more pools and multihop routes.
+type TakerFeeOverrideProposal struct {
+	Title          string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolTakerFees  []PoolTakerFee  `protobuf:"bytes,3,rep,name=pool_taker_fees,json=poolTakerFees,proto3" json:"pool_taker_fees"`
+	RouteTakerFees []RouteTakerFee `protobuf:"bytes,4,rep,name=route_taker_fees,json=routeTakerFees,proto3" json:"route_taker_fees"`
+}
+
+func (m *TakerFeeOverrideProposal) Reset()      { *m = TakerFeeOverrideProposal{} }
+func (*TakerFeeOverrideProposal) ProtoMessage() {}
+func (*TakerFeeOverrideProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95b3c1cda2a8632, []int{1}
+}
+func (m *TakerFeeOverrideProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeOverrideProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeOverrideProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeOverrideProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeOverrideProposal.Merge(m, src)
+}
+func (m *TakerFeeOverrideProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeOverrideProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeOverrideProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeOverrideProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DenomPairTakerFeeProposal)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFeeProposal")
+	proto.RegisterType((*TakerFeeOverrideProposal)(nil), "osmosis.poolmanager.v1beta1.TakerFeeOverrideProposal")
 }
 
 func init() {
@@ -72,25 +114,30 @@ func init() {
 }
 
 var fileDescriptor_c95b3c1cda2a8632 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x86, 0x2a, 0xd3, 0x43, 0x52, 0xa6, 0x07, 0x55, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xa9, 0xe0, 0x33, 0xb9, 0xa4, 0x02, 0xa2,
-	0x4a, 0xe9, 0x08, 0x23, 0x97, 0xa4, 0x4b, 0x6a, 0x5e, 0x7e, 0x6e, 0x40, 0x62, 0x66, 0x51, 0x48,
-	0x62, 0x76, 0x6a, 0x91, 0x5b, 0x6a, 0x6a, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0x62, 0x8e, 0x90,
-	0x08, 0x17, 0x6b, 0x49, 0x66, 0x49, 0x4e, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x84,
-	0x23, 0xa4, 0xc0, 0xc5, 0x9d, 0x92, 0x5a, 0x9c, 0x5c, 0x94, 0x59, 0x50, 0x92, 0x99, 0x9f, 0x27,
-	0xc1, 0x04, 0x96, 0x43, 0x16, 0x12, 0x4a, 0xe5, 0x12, 0x49, 0x01, 0x19, 0x1a, 0x5f, 0x90, 0x98,
-	0x59, 0x14, 0x5f, 0x02, 0x32, 0x36, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb,
-	0x48, 0x4f, 0x0f, 0x8f, 0x6f, 0xf4, 0x30, 0x5c, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90,
-	0x60, 0x0a, 0xba, 0x84, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x02,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6a, 0xad, 0x6e, 0x4e, 0x62, 0x52, 0x31, 0x8c, 0xa3,
-	0x5f, 0x66, 0x64, 0xa8, 0x5f, 0x81, 0x12, 0x48, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x00, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x12, 0xb5, 0x3f, 0xa2, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x93, 0xb6, 0xdf, 0x87, 0x4e, 0xf1, 0x5f, 0xe8, 0x22, 0x56, 0x48, 0x4b, 0x51, 0xa8,
+	0x82, 0x09, 0xad, 0x0b, 0xc1, 0x65, 0x11, 0xb7, 0xd6, 0x22, 0x08, 0xdd, 0x94, 0x49, 0x73, 0x8d,
+	0x83, 0x49, 0x6f, 0x98, 0x99, 0x86, 0xfa, 0x06, 0x2e, 0x75, 0xe7, 0xb2, 0x0f, 0xe3, 0xa2, 0xcb,
+	0x2e, 0x5d, 0x89, 0xb4, 0x2f, 0x22, 0x49, 0x53, 0x1a, 0x2b, 0x64, 0xe3, 0x2e, 0x73, 0xe6, 0xdc,
+	0x5f, 0xce, 0x19, 0x2e, 0x39, 0x42, 0xe1, 0xa3, 0x60, 0xc2, 0x0a, 0x10, 0x3d, 0x9f, 0x0e, 0xa8,
+	0x0b, 0xdc, 0x0a, 0x1b, 0x36, 0x48, 0xda, 0xb0, 0x5c, 0x0c, 0xcd, 0x80, 0xa3, 0x44, 0xed, 0x20,
+	0xb1, 0x99, 0x29, 0x9b, 0x99, 0xd8, 0xca, 0x25, 0x17, 0x5d, 0x8c, 0x7d, 0x56, 0xf4, 0xb5, 0x18,
+	0x29, 0x1f, 0x66, 0x91, 0xe5, 0x68, 0xe1, 0xaa, 0xbd, 0xab, 0x64, 0xff, 0x12, 0x06, 0xe8, 0xb7,
+	0x29, 0xe3, 0xb7, 0xf4, 0x11, 0xf8, 0x15, 0x40, 0x9b, 0x63, 0x80, 0x82, 0x7a, 0x5a, 0x89, 0xfc,
+	0x93, 0x4c, 0x7a, 0xa0, 0xab, 0x55, 0xb5, 0xbe, 0xd9, 0x59, 0x1c, 0xb4, 0x2a, 0x29, 0x3a, 0x20,
+	0xfa, 0x9c, 0x05, 0x92, 0xe1, 0x40, 0xcf, 0xc5, 0x77, 0x69, 0x49, 0x03, 0x52, 0x72, 0x22, 0x68,
+	0x2f, 0xa0, 0x8c, 0xf7, 0x64, 0x84, 0xed, 0xdd, 0x03, 0xe8, 0xf9, 0x6a, 0xbe, 0x5e, 0x6c, 0x9a,
+	0x66, 0x46, 0x1b, 0xf3, 0x57, 0x9a, 0x56, 0x61, 0xf2, 0x59, 0x51, 0x3a, 0x7b, 0xce, 0xfa, 0xc5,
+	0xc5, 0xc6, 0xf3, 0xb8, 0xa2, 0xbc, 0x8d, 0x2b, 0x4a, 0xed, 0x35, 0x47, 0xf4, 0xa5, 0x7c, 0x1d,
+	0x02, 0xe7, 0xcc, 0xf9, 0x7b, 0x8b, 0x3b, 0xb2, 0x13, 0x05, 0x5c, 0xe5, 0x17, 0x49, 0x81, 0xe3,
+	0xcc, 0x02, 0x6d, 0x44, 0x6f, 0x2d, 0xfb, 0x56, 0x90, 0xd2, 0x84, 0xd6, 0x25, 0xbb, 0x1c, 0x87,
+	0x12, 0xd2, 0xe4, 0x42, 0x4c, 0x3e, 0xc9, 0x24, 0x77, 0xa2, 0xa1, 0x35, 0xf4, 0x36, 0x4f, 0x8b,
+	0x62, 0xf5, 0x26, 0xad, 0x9b, 0xc9, 0xcc, 0x50, 0xa7, 0x33, 0x43, 0xfd, 0x9a, 0x19, 0xea, 0xcb,
+	0xdc, 0x50, 0xa6, 0x73, 0x43, 0xf9, 0x98, 0x1b, 0x4a, 0xf7, 0xdc, 0x65, 0xf2, 0x61, 0x68, 0x9b,
+	0x7d, 0xf4, 0xad, 0xe4, 0x7f, 0xa7, 0x1e, 0xb5, 0xc5, 0xf2, 0x60, 0x85, 0xcd, 0x86, 0x35, 0xfa,
+	0xb1, 0x38, 0xf2, 0x29, 0x00, 0x61, 0xff, 0x8f, 0x97, 0xe6, 0xec, 0x3b, 0x00, 0x00, 0xff, 0xff,
+	0x4a, 0x89, 0x73, 0xa6, 0xb6, 0x02, 0x00, 0x00,
 }
 
 func (m *DenomPairTakerFeeProposal) Marshal() (dAtA []byte, err error) {
@@ -144,6 +191,71 @@ func (m *DenomPairTakerFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TakerFeeOverrideProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeOverrideProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeOverrideProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RouteTakerFees) > 0 {
+		for iNdEx := len(m.RouteTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolTakerFees) > 0 {
+		for iNdEx := len(m.PoolTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -178,6 +290,35 @@ func (m *DenomPairTakerFeeProposal) Size() (n int) {
 	return n
 }
 
+func (m *TakerFeeOverrideProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolTakerFees) > 0 {
+		for _, e := range m.PoolTakerFees {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.RouteTakerFees) > 0 {
+		for _, e := range m.RouteTakerFees {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -332,6 +473,188 @@ func (m *DenomPairTakerFeeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TakerFeeOverrideProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeOverrideProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeOverrideProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTakerFees = append(m.PoolTakerFees, PoolTakerFee{})
+			if err := m.PoolTakerFees[len(m.PoolTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteTakerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteTakerFees = append(m.RouteTakerFees, RouteTakerFee{})
+			if err := m.RouteTakerFees[len(m.RouteTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -144,3 +144,83 @@ func TestDenomPairTakerFeeProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestTakerFeeOverrideProposal_ValidateBasic(t *testing.T) {
+	basePoolRecord := types.PoolTakerFee{
+		PoolId:   1,
+		TakerFee: osmomath.MustNewDecFromStr("0.0005"),
+	}
+	baseRouteRecord := types.RouteTakerFee{
+		TokenOutDenom: "uosmo",
+		TakerFee:      osmomath.MustNewDecFromStr("0.001"),
+	}
+
+	tests := []struct {
+		name         string
+		poolRecords  []types.PoolTakerFee
+		routeRecords []types.RouteTakerFee
+		expectPass   bool
+	}{
+		{
+			name:         "proper records",
+			poolRecords:  []types.PoolTakerFee{basePoolRecord},
+			routeRecords: []types.RouteTakerFee{baseRouteRecord},
+			expectPass:   true,
+		},
+		{
+			name:        "only pool records",
+			poolRecords: []types.PoolTakerFee{basePoolRecord},
+			expectPass:  true,
+		},
+		{
+			name:         "removed records without taker fee",
+			poolRecords:  []types.PoolTakerFee{{PoolId: 1, Remove: true}},
+			routeRecords: []types.RouteTakerFee{{TokenOutDenom: "uosmo", Remove: true}},
+			expectPass:   true,
+		},
+		{
+			name:       "no records",
+			expectPass: false,
+		},
+		{
+			name:        "zero pool id",
+			poolRecords: []types.PoolTakerFee{{PoolId: 0, TakerFee: basePoolRecord.TakerFee}},
+			expectPass:  false,
+		},
+		{
+			name:        "duplicate pool",
+			poolRecords: []types.PoolTakerFee{basePoolRecord, {PoolId: 1, Remove: true}},
+			expectPass:  false,
+		},
+		{
+			name:        "pool taker fee of one",
+			poolRecords: []types.PoolTakerFee{{PoolId: 1, TakerFee: osmomath.OneDec()}},
+			expectPass:  false,
+		},
+		{
+			name:         "invalid token out denom",
+			routeRecords: []types.RouteTakerFee{{TokenOutDenom: "", TakerFee: baseRouteRecord.TakerFee}},
+			expectPass:   false,
+		},
+		{
+			name:         "duplicate route",
+			routeRecords: []types.RouteTakerFee{baseRouteRecord, baseRouteRecord},
+			expectPass:   false,
+		},
+		{
+			name:         "negative route taker fee",
+			routeRecords: []types.RouteTakerFee{{TokenOutDenom: "uosmo", TakerFee: osmomath.NewDec(-1)}},
+			expectPass:   false,
+		},
+	}
+
+	for _, test := range tests {
+		proposal := types.NewTakerFeeOverrideProposal("title", "description", test.poolRecords, test.routeRecords)
+
+		if test.expectPass {
+			require.NoError(t, proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
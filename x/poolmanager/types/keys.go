@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -40,6 +41,32 @@ var (
 
 	// KeyTakerFeeProtoRevAccountingHeight defines key to store the accounting height for the above taker fee trackers.
 	KeyTakerFeeProtoRevAccountingHeight = []byte{0x07}
+
+	// PoolTakerFeePrefix defines prefix to store the taker fee overrides of pools.
+	PoolTakerFeePrefix = []byte{0x08}
+
+	// RouteTakerFeePrefix defines prefix to store the taker fee overrides of multihop routes by their token out denom.
+	RouteTakerFeePrefix = []byte{0x09}
+
+	// TakerFeeRevenuePrefix defines prefix to store the taker fee revenue of pools per time bucket.
+	TakerFeeRevenuePrefix = []byte{0x0A}
+
+	// TakerFeeRevenueByPoolPrefix defines prefix to index the taker fee revenue keys by pool.
+	TakerFeeRevenueByPoolPrefix = []byte{0x0B}
+
+	// TakerFeeRevenueByDenomPairPrefix defines prefix to index the taker fee revenue keys by denom pair.
+	TakerFeeRevenueByDenomPairPrefix = []byte{0x0C}
+)
+
+const (
+	// TakerFeeRevenueBucketDuration is the duration of the time buckets the taker fee revenue is accumulated in.
+	TakerFeeRevenueBucketDuration = 24 * time.Hour
+
+	// TakerFeeRevenueRetentionPeriod is how long the taker fee revenue time buckets are kept before being pruned.
+	TakerFeeRevenueRetentionPeriod = 90 * 24 * time.Hour
+
+	// MaxTakerFeeRevenuesPrunedPerBlock bounds the number of expired taker fee revenues deleted at the end of a block.
+	MaxTakerFeeRevenuesPrunedPerBlock = 1000
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -64,6 +91,69 @@ func FormatDenomTradePairKey(denom0, denom1 string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s%s%s", DenomTradePairPrefix, KeySeparator, denoms[0], KeySeparator, denoms[1]))
 }
 
+// FormatPoolTakerFeeKey returns the key for the taker fee override of the given pool.
+func FormatPoolTakerFeeKey(poolId uint64) []byte {
+	return append(PoolTakerFeePrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// FormatRouteTakerFeeKey returns the key for the taker fee override of the multihop routes
+// ending in the given token out denom.
+func FormatRouteTakerFeeKey(tokenOutDenom string) []byte {
+	key := make([]byte, 0, len(RouteTakerFeePrefix)+len(tokenOutDenom))
+	key = append(key, RouteTakerFeePrefix...)
+	return append(key, tokenOutDenom...)
+}
+
+// FormatTakerFeeRevenueBucketPrefix returns the prefix of the taker fee revenue keys of the given time bucket.
+func FormatTakerFeeRevenueBucketPrefix(bucketStartTime time.Time) []byte {
+	key := make([]byte, 0, len(TakerFeeRevenuePrefix)+8)
+	key = append(key, TakerFeeRevenuePrefix...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(bucketStartTime.Unix()))...)
+}
+
+// FormatTakerFeeRevenueKey returns the key for the taker fee revenue of the given pool and denom pair
+// charged in the given denom during the given time bucket.
+// The denom pair must be sorted.
+func FormatTakerFeeRevenueKey(bucketStartTime time.Time, poolId uint64, denom0, denom1, denom string) []byte {
+	key := FormatTakerFeeRevenueBucketPrefix(bucketStartTime)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return append(key, denom0+KeySeparator+denom1+KeySeparator+denom...)
+}
+
+// FormatTakerFeeRevenueByPoolPrefix returns the prefix of the taker fee revenue index keys of the given pool.
+func FormatTakerFeeRevenueByPoolPrefix(poolId uint64) []byte {
+	key := make([]byte, 0, len(TakerFeeRevenueByPoolPrefix)+8)
+	key = append(key, TakerFeeRevenueByPoolPrefix...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// FormatTakerFeeRevenueByPoolKey returns the key indexing the taker fee revenue of the given pool and denom pair
+// charged in the given denom during the given time bucket by pool, then by time bucket.
+// The denom pair must be sorted.
+func FormatTakerFeeRevenueByPoolKey(bucketStartTime time.Time, poolId uint64, denom0, denom1, denom string) []byte {
+	key := FormatTakerFeeRevenueByPoolPrefix(poolId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(bucketStartTime.Unix()))...)
+	return append(key, denom0+KeySeparator+denom1+KeySeparator+denom...)
+}
+
+// FormatTakerFeeRevenueByDenomPairPrefix returns the prefix of the taker fee revenue index keys of the given denom pair.
+// The denom pair must be sorted.
+func FormatTakerFeeRevenueByDenomPairPrefix(denom0, denom1 string) []byte {
+	key := make([]byte, 0, len(TakerFeeRevenueByDenomPairPrefix)+len(denom0)+len(denom1)+2*len(KeySeparator))
+	key = append(key, TakerFeeRevenueByDenomPairPrefix...)
+	return append(key, denom0+KeySeparator+denom1+KeySeparator...)
+}
+
+// FormatTakerFeeRevenueByDenomPairKey returns the key indexing the taker fee revenue of the given pool and denom pair
+// charged in the given denom during the given time bucket by denom pair, then by time bucket.
+// The denom pair must be sorted.
+func FormatTakerFeeRevenueByDenomPairKey(bucketStartTime time.Time, poolId uint64, denom0, denom1, denom string) []byte {
+	key := FormatTakerFeeRevenueByDenomPairPrefix(denom0, denom1)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(bucketStartTime.Unix()))...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return append(key, denom...)
+}
+
 // ParseModuleRouteFromBz parses the raw bytes into ModuleRoute.
 // Returns error if fails to parse or if the bytes are empty.
 func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
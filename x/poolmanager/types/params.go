@@ -249,3 +249,50 @@ func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	}
 	return nil
 }
+
+func validatePoolTakerFees(records []PoolTakerFee) error {
+	seenPoolIds := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if record.PoolId == 0 {
+			return fmt.Errorf("pool id cannot be 0")
+		}
+		if seenPoolIds[record.PoolId] {
+			return fmt.Errorf("duplicate taker fee override for pool %d", record.PoolId)
+		}
+		seenPoolIds[record.PoolId] = true
+
+		if err := validateTakerFeeOverride(record.TakerFee, record.Remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRouteTakerFees(records []RouteTakerFee) error {
+	seenTokenOutDenoms := make(map[string]bool, len(records))
+	for _, record := range records {
+		if err := sdk.ValidateDenom(record.TokenOutDenom); err != nil {
+			return fmt.Errorf("token out denom is invalid: %s", err)
+		}
+		if seenTokenOutDenoms[record.TokenOutDenom] {
+			return fmt.Errorf("duplicate taker fee override for routes ending in %s", record.TokenOutDenom)
+		}
+		seenTokenOutDenoms[record.TokenOutDenom] = true
+
+		if err := validateTakerFeeOverride(record.TakerFee, record.Remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTakerFeeOverride validates the taker fee of an override, unless the override is being removed.
+func validateTakerFeeOverride(takerFee osmomath.Dec, remove bool) error {
+	if remove {
+		return nil
+	}
+	if takerFee.IsNil() || takerFee.IsNegative() || takerFee.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("taker fee must be between 0 and 1: %s", takerFee.String())
+	}
+	return nil
+}
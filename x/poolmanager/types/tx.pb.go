@@ -583,6 +583,124 @@ func (m *DenomPairTakerFee) GetDenom1() string {
 	return ""
 }
 
+// PoolTakerFee is a taker fee override for all swaps against a pool,
+// which takes precedence over the taker fee of the denom pair being swapped.
+type PoolTakerFee struct {
+	PoolId   uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TakerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+	// remove deletes the override instead of setting it when the record
+	// is part of a proposal. The taker fee is ignored in that case.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty" yaml:"remove"`
+}
+
+func (m *PoolTakerFee) Reset()         { *m = PoolTakerFee{} }
+func (m *PoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*PoolTakerFee) ProtoMessage()    {}
+func (*PoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *PoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTakerFee.Merge(m, src)
+}
+func (m *PoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTakerFee proto.InternalMessageInfo
+
+func (m *PoolTakerFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolTakerFee) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+// RouteTakerFee is a taker fee override for every hop of a multihop swap
+// whose route ends in the given token out denom, e.g. routes that land on
+// OSMO. The taker fee override of the pool being swapped takes precedence
+// over it, so that a discount set for a specific pool also applies when the
+// pool is a hop of such a route. It takes precedence over the taker fee of
+// the denom pair being swapped.
+type RouteTakerFee struct {
+	TokenOutDenom string                      `protobuf:"bytes,1,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TakerFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee" yaml:"taker_fee"`
+	// remove deletes the override instead of setting it when the record
+	// is part of a proposal. The taker fee is ignored in that case.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty" yaml:"remove"`
+}
+
+func (m *RouteTakerFee) Reset()         { *m = RouteTakerFee{} }
+func (m *RouteTakerFee) String() string { return proto.CompactTextString(m) }
+func (*RouteTakerFee) ProtoMessage()    {}
+func (*RouteTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{12}
+}
+func (m *RouteTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteTakerFee.Merge(m, src)
+}
+func (m *RouteTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteTakerFee proto.InternalMessageInfo
+
+func (m *RouteTakerFee) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *RouteTakerFee) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
@@ -595,6 +713,8 @@ func init() {
 	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
 	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
 	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*PoolTakerFee)(nil), "osmosis.poolmanager.v1beta1.PoolTakerFee")
+	proto.RegisterType((*RouteTakerFee)(nil), "osmosis.poolmanager.v1beta1.RouteTakerFee")
 }
 
 func init() {
@@ -602,69 +722,74 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x48, 0x93, 0x29, 0x6d, 0xe3, 0xc5, 0x21, 0xae, 0x53, 0xec, 0x68, 0x5b,
-	0x81, 0x83, 0xd8, 0x5d, 0xec, 0x56, 0x2a, 0x38, 0x91, 0x10, 0x6e, 0x40, 0x8a, 0x54, 0xcb, 0xed,
-	0xd2, 0x13, 0x97, 0xd5, 0xd8, 0x19, 0xdc, 0x25, 0xd9, 0x1d, 0xcb, 0x33, 0xdb, 0x3a, 0x37, 0x40,
-	0x3d, 0x45, 0x1c, 0xf8, 0x06, 0x48, 0x7c, 0x02, 0xbe, 0x01, 0xd7, 0x1e, 0x7b, 0x44, 0x1c, 0x2c,
-	0x94, 0x20, 0x71, 0xcf, 0x09, 0x09, 0x04, 0x68, 0x5e, 0x76, 0x6d, 0xaf, 0x37, 0x7e, 0x49, 0xd4,
-	0x5c, 0x22, 0xef, 0xec, 0xf3, 0xfa, 0x7f, 0x7e, 0xfb, 0x68, 0x02, 0xef, 0x10, 0xea, 0x11, 0xea,
-	0x52, 0xab, 0x4d, 0xc8, 0x81, 0x87, 0x7c, 0xd4, 0xc2, 0x1d, 0xeb, 0x59, 0xa9, 0x81, 0x19, 0x2a,
-	0x59, 0xac, 0x6b, 0xb6, 0x3b, 0x84, 0x11, 0x6d, 0x5d, 0x59, 0x99, 0x03, 0x56, 0xa6, 0xb2, 0xca,
-	0x65, 0x5a, 0xa4, 0x45, 0x84, 0x9d, 0xc5, 0x7f, 0x49, 0x97, 0x5c, 0x1a, 0x79, 0xae, 0x4f, 0x2c,
-	0xf1, 0x57, 0x1d, 0xe5, 0x9b, 0x22, 0x8c, 0xd5, 0x40, 0x14, 0x47, 0x39, 0x9a, 0xc4, 0xf5, 0xd5,
-	0xfb, 0x0f, 0xc6, 0xd5, 0x42, 0x9f, 0xa3, 0xb6, 0xd3, 0x21, 0x01, 0xc3, 0xd2, 0x5a, 0xff, 0x27,
-	0x05, 0x33, 0x35, 0xda, 0xfa, 0xe2, 0x39, 0x6a, 0x7f, 0xd6, 0x45, 0x4d, 0xf6, 0xa9, 0x47, 0x02,
-	0x9f, 0xed, 0xfa, 0xda, 0x26, 0x5c, 0xa4, 0xd8, 0xdf, 0xc3, 0x9d, 0x2c, 0xd8, 0x00, 0xc5, 0xe5,
-	0x6a, 0xfa, 0xb4, 0x57, 0xb8, 0x76, 0x88, 0xbc, 0x83, 0x8a, 0x2e, 0xcf, 0x75, 0x5b, 0x19, 0x68,
-	0x0f, 0xe1, 0xa2, 0x08, 0x49, 0xb3, 0xa9, 0x8d, 0xf9, 0xe2, 0xd5, 0xb2, 0x69, 0x8e, 0x69, 0xd4,
-	0xe4, 0xa9, 0xc2, 0x2c, 0x36, 0x77, 0xab, 0x2e, 0xbc, 0xec, 0x15, 0xe6, 0x6c, 0x15, 0x43, 0xab,
-	0xc1, 0x25, 0x46, 0xf6, 0xb1, 0xef, 0xb8, 0x7e, 0x76, 0x7e, 0x03, 0x14, 0xaf, 0x96, 0x6f, 0x9a,
-	0xb2, 0x65, 0x93, 0xb7, 0x1c, 0xc5, 0x79, 0x40, 0x5c, 0xbf, 0xba, 0xc6, 0x5d, 0x4f, 0x7b, 0x85,
-	0x1b, 0xb2, 0xb2, 0xd0, 0x51, 0xb7, 0xaf, 0x88, 0x9f, 0xbb, 0xbe, 0xe6, 0xc1, 0x8c, 0x3c, 0x25,
-	0x01, 0x73, 0x3c, 0xd7, 0x77, 0x90, 0xc8, 0x9d, 0x5d, 0x10, 0x5d, 0x6d, 0x73, 0xff, 0xdf, 0x7a,
-	0x85, 0x55, 0x99, 0x81, 0xee, 0xed, 0x9b, 0x2e, 0xb1, 0x3c, 0xc4, 0x9e, 0x9a, 0xbb, 0x3e, 0x3b,
-	0xed, 0x15, 0xd6, 0x07, 0x03, 0x0f, 0x87, 0xd0, 0xed, 0xb4, 0x38, 0xae, 0x07, 0xac, 0xe6, 0xfa,
-	0xb2, 0xa5, 0x8a, 0x71, 0xf4, 0xe7, 0xcf, 0xef, 0x17, 0x93, 0x46, 0xc0, 0xa5, 0x37, 0x30, 0xd7,
-	0xd8, 0x90, 0xfe, 0x86, 0xeb, 0xeb, 0xdf, 0x01, 0x78, 0x2b, 0x49, 0x7e, 0x1b, 0xd3, 0x36, 0xf1,
-	0x29, 0xd6, 0x1a, 0x70, 0xa5, 0x9f, 0x5b, 0x95, 0x2e, 0x07, 0xf2, 0xd1, 0xa4, 0xd2, 0xd7, 0xe2,
-	0xa5, 0x87, 0x65, 0x5f, 0x0f, 0xcb, 0x96, 0xd9, 0xf4, 0xbf, 0x52, 0x30, 0xcf, 0x8b, 0x68, 0x1f,
-	0xb8, 0x4c, 0x4c, 0xe4, 0x42, 0x34, 0x3c, 0x8e, 0xd1, 0x70, 0x77, 0x6a, 0x1a, 0xfa, 0x05, 0xc4,
-	0x90, 0xf8, 0x04, 0x5e, 0x0f, 0x27, 0xeb, 0xec, 0x61, 0x9f, 0x78, 0x02, 0x8c, 0xe5, 0xea, 0xcd,
-	0xd3, 0x5e, 0x61, 0x75, 0x78, 0xf2, 0xf2, 0xbd, 0x6e, 0xbf, 0xa9, 0xe6, 0xbf, 0xc3, 0x1f, 0x2f,
-	0x1b, 0x82, 0x22, 0x87, 0xe0, 0x76, 0x22, 0x04, 0xbc, 0xc5, 0x81, 0xf9, 0x7f, 0x0f, 0xe0, 0xbb,
-	0xe3, 0xa5, 0xbf, 0x54, 0x12, 0xfe, 0x4b, 0xc1, 0xd5, 0x51, 0x1c, 0xeb, 0x01, 0x9b, 0x05, 0x80,
-	0x5a, 0x0c, 0x00, 0x6b, 0x4a, 0x00, 0xea, 0x41, 0xe2, 0xf0, 0xbf, 0x86, 0x6f, 0x45, 0xc3, 0xf5,
-	0x50, 0x37, 0x6c, 0x5d, 0x12, 0xb0, 0x35, 0xa9, 0xf5, 0x5c, 0x0c, 0x8f, 0x7e, 0x04, 0xdd, 0x5e,
-	0x51, 0x8c, 0xd4, 0x50, 0x57, 0x56, 0xa0, 0x3d, 0x82, 0xcb, 0x91, 0x48, 0x02, 0x8e, 0xb1, 0xcb,
-	0x27, 0xab, 0x96, 0xcf, 0x4a, 0x4c, 0x5e, 0xdd, 0x5e, 0x0a, 0x75, 0xad, 0x98, 0x1c, 0x85, 0xcd,
-	0xe9, 0xf6, 0x01, 0x77, 0xfd, 0x06, 0xc0, 0x77, 0x12, 0x27, 0x10, 0x71, 0xe0, 0xc0, 0x1b, 0x51,
-	0x37, 0x43, 0x18, 0xdc, 0x9f, 0xa4, 0xc5, 0xdb, 0x31, 0x2d, 0x42, 0x1d, 0xae, 0x29, 0x1d, 0x14,
-	0x04, 0x7f, 0xa7, 0x60, 0x61, 0x1c, 0x93, 0x33, 0xe2, 0x60, 0xc7, 0x70, 0xb8, 0x37, 0x3d, 0x0e,
-	0x67, 0x2e, 0x84, 0x6a, 0xa8, 0x01, 0x87, 0x79, 0x70, 0x23, 0xe4, 0xe2, 0x6d, 0x46, 0x06, 0x61,
-	0x9b, 0xf5, 0x80, 0xc9, 0x9d, 0x70, 0x06, 0x57, 0x0b, 0xaf, 0x81, 0xab, 0xca, 0x26, 0xa7, 0xe0,
-	0xce, 0xc4, 0x85, 0xc0, 0x01, 0x38, 0x02, 0xf0, 0xbd, 0x09, 0xea, 0x5f, 0x1e, 0x0a, 0xff, 0x02,
-	0xb8, 0xc6, 0x8b, 0xc1, 0x52, 0xb3, 0x47, 0xc8, 0xed, 0x3c, 0x41, 0xfb, 0xb8, 0xf3, 0x39, 0xc6,
-	0xb3, 0x20, 0xf0, 0x02, 0xc0, 0x8c, 0x18, 0x82, 0xd3, 0x46, 0x6e, 0xc7, 0x61, 0x3c, 0x84, 0xf3,
-	0x15, 0xc6, 0x53, 0xdd, 0x17, 0x46, 0x32, 0x57, 0x6f, 0xab, 0xef, 0x4e, 0xad, 0xe5, 0xa4, 0xc8,
-	0xba, 0x9d, 0xde, 0x8b, 0xfb, 0x55, 0x4a, 0x7c, 0x0a, 0x89, 0xd7, 0x23, 0x8a, 0x99, 0x21, 0xec,
-	0x0d, 0x1e, 0xc6, 0x10, 0x61, 0x0c, 0x1e, 0x66, 0x4b, 0x7e, 0x0a, 0x09, 0xfd, 0x47, 0x43, 0xc8,
-	0xc2, 0x2b, 0x34, 0x68, 0x36, 0x31, 0xa5, 0x42, 0x88, 0x25, 0x3b, 0x7c, 0xd4, 0x7f, 0x01, 0x30,
-	0x9d, 0xa8, 0x9b, 0x48, 0xf5, 0xe1, 0xa8, 0x6e, 0xf2, 0x5c, 0xb7, 0x95, 0x41, 0x64, 0x5a, 0xca,
-	0xa6, 0x12, 0x4d, 0x4b, 0xa1, 0x69, 0x49, 0x7b, 0x02, 0x97, 0xfb, 0xb2, 0xce, 0x0f, 0x41, 0xb0,
-	0x3e, 0x0a, 0xc1, 0x43, 0xdc, 0x42, 0xcd, 0xc3, 0x1d, 0xdc, 0x1c, 0xd8, 0x5e, 0x7d, 0xe9, 0x96,
-	0x98, 0xaa, 0xb5, 0xfc, 0xc7, 0x1b, 0x70, 0xbe, 0x46, 0x5b, 0xda, 0xb7, 0x00, 0xa6, 0x47, 0x2f,
-	0x05, 0xa5, 0xb1, 0x73, 0x4b, 0xba, 0xd6, 0xe4, 0x3e, 0x9e, 0xd9, 0x25, 0xd2, 0xf9, 0x05, 0x80,
-	0x5a, 0xc2, 0x26, 0x2a, 0xcf, 0x18, 0xb1, 0x1e, 0xb0, 0x5c, 0x65, 0x76, 0x9f, 0xa8, 0x8c, 0x1f,
-	0x01, 0x5c, 0x1f, 0x77, 0x53, 0xda, 0x9a, 0x18, 0xfb, 0x6c, 0xe7, 0xdc, 0x83, 0x0b, 0x38, 0x47,
-	0x15, 0xfe, 0x04, 0xe0, 0xad, 0xb1, 0xcb, 0x7b, 0xfb, 0xdc, 0x59, 0xb8, 0x78, 0x3b, 0x17, 0xf1,
-	0x8e, 0x8a, 0x3c, 0x02, 0x30, 0x93, 0xb8, 0x56, 0xee, 0x4d, 0x0c, 0x9f, 0xe0, 0x95, 0xdb, 0x3e,
-	0x8f, 0x57, 0x58, 0x4c, 0xf5, 0xf1, 0xcb, 0xe3, 0x3c, 0x78, 0x75, 0x9c, 0x07, 0xbf, 0x1f, 0xe7,
-	0xc1, 0x0f, 0x27, 0xf9, 0xb9, 0x57, 0x27, 0xf9, 0xb9, 0x5f, 0x4f, 0xf2, 0x73, 0x5f, 0xde, 0x6f,
-	0xb9, 0xec, 0x69, 0xd0, 0x30, 0x9b, 0xc4, 0xb3, 0x54, 0x06, 0xe3, 0x00, 0x35, 0x68, 0xf8, 0x60,
-	0x3d, 0x2b, 0x97, 0xac, 0xee, 0xd0, 0x2e, 0x61, 0x87, 0x6d, 0x4c, 0x1b, 0x8b, 0xe2, 0xdf, 0xab,
-	0xbb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x7e, 0x3e, 0x7d, 0x1a, 0x0e, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xe3, 0x54,
+	0x14, 0xed, 0x4b, 0x4b, 0x9b, 0xbe, 0x99, 0x7e, 0xc4, 0xa4, 0x34, 0x93, 0x0e, 0x49, 0xe5, 0x19,
+	0x41, 0x0a, 0xd8, 0x26, 0x99, 0x91, 0x06, 0xd2, 0x4a, 0x88, 0x4c, 0x41, 0xaa, 0x34, 0x51, 0x3a,
+	0x66, 0x56, 0x6c, 0x2c, 0x27, 0x79, 0x64, 0x4c, 0x63, 0xbf, 0x28, 0x7e, 0xee, 0xa4, 0x3b, 0x40,
+	0xb3, 0xaa, 0x58, 0xf0, 0x0f, 0x90, 0xf8, 0x05, 0xfc, 0x00, 0x24, 0xb6, 0xb3, 0x9c, 0x05, 0x0b,
+	0xc4, 0x22, 0x42, 0x2d, 0x12, 0xfb, 0xac, 0x90, 0x40, 0x80, 0xde, 0x87, 0x9d, 0xc4, 0x71, 0xf3,
+	0x31, 0x85, 0xce, 0xa6, 0xb2, 0x9f, 0xef, 0x3d, 0xf7, 0xdc, 0x73, 0x8f, 0x6f, 0x5c, 0x78, 0x1b,
+	0xbb, 0x36, 0x76, 0x2d, 0x57, 0x6b, 0x61, 0xdc, 0xb4, 0x4d, 0xc7, 0x6c, 0xa0, 0xb6, 0x76, 0x9c,
+	0xaf, 0x22, 0x62, 0xe6, 0x35, 0xd2, 0x51, 0x5b, 0x6d, 0x4c, 0xb0, 0xb4, 0x25, 0xa2, 0xd4, 0x81,
+	0x28, 0x55, 0x44, 0xa5, 0x93, 0x0d, 0xdc, 0xc0, 0x2c, 0x4e, 0xa3, 0x57, 0x3c, 0x25, 0x9d, 0x30,
+	0x6d, 0xcb, 0xc1, 0x1a, 0xfb, 0x2b, 0x8e, 0x32, 0x35, 0x06, 0xa3, 0x55, 0x4d, 0x17, 0x05, 0x35,
+	0x6a, 0xd8, 0x72, 0xc4, 0xf3, 0x77, 0xc6, 0x71, 0x71, 0x9f, 0x98, 0x2d, 0xa3, 0x8d, 0x3d, 0x82,
+	0x78, 0xb4, 0xfc, 0x57, 0x0c, 0x26, 0xcb, 0x6e, 0xe3, 0x93, 0x27, 0x66, 0xeb, 0xa3, 0x8e, 0x59,
+	0x23, 0x1f, 0xda, 0xd8, 0x73, 0xc8, 0x81, 0x23, 0xed, 0xc0, 0x45, 0x17, 0x39, 0x75, 0xd4, 0x4e,
+	0x81, 0x6d, 0x90, 0x5b, 0x2e, 0x25, 0x7a, 0xdd, 0xec, 0xca, 0x89, 0x69, 0x37, 0x8b, 0x32, 0x3f,
+	0x97, 0x75, 0x11, 0x20, 0x3d, 0x80, 0x8b, 0x0c, 0xd2, 0x4d, 0xc5, 0xb6, 0xe7, 0x73, 0xd7, 0x0a,
+	0xaa, 0x3a, 0xa6, 0x51, 0x95, 0x96, 0xf2, 0xab, 0xe8, 0x34, 0xad, 0xb4, 0xf0, 0xac, 0x9b, 0x9d,
+	0xd3, 0x05, 0x86, 0x54, 0x86, 0x71, 0x82, 0x8f, 0x90, 0x63, 0x58, 0x4e, 0x6a, 0x7e, 0x1b, 0xe4,
+	0xae, 0x15, 0x6e, 0xa8, 0xbc, 0x65, 0x95, 0xb6, 0x1c, 0xe0, 0xdc, 0xc7, 0x96, 0x53, 0xda, 0xa4,
+	0xa9, 0xbd, 0x6e, 0x76, 0x8d, 0x33, 0xf3, 0x13, 0x65, 0x7d, 0x89, 0x5d, 0x1e, 0x38, 0x92, 0x0d,
+	0x93, 0xfc, 0x14, 0x7b, 0xc4, 0xb0, 0x2d, 0xc7, 0x30, 0x59, 0xed, 0xd4, 0x02, 0xeb, 0x6a, 0x8f,
+	0xe6, 0xff, 0xd2, 0xcd, 0x6e, 0xf0, 0x0a, 0x6e, 0xfd, 0x48, 0xb5, 0xb0, 0x66, 0x9b, 0xe4, 0xb1,
+	0x7a, 0xe0, 0x90, 0x5e, 0x37, 0xbb, 0x35, 0x08, 0x3c, 0x0c, 0x21, 0xeb, 0x09, 0x76, 0x5c, 0xf1,
+	0x48, 0xd9, 0x72, 0x78, 0x4b, 0x45, 0xe5, 0xf4, 0xf7, 0xef, 0xdf, 0xca, 0x45, 0x8d, 0x80, 0x4a,
+	0xaf, 0x20, 0xaa, 0xb1, 0xc2, 0xf3, 0x15, 0xcb, 0x91, 0xbf, 0x02, 0xf0, 0x66, 0x94, 0xfc, 0x3a,
+	0x72, 0x5b, 0xd8, 0x71, 0x91, 0x54, 0x85, 0xeb, 0xfd, 0xda, 0x82, 0x3a, 0x1f, 0xc8, 0x7b, 0x93,
+	0xa8, 0x6f, 0x86, 0xa9, 0xfb, 0xb4, 0x57, 0x7d, 0xda, 0xbc, 0x9a, 0xfc, 0x47, 0x0c, 0x66, 0x28,
+	0x89, 0x56, 0xd3, 0x22, 0x6c, 0x22, 0x97, 0x72, 0xc3, 0xc3, 0x90, 0x1b, 0xee, 0x4c, 0xed, 0x86,
+	0x3e, 0x81, 0x90, 0x25, 0x3e, 0x80, 0xab, 0xfe, 0x64, 0x8d, 0x3a, 0x72, 0xb0, 0xcd, 0x8c, 0xb1,
+	0x5c, 0xba, 0xd1, 0xeb, 0x66, 0x37, 0x86, 0x27, 0xcf, 0x9f, 0xcb, 0xfa, 0x75, 0x31, 0xff, 0x7d,
+	0x7a, 0x7b, 0xd5, 0x26, 0xc8, 0x51, 0x13, 0xdc, 0x8a, 0x34, 0x01, 0x6d, 0x71, 0x60, 0xfe, 0x5f,
+	0x03, 0xf8, 0xc6, 0x78, 0xe9, 0xaf, 0xd4, 0x09, 0xff, 0xc4, 0xe0, 0xc6, 0xa8, 0x1d, 0x2b, 0x1e,
+	0x99, 0xc5, 0x00, 0xe5, 0x90, 0x01, 0xb4, 0x29, 0x0d, 0x50, 0xf1, 0x22, 0x87, 0xff, 0x39, 0x7c,
+	0x35, 0x18, 0xae, 0x6d, 0x76, 0xfc, 0xd6, 0xb9, 0x03, 0x76, 0x27, 0xb5, 0x9e, 0x0e, 0xd9, 0xa3,
+	0x8f, 0x20, 0xeb, 0xeb, 0xc2, 0x23, 0x65, 0xb3, 0xc3, 0x19, 0x48, 0x87, 0x70, 0x39, 0x10, 0x29,
+	0xb5, 0x30, 0x69, 0xf9, 0xa4, 0xc4, 0xf2, 0x59, 0x0f, 0xc9, 0x2b, 0xeb, 0x71, 0x5f, 0xd7, 0xa2,
+	0x4a, 0xad, 0xb0, 0x33, 0xdd, 0x3e, 0xa0, 0xa9, 0x5f, 0x00, 0xf8, 0x7a, 0xe4, 0x04, 0x02, 0x1f,
+	0x18, 0x70, 0x2d, 0xe8, 0x66, 0xc8, 0x06, 0xf7, 0x26, 0x69, 0xf1, 0x5a, 0x48, 0x0b, 0x5f, 0x87,
+	0x15, 0xa1, 0x83, 0x30, 0xc1, 0x9f, 0x31, 0x98, 0x1d, 0xe7, 0xc9, 0x19, 0xed, 0xa0, 0x87, 0xec,
+	0x70, 0x77, 0x7a, 0x3b, 0x5c, 0xb8, 0x10, 0x4a, 0x70, 0xad, 0x6f, 0xe6, 0xc1, 0x8d, 0x90, 0x0e,
+	0xb7, 0x19, 0x04, 0xf8, 0x6d, 0x56, 0x3c, 0xc2, 0x77, 0xc2, 0x05, 0xbe, 0x5a, 0xf8, 0x1f, 0x7c,
+	0x55, 0xdc, 0xa1, 0x2e, 0xb8, 0x3d, 0x71, 0x21, 0x50, 0x03, 0x9c, 0x02, 0xf8, 0xe6, 0x04, 0xf5,
+	0xaf, 0xce, 0x0a, 0x7f, 0x03, 0xb8, 0x49, 0xc9, 0x20, 0xae, 0xd9, 0xa1, 0x69, 0xb5, 0x1f, 0x99,
+	0x47, 0xa8, 0xfd, 0x31, 0x42, 0xb3, 0x58, 0xe0, 0x29, 0x80, 0x49, 0x36, 0x04, 0xa3, 0x65, 0x5a,
+	0x6d, 0x83, 0x50, 0x08, 0xe3, 0x33, 0x84, 0xa6, 0xfa, 0x5e, 0x18, 0xa9, 0x5c, 0xba, 0x25, 0xde,
+	0x3b, 0xb1, 0x96, 0xa3, 0x90, 0x65, 0x3d, 0x51, 0x0f, 0xe7, 0x15, 0xf3, 0x74, 0x0a, 0x91, 0x9f,
+	0x47, 0x2e, 0x22, 0x0a, 0x8b, 0x57, 0x28, 0x8c, 0xc2, 0x60, 0x14, 0x0a, 0xb3, 0x0b, 0xb3, 0x17,
+	0xf4, 0x1f, 0x0c, 0x21, 0x05, 0x97, 0x5c, 0xaf, 0x56, 0x43, 0xae, 0xcb, 0x84, 0x88, 0xeb, 0xfe,
+	0xad, 0xfc, 0x23, 0x80, 0x89, 0x48, 0xdd, 0x58, 0xa9, 0x77, 0x47, 0x75, 0xe3, 0xe7, 0xb2, 0x2e,
+	0x02, 0x82, 0xd0, 0x7c, 0x2a, 0x16, 0x19, 0x9a, 0xf7, 0x43, 0xf3, 0xd2, 0x23, 0xb8, 0xdc, 0x97,
+	0x75, 0x7e, 0xc8, 0x04, 0x5b, 0xa3, 0x26, 0x78, 0x80, 0x1a, 0x66, 0xed, 0x64, 0x1f, 0xd5, 0x06,
+	0xb6, 0x57, 0x5f, 0xba, 0x38, 0x11, 0x5c, 0xe5, 0x1f, 0x00, 0xbc, 0x7e, 0x88, 0x71, 0x33, 0x20,
+	0xff, 0x36, 0x5c, 0xa2, 0xba, 0x19, 0x56, 0x9d, 0xb1, 0x5f, 0x28, 0x49, 0xbd, 0x6e, 0x76, 0x95,
+	0x23, 0x88, 0x07, 0xb2, 0xbe, 0x48, 0xaf, 0x0e, 0xea, 0xc3, 0x9c, 0x62, 0xff, 0x11, 0x27, 0x2a,
+	0x4a, 0x1b, 0xd9, 0xf8, 0x98, 0xb7, 0x19, 0x1f, 0x14, 0x85, 0x9f, 0xcb, 0xba, 0x08, 0x90, 0x7f,
+	0x02, 0x70, 0x85, 0xbd, 0x43, 0x01, 0xff, 0x88, 0xc5, 0x01, 0x66, 0x5d, 0x1c, 0x2f, 0xbb, 0xad,
+	0xc2, 0x6f, 0xaf, 0xc0, 0xf9, 0xb2, 0xdb, 0x90, 0xbe, 0x04, 0x30, 0x31, 0xfa, 0xa9, 0x96, 0x1f,
+	0xfb, 0x36, 0x45, 0x7d, 0x6c, 0xa6, 0xdf, 0x9f, 0x39, 0x25, 0x70, 0xff, 0x53, 0x00, 0xa5, 0x88,
+	0xdf, 0x87, 0xc2, 0x8c, 0x88, 0x15, 0x8f, 0xa4, 0x8b, 0xb3, 0xe7, 0x04, 0x34, 0xbe, 0x05, 0x70,
+	0x6b, 0xdc, 0xf7, 0xeb, 0xee, 0x44, 0xec, 0x8b, 0x93, 0xd3, 0xf7, 0x2f, 0x91, 0x1c, 0x30, 0xfc,
+	0x0e, 0xc0, 0x9b, 0x63, 0x7f, 0x52, 0xf7, 0x5e, 0xb8, 0x0a, 0x15, 0x6f, 0xff, 0x32, 0xd9, 0x01,
+	0xc9, 0x53, 0x00, 0x93, 0x91, 0xcb, 0xfe, 0xee, 0x44, 0xf8, 0x88, 0xac, 0xf4, 0xde, 0x8b, 0x64,
+	0xf9, 0x64, 0x4a, 0x0f, 0x9f, 0x9d, 0x65, 0xc0, 0xf3, 0xb3, 0x0c, 0xf8, 0xf5, 0x2c, 0x03, 0xbe,
+	0x39, 0xcf, 0xcc, 0x3d, 0x3f, 0xcf, 0xcc, 0xfd, 0x7c, 0x9e, 0x99, 0xfb, 0xf4, 0x5e, 0xc3, 0x22,
+	0x8f, 0xbd, 0xaa, 0x5a, 0xc3, 0xb6, 0x26, 0x2a, 0x28, 0x4d, 0xb3, 0xea, 0xfa, 0x37, 0xda, 0x71,
+	0x21, 0xaf, 0x75, 0x86, 0x36, 0x3c, 0x39, 0x69, 0x21, 0xb7, 0xba, 0xc8, 0xfe, 0xe9, 0xbd, 0xf3,
+	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x94, 0xa1, 0x85, 0xb0, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1397,6 +1522,104 @@ func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1598,6 +1821,41 @@ func (m *DenomPairTakerFee) Size() (n int) {
 	return n
 }
 
+func (m *PoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *RouteTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3004,6 +3262,265 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
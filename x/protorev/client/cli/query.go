@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
 )
 
@@ -26,11 +27,16 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryDeveloperAccountCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMaxPoolPointsPerTxCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMaxPoolPointsPerBlockCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMaxCyclicRouteHopsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryBaseDenomsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryBackrunRecordsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTopTriggerPoolsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitShareByTriggerPoolCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryDryRunCmd)
 
 	return cmd
 }
@@ -126,6 +132,52 @@ func NewQueryMaxPoolPointsPerBlockCmd() (*osmocli.QueryDescriptor, *types.QueryG
 	}, &types.QueryGetProtoRevMaxPoolPointsPerBlockRequest{}
 }
 
+// NewQueryMaxCyclicRouteHopsCmd returns the command to query the max number of hops of the routes found by the cycle finder
+func NewQueryMaxCyclicRouteHopsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevMaxCyclicRouteHopsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "max-cyclic-route-hops",
+		Short: "Query the max number of hops of the cyclic routes found by the cycle finder",
+	}, &types.QueryGetProtoRevMaxCyclicRouteHopsRequest{}
+}
+
+// NewQueryBackrunRecordsCmd returns the command to query the backruns executed by protorev and the txs that triggered them
+func NewQueryBackrunRecordsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevBackrunRecordsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "backrun-records",
+		Short: "Query the backruns executed by protorev and the txs that triggered them",
+	}, &types.QueryGetProtoRevBackrunRecordsRequest{}
+}
+
+// NewQueryTopTriggerPoolsCmd returns the command to query the pools whose swaps triggered the most backruns
+func NewQueryTopTriggerPoolsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevTopTriggerPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "top-trigger-pools",
+		Short: "Query the pools whose swaps triggered the most backruns over the most recent days",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} top-trigger-pools 7 10`,
+	}, &types.QueryGetProtoRevTopTriggerPoolsRequest{}
+}
+
+// NewQueryProfitShareByTriggerPoolCmd returns the command to query the share of the backrun profits made after swaps on each pool
+func NewQueryProfitShareByTriggerPoolCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitShareByTriggerPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "profit-share-by-trigger-pool",
+		Short: "Query the share of the backrun profits made after swaps on each pool over the most recent days",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} profit-share-by-trigger-pool 7`,
+	}, &types.QueryGetProtoRevProfitShareByTriggerPoolRequest{}
+}
+
+// NewQueryDryRunCmd returns the command to query the backruns protorev would try after a hypothetical swap
+func NewQueryDryRunCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevDryRunRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "dry-run",
+		Short: "Query the backruns protorev would try after a hypothetical swap, with the optimal input and expected profit of each route",
+		Long: `{{.Short}}
+The route of the swap is a JSON array of pool ids and token out denoms.{{.ExampleHeader}}
+{{.CommandPrefix}} dry-run 1000000uosmo '[{"pool_id":1,"token_out_denom":"uatom"}]'`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Route": parseSwapRoute},
+	}, &types.QueryGetProtoRevDryRunRequest{}
+}
+
 // NewQueryBaseDenomsCmd returns the command to query the base denoms
 func NewQueryBaseDenomsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevBaseDenomsRequest) {
 	return &osmocli.QueryDescriptor{
@@ -177,3 +229,15 @@ func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, e
 	}
 	return route, osmocli.UsedArg, err
 }
+
+// convert a JSON array of swap route steps to []poolmanagertypes.SwapAmountInRoute
+//
+//nolint:unparam
+func parseSwapRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	var route []poolmanagertypes.SwapAmountInRoute
+	err := json.Unmarshal([]byte(arg), &route)
+	if err != nil {
+		return nil, osmocli.UsedArg, err
+	}
+	return route, osmocli.UsedArg, err
+}
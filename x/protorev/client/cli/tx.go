@@ -26,6 +26,7 @@ func NewCmdTx() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, CmdSetDeveloperAccount)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerTx)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerBlock)
+	osmocli.AddTxCmd(txCmd, CmdSetMaxCyclicRouteHops)
 	txCmd.AddCommand(
 		CmdSetDeveloperHotRoutes().BuildCommandCustomFn(),
 		CmdSetInfoByPoolType().BuildCommandCustomFn(),
@@ -120,6 +121,26 @@ func CmdSetMaxPoolPointsPerTx() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPointsP
 	}, &types.MsgSetMaxPoolPointsPerTx{}
 }
 
+// CmdSetMaxCyclicRouteHops implements the command to set the max number of hops of the routes found by the cycle finder
+func CmdSetMaxCyclicRouteHops() (*osmocli.TxCliDesc, *types.MsgSetMaxCyclicRouteHops) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-max-cyclic-route-hops",
+		Short:   "set the max number of hops of the cyclic routes found by the cycle finder (0 disables the cycle finder)",
+		NumArgs: 1,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			maxCyclicRouteHops, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.MsgSetMaxCyclicRouteHops{
+				MaxCyclicRouteHops: maxCyclicRouteHops,
+				Admin:              clientCtx.GetFromAddress().String(),
+			}, nil
+		},
+	}, &types.MsgSetMaxCyclicRouteHops{}
+}
+
 // CmdSetMaxPoolPointsPerBlock implements the command to set the max pool points per block
 func CmdSetMaxPoolPointsPerBlock() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPointsPerBlock) {
 	return &osmocli.TxCliDesc{
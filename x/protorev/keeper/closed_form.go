@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// routeSwapModel models the amount out of a route given an amount in x as a * x / (1 + c * x). This is exact for
+// routes of constant product pools (including concentrated liquidity pools within their current tick range) and
+// approximates the swaps of weighted pools around their spot price.
+type routeSwapModel struct {
+	a osmomath.BigDec
+	c osmomath.BigDec
+}
+
+// routeProfit is the profit of a route for a given number of steps. Amounts whose swaps fail are not profitable.
+type routeProfit struct {
+	profit osmomath.Int
+	ok     bool
+}
+
+// getRouteSwapModel composes the swap models of all of the pools in the route. Returns false if the route contains
+// a pool that is neither a balancer nor a concentrated liquidity pool, or a pool without liquidity.
+func (k Keeper) getRouteSwapModel(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputDenom string) (routeSwapModel, bool) {
+	model := routeSwapModel{a: osmomath.OneBigDec(), c: osmomath.ZeroBigDec()}
+
+	tokenInDenom := inputDenom
+	for _, hop := range route {
+		pool, err := k.poolmanagerKeeper.GetPool(ctx, hop.PoolId)
+		if err != nil {
+			return routeSwapModel{}, false
+		}
+
+		reserveIn, reserveOut, ok := getVirtualReserves(pool, tokenInDenom, hop.TokenOutDenom)
+		if !ok {
+			return routeSwapModel{}, false
+		}
+
+		// The taker fee overrides of routes are ignored since the model is refitted to the exact amounts out
+		takerFee, err := k.poolmanagerKeeper.GetTakerFee(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		if err != nil {
+			return routeSwapModel{}, false
+		}
+
+		spreadFactor, err := k.poolmanagerKeeper.GetEffectiveSpreadFactor(ctx, hop.PoolId)
+		if err != nil {
+			return routeSwapModel{}, false
+		}
+
+		// Composing a * x / (1 + c * x) with the constant product swap gamma * Rout * y / (Rin + gamma * y)
+		// yields a model of the same form
+		gamma := osmomath.BigDecFromDec(osmomath.OneDec().Sub(spreadFactor).Mul(osmomath.OneDec().Sub(takerFee)))
+		scaledA := model.a.Mul(gamma)
+		model.c = model.c.Add(scaledA.Quo(reserveIn))
+		model.a = scaledA.Mul(reserveOut).Quo(reserveIn)
+
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	return model, true
+}
+
+// getVirtualReserves returns the reserves of the constant product pool that best approximates the swaps of the given
+// pool around its spot price. For weighted pools, the reserves match the spot price and its rate of change, which is
+// exact for equally weighted pools. For concentrated liquidity pools, the reserves are the virtual reserves of the
+// current tick range.
+func getVirtualReserves(pool poolmanagertypes.PoolI, tokenInDenom, tokenOutDenom string) (osmomath.BigDec, osmomath.BigDec, bool) {
+	var reserveIn, reserveOut osmomath.BigDec
+
+	switch pool := pool.(type) {
+	case *balancer.Pool:
+		assetIn, err := pool.GetPoolAsset(tokenInDenom)
+		if err != nil {
+			return osmomath.BigDec{}, osmomath.BigDec{}, false
+		}
+		assetOut, err := pool.GetPoolAsset(tokenOutDenom)
+		if err != nil || !assetOut.Weight.IsPositive() {
+			return osmomath.BigDec{}, osmomath.BigDec{}, false
+		}
+
+		weightRatio := osmomath.BigDecFromSDKInt(assetIn.Weight).Quo(osmomath.BigDecFromSDKInt(assetOut.Weight))
+		scale := osmomath.NewBigDec(2).Quo(osmomath.OneBigDec().Add(weightRatio))
+		reserveIn = osmomath.BigDecFromSDKInt(assetIn.Token.Amount).Mul(scale)
+		reserveOut = osmomath.BigDecFromSDKInt(assetOut.Token.Amount).Mul(weightRatio).Mul(scale)
+	case cltypes.ConcentratedPoolExtension:
+		sqrtPrice := pool.GetCurrentSqrtPrice()
+		liquidity := osmomath.BigDecFromDec(pool.GetLiquidity())
+		if !sqrtPrice.IsPositive() || !liquidity.IsPositive() {
+			return osmomath.BigDec{}, osmomath.BigDec{}, false
+		}
+
+		// The price is the amount of token1 per token0
+		reserveIn, reserveOut = liquidity.Quo(sqrtPrice), liquidity.Mul(sqrtPrice)
+		if tokenInDenom != pool.GetToken0() {
+			reserveIn, reserveOut = reserveOut, reserveIn
+		}
+	default:
+		return osmomath.BigDec{}, osmomath.BigDec{}, false
+	}
+
+	if !reserveIn.IsPositive() || !reserveOut.IsPositive() {
+		return osmomath.BigDec{}, osmomath.BigDec{}, false
+	}
+
+	return reserveIn, reserveOut, true
+}
+
+// refitRouteSwapModel fits the route swap model to the exact amounts out of two amounts in. Since
+// x / out(x) = 1 / a + (c / a) * x is linear in x, the model is determined by two points.
+func refitRouteSwapModel(amountIn1, amountOut1, amountIn2, amountOut2 osmomath.Int) (routeSwapModel, bool) {
+	if amountIn1.Equal(amountIn2) || !amountOut1.IsPositive() || !amountOut2.IsPositive() {
+		return routeSwapModel{}, false
+	}
+
+	ratio1 := osmomath.BigDecFromSDKInt(amountIn1).Quo(osmomath.BigDecFromSDKInt(amountOut1))
+	ratio2 := osmomath.BigDecFromSDKInt(amountIn2).Quo(osmomath.BigDecFromSDKInt(amountOut2))
+	slope := ratio2.Sub(ratio1).Quo(osmomath.BigDecFromSDKInt(amountIn2.Sub(amountIn1)))
+	intercept := ratio1.Sub(slope.Mul(osmomath.BigDecFromSDKInt(amountIn1)))
+	if !slope.IsPositive() || !intercept.IsPositive() {
+		return routeSwapModel{}, false
+	}
+
+	return routeSwapModel{a: osmomath.OneBigDec().Quo(intercept), c: slope.Quo(intercept)}, true
+}
+
+// optimalSteps returns the number of steps, rounded down and between 1 and maxSteps, of the amount in that maximizes
+// a * x / (1 + c * x) - x, which is x = (sqrt(a) - 1) / c.
+func (m routeSwapModel) optimalSteps(stepSize osmomath.Int, maxSteps int64) int64 {
+	if !m.c.IsPositive() || m.a.LTE(osmomath.OneBigDec()) {
+		return 1
+	}
+
+	sqrtA, err := osmomath.MonotonicSqrtBigDec(m.a)
+	if err != nil {
+		return 1
+	}
+
+	steps := sqrtA.Sub(osmomath.OneBigDec()).Quo(m.c).Quo(osmomath.BigDecFromSDKInt(stepSize))
+	switch {
+	case steps.GT(osmomath.NewBigDec(maxSteps)):
+		return maxSteps
+	case steps.LT(osmomath.OneBigDec()):
+		return 1
+	default:
+		return steps.TruncateInt().Int64()
+	}
+}
+
+// findMaxProfitInClosedForm finds the max profit for a route using its swap model. The optimal amount in of the
+// model is refitted to the exact amounts out of the route a few times and then adjusted step by step until the
+// profit no longer increases, which yields the same amount in as the binary search with far fewer swap estimates.
+func (k Keeper) findMaxProfitInClosedForm(
+	ctx sdk.Context,
+	route RouteMetaData,
+	inputDenom string,
+	model routeSwapModel,
+	minInProfit osmomath.Int,
+) (sdk.Coin, osmomath.Int, error) {
+	// If there are concentrated liquidity pools in the route, then the amount in is bounded by the max ticks moved
+	upperBound, err := k.CalculateUpperBoundForSearch(ctx, route, inputDenom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+	if upperBound.LT(osmomath.OneInt()) {
+		return sdk.Coin{}, osmomath.ZeroInt(), nil
+	}
+	maxSteps := upperBound.Int64()
+
+	// Cache the profits by number of steps since the refits and the step adjustments evaluate the same amounts
+	profits := map[int64]routeProfit{1: {profit: minInProfit, ok: true}}
+	getProfit := func(steps int64) routeProfit {
+		if profit, ok := profits[steps]; ok {
+			return profit
+		}
+
+		_, profit, err := k.EstimateMultihopProfit(ctx, inputDenom, route.StepSize.MulRaw(steps), route.Route)
+		profits[steps] = routeProfit{profit: profit, ok: err == nil}
+		return profits[steps]
+	}
+
+	// Refit the model to the exact amounts out of the previous and current optimal amounts in
+	steps := model.optimalSteps(route.StepSize, maxSteps)
+	previousSteps := int64(1)
+	for refinement := 0; refinement < types.MaxClosedFormRefinements && steps != previousSteps; refinement++ {
+		previous, current := getProfit(previousSteps), getProfit(steps)
+		if !current.ok {
+			break
+		}
+
+		previousAmountIn, currentAmountIn := route.StepSize.MulRaw(previousSteps), route.StepSize.MulRaw(steps)
+		refitted, ok := refitRouteSwapModel(previousAmountIn, previous.profit.Add(previousAmountIn), currentAmountIn, current.profit.Add(currentAmountIn))
+		if !ok {
+			break
+		}
+
+		previousSteps, steps = steps, refitted.optimalSteps(route.StepSize, maxSteps)
+	}
+
+	// Move towards the most profitable number of steps, doubling the move while the profit increases and halving
+	// it otherwise. Ties move to the larger amount in, like the binary search.
+	delta := int64(1)
+	for iteration := 0; iteration < types.MaxIterations; iteration++ {
+		up, down := steps+delta, steps-delta
+		if up > maxSteps {
+			up = maxSteps
+		}
+		if down < 1 {
+			down = 1
+		}
+
+		if up > steps && getProfit(up).isAtLeast(getProfit(steps)) {
+			steps, delta = up, delta*2
+		} else if down < steps && getProfit(down).isGreaterThan(getProfit(steps)) {
+			steps, delta = down, delta*2
+		} else if delta > 1 {
+			delta /= 2
+		} else {
+			break
+		}
+	}
+
+	profit := getProfit(steps)
+	if !profit.ok || profit.profit.LT(minInProfit) {
+		return sdk.NewCoin(inputDenom, route.StepSize), minInProfit, nil
+	}
+
+	return sdk.NewCoin(inputDenom, route.StepSize.MulRaw(steps)), profit.profit, nil
+}
+
+// isAtLeast returns true if the profit is at least the other profit. Failed swaps are less profitable than any amount.
+func (p routeProfit) isAtLeast(other routeProfit) bool {
+	return p.ok && (!other.ok || p.profit.GTE(other.profit))
+}
+
+// isGreaterThan returns true if the profit is greater than the other profit.
+func (p routeProfit) isGreaterThan(other routeProfit) bool {
+	return p.ok && (!other.ok || p.profit.GT(other.profit))
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
+)
+
+// BenchmarkFindMaxProfitWithBinarySearch benchmarks the binary search for the max profit of routes made only of
+// balancer and concentrated liquidity pools, reporting the gas consumed per search.
+func BenchmarkFindMaxProfitWithBinarySearch(b *testing.B) {
+	benchmarkFindMaxProfit(b, func(s *KeeperTestSuite, ctx sdk.Context, route keeper.RouteMetaData) error {
+		_, _, err := s.App.ProtoRevKeeper.FindMaxProfitWithBinarySearch(ctx, route)
+		return err
+	})
+}
+
+// BenchmarkFindMaxProfitInClosedForm benchmarks the closed form search for the max profit of the same routes as
+// BenchmarkFindMaxProfitWithBinarySearch, reporting the gas consumed per search.
+func BenchmarkFindMaxProfitInClosedForm(b *testing.B) {
+	benchmarkFindMaxProfit(b, func(s *KeeperTestSuite, ctx sdk.Context, route keeper.RouteMetaData) error {
+		_, _, _, err := s.App.ProtoRevKeeper.FindMaxProfitInClosedForm(ctx, route)
+		return err
+	})
+}
+
+// benchmarkFindMaxProfit runs the given search for the max profit of each route in a sub-benchmark, and reports the
+// gas it consumes per search alongside the time.
+func benchmarkFindMaxProfit(b *testing.B, findMaxProfit func(s *KeeperTestSuite, ctx sdk.Context, route keeper.RouteMetaData) error) {
+	routes := map[string]poolmanagertypes.SwapAmountInRoutes{
+		"balancer":            routeTwoAssetSameWeight,
+		"balancer four pools": fourPoolRoute,
+		"concentrated":        clPoolRouteMulti,
+	}
+
+	// Setup the test suite
+	s := new(KeeperTestSuite)
+	s.SetT(&testing.T{})
+	s.SetupTest()
+
+	for name, route := range routes {
+		route := keeper.RouteMetaData{
+			Route:    route,
+			StepSize: osmomath.NewInt(1_000_000),
+		}
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			totalGas := uint64(0)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				ctx, _ := s.Ctx.CacheContext()
+				ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				b.StartTimer()
+
+				if err := findMaxProfit(s, ctx, route); err != nil {
+					b.Fatal(err)
+				}
+				totalGas += ctx.GasMeter().GasConsumed()
+			}
+
+			b.ReportMetric(float64(totalGas)/float64(b.N), "gas/op")
+		})
+	}
+}
+
+// TestFindMaxProfitInClosedForm compares the gas consumed by the closed form search against the binary search
+// on routes of balancer and concentrated liquidity pools. The closed form search must find at least as much profit.
+func (s *KeeperTestSuite) TestFindMaxProfitInClosedForm() {
+	tests := []struct {
+		name  string
+		route poolmanagertypes.SwapAmountInRoutes
+	}{
+		{name: "2 Asset, Same Weights", route: routeTwoAssetSameWeight},
+		{name: "Multi Asset, Different Weights", route: routeDiffDenom},
+		{name: "Four Pool Route", route: fourPoolRoute},
+		{name: "Two Pool Route", route: twoPoolRoute},
+		{name: "CL Route Multi", route: clPoolRouteMulti},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			route := keeper.RouteMetaData{
+				Route:    test.route,
+				StepSize: osmomath.NewInt(1_000_000),
+			}
+
+			binarySearchCtx, _ := s.Ctx.CacheContext()
+			binarySearchCtx = binarySearchCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			binarySearchAmtIn, binarySearchProfit, err := s.App.ProtoRevKeeper.FindMaxProfitWithBinarySearch(binarySearchCtx, route)
+			s.Require().NoError(err)
+
+			closedFormCtx, _ := s.Ctx.CacheContext()
+			closedFormCtx = closedFormCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			closedFormAmtIn, closedFormProfit, ok, err := s.App.ProtoRevKeeper.FindMaxProfitInClosedForm(closedFormCtx, route)
+			s.Require().NoError(err)
+			s.Require().True(ok)
+
+			binarySearchGas, closedFormGas := binarySearchCtx.GasMeter().GasConsumed(), closedFormCtx.GasMeter().GasConsumed()
+			s.T().Logf("binary search: %s for a profit of %s using %d gas, closed form: %s for a profit of %s using %d gas",
+				binarySearchAmtIn, binarySearchProfit, binarySearchGas, closedFormAmtIn, closedFormProfit, closedFormGas)
+
+			s.Require().True(closedFormProfit.GTE(binarySearchProfit))
+			s.Require().Less(closedFormGas, binarySearchGas)
+		})
+	}
+
+	// Routes containing other pool types fall back to the binary search
+	_, _, ok, err := s.App.ProtoRevKeeper.FindMaxProfitInClosedForm(s.Ctx, keeper.RouteMetaData{Route: routeStableSwap, StepSize: osmomath.NewInt(1_000_000)})
+	s.Require().NoError(err)
+	s.Require().False(ok)
+}
@@ -0,0 +1,481 @@
+package keeper
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// cyclicRouteGraphEdge is a pool of the cycle finder graph seen from one of its denoms.
+type cyclicRouteGraphEdge struct {
+	PoolId     uint64
+	OtherDenom string
+}
+
+// cyclicRouteHop is a hop of a cyclic route being built by the cycle finder.
+type cyclicRouteHop struct {
+	Hop poolmanagertypes.SwapAmountInRoute
+	// The amount of token out received per token in at the spot price, net of the spread factor
+	Rate osmomath.BigDec
+}
+
+// cyclicRouteCandidate is a cyclic route found by the cycle finder that is profitable at the spot price.
+type cyclicRouteCandidate struct {
+	Route     poolmanagertypes.SwapAmountInRoutes
+	BaseDenom types.BaseDenom
+	// The amount of base denom received per base denom in at the spot price
+	SpotRate osmomath.BigDec
+}
+
+// cyclicRouteSearch holds the state of a search for cyclic routes through the pool that was swapped on.
+// The route swaps back on the swapped pool, i.e. from the token out to the token in of the swap.
+type cyclicRouteSearch struct {
+	BaseDenom     types.BaseDenom
+	SwappedPoolId uint64
+	SwapTokenIn   string
+	SwapTokenOut  string
+	MaxHops       int
+
+	Hops          []cyclicRouteHop
+	VisitedDenoms map[string]bool
+	VisitedPools  map[uint64]bool
+	// The number of pools explored so far and the max number of pools that can be explored, shared by the
+	// searches for all base denoms
+	NumPoolsExplored *uint64
+	MaxPoolsExplored uint64
+	Candidates       []cyclicRouteCandidate
+}
+
+// BuildCyclicRoutes builds cyclic arbitrage routes of up to the max cyclic route hops using the cycle finder. A route starts
+// and ends with a base denom, goes through the pools of the cycle finder graph and swaps back on the pool that was swapped
+// on. Only routes with more hops than the ones built by the highest liquidity method that are profitable at the spot price
+// are returned, sorted by decreasing spot profitability.
+//
+// The search consumes one pool point per CyclicRouteSearchPoolsPerPoolPoint pools explored, which are deducted from the
+// remaining pool points of the tx and block. The returned routes fit together in the pool points remaining after the search.
+func (k Keeper) BuildCyclicRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+
+	maxHops := k.GetMaxCyclicRouteHops(ctx)
+	if maxHops == 0 {
+		return routes, nil
+	}
+
+	// The search cannot explore more pools than the remaining pool points of the tx pay for
+	maxPoolsExplored := *remainingTxPoolPoints * types.CyclicRouteSearchPoolsPerPoolPoint
+	if maxPoolsExplored > types.MaxCyclicRouteSearchPools {
+		maxPoolsExplored = types.MaxCyclicRouteSearchPools
+	}
+	if maxPoolsExplored == 0 {
+		return routes, nil
+	}
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	numPoolsExplored := uint64(0)
+	candidates := make([]cyclicRouteCandidate, 0)
+	for _, baseDenom := range baseDenoms {
+		search := &cyclicRouteSearch{
+			BaseDenom:        baseDenom,
+			SwappedPoolId:    poolId,
+			SwapTokenIn:      tokenIn,
+			SwapTokenOut:     tokenOut,
+			MaxHops:          int(maxHops),
+			VisitedDenoms:    map[string]bool{baseDenom.Denom: true},
+			VisitedPools:     make(map[uint64]bool),
+			NumPoolsExplored: &numPoolsExplored,
+			MaxPoolsExplored: maxPoolsExplored,
+		}
+		k.searchCyclicRoutes(ctx, search, baseDenom.Denom)
+		candidates = append(candidates, search.Candidates...)
+	}
+
+	// Charge the pools explored against the pool point budgets, rounding up
+	searchPoolPoints := (numPoolsExplored + types.CyclicRouteSearchPoolsPerPoolPoint - 1) / types.CyclicRouteSearchPoolsPerPoolPoint
+	*remainingTxPoolPoints -= searchPoolPoints
+	*remainingBlockPoolPoints -= searchPoolPoints
+	if err := k.IncrementPointCountForBlock(ctx, searchPoolPoints); err != nil {
+		return routes, err
+	}
+
+	// Routes with a higher spot profitability are more likely to be profitable once simulated. Routes with the same
+	// spot profitability keep the priority order of their base denoms.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].SpotRate.GT(candidates[j].SpotRate)
+	})
+
+	remainingPoolPoints := *remainingTxPoolPoints
+	for _, candidate := range candidates {
+		if len(routes) >= types.MaxCyclicRoutesPerSwap {
+			break
+		}
+
+		// Check that the route is valid and fits, along with the routes already built, in the pool points remaining
+		// after the search. CalculateRoutePoolPoints only checks the route alone against GetRemainingPoolPoints.
+		routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, candidate.Route)
+		if err != nil || routePoolPoints > remainingPoolPoints {
+			continue
+		}
+		remainingPoolPoints -= routePoolPoints
+
+		routes = append(routes, RouteMetaData{
+			Route:      candidate.Route,
+			PoolPoints: routePoolPoints,
+			StepSize:   candidate.BaseDenom.StepSize,
+		})
+	}
+
+	return routes, nil
+}
+
+// searchCyclicRoutes explores the cycle finder graph depth first from the given denom, recording the cyclic routes
+// that are profitable at the spot price in the search.
+func (k Keeper) searchCyclicRoutes(ctx sdk.Context, search *cyclicRouteSearch, denom string) {
+	// The route is complete once it is back to the base denom
+	if len(search.Hops) > 0 && denom == search.BaseDenom.Denom {
+		if search.VisitedPools[search.SwappedPoolId] && uint64(len(search.Hops)) >= types.MinCyclicRouteHops {
+			search.recordCandidate()
+		}
+		return
+	}
+
+	if len(search.Hops) >= search.MaxHops {
+		return
+	}
+
+	// The swapped pool is swapped on as soon as its token out is reached
+	if denom == search.SwapTokenOut && !search.VisitedPools[search.SwappedPoolId] {
+		if search.SwapTokenIn != search.BaseDenom.Denom && search.VisitedDenoms[search.SwapTokenIn] {
+			return
+		}
+		if k.pushCyclicRouteHop(ctx, search, search.SwappedPoolId, denom, search.SwapTokenIn) {
+			k.searchCyclicRoutes(ctx, search, search.SwapTokenIn)
+			search.popCyclicRouteHop()
+		}
+		return
+	}
+
+	for _, edge := range k.getCyclicRouteGraphEdges(ctx, denom) {
+		if *search.NumPoolsExplored >= search.MaxPoolsExplored {
+			return
+		}
+		*search.NumPoolsExplored++
+
+		if edge.PoolId == search.SwappedPoolId || search.VisitedPools[edge.PoolId] {
+			continue
+		}
+
+		// The base denom can only be swapped into to complete the route, and any other denom must leave room for
+		// at least one more hop back to the base denom
+		if edge.OtherDenom == search.BaseDenom.Denom {
+			if !search.VisitedPools[search.SwappedPoolId] || uint64(len(search.Hops)+1) < types.MinCyclicRouteHops {
+				continue
+			}
+		} else if search.VisitedDenoms[edge.OtherDenom] || len(search.Hops)+1 >= search.MaxHops {
+			continue
+		}
+
+		if k.pushCyclicRouteHop(ctx, search, edge.PoolId, denom, edge.OtherDenom) {
+			k.searchCyclicRoutes(ctx, search, edge.OtherDenom)
+			search.popCyclicRouteHop()
+		}
+	}
+}
+
+// pushCyclicRouteHop appends a hop to the route being built by the search. Returns false if the spot rate of the hop
+// cannot be calculated.
+func (k Keeper) pushCyclicRouteHop(ctx sdk.Context, search *cyclicRouteSearch, poolId uint64, tokenInDenom, tokenOutDenom string) bool {
+	rate, err := k.getCyclicRouteHopRate(ctx, poolId, tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return false
+	}
+
+	search.Hops = append(search.Hops, cyclicRouteHop{
+		Hop: poolmanagertypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: tokenOutDenom,
+		},
+		Rate: rate,
+	})
+	search.VisitedPools[poolId] = true
+	search.VisitedDenoms[tokenOutDenom] = true
+
+	return true
+}
+
+// popCyclicRouteHop removes the last hop of the route being built by the search.
+func (s *cyclicRouteSearch) popCyclicRouteHop() {
+	hop := s.Hops[len(s.Hops)-1]
+	s.Hops = s.Hops[:len(s.Hops)-1]
+
+	delete(s.VisitedPools, hop.Hop.PoolId)
+	if hop.Hop.TokenOutDenom != s.BaseDenom.Denom {
+		delete(s.VisitedDenoms, hop.Hop.TokenOutDenom)
+	}
+}
+
+// recordCandidate records the route built by the search if it is profitable at the spot price.
+func (s *cyclicRouteSearch) recordCandidate() {
+	spotRate := osmomath.OneBigDec()
+	route := make(poolmanagertypes.SwapAmountInRoutes, 0, len(s.Hops))
+	for _, hop := range s.Hops {
+		spotRate = spotRate.Mul(hop.Rate)
+		route = append(route, hop.Hop)
+	}
+
+	if !spotRate.GT(osmomath.OneBigDec()) {
+		return
+	}
+
+	s.Candidates = append(s.Candidates, cyclicRouteCandidate{
+		Route:     route,
+		BaseDenom: s.BaseDenom,
+		SpotRate:  spotRate,
+	})
+}
+
+// getCyclicRouteHopRate returns the amount of token out received per token in when swapping on the given pool at the
+// spot price, net of the spread factor.
+func (k Keeper) getCyclicRouteHopRate(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (osmomath.BigDec, error) {
+	spreadFactor, err := k.poolmanagerKeeper.GetEffectiveSpreadFactor(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	// The spot price of the token in quoted in the token out
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, poolId, tokenOutDenom, tokenInDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return spotPrice.Mul(osmomath.BigDecFromDec(osmomath.OneDec().Sub(spreadFactor))), nil
+}
+
+// ---------------------- Cycle Finder Graph ---------------------- //
+
+// UpdateCyclicRouteGraph adds the given pool to the cycle finder graph if it is eligible, updates its liquidity if it is
+// already in the graph, and removes it from the graph if it is no longer eligible. A pool is eligible if it is active, has
+// two denoms and enough liquidity in one of the base denoms. If the graph is full, the least liquid pool of the graph is
+// evicted to make room for the pool if it is more liquid. The graph is only maintained while the cycle finder is enabled.
+func (k Keeper) UpdateCyclicRouteGraph(ctx sdk.Context, poolId uint64) {
+	if k.GetMaxCyclicRouteHops(ctx) == 0 {
+		return
+	}
+
+	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error("Protorev error getting pool denoms in UpdateCyclicRouteGraph: " + err.Error())
+		return
+	}
+	if len(denoms) != 2 {
+		return
+	}
+
+	liquidity, eligible, err := k.getCyclicRouteGraphLiquidity(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error("Protorev error checking pool eligibility in UpdateCyclicRouteGraph: " + err.Error())
+		return
+	}
+
+	inGraph := k.IsPoolInCyclicRouteGraph(ctx, poolId)
+	switch {
+	case eligible && inGraph:
+		k.setCyclicRouteGraphPool(ctx, poolId, denoms[0], denoms[1], liquidity)
+	case eligible && !inGraph:
+		poolCount := k.GetCyclicRouteGraphPoolCount(ctx)
+		if poolCount >= types.MaxCyclicRouteGraphPools {
+			leastLiquidPoolId, leastLiquidity, found := k.getLeastLiquidCyclicRouteGraphPool(ctx)
+			if !found || leastLiquidity >= liquidity {
+				return
+			}
+
+			leastLiquidPoolDenoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, leastLiquidPoolId)
+			if err != nil {
+				ctx.Logger().Error("Protorev error getting evicted pool denoms in UpdateCyclicRouteGraph: " + err.Error())
+				return
+			}
+			k.deleteCyclicRouteGraphPool(ctx, leastLiquidPoolId, leastLiquidPoolDenoms[0], leastLiquidPoolDenoms[1])
+			poolCount--
+		}
+		k.setCyclicRouteGraphPool(ctx, poolId, denoms[0], denoms[1], liquidity)
+		k.setCyclicRouteGraphPoolCount(ctx, poolCount+1)
+	case !eligible && inGraph:
+		k.deleteCyclicRouteGraphPool(ctx, poolId, denoms[0], denoms[1])
+		k.setCyclicRouteGraphPoolCount(ctx, k.GetCyclicRouteGraphPoolCount(ctx)-1)
+	}
+}
+
+// getCyclicRouteGraphLiquidity returns the liquidity of the given pool valued in uosmo, and whether the pool is active and
+// has enough liquidity to be stored in the cycle finder graph. The liquidity of a pool is valued as twice its amount of its
+// highest priority base denom that can be priced in uosmo, at the spot price of the highest liquidity pool between them,
+// and must be at least the min cyclic route graph liquidity.
+func (k Keeper) getCyclicRouteGraphLiquidity(ctx sdk.Context, poolId uint64) (uint64, bool, error) {
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return 0, false, err
+	}
+	if !pool.IsActive(ctx) {
+		return 0, false, nil
+	}
+
+	coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return 0, false, err
+	}
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, baseDenom := range baseDenoms {
+		amount := coins.AmountOf(baseDenom.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		price, found, err := k.getCyclicRouteGraphPrice(ctx, baseDenom.Denom)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found {
+			continue
+		}
+
+		liquidity := price.Dec().MulInt(amount.MulRaw(2)).TruncateInt()
+		if !liquidity.IsUint64() {
+			return math.MaxUint64, true, nil
+		}
+		return liquidity.Uint64(), liquidity.GTE(types.MinCyclicRouteGraphLiquidity), nil
+	}
+
+	return 0, false, nil
+}
+
+// getCyclicRouteGraphPrice returns the spot price of the given base denom quoted in uosmo, in the highest liquidity pool
+// between them. Returns false if there is no such pool.
+func (k Keeper) getCyclicRouteGraphPrice(ctx sdk.Context, baseDenom string) (osmomath.BigDec, bool, error) {
+	if baseDenom == types.OsmosisDenomination {
+		return osmomath.OneBigDec(), true, nil
+	}
+
+	poolId, err := k.GetPoolForDenomPair(ctx, types.OsmosisDenomination, baseDenom)
+	if err != nil {
+		return osmomath.BigDec{}, false, nil
+	}
+
+	swapModule, err := k.poolmanagerKeeper.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return osmomath.BigDec{}, false, err
+	}
+
+	spotPrice, err := swapModule.CalculateSpotPrice(ctx, poolId, types.OsmosisDenomination, baseDenom)
+	if err != nil {
+		return osmomath.BigDec{}, false, err
+	}
+
+	return spotPrice, true, nil
+}
+
+// IsPoolInCyclicRouteGraph returns whether the given pool is in the cycle finder graph.
+func (k Keeper) IsPoolInCyclicRouteGraph(ctx sdk.Context, poolId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetKeyPrefixCyclicRouteGraphPool(poolId))
+}
+
+// GetCyclicRouteGraphPoolCount returns the number of pools in the cycle finder graph.
+func (k Keeper) GetCyclicRouteGraphPoolCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCyclicRouteGraphPoolCount)
+	bz := store.Get(types.KeyPrefixCyclicRouteGraphPoolCount)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// DeleteCyclicRouteGraph removes all of the pools from the cycle finder graph.
+func (k Keeper) DeleteCyclicRouteGraph(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixCyclicRouteGraphPools)
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixCyclicRouteGraphEdges)
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixCyclicRouteGraphPoolsByLiquidity)
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixCyclicRouteGraphPoolCount)
+}
+
+// getCyclicRouteGraphEdges returns the pools of the cycle finder graph containing the given denom, sorted by pool id.
+func (k Keeper) getCyclicRouteGraphEdges(ctx sdk.Context, denom string) []cyclicRouteGraphEdge {
+	edges := make([]cyclicRouteGraphEdge, 0)
+
+	keyPrefix := types.GetKeyPrefixCyclicRouteGraphEdgesForDenom(denom)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		edges = append(edges, cyclicRouteGraphEdge{
+			PoolId:     sdk.BigEndianToUint64(iterator.Key()[len(keyPrefix):]),
+			OtherDenom: string(iterator.Value()),
+		})
+	}
+
+	return edges
+}
+
+// getLeastLiquidCyclicRouteGraphPool returns the pool of the cycle finder graph with the least liquidity, and its
+// liquidity. Returns false if the graph is empty.
+func (k Keeper) getLeastLiquidCyclicRouteGraphPool(ctx sdk.Context) (uint64, uint64, bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixCyclicRouteGraphPoolsByLiquidity)
+
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0, 0, false
+	}
+
+	key := iterator.Key()[len(types.KeyPrefixCyclicRouteGraphPoolsByLiquidity):]
+	return sdk.BigEndianToUint64(key[8:]), sdk.BigEndianToUint64(key[:8]), true
+}
+
+// setCyclicRouteGraphPool stores the given pool with the given liquidity in the cycle finder graph.
+func (k Keeper) setCyclicRouteGraphPool(ctx sdk.Context, poolId uint64, denomA, denomB string, liquidity uint64) {
+	store := ctx.KVStore(k.storeKey)
+	poolKey := types.GetKeyPrefixCyclicRouteGraphPool(poolId)
+	if bz := store.Get(poolKey); bz != nil {
+		store.Delete(types.GetKeyPrefixCyclicRouteGraphPoolByLiquidity(sdk.BigEndianToUint64(bz), poolId))
+	}
+
+	store.Set(poolKey, sdk.Uint64ToBigEndian(liquidity))
+	store.Set(types.GetKeyPrefixCyclicRouteGraphPoolByLiquidity(liquidity, poolId), []byte{})
+	store.Set(types.GetKeyPrefixCyclicRouteGraphEdge(denomA, poolId), []byte(denomB))
+	store.Set(types.GetKeyPrefixCyclicRouteGraphEdge(denomB, poolId), []byte(denomA))
+}
+
+// deleteCyclicRouteGraphPool removes the given pool from the cycle finder graph.
+func (k Keeper) deleteCyclicRouteGraphPool(ctx sdk.Context, poolId uint64, denomA, denomB string) {
+	store := ctx.KVStore(k.storeKey)
+	poolKey := types.GetKeyPrefixCyclicRouteGraphPool(poolId)
+	if bz := store.Get(poolKey); bz != nil {
+		store.Delete(types.GetKeyPrefixCyclicRouteGraphPoolByLiquidity(sdk.BigEndianToUint64(bz), poolId))
+	}
+
+	store.Delete(poolKey)
+	store.Delete(types.GetKeyPrefixCyclicRouteGraphEdge(denomA, poolId))
+	store.Delete(types.GetKeyPrefixCyclicRouteGraphEdge(denomB, poolId))
+}
+
+// setCyclicRouteGraphPoolCount sets the number of pools in the cycle finder graph.
+func (k Keeper) setCyclicRouteGraphPoolCount(ctx sdk.Context, poolCount uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCyclicRouteGraphPoolCount)
+	store.Set(types.KeyPrefixCyclicRouteGraphPoolCount, sdk.Uint64ToBigEndian(poolCount))
+}
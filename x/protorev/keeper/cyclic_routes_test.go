@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// TestBuildCyclicRoutes tests that the cycle finder maintains its graph from the pool hooks, finds the cyclic
+// routes through the swapped pool that are profitable at the spot price and charges its search to the pool points.
+func (s *KeeperTestSuite) TestBuildCyclicRoutes() {
+	protoRevKeeper := s.App.ProtoRevKeeper
+	s.Require().NoError(protoRevKeeper.SetMaxCyclicRouteHops(s.Ctx, types.MinCyclicRouteHops))
+
+	// buildCyclicRoutes builds the cyclic routes with the remaining pool points, and returns the pool points consumed by the search
+	buildCyclicRoutes := func(tokenIn, tokenOut string, poolId uint64) ([]keeper.RouteMetaData, uint64) {
+		remainingTxPoolPoints, remainingBlockPoolPoints, err := protoRevKeeper.GetRemainingPoolPoints(s.Ctx)
+		s.Require().NoError(err)
+		initialTxPoolPoints := remainingTxPoolPoints
+
+		routes, err := protoRevKeeper.BuildCyclicRoutes(s.Ctx, tokenIn, tokenOut, poolId, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+		s.Require().NoError(err)
+		return routes, initialTxPoolPoints - remainingTxPoolPoints
+	}
+
+	// The cycle uosmo -> Atom -> cycleA -> cycleB -> uosmo is profitable since cycleA is worth
+	// two cycleB in the swapped pool and one cycleB everywhere else
+	liquidity := osmomath.NewInt(10_000_000_000)
+	osmoAtomPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, liquidity), sdk.NewCoin("Atom", liquidity))
+	atomCycleAPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("Atom", liquidity), sdk.NewCoin("cycleA", liquidity))
+	cycleBOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("cycleB", liquidity), sdk.NewCoin(types.OsmosisDenomination, liquidity))
+	swappedPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("cycleA", liquidity), sdk.NewCoin("cycleB", liquidity.MulRaw(2)))
+
+	// Pools without enough liquidity in a base denom are not added to the graph
+	illiquidPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1_000)), sdk.NewCoin("cycleC", osmomath.NewInt(1_000)))
+	s.Require().False(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, illiquidPoolId))
+	for _, poolId := range []uint64{osmoAtomPoolId, atomCycleAPoolId, cycleBOsmoPoolId} {
+		s.Require().True(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, poolId))
+	}
+	s.Require().Equal(uint64(3), protoRevKeeper.GetCyclicRouteGraphPoolCount(s.Ctx))
+
+	// The swap of cycleB for cycleA is backrun by swapping cycleA for cycleB on the swapped pool.
+	// The cycle is found starting from both base denoms it goes through.
+	pointCountForBlock, err := protoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+	routes, searchPoolPoints := buildCyclicRoutes("cycleB", "cycleA", swappedPoolId)
+	s.Require().Len(routes, 2)
+
+	// The few pools explored by the search consume a single pool point, which is also counted for the block
+	s.Require().Equal(uint64(1), searchPoolPoints)
+	newPointCountForBlock, err := protoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(pointCountForBlock+1, newPointCountForBlock)
+
+	osmoRoute := poolmanagertypes.SwapAmountInRoutes{
+		{PoolId: osmoAtomPoolId, TokenOutDenom: "Atom"},
+		{PoolId: atomCycleAPoolId, TokenOutDenom: "cycleA"},
+		{PoolId: swappedPoolId, TokenOutDenom: "cycleB"},
+		{PoolId: cycleBOsmoPoolId, TokenOutDenom: types.OsmosisDenomination},
+	}
+	atomRoute := poolmanagertypes.SwapAmountInRoutes{
+		{PoolId: atomCycleAPoolId, TokenOutDenom: "cycleA"},
+		{PoolId: swappedPoolId, TokenOutDenom: "cycleB"},
+		{PoolId: cycleBOsmoPoolId, TokenOutDenom: types.OsmosisDenomination},
+		{PoolId: osmoAtomPoolId, TokenOutDenom: "Atom"},
+	}
+	s.Require().ElementsMatch([]poolmanagertypes.SwapAmountInRoutes{osmoRoute, atomRoute}, []poolmanagertypes.SwapAmountInRoutes{routes[0].Route, routes[1].Route})
+	for _, route := range routes {
+		expectedPoolPoints, err := protoRevKeeper.CalculateRoutePoolPoints(s.Ctx, route.Route)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedPoolPoints, route.PoolPoints)
+	}
+
+	// The swap in the opposite direction is not backrun since the cycle is not profitable in that direction
+	routes, _ = buildCyclicRoutes("cycleA", "cycleB", swappedPoolId)
+	s.Require().Empty(routes)
+
+	// Routes are only returned as long as they fit together in the pool points remaining after the search
+	osmoRoutePoolPoints, err := protoRevKeeper.CalculateRoutePoolPoints(s.Ctx, osmoRoute)
+	s.Require().NoError(err)
+	s.Require().NoError(protoRevKeeper.SetMaxPointsPerTx(s.Ctx, 1+osmoRoutePoolPoints))
+	routes, _ = buildCyclicRoutes("cycleB", "cycleA", swappedPoolId)
+	s.Require().Len(routes, 1)
+
+	// Routes consuming more pool points than remain in the tx are not returned
+	s.Require().NoError(protoRevKeeper.SetMaxPointsPerTx(s.Ctx, 1))
+	routes, _ = buildCyclicRoutes("cycleB", "cycleA", swappedPoolId)
+	s.Require().Empty(routes)
+	s.Require().NoError(protoRevKeeper.SetMaxPointsPerTx(s.Ctx, 18))
+
+	// Pools are removed from the graph once liquidity is withdrawn below the min liquidity
+	_, err = s.App.GAMMKeeper.ExitPool(s.Ctx, s.TestAccs[0], cycleBOsmoPoolId, gammtypes.OneShare.MulRaw(60), sdk.NewCoins())
+	s.Require().NoError(err)
+	s.Require().False(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, cycleBOsmoPoolId))
+	s.Require().Equal(uint64(2), protoRevKeeper.GetCyclicRouteGraphPoolCount(s.Ctx))
+
+	// The liquidity of the pools is valued in uosmo whatever their base denom. Both pools hold as much of their base
+	// denom, but Atom is worth less than uosmo in the highest liquidity Atom/uosmo pool.
+	osmoAtomLiquidity, _, err := protoRevKeeper.GetCyclicRouteGraphLiquidity(s.Ctx, osmoAtomPoolId)
+	s.Require().NoError(err)
+	atomCycleALiquidity, _, err := protoRevKeeper.GetCyclicRouteGraphLiquidity(s.Ctx, atomCycleAPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(liquidity.MulRaw(2).Uint64(), osmoAtomLiquidity)
+	s.Require().Less(atomCycleALiquidity, osmoAtomLiquidity)
+
+	// The valuation does not depend on the step sizes of the base denoms
+	baseDenoms, err := protoRevKeeper.GetAllBaseDenoms(s.Ctx)
+	s.Require().NoError(err)
+	for i := range baseDenoms {
+		baseDenoms[i].StepSize = baseDenoms[i].StepSize.MulRaw(1_000)
+	}
+	s.Require().NoError(protoRevKeeper.SetBaseDenoms(s.Ctx, baseDenoms))
+	newAtomCycleALiquidity, _, err := protoRevKeeper.GetCyclicRouteGraphLiquidity(s.Ctx, atomCycleAPoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(atomCycleALiquidity, newAtomCycleALiquidity)
+
+	// Once the graph is full, the least liquid pool is evicted for a more liquid pool
+	protoRevKeeper.SetCyclicRouteGraphPoolCount(s.Ctx, types.MaxCyclicRouteGraphPools)
+	moreLiquidPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, liquidity.MulRaw(2)), sdk.NewCoin("cycleD", liquidity))
+	s.Require().True(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, moreLiquidPoolId))
+	s.Require().False(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, atomCycleAPoolId))
+	s.Require().True(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, osmoAtomPoolId))
+	s.Require().Equal(types.MaxCyclicRouteGraphPools, protoRevKeeper.GetCyclicRouteGraphPoolCount(s.Ctx))
+
+	// A pool that is not more liquid than the least liquid pool of the full graph is not added
+	equallyLiquidPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, liquidity), sdk.NewCoin("cycleE", liquidity))
+	s.Require().False(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, equallyLiquidPoolId))
+	s.Require().True(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, osmoAtomPoolId))
+
+	// Disabling the cycle finder clears its graph
+	server := keeper.NewMsgServer(*s.App.AppKeepers.ProtoRevKeeper)
+	_, err = server.SetMaxCyclicRouteHops(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxCyclicRouteHops(s.adminAccount.String(), 0))
+	s.Require().NoError(err)
+	s.Require().Zero(protoRevKeeper.GetMaxCyclicRouteHops(s.Ctx))
+	s.Require().Zero(protoRevKeeper.GetCyclicRouteGraphPoolCount(s.Ctx))
+	s.Require().False(protoRevKeeper.IsPoolInCyclicRouteGraph(s.Ctx, osmoAtomPoolId))
+	routes, _ = buildCyclicRoutes("cycleB", "cycleA", swappedPoolId)
+	s.Require().Empty(routes)
+
+	// Only the admin can set the max cyclic route hops
+	_, err = server.SetMaxCyclicRouteHops(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxCyclicRouteHops(s.TestAccs[0].String(), types.MinCyclicRouteHops))
+	s.Require().Error(err)
+}
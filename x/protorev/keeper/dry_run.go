@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// backrunTrace records the routes tried to backrun each swapped pool by ProtoRevTrade when executed by DryRun.
+type backrunTrace struct {
+	backruns []types.DryRunBackrun
+
+	// optimalRoute is the index of the most profitable route tried to backrun the current pool, or -1 if there is none.
+	optimalRoute int
+}
+
+// DryRun executes a hypothetical swap of the given coin along the given route in a cache context and then runs the
+// module against it exactly as the posthandler would after a tx, without committing any state. The swap is executed
+// by the module account with minted coins. It returns the routes tried to backrun the swap on each pool, with their
+// optimal input and expected profit, and the number of pool points consumed by the routes.
+//
+// Returns error if the module would not run or if the swap fails.
+func (k Keeper) DryRun(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, tokenIn sdk.Coin) (backruns []types.DryRunBackrun, poolPointsUsed uint64, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			backruns, poolPointsUsed = nil, 0
+			err = fmt.Errorf("function DryRun failed due to internal reason: %v", r)
+		}
+	}()
+
+	if err := route.Validate(); err != nil {
+		return nil, 0, err
+	}
+	if err := tokenIn.Validate(); err != nil {
+		return nil, 0, err
+	}
+	if !tokenIn.IsPositive() {
+		return nil, 0, fmt.Errorf("token in must be positive, got %s", tokenIn)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.AnteHandleCheck(cacheCtx); err != nil {
+		return nil, 0, err
+	}
+
+	// Only the hypothetical swap is backrun
+	k.DeleteSwapsToBackrun(cacheCtx)
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return nil, 0, err
+	}
+	if _, err := k.poolmanagerKeeper.RouteExactAmountIn(cacheCtx, k.accountKeeper.GetModuleAddress(types.ModuleName), route, tokenIn, osmomath.OneInt()); err != nil {
+		return nil, 0, err
+	}
+
+	poolPointsBefore, err := k.GetPointCountForBlock(cacheCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	trace := &backrunTrace{}
+	if err := k.protoRevTrade(cacheCtx, k.ExtractSwappedPools(cacheCtx), trace); err != nil {
+		return nil, 0, err
+	}
+
+	poolPointsAfter, err := k.GetPointCountForBlock(cacheCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return trace.backruns, poolPointsAfter - poolPointsBefore, nil
+}
+
+// beginBackrun records that the routes tried next backrun the given swapped pool.
+func (t *backrunTrace) beginBackrun(pool SwapToBackrun) {
+	t.backruns = append(t.backruns, types.DryRunBackrun{
+		PoolId:   pool.PoolId,
+		TokenIn:  pool.TokenInDenom,
+		TokenOut: pool.TokenOutDenom,
+		Routes:   []types.DryRunRoute{},
+	})
+	t.optimalRoute = -1
+}
+
+// addRoute records the outcome of the search for the optimal input of the given route.
+func (t *backrunTrace) addRoute(route RouteMetaData, inputCoin sdk.Coin, profit osmomath.Int, err error) {
+	// Unprofitable routes have no input coin
+	if inputCoin.Amount.IsNil() {
+		inputCoin = sdk.NewCoin(route.Route[route.Route.Length()-1].TokenOutDenom, osmomath.ZeroInt())
+	}
+
+	dryRunRoute := types.DryRunRoute{
+		Route:        route.Route,
+		PoolPoints:   route.PoolPoints,
+		OptimalInput: inputCoin,
+		Profit:       profit,
+	}
+	if err != nil {
+		dryRunRoute.Error = err.Error()
+	}
+
+	backrun := &t.backruns[len(t.backruns)-1]
+	backrun.Routes = append(backrun.Routes, dryRunRoute)
+}
+
+// setOptimalRoute records that the last route tried is the most profitable route so far.
+func (t *backrunTrace) setOptimalRoute() {
+	t.optimalRoute = len(t.backruns[len(t.backruns)-1].Routes) - 1
+}
+
+// markOptimalRouteExecuted records that the most profitable route tried to backrun the current pool was executed.
+func (t *backrunTrace) markOptimalRouteExecuted() {
+	if t.optimalRoute >= 0 {
+		t.backruns[len(t.backruns)-1].Routes[t.optimalRoute].Executed = true
+	}
+}
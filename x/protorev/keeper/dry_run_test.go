@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// TestDryRun tests that a dry run reports the routes tried to backrun a hypothetical swap without committing any state.
+func (s *KeeperTestSuite) TestDryRun() {
+	protoRevKeeper := s.App.ProtoRevKeeper
+	tokenInDenom := "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0"
+	tokenOutDenom := "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC"
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 23, TokenOutDenom: tokenOutDenom}}
+	tokenIn := sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000))
+
+	liquidityBefore, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, 23)
+	s.Require().NoError(err)
+	pointCountBefore, err := protoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+
+	backruns, poolPointsUsed, err := protoRevKeeper.DryRun(s.Ctx, route, tokenIn)
+	s.Require().NoError(err)
+	s.Require().Len(backruns, 1)
+	s.Require().Equal(uint64(23), backruns[0].PoolId)
+	s.Require().Equal(tokenInDenom, backruns[0].TokenIn)
+	s.Require().Equal(tokenOutDenom, backruns[0].TokenOut)
+	s.Require().NotEmpty(backruns[0].Routes)
+
+	// Only the most profitable route is executed
+	executedRoutes := 0
+	for _, dryRunRoute := range backruns[0].Routes {
+		s.Require().Empty(dryRunRoute.Error)
+		if dryRunRoute.Executed {
+			executedRoutes++
+			s.Require().True(dryRunRoute.Profit.IsPositive())
+			s.Require().True(dryRunRoute.OptimalInput.IsPositive())
+		}
+	}
+	s.Require().Equal(1, executedRoutes)
+	s.Require().Positive(poolPointsUsed)
+
+	// No state is committed
+	liquidityAfter, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, 23)
+	s.Require().NoError(err)
+	s.Require().Equal(liquidityBefore, liquidityAfter)
+	pointCountAfter, err := protoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(pointCountBefore, pointCountAfter)
+	_, err = protoRevKeeper.GetNumberOfTrades(s.Ctx)
+	s.Require().Error(err)
+
+	// The swap must be valid
+	_, _, err = protoRevKeeper.DryRun(s.Ctx, poolmanagertypes.SwapAmountInRoutes{}, tokenIn)
+	s.Require().Error(err)
+	_, _, err = protoRevKeeper.DryRun(s.Ctx, route, sdk.NewCoin(tokenInDenom, osmomath.ZeroInt()))
+	s.Require().Error(err)
+
+	// Nothing is tried if the module is disabled
+	protoRevKeeper.SetProtoRevEnabled(s.Ctx, false)
+	_, _, err = protoRevKeeper.DryRun(s.Ctx, route, tokenIn)
+	s.Require().Error(err)
+	protoRevKeeper.SetProtoRevEnabled(s.Ctx, true)
+
+	// The same dry run can be queried
+	res, err := s.queryClient.GetProtoRevDryRun(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevDryRunRequest{TokenIn: tokenIn.String(), Route: route})
+	s.Require().NoError(err)
+	s.Require().Len(res.Backruns, 1)
+	s.Require().Len(res.Backruns[0].Routes, len(backruns[0].Routes))
+	for index, dryRunRoute := range res.Backruns[0].Routes {
+		s.Require().Equal(backruns[0].Routes[index].Executed, dryRunRoute.Executed)
+		s.Require().True(backruns[0].Routes[index].Profit.Equal(dryRunRoute.Profit))
+	}
+	s.Require().Equal(poolPointsUsed, res.PoolPointsUsed)
+
+	_, err = s.queryClient.GetProtoRevDryRun(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevDryRunRequest{TokenIn: "invalid", Route: route})
+	s.Require().Error(err)
+}
@@ -16,7 +16,7 @@ import (
 // EmitBackrunEvent updates and emits a backrunEvent
 func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, profit, tokenOutAmount osmomath.Int, remainingTxPoolPoints, remainingBlockPoolPoints uint64) {
 	// Get tx hash
-	txHash := strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+	txHash := getTxHash(ctx)
 	// Update the backrun event and add it to the context
 	backrunEvent := sdk.NewEvent(
 		types.TypeEvtBackrun,
@@ -34,3 +34,8 @@ func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, p
 	)
 	ctx.EventManager().EmitEvent(backrunEvent)
 }
+
+// getTxHash returns the hash of the tx being executed
+func getTxHash(ctx sdk.Context) string {
+	return strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+}
@@ -30,6 +30,11 @@ func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, ep
 
 // AfterEpochEnd is the epoch end hook.
 func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	// Prune the profit attribution even if the module is disabled, so that it does not outlive its retention period
+	if epochIdentifier == "day" {
+		h.k.PruneProfitAttribution(ctx)
+	}
+
 	if h.k.GetProtoRevEnabled(ctx) {
 		switch epochIdentifier {
 		case "day":
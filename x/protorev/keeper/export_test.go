@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// FindMaxProfitWithBinarySearch runs the binary search for the max profit of the route.
+func (k Keeper) FindMaxProfitWithBinarySearch(ctx sdk.Context, route RouteMetaData) (sdk.Coin, osmomath.Int, error) {
+	return k.findMaxProfitWithBinarySearch(ctx, route, route.Route[route.Route.Length()-1].TokenOutDenom)
+}
+
+// FindMaxProfitInClosedForm runs the closed form search for the max profit of the route. Returns false if
+// the swaps of the route cannot be modelled.
+func (k Keeper) FindMaxProfitInClosedForm(ctx sdk.Context, route RouteMetaData) (sdk.Coin, osmomath.Int, bool, error) {
+	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
+	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, route.StepSize, route.Route)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), false, err
+	}
+
+	model, ok := k.getRouteSwapModel(ctx, route.Route, inputDenom)
+	if !ok {
+		return sdk.Coin{}, osmomath.ZeroInt(), false, nil
+	}
+
+	tokenIn, profit, err := k.findMaxProfitInClosedForm(ctx, route, inputDenom, model, minInProfit)
+	return tokenIn, profit, true, err
+}
+
+// SetCyclicRouteGraphPoolCount sets the number of pools in the cycle finder graph.
+func (k Keeper) SetCyclicRouteGraphPoolCount(ctx sdk.Context, poolCount uint64) {
+	k.setCyclicRouteGraphPoolCount(ctx, poolCount)
+}
+
+// GetCyclicRouteGraphLiquidity returns the liquidity of the given pool valued in uosmo.
+func (k Keeper) GetCyclicRouteGraphLiquidity(ctx sdk.Context, poolId uint64) (uint64, bool, error) {
+	return k.getCyclicRouteGraphLiquidity(ctx, poolId)
+}
@@ -77,6 +77,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	// Set the number of pool points that have been consumed in the current block.
 	k.SetPointCountForBlock(ctx, genState.PointCountForBlock)
 
+	// Configure the max number of hops of the routes found by the cycle finder. The cycle finder graph
+	// is not part of genesis, it is rebuilt from the pools that are created and swapped on.
+	if err := k.SetMaxCyclicRouteHops(ctx, genState.MaxCyclicRouteHops); err != nil {
+		panic(err)
+	}
+
 	// Configure the pool info for genesis.
 	k.SetInfoByPoolType(ctx, genState.InfoByPoolType)
 
@@ -166,6 +172,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		genesis.PointCountForBlock = pointCount
 	}
 
+	// Export the max number of hops of the routes found by the cycle finder.
+	genesis.MaxCyclicRouteHops = k.GetMaxCyclicRouteHops(ctx)
+
 	// Export the profits that have been collected by Protorev.
 	genesis.Profits = k.GetAllProfits(ctx)
 
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -254,3 +256,88 @@ func (q Querier) GetAllProtocolRevenue(c context.Context, req *types.QueryGetAll
 
 	return &types.QueryGetAllProtocolRevenueResponse{AllProtocolRevenue: allProtocolRevenue}, nil
 }
+
+// GetProtoRevMaxCyclicRouteHops queries the maximum number of hops of the cyclic routes found by the cycle finder
+func (q Querier) GetProtoRevMaxCyclicRouteHops(c context.Context, req *types.QueryGetProtoRevMaxCyclicRouteHopsRequest) (*types.QueryGetProtoRevMaxCyclicRouteHopsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevMaxCyclicRouteHopsResponse{MaxCyclicRouteHops: q.Keeper.GetMaxCyclicRouteHops(ctx)}, nil
+}
+
+// GetProtoRevBackrunRecords queries the backruns executed by the module that have not been pruned yet
+func (q Querier) GetProtoRevBackrunRecords(c context.Context, req *types.QueryGetProtoRevBackrunRecordsRequest) (*types.QueryGetProtoRevBackrunRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records := []types.BackrunRecord{}
+	recordStore := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.KeyPrefixBackrunRecords)
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(_, value []byte) error {
+		record, err := parseBackrunRecord(value)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevBackrunRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// GetProtoRevTopTriggerPools queries the pools whose swaps triggered the most backruns over the most recent days
+func (q Querier) GetProtoRevTopTriggerPools(c context.Context, req *types.QueryGetProtoRevTopTriggerPoolsRequest) (*types.QueryGetProtoRevTopTriggerPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	statistics, err := q.Keeper.GetTopTriggerPools(ctx, req.Days, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetProtoRevTopTriggerPoolsResponse{Statistics: statistics}, nil
+}
+
+// GetProtoRevProfitShareByTriggerPool queries the share of the backrun profits made after swaps on each pool over the most recent days
+func (q Querier) GetProtoRevProfitShareByTriggerPool(c context.Context, req *types.QueryGetProtoRevProfitShareByTriggerPoolRequest) (*types.QueryGetProtoRevProfitShareByTriggerPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	profitShares, totalProfits, err := q.Keeper.GetProfitSharesByTriggerPool(ctx, req.Days)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitShareByTriggerPoolResponse{ProfitShares: profitShares, TotalProfits: totalProfits}, nil
+}
+
+// GetProtoRevDryRun queries the backruns the module would try after a hypothetical swap, without committing any state
+func (q Querier) GetProtoRevDryRun(c context.Context, req *types.QueryGetProtoRevDryRunRequest) (*types.QueryGetProtoRevDryRunResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	backruns, poolPointsUsed, err := q.Keeper.DryRun(ctx, req.Route, tokenIn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevDryRunResponse{Backruns: backruns, PoolPointsUsed: poolPointsUsed}, nil
+}
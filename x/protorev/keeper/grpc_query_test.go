@@ -446,3 +446,31 @@ func (s *KeeperTestSuite) TestGetAllProtocolRevenueGRPCQuery() {
 	s.Require().Equal(expectedTakerFeeToStakers.Add(expectedTakerFeeToStakers...), res.AllProtocolRevenue.TakerFeesTracker.TakerFeesToStakers)
 	s.Require().Equal(expectedTakerFeeToCommunityPool.Add(expectedTakerFeeToCommunityPool...), res.AllProtocolRevenue.TakerFeesTracker.TakerFeesToCommunityPool)
 }
+
+// TestGetProtoRevProfitAttributionQueries tests the queries for the backrun records and their aggregation by trigger pool
+func (s *KeeperTestSuite) TestGetProtoRevProfitAttributionQueries() {
+	s.pseudoExecuteBackrun(1, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(300)))
+	s.pseudoExecuteBackrun(2, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+	s.pseudoExecuteBackrun(2, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+
+	recordsRes, err := s.queryClient.GetProtoRevBackrunRecords(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevBackrunRecordsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(recordsRes.Records, 3)
+	s.Require().Equal([]uint64{1, 2, 2}, []uint64{recordsRes.Records[0].PoolId, recordsRes.Records[1].PoolId, recordsRes.Records[2].PoolId})
+
+	topPoolsRes, err := s.queryClient.GetProtoRevTopTriggerPools(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevTopTriggerPoolsRequest{Days: 1, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(topPoolsRes.Statistics, 1)
+	s.Require().Equal(uint64(2), topPoolsRes.Statistics[0].PoolId)
+	s.Require().Equal(uint64(2), topPoolsRes.Statistics[0].NumberOfBackruns)
+
+	profitShareRes, err := s.queryClient.GetProtoRevProfitShareByTriggerPool(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevProfitShareByTriggerPoolRequest{Days: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(500))), profitShareRes.TotalProfits)
+	s.Require().Len(profitShareRes.ProfitShares, 2)
+	s.Require().True(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.OsmosisDenomination, osmomath.NewDecWithPrec(6, 1))).Equal(profitShareRes.ProfitShares[0].ProfitShares))
+
+	// The number of days must be set
+	_, err = s.queryClient.GetProtoRevTopTriggerPools(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevTopTriggerPoolsRequest{})
+	s.Require().Error(err)
+}
@@ -25,13 +25,16 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 // GAMM HOOKS
 // ----------------------------------------------------------------------------
 
-// AfterCFMMPoolCreated hook checks and potentially stores the pool via the highest liquidity method.
+// AfterCFMMPoolCreated hook checks and potentially stores the pool via the highest liquidity method and in the cycle finder graph.
 func (h Hooks) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	h.k.AfterPoolCreatedWithCoins(ctx, poolId)
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
 }
 
-// AfterJoinPool stores swaps to be checked by protorev given the coins entered into the pool.
+// AfterJoinPool stores swaps to be checked by protorev given the coins entered into the pool and updates the pool in the cycle finder graph.
 func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
+
 	// Checked to avoid future unintended behavior based on how the hook is called
 	if len(enterCoins) != 1 {
 		return
@@ -40,8 +43,10 @@ func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreJoinExitPoolSwaps(ctx, sender, poolId, enterCoins[0].Denom, true)
 }
 
-// AfterExitPool stores swaps to be checked by protorev given the coins exited from the pool.
+// AfterExitPool stores swaps to be checked by protorev given the coins exited from the pool and updates the pool in the cycle finder graph.
 func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins) {
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
+
 	// Added due to ExitSwapShareAmountIn both calling
 	// ExitPoolHook with all denoms of the pool and then also
 	// Swapping which triggers the after swap hook.
@@ -53,7 +58,7 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	h.k.StoreJoinExitPoolSwaps(ctx, sender, poolId, exitCoins[0].Denom, false)
 }
 
-// AfterCFMMSwap stores swaps to be checked by protorev given the coins swapped in the pool.
+// AfterCFMMSwap stores swaps to be checked by protorev given the coins swapped in the pool and updates the pool in the cycle finder graph.
 func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	// Checked to avoid future unintended behavior based on how the hook is called
 	if len(input) != 1 || len(output) != 1 {
@@ -61,6 +66,7 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 	}
 
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
 }
 
 // ----------------------------------------------------------------------------
@@ -71,16 +77,23 @@ func (h Hooks) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 }
 
-// AfterInitialPoolPositionCreated checks and potentially stores the pool via the highest liquidity method.
+// AfterInitialPoolPositionCreated checks and potentially stores the pool via the highest liquidity method and in the cycle finder graph.
 func (h Hooks) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
 	h.k.AfterPoolCreatedWithCoins(ctx, poolId)
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
 }
 
-// AfterLastPoolPositionRemoved is a noop.
+// AfterLastPoolPositionRemoved removes the pool from the cycle finder graph.
 func (h Hooks) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
+}
+
+// AfterPoolPositionWithdrawn updates the pool in the cycle finder graph.
+func (h Hooks) AfterPoolPositionWithdrawn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
 }
 
-// AfterConcentratedPoolSwap stores swaps to be checked by protorev given the coins swapped in the pool.
+// AfterConcentratedPoolSwap stores swaps to be checked by protorev given the coins swapped in the pool and updates the pool in the cycle finder graph.
 func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	// Checked to avoid future unintended behavior based on how the hook is called
 	if len(input) != 1 || len(output) != 1 {
@@ -88,6 +101,7 @@ func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress,
 	}
 
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
+	h.k.UpdateCyclicRouteGraph(ctx, poolId)
 }
 
 // ----------------------------------------------------------------------------
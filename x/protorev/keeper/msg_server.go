@@ -164,6 +164,29 @@ func (m MsgServer) SetBaseDenoms(c context.Context, msg *types.MsgSetBaseDenoms)
 	return &types.MsgSetBaseDenomsResponse{}, nil
 }
 
+// SetMaxCyclicRouteHops sets the maximum number of hops of the cyclic routes found by the cycle finder
+func (m MsgServer) SetMaxCyclicRouteHops(c context.Context, msg *types.MsgSetMaxCyclicRouteHops) (*types.MsgSetMaxCyclicRouteHopsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	// Set the max cyclic route hops
+	if err := m.k.SetMaxCyclicRouteHops(ctx, msg.MaxCyclicRouteHops); err != nil {
+		return nil, err
+	}
+
+	// The cycle finder graph is not maintained while the cycle finder is disabled, so it is cleared to be rebuilt
+	// from scratch once the cycle finder is enabled again
+	if msg.MaxCyclicRouteHops == 0 {
+		m.k.DeleteCyclicRouteGraph(ctx)
+	}
+
+	return &types.MsgSetMaxCyclicRouteHopsResponse{}, nil
+}
+
 // AdminCheck ensures that the sender is the admin account.
 func (m MsgServer) AdminCheck(ctx sdk.Context, admin string) error {
 	sender, err := sdk.AccAddressFromBech32(admin)
@@ -117,6 +117,12 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 		}
 	}()
 
+	return k.protoRevTrade(ctx, swappedPools, nil)
+}
+
+// protoRevTrade implements ProtoRevTrade.
+// If trace is not nil, the routes tried to backrun each pool are recorded in it.
+func (k Keeper) protoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun, trace *backrunTrace) error {
 	// Get the total number of pool points that can be consumed in this transaction
 	remainingTxPoolPoints, remainingBlockPoolPoints, err := k.GetRemainingPoolPoints(ctx)
 	if err != nil {
@@ -126,16 +132,24 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 	// Iterate and build arbitrage routes for each pool that was swapped on
 	for _, pool := range swappedPools {
 		// Build the routes for the pool that was swapped on
-		routes := k.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
+		routes := k.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+
+		if trace != nil {
+			trace.beginBackrun(pool)
+		}
 
 		// Find optimal route (input coin, profit, route) for the given routes
-		maxProfitInputCoin, maxProfitAmount, optimalRoute := k.IterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+		maxProfitInputCoin, maxProfitAmount, optimalRoute := k.iterateRoutes(ctx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints, trace)
 
 		// The error that returns here is particularly focused on the minting/burning of coins, and the execution of the MultiHopSwapExactAmountIn.
 		if maxProfitAmount.GT(osmomath.ZeroInt()) {
 			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, pool, remainingTxPoolPoints, remainingBlockPoolPoints); err != nil {
 				return err
 			}
+
+			if trace != nil {
+				trace.markOptimalRouteExecuted()
+			}
 		}
 	}
 
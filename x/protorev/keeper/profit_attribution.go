@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// ----------------------- Profit Attribution Stores  ----------------------- //
+
+// profitAttributionBucketStartTime returns the start time of the time bucket the given time falls in.
+func profitAttributionBucketStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(types.ProfitAttributionBucketDuration)
+}
+
+// RecordBackrun records a backrun executed after a swap on the given pool, attributing it to the current tx, and adds
+// it to the statistics of the pool for the current time bucket. Must be called after the number of trades is incremented.
+func (k Keeper) RecordBackrun(ctx sdk.Context, pool SwapToBackrun, route poolmanagertypes.SwapAmountInRoutes, profit sdk.Coin) error {
+	// The number of trades executed uniquely identifies the backrun
+	tradeNumber, err := k.GetNumberOfTrades(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	record := types.BackrunRecord{
+		TxHash:      getTxHash(ctx),
+		PoolId:      pool.PoolId,
+		TokenIn:     pool.TokenInDenom,
+		TokenOut:    pool.TokenOutDenom,
+		Route:       route.PoolIds(),
+		Profit:      profit,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().UTC(),
+	}
+	osmoutils.MustSet(store, types.GetKeyPrefixBackrunRecord(ctx.BlockTime(), tradeNumber.Uint64()), &record)
+
+	key := types.GetKeyPrefixTriggerPoolStatistics(profitAttributionBucketStartTime(ctx.BlockTime()), pool.PoolId)
+	statistics := types.TriggerPoolStatistics{}
+	found, err := osmoutils.Get(store, key, &statistics)
+	if err != nil {
+		return err
+	}
+	if !found {
+		statistics = types.TriggerPoolStatistics{PoolId: pool.PoolId, Profits: sdk.NewCoins()}
+	}
+
+	statistics.NumberOfBackruns++
+	statistics.Profits = statistics.Profits.Add(profit)
+	osmoutils.MustSet(store, key, &statistics)
+	return nil
+}
+
+// GetAllBackrunRecords returns all of the backrun records that have not been pruned, ordered by execution.
+func (k Keeper) GetAllBackrunRecords(ctx sdk.Context) ([]types.BackrunRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixBackrunRecords, parseBackrunRecord)
+}
+
+// GetTriggerPoolStatistics returns the statistics of the backruns triggered by swaps on each pool over the given number
+// of most recent time buckets, including the current one, ordered by pool id.
+func (k Keeper) GetTriggerPoolStatistics(ctx sdk.Context, days uint64) ([]types.TriggerPoolStatistics, error) {
+	if days == 0 || days > types.ProfitAttributionRetentionDays {
+		return nil, fmt.Errorf("days must be between 1 and %d, got %d", types.ProfitAttributionRetentionDays, days)
+	}
+
+	currentBucketStartTime := profitAttributionBucketStartTime(ctx.BlockTime())
+	startKey := types.GetKeyPrefixTriggerPoolStatisticsByBucket(currentBucketStartTime.Add(-time.Duration(days-1) * types.ProfitAttributionBucketDuration))
+	endKey := types.GetKeyPrefixTriggerPoolStatisticsByBucket(currentBucketStartTime.Add(types.ProfitAttributionBucketDuration))
+	bucketStatistics, err := osmoutils.GatherValuesFromStore(ctx.KVStore(k.storeKey), startKey, endKey, parseTriggerPoolStatistics)
+	if err != nil {
+		return nil, err
+	}
+
+	// Aggregate the statistics of each pool across the time buckets
+	statisticsByPool := make(map[uint64]types.TriggerPoolStatistics)
+	for _, statistics := range bucketStatistics {
+		aggregated, ok := statisticsByPool[statistics.PoolId]
+		if !ok {
+			aggregated = types.TriggerPoolStatistics{PoolId: statistics.PoolId, Profits: sdk.NewCoins()}
+		}
+
+		aggregated.NumberOfBackruns += statistics.NumberOfBackruns
+		aggregated.Profits = aggregated.Profits.Add(statistics.Profits...)
+		statisticsByPool[statistics.PoolId] = aggregated
+	}
+
+	poolStatistics := make([]types.TriggerPoolStatistics, 0, len(statisticsByPool))
+	for _, statistics := range statisticsByPool {
+		poolStatistics = append(poolStatistics, statistics)
+	}
+	sort.Slice(poolStatistics, func(i, j int) bool {
+		return poolStatistics[i].PoolId < poolStatistics[j].PoolId
+	})
+
+	return poolStatistics, nil
+}
+
+// GetTopTriggerPools returns the statistics of the pools whose swaps triggered the most backruns over the given number
+// of most recent time buckets, ordered by decreasing number of backruns and then by pool id. A limit of 0 returns all pools.
+func (k Keeper) GetTopTriggerPools(ctx sdk.Context, days, limit uint64) ([]types.TriggerPoolStatistics, error) {
+	poolStatistics, err := k.GetTriggerPoolStatistics(ctx, days)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(poolStatistics, func(i, j int) bool {
+		return poolStatistics[i].NumberOfBackruns > poolStatistics[j].NumberOfBackruns
+	})
+
+	if limit != 0 && limit < uint64(len(poolStatistics)) {
+		poolStatistics = poolStatistics[:limit]
+	}
+
+	return poolStatistics, nil
+}
+
+// GetProfitSharesByTriggerPool returns the share of the profits of all backruns made by the backruns triggered by swaps
+// on each pool over the given number of most recent time buckets, ordered by pool id, alongside the total profits.
+func (k Keeper) GetProfitSharesByTriggerPool(ctx sdk.Context, days uint64) ([]types.TriggerPoolProfitShare, sdk.Coins, error) {
+	poolStatistics, err := k.GetTriggerPoolStatistics(ctx, days)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalProfits := sdk.NewCoins()
+	for _, statistics := range poolStatistics {
+		totalProfits = totalProfits.Add(statistics.Profits...)
+	}
+
+	profitShares := make([]types.TriggerPoolProfitShare, len(poolStatistics))
+	for index, statistics := range poolStatistics {
+		// The profits are sorted by denom, so the shares are as well
+		shares := sdk.DecCoins{}
+		for _, profit := range statistics.Profits {
+			share := profit.Amount.ToLegacyDec().Quo(totalProfits.AmountOf(profit.Denom).ToLegacyDec())
+			shares = append(shares, sdk.NewDecCoinFromDec(profit.Denom, share))
+		}
+
+		profitShares[index] = types.TriggerPoolProfitShare{
+			Statistics:   statistics,
+			ProfitShares: shares,
+		}
+	}
+
+	return profitShares, totalProfits, nil
+}
+
+// PruneProfitAttribution deletes the backrun records and trigger pool statistics of the time buckets that are older
+// than the retention period.
+func (k Keeper) PruneProfitAttribution(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	cutoff := profitAttributionBucketStartTime(ctx.BlockTime()).Add(-time.Duration(types.ProfitAttributionRetentionDays-1) * types.ProfitAttributionBucketDuration)
+
+	deleteKeysInRange(store, types.KeyPrefixBackrunRecords, types.GetKeyPrefixBackrunRecordsByTime(cutoff))
+	deleteKeysInRange(store, types.KeyPrefixTriggerPoolStatistics, types.GetKeyPrefixTriggerPoolStatisticsByBucket(cutoff))
+}
+
+// deleteKeysInRange deletes all of the keys in the given range, with the start key inclusive and the end key exclusive.
+func deleteKeysInRange(store sdk.KVStore, startKey, endKey []byte) {
+	iterator := store.Iterator(startKey, endKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+func parseBackrunRecord(bz []byte) (types.BackrunRecord, error) {
+	record := types.BackrunRecord{}
+	if err := proto.Unmarshal(bz, &record); err != nil {
+		return types.BackrunRecord{}, err
+	}
+	return record, nil
+}
+
+func parseTriggerPoolStatistics(bz []byte) (types.TriggerPoolStatistics, error) {
+	statistics := types.TriggerPoolStatistics{}
+	if err := proto.Unmarshal(bz, &statistics); err != nil {
+		return types.TriggerPoolStatistics{}, err
+	}
+	return statistics, nil
+}
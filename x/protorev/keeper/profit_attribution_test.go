@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+)
+
+// pseudoExecuteBackrun updates the statistics and records a backrun as if it had been executed after a swap on the given pool
+func (s *KeeperTestSuite) pseudoExecuteBackrun(poolId uint64, profit sdk.Coin) {
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: profit.Denom}}
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, profit.Denom, profit.Amount))
+
+	pool := keeper.SwapToBackrun{PoolId: poolId, TokenInDenom: "Atom", TokenOutDenom: types.OsmosisDenomination}
+	s.Require().NoError(s.App.ProtoRevKeeper.RecordBackrun(s.Ctx, pool, route, profit))
+}
+
+// TestProfitAttribution tests that backruns are recorded with the tx and pool that triggered them, aggregated
+// by pool over the most recent days and pruned after the retention period.
+func (s *KeeperTestSuite) TestProfitAttribution() {
+	protoRevKeeper := s.App.ProtoRevKeeper
+	dayZero := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	txBytes := []byte("tx")
+	s.Ctx = s.Ctx.WithBlockTime(dayZero).WithTxBytes(txBytes)
+
+	s.pseudoExecuteBackrun(1, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+	s.pseudoExecuteBackrun(2, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(300)))
+	s.pseudoExecuteBackrun(2, sdk.NewCoin("Atom", osmomath.NewInt(50)))
+
+	s.Ctx = s.Ctx.WithBlockTime(dayZero.Add(types.ProfitAttributionBucketDuration))
+	s.pseudoExecuteBackrun(3, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+	s.pseudoExecuteBackrun(2, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+
+	// Each backrun is recorded with the tx and pool swap that triggered it
+	records, err := protoRevKeeper.GetAllBackrunRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(records, 5)
+	s.Require().Equal(types.BackrunRecord{
+		TxHash:      strings.ToUpper(hex.EncodeToString(tmhash.Sum(txBytes))),
+		PoolId:      1,
+		TokenIn:     "Atom",
+		TokenOut:    types.OsmosisDenomination,
+		Route:       []uint64{1},
+		Profit:      sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)),
+		BlockHeight: s.Ctx.BlockHeight(),
+		BlockTime:   dayZero,
+	}, records[0])
+
+	// Only the backruns of the current day are aggregated over a single day
+	topPools, err := protoRevKeeper.GetTopTriggerPools(s.Ctx, 1, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TriggerPoolStatistics{
+		{PoolId: 2, NumberOfBackruns: 1, Profits: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))},
+		{PoolId: 3, NumberOfBackruns: 1, Profits: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))},
+	}, topPools)
+
+	// Pools are ordered by decreasing number of backruns, then by pool id
+	topPools, err = protoRevKeeper.GetTopTriggerPools(s.Ctx, 2, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.TriggerPoolStatistics{
+		{PoolId: 2, NumberOfBackruns: 3, Profits: sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(50)), sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(400)))},
+		{PoolId: 1, NumberOfBackruns: 1, Profits: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))},
+	}, topPools)
+
+	profitShares, totalProfits, err := protoRevKeeper.GetProfitSharesByTriggerPool(s.Ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(50)), sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(600))), totalProfits)
+	s.Require().Len(profitShares, 3)
+	s.Require().Equal([]uint64{1, 2, 3}, []uint64{profitShares[0].Statistics.PoolId, profitShares[1].Statistics.PoolId, profitShares[2].Statistics.PoolId})
+	s.Require().True(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.OsmosisDenomination, osmomath.NewDec(1).QuoInt64(6))).Equal(profitShares[0].ProfitShares))
+	s.Require().True(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("Atom", osmomath.OneDec()),
+		sdk.NewDecCoinFromDec(types.OsmosisDenomination, osmomath.NewDec(2).QuoInt64(3)),
+	).Equal(profitShares[1].ProfitShares))
+
+	// The number of days must be within the retention period
+	_, err = protoRevKeeper.GetTopTriggerPools(s.Ctx, 0, 0)
+	s.Require().Error(err)
+	_, _, err = protoRevKeeper.GetProfitSharesByTriggerPool(s.Ctx, types.ProfitAttributionRetentionDays+1)
+	s.Require().Error(err)
+
+	// Once the first day falls out of the retention period, its backruns are pruned
+	s.Ctx = s.Ctx.WithBlockTime(dayZero.Add(time.Duration(types.ProfitAttributionRetentionDays) * types.ProfitAttributionBucketDuration))
+	protoRevKeeper.PruneProfitAttribution(s.Ctx)
+
+	records, err = protoRevKeeper.GetAllBackrunRecords(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	for _, record := range records {
+		s.Require().Equal(dayZero.Add(types.ProfitAttributionBucketDuration), record.BlockTime)
+	}
+
+	topPools, err = protoRevKeeper.GetTopTriggerPools(s.Ctx, types.ProfitAttributionRetentionDays, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2, 3}, []uint64{topPools[0].PoolId, topPools[1].PoolId})
+	s.Require().Equal(uint64(1), topPools[0].NumberOfBackruns)
+}
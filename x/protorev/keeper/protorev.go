@@ -471,6 +471,31 @@ func (k Keeper) SetMaxPointsPerBlock(ctx sdk.Context, maxPoints uint64) error {
 	return nil
 }
 
+// GetMaxCyclicRouteHops returns the max number of hops of the cyclic routes found by the cycle finder.
+// It returns 0, meaning the cycle finder is disabled, if it has not been set.
+func (k Keeper) GetMaxCyclicRouteHops(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMaxCyclicRouteHops)
+	bz := store.Get(types.KeyPrefixMaxCyclicRouteHops)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetMaxCyclicRouteHops sets the max number of hops of the cyclic routes found by the cycle finder. Setting it to 0
+// disables the cycle finder.
+func (k Keeper) SetMaxCyclicRouteHops(ctx sdk.Context, maxHops uint64) error {
+	if err := types.ValidateMaxCyclicRouteHops(maxHops); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMaxCyclicRouteHops)
+	store.Set(types.KeyPrefixMaxCyclicRouteHops, sdk.Uint64ToBigEndian(maxHops))
+
+	return nil
+}
+
 // GetInfoByPoolType retrieves the metadata about the different pool types. This is used to determine the execution costs of
 // different pool types when calculating the optimal route (in terms of time and gas consumption).
 func (k Keeper) GetInfoByPoolType(ctx sdk.Context) types.InfoByPoolType {
@@ -13,6 +13,12 @@ var zeroInt = osmomath.ZeroInt()
 // IterateRoutes checks the profitability of every single route that is passed in
 // and returns the optimal route if there is one
 func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes) {
+	return k.iterateRoutes(ctx, routes, remainingTxPoolPoints, remainingBlockPoolPoints, nil)
+}
+
+// iterateRoutes implements IterateRoutes.
+// If trace is not nil, the routes tried are recorded in it.
+func (k Keeper) iterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64, trace *backrunTrace) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes) {
 	var optimalRoute poolmanagertypes.SwapAmountInRoutes
 	var maxProfitInputCoin sdk.Coin
 	maxProfit := osmomath.ZeroInt()
@@ -26,6 +32,9 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 
 		// Find the max profit for the route if it exists
 		inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, routes[index], remainingTxPoolPoints, remainingBlockPoolPoints)
+		if trace != nil {
+			trace.addRoute(routes[index], inputCoin, profit, err)
+		}
 		if err != nil {
 			k.Logger(ctx).Error("Error finding max profit for route: " + err.Error())
 			continue
@@ -44,6 +53,10 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 				optimalRoute = routes[index].Route
 				maxProfit = profit
 				maxProfitInputCoin = inputCoin
+
+				if trace != nil {
+					trace.setOptimalRoute()
+				}
 			}
 		}
 	}
@@ -106,23 +119,16 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 	return tokenIn, profit, nil
 }
 
-// FindMaxProfitRoute runs a binary search to find the max profit for a given route
+// FindMaxProfitRoute finds the max profit for a given route. Routes made only of balancer and concentrated liquidity
+// pools are optimized in closed form (see findMaxProfitInClosedForm), while other routes fall back to a binary search.
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, error) {
-	// Track the tokenIn amount/denom and the profit
-	tokenIn := sdk.Coin{}
-	profit := osmomath.ZeroInt()
-
-	// Track the left and right bounds of the binary search
-	curLeft := osmomath.OneInt()
-	curRight := types.MaxInputAmount
-
 	// Input denom used for cyclic arbitrage
 	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
 
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
-	// If there is no profit, then we can return early and not run the binary search.
-	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
+	// If there is no profit, then we can return early and not run the search.
+	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, route.StepSize, route.Route)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	} else if minInProfit.LTE(osmomath.ZeroInt()) {
@@ -138,8 +144,22 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
 
+	// Optimize the route in closed form if the swaps of all of its pools can be modelled
+	if model, ok := k.getRouteSwapModel(ctx, route.Route, inputDenom); ok {
+		return k.findMaxProfitInClosedForm(ctx, route, inputDenom, model, minInProfit)
+	}
+
+	return k.findMaxProfitWithBinarySearch(ctx, route, inputDenom)
+}
+
+// findMaxProfitWithBinarySearch runs a binary search to find the max profit for a given route
+func (k Keeper) findMaxProfitWithBinarySearch(ctx sdk.Context, route RouteMetaData, inputDenom string) (sdk.Coin, osmomath.Int, error) {
+	// Track the tokenIn amount/denom and the profit
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+
 	// Update the search range if the max input amount is too small/large
-	curLeft, curRight, err = k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
+	curLeft, curRight, err := k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, osmomath.OneInt(), types.MaxInputAmount)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
@@ -376,6 +396,11 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 		return err
 	}
 
+	// Attribute the trade to the tx and pool that triggered it
+	if err = k.RecordBackrun(ctx, pool, route, sdk.NewCoin(inputCoin.Denom, profit)); err != nil {
+		return err
+	}
+
 	// Send the developer fee to the developer address
 	if err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit)); err != nil {
 		ctx.Logger().Error("failed to send developer fee: " + err.Error())
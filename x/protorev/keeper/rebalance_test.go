@@ -523,6 +523,13 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			s.Require().NoError(err)
 			s.Require().Equal(test.expectedNumOfTrades, totalNumberOfTrades)
 
+			// Check the trade was recorded along with the swap that triggered it
+			records, err := s.App.ProtoRevKeeper.GetAllBackrunRecords(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Len(records, int(test.expectedNumOfTrades.Int64()))
+			s.Require().Equal(test.param.route.PoolIds(), records[len(records)-1].Route)
+			s.Require().Equal(sdk.NewCoin(test.arbDenom, test.param.expectedProfit), records[len(records)-1].Profit)
+
 			// Check the dev account was paid the correct amount
 			developerAccBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, devAccount, test.arbDenom)
 			s.Require().Equal(test.param.expectedProfit.MulRaw(types.ProfitSplitPhase1).QuoRaw(100), developerAccBalance.Amount)
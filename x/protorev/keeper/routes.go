@@ -20,7 +20,8 @@ type RouteMetaData struct {
 }
 
 // BuildRoutes builds all of the possible arbitrage routes given the tokenIn, tokenOut and poolId that were used in the swap.
-func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) []RouteMetaData {
+// The pool points consumed by the cycle finder search are deducted from the remaining pool points.
+func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) []RouteMetaData {
 	routes := make([]RouteMetaData, 0)
 
 	// Append hot routes if they exist
@@ -33,6 +34,11 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append routes found by the cycle finder if it is enabled
+	if cyclicRoutes, err := k.BuildCyclicRoutes(ctx, tokenIn, tokenOut, poolId, remainingTxPoolPoints, remainingBlockPoolPoints); err == nil {
+		routes = append(routes, cyclicRoutes...)
+	}
+
 	return routes
 }
 
@@ -114,7 +114,9 @@ func (s *KeeperTestSuite) TestBuildRoutes() {
 
 	for _, tc := range cases {
 		s.Run(tc.description, func() {
-			routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID)
+			remainingTxPoolPoints, remainingBlockPoolPoints, err := s.App.ProtoRevKeeper.GetRemainingPoolPoints(s.Ctx)
+			s.Require().NoError(err)
+			routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID, &remainingTxPoolPoints, &remainingBlockPoolPoints)
 			s.Require().Equal(len(tc.expectedRoutes), len(routes))
 
 			for routeIndex, route := range routes {
@@ -22,6 +22,7 @@ const (
 	setMaxPoolPointsPerBlock = "osmosis/MsgSetMaxPoolPointsPerBlock"
 	setInfoByPoolType        = "osmosis/MsgSetInfoByPoolType"
 	setBaseDenoms            = "osmosis/MsgSetBaseDenoms"
+	setMaxCyclicRouteHops    = "osmosis/MsgSetMaxCyclicRouteHops"
 
 	// proposals
 	setProtoRevEnabledProposal      = "osmosis/SetProtoRevEnabledProposal"
@@ -42,6 +43,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerBlock{}, setMaxPoolPointsPerBlock, nil)
 	cdc.RegisterConcrete(&MsgSetInfoByPoolType{}, setInfoByPoolType, nil)
 	cdc.RegisterConcrete(&MsgSetBaseDenoms{}, setBaseDenoms, nil)
+	cdc.RegisterConcrete(&MsgSetMaxCyclicRouteHops{}, setMaxCyclicRouteHops, nil)
 
 	// proposals
 	cdc.RegisterConcrete(&SetProtoRevEnabledProposal{}, setProtoRevEnabledProposal, nil)
@@ -57,6 +59,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetMaxPoolPointsPerBlock{},
 		&MsgSetInfoByPoolType{},
 		&MsgSetBaseDenoms{},
+		&MsgSetMaxCyclicRouteHops{},
 	)
 
 	// proposals
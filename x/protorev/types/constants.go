@@ -1,6 +1,10 @@
 package types
 
-import "github.com/osmosis-labs/osmosis/osmomath"
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
 
 // OsmosisDenomination stores the native denom name for Osmosis on chain used for route building
 var OsmosisDenomination string = "uosmo"
@@ -17,6 +21,9 @@ var ExtendedMaxInputAmount = osmomath.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// Max number of times the optimal amount in of the closed form search is refitted to the exact amounts out of a route
+const MaxClosedFormRefinements int = 2
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -28,6 +35,43 @@ const MaxPoolPointsPerBlock uint64 = 200
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
+// ----------------- Cycle Finder Constants ----------------- //
+
+// Min and max number of hops of the cyclic routes found by the cycle finder. Routes with
+// fewer hops are already built by the highest liquidity method
+const (
+	MinCyclicRouteHops uint64 = 4
+	MaxCyclicRouteHops uint64 = 6
+)
+
+// Max number of pools that can be stored in the cycle finder graph. Once the graph is full, a pool is
+// only added by evicting the least liquid pool of the graph, if it is less liquid than the added pool
+const MaxCyclicRouteGraphPools uint64 = 1_000
+
+// Min liquidity a pool must have to be stored in the cycle finder graph, denominated in uosmo. The
+// liquidity of a pool is valued as twice its amount of a base denom, so a pool must hold at least
+// 5,000 osmo worth of the base denom.
+var MinCyclicRouteGraphLiquidity = osmomath.NewInt(10_000_000_000)
+
+// Max number of pools the cycle finder explores when searching for cyclic routes after a swap
+const MaxCyclicRouteSearchPools uint64 = 200
+
+// Number of pools the cycle finder explores per pool point consumed. Exploring a pool calculates its spot
+// price, which takes around 25 µs, while a pool point accounts for around 1 ms of simulation and execution
+const CyclicRouteSearchPoolsPerPoolPoint uint64 = 40
+
+// Max number of cyclic routes the cycle finder returns after a swap
+const MaxCyclicRoutesPerSwap int = 3
+
+// ----------------- Profit Attribution Constants ----------------- //
+
+// Duration of the time buckets in which backruns are aggregated by the pool whose swap triggered them
+const ProfitAttributionBucketDuration = 24 * time.Hour
+
+// Number of time buckets (days), including the current one, for which backrun records and their
+// aggregation are kept before being pruned
+const ProfitAttributionRetentionDays uint64 = 30
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
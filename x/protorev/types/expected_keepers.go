@@ -63,6 +63,8 @@ type PoolManagerKeeper interface {
 	GetTakerFeeTrackerForStakers(ctx sdk.Context) sdk.Coins
 	GetTakerFeeTrackerForCommunityPool(ctx sdk.Context) sdk.Coins
 	GetTakerFeeTrackerStartHeight(ctx sdk.Context) int64
+	GetTakerFee(ctx sdk.Context, poolId uint64, denom0, denom1 string) (osmomath.Dec, error)
+	GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (osmomath.Dec, error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
@@ -39,6 +39,7 @@ var (
 	DefaultMaxPoolPointsPerTx        = uint64(18)
 	DefaultPoolPointsConsumedInBlock = uint64(0)
 	DefaultProfits                   = []sdk.Coin{}
+	DefaultMaxCyclicRouteHops        = uint64(0)
 	DefaultCyclicArbTracker          = CyclicArbTracker{
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
@@ -61,6 +62,7 @@ func DefaultGenesis() *GenesisState {
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
 		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		MaxCyclicRouteHops:     DefaultMaxCyclicRouteHops,
 	}
 }
 
@@ -103,6 +105,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the max cyclic route hops
+	if err := ValidateMaxCyclicRouteHops(gs.MaxCyclicRouteHops); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
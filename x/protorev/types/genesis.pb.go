@@ -62,6 +62,9 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The maximum number of hops of the cyclic arbitrage routes found by the
+	// cycle finder. 0 means the cycle finder is disabled.
+	MaxCyclicRouteHops uint64 `protobuf:"varint,15,opt,name=max_cyclic_route_hops,json=maxCyclicRouteHops,proto3" json:"max_cyclic_route_hops,omitempty" yaml:"max_cyclic_route_hops"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -195,6 +198,13 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetMaxCyclicRouteHops() uint64 {
+	if m != nil {
+		return m.MaxCyclicRouteHops
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -204,56 +214,58 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xe3, 0x46,
-	0x18, 0x8f, 0x0b, 0x85, 0x32, 0x81, 0x08, 0x86, 0x06, 0x39, 0x69, 0x71, 0x5c, 0x17, 0xda, 0xa8,
-	0x2a, 0xb6, 0xa0, 0x3d, 0xf5, 0x50, 0x09, 0x53, 0xd1, 0x56, 0x55, 0x51, 0x64, 0x52, 0x55, 0x6a,
-	0xa5, 0x4e, 0xc7, 0xce, 0x24, 0x58, 0xd8, 0x1e, 0xcb, 0x33, 0x09, 0xc9, 0x03, 0xec, 0x7d, 0x1f,
-	0x66, 0x1f, 0x82, 0x23, 0xda, 0xcb, 0xee, 0x29, 0x5a, 0xc1, 0x1b, 0xe4, 0x09, 0x56, 0x9e, 0x99,
-	0x24, 0x10, 0xe2, 0xdd, 0x9b, 0xe7, 0xfb, 0x7e, 0x7f, 0xbe, 0xdf, 0xfc, 0x31, 0xf8, 0x86, 0xb2,
-	0x98, 0xb2, 0x90, 0x39, 0x69, 0x46, 0x39, 0xcd, 0xc8, 0xc0, 0x19, 0x1c, 0xfb, 0x84, 0xe3, 0x63,
-	0xa7, 0x47, 0x12, 0xc2, 0x42, 0x66, 0x8b, 0x06, 0xd4, 0x15, 0xce, 0x9e, 0xe2, 0x6c, 0x85, 0xab,
-	0x7f, 0xde, 0xa3, 0x3d, 0x2a, 0xaa, 0x4e, 0xfe, 0x25, 0x01, 0xf5, 0x6f, 0x0b, 0x75, 0x67, 0x02,
-	0x12, 0x78, 0x58, 0x0c, 0xc4, 0x19, 0x8e, 0x95, 0x61, 0xbd, 0x16, 0x08, 0x1c, 0x92, 0x46, 0x72,
-	0xa1, 0x5a, 0x86, 0x5c, 0x39, 0x3e, 0x66, 0x64, 0x46, 0x0e, 0x68, 0x98, 0xc8, 0xbe, 0xf5, 0x06,
-	0x80, 0xcd, 0x5f, 0x65, 0x98, 0x4b, 0x8e, 0x39, 0x81, 0x3f, 0x83, 0x35, 0xa9, 0xad, 0x6b, 0xa6,
-	0xd6, 0x2c, 0x9f, 0x98, 0x76, 0x51, 0x38, 0xbb, 0x25, 0x70, 0xee, 0xea, 0xed, 0xb8, 0x51, 0xf2,
-	0x14, 0x0b, 0xbe, 0xd0, 0x40, 0x95, 0xd3, 0x6b, 0x92, 0xa0, 0x14, 0x87, 0x19, 0xc2, 0x99, 0x8f,
-	0x32, 0xda, 0xe7, 0x84, 0xe9, 0x9f, 0x98, 0x2b, 0xcd, 0xf2, 0xc9, 0xf7, 0xc5, 0x7a, 0xed, 0x9c,
-	0xd6, 0xc2, 0x61, 0x76, 0x9a, 0xf9, 0x9e, 0xe0, 0xb8, 0x07, 0xb9, 0xf6, 0x64, 0xdc, 0xf8, 0x72,
-	0x84, 0xe3, 0xe8, 0x27, 0x6b, 0xa9, 0xb0, 0xe5, 0x41, 0xfe, 0x8c, 0x09, 0xff, 0x07, 0xe5, 0x3c,
-	0x33, 0xea, 0x90, 0x84, 0xc6, 0x4c, 0x5f, 0x11, 0xe6, 0x5f, 0x17, 0x9b, 0xbb, 0x98, 0x91, 0x5f,
-	0x72, 0xac, 0x5b, 0x57, 0x9e, 0x50, 0x7a, 0x3e, 0x52, 0xb1, 0x3c, 0xe0, 0x4f, 0x61, 0x0c, 0x12,
-	0xb0, 0x99, 0x52, 0x1a, 0xa1, 0x1b, 0x12, 0xf6, 0xae, 0x38, 0xd3, 0x57, 0xc5, 0x7e, 0x1d, 0x7e,
-	0x60, 0xbf, 0x28, 0x8d, 0xfe, 0x96, 0x60, 0xf7, 0x0b, 0x65, 0xb2, 0x2b, 0x4d, 0x1e, 0x0b, 0x59,
-	0x5e, 0x39, 0x9d, 0x23, 0x21, 0x02, 0xb5, 0x0e, 0x1e, 0x31, 0xc4, 0xc2, 0x24, 0x20, 0x28, 0xa6,
-	0x9d, 0x7e, 0x44, 0x90, 0xba, 0x7f, 0xfa, 0xa7, 0xa6, 0xd6, 0x5c, 0x75, 0x0f, 0x26, 0xe3, 0x86,
-	0x29, 0x85, 0x0a, 0xa1, 0x96, 0xb7, 0x97, 0xf7, 0x2e, 0xf3, 0xd6, 0x9f, 0xa2, 0xa3, 0x8e, 0x1d,
-	0x22, 0x50, 0xe9, 0x90, 0x01, 0x89, 0x68, 0x4a, 0x32, 0xd4, 0x25, 0x84, 0xe9, 0x6b, 0x62, 0xb3,
-	0x6a, 0xb6, 0xba, 0x49, 0x79, 0xe6, 0x59, 0x88, 0x33, 0x1a, 0x26, 0xee, 0xbe, 0x9a, 0xbe, 0xaa,
-	0x4c, 0x9f, 0xd0, 0x2d, 0x6f, 0x6b, 0x56, 0x38, 0x27, 0x84, 0xc1, 0x0b, 0xb0, 0x1b, 0x61, 0x4e,
-	0x18, 0x47, 0x7e, 0x44, 0x83, 0x6b, 0x74, 0x25, 0x92, 0xe9, 0xeb, 0x62, 0x76, 0x63, 0x32, 0x6e,
-	0xd4, 0xa5, 0xcc, 0x12, 0x90, 0xe5, 0xed, 0xc8, 0xaa, 0x9b, 0x17, 0x7f, 0x13, 0x35, 0xf8, 0x2f,
-	0xd8, 0x99, 0x3b, 0xe2, 0x4e, 0x27, 0x23, 0x8c, 0xe9, 0x9f, 0x99, 0x5a, 0x73, 0xc3, 0xb5, 0x27,
-	0xe3, 0x86, 0xbe, 0x38, 0x94, 0x82, 0x58, 0xaf, 0x5f, 0x1d, 0x55, 0x54, 0xa4, 0x53, 0x59, 0xf2,
-	0xb6, 0x67, 0x28, 0x55, 0x81, 0xff, 0x81, 0x5a, 0x8c, 0x87, 0x48, 0x1c, 0x48, 0x4a, 0xc3, 0x84,
-	0x33, 0x94, 0x6b, 0x88, 0xa1, 0xf4, 0x8d, 0xc5, 0xed, 0x2e, 0x84, 0x5a, 0x5e, 0x35, 0xc6, 0xc3,
-	0xfc, 0xc4, 0x5b, 0xa2, 0xd3, 0x22, 0x99, 0x88, 0x00, 0xff, 0x02, 0x7b, 0xcb, 0x48, 0x7c, 0xa8,
-	0x03, 0x21, 0xfe, 0xd5, 0x64, 0xdc, 0xd8, 0x2f, 0x16, 0xe7, 0x43, 0xcb, 0x83, 0x8b, 0xca, 0xed,
-	0x21, 0xbc, 0x04, 0x55, 0x81, 0x42, 0x01, 0xed, 0x27, 0x1c, 0x75, 0xe9, 0x74, 0xe4, 0xb2, 0x50,
-	0x35, 0xe7, 0x6f, 0x68, 0x29, 0xcc, 0xf2, 0xa0, 0xa8, 0x9f, 0xe5, 0xe5, 0x73, 0xaa, 0x66, 0xfd,
-	0x03, 0xac, 0xa7, 0x19, 0xed, 0x86, 0x9c, 0xe9, 0x9b, 0x1f, 0xbb, 0x12, 0x7b, 0xea, 0x4a, 0x54,
-	0x94, 0x8b, 0xe4, 0x59, 0xde, 0x54, 0x01, 0xf6, 0xc1, 0x4e, 0x98, 0x74, 0x29, 0xf2, 0x47, 0x32,
-	0x14, 0x1f, 0xa5, 0x44, 0xdf, 0x12, 0x6f, 0xa6, 0x59, 0xfc, 0x66, 0x7e, 0x4f, 0xba, 0xd4, 0x1d,
-	0xe5, 0x69, 0xdb, 0xa3, 0x94, 0xb8, 0xa6, 0x72, 0x51, 0x67, 0xfc, 0x4c, 0xd0, 0xf2, 0x2a, 0xe1,
-	0x13, 0x06, 0xbc, 0x01, 0x30, 0x18, 0x05, 0x51, 0x18, 0x88, 0x3f, 0x06, 0xcf, 0x70, 0x70, 0x4d,
-	0x32, 0xbd, 0x22, 0x7c, 0xbf, 0x2b, 0xf6, 0x3d, 0x13, 0x9c, 0xd3, 0xcc, 0x6f, 0x4b, 0x86, 0xbb,
-	0x3f, 0x19, 0x37, 0x6a, 0xd2, 0xf5, 0xb9, 0x9e, 0xe5, 0x6d, 0x07, 0x8b, 0x84, 0x8b, 0xdb, 0x7b,
-	0x43, 0xbb, 0xbb, 0x37, 0xb4, 0x77, 0xf7, 0x86, 0xf6, 0xf2, 0xc1, 0x28, 0xdd, 0x3d, 0x18, 0xa5,
-	0xb7, 0x0f, 0x46, 0xe9, 0x9f, 0x1f, 0x7b, 0x21, 0xbf, 0xea, 0xfb, 0x76, 0x40, 0x63, 0x47, 0x0d,
-	0x70, 0x14, 0x61, 0x9f, 0x4d, 0x17, 0xce, 0xe0, 0xe4, 0xd8, 0x19, 0xce, 0xff, 0xf9, 0x79, 0x30,
-	0xe6, 0xaf, 0x89, 0xf5, 0x0f, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72, 0xe1, 0xd3, 0x73, 0x95,
-	0x06, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x6e, 0xe3, 0x44,
+	0x1c, 0x8f, 0xd9, 0xd2, 0x65, 0x27, 0xdd, 0xb0, 0x9d, 0x25, 0xd5, 0x24, 0x50, 0xc7, 0x98, 0x5d,
+	0x88, 0x10, 0x6b, 0xab, 0x85, 0x13, 0x07, 0xa4, 0xba, 0x68, 0x59, 0x84, 0x58, 0x45, 0x6e, 0x10,
+	0x12, 0x48, 0x0c, 0x63, 0x67, 0x92, 0x58, 0xb5, 0x3d, 0x96, 0x67, 0x92, 0x4d, 0x1e, 0x80, 0x3b,
+	0x0f, 0xc2, 0x91, 0x87, 0xd8, 0xe3, 0x8a, 0x13, 0xa7, 0x08, 0xb5, 0x6f, 0x90, 0x27, 0x40, 0xf3,
+	0x91, 0xa4, 0x4d, 0x63, 0xb8, 0x65, 0xfe, 0xff, 0xdf, 0xc7, 0xff, 0x63, 0x3c, 0x01, 0x1f, 0x33,
+	0x9e, 0x31, 0x9e, 0x70, 0xbf, 0x28, 0x99, 0x60, 0x25, 0x9d, 0xfa, 0xd3, 0x93, 0x88, 0x0a, 0x72,
+	0xe2, 0x8f, 0x68, 0x4e, 0x79, 0xc2, 0x3d, 0x95, 0x80, 0xc8, 0xe0, 0xbc, 0x15, 0xce, 0x33, 0xb8,
+	0xf6, 0x7b, 0x23, 0x36, 0x62, 0x2a, 0xea, 0xcb, 0x5f, 0x1a, 0xd0, 0xfe, 0xa4, 0x52, 0x77, 0x2d,
+	0xa0, 0x81, 0x4f, 0xab, 0x81, 0xa4, 0x24, 0x99, 0x31, 0x6c, 0xb7, 0x62, 0x85, 0xc3, 0xda, 0x48,
+	0x1f, 0x4c, 0xca, 0xd6, 0x27, 0x3f, 0x22, 0x9c, 0xae, 0xc9, 0x31, 0x4b, 0x72, 0x9d, 0x77, 0xff,
+	0xa8, 0x83, 0x83, 0x6f, 0x74, 0x33, 0x17, 0x82, 0x08, 0x0a, 0xbf, 0x02, 0xfb, 0x5a, 0x1b, 0x59,
+	0x8e, 0xd5, 0xad, 0x9f, 0x3a, 0x5e, 0x55, 0x73, 0x5e, 0x4f, 0xe1, 0x82, 0xbd, 0xd7, 0x8b, 0x4e,
+	0x2d, 0x34, 0x2c, 0xf8, 0x9b, 0x05, 0x9a, 0x82, 0x5d, 0xd2, 0x1c, 0x17, 0x24, 0x29, 0x31, 0x29,
+	0x23, 0x5c, 0xb2, 0x89, 0xa0, 0x1c, 0xbd, 0xe5, 0xdc, 0xeb, 0xd6, 0x4f, 0x3f, 0xab, 0xd6, 0xeb,
+	0x4b, 0x5a, 0x8f, 0x24, 0xe5, 0x59, 0x19, 0x85, 0x8a, 0x13, 0x3c, 0x91, 0xda, 0xcb, 0x45, 0xe7,
+	0x83, 0x39, 0xc9, 0xd2, 0x2f, 0xdd, 0x9d, 0xc2, 0x6e, 0x08, 0xc5, 0x1d, 0x26, 0xfc, 0x15, 0xd4,
+	0x65, 0xcf, 0x78, 0x40, 0x73, 0x96, 0x71, 0x74, 0x4f, 0x99, 0x7f, 0x54, 0x6d, 0x1e, 0x10, 0x4e,
+	0xbf, 0x96, 0xd8, 0xa0, 0x6d, 0x3c, 0xa1, 0xf6, 0xbc, 0xa1, 0xe2, 0x86, 0x20, 0x5a, 0xc1, 0x38,
+	0xa4, 0xe0, 0xa0, 0x60, 0x2c, 0xc5, 0xaf, 0x68, 0x32, 0x1a, 0x0b, 0x8e, 0xf6, 0xd4, 0xbc, 0x9e,
+	0xfe, 0xc7, 0xbc, 0x18, 0x4b, 0x7f, 0xd4, 0xe0, 0xe0, 0x7d, 0x63, 0xf2, 0x58, 0x9b, 0xdc, 0x14,
+	0x72, 0xc3, 0x7a, 0xb1, 0x41, 0x42, 0x0c, 0x5a, 0x03, 0x32, 0xe7, 0x98, 0x27, 0x79, 0x4c, 0x71,
+	0xc6, 0x06, 0x93, 0x94, 0x62, 0x73, 0xff, 0xd0, 0xdb, 0x8e, 0xd5, 0xdd, 0x0b, 0x9e, 0x2c, 0x17,
+	0x1d, 0x47, 0x0b, 0x55, 0x42, 0xdd, 0xf0, 0x48, 0xe6, 0x2e, 0x64, 0xea, 0x7b, 0x95, 0x31, 0x6b,
+	0x87, 0x18, 0x34, 0x06, 0x74, 0x4a, 0x53, 0x56, 0xd0, 0x12, 0x0f, 0x29, 0xe5, 0x68, 0x5f, 0x0d,
+	0xab, 0xe5, 0x99, 0x9b, 0x24, 0x7b, 0x5e, 0x37, 0x71, 0xce, 0x92, 0x3c, 0x38, 0x36, 0xd5, 0x37,
+	0x8d, 0xe9, 0x2d, 0xba, 0x1b, 0x3e, 0x5c, 0x07, 0x9e, 0x53, 0xca, 0xe1, 0x4b, 0xf0, 0x38, 0x25,
+	0x82, 0x72, 0x81, 0xa3, 0x94, 0xc5, 0x97, 0x78, 0xac, 0x3a, 0x43, 0xf7, 0x55, 0xed, 0xf6, 0x72,
+	0xd1, 0x69, 0x6b, 0x99, 0x1d, 0x20, 0x37, 0x3c, 0xd4, 0xd1, 0x40, 0x06, 0x5f, 0xa8, 0x18, 0xfc,
+	0x19, 0x1c, 0x6e, 0x1c, 0xc9, 0x60, 0x50, 0x52, 0xce, 0xd1, 0x3b, 0x8e, 0xd5, 0x7d, 0x10, 0x78,
+	0xcb, 0x45, 0x07, 0x6d, 0x17, 0x65, 0x20, 0xee, 0x5f, 0x7f, 0x3e, 0x6b, 0x98, 0x96, 0xce, 0x74,
+	0x28, 0x7c, 0xb4, 0x46, 0x99, 0x08, 0xfc, 0x05, 0xb4, 0x32, 0x32, 0xc3, 0x6a, 0x21, 0x05, 0x4b,
+	0x72, 0xc1, 0xb1, 0xd4, 0x50, 0x45, 0xa1, 0x07, 0xdb, 0xe3, 0xae, 0x84, 0xba, 0x61, 0x33, 0x23,
+	0x33, 0xb9, 0xf1, 0x9e, 0xca, 0xf4, 0x68, 0xa9, 0x5a, 0x80, 0x3f, 0x80, 0xa3, 0x5d, 0x24, 0x31,
+	0x43, 0x40, 0x89, 0x7f, 0xb8, 0x5c, 0x74, 0x8e, 0xab, 0xc5, 0xc5, 0xcc, 0x0d, 0xe1, 0xb6, 0x72,
+	0x7f, 0x06, 0x2f, 0x40, 0x53, 0xa1, 0x70, 0xcc, 0x26, 0xb9, 0xc0, 0x43, 0xb6, 0x2a, 0xb9, 0xae,
+	0x54, 0x9d, 0xcd, 0x37, 0xb4, 0x13, 0xe6, 0x86, 0x50, 0xc5, 0xcf, 0x65, 0xf8, 0x39, 0x33, 0xb5,
+	0x7e, 0x07, 0xee, 0x17, 0x25, 0x1b, 0x26, 0x82, 0xa3, 0x83, 0xff, 0xbb, 0x12, 0x47, 0xe6, 0x4a,
+	0x34, 0x8c, 0x8b, 0xe6, 0xb9, 0xe1, 0x4a, 0x01, 0x4e, 0xc0, 0x61, 0x92, 0x0f, 0x19, 0x8e, 0xe6,
+	0xba, 0x29, 0x31, 0x2f, 0x28, 0x7a, 0xa8, 0xbe, 0x99, 0x6e, 0xf5, 0x37, 0xf3, 0x6d, 0x3e, 0x64,
+	0xc1, 0x5c, 0x76, 0xdb, 0x9f, 0x17, 0x34, 0x70, 0x8c, 0x8b, 0xd9, 0xf1, 0x1d, 0x41, 0x37, 0x6c,
+	0x24, 0xb7, 0x18, 0xf0, 0x15, 0x80, 0xf1, 0x3c, 0x4e, 0x93, 0x58, 0xbd, 0x18, 0xa2, 0x24, 0xf1,
+	0x25, 0x2d, 0x51, 0x43, 0xf9, 0x7e, 0x5a, 0xed, 0x7b, 0xae, 0x38, 0x67, 0x65, 0xd4, 0xd7, 0x8c,
+	0xe0, 0x78, 0xb9, 0xe8, 0xb4, 0xb4, 0xeb, 0x5d, 0x3d, 0x37, 0x7c, 0x14, 0x6f, 0x11, 0xe4, 0x46,
+	0xe4, 0x02, 0x0d, 0x58, 0x3d, 0x55, 0x78, 0xcc, 0x0a, 0x8e, 0xde, 0xdd, 0xde, 0xc8, 0x4e, 0x98,
+	0x5e, 0xb3, 0x2e, 0x43, 0x3d, 0x69, 0x2f, 0x58, 0xc1, 0x83, 0x97, 0xaf, 0xaf, 0x6c, 0xeb, 0xcd,
+	0x95, 0x6d, 0xfd, 0x73, 0x65, 0x5b, 0xbf, 0x5f, 0xdb, 0xb5, 0x37, 0xd7, 0x76, 0xed, 0xef, 0x6b,
+	0xbb, 0xf6, 0xd3, 0x17, 0xa3, 0x44, 0x8c, 0x27, 0x91, 0x17, 0xb3, 0xcc, 0x37, 0x5d, 0x3d, 0x4b,
+	0x49, 0xc4, 0x57, 0x07, 0x7f, 0x7a, 0x7a, 0xe2, 0xcf, 0x36, 0x7f, 0x24, 0x72, 0x5a, 0x3c, 0xda,
+	0x57, 0xe7, 0xcf, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x96, 0x30, 0xa6, 0x85, 0xea, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +288,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCyclicRouteHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCyclicRouteHops))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +496,9 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.MaxCyclicRouteHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxCyclicRouteHops))
+	}
 	return n
 }
 
@@ -915,6 +935,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCyclicRouteHops", wireType)
+			}
+			m.MaxCyclicRouteHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCyclicRouteHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
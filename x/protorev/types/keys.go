@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -38,6 +39,13 @@ const (
 	prefixSwapsToBackrun
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
+	prefixMaxCyclicRouteHops
+	prefixCyclicRouteGraphPools
+	prefixCyclicRouteGraphEdges
+	prefixCyclicRouteGraphPoolCount
+	prefixBackrunRecords
+	prefixTriggerPoolStatistics
+	prefixCyclicRouteGraphPoolsByLiquidity
 )
 
 var (
@@ -97,6 +105,29 @@ var (
 
 	// KeyCyclicArbTracker is the prefix for store that keeps track of the height we began tracking cyclic arbitrage
 	KeyCyclicArbTrackerStartHeight = []byte{prefixcyclicArbTrackerStartHeight}
+
+	// -------------- Keys for cycle finder stores -------------- //
+	// KeyPrefixMaxCyclicRouteHops is the prefix for store that keeps track of the max number of hops of the routes found by the cycle finder
+	KeyPrefixMaxCyclicRouteHops = []byte{prefixMaxCyclicRouteHops}
+
+	// KeyPrefixCyclicRouteGraphPools is the prefix for store that keeps track of the pools in the cycle finder graph
+	KeyPrefixCyclicRouteGraphPools = []byte{prefixCyclicRouteGraphPools}
+
+	// KeyPrefixCyclicRouteGraphEdges is the prefix for store that keeps track of the pools in the cycle finder graph by denom
+	KeyPrefixCyclicRouteGraphEdges = []byte{prefixCyclicRouteGraphEdges}
+
+	// KeyPrefixCyclicRouteGraphPoolCount is the prefix for store that keeps track of the number of pools in the cycle finder graph
+	KeyPrefixCyclicRouteGraphPoolCount = []byte{prefixCyclicRouteGraphPoolCount}
+
+	// KeyPrefixCyclicRouteGraphPoolsByLiquidity is the prefix for store that keeps track of the pools in the cycle finder graph by liquidity
+	KeyPrefixCyclicRouteGraphPoolsByLiquidity = []byte{prefixCyclicRouteGraphPoolsByLiquidity}
+
+	// -------------- Keys for profit attribution stores -------------- //
+	// KeyPrefixBackrunRecords is the prefix for store that keeps track of the backruns executed and the txs that triggered them
+	KeyPrefixBackrunRecords = []byte{prefixBackrunRecords}
+
+	// KeyPrefixTriggerPoolStatistics is the prefix for store that keeps track of the backruns triggered by swaps on each pool per time bucket
+	KeyPrefixTriggerPoolStatistics = []byte{prefixTriggerPoolStatistics}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -150,6 +181,47 @@ func CreateRouteFromKey(key []byte) ([]uint64, error) {
 	return route, nil
 }
 
+// Returns the key needed to check whether a pool is in the cycle finder graph
+func GetKeyPrefixCyclicRouteGraphPool(poolId uint64) []byte {
+	return append(KeyPrefixCyclicRouteGraphPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key prefix needed to iterate over the pools containing the given denom in the cycle finder graph
+func GetKeyPrefixCyclicRouteGraphEdgesForDenom(denom string) []byte {
+	return append(KeyPrefixCyclicRouteGraphEdges, []byte(denom+"|")...)
+}
+
+// Returns the key needed to fetch the other denom of a pool containing the given denom in the cycle finder graph
+func GetKeyPrefixCyclicRouteGraphEdge(denom string, poolId uint64) []byte {
+	return append(GetKeyPrefixCyclicRouteGraphEdgesForDenom(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to iterate over the pools of the cycle finder graph by increasing liquidity
+func GetKeyPrefixCyclicRouteGraphPoolByLiquidity(liquidity, poolId uint64) []byte {
+	key := append(KeyPrefixCyclicRouteGraphPoolsByLiquidity, sdk.Uint64ToBigEndian(liquidity)...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key prefix of the backrun records executed at the given time, used to iterate over the records by time
+func GetKeyPrefixBackrunRecordsByTime(blockTime time.Time) []byte {
+	return append(KeyPrefixBackrunRecords, sdk.Uint64ToBigEndian(uint64(blockTime.Unix()))...)
+}
+
+// Returns the key needed to fetch the backrun record of the given trade number
+func GetKeyPrefixBackrunRecord(blockTime time.Time, tradeNumber uint64) []byte {
+	return append(GetKeyPrefixBackrunRecordsByTime(blockTime), sdk.Uint64ToBigEndian(tradeNumber)...)
+}
+
+// Returns the key prefix of the trigger pool statistics of the given time bucket, used to iterate over the statistics by time
+func GetKeyPrefixTriggerPoolStatisticsByBucket(bucketStartTime time.Time) []byte {
+	return append(KeyPrefixTriggerPoolStatistics, sdk.Uint64ToBigEndian(uint64(bucketStartTime.Unix()))...)
+}
+
+// Returns the key needed to fetch the statistics of the backruns triggered by swaps on a pool during a time bucket
+func GetKeyPrefixTriggerPoolStatistics(bucketStartTime time.Time, poolId uint64) []byte {
+	return append(GetKeyPrefixTriggerPoolStatisticsByBucket(bucketStartTime), sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Returns the key needed to fetch the developer fees by coin
 func GetKeyPrefixDeveloperFees(denom string) []byte {
 	return append(KeyPrefixDeveloperFees, []byte(denom)...)
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
 	_ sdk.Msg = &MsgSetInfoByPoolType{}
 	_ sdk.Msg = &MsgSetBaseDenoms{}
+	_ sdk.Msg = &MsgSetMaxCyclicRouteHops{}
 )
 
 const (
@@ -21,6 +22,7 @@ const (
 	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
 	TypeMsgSetPoolTypeInfo          = "set_info_by_pool_type"
 	TypeMsgSetBaseDenoms            = "set_base_denoms"
+	TypeMsgSetMaxCyclicRouteHops    = "set_max_cyclic_route_hops"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -293,3 +295,48 @@ func (msg MsgSetBaseDenoms) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetMaxCyclicRouteHops ---------------------- //
+// NewMsgSetMaxCyclicRouteHops creates a new MsgSetMaxCyclicRouteHops instance
+func NewMsgSetMaxCyclicRouteHops(admin string, maxCyclicRouteHops uint64) *MsgSetMaxCyclicRouteHops {
+	return &MsgSetMaxCyclicRouteHops{
+		Admin:              admin,
+		MaxCyclicRouteHops: maxCyclicRouteHops,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetMaxCyclicRouteHops) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetMaxCyclicRouteHops) Type() string {
+	return TypeMsgSetMaxCyclicRouteHops
+}
+
+// ValidateBasic validates the MsgSetMaxCyclicRouteHops
+func (msg MsgSetMaxCyclicRouteHops) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	// Max cyclic route hops must be in the valid range
+	if err := ValidateMaxCyclicRouteHops(msg.MaxCyclicRouteHops); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMaxCyclicRouteHops) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMaxCyclicRouteHops) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
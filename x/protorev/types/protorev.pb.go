@@ -11,17 +11,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	types2 "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -836,6 +840,403 @@ func (m *CyclicArbTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+// BackrunRecord is a backrun executed by the module, attributed to the user
+// transaction and the swap that triggered it
+type BackrunRecord struct {
+	// tx_hash is the hash of the transaction that triggered the backrun
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// pool_id is the id of the pool swapped on by the transaction
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the denom swapped into the pool by the transaction
+	TokenIn string `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// token_out is the denom swapped out of the pool by the transaction
+	TokenOut string `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+	// route is the route of the backrun (pool ids along the arbitrage route)
+	Route []uint64 `protobuf:"varint,5,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// profit is the profit made by the backrun
+	Profit types.Coin `protobuf:"bytes,6,opt,name=profit,proto3" json:"profit" yaml:"profit"`
+	// block_height is the height of the block the backrun was executed in
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// block_time is the time of the block the backrun was executed in
+	BlockTime time.Time `protobuf:"bytes,8,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
+}
+
+func (m *BackrunRecord) Reset()         { *m = BackrunRecord{} }
+func (m *BackrunRecord) String() string { return proto.CompactTextString(m) }
+func (*BackrunRecord) ProtoMessage()    {}
+func (*BackrunRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{14}
+}
+func (m *BackrunRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackrunRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackrunRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackrunRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackrunRecord.Merge(m, src)
+}
+func (m *BackrunRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackrunRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackrunRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackrunRecord proto.InternalMessageInfo
+
+func (m *BackrunRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *BackrunRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *BackrunRecord) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *BackrunRecord) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+func (m *BackrunRecord) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *BackrunRecord) GetProfit() types.Coin {
+	if m != nil {
+		return m.Profit
+	}
+	return types.Coin{}
+}
+
+func (m *BackrunRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BackrunRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// TriggerPoolStatistics contains the number of backruns triggered by swaps on a
+// given pool and the profits from the backruns
+type TriggerPoolStatistics struct {
+	// pool_id is the id of the pool swapped on by the triggering transactions
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// number_of_backruns is the number of backruns triggered by swaps on the pool
+	NumberOfBackruns uint64 `protobuf:"varint,2,opt,name=number_of_backruns,json=numberOfBackruns,proto3" json:"number_of_backruns,omitempty" yaml:"number_of_backruns"`
+	// profits is the total profit from the backruns triggered by swaps on the
+	// pool
+	Profits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=profits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"profits" yaml:"profits"`
+}
+
+func (m *TriggerPoolStatistics) Reset()         { *m = TriggerPoolStatistics{} }
+func (m *TriggerPoolStatistics) String() string { return proto.CompactTextString(m) }
+func (*TriggerPoolStatistics) ProtoMessage()    {}
+func (*TriggerPoolStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
+}
+func (m *TriggerPoolStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerPoolStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerPoolStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerPoolStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerPoolStatistics.Merge(m, src)
+}
+func (m *TriggerPoolStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerPoolStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerPoolStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerPoolStatistics proto.InternalMessageInfo
+
+func (m *TriggerPoolStatistics) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TriggerPoolStatistics) GetNumberOfBackruns() uint64 {
+	if m != nil {
+		return m.NumberOfBackruns
+	}
+	return 0
+}
+
+func (m *TriggerPoolStatistics) GetProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Profits
+	}
+	return nil
+}
+
+// TriggerPoolProfitShare contains the statistics of the backruns triggered by
+// swaps on a given pool alongside their share of the profits of all backruns
+type TriggerPoolProfitShare struct {
+	// statistics are the statistics of the backruns triggered by swaps on the
+	// pool
+	Statistics TriggerPoolStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics" yaml:"statistics"`
+	// profit_shares is the share of the profits of all backruns made by the
+	// backruns triggered by swaps on the pool, for each denom
+	ProfitShares github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=profit_shares,json=profitShares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"profit_shares" yaml:"profit_shares"`
+}
+
+func (m *TriggerPoolProfitShare) Reset()         { *m = TriggerPoolProfitShare{} }
+func (m *TriggerPoolProfitShare) String() string { return proto.CompactTextString(m) }
+func (*TriggerPoolProfitShare) ProtoMessage()    {}
+func (*TriggerPoolProfitShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{16}
+}
+func (m *TriggerPoolProfitShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerPoolProfitShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerPoolProfitShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerPoolProfitShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerPoolProfitShare.Merge(m, src)
+}
+func (m *TriggerPoolProfitShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerPoolProfitShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerPoolProfitShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerPoolProfitShare proto.InternalMessageInfo
+
+func (m *TriggerPoolProfitShare) GetStatistics() TriggerPoolStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return TriggerPoolStatistics{}
+}
+
+func (m *TriggerPoolProfitShare) GetProfitShares() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ProfitShares
+	}
+	return nil
+}
+
+// DryRunRoute is a cyclic arbitrage route the module tried to backrun a swap
+// with during a dry run
+type DryRunRoute struct {
+	// route is the cyclic arbitrage route
+	Route []types1.SwapAmountInRoute `protobuf:"bytes,1,rep,name=route,proto3" json:"route" yaml:"route"`
+	// pool_points is the number of pool points the route consumes if it is
+	// profitable
+	PoolPoints uint64 `protobuf:"varint,2,opt,name=pool_points,json=poolPoints,proto3" json:"pool_points,omitempty" yaml:"pool_points"`
+	// optimal_input is the amount in that maximizes the profit of the route.
+	// It is zero if the route is not profitable
+	OptimalInput types.Coin `protobuf:"bytes,3,opt,name=optimal_input,json=optimalInput,proto3" json:"optimal_input" yaml:"optimal_input"`
+	// profit is the expected profit of the route, denominated in the input denom
+	Profit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=profit,proto3,customtype=cosmossdk.io/math.Int" json:"profit" yaml:"profit"`
+	// error is the error encountered while searching for the optimal input, if
+	// any
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+	// executed is whether the route is the most profitable route tried after
+	// the swap, which the module executes
+	Executed bool `protobuf:"varint,6,opt,name=executed,proto3" json:"executed,omitempty" yaml:"executed"`
+}
+
+func (m *DryRunRoute) Reset()         { *m = DryRunRoute{} }
+func (m *DryRunRoute) String() string { return proto.CompactTextString(m) }
+func (*DryRunRoute) ProtoMessage()    {}
+func (*DryRunRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{17}
+}
+func (m *DryRunRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunRoute.Merge(m, src)
+}
+func (m *DryRunRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunRoute proto.InternalMessageInfo
+
+func (m *DryRunRoute) GetRoute() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *DryRunRoute) GetPoolPoints() uint64 {
+	if m != nil {
+		return m.PoolPoints
+	}
+	return 0
+}
+
+func (m *DryRunRoute) GetOptimalInput() types.Coin {
+	if m != nil {
+		return m.OptimalInput
+	}
+	return types.Coin{}
+}
+
+func (m *DryRunRoute) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DryRunRoute) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// DryRunBackrun contains the cyclic arbitrage routes the module tried to
+// backrun a swap on a given pool with during a dry run
+type DryRunBackrun struct {
+	// pool_id is the id of the pool swapped on
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the denom swapped into the pool
+	TokenIn string `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// token_out is the denom swapped out of the pool
+	TokenOut string `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+	// routes are the routes tried, in the order they were tried
+	Routes []DryRunRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *DryRunBackrun) Reset()         { *m = DryRunBackrun{} }
+func (m *DryRunBackrun) String() string { return proto.CompactTextString(m) }
+func (*DryRunBackrun) ProtoMessage()    {}
+func (*DryRunBackrun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{18}
+}
+func (m *DryRunBackrun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunBackrun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunBackrun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DryRunBackrun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunBackrun.Merge(m, src)
+}
+func (m *DryRunBackrun) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunBackrun) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunBackrun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunBackrun proto.InternalMessageInfo
+
+func (m *DryRunBackrun) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DryRunBackrun) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *DryRunBackrun) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+func (m *DryRunBackrun) GetRoutes() []DryRunRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
@@ -851,6 +1252,11 @@ func init() {
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
 	proto.RegisterType((*AllProtocolRevenue)(nil), "osmosis.protorev.v1beta1.AllProtocolRevenue")
 	proto.RegisterType((*CyclicArbTracker)(nil), "osmosis.protorev.v1beta1.CyclicArbTracker")
+	proto.RegisterType((*BackrunRecord)(nil), "osmosis.protorev.v1beta1.BackrunRecord")
+	proto.RegisterType((*TriggerPoolStatistics)(nil), "osmosis.protorev.v1beta1.TriggerPoolStatistics")
+	proto.RegisterType((*TriggerPoolProfitShare)(nil), "osmosis.protorev.v1beta1.TriggerPoolProfitShare")
+	proto.RegisterType((*DryRunRoute)(nil), "osmosis.protorev.v1beta1.DryRunRoute")
+	proto.RegisterType((*DryRunBackrun)(nil), "osmosis.protorev.v1beta1.DryRunBackrun")
 }
 
 func init() {
@@ -858,82 +1264,114 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xc1, 0x6f, 0x1b, 0xc5,
-	0x17, 0xce, 0xd6, 0x69, 0x5a, 0x8f, 0x5b, 0xdb, 0x9d, 0xa6, 0xad, 0xe3, 0xfe, 0x7e, 0xde, 0x30,
-	0x2d, 0xe0, 0x22, 0xc5, 0x26, 0x81, 0x03, 0x2a, 0xea, 0x21, 0x1b, 0x14, 0x11, 0x21, 0x92, 0x68,
-	0x62, 0xa9, 0x82, 0xcb, 0x32, 0xbb, 0x1e, 0x3b, 0x8b, 0xbd, 0x3b, 0xd6, 0xce, 0x38, 0x71, 0x8a,
-	0x54, 0x21, 0x71, 0xe4, 0xc2, 0x85, 0x1b, 0x07, 0x6e, 0x48, 0x48, 0xfc, 0x1f, 0x3d, 0xf6, 0x58,
-	0x71, 0x58, 0x50, 0x72, 0x41, 0x70, 0xf3, 0x5f, 0x80, 0x76, 0x66, 0x76, 0xed, 0x6c, 0xe2, 0x86,
-	0x48, 0x88, 0x93, 0x77, 0xdf, 0xfb, 0xbe, 0xef, 0xcd, 0xfb, 0xde, 0xce, 0x78, 0xc0, 0xdb, 0x8c,
-	0xfb, 0x8c, 0x7b, 0xbc, 0x39, 0x08, 0x99, 0x60, 0x21, 0x3d, 0x68, 0x1e, 0xac, 0x3a, 0x54, 0x90,
-	0xd5, 0x34, 0xd0, 0x90, 0x0f, 0xb0, 0xa2, 0x81, 0x8d, 0x34, 0xae, 0x81, 0xd5, 0x25, 0x57, 0xa6,
-	0x6c, 0x99, 0x68, 0xaa, 0x17, 0x85, 0xaa, 0x2e, 0x76, 0x59, 0x97, 0xa9, 0x78, 0xfc, 0xa4, 0xa3,
-	0x35, 0x85, 0x69, 0x3a, 0x84, 0xd3, 0xb4, 0x9c, 0xcb, 0xbc, 0x40, 0xe7, 0x1f, 0xa5, 0x6b, 0x62,
-	0xac, 0xef, 0x93, 0x80, 0x74, 0x69, 0x98, 0xe2, 0xba, 0x34, 0xa0, 0xe9, 0x32, 0xaa, 0x0f, 0x13,
-	0xa8, 0x18, 0x75, 0x28, 0xe5, 0xe7, 0xa3, 0xd0, 0x2b, 0x03, 0xc0, 0x16, 0xeb, 0xd1, 0x60, 0x97,
-	0x78, 0xe1, 0x7a, 0xe8, 0x60, 0x36, 0x14, 0x94, 0xc3, 0xcf, 0x00, 0x20, 0xa1, 0x63, 0x87, 0xf2,
-	0xad, 0x62, 0x2c, 0xe7, 0xea, 0x85, 0x35, 0xb3, 0x31, 0xab, 0xcf, 0x86, 0x64, 0x59, 0x4b, 0x2f,
-	0x22, 0x73, 0x6e, 0x1c, 0x99, 0xb7, 0x8e, 0x88, 0xdf, 0x7f, 0x8c, 0x26, 0x02, 0x08, 0xe7, 0x49,
-	0x2a, 0xdd, 0x00, 0xd7, 0x45, 0x5c, 0xd0, 0xf6, 0x82, 0xca, 0x95, 0x65, 0xa3, 0x9e, 0xb7, 0x6e,
-	0x8f, 0x23, 0xb3, 0xa4, 0x38, 0x49, 0x06, 0xe1, 0x6b, 0xf2, 0x71, 0x2b, 0x80, 0xab, 0x20, 0xaf,
-	0xa2, 0x6c, 0x28, 0x2a, 0x39, 0x49, 0x58, 0x1c, 0x47, 0x66, 0x79, 0x9a, 0xc0, 0x86, 0x02, 0x61,
-	0x25, 0xbb, 0x33, 0x14, 0x8f, 0xe7, 0xff, 0xf8, 0xd1, 0x34, 0xd0, 0x2f, 0x06, 0xb8, 0x2a, 0x6b,
-	0xc2, 0x6d, 0xb0, 0x20, 0x42, 0xd2, 0xfe, 0x27, 0x9d, 0xb4, 0x62, 0x9c, 0x75, 0x47, 0x77, 0x72,
-	0x53, 0x17, 0x91, 0x64, 0x84, 0xb5, 0x0a, 0xdc, 0x06, 0x79, 0x2e, 0xe8, 0xc0, 0xe6, 0xde, 0x33,
-	0xaa, 0x7b, 0x58, 0x8d, 0x19, 0xbf, 0x46, 0xe6, 0x1d, 0x35, 0x40, 0xde, 0xee, 0x35, 0x3c, 0xd6,
-	0xf4, 0x89, 0xd8, 0x6f, 0x6c, 0x05, 0x62, 0xb2, 0xde, 0x94, 0x87, 0xf0, 0xf5, 0xf8, 0x79, 0xcf,
-	0x7b, 0x46, 0xf5, 0x7a, 0xbf, 0x37, 0xc0, 0x55, 0x59, 0x1e, 0x3e, 0x00, 0xf3, 0xf1, 0x7c, 0x2b,
-	0xc6, 0xb2, 0x51, 0x9f, 0xb7, 0x4a, 0xe3, 0xc8, 0x2c, 0x28, 0x76, 0x1c, 0x45, 0x58, 0x26, 0xff,
-	0x3b, 0x1f, 0xff, 0x34, 0x40, 0x49, 0xfa, 0xb8, 0x27, 0x88, 0xf0, 0xb8, 0xf0, 0x5c, 0x0e, 0x3f,
-	0x01, 0xd7, 0x06, 0x21, 0xeb, 0x78, 0x22, 0xb1, 0x74, 0xa9, 0xa1, 0xbf, 0xee, 0xf8, 0xcb, 0x4d,
-	0xdd, 0xdc, 0x60, 0x5e, 0x60, 0xdd, 0xd5, 0x66, 0x16, 0x75, 0x0f, 0x8a, 0x87, 0x70, 0xa2, 0x00,
-	0x1d, 0x50, 0x0e, 0x86, 0xbe, 0x43, 0x43, 0x9b, 0x75, 0x6c, 0x3d, 0x28, 0xd5, 0xd1, 0x07, 0x17,
-	0xb9, 0x7a, 0x4f, 0x69, 0x66, 0xe9, 0x08, 0x17, 0x55, 0x68, 0xa7, 0xd3, 0x52, 0x23, 0x7b, 0x0b,
-	0x5c, 0x95, 0xdf, 0x62, 0x25, 0xb7, 0x9c, 0xab, 0xcf, 0x5b, 0xe5, 0x71, 0x64, 0xde, 0x50, 0x5c,
-	0x19, 0x46, 0x58, 0xa5, 0xd1, 0x4f, 0x57, 0x40, 0x61, 0x97, 0xb1, 0xfe, 0x53, 0xea, 0x75, 0xf7,
-	0x05, 0x87, 0x4f, 0xc0, 0x4d, 0x2e, 0x88, 0xd3, 0xa7, 0xf6, 0xa1, 0x8c, 0xe8, 0x99, 0x54, 0xc6,
-	0x91, 0xb9, 0x98, 0x4c, 0x74, 0x2a, 0x8d, 0xf0, 0x0d, 0xf5, 0xae, 0xf8, 0x70, 0x03, 0x94, 0x1c,
-	0xd2, 0x27, 0x81, 0x4b, 0xc3, 0x44, 0xe0, 0x8a, 0x14, 0xa8, 0x8e, 0x23, 0xf3, 0xae, 0x12, 0xc8,
-	0x00, 0x10, 0x2e, 0x26, 0x11, 0x2d, 0xb2, 0x03, 0x6e, 0xbb, 0x2c, 0x70, 0x69, 0x20, 0x42, 0x22,
-	0x68, 0x3b, 0x11, 0xca, 0x49, 0xa1, 0xda, 0x38, 0x32, 0xab, 0x4a, 0xe8, 0x1c, 0x10, 0xc2, 0x70,
-	0x3a, 0x3a, 0x59, 0x55, 0x6c, 0xe8, 0x21, 0xe1, 0x7e, 0x22, 0x36, 0x9f, 0x5d, 0x55, 0x06, 0x80,
-	0x70, 0x31, 0x89, 0x28, 0x11, 0xf4, 0x43, 0x0e, 0x14, 0xb7, 0x82, 0x0e, 0xb3, 0x8e, 0x62, 0xbf,
-	0x5a, 0x47, 0x03, 0x0a, 0x9f, 0x82, 0x05, 0xd5, 0xbd, 0x74, 0xa9, 0xb0, 0x56, 0x9f, 0xbd, 0xcf,
-	0xf6, 0x24, 0x2e, 0x66, 0x4a, 0x8d, 0xcc, 0x86, 0x53, 0x2a, 0x08, 0x6b, 0x39, 0x68, 0x83, 0xeb,
-	0x89, 0x27, 0xd2, 0xbf, 0xc2, 0xda, 0x3b, 0xb3, 0xa5, 0x2d, 0x8d, 0x4c, 0xc5, 0xef, 0x69, 0xf1,
-	0xd2, 0x69, 0xbf, 0x11, 0x4e, 0x45, 0x21, 0x03, 0x37, 0xa6, 0x7d, 0x92, 0xde, 0x16, 0xd6, 0x1a,
-	0xb3, 0x8b, 0x6c, 0x4c, 0xa1, 0xd3, 0x42, 0xf7, 0x75, 0xa1, 0xdb, 0x67, 0xe7, 0x81, 0xf0, 0xa9,
-	0x02, 0x71, 0x47, 0x89, 0x9f, 0xd2, 0xfb, 0xd7, 0x76, 0xb4, 0xa1, 0x91, 0xb3, 0x3a, 0x4a, 0x94,
-	0x10, 0x4e, 0x45, 0xd1, 0x87, 0xa0, 0x78, 0xda, 0x63, 0xf8, 0x08, 0x2c, 0x9c, 0xfa, 0x86, 0x6f,
-	0x4d, 0xfc, 0x4e, 0x66, 0xac, 0x01, 0xe8, 0x09, 0x28, 0x67, 0x5d, 0xbc, 0x0c, 0xfd, 0x5b, 0x03,
-	0x2c, 0x9e, 0x67, 0xd0, 0x25, 0x34, 0xe0, 0xc7, 0xe0, 0x96, 0x4f, 0x46, 0xb6, 0xf0, 0xdc, 0x1e,
-	0xb7, 0xdd, 0x90, 0x71, 0x4e, 0xdb, 0x7a, 0xef, 0xfc, 0x6f, 0x1c, 0x99, 0x15, 0xc5, 0x3a, 0x03,
-	0x41, 0xb8, 0xe4, 0x93, 0x51, 0x2b, 0x0e, 0x6d, 0xe8, 0x88, 0x00, 0xe5, 0xac, 0x81, 0xf0, 0x0b,
-	0x50, 0x50, 0x75, 0x6c, 0x9f, 0x0c, 0x92, 0x33, 0xec, 0xc1, 0xec, 0x09, 0xa8, 0x6f, 0xfe, 0x53,
-	0x32, 0xb0, 0xaa, 0xda, 0x7a, 0x38, 0xbd, 0x6c, 0xa9, 0x82, 0x30, 0x38, 0x4c, 0x60, 0x1c, 0x3d,
-	0x07, 0xf9, 0x94, 0x74, 0x99, 0xbe, 0x37, 0x41, 0xd9, 0x65, 0xb1, 0x6f, 0xae, 0xb0, 0x49, 0xbb,
-	0x1d, 0x52, 0x9e, 0x1c, 0x86, 0xf7, 0x27, 0xe7, 0x5d, 0x16, 0x81, 0x70, 0x29, 0x09, 0xad, 0xeb,
-	0xc8, 0x37, 0x06, 0xc8, 0x5b, 0x84, 0xd3, 0x8f, 0x68, 0xc0, 0xfc, 0xf8, 0xf8, 0x6b, 0xc7, 0x0f,
-	0xb2, 0x7e, 0x7e, 0xfa, 0xf8, 0x93, 0x61, 0x84, 0x55, 0xfa, 0xdf, 0xfe, 0x67, 0x43, 0x5f, 0xe7,
-	0x00, 0x5c, 0xef, 0xf7, 0x77, 0x63, 0x3f, 0x5d, 0xd6, 0xc7, 0xf4, 0x80, 0x06, 0x43, 0x0a, 0x9f,
-	0x03, 0x28, 0x48, 0x8f, 0x86, 0x76, 0x7c, 0x33, 0x89, 0xcf, 0x6c, 0xb7, 0x47, 0x43, 0x7d, 0x68,
-	0xac, 0x4c, 0xa6, 0x30, 0xb9, 0xe3, 0x4c, 0xfe, 0x9f, 0x63, 0xda, 0x26, 0xa5, 0xbc, 0xa5, 0x48,
-	0xd6, 0x1b, 0x7a, 0x1e, 0x4b, 0xfa, 0x7f, 0xec, 0x8c, 0x2c, 0xc2, 0x65, 0x91, 0x21, 0x41, 0x1f,
-	0x94, 0xc4, 0xe8, 0x74, 0x71, 0x75, 0xac, 0xbc, 0x99, 0x16, 0x57, 0xb7, 0xa6, 0x49, 0xdd, 0xd1,
-	0x74, 0xd1, 0x9a, 0x2e, 0xaa, 0xcf, 0xca, 0x8c, 0x16, 0xc2, 0x37, 0xc5, 0x34, 0x1c, 0x7e, 0x05,
-	0xa0, 0x7b, 0xe4, 0xf6, 0x3d, 0xd7, 0x8e, 0xef, 0x44, 0x49, 0xc5, 0xdc, 0x85, 0xdb, 0x5e, 0x72,
-	0xd6, 0x43, 0x67, 0x46, 0xaf, 0x67, 0x35, 0x11, 0x2e, 0xbb, 0x19, 0x12, 0xfa, 0xcb, 0x00, 0xe5,
-	0xac, 0x12, 0xfc, 0x12, 0x80, 0x09, 0xfb, 0xe2, 0xbf, 0xf0, 0x77, 0xe3, 0xc2, 0x3f, 0xff, 0x66,
-	0xd6, 0xbb, 0x9e, 0xd8, 0x1f, 0x3a, 0x0d, 0x97, 0xf9, 0xfa, 0x36, 0xab, 0x7f, 0x56, 0x78, 0xbb,
-	0xd7, 0x14, 0x47, 0x03, 0xca, 0x25, 0x81, 0xe3, 0x7c, 0xba, 0x0e, 0xd8, 0x03, 0xff, 0xdf, 0x57,
-	0xbb, 0x84, 0xb8, 0x2e, 0x1b, 0x06, 0xc2, 0x0b, 0xba, 0x36, 0x17, 0x24, 0x14, 0xdc, 0xee, 0x84,
-	0xcc, 0x97, 0xd6, 0xe7, 0xac, 0xfa, 0x38, 0x32, 0x1f, 0xaa, 0xc6, 0x5e, 0x0b, 0x47, 0xb8, 0xaa,
-	0xf2, 0xeb, 0x69, 0x7a, 0x4f, 0x66, 0x37, 0x43, 0xe6, 0x5b, 0xdb, 0x2f, 0x8e, 0x6b, 0xc6, 0xcb,
-	0xe3, 0x9a, 0xf1, 0xfb, 0x71, 0xcd, 0xf8, 0xee, 0xa4, 0x36, 0xf7, 0xf2, 0xa4, 0x36, 0xf7, 0xea,
-	0xa4, 0x36, 0xf7, 0xf9, 0xfb, 0x53, 0x6b, 0xd7, 0x96, 0xaf, 0xf4, 0x89, 0xc3, 0x93, 0x97, 0xe6,
-	0xc1, 0xda, 0x6a, 0x73, 0x34, 0xb9, 0xec, 0xcb, 0x6e, 0x9c, 0x05, 0xf9, 0xfe, 0xde, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x52, 0x72, 0x8b, 0x0d, 0x0c, 0x00, 0x00,
+	// 1707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0x8e, 0x13, 0x4f, 0x5c, 0x4e, 0x62, 0x4f, 0x4d, 0x66, 0xc6, 0xe3, 0x9d, 0x71, 0x87,
+	0xda, 0x65, 0xc9, 0xb2, 0x8c, 0x4d, 0x02, 0x12, 0x68, 0xd0, 0x1e, 0xd2, 0x19, 0x8d, 0x26, 0x1a,
+	0x31, 0x13, 0x55, 0x2c, 0x2d, 0xac, 0x90, 0x9a, 0x72, 0xbb, 0x62, 0x37, 0x76, 0x77, 0x59, 0x5d,
+	0xe5, 0xc4, 0x59, 0xa4, 0x15, 0x12, 0x37, 0x90, 0xd0, 0x5e, 0xf6, 0xc6, 0x01, 0x4e, 0x48, 0x48,
+	0xfc, 0x1f, 0x7b, 0xdc, 0x03, 0x87, 0x15, 0x07, 0x0f, 0x9a, 0xb9, 0x20, 0xb8, 0x59, 0xe2, 0x8e,
+	0xea, 0xa3, 0x3f, 0xdc, 0x89, 0x93, 0x89, 0x04, 0x9c, 0xdc, 0xf5, 0x3e, 0x7e, 0xaf, 0xea, 0xf7,
+	0xaa, 0x5e, 0xbd, 0x32, 0xf8, 0x16, 0xe3, 0x01, 0xe3, 0x3e, 0x6f, 0x8d, 0x22, 0x26, 0x58, 0x44,
+	0x4f, 0x5a, 0x27, 0x3b, 0x1d, 0x2a, 0xc8, 0x4e, 0x22, 0x68, 0xaa, 0x0f, 0x58, 0x33, 0x86, 0xcd,
+	0x44, 0x6e, 0x0c, 0xeb, 0xf7, 0x3d, 0xa5, 0x72, 0x95, 0xa2, 0xa5, 0x07, 0xda, 0xaa, 0xbe, 0xd9,
+	0x63, 0x3d, 0xa6, 0xe5, 0xf2, 0xcb, 0x48, 0xed, 0x1e, 0x63, 0xbd, 0x21, 0xd5, 0x11, 0x3a, 0xe3,
+	0xe3, 0x96, 0xf0, 0x03, 0xca, 0x05, 0x09, 0x46, 0xc6, 0xa0, 0xa1, 0x41, 0x5a, 0x1d, 0xc2, 0x69,
+	0x32, 0x1f, 0x8f, 0xf9, 0xa1, 0xd1, 0x7f, 0x90, 0x4c, 0x9a, 0xb1, 0x61, 0x40, 0x42, 0xd2, 0xa3,
+	0x51, 0x62, 0xd7, 0xa3, 0x21, 0x4d, 0xe6, 0x59, 0xff, 0xce, 0x65, 0xa6, 0xfc, 0x94, 0x8c, 0xdc,
+	0x88, 0x8d, 0x05, 0x35, 0xd6, 0xef, 0xc5, 0xd6, 0x62, 0x72, 0x4c, 0x29, 0xbf, 0x18, 0x13, 0x7d,
+	0x6d, 0x01, 0xd8, 0x66, 0x03, 0x1a, 0x1e, 0x12, 0x3f, 0xda, 0x8b, 0x3a, 0x58, 0x22, 0x70, 0xf8,
+	0x53, 0x00, 0x48, 0xd4, 0xd1, 0x78, 0xbc, 0x66, 0x6d, 0x15, 0xb6, 0xcb, 0xbb, 0x76, 0x73, 0x11,
+	0x6d, 0x4d, 0xe5, 0xe5, 0xdc, 0xff, 0x72, 0x6a, 0xdf, 0x98, 0x4d, 0xed, 0x5b, 0x67, 0x24, 0x18,
+	0x3e, 0x46, 0x29, 0x00, 0xc2, 0x25, 0x92, 0x40, 0x37, 0xc1, 0xaa, 0x90, 0x01, 0x5d, 0x3f, 0xac,
+	0x2d, 0x6d, 0x59, 0xdb, 0x25, 0xe7, 0xf6, 0x6c, 0x6a, 0x57, 0xb4, 0x4f, 0xac, 0x41, 0xf8, 0xa6,
+	0xfa, 0x3c, 0x08, 0xe1, 0x0e, 0x28, 0x69, 0x29, 0x1b, 0x8b, 0x5a, 0x41, 0x39, 0x6c, 0xce, 0xa6,
+	0x76, 0x35, 0xeb, 0xc0, 0xc6, 0x02, 0x61, 0x0d, 0xfb, 0x72, 0x2c, 0x1e, 0x2f, 0xff, 0xe3, 0x0f,
+	0xb6, 0x85, 0xfe, 0x62, 0x81, 0x15, 0x15, 0x13, 0xbe, 0x00, 0x45, 0x11, 0x91, 0xee, 0xdb, 0xac,
+	0xa4, 0x2d, 0xed, 0x9c, 0x3b, 0x66, 0x25, 0xeb, 0x26, 0x88, 0x72, 0x46, 0xd8, 0xa0, 0xc0, 0x17,
+	0xa0, 0xc4, 0x05, 0x1d, 0xb9, 0xdc, 0xff, 0x94, 0x9a, 0x35, 0xec, 0x48, 0x8f, 0xbf, 0x4d, 0xed,
+	0x3b, 0x3a, 0xdd, 0xbc, 0x3b, 0x68, 0xfa, 0xac, 0x15, 0x10, 0xd1, 0x6f, 0x1e, 0x84, 0x22, 0x9d,
+	0x6f, 0xe2, 0x87, 0xf0, 0xaa, 0xfc, 0x3e, 0xf2, 0x3f, 0xa5, 0x66, 0xbe, 0x5f, 0x58, 0x60, 0x45,
+	0x85, 0x87, 0xef, 0x82, 0x65, 0x99, 0xe2, 0x9a, 0xb5, 0x65, 0x6d, 0x2f, 0x3b, 0x95, 0xd9, 0xd4,
+	0x2e, 0x6b, 0x6f, 0x29, 0x45, 0x58, 0x29, 0xff, 0x7f, 0x3c, 0xfe, 0xd3, 0x02, 0x15, 0xc5, 0xe3,
+	0x91, 0x20, 0xc2, 0xe7, 0xc2, 0xf7, 0x38, 0x7c, 0x0e, 0x6e, 0x8e, 0x22, 0x76, 0xec, 0x8b, 0x98,
+	0xd2, 0xfb, 0x4d, 0x73, 0x58, 0xe4, 0x3e, 0x4f, 0xd8, 0xdc, 0x67, 0x7e, 0xe8, 0xdc, 0x35, 0x64,
+	0x6e, 0x98, 0x35, 0x68, 0x3f, 0x84, 0x63, 0x04, 0xd8, 0x01, 0xd5, 0x70, 0x1c, 0x74, 0x68, 0xe4,
+	0xb2, 0x63, 0xd7, 0x24, 0x4a, 0xaf, 0xe8, 0x87, 0x57, 0xb1, 0x7a, 0x4f, 0x63, 0xe6, 0xdd, 0x11,
+	0xde, 0xd0, 0xa2, 0x97, 0xc7, 0x6d, 0x9d, 0xb2, 0xf7, 0xc1, 0x8a, 0xda, 0x8b, 0xb5, 0xc2, 0x56,
+	0x61, 0x7b, 0xd9, 0xa9, 0xce, 0xa6, 0xf6, 0x9a, 0xf6, 0x55, 0x62, 0x84, 0xb5, 0x1a, 0xfd, 0x69,
+	0x09, 0x94, 0x0f, 0x19, 0x1b, 0x7e, 0x4c, 0xfd, 0x5e, 0x5f, 0x70, 0xf8, 0x11, 0x58, 0xe7, 0x82,
+	0x74, 0x86, 0xd4, 0x3d, 0x55, 0x12, 0x93, 0x93, 0xda, 0x6c, 0x6a, 0x6f, 0xc6, 0x19, 0xcd, 0xa8,
+	0x11, 0x5e, 0xd3, 0x63, 0xed, 0x0f, 0xf7, 0x41, 0xa5, 0x43, 0x86, 0x24, 0xf4, 0x68, 0x14, 0x03,
+	0x2c, 0x29, 0x80, 0xfa, 0x6c, 0x6a, 0xdf, 0xd5, 0x00, 0x39, 0x03, 0x84, 0x37, 0x62, 0x89, 0x01,
+	0x79, 0x09, 0x6e, 0x7b, 0x2c, 0xf4, 0x68, 0x28, 0x22, 0x22, 0x68, 0x37, 0x06, 0x2a, 0x28, 0xa0,
+	0xc6, 0x6c, 0x6a, 0xd7, 0x35, 0xd0, 0x05, 0x46, 0x08, 0xc3, 0xac, 0x34, 0x9d, 0x95, 0x24, 0xf4,
+	0x94, 0xf0, 0x20, 0x06, 0x5b, 0xce, 0xcf, 0x2a, 0x67, 0x80, 0xf0, 0x46, 0x2c, 0xd1, 0x20, 0xe8,
+	0xf7, 0x05, 0xb0, 0x71, 0x10, 0x1e, 0x33, 0xe7, 0x4c, 0xf2, 0xd5, 0x3e, 0x1b, 0x51, 0xf8, 0x31,
+	0x28, 0xea, 0xd5, 0x2b, 0x96, 0xca, 0xbb, 0xdb, 0x8b, 0xcf, 0xd9, 0x91, 0xb2, 0x93, 0x9e, 0x0a,
+	0x23, 0x77, 0xe0, 0x34, 0x0a, 0xc2, 0x06, 0x0e, 0xba, 0x60, 0x35, 0xe6, 0x44, 0xf1, 0x57, 0xde,
+	0xfd, 0xf6, 0x62, 0x68, 0xc7, 0x58, 0x26, 0xe0, 0xf7, 0x0c, 0x78, 0x65, 0x9e, 0x6f, 0x84, 0x13,
+	0x50, 0xc8, 0xc0, 0x5a, 0x96, 0x27, 0xc5, 0x6d, 0x79, 0xb7, 0xb9, 0x38, 0xc8, 0x7e, 0xc6, 0x3a,
+	0x09, 0xf4, 0x8e, 0x09, 0x74, 0xfb, 0x7c, 0x3e, 0x10, 0x9e, 0x0b, 0x20, 0x57, 0x14, 0xf3, 0x59,
+	0x5b, 0xbe, 0x6a, 0x45, 0xfb, 0xc6, 0x72, 0xd1, 0x8a, 0x62, 0x24, 0x84, 0x13, 0x50, 0xf4, 0x23,
+	0xb0, 0x31, 0xcf, 0x31, 0xfc, 0x00, 0x14, 0xe7, 0xf6, 0xf0, 0xad, 0x94, 0xef, 0x38, 0xc7, 0xc6,
+	0x00, 0x7d, 0x04, 0xaa, 0x79, 0x16, 0xaf, 0xe3, 0xfe, 0x5b, 0x0b, 0x6c, 0x5e, 0x44, 0xd0, 0x35,
+	0x30, 0xe0, 0x33, 0x70, 0x2b, 0x20, 0x13, 0x57, 0xf8, 0xde, 0x80, 0xbb, 0x5e, 0xc4, 0x38, 0xa7,
+	0x5d, 0x73, 0x76, 0x1e, 0xcc, 0xa6, 0x76, 0x4d, 0x7b, 0x9d, 0x33, 0x41, 0xb8, 0x12, 0x90, 0x49,
+	0x5b, 0x8a, 0xf6, 0x8d, 0x44, 0x80, 0x6a, 0x9e, 0x40, 0xf8, 0x73, 0x50, 0xd6, 0x71, 0xdc, 0x80,
+	0x8c, 0xe2, 0x1a, 0xf6, 0xee, 0xe2, 0x0c, 0xe8, 0x3d, 0xff, 0x63, 0x32, 0x72, 0xea, 0x86, 0x7a,
+	0x98, 0x9d, 0xb6, 0x42, 0x41, 0x18, 0x9c, 0xc6, 0x66, 0x1c, 0x7d, 0x06, 0x4a, 0x89, 0xd3, 0x75,
+	0xd6, 0xfd, 0x14, 0x54, 0x3d, 0x26, 0x79, 0xf3, 0x84, 0x4b, 0xba, 0xdd, 0x88, 0xf2, 0xb8, 0x18,
+	0xbe, 0x93, 0xd6, 0xbb, 0xbc, 0x05, 0xc2, 0x95, 0x58, 0xb4, 0x67, 0x24, 0xbf, 0xb6, 0x40, 0xc9,
+	0x21, 0x9c, 0x3e, 0xa1, 0x21, 0x0b, 0x64, 0xf9, 0xeb, 0xca, 0x0f, 0x15, 0xbf, 0x94, 0x2d, 0x7f,
+	0x4a, 0x8c, 0xb0, 0x56, 0xff, 0xb7, 0x6f, 0x36, 0xf4, 0xab, 0x02, 0x80, 0x7b, 0xc3, 0xe1, 0xa1,
+	0xe4, 0xd3, 0x63, 0x43, 0x4c, 0x4f, 0x68, 0x38, 0xa6, 0xf0, 0x33, 0x00, 0x05, 0x19, 0xd0, 0xc8,
+	0x95, 0x9d, 0x89, 0xac, 0xd9, 0xde, 0x80, 0x46, 0xa6, 0x68, 0x3c, 0x4a, 0xb3, 0x90, 0xb6, 0x39,
+	0xe9, 0xfd, 0x2c, 0xdd, 0x9e, 0x52, 0xca, 0xdb, 0xda, 0xc9, 0xf9, 0x86, 0xc9, 0xc7, 0x7d, 0x73,
+	0x8f, 0x9d, 0x83, 0x45, 0xb8, 0x2a, 0x72, 0x4e, 0x30, 0x00, 0x15, 0x31, 0x99, 0x0f, 0xae, 0xcb,
+	0xca, 0x37, 0x93, 0xe0, 0xba, 0x6b, 0x4a, 0xe3, 0x4e, 0xb2, 0x41, 0x1b, 0x26, 0xa8, 0xa9, 0x95,
+	0x39, 0x2c, 0x84, 0xd7, 0x45, 0xd6, 0x1c, 0xfe, 0x12, 0x40, 0xef, 0xcc, 0x1b, 0xfa, 0x9e, 0x2b,
+	0x7b, 0xa2, 0x38, 0x62, 0xe1, 0xca, 0x63, 0xaf, 0x7c, 0xf6, 0xa2, 0xce, 0x82, 0xb5, 0x9e, 0xc7,
+	0x44, 0xb8, 0xea, 0xe5, 0x9c, 0xd0, 0xbf, 0x2c, 0x50, 0xcd, 0x23, 0xc1, 0x5f, 0x00, 0x90, 0x7a,
+	0x5f, 0x7d, 0x85, 0x7f, 0x57, 0x06, 0xfe, 0xf3, 0x2b, 0x7b, 0xbb, 0xe7, 0x8b, 0xfe, 0xb8, 0xd3,
+	0xf4, 0x58, 0x60, 0x9a, 0x63, 0xf3, 0xf3, 0x88, 0x77, 0x07, 0x2d, 0x71, 0x36, 0xa2, 0x5c, 0x39,
+	0x70, 0x5c, 0x4a, 0xe6, 0x01, 0x07, 0xe0, 0x61, 0x5f, 0x9f, 0x12, 0xe2, 0x79, 0x6c, 0x1c, 0x0a,
+	0x3f, 0xec, 0xb9, 0x5c, 0x90, 0x48, 0x70, 0xf7, 0x38, 0x62, 0x81, 0xa2, 0xbe, 0xe0, 0x6c, 0xcf,
+	0xa6, 0xf6, 0x7b, 0x7a, 0x61, 0x97, 0x9a, 0x23, 0x5c, 0xd7, 0xfa, 0xbd, 0x44, 0x7d, 0xa4, 0xb4,
+	0x4f, 0xa5, 0xf2, 0xaf, 0x05, 0xb0, 0xee, 0x10, 0x6f, 0x10, 0x8d, 0x43, 0x4c, 0x3d, 0x16, 0x75,
+	0xe1, 0x87, 0xe0, 0xa6, 0x98, 0xb8, 0x7d, 0xc2, 0xfb, 0x66, 0xf3, 0xc3, 0xb4, 0x17, 0x31, 0x0a,
+	0xd9, 0xd9, 0x4d, 0x9e, 0x11, 0xde, 0x97, 0xc6, 0x72, 0xd7, 0xb9, 0x7e, 0x5c, 0x6b, 0x32, 0xc6,
+	0x46, 0x81, 0x70, 0x51, 0x7e, 0x1d, 0x74, 0xe7, 0x3a, 0xb0, 0xc2, 0x75, 0x3b, 0xb0, 0xe5, 0xb7,
+	0xe9, 0xc0, 0xd2, 0xb6, 0x65, 0xe5, 0xd2, 0xb6, 0x05, 0x3e, 0x03, 0x45, 0xdd, 0x4d, 0xd5, 0x8a,
+	0x5b, 0xd6, 0xe5, 0xb9, 0xcc, 0x5d, 0xb5, 0xda, 0x4d, 0x2e, 0x4a, 0x7d, 0xc0, 0xc7, 0x60, 0xad,
+	0x33, 0x64, 0xde, 0xc0, 0xd5, 0x24, 0xd7, 0x6e, 0xaa, 0xe4, 0xdc, 0x4b, 0x6f, 0xb5, 0xac, 0x16,
+	0xe1, 0xb2, 0x1a, 0x3e, 0x53, 0x23, 0xf8, 0x13, 0x00, 0xb4, 0x56, 0x3e, 0x82, 0x6a, 0xab, 0x6a,
+	0x26, 0xf5, 0xa6, 0x7e, 0x21, 0x35, 0xe3, 0x17, 0x52, 0xb3, 0x1d, 0xbf, 0x90, 0x9c, 0x87, 0xf3,
+	0x0f, 0x86, 0xd4, 0x17, 0x7d, 0xfe, 0xca, 0xb6, 0x70, 0x49, 0x09, 0xa4, 0x39, 0xfa, 0xcd, 0x12,
+	0xb8, 0xd3, 0x8e, 0xfc, 0x5e, 0x4f, 0x5f, 0x48, 0x99, 0x4e, 0x34, 0x93, 0x31, 0xeb, 0xca, 0x8c,
+	0x3d, 0x07, 0x30, 0x6d, 0x15, 0x3b, 0x7a, 0x9b, 0x70, 0x93, 0xe9, 0x87, 0xe9, 0xc1, 0x3a, 0x6f,
+	0x83, 0x70, 0x35, 0x6e, 0x28, 0xcd, 0xee, 0xe2, 0xf0, 0x34, 0xed, 0x81, 0x0b, 0x57, 0x1d, 0x20,
+	0xe7, 0xe2, 0x1e, 0xf8, 0x5a, 0x47, 0x2a, 0x8e, 0x86, 0xbe, 0x58, 0x02, 0x77, 0x33, 0x64, 0x1c,
+	0x2a, 0xf1, 0x51, 0x9f, 0x44, 0x54, 0x9e, 0x6b, 0x9e, 0x70, 0x63, 0x0a, 0x6a, 0xeb, 0xb2, 0xd7,
+	0xce, 0x05, 0x94, 0xe6, 0xdf, 0x71, 0x29, 0x20, 0xc2, 0x19, 0x74, 0xf8, 0x3b, 0x0b, 0xac, 0xeb,
+	0x29, 0xb9, 0x5c, 0x06, 0x97, 0x44, 0x4a, 0x1a, 0x1e, 0x5c, 0x48, 0xc3, 0x13, 0xea, 0x29, 0x26,
+	0x9e, 0x1b, 0xf0, 0xcd, 0x2c, 0x13, 0x06, 0x40, 0xf2, 0xf1, 0xe1, 0x5b, 0xf0, 0x61, 0xb0, 0x38,
+	0x5e, 0x1b, 0xa5, 0x6b, 0xe7, 0xe8, 0x8f, 0x05, 0x50, 0x7e, 0x12, 0x9d, 0xe1, 0x71, 0xa8, 0x9f,
+	0x7d, 0x9f, 0xc4, 0x87, 0x47, 0xd7, 0xb7, 0xe6, 0xa5, 0x17, 0xcb, 0xd1, 0x29, 0x19, 0xed, 0x05,
+	0xb2, 0xa0, 0x1c, 0x68, 0x77, 0x67, 0xd3, 0xcc, 0xf4, 0xc2, 0x03, 0xf7, 0x03, 0x50, 0x56, 0xbb,
+	0x6b, 0xc4, 0xfc, 0x50, 0xc4, 0x5b, 0xe8, 0x6e, 0xda, 0x17, 0x64, 0x94, 0x08, 0x03, 0x39, 0x3a,
+	0x54, 0x03, 0xf8, 0x33, 0xb0, 0xce, 0x46, 0xc2, 0x0f, 0xc8, 0xd0, 0xf5, 0xc3, 0x91, 0x79, 0x8a,
+	0x5d, 0xba, 0x77, 0x1e, 0xcc, 0x33, 0x36, 0xe7, 0x8d, 0xf0, 0x9a, 0x19, 0x1f, 0xc8, 0x21, 0x7c,
+	0x9a, 0xd4, 0x01, 0x5d, 0x5f, 0x9a, 0x57, 0x5d, 0xde, 0x0b, 0xaa, 0xc0, 0xfb, 0x60, 0x85, 0x46,
+	0x11, 0x8b, 0x6a, 0x2b, 0xf9, 0x7e, 0x41, 0x89, 0x11, 0xd6, 0x6a, 0xd8, 0x02, 0xab, 0x74, 0x42,
+	0xbd, 0xb1, 0xec, 0x99, 0x65, 0xe5, 0x59, 0xcd, 0x96, 0xc0, 0x58, 0x83, 0x70, 0x62, 0x84, 0xfe,
+	0x6d, 0x81, 0x75, 0x9d, 0x23, 0x73, 0x8e, 0xae, 0x77, 0x80, 0xff, 0xf7, 0x8f, 0x5e, 0xd8, 0x06,
+	0x45, 0xf3, 0xb7, 0xc7, 0xf2, 0x56, 0x61, 0xae, 0x25, 0x38, 0x77, 0x7c, 0x32, 0x9b, 0x2d, 0x5f,
+	0x56, 0xe3, 0x3f, 0x3e, 0x0c, 0x96, 0xf3, 0xe2, 0xcb, 0xd7, 0x0d, 0xeb, 0xab, 0xd7, 0x0d, 0xeb,
+	0xef, 0xaf, 0x1b, 0xd6, 0xe7, 0x6f, 0x1a, 0x37, 0xbe, 0x7a, 0xd3, 0xb8, 0xf1, 0xf5, 0x9b, 0xc6,
+	0x8d, 0x4f, 0xbe, 0x9f, 0xd9, 0xf0, 0x26, 0xd2, 0xa3, 0x21, 0xe9, 0xf0, 0x78, 0xd0, 0x3a, 0xd9,
+	0xdd, 0x69, 0x4d, 0xd2, 0xff, 0xb4, 0xd4, 0x11, 0xe8, 0x14, 0xd5, 0xf8, 0x7b, 0xff, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0x52, 0x91, 0x39, 0xbe, 0xf4, 0x12, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -1640,40 +2078,359 @@ func (m *CyclicArbTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtorev(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BackrunRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenPairArbRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BackrunRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackrunRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ArbRoutes) > 0 {
-		for _, e := range m.ArbRoutes {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
-		}
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	i -= n10
+	i = encodeVarintProtorev(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
+	{
+		size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Route) > 0 {
+		dAtA13 := make([]byte, len(m.Route)*10)
+		var j12 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintProtorev(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerPoolStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerPoolStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerPoolStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumberOfBackruns != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.NumberOfBackruns))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerPoolProfitShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerPoolProfitShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerPoolProfitShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProfitShares) > 0 {
+		for iNdEx := len(m.ProfitShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OptimalInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolPoints != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunBackrun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunBackrun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunBackrun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPairArbRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArbRoutes) > 0 {
+		for _, e := range m.ArbRoutes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	return n
+}
+
 func (m *Route) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1891,6 +2648,137 @@ func (m *CyclicArbTracker) Size() (n int) {
 	return n
 }
 
+func (m *BackrunRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovProtorev(uint64(e))
+		}
+		n += 1 + sovProtorev(uint64(l)) + l
+	}
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovProtorev(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *TriggerPoolStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	if m.NumberOfBackruns != 0 {
+		n += 1 + sovProtorev(uint64(m.NumberOfBackruns))
+	}
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggerPoolProfitShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if len(m.ProfitShares) > 0 {
+		for _, e := range m.ProfitShares {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DryRunRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	if m.PoolPoints != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolPoints))
+	}
+	l = m.OptimalInput.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DryRunBackrun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovProtorev(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3043,19 +3931,815 @@ func (m *CosmwasmPoolInfo) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CosmwasmPoolInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CosmwasmPoolInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmwasmPoolInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmwasmPoolInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightMaps = append(m.WeightMaps, WeightMap{})
+			if err := m.WeightMaps[len(m.WeightMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllProtocolRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllProtocolRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeesTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CyclicArbTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CyclicArbTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CyclicArbTracker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CyclicArbTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CyclicArb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CyclicArb = append(m.CyclicArb, types.Coin{})
+			if err := m.CyclicArb[len(m.CyclicArb)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightAccountingStartsFrom", wireType)
+			}
+			m.HeightAccountingStartsFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightAccountingStartsFrom |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackrunRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackrunRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackrunRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtorev
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeightMaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3082,8 +4766,7 @@ func (m *CosmwasmPoolInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WeightMaps = append(m.WeightMaps, WeightMap{})
-			if err := m.WeightMaps[len(m.WeightMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3108,7 +4791,7 @@ func (m *CosmwasmPoolInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightMap) Unmarshal(dAtA []byte) error {
+func (m *TriggerPoolStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,17 +4814,17 @@ func (m *WeightMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerPoolStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerPoolStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Weight = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3151,16 +4834,35 @@ func (m *WeightMap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfBackruns", wireType)
+			}
+			m.NumberOfBackruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfBackruns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3170,23 +4872,25 @@ func (m *WeightMap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3209,7 +4913,7 @@ func (m *WeightMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BaseDenom) Unmarshal(dAtA []byte) error {
+func (m *TriggerPoolProfitShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3232,17 +4936,17 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerPoolProfitShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerPoolProfitShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3252,29 +4956,30 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3284,23 +4989,23 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProfitShares = append(m.ProfitShares, types.DecCoin{})
+			if err := m.ProfitShares[len(m.ProfitShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3325,7 +5030,7 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
+func (m *DryRunRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3348,15 +5053,15 @@ func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllProtocolRevenue: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllProtocolRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3383,13 +5088,33 @@ func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Route = append(m.Route, types1.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPoints", wireType)
+			}
+			m.PoolPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxFeesTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimalInput", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3416,15 +5141,15 @@ func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OptimalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CyclicArbTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3434,25 +5159,78 @@ func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CyclicArbTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -3474,7 +5252,7 @@ func (m *AllProtocolRevenue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
+func (m *DryRunBackrun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,17 +5275,36 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CyclicArbTracker: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRunBackrun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CyclicArbTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRunBackrun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CyclicArb", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3517,31 +5314,61 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtorev
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProtorev
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CyclicArb = append(m.CyclicArb, types.Coin{})
-			if err := m.CyclicArb[len(m.CyclicArb)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeightAccountingStartsFrom", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			m.HeightAccountingStartsFrom = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -3551,11 +5378,26 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeightAccountingStartsFrom |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, DryRunRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -7,11 +7,13 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -1469,1520 +1471,1606 @@ func (m *QueryGetAllProtocolRevenueResponse) GetAllProtocolRevenue() AllProtocol
 	return AllProtocolRevenue{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevInfoByPoolTypeRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevInfoByPoolTypeRequest")
-	proto.RegisterType((*QueryGetProtoRevInfoByPoolTypeResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevInfoByPoolTypeResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
-	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
-	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
-	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
-	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+// QueryGetProtoRevMaxCyclicRouteHopsRequest is request type for the
+// Query/GetProtoRevMaxCyclicRouteHops RPC method.
+type QueryGetProtoRevMaxCyclicRouteHopsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) Reset() {
+	*m = QueryGetProtoRevMaxCyclicRouteHopsRequest{}
 }
-
-var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6f, 0xdb, 0xd4,
-	0x1b, 0xae, 0xf7, 0xd1, 0xfe, 0x76, 0xf6, 0xf1, 0x5b, 0x0f, 0x6d, 0x97, 0xba, 0x5d, 0x92, 0x9e,
-	0xb6, 0xeb, 0xd7, 0x9a, 0xb0, 0x0f, 0x60, 0x7c, 0x0c, 0xa8, 0x57, 0x98, 0xaa, 0x89, 0x35, 0x98,
-	0x72, 0x03, 0x12, 0xc1, 0x49, 0xdc, 0xce, 0x9a, 0xe3, 0xe3, 0xd9, 0x4e, 0xd5, 0xdc, 0x32, 0x09,
-	0x84, 0x84, 0xc4, 0xd7, 0x1f, 0x00, 0xd7, 0x88, 0x7f, 0x80, 0x4b, 0xb8, 0x9a, 0xe0, 0x66, 0x08,
-	0x09, 0xa1, 0x81, 0x22, 0xb4, 0x71, 0xc1, 0x75, 0xfe, 0x00, 0x84, 0x7c, 0xce, 0xeb, 0xc4, 0xf1,
-	0xb1, 0xd3, 0xb8, 0x95, 0xb8, 0x4b, 0x7c, 0xde, 0xf7, 0x79, 0x9f, 0xe7, 0x7c, 0x3e, 0x2f, 0x9a,
-	0xa3, 0x6e, 0x9d, 0xba, 0x86, 0x5b, 0xb4, 0x1d, 0xea, 0x51, 0x47, 0xdf, 0x2d, 0xee, 0x5e, 0xaa,
-	0xe8, 0x9e, 0x76, 0xa9, 0x78, 0xaf, 0xa1, 0x3b, 0xcd, 0x02, 0xfb, 0x8c, 0x33, 0x10, 0x55, 0x08,
-	0xa2, 0x0a, 0x10, 0x25, 0x8f, 0xed, 0xd0, 0x1d, 0xca, 0xbe, 0x16, 0xfd, 0x5f, 0x3c, 0x40, 0x9e,
-	0xde, 0xa1, 0x74, 0xc7, 0xd4, 0x8b, 0x9a, 0x6d, 0x14, 0x35, 0xcb, 0xa2, 0x9e, 0xe6, 0x19, 0xd4,
-	0x82, 0x74, 0x79, 0xb9, 0xca, 0xe0, 0x8a, 0x15, 0xcd, 0xd5, 0x79, 0x99, 0x4e, 0x51, 0x5b, 0xdb,
-	0x31, 0x2c, 0x16, 0x0c, 0xb1, 0xf3, 0x89, 0xfc, 0x6c, 0xcd, 0xd1, 0xea, 0x01, 0xe4, 0x42, 0x72,
-	0x58, 0xc0, 0x98, 0x07, 0x66, 0xc3, 0xb5, 0x83, 0x98, 0x2a, 0x35, 0xa0, 0x1e, 0x19, 0x43, 0xf8,
-	0x4d, 0x9f, 0x51, 0x89, 0xa1, 0xab, 0xfa, 0xbd, 0x86, 0xee, 0x7a, 0x64, 0x1b, 0x3d, 0xd5, 0xf3,
-	0xd5, 0xb5, 0xa9, 0xe5, 0xea, 0x78, 0x13, 0x0d, 0x73, 0x16, 0x19, 0x29, 0x2f, 0x2d, 0x9e, 0xbc,
-	0x9c, 0x2f, 0x24, 0xcd, 0x53, 0x81, 0x67, 0x2a, 0xe3, 0x0f, 0x5a, 0xb9, 0xa1, 0x76, 0x2b, 0x77,
-	0xba, 0xa9, 0xd5, 0xcd, 0x17, 0x08, 0xcf, 0x26, 0x2a, 0xc0, 0x90, 0x05, 0x34, 0xcf, 0xea, 0xdc,
-	0xd4, 0xbd, 0x92, 0x8f, 0xa0, 0xea, 0xbb, 0xb7, 0x1b, 0xf5, 0x8a, 0xee, 0x6c, 0x6e, 0x6f, 0x39,
-	0x5a, 0x4d, 0xef, 0x10, 0xfa, 0x44, 0x42, 0x17, 0xf6, 0x8b, 0x04, 0x92, 0x15, 0x74, 0xd6, 0x62,
-	0x23, 0x65, 0xba, 0x5d, 0xf6, 0xd8, 0x18, 0xa3, 0x7b, 0x42, 0xb9, 0xe6, 0x93, 0x79, 0xd4, 0xca,
-	0x8d, 0xf3, 0x39, 0x71, 0x6b, 0x77, 0x0b, 0x06, 0x2d, 0xd6, 0x35, 0xef, 0x4e, 0x61, 0xc3, 0xf2,
-	0xda, 0xad, 0xdc, 0x39, 0xce, 0x32, 0x9a, 0x4e, 0xd4, 0x33, 0x56, 0x4f, 0x2d, 0xb2, 0x29, 0xf2,
-	0x2e, 0x39, 0x74, 0xdb, 0xf0, 0x5c, 0xa5, 0xb9, 0xae, 0x5b, 0xb4, 0x0e, 0xbc, 0xf1, 0x05, 0x74,
-	0xbc, 0xe6, 0xff, 0x07, 0x06, 0x67, 0xdb, 0xad, 0xdc, 0x29, 0x5e, 0x84, 0x7d, 0x26, 0x2a, 0x1f,
-	0x26, 0x96, 0x28, 0x2f, 0x0a, 0x08, 0xf2, 0xd6, 0xd1, 0xb0, 0xcd, 0x46, 0x60, 0x0d, 0x26, 0x0b,
-	0x5c, 0x4d, 0xc1, 0x5f, 0xe1, 0xce, 0xf4, 0xdf, 0xa0, 0x86, 0xa5, 0x8c, 0x86, 0x26, 0x9e, 0xa5,
-	0xf8, 0x13, 0xcf, 0x7f, 0xcc, 0xa2, 0x99, 0x68, 0xbd, 0x35, 0xd3, 0x84, 0x92, 0xc1, 0xa4, 0xdf,
-	0x43, 0xa4, 0x5f, 0x10, 0x10, 0xba, 0x85, 0x46, 0x38, 0xa8, 0x3f, 0xcd, 0x47, 0xfb, 0x33, 0x9a,
-	0x80, 0xed, 0x70, 0x26, 0xcc, 0xca, 0x25, 0xea, 0x48, 0xe7, 0x17, 0x5a, 0x8c, 0x96, 0x7c, 0xcb,
-	0x3f, 0x4c, 0xae, 0x67, 0x54, 0x5d, 0xa5, 0xa9, 0xd2, 0x86, 0xa7, 0x87, 0xe6, 0xd6, 0xf1, 0xff,
-	0xb3, 0xb2, 0xc7, 0xc2, 0x73, 0xcb, 0x3e, 0x13, 0x95, 0x0f, 0x93, 0xcf, 0x25, 0xb4, 0x34, 0x00,
-	0x28, 0xc8, 0xa9, 0x21, 0xe4, 0x76, 0x06, 0x61, 0x8e, 0x97, 0x92, 0xf7, 0x39, 0x4b, 0x0e, 0xa1,
-	0x4d, 0x82, 0xc2, 0x51, 0xce, 0xa4, 0x0b, 0x45, 0xd4, 0x10, 0x2e, 0x59, 0x11, 0x29, 0xad, 0x99,
-	0x66, 0x04, 0x2c, 0x58, 0x87, 0x2f, 0x24, 0xb4, 0x3c, 0x48, 0x74, 0x82, 0x82, 0xa3, 0xff, 0x95,
-	0x82, 0x2d, 0x7a, 0x57, 0xb7, 0x4a, 0x9a, 0xe1, 0xac, 0x39, 0x15, 0x86, 0xda, 0x51, 0xf0, 0x71,
-	0x8c, 0x82, 0xb8, 0x68, 0x50, 0xf0, 0x2e, 0x1a, 0x66, 0x4b, 0x17, 0xb0, 0xbf, 0x98, 0xcc, 0x5e,
-	0x44, 0x89, 0xde, 0x39, 0x1c, 0x89, 0xa8, 0x00, 0x49, 0xe6, 0xd1, 0xac, 0x30, 0x99, 0xb5, 0xba,
-	0x61, 0xad, 0x55, 0xab, 0xb4, 0x61, 0x79, 0x01, 0x65, 0x1d, 0xcd, 0xf5, 0x0f, 0x03, 0xae, 0xd7,
-	0xd1, 0x69, 0xcd, 0xff, 0x5e, 0xd6, 0xf8, 0x00, 0x9c, 0xf4, 0x4c, 0xbb, 0x95, 0x1b, 0xe3, 0x04,
-	0x7a, 0x86, 0x89, 0x7a, 0x4a, 0x0b, 0xc1, 0x90, 0x25, 0xb4, 0x10, 0x2d, 0xb3, 0xae, 0xef, 0xea,
-	0x26, 0xb5, 0x75, 0x27, 0xc2, 0xa8, 0x21, 0x9e, 0x0d, 0x31, 0x14, 0x58, 0x6d, 0xa0, 0xd1, 0x5a,
-	0x30, 0x16, 0x61, 0x36, 0xdd, 0x6e, 0xe5, 0x32, 0xc1, 0x1d, 0x14, 0x09, 0x21, 0xea, 0xd9, 0x5a,
-	0x04, 0x32, 0xee, 0x8e, 0xde, 0xb0, 0xb6, 0xa9, 0xd2, 0x2c, 0x51, 0x6a, 0x6e, 0x35, 0xed, 0xe0,
-	0x3c, 0x92, 0xaf, 0x62, 0xee, 0xe8, 0x68, 0x24, 0xd0, 0x6b, 0xa0, 0x51, 0xc3, 0xda, 0xa6, 0xe5,
-	0x4a, 0xb3, 0x6c, 0x53, 0x6a, 0x96, 0xbd, 0xa6, 0xad, 0xc3, 0x59, 0x5b, 0x4c, 0x5e, 0xeb, 0x5e,
-	0x30, 0x25, 0x0f, 0xeb, 0x0c, 0x62, 0x04, 0x40, 0xa2, 0x9e, 0x31, 0x7a, 0x32, 0x48, 0x01, 0x5d,
-	0x8c, 0x12, 0x7c, 0x43, 0xdb, 0xf3, 0x87, 0x4b, 0xd4, 0xb0, 0x3c, 0xb7, 0xa4, 0x3b, 0x8a, 0x49,
-	0xab, 0x77, 0x03, 0x45, 0x9f, 0x4a, 0x68, 0x75, 0xc0, 0x04, 0x10, 0xf6, 0x1e, 0x9a, 0xac, 0x6b,
-	0x7b, 0x9c, 0x83, 0xcd, 0x42, 0xca, 0xfe, 0xf4, 0x56, 0xfc, 0x20, 0x26, 0xf0, 0x98, 0x32, 0xd7,
-	0x6e, 0xe5, 0xf2, 0x9c, 0x72, 0x62, 0x28, 0x51, 0xc7, 0xeb, 0x71, 0x75, 0xe2, 0x4e, 0x5d, 0x94,
-	0xd0, 0xd6, 0x5e, 0x40, 0xff, 0x7e, 0xcc, 0xa9, 0x8b, 0x8b, 0x06, 0xee, 0x6f, 0xa3, 0x89, 0x38,
-	0x42, 0xde, 0x1e, 0x10, 0x9f, 0x69, 0xb7, 0x72, 0xe7, 0x93, 0x89, 0x7b, 0x7b, 0x44, 0xc5, 0x75,
-	0x01, 0x3e, 0xee, 0xa9, 0x51, 0x34, 0x57, 0x67, 0xaf, 0x5a, 0xe7, 0x82, 0xf8, 0x50, 0x12, 0xdf,
-	0x9a, 0x70, 0x14, 0x50, 0x7c, 0x1f, 0x9d, 0xf4, 0x1f, 0x95, 0x32, 0x7b, 0x34, 0x83, 0xdb, 0x61,
-	0x36, 0x79, 0xc7, 0x74, 0x20, 0x14, 0x19, 0x36, 0x0b, 0xe6, 0x02, 0x42, 0x28, 0x44, 0x45, 0x95,
-	0x4e, 0x25, 0x92, 0x47, 0xd9, 0x28, 0x8f, 0xd7, 0x2c, 0xad, 0x62, 0xea, 0xb5, 0x80, 0xea, 0x26,
-	0xca, 0x25, 0x46, 0x00, 0xcd, 0x8b, 0x68, 0x44, 0xe7, 0x9f, 0xd8, 0xd4, 0xfd, 0x4f, 0xc1, 0xdd,
-	0x37, 0x0f, 0x06, 0x88, 0x1a, 0x84, 0xf8, 0xde, 0x66, 0x4a, 0x78, 0xfc, 0x29, 0x35, 0x83, 0x77,
-	0xee, 0x2a, 0x42, 0x5d, 0xba, 0x70, 0x88, 0xc7, 0xbb, 0x17, 0x74, 0x77, 0x8c, 0xa8, 0x27, 0x3a,
-	0x4a, 0xf0, 0x73, 0xe8, 0x24, 0xf5, 0xee, 0xe8, 0x0e, 0xa4, 0x1d, 0x61, 0x69, 0x13, 0xdd, 0x19,
-	0x08, 0x0d, 0x12, 0x15, 0xb1, 0x7f, 0x2c, 0x91, 0xdc, 0x42, 0xd3, 0xf1, 0x6c, 0x40, 0xdc, 0x0a,
-	0x1a, 0x61, 0x4b, 0x6f, 0xd4, 0x60, 0x5f, 0x84, 0xc4, 0xc1, 0x80, 0xef, 0x33, 0x28, 0x35, 0x37,
-	0x6a, 0xe1, 0xc5, 0xe7, 0xd6, 0xc1, 0xa3, 0x55, 0x1f, 0x6b, 0x57, 0xb7, 0x1a, 0x9d, 0x8b, 0xe3,
-	0x9b, 0xd0, 0xe2, 0xc7, 0x45, 0x41, 0xe1, 0xfb, 0x12, 0x1a, 0xd3, 0x4c, 0xb3, 0x6c, 0xc3, 0x78,
-	0xd9, 0xe1, 0x01, 0x70, 0x71, 0xf4, 0x79, 0x24, 0x44, 0x50, 0x65, 0x16, 0xf6, 0xc3, 0x14, 0xdc,
-	0xd1, 0x31, 0xb8, 0x44, 0xc5, 0x9a, 0x90, 0x78, 0xf9, 0x9f, 0x0c, 0x3a, 0xce, 0xc8, 0xe2, 0x8f,
-	0x24, 0x34, 0xcc, 0x6d, 0x2e, 0xee, 0x53, 0x5b, 0x74, 0xd7, 0xf2, 0xea, 0x80, 0xd1, 0x5c, 0x37,
-	0xc9, 0x7f, 0xf0, 0xcb, 0x5f, 0x5f, 0x1e, 0x91, 0x71, 0xa6, 0x28, 0x98, 0x7e, 0x6e, 0xa3, 0xf1,
-	0x8f, 0x12, 0x9a, 0x4c, 0x34, 0xc6, 0xf8, 0x95, 0x7d, 0xca, 0xed, 0x67, 0xbe, 0xe5, 0x57, 0x0f,
-	0x0e, 0x00, 0x12, 0x96, 0x99, 0x84, 0x39, 0x4c, 0x44, 0x09, 0x51, 0xb3, 0x1d, 0x15, 0xd3, 0x6b,
-	0x83, 0xd3, 0x88, 0x89, 0x75, 0xe4, 0x69, 0xc4, 0xc4, 0x3b, 0xf0, 0x7e, 0x62, 0xc0, 0xc6, 0xfa,
-	0xcf, 0x10, 0x3b, 0x59, 0xf8, 0x3b, 0x09, 0x8d, 0xc7, 0xda, 0x67, 0xfc, 0xe2, 0xe0, 0x3c, 0x04,
-	0x67, 0x2e, 0xbf, 0x74, 0xb0, 0x64, 0x10, 0x30, 0xcf, 0x04, 0xe4, 0xf0, 0x79, 0x51, 0x00, 0x9c,
-	0x03, 0xc6, 0xf0, 0x57, 0x09, 0x4d, 0xf7, 0xb3, 0xcc, 0x58, 0x19, 0x9c, 0x45, 0x92, 0x89, 0x97,
-	0x6f, 0x1c, 0x0a, 0x03, 0x04, 0xad, 0x32, 0x41, 0x0b, 0x78, 0x5e, 0x14, 0xd4, 0x75, 0xac, 0xfe,
-	0xa2, 0x30, 0x0b, 0x88, 0x1f, 0x49, 0xe8, 0x7c, 0x5f, 0x2b, 0x8d, 0x6f, 0xa4, 0x9a, 0xdf, 0x78,
-	0xdb, 0x2e, 0xaf, 0x1f, 0x0e, 0x04, 0xb4, 0x15, 0x98, 0xb6, 0x45, 0x7c, 0x21, 0x7e, 0xb1, 0x98,
-	0xa2, 0x72, 0x57, 0x25, 0xfe, 0xbd, 0x57, 0x9c, 0xe8, 0x8f, 0xd3, 0x88, 0x4b, 0x74, 0xf4, 0x69,
-	0xc4, 0x25, 0x1b, 0x7d, 0x52, 0x64, 0xe2, 0x96, 0xf0, 0x82, 0x28, 0xce, 0xf3, 0xb3, 0xca, 0xb6,
-	0x66, 0x38, 0x65, 0xcd, 0xa9, 0x70, 0x9d, 0x2e, 0xfe, 0x5e, 0x42, 0xe7, 0x12, 0x1c, 0x39, 0xbe,
-	0x9e, 0x62, 0xbe, 0x45, 0xc3, 0x2f, 0xbf, 0x7c, 0xd0, 0x74, 0xd0, 0xb2, 0xc0, 0xb4, 0xcc, 0xe0,
-	0x5c, 0xcc, 0x42, 0x85, 0x3b, 0x00, 0xfc, 0xb3, 0x84, 0xa6, 0xfa, 0x78, 0x78, 0xbc, 0x36, 0x38,
-	0x91, 0x84, 0x56, 0x41, 0x56, 0x0e, 0x03, 0x01, 0x7a, 0x56, 0x98, 0x9e, 0x79, 0x3c, 0x2b, 0xea,
-	0x11, 0xfa, 0x06, 0xfc, 0x53, 0xef, 0xa5, 0xdd, 0xeb, 0xd4, 0xd3, 0x5c, 0xda, 0xb1, 0xad, 0x45,
-	0x9a, 0x4b, 0x3b, 0xbe, 0xe3, 0xe8, 0xa7, 0x46, 0x68, 0x1c, 0xf0, 0x1f, 0xbd, 0x67, 0x48, 0xf4,
-	0xcc, 0x69, 0xce, 0x50, 0xa2, 0x3f, 0x4f, 0x73, 0x86, 0x92, 0x6d, 0x3b, 0x79, 0x9a, 0x29, 0x5b,
-	0xc6, 0x8b, 0xa2, 0xb2, 0x78, 0x9b, 0x8e, 0xff, 0x96, 0x50, 0x7e, 0xbf, 0x8e, 0x06, 0xbf, 0x7e,
-	0x70, 0x72, 0xe1, 0x1e, 0x4a, 0xbe, 0x79, 0x68, 0x1c, 0xd0, 0x79, 0x85, 0xe9, 0x5c, 0xc5, 0x2b,
-	0x83, 0xe9, 0x64, 0x7d, 0x54, 0xf4, 0xfd, 0xed, 0xb6, 0x14, 0x69, 0xde, 0x5f, 0xa1, 0x5d, 0x49,
-	0xf3, 0xfe, 0x8a, 0x5d, 0x4c, 0xbf, 0xf7, 0x37, 0xd4, 0x97, 0xe0, 0x6f, 0x25, 0x84, 0xc5, 0x26,
-	0x03, 0x5f, 0x1b, 0xbc, 0x76, 0x6f, 0xe7, 0x22, 0x3f, 0x7f, 0x80, 0x4c, 0xa0, 0x3c, 0xc3, 0x28,
-	0x4f, 0xe1, 0x49, 0x91, 0x32, 0xb4, 0x31, 0xf8, 0x6b, 0x09, 0xfd, 0x3f, 0xd2, 0x33, 0xe0, 0x67,
-	0x52, 0x98, 0xad, 0x6e, 0xc7, 0x23, 0x3f, 0x9b, 0x36, 0x0d, 0x58, 0x66, 0x19, 0xcb, 0x0c, 0x9e,
-	0x88, 0x71, 0x66, 0x3e, 0x9d, 0x1f, 0xf8, 0x6e, 0x10, 0xdb, 0x81, 0x41, 0x76, 0x43, 0x62, 0xff,
-	0x32, 0xc8, 0x6e, 0x48, 0x6e, 0x6b, 0xf6, 0x7b, 0xe0, 0xa3, 0x5d, 0x89, 0x72, 0xfb, 0xc1, 0xe3,
-	0xac, 0xf4, 0xf0, 0x71, 0x56, 0xfa, 0xf3, 0x71, 0x56, 0xfa, 0xec, 0x49, 0x76, 0xe8, 0xe1, 0x93,
-	0xec, 0xd0, 0x6f, 0x4f, 0xb2, 0x43, 0xef, 0x5c, 0xdd, 0x31, 0xbc, 0x3b, 0x8d, 0x4a, 0xa1, 0x4a,
-	0xeb, 0x01, 0xd6, 0xaa, 0xa9, 0x55, 0xdc, 0x0e, 0xf0, 0xee, 0xe5, 0x4b, 0xc5, 0xbd, 0xd0, 0x13,
-	0xdb, 0xb4, 0x75, 0xb7, 0x32, 0xcc, 0xfe, 0x5f, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xd3,
-	0xb1, 0x5a, 0x1a, 0x19, 0x00, 0x00,
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevMaxCyclicRouteHopsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevMaxCyclicRouteHopsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevInfoByPoolType queries pool type information that is currently
-	// being utilized by the module
-	GetProtoRevInfoByPoolType(ctx context.Context, in *QueryGetProtoRevInfoByPoolTypeRequest, opts ...grpc.CallOption) (*QueryGetProtoRevInfoByPoolTypeResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
-	// GetAllProtocolRevenue queries all of the protocol revenue that has been
-	// accumulated by any module
-	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+// QueryGetProtoRevMaxCyclicRouteHopsResponse is response type for the
+// Query/GetProtoRevMaxCyclicRouteHops RPC method.
+type QueryGetProtoRevMaxCyclicRouteHopsResponse struct {
+	// max_cyclic_route_hops is the maximum number of hops of the cyclic
+	// arbitrage routes found by the cycle finder. 0 means the cycle finder is
+	// disabled
+	MaxCyclicRouteHops uint64 `protobuf:"varint,1,opt,name=max_cyclic_route_hops,json=maxCyclicRouteHops,proto3" json:"max_cyclic_route_hops,omitempty" yaml:"max_cyclic_route_hops"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) Reset() {
+	*m = QueryGetProtoRevMaxCyclicRouteHopsResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*QueryGetProtoRevMaxCyclicRouteHopsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevMaxCyclicRouteHopsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
 }
-
-func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	out := new(QueryGetProtoRevNumberOfTradesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	out := new(QueryGetProtoRevProfitsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
-	out := new(QueryGetProtoRevAllProfitsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevMaxCyclicRouteHopsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) GetMaxCyclicRouteHops() uint64 {
+	if m != nil {
+		return m.MaxCyclicRouteHops
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	out := new(QueryGetProtoRevStatisticsByRouteResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevBackrunRecordsRequest is request type for the
+// Query/GetProtoRevBackrunRecords RPC method.
+type QueryGetProtoRevBackrunRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevBackrunRecordsRequest) Reset()         { *m = QueryGetProtoRevBackrunRecordsRequest{} }
+func (m *QueryGetProtoRevBackrunRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevBackrunRecordsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevBackrunRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevBackrunRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevBackrunRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevBackrunRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevBackrunRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevBackrunRecordsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevBackrunRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevBackrunRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevBackrunRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevBackrunRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevBackrunRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
-	out := new(QueryGetProtoRevAdminAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevBackrunRecordsResponse is response type for the
+// Query/GetProtoRevBackrunRecords RPC method.
+type QueryGetProtoRevBackrunRecordsResponse struct {
+	// records are the backruns executed by the module, ordered by execution
+	Records    []BackrunRecord     `protobuf:"bytes,1,rep,name=records,proto3" json:"records" yaml:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	out := new(QueryGetProtoRevDeveloperAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevBackrunRecordsResponse) Reset() {
+	*m = QueryGetProtoRevBackrunRecordsResponse{}
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevBackrunRecordsResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevBackrunRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevBackrunRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevBackrunRecordsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevBackrunRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevBackrunRecordsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevInfoByPoolType(ctx context.Context, in *QueryGetProtoRevInfoByPoolTypeRequest, opts ...grpc.CallOption) (*QueryGetProtoRevInfoByPoolTypeResponse, error) {
-	out := new(QueryGetProtoRevInfoByPoolTypeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevInfoByPoolType", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevBackrunRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevBackrunRecordsResponse) GetRecords() []BackrunRecord {
+	if m != nil {
+		return m.Records
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevBackrunRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevTopTriggerPoolsRequest is request type for the
+// Query/GetProtoRevTopTriggerPools RPC method.
+type QueryGetProtoRevTopTriggerPoolsRequest struct {
+	// days is the number of most recent days, including the current one, over
+	// which the backruns are aggregated
+	Days uint64 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" yaml:"days"`
+	// limit is the maximum number of pools returned. 0 returns all pools
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" yaml:"limit"`
 }
 
-func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	out := new(QueryGetProtoRevBaseDenomsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) Reset() {
+	*m = QueryGetProtoRevTopTriggerPoolsRequest{}
 }
-
-func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
-	out := new(QueryGetProtoRevEnabledResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevTopTriggerPoolsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevTopTriggerPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
+}
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
-	out := new(QueryGetProtoRevPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) GetDays() uint64 {
+	if m != nil {
+		return m.Days
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error) {
-	out := new(QueryGetAllProtocolRevenueResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetAllProtocolRevenue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevInfoByPoolType queries pool type information that is currently
-	// being utilized by the module
-	GetProtoRevInfoByPoolType(context.Context, *QueryGetProtoRevInfoByPoolTypeRequest) (*QueryGetProtoRevInfoByPoolTypeResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
-	// GetAllProtocolRevenue queries all of the protocol revenue that has been
-	// accumulated by any module
-	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+// QueryGetProtoRevTopTriggerPoolsResponse is response type for the
+// Query/GetProtoRevTopTriggerPools RPC method.
+type QueryGetProtoRevTopTriggerPoolsResponse struct {
+	// statistics contains the number of backruns and profits triggered by swaps
+	// on each pool, ordered by decreasing number of backruns
+	Statistics []TriggerPoolStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics" yaml:"statistics"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) Reset() {
+	*m = QueryGetProtoRevTopTriggerPoolsResponse{}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevTopTriggerPoolsResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevTopTriggerPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
 }
-func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+
+var xxx_messageInfo_QueryGetProtoRevTopTriggerPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) GetStatistics() []TriggerPoolStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+
+// QueryGetProtoRevProfitShareByTriggerPoolRequest is request type for the
+// Query/GetProtoRevProfitShareByTriggerPool RPC method.
+type QueryGetProtoRevProfitShareByTriggerPoolRequest struct {
+	// days is the number of most recent days, including the current one, over
+	// which the backruns are aggregated
+	Days uint64 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" yaml:"days"`
 }
-func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) Reset() {
+	*m = QueryGetProtoRevProfitShareByTriggerPoolRequest{}
 }
-func (*UnimplementedQueryServer) GetProtoRevInfoByPoolType(ctx context.Context, req *QueryGetProtoRevInfoByPoolTypeRequest) (*QueryGetProtoRevInfoByPoolTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevInfoByPoolType not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+func (*QueryGetProtoRevProfitShareByTriggerPoolRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProfitShareByTriggerPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{38}
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) GetDays() uint64 {
+	if m != nil {
+		return m.Days
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevNumberOfTradesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevProfitShareByTriggerPoolResponse is response type for the
+// Query/GetProtoRevProfitShareByTriggerPool RPC method.
+type QueryGetProtoRevProfitShareByTriggerPoolResponse struct {
+	// profit_shares contains the share of the profits made by the backruns
+	// triggered by swaps on each pool, ordered by pool id
+	ProfitShares []TriggerPoolProfitShare `protobuf:"bytes,1,rep,name=profit_shares,json=profitShares,proto3" json:"profit_shares" yaml:"profit_shares"`
+	// total_profits is the total profit made by all backruns
+	TotalProfits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_profits,json=totalProfits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_profits" yaml:"total_profits"`
 }
 
-func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevProfitsByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) Reset() {
+	*m = QueryGetProtoRevProfitShareByTriggerPoolResponse{}
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitShareByTriggerPoolResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProfitShareByTriggerPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{39}
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllProfitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+var xxx_messageInfo_QueryGetProtoRevProfitShareByTriggerPoolResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) GetProfitShares() []TriggerPoolProfitShare {
+	if m != nil {
+		return m.ProfitShares
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevStatisticsByRouteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) GetTotalProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalProfits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevDryRunRequest is request type for the
+// Query/GetProtoRevDryRun RPC method.
+type QueryGetProtoRevDryRunRequest struct {
+	// token_in is the coin swapped in by the hypothetical swap, e.g. 1000uosmo
+	TokenIn string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// route is the route of the hypothetical swap
+	Route []types1.SwapAmountInRoute `protobuf:"bytes,2,rep,name=route,proto3" json:"route" yaml:"route"`
 }
 
-func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+func (m *QueryGetProtoRevDryRunRequest) Reset()         { *m = QueryGetProtoRevDryRunRequest{} }
+func (m *QueryGetProtoRevDryRunRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevDryRunRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevDryRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{40}
+}
+func (m *QueryGetProtoRevDryRunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDryRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDryRunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevDryRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDryRunRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevDryRunRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDryRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDryRunRequest.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAdminAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+var xxx_messageInfo_QueryGetProtoRevDryRunRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevDryRunRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevDeveloperAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+func (m *QueryGetProtoRevDryRunRequest) GetRoute() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevInfoByPoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevInfoByPoolTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevInfoByPoolType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevInfoByPoolType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevInfoByPoolType(ctx, req.(*QueryGetProtoRevInfoByPoolTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevDryRunResponse is response type for the
+// Query/GetProtoRevDryRun RPC method.
+type QueryGetProtoRevDryRunResponse struct {
+	// backruns contains the routes tried after the swap on each pool of the
+	// hypothetical swap, with their optimal input and expected profit
+	Backruns []DryRunBackrun `protobuf:"bytes,1,rep,name=backruns,proto3" json:"backruns" yaml:"backruns"`
+	// pool_points_used is the number of pool points consumed by the routes
+	PoolPointsUsed uint64 `protobuf:"varint,2,opt,name=pool_points_used,json=poolPointsUsed,proto3" json:"pool_points_used,omitempty" yaml:"pool_points_used"`
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+func (m *QueryGetProtoRevDryRunResponse) Reset()         { *m = QueryGetProtoRevDryRunResponse{} }
+func (m *QueryGetProtoRevDryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevDryRunResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevDryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{41}
+}
+func (m *QueryGetProtoRevDryRunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDryRunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevDryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDryRunResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevDryRunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDryRunResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
+var xxx_messageInfo_QueryGetProtoRevDryRunResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevDryRunResponse) GetBackruns() []DryRunBackrun {
+	if m != nil {
+		return m.Backruns
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevBaseDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+func (m *QueryGetProtoRevDryRunResponse) GetPoolPointsUsed() uint64 {
+	if m != nil {
+		return m.PoolPointsUsed
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevEnabledRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevInfoByPoolTypeRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevInfoByPoolTypeRequest")
+	proto.RegisterType((*QueryGetProtoRevInfoByPoolTypeResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevInfoByPoolTypeResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
+	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
+	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
+	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxCyclicRouteHopsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxCyclicRouteHopsRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxCyclicRouteHopsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxCyclicRouteHopsResponse")
+	proto.RegisterType((*QueryGetProtoRevBackrunRecordsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBackrunRecordsRequest")
+	proto.RegisterType((*QueryGetProtoRevBackrunRecordsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBackrunRecordsResponse")
+	proto.RegisterType((*QueryGetProtoRevTopTriggerPoolsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTopTriggerPoolsRequest")
+	proto.RegisterType((*QueryGetProtoRevTopTriggerPoolsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTopTriggerPoolsResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitShareByTriggerPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitShareByTriggerPoolRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitShareByTriggerPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitShareByTriggerPoolResponse")
+	proto.RegisterType((*QueryGetProtoRevDryRunRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDryRunRequest")
+	proto.RegisterType((*QueryGetProtoRevDryRunResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDryRunResponse")
 }
 
-func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
 }
 
-func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllProtocolRevenueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllProtocolRevenue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetAllProtocolRevenue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllProtocolRevenue(ctx, req.(*QueryGetAllProtocolRevenueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var fileDescriptor_f5e7ac9973cce389 = []byte{
+	// 2224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6f, 0x1c, 0x57,
+	0x19, 0xcf, 0xb8, 0xa9, 0x9d, 0x9e, 0x5c, 0x7d, 0x6a, 0x27, 0xf6, 0xd8, 0xd9, 0xb5, 0x8f, 0xe3,
+	0x5b, 0x12, 0xef, 0x26, 0x69, 0x69, 0x03, 0xa4, 0x60, 0x4f, 0xdc, 0xa6, 0xa6, 0xa2, 0x31, 0x13,
+	0x17, 0x89, 0x82, 0x18, 0x66, 0x77, 0xc7, 0xeb, 0xc1, 0xb3, 0x73, 0x26, 0x33, 0xb3, 0xae, 0xf7,
+	0x11, 0x2a, 0x71, 0x91, 0x90, 0xb8, 0x89, 0x37, 0x24, 0x78, 0xa5, 0xf0, 0xc4, 0x1b, 0x8f, 0x20,
+	0x21, 0x95, 0x8b, 0x50, 0x11, 0x12, 0x42, 0x05, 0x2d, 0x28, 0xe1, 0x81, 0xe7, 0x15, 0x7f, 0x00,
+	0x9a, 0x73, 0xbe, 0x99, 0x9d, 0x99, 0x33, 0xb3, 0x37, 0xa3, 0x3e, 0x79, 0x3d, 0xe7, 0xfb, 0x7e,
+	0xe7, 0xf7, 0x3b, 0xb7, 0xef, 0x9c, 0x1f, 0xba, 0x46, 0xbd, 0x06, 0xf5, 0x4c, 0xaf, 0xec, 0xb8,
+	0xd4, 0xa7, 0xae, 0x71, 0x54, 0x3e, 0xba, 0x5d, 0x31, 0x7c, 0xfd, 0x76, 0xf9, 0x71, 0xd3, 0x70,
+	0x5b, 0x25, 0xf6, 0x19, 0xcf, 0x40, 0x54, 0x29, 0x8c, 0x2a, 0x41, 0x94, 0x3c, 0x55, 0xa7, 0x75,
+	0xca, 0xbe, 0x96, 0x83, 0x5f, 0x3c, 0x40, 0x9e, 0xaf, 0x53, 0x5a, 0xb7, 0x8c, 0xb2, 0xee, 0x98,
+	0x65, 0xdd, 0xb6, 0xa9, 0xaf, 0xfb, 0x26, 0xb5, 0x21, 0x5d, 0xbe, 0x5e, 0x65, 0x70, 0xe5, 0x8a,
+	0xee, 0x19, 0xbc, 0x9b, 0xa8, 0x53, 0x47, 0xaf, 0x9b, 0x36, 0x0b, 0x86, 0xd8, 0xe5, 0x5c, 0x7e,
+	0x8e, 0xee, 0xea, 0x8d, 0x10, 0x72, 0x35, 0x3f, 0x2c, 0x64, 0xcc, 0x03, 0x6f, 0x46, 0x81, 0x94,
+	0x5a, 0x0d, 0xdd, 0xd6, 0xeb, 0x86, 0x1b, 0xc5, 0x7a, 0xef, 0xe8, 0x8e, 0xe6, 0xd2, 0xa6, 0x6f,
+	0x40, 0x74, 0x21, 0xce, 0x34, 0x8c, 0xaa, 0x52, 0x13, 0xd8, 0x91, 0x29, 0x84, 0x3f, 0x17, 0xf0,
+	0xdf, 0x65, 0x5c, 0x54, 0xe3, 0x71, 0xd3, 0xf0, 0x7c, 0xb2, 0x8f, 0x9e, 0x4f, 0x7c, 0xf5, 0x1c,
+	0x6a, 0x7b, 0x06, 0x7e, 0x88, 0xc6, 0x39, 0xe7, 0x19, 0x69, 0x41, 0x5a, 0x3b, 0x7b, 0x67, 0xa1,
+	0x94, 0x37, 0xaa, 0x25, 0x9e, 0xa9, 0x4c, 0xbf, 0xdf, 0x2e, 0x9e, 0xea, 0xb4, 0x8b, 0xe7, 0x5b,
+	0x7a, 0xc3, 0xfa, 0x04, 0xe1, 0xd9, 0x44, 0x05, 0x18, 0xb2, 0x8a, 0x96, 0x59, 0x3f, 0x0f, 0x0c,
+	0x7f, 0x37, 0x40, 0x50, 0x8d, 0xa3, 0x37, 0x9b, 0x8d, 0x8a, 0xe1, 0x3e, 0xdc, 0xdf, 0x73, 0xf5,
+	0x9a, 0x11, 0x11, 0xfa, 0x8e, 0x84, 0x56, 0xfa, 0x45, 0x02, 0xc9, 0x0a, 0xba, 0x64, 0xb3, 0x16,
+	0x8d, 0xee, 0x6b, 0x3e, 0x6b, 0x63, 0x74, 0x9f, 0x53, 0xee, 0x06, 0x64, 0x3e, 0x6c, 0x17, 0xa7,
+	0xf9, 0x98, 0x78, 0xb5, 0xc3, 0x92, 0x49, 0xcb, 0x0d, 0xdd, 0x3f, 0x28, 0xed, 0xd8, 0x7e, 0xa7,
+	0x5d, 0xbc, 0xc2, 0x59, 0xa6, 0xd3, 0x89, 0x7a, 0xc1, 0x4e, 0xf4, 0x45, 0x1e, 0x8a, 0xbc, 0x77,
+	0x5d, 0xba, 0x6f, 0xfa, 0x9e, 0xd2, 0xda, 0x36, 0x6c, 0xda, 0x00, 0xde, 0x78, 0x05, 0x3d, 0x5b,
+	0x0b, 0xfe, 0x07, 0x06, 0x97, 0x3a, 0xed, 0xe2, 0x39, 0xde, 0x09, 0xfb, 0x4c, 0x54, 0xde, 0x4c,
+	0x6c, 0xb4, 0xd2, 0x0f, 0x10, 0xe4, 0x6d, 0xa3, 0x71, 0x87, 0xb5, 0xc0, 0x1c, 0xcc, 0x96, 0xb8,
+	0x9a, 0x52, 0x30, 0xc3, 0xd1, 0xf0, 0xdf, 0xa7, 0xa6, 0xad, 0x4c, 0xc6, 0x06, 0x9e, 0xa5, 0x04,
+	0x03, 0xcf, 0x7f, 0x2c, 0xa1, 0xc5, 0x74, 0x7f, 0x5b, 0x96, 0x05, 0x5d, 0x86, 0x83, 0xfe, 0x18,
+	0x91, 0x5e, 0x41, 0x40, 0xe8, 0x0d, 0x34, 0xc1, 0x41, 0x83, 0x61, 0x7e, 0xa6, 0x37, 0xa3, 0xcb,
+	0xb0, 0x1c, 0x2e, 0xc4, 0x59, 0x79, 0x44, 0x9d, 0x88, 0x7e, 0xa1, 0xb5, 0x74, 0x97, 0x8f, 0x82,
+	0xad, 0xe7, 0xf9, 0x66, 0xd5, 0x53, 0x5a, 0x2a, 0x6d, 0xfa, 0x46, 0x6c, 0x6c, 0xd9, 0x4a, 0x67,
+	0xdd, 0x9e, 0x8e, 0x8f, 0x2d, 0xfb, 0x4c, 0x54, 0xde, 0x4c, 0xbe, 0x2f, 0xa1, 0xf5, 0x01, 0x40,
+	0x41, 0x4e, 0x0d, 0x21, 0x2f, 0x6a, 0x84, 0x31, 0x5e, 0xcf, 0x5f, 0xe7, 0x2c, 0x39, 0x86, 0x36,
+	0x0b, 0x0a, 0x27, 0x39, 0x93, 0x2e, 0x14, 0x51, 0x63, 0xb8, 0xe4, 0x86, 0x48, 0x69, 0xcb, 0xb2,
+	0x52, 0x60, 0xe1, 0x3c, 0xfc, 0x40, 0x42, 0xd7, 0x07, 0x89, 0xce, 0x51, 0xf0, 0xcc, 0x47, 0xa5,
+	0x60, 0x8f, 0x1e, 0x1a, 0xf6, 0xae, 0x6e, 0xba, 0x5b, 0x6e, 0x85, 0xa1, 0x46, 0x0a, 0xbe, 0x9d,
+	0xa1, 0x20, 0x2b, 0x1a, 0x14, 0x7c, 0x11, 0x8d, 0xb3, 0xa9, 0x0b, 0xd9, 0xdf, 0xcc, 0x67, 0x2f,
+	0xa2, 0xa4, 0xcf, 0x1c, 0x8e, 0x44, 0x54, 0x80, 0x24, 0xcb, 0x68, 0x49, 0x18, 0xcc, 0x5a, 0xc3,
+	0xb4, 0xb7, 0xaa, 0x55, 0xda, 0xb4, 0xfd, 0x90, 0xb2, 0x81, 0xae, 0xf5, 0x0e, 0x03, 0xae, 0xaf,
+	0xa0, 0xf3, 0x7a, 0xf0, 0x5d, 0xd3, 0x79, 0x03, 0xec, 0xf4, 0x99, 0x4e, 0xbb, 0x38, 0xc5, 0x09,
+	0x24, 0x9a, 0x89, 0x7a, 0x4e, 0x8f, 0xc1, 0x90, 0x75, 0xb4, 0x9a, 0xee, 0x66, 0xdb, 0x38, 0x32,
+	0x2c, 0xea, 0x18, 0x6e, 0x8a, 0x51, 0x13, 0xad, 0xf5, 0x0f, 0x05, 0x56, 0x3b, 0x68, 0xb2, 0x16,
+	0xb6, 0xa5, 0x98, 0xcd, 0x77, 0xda, 0xc5, 0x99, 0xf0, 0x0c, 0x4a, 0x85, 0x10, 0xf5, 0x52, 0x2d,
+	0x05, 0x99, 0x75, 0x46, 0xef, 0xd8, 0xfb, 0x54, 0x69, 0xed, 0x52, 0x6a, 0xed, 0xb5, 0x9c, 0x70,
+	0x3f, 0x92, 0x9f, 0x64, 0x9c, 0xd1, 0xe9, 0x48, 0xa0, 0xd7, 0x44, 0x93, 0xa6, 0xbd, 0x4f, 0xb5,
+	0x4a, 0x4b, 0x0b, 0xaa, 0x98, 0xe6, 0xb7, 0x1c, 0x03, 0xf6, 0xda, 0x5a, 0xfe, 0x5c, 0x27, 0xc1,
+	0x94, 0x05, 0x98, 0x67, 0x10, 0x23, 0x00, 0x12, 0xf5, 0x82, 0x99, 0xc8, 0x20, 0x25, 0x74, 0x33,
+	0x4d, 0xf0, 0xb3, 0xfa, 0x71, 0xd0, 0xbc, 0x4b, 0x4d, 0xdb, 0xf7, 0x76, 0x0d, 0x57, 0xb1, 0x68,
+	0xf5, 0x30, 0x54, 0xf4, 0x5d, 0x09, 0x6d, 0x0c, 0x98, 0x00, 0xc2, 0xbe, 0x8c, 0x66, 0x1b, 0xfa,
+	0x31, 0xe7, 0xe0, 0xb0, 0x10, 0x2d, 0x18, 0xde, 0x4a, 0x10, 0xc4, 0x04, 0x9e, 0x56, 0xae, 0x75,
+	0xda, 0xc5, 0x05, 0x4e, 0x39, 0x37, 0x94, 0xa8, 0xd3, 0x8d, 0xac, 0x7e, 0xb2, 0x76, 0x5d, 0x9a,
+	0xd0, 0xde, 0x71, 0x48, 0xff, 0xdd, 0x8c, 0x5d, 0x97, 0x15, 0x0d, 0xdc, 0xdf, 0x42, 0x97, 0xb3,
+	0x08, 0xf9, 0xc7, 0x40, 0x7c, 0xb1, 0xd3, 0x2e, 0x5e, 0xcd, 0x27, 0xee, 0x1f, 0x13, 0x15, 0x37,
+	0x04, 0xf8, 0xac, 0x52, 0xa3, 0xe8, 0x9e, 0xc1, 0xaa, 0x5a, 0x74, 0x40, 0x7c, 0x43, 0x42, 0xa4,
+	0x57, 0x14, 0x50, 0xfc, 0x0a, 0x3a, 0x1b, 0x14, 0x15, 0x8d, 0x15, 0xcd, 0xf0, 0x74, 0x58, 0xca,
+	0x5f, 0x31, 0x11, 0x84, 0x22, 0xc3, 0x62, 0xc1, 0x5c, 0x40, 0x0c, 0x85, 0xa8, 0xa8, 0x12, 0xf5,
+	0x44, 0x16, 0x50, 0x21, 0xcd, 0xe3, 0x55, 0x5b, 0xaf, 0x58, 0x46, 0x2d, 0xa4, 0xfa, 0x10, 0x15,
+	0x73, 0x23, 0x80, 0xe6, 0x4d, 0x34, 0x61, 0xf0, 0x4f, 0x6c, 0xe8, 0xce, 0x28, 0xb8, 0x5b, 0xf3,
+	0xa0, 0x81, 0xa8, 0x61, 0x48, 0x70, 0xb7, 0x99, 0x13, 0x8a, 0x3f, 0xa5, 0x56, 0x58, 0xe7, 0x5e,
+	0x44, 0xa8, 0x4b, 0x17, 0x36, 0xf1, 0x74, 0xf7, 0x80, 0xee, 0xb6, 0x11, 0xf5, 0xb9, 0x48, 0x09,
+	0x7e, 0x19, 0x9d, 0xa5, 0xfe, 0x81, 0xe1, 0x42, 0xda, 0x18, 0x4b, 0xbb, 0xdc, 0x1d, 0x81, 0x58,
+	0x23, 0x51, 0x11, 0xfb, 0x8f, 0x25, 0x92, 0x37, 0xd0, 0x7c, 0x36, 0x1b, 0x10, 0x77, 0x03, 0x4d,
+	0xb0, 0xa9, 0x37, 0x6b, 0xb0, 0x2e, 0x62, 0xe2, 0xa0, 0x21, 0xb8, 0x67, 0x50, 0x6a, 0xed, 0xd4,
+	0xe2, 0x93, 0xcf, 0xaf, 0x0e, 0x3e, 0xad, 0x06, 0x58, 0x47, 0x86, 0xdd, 0x8c, 0x0e, 0x8e, 0xf7,
+	0x62, 0x93, 0x9f, 0x15, 0x05, 0x1d, 0xbf, 0x2b, 0xa1, 0x29, 0xdd, 0xb2, 0x34, 0x07, 0xda, 0x35,
+	0x97, 0x07, 0xc0, 0xc1, 0xd1, 0xa3, 0x48, 0x88, 0xa0, 0xca, 0x12, 0xac, 0x87, 0x39, 0x38, 0xa3,
+	0x33, 0x70, 0x89, 0x8a, 0x75, 0x21, 0x31, 0x67, 0x07, 0xde, 0x6f, 0x55, 0x2d, 0xb3, 0xca, 0x0a,
+	0xd0, 0xeb, 0xd4, 0x89, 0x96, 0xf5, 0xd7, 0xb2, 0x77, 0xa0, 0x10, 0x0d, 0x0a, 0x1f, 0xa1, 0x60,
+	0xdb, 0x6b, 0x55, 0xd6, 0xcc, 0xaf, 0xf1, 0xda, 0x01, 0x75, 0x3c, 0x18, 0xe8, 0x85, 0x4e, 0xbb,
+	0x38, 0xdf, 0xdd, 0x80, 0x42, 0x18, 0xdf, 0x7f, 0x29, 0x70, 0x42, 0xc5, 0xf3, 0x5b, 0xd1, 0xab,
+	0x87, 0x6e, 0xd3, 0x56, 0x8d, 0x2a, 0x75, 0x6b, 0x21, 0x59, 0xfc, 0x1a, 0x42, 0xdd, 0xc7, 0x0b,
+	0x0c, 0xea, 0x4a, 0xe2, 0x2e, 0xc7, 0x1f, 0x54, 0xdd, 0x2b, 0x7e, 0x3d, 0x9c, 0x42, 0x35, 0x96,
+	0x49, 0xfe, 0x98, 0x51, 0x07, 0xd2, 0x3d, 0x82, 0xe0, 0x2f, 0xa0, 0x09, 0x97, 0x7f, 0x82, 0xbd,
+	0xbc, 0xda, 0x6b, 0x2f, 0xc7, 0x20, 0xd2, 0x37, 0x49, 0x40, 0x21, 0x6a, 0x88, 0x87, 0x1f, 0x24,
+	0xd4, 0x8c, 0x31, 0x35, 0xab, 0x7d, 0xd5, 0x70, 0x5e, 0x09, 0x39, 0x4d, 0x51, 0xcd, 0x1e, 0x75,
+	0xf6, 0x5c, 0xb3, 0x5e, 0x37, 0xdc, 0x60, 0x67, 0x44, 0x03, 0xb8, 0x84, 0x4e, 0xd7, 0xf4, 0x56,
+	0x38, 0x5b, 0x17, 0x3b, 0xed, 0xe2, 0x59, 0xa8, 0xb3, 0x7a, 0xcb, 0x23, 0x2a, 0x6b, 0x0c, 0x6e,
+	0xad, 0x96, 0xd9, 0x30, 0x7d, 0x46, 0x29, 0x71, 0x6b, 0x65, 0x9f, 0x89, 0xca, 0x9b, 0xc9, 0x8f,
+	0x24, 0xb4, 0xda, 0xb7, 0x5f, 0x18, 0xc6, 0xaf, 0x66, 0xdc, 0xf8, 0xca, 0x3d, 0xee, 0x4c, 0x5d,
+	0x8c, 0xe1, 0xef, 0x7d, 0x9f, 0x47, 0xe5, 0xec, 0x97, 0xca, 0xa3, 0x03, 0xdd, 0x35, 0x94, 0x56,
+	0x0c, 0x7c, 0x98, 0x71, 0x21, 0xbf, 0x1c, 0x43, 0xb7, 0x06, 0x07, 0x06, 0xe1, 0x1e, 0x3a, 0xcf,
+	0x5f, 0x0e, 0x9a, 0x17, 0x04, 0x85, 0xda, 0x6f, 0x0d, 0xa4, 0x3d, 0x8e, 0x3e, 0x0f, 0xe2, 0xa7,
+	0xe2, 0x0f, 0x13, 0x00, 0x25, 0xea, 0x39, 0xa7, 0x1b, 0xea, 0xe1, 0x6f, 0x49, 0xe8, 0xbc, 0x4f,
+	0x7d, 0xdd, 0xd2, 0xe0, 0xd5, 0x32, 0x33, 0xd6, 0xef, 0xdd, 0xf3, 0x7a, 0x12, 0x3e, 0x91, 0x4d,
+	0xde, 0xfb, 0x67, 0x71, 0xad, 0x6e, 0xfa, 0x07, 0xcd, 0x4a, 0xa9, 0x4a, 0x1b, 0x65, 0x78, 0xb0,
+	0xf3, 0x3f, 0x1b, 0x5e, 0xed, 0xb0, 0x1c, 0x5c, 0x66, 0x3c, 0x06, 0xe4, 0xa9, 0xe7, 0x58, 0xee,
+	0x2e, 0xa4, 0xfe, 0x5c, 0x42, 0x57, 0x85, 0x3b, 0xa1, 0xdb, 0x52, 0x9b, 0x76, 0x38, 0xf6, 0x25,
+	0x74, 0xc6, 0x0f, 0xae, 0xc8, 0x9a, 0x69, 0x43, 0xe9, 0x78, 0xbe, 0xd3, 0x2e, 0x5e, 0x0c, 0x79,
+	0xf0, 0x16, 0xa2, 0x4e, 0xb0, 0x9f, 0x3b, 0x36, 0x7e, 0x3b, 0x7c, 0x54, 0x71, 0x4d, 0xa5, 0xee,
+	0x48, 0x76, 0xdd, 0x86, 0x48, 0xdb, 0xa3, 0x77, 0x74, 0x67, 0xab, 0x11, 0xdc, 0x12, 0x77, 0x6c,
+	0x76, 0xe0, 0x28, 0x53, 0x20, 0x34, 0xf3, 0x21, 0xf6, 0x5b, 0x09, 0x15, 0xf2, 0xd8, 0xc2, 0x84,
+	0x7e, 0x09, 0x9d, 0xa9, 0xf0, 0x7d, 0x3e, 0xc0, 0x89, 0xc0, 0x73, 0xe1, 0x5c, 0x50, 0xae, 0x40,
+	0xd7, 0x17, 0xc3, 0xb2, 0xc8, 0x61, 0x88, 0x1a, 0x21, 0xe2, 0x57, 0xd1, 0xa5, 0xf8, 0xad, 0xa5,
+	0xe9, 0x19, 0x35, 0xd8, 0x86, 0x73, 0xdd, 0xd7, 0x7f, 0x3a, 0x82, 0xa8, 0x17, 0x9c, 0xe8, 0x4a,
+	0xf3, 0x96, 0x67, 0xd4, 0xee, 0xfc, 0x78, 0x11, 0x3d, 0xcb, 0x74, 0xe0, 0x6f, 0x4a, 0x68, 0x9c,
+	0x3b, 0x1d, 0xb8, 0x47, 0xf9, 0x11, 0x0d, 0x16, 0x79, 0x63, 0xc0, 0x68, 0x3e, 0x2c, 0x64, 0xe1,
+	0xeb, 0x7f, 0xf9, 0xf7, 0x0f, 0xc7, 0x64, 0x3c, 0x53, 0x16, 0x5c, 0x22, 0xee, 0xa4, 0xe0, 0xdf,
+	0x4b, 0x68, 0x36, 0xd7, 0x1b, 0xc1, 0x9f, 0xee, 0xd3, 0x5d, 0x3f, 0xff, 0x45, 0xde, 0x1c, 0x1d,
+	0x00, 0x24, 0x5c, 0x67, 0x12, 0xae, 0x61, 0x22, 0x4a, 0x48, 0xfb, 0x2d, 0x69, 0x31, 0x49, 0x27,
+	0x64, 0x18, 0x31, 0x99, 0xa6, 0x8c, 0xbc, 0x39, 0x3a, 0x40, 0x7f, 0x31, 0xb0, 0xab, 0x83, 0x97,
+	0x08, 0xbb, 0x5c, 0xe1, 0x5f, 0x49, 0x68, 0x3a, 0xd3, 0x41, 0xc1, 0x9f, 0x1c, 0x9c, 0x87, 0x60,
+	0xce, 0xc8, 0xf7, 0x46, 0x4b, 0x06, 0x01, 0xcb, 0x4c, 0x40, 0x11, 0x5f, 0x15, 0x05, 0xc0, 0x55,
+	0x88, 0x31, 0xfc, 0xab, 0x84, 0xe6, 0x7b, 0xb9, 0x26, 0x58, 0x19, 0x9c, 0x45, 0x9e, 0x8f, 0x23,
+	0xdf, 0x3f, 0x11, 0x06, 0x08, 0xda, 0x60, 0x82, 0x56, 0xf1, 0xb2, 0x28, 0xa8, 0x5b, 0xbc, 0x82,
+	0x49, 0x61, 0x47, 0x11, 0xfe, 0x50, 0x42, 0x57, 0x7b, 0xba, 0x29, 0xf8, 0xfe, 0x50, 0xe3, 0x9b,
+	0xed, 0xdc, 0xc8, 0xdb, 0x27, 0x03, 0x01, 0x6d, 0x25, 0xa6, 0x6d, 0x0d, 0xaf, 0x64, 0x4f, 0x16,
+	0xbf, 0x00, 0x76, 0x55, 0xe2, 0xbf, 0x27, 0xc5, 0x89, 0x16, 0xc9, 0x30, 0xe2, 0x72, 0x4d, 0x1d,
+	0x79, 0xfb, 0x64, 0x20, 0x20, 0xae, 0xcc, 0xc4, 0xad, 0xe3, 0x55, 0x51, 0x1c, 0x2f, 0x4f, 0x8e,
+	0x6e, 0xba, 0x9a, 0xee, 0x56, 0xb8, 0x4e, 0x0f, 0xff, 0x5a, 0x42, 0x57, 0x72, 0x4c, 0x19, 0xfc,
+	0xca, 0x10, 0xe3, 0x2d, 0x7a, 0x3e, 0xf2, 0xa7, 0x46, 0x4d, 0x07, 0x2d, 0xab, 0x4c, 0xcb, 0x22,
+	0x2e, 0x66, 0x4c, 0x54, 0xdc, 0x04, 0xc2, 0x7f, 0x96, 0xd0, 0x5c, 0x0f, 0x1b, 0x07, 0x6f, 0x0d,
+	0x4e, 0x24, 0xc7, 0x2d, 0x92, 0x95, 0x93, 0x40, 0x80, 0x9e, 0x1b, 0x4c, 0xcf, 0x32, 0x5e, 0x12,
+	0xf5, 0x08, 0xd6, 0x11, 0xfe, 0x43, 0xf2, 0xd0, 0x4e, 0x9a, 0x35, 0xc3, 0x1c, 0xda, 0x99, 0xee,
+	0x92, 0xbc, 0x39, 0x3a, 0x40, 0x7f, 0x35, 0x82, 0x77, 0x84, 0xff, 0x91, 0xdc, 0x43, 0xa2, 0x6d,
+	0x32, 0xcc, 0x1e, 0xca, 0xb5, 0x68, 0xe4, 0xed, 0x93, 0x81, 0x80, 0xb2, 0x5b, 0x4c, 0xd9, 0x75,
+	0xbc, 0x26, 0x2a, 0xcb, 0x76, 0x6a, 0xf0, 0x7f, 0x24, 0xb4, 0xd0, 0xcf, 0xd4, 0xc2, 0xaf, 0x8d,
+	0x4e, 0x2e, 0x6e, 0xa3, 0xc9, 0x0f, 0x4e, 0x8c, 0x03, 0x3a, 0x5f, 0x60, 0x3a, 0x37, 0xf0, 0x8d,
+	0xc1, 0x74, 0x32, 0x2b, 0x2d, 0x5d, 0x7f, 0xbb, 0xae, 0xd2, 0x30, 0xf5, 0x57, 0x70, 0xac, 0xe4,
+	0x7b, 0xa3, 0x25, 0xf7, 0xaf, 0xbf, 0x31, 0x6b, 0x0a, 0xff, 0x42, 0x42, 0x58, 0xf4, 0x99, 0xf0,
+	0xdd, 0xc1, 0xfb, 0x4e, 0x9a, 0x57, 0xf2, 0xc7, 0x47, 0xc8, 0x04, 0xca, 0x8b, 0x8c, 0xf2, 0x1c,
+	0x9e, 0x15, 0x29, 0x83, 0x93, 0x85, 0x7f, 0x2a, 0xa1, 0x8b, 0x29, 0xdb, 0x08, 0x7f, 0x6c, 0x88,
+	0xcb, 0x56, 0xf7, 0xcd, 0x28, 0xbf, 0x34, 0x6c, 0x1a, 0xb0, 0x2c, 0x30, 0x96, 0x33, 0xf8, 0xb2,
+	0xc8, 0x32, 0x58, 0x1e, 0xf8, 0x37, 0x7c, 0x35, 0x88, 0x8e, 0xd0, 0x20, 0xab, 0x21, 0xd7, 0xc2,
+	0x92, 0xef, 0x8d, 0x96, 0x3c, 0x58, 0x81, 0x4f, 0x1b, 0x53, 0xe9, 0x02, 0x2f, 0x3a, 0x4a, 0x43,
+	0x1e, 0x4e, 0xd9, 0xee, 0x95, 0xbc, 0x7d, 0x32, 0x90, 0xfe, 0x05, 0x3e, 0xd3, 0xc5, 0xc2, 0xbf,
+	0x4b, 0x16, 0x92, 0xa4, 0x75, 0x34, 0x4c, 0x21, 0xc9, 0xb4, 0xb9, 0xe4, 0xcd, 0xd1, 0x01, 0x40,
+	0xd1, 0x3a, 0x53, 0xb4, 0x84, 0x17, 0xb3, 0x36, 0x2f, 0xcb, 0xd0, 0x42, 0x17, 0xea, 0x4f, 0x12,
+	0x92, 0xf3, 0x0d, 0x1c, 0xbc, 0x39, 0xcc, 0x15, 0x2a, 0xcb, 0x73, 0x92, 0xb7, 0x4e, 0x80, 0xd0,
+	0xbf, 0x2e, 0xfa, 0xd4, 0xd1, 0x7c, 0x9e, 0xc3, 0x4e, 0x57, 0x0f, 0xff, 0x57, 0x42, 0x4b, 0x03,
+	0x38, 0x34, 0x78, 0x67, 0xd8, 0x37, 0x56, 0xae, 0x7d, 0x24, 0x7f, 0xe6, 0xff, 0x01, 0x05, 0x5a,
+	0x5f, 0x62, 0x5a, 0x6f, 0xe1, 0x52, 0xde, 0xc3, 0x8d, 0x7b, 0x3e, 0xc1, 0x5d, 0x20, 0xae, 0x1b,
+	0xff, 0x4c, 0x42, 0x93, 0x82, 0x6b, 0x81, 0x5f, 0x1e, 0xe2, 0x8e, 0x15, 0x77, 0x65, 0xe4, 0xbb,
+	0xc3, 0x27, 0xf6, 0x3f, 0x85, 0x6b, 0x6e, 0x4b, 0x0b, 0x8c, 0x90, 0x37, 0xdf, 0x7f, 0x52, 0x90,
+	0x3e, 0x78, 0x52, 0x90, 0xfe, 0xf5, 0xa4, 0x20, 0x7d, 0xef, 0x69, 0xe1, 0xd4, 0x07, 0x4f, 0x0b,
+	0xa7, 0xfe, 0xf6, 0xb4, 0x70, 0xea, 0xed, 0x17, 0x63, 0x36, 0x13, 0xa4, 0x6f, 0x58, 0x7a, 0xc5,
+	0x8b, 0xb0, 0x8e, 0xee, 0xdc, 0x2e, 0x1f, 0xc7, 0xa6, 0xbf, 0xe5, 0x18, 0x5e, 0x65, 0x9c, 0xfd,
+	0xff, 0xc2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x44, 0x1f, 0x7a, 0xc0, 0x69, 0x23, 0x00, 0x00,
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.protorev.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetProtoRevNumberOfTrades",
-			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
-		},
-		{
-			MethodName: "GetProtoRevProfitsByDenom",
-			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllProfits",
-			Handler:    _Query_GetProtoRevAllProfits_Handler,
-		},
-		{
-			MethodName: "GetProtoRevStatisticsByRoute",
-			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllRouteStatistics",
-			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
-		},
-		{
-			MethodName: "GetProtoRevTokenPairArbRoutes",
-			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAdminAccount",
-			Handler:    _Query_GetProtoRevAdminAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevDeveloperAccount",
-			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevInfoByPoolType",
-			Handler:    _Query_GetProtoRevInfoByPoolType_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerTx",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
-		},
-		{
-			MethodName: "GetProtoRevBaseDenoms",
-			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
-		},
-		{
-			MethodName: "GetProtoRevEnabled",
-			Handler:    _Query_GetProtoRevEnabled_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPool",
-			Handler:    _Query_GetProtoRevPool_Handler,
-		},
-		{
-			MethodName: "GetAllProtocolRevenue",
-			Handler:    _Query_GetAllProtocolRevenue_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/protorev/v1beta1/query.proto",
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevInfoByPoolType queries pool type information that is currently
+	// being utilized by the module
+	GetProtoRevInfoByPoolType(ctx context.Context, in *QueryGetProtoRevInfoByPoolTypeRequest, opts ...grpc.CallOption) (*QueryGetProtoRevInfoByPoolTypeResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+	// GetAllProtocolRevenue queries all of the protocol revenue that has been
+	// accumulated by any module
+	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevMaxCyclicRouteHops queries the maximum number of hops of the
+	// cyclic arbitrage routes found by the cycle finder
+	GetProtoRevMaxCyclicRouteHops(ctx context.Context, in *QueryGetProtoRevMaxCyclicRouteHopsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxCyclicRouteHopsResponse, error)
+	// GetProtoRevBackrunRecords queries the backruns executed by the module that
+	// have not been pruned yet, along with the transactions that triggered them
+	GetProtoRevBackrunRecords(ctx context.Context, in *QueryGetProtoRevBackrunRecordsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBackrunRecordsResponse, error)
+	// GetProtoRevTopTriggerPools queries the pools whose swaps triggered the most
+	// backruns over the most recent days
+	GetProtoRevTopTriggerPools(ctx context.Context, in *QueryGetProtoRevTopTriggerPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTopTriggerPoolsResponse, error)
+	// GetProtoRevProfitShareByTriggerPool queries the share of the backrun
+	// profits made after swaps on each pool over the most recent days
+	GetProtoRevProfitShareByTriggerPool(ctx context.Context, in *QueryGetProtoRevProfitShareByTriggerPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitShareByTriggerPoolResponse, error)
+	// GetProtoRevDryRun queries the backruns the module would try after a
+	// hypothetical swap, without committing any state
+	GetProtoRevDryRun(ctx context.Context, in *QueryGetProtoRevDryRunRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDryRunResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	out := new(QueryGetProtoRevNumberOfTradesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	out := new(QueryGetProtoRevProfitsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
+	out := new(QueryGetProtoRevAllProfitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	out := new(QueryGetProtoRevStatisticsByRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NumberOfTrades.Size()
-		i -= size
-		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
+	out := new(QueryGetProtoRevAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	out := new(QueryGetProtoRevDeveloperAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevInfoByPoolType(ctx context.Context, in *QueryGetProtoRevInfoByPoolTypeRequest, opts ...grpc.CallOption) (*QueryGetProtoRevInfoByPoolTypeResponse, error) {
+	out := new(QueryGetProtoRevInfoByPoolTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevInfoByPoolType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Profit != nil {
-		{
-			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Profits) > 0 {
-		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	out := new(QueryGetProtoRevBaseDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
+	out := new(QueryGetProtoRevEnabledResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		dAtA4 := make([]byte, len(m.Route)*10)
-		var j3 int
-		for _, num := range m.Route {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
+	out := new(QueryGetProtoRevPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error) {
+	out := new(QueryGetAllProtocolRevenueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetAllProtocolRevenue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevMaxCyclicRouteHops(ctx context.Context, in *QueryGetProtoRevMaxCyclicRouteHopsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxCyclicRouteHopsResponse, error) {
+	out := new(QueryGetProtoRevMaxCyclicRouteHopsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxCyclicRouteHops", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevBackrunRecords(ctx context.Context, in *QueryGetProtoRevBackrunRecordsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBackrunRecordsResponse, error) {
+	out := new(QueryGetProtoRevBackrunRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBackrunRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevTopTriggerPools(ctx context.Context, in *QueryGetProtoRevTopTriggerPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTopTriggerPoolsResponse, error) {
+	out := new(QueryGetProtoRevTopTriggerPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTopTriggerPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetProtoRevProfitShareByTriggerPool(ctx context.Context, in *QueryGetProtoRevProfitShareByTriggerPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitShareByTriggerPoolResponse, error) {
+	out := new(QueryGetProtoRevProfitShareByTriggerPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitShareByTriggerPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevDryRun(ctx context.Context, in *QueryGetProtoRevDryRunRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDryRunResponse, error) {
+	out := new(QueryGetProtoRevDryRunResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDryRun", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevInfoByPoolType queries pool type information that is currently
+	// being utilized by the module
+	GetProtoRevInfoByPoolType(context.Context, *QueryGetProtoRevInfoByPoolTypeRequest) (*QueryGetProtoRevInfoByPoolTypeResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+	// GetAllProtocolRevenue queries all of the protocol revenue that has been
+	// accumulated by any module
+	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevMaxCyclicRouteHops queries the maximum number of hops of the
+	// cyclic arbitrage routes found by the cycle finder
+	GetProtoRevMaxCyclicRouteHops(context.Context, *QueryGetProtoRevMaxCyclicRouteHopsRequest) (*QueryGetProtoRevMaxCyclicRouteHopsResponse, error)
+	// GetProtoRevBackrunRecords queries the backruns executed by the module that
+	// have not been pruned yet, along with the transactions that triggered them
+	GetProtoRevBackrunRecords(context.Context, *QueryGetProtoRevBackrunRecordsRequest) (*QueryGetProtoRevBackrunRecordsResponse, error)
+	// GetProtoRevTopTriggerPools queries the pools whose swaps triggered the most
+	// backruns over the most recent days
+	GetProtoRevTopTriggerPools(context.Context, *QueryGetProtoRevTopTriggerPoolsRequest) (*QueryGetProtoRevTopTriggerPoolsResponse, error)
+	// GetProtoRevProfitShareByTriggerPool queries the share of the backrun
+	// profits made after swaps on each pool over the most recent days
+	GetProtoRevProfitShareByTriggerPool(context.Context, *QueryGetProtoRevProfitShareByTriggerPoolRequest) (*QueryGetProtoRevProfitShareByTriggerPoolResponse, error)
+	// GetProtoRevDryRun queries the backruns the module would try after a
+	// hypothetical swap, without committing any state
+	GetProtoRevDryRun(context.Context, *QueryGetProtoRevDryRunRequest) (*QueryGetProtoRevDryRunResponse, error)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
 }
-
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminAccount) > 0 {
-		i -= len(m.AdminAccount)
-		copy(dAtA[i:], m.AdminAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevInfoByPoolType(ctx context.Context, req *QueryGetProtoRevInfoByPoolTypeRequest) (*QueryGetProtoRevInfoByPoolTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevInfoByPoolType not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeveloperAccount) > 0 {
-		i -= len(m.DeveloperAccount)
-		copy(dAtA[i:], m.DeveloperAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
 }
-
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
 }
-
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxCyclicRouteHops(ctx context.Context, req *QueryGetProtoRevMaxCyclicRouteHopsRequest) (*QueryGetProtoRevMaxCyclicRouteHopsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxCyclicRouteHops not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevBackrunRecords(ctx context.Context, req *QueryGetProtoRevBackrunRecordsRequest) (*QueryGetProtoRevBackrunRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBackrunRecords not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevTopTriggerPools(ctx context.Context, req *QueryGetProtoRevTopTriggerPoolsRequest) (*QueryGetProtoRevTopTriggerPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTopTriggerPools not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitShareByTriggerPool(ctx context.Context, req *QueryGetProtoRevProfitShareByTriggerPoolRequest) (*QueryGetProtoRevProfitShareByTriggerPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitShareByTriggerPool not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevDryRun(ctx context.Context, req *QueryGetProtoRevDryRunRequest) (*QueryGetProtoRevDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDryRun not implemented")
 }
 
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InfoByPoolType.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevNumberOfTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitsByDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllProfitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevStatisticsByRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAdminAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevInfoByPoolType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevInfoByPoolTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevInfoByPoolType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevInfoByPoolType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevInfoByPoolType(ctx, req.(*QueryGetProtoRevInfoByPoolTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBaseDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllProtocolRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllProtocolRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetAllProtocolRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllProtocolRevenue(ctx, req.(*QueryGetAllProtocolRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMaxCyclicRouteHops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxCyclicRouteHopsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxCyclicRouteHops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxCyclicRouteHops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxCyclicRouteHops(ctx, req.(*QueryGetProtoRevMaxCyclicRouteHopsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevBackrunRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBackrunRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBackrunRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBackrunRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBackrunRecords(ctx, req.(*QueryGetProtoRevBackrunRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevTopTriggerPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTopTriggerPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTopTriggerPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTopTriggerPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTopTriggerPools(ctx, req.(*QueryGetProtoRevTopTriggerPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProfitShareByTriggerPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitShareByTriggerPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitShareByTriggerPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitShareByTriggerPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitShareByTriggerPool(ctx, req.(*QueryGetProtoRevProfitShareByTriggerPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDryRun(ctx, req.(*QueryGetProtoRevDryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.protorev.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetProtoRevNumberOfTrades",
+			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitsByDenom",
+			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllProfits",
+			Handler:    _Query_GetProtoRevAllProfits_Handler,
+		},
+		{
+			MethodName: "GetProtoRevStatisticsByRoute",
+			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllRouteStatistics",
+			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTokenPairArbRoutes",
+			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAdminAccount",
+			Handler:    _Query_GetProtoRevAdminAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDeveloperAccount",
+			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevInfoByPoolType",
+			Handler:    _Query_GetProtoRevInfoByPoolType_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerTx",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBaseDenoms",
+			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEnabled",
+			Handler:    _Query_GetProtoRevEnabled_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPool",
+			Handler:    _Query_GetProtoRevPool_Handler,
+		},
+		{
+			MethodName: "GetAllProtocolRevenue",
+			Handler:    _Query_GetAllProtocolRevenue_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxCyclicRouteHops",
+			Handler:    _Query_GetProtoRevMaxCyclicRouteHops_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBackrunRecords",
+			Handler:    _Query_GetProtoRevBackrunRecords_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTopTriggerPools",
+			Handler:    _Query_GetProtoRevTopTriggerPools_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitShareByTriggerPool",
+			Handler:    _Query_GetProtoRevProfitShareByTriggerPool_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDryRun",
+			Handler:    _Query_GetProtoRevDryRun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/protorev/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2992,12 +3080,12 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3005,7 +3093,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3015,34 +3103,30 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3052,12 +3136,12 @@ func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3065,7 +3149,7 @@ func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3075,30 +3159,30 @@ func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3108,34 +3192,27 @@ func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OtherDenom) > 0 {
-		i -= len(m.OtherDenom)
-		copy(dAtA[i:], m.OtherDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3145,25 +3222,32 @@ func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if m.Profit != nil {
+		{
+			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3173,12 +3257,12 @@ func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3186,7 +3270,7 @@ func (m *QueryGetAllProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3196,412 +3280,2546 @@ func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AllProtocolRevenue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Route) > 0 {
+		dAtA4 := make([]byte, len(m.Route)*10)
+		var j3 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Profit != nil {
-		l = m.Profit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Profits) > 0 {
-		for _, e := range m.Profits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		l = 0
-		for _, e := range m.Route {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Statistics.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for _, e := range m.Statistics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AdminAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DeveloperAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InfoByPoolType.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BaseDenoms) > 0 {
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InfoByPoolType.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OtherDenom) > 0 {
+		i -= len(m.OtherDenom)
+		copy(dAtA[i:], m.OtherDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllProtocolRevenue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCyclicRouteHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCyclicRouteHops))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBackrunRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBackrunRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBackrunRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBackrunRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBackrunRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBackrunRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Days != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Days != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalProfits) > 0 {
+		for iNdEx := len(m.TotalProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProfitShares) > 0 {
+		for iNdEx := len(m.ProfitShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDryRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDryRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDryRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolPointsUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolPointsUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Backruns) > 0 {
+		for iNdEx := len(m.Backruns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backruns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profit != nil {
+		l = m.Profit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InfoByPoolType.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
 		for _, e := range m.BaseDenoms {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OtherDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllProtocolRevenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCyclicRouteHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCyclicRouteHops))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBackrunRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBackrunRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Days != 0 {
+		n += 1 + sovQuery(uint64(m.Days))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Days != 0 {
+		n += 1 + sovQuery(uint64(m.Days))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProfitShares) > 0 {
+		for _, e := range m.ProfitShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalProfits) > 0 {
+		for _, e := range m.TotalProfits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDryRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDryRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Backruns) > 0 {
+		for _, e := range m.Backruns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PoolPointsUsed != 0 {
+		n += 1 + sovQuery(uint64(m.PoolPointsUsed))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profit == nil {
+				m.Profit = &types.Coin{}
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OtherDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetAllProtocolRevenueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, RouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.AllProtocolRevenue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3624,10 +5842,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3651,7 +5869,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3674,15 +5892,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3709,7 +5927,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3734,7 +5953,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3757,10 +5976,10 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3784,7 +6003,7 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3807,15 +6026,15 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3843,9 +6062,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3868,7 +6085,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3891,44 +6108,12 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3950,7 +6135,7 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3973,17 +6158,17 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3993,27 +6178,23 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profit == nil {
-				m.Profit = &types.Coin{}
-			}
-			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4036,7 +6217,7 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4059,10 +6240,10 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4086,7 +6267,7 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4109,15 +6290,15 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfoByPoolType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4144,8 +6325,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profits = append(m.Profits, types.Coin{})
-			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InfoByPoolType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4170,7 +6350,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4193,88 +6373,12 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Route = append(m.Route, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Route) == 0 {
-					m.Route = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Route = append(m.Route, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4296,7 +6400,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4319,17 +6423,17 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4339,25 +6443,11 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4379,7 +6469,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4402,10 +6492,10 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4429,7 +6519,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4452,46 +6542,31 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Statistics = append(m.Statistics, RouteStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4513,7 +6588,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4536,10 +6611,10 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4563,7 +6638,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,15 +6661,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4621,8 +6696,8 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4647,7 +6722,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4670,10 +6745,10 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4697,7 +6772,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4720,17 +6795,17 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4740,24 +6815,12 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdminAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4779,7 +6842,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4802,12 +6865,76 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4829,7 +6956,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4852,17 +6979,17 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4872,24 +6999,11 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4911,7 +7025,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,10 +7048,10 @@ func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllProtocolRevenueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllProtocolRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4961,7 +7075,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,15 +7098,15 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllProtocolRevenueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllProtocolRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfoByPoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllProtocolRevenue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5019,7 +7133,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InfoByPoolType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllProtocolRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5044,7 +7158,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxCyclicRouteHopsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,10 +7181,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxCyclicRouteHopsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxCyclicRouteHopsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5094,7 +7208,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxCyclicRouteHopsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5117,17 +7231,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxCyclicRouteHopsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxCyclicRouteHopsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCyclicRouteHops", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			m.MaxCyclicRouteHops = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5137,7 +7251,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				m.MaxCyclicRouteHops |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5163,7 +7277,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBackrunRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5186,12 +7300,48 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBackrunRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBackrunRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5213,7 +7363,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBackrunRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5236,17 +7386,51 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBackrunRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBackrunRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BackrunRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5256,11 +7440,28 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5282,7 +7483,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTopTriggerPoolsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5305,12 +7506,50 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTopTriggerPoolsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTopTriggerPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5332,7 +7571,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTopTriggerPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5355,15 +7594,15 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTopTriggerPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTopTriggerPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5390,8 +7629,8 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Statistics = append(m.Statistics, TriggerPoolStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5416,57 +7655,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitShareByTriggerPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5489,17 +7678,17 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitShareByTriggerPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitShareByTriggerPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
 			}
-			var v int
+			m.Days = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5509,12 +7698,11 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Days |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5536,7 +7724,7 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitShareByTriggerPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5559,17 +7747,17 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitShareByTriggerPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitShareByTriggerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5579,29 +7767,31 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.ProfitShares = append(m.ProfitShares, TriggerPoolProfitShare{})
+			if err := m.ProfitShares[len(m.ProfitShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProfits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5611,23 +7801,25 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			m.TotalProfits = append(m.TotalProfits, types.Coin{})
+			if err := m.TotalProfits[len(m.TotalProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5650,7 +7842,7 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDryRunRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5673,17 +7865,17 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDryRunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5693,61 +7885,58 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetAllProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Route = append(m.Route, types1.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllProtocolRevenueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllProtocolRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5769,7 +7958,7 @@ func (m *QueryGetAllProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDryRunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5792,15 +7981,15 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllProtocolRevenueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDryRunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllProtocolRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllProtocolRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backruns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5827,10 +8016,30 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllProtocolRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Backruns = append(m.Backruns, DryRunBackrun{})
+			if err := m.Backruns[len(m.Backruns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPointsUsed", wireType)
+			}
+			m.PoolPointsUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPointsUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
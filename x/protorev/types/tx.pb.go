@@ -591,6 +591,100 @@ func (m *MsgSetBaseDenomsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBaseDenomsResponse proto.InternalMessageInfo
 
+// MsgSetMaxCyclicRouteHops defines the Msg/SetMaxCyclicRouteHops request type.
+type MsgSetMaxCyclicRouteHops struct {
+	// admin is the account that is authorized to set the max cyclic route hops.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// max_cyclic_route_hops is the maximum number of hops of the cyclic
+	// arbitrage routes found by the cycle finder. 0 disables the cycle finder.
+	MaxCyclicRouteHops uint64 `protobuf:"varint,2,opt,name=max_cyclic_route_hops,json=maxCyclicRouteHops,proto3" json:"max_cyclic_route_hops,omitempty" yaml:"max_cyclic_route_hops"`
+}
+
+func (m *MsgSetMaxCyclicRouteHops) Reset()         { *m = MsgSetMaxCyclicRouteHops{} }
+func (m *MsgSetMaxCyclicRouteHops) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxCyclicRouteHops) ProtoMessage()    {}
+func (*MsgSetMaxCyclicRouteHops) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{12}
+}
+func (m *MsgSetMaxCyclicRouteHops) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxCyclicRouteHops) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxCyclicRouteHops.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxCyclicRouteHops) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxCyclicRouteHops.Merge(m, src)
+}
+func (m *MsgSetMaxCyclicRouteHops) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxCyclicRouteHops) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxCyclicRouteHops.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxCyclicRouteHops proto.InternalMessageInfo
+
+func (m *MsgSetMaxCyclicRouteHops) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetMaxCyclicRouteHops) GetMaxCyclicRouteHops() uint64 {
+	if m != nil {
+		return m.MaxCyclicRouteHops
+	}
+	return 0
+}
+
+// MsgSetMaxCyclicRouteHopsResponse defines the Msg/SetMaxCyclicRouteHops
+// response type.
+type MsgSetMaxCyclicRouteHopsResponse struct {
+}
+
+func (m *MsgSetMaxCyclicRouteHopsResponse) Reset()         { *m = MsgSetMaxCyclicRouteHopsResponse{} }
+func (m *MsgSetMaxCyclicRouteHopsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxCyclicRouteHopsResponse) ProtoMessage()    {}
+func (*MsgSetMaxCyclicRouteHopsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{13}
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxCyclicRouteHopsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxCyclicRouteHopsResponse.Merge(m, src)
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxCyclicRouteHopsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxCyclicRouteHopsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetHotRoutes)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutes")
 	proto.RegisterType((*MsgSetHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutesResponse")
@@ -604,68 +698,75 @@ func init() {
 	proto.RegisterType((*MsgSetMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPoolPointsPerBlockResponse")
 	proto.RegisterType((*MsgSetBaseDenoms)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenoms")
 	proto.RegisterType((*MsgSetBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenomsResponse")
+	proto.RegisterType((*MsgSetMaxCyclicRouteHops)(nil), "osmosis.protorev.v1beta1.MsgSetMaxCyclicRouteHops")
+	proto.RegisterType((*MsgSetMaxCyclicRouteHopsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxCyclicRouteHopsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/protorev/v1beta1/tx.proto", fileDescriptor_2783dce032fc6954) }
 
 var fileDescriptor_2783dce032fc6954 = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
-	0x18, 0xcd, 0xa4, 0x80, 0xe8, 0xa4, 0x40, 0xd7, 0xa4, 0xad, 0xd7, 0x6c, 0xbd, 0xce, 0x84, 0xaa,
-	0x9b, 0xaa, 0xb5, 0xd9, 0xa5, 0xfc, 0x90, 0x25, 0x90, 0x62, 0xf5, 0x40, 0x0f, 0x41, 0x91, 0x1b,
-	0x84, 0xc4, 0x01, 0x63, 0xef, 0x4e, 0x1c, 0xab, 0x6b, 0x8f, 0xe5, 0x99, 0x44, 0xbb, 0x57, 0x8e,
-	0x9c, 0x90, 0x90, 0x38, 0xf0, 0x2f, 0xc0, 0x01, 0x21, 0xae, 0xdc, 0xc3, 0xad, 0xa2, 0x07, 0x7a,
-	0x61, 0x85, 0x12, 0x24, 0xae, 0x68, 0xff, 0x02, 0xe4, 0x19, 0xaf, 0xb7, 0x5e, 0xdb, 0x24, 0x61,
-	0x2f, 0xab, 0xf5, 0x37, 0xef, 0x7b, 0xdf, 0x7b, 0xcf, 0xf6, 0x67, 0xb8, 0x41, 0x68, 0x48, 0x68,
-	0x40, 0x8d, 0x38, 0x21, 0x8c, 0x24, 0xf8, 0xc8, 0x38, 0xea, 0x7a, 0x98, 0xb9, 0x5d, 0x83, 0x8d,
-	0x74, 0x5e, 0x93, 0xe4, 0x0c, 0xa2, 0xcf, 0x20, 0x7a, 0x06, 0x51, 0xd6, 0x7d, 0xe2, 0x13, 0x5e,
-	0x35, 0xd2, 0x7f, 0x02, 0xa0, 0x34, 0xdc, 0x30, 0x88, 0x88, 0xc1, 0x7f, 0xb3, 0x52, 0xcb, 0x27,
-	0xc4, 0x1f, 0x62, 0xc3, 0x8d, 0x03, 0xc3, 0x8d, 0x22, 0xc2, 0x5c, 0x16, 0x90, 0x28, 0x63, 0x54,
-	0x6e, 0xd7, 0x6a, 0xc8, 0x27, 0x0a, 0x60, 0xb3, 0xcf, 0x91, 0x8e, 0x18, 0x29, 0x2e, 0xc4, 0x11,
-	0xfa, 0x1d, 0xc0, 0xd7, 0x76, 0xa8, 0xff, 0x08, 0xb3, 0x8f, 0x08, 0xb3, 0xc9, 0x21, 0xc3, 0x54,
-	0xfa, 0x10, 0xbe, 0xe8, 0x0e, 0xc2, 0x20, 0x92, 0x81, 0x06, 0x3a, 0x97, 0xad, 0xce, 0x74, 0xd2,
-	0xbe, 0x32, 0x76, 0xc3, 0xa1, 0x89, 0x78, 0x19, 0xfd, 0xf6, 0xf3, 0xbd, 0xf5, 0x8c, 0x64, 0x7b,
-	0x30, 0x48, 0x30, 0xa5, 0x8f, 0x58, 0x12, 0x44, 0xbe, 0x2d, 0xda, 0xa4, 0x7d, 0x08, 0x0f, 0x08,
-	0x73, 0x12, 0xce, 0x26, 0xaf, 0x6a, 0x97, 0x3a, 0x6b, 0xbd, 0xbb, 0x7a, 0x5d, 0x1a, 0xfa, 0x1e,
-	0x79, 0x8c, 0xa3, 0x5d, 0x37, 0x48, 0xb6, 0x13, 0x4f, 0x28, 0xb0, 0x9a, 0xc7, 0x93, 0xf6, 0xca,
-	0x74, 0xd2, 0x6e, 0x88, 0xb1, 0x73, 0x36, 0x64, 0x5f, 0x3e, 0x98, 0xe9, 0x34, 0x5b, 0x5f, 0xfd,
-	0xfd, 0xe3, 0x9d, 0x1b, 0xb3, 0x10, 0x16, 0x5c, 0xa0, 0x26, 0xbc, 0xb1, 0x50, 0xb2, 0x31, 0x8d,
-	0x49, 0x44, 0x31, 0x3a, 0x06, 0xf0, 0xba, 0x38, 0x7b, 0x80, 0x8f, 0xf0, 0x90, 0xc4, 0x38, 0xd9,
-	0xee, 0xf7, 0xc9, 0x61, 0xc4, 0x96, 0xf6, 0xfe, 0x10, 0x36, 0x06, 0x33, 0x4e, 0xc7, 0x15, 0xa4,
-	0xf2, 0x2a, 0xe7, 0x6a, 0x4d, 0x27, 0x6d, 0x59, 0x70, 0x95, 0x20, 0xc8, 0xbe, 0x3a, 0x58, 0x90,
-	0x62, 0x6e, 0xa6, 0xf6, 0xd4, 0xa2, 0xbd, 0x45, 0xbd, 0x48, 0x83, 0x6a, 0xf5, 0x49, 0x6e, 0xf6,
-	0x1f, 0x00, 0xd7, 0x05, 0xe4, 0x61, 0xb4, 0x4f, 0xac, 0xf1, 0x2e, 0x21, 0xc3, 0xbd, 0x71, 0x8c,
-	0x97, 0xb6, 0x7a, 0x08, 0x1b, 0x41, 0xb4, 0x4f, 0x1c, 0x6f, 0xec, 0xc4, 0x84, 0x0c, 0x1d, 0x36,
-	0x8e, 0x31, 0xb7, 0xba, 0xd6, 0xeb, 0xd4, 0xdf, 0xed, 0xa2, 0x08, 0x4b, 0xcb, 0xee, 0x74, 0x16,
-	0x4c, 0x89, 0x10, 0xd9, 0xaf, 0x06, 0x85, 0x0e, 0x73, 0x23, 0x8d, 0xa5, 0x55, 0x8c, 0xa5, 0x48,
-	0x8a, 0x54, 0xd8, 0xaa, 0xaa, 0xe7, 0x91, 0x3c, 0x03, 0x50, 0x16, 0x80, 0x1d, 0x77, 0x94, 0x9e,
-	0xee, 0x92, 0x20, 0x62, 0x74, 0x17, 0x27, 0x7b, 0xa3, 0xa5, 0x63, 0xf9, 0x04, 0x5e, 0x0f, 0xdd,
-	0x91, 0x70, 0x10, 0x73, 0x5e, 0x27, 0xbd, 0xd1, 0x6c, 0xc4, 0xb3, 0x79, 0xc1, 0xda, 0x98, 0x4e,
-	0xda, 0x37, 0x05, 0x61, 0x35, 0x0e, 0xd9, 0x52, 0x58, 0x92, 0x65, 0xde, 0x4a, 0x6d, 0x6b, 0x45,
-	0xdb, 0x65, 0xf5, 0x08, 0x41, 0xad, 0xee, 0x2c, 0xb7, 0xff, 0x07, 0x80, 0x6f, 0x54, 0x83, 0xac,
-	0x21, 0xe9, 0x3f, 0x5e, 0x3a, 0x81, 0xcf, 0x61, 0xb3, 0xca, 0x99, 0x97, 0x92, 0x67, 0x21, 0xbc,
-	0x39, 0x9d, 0xb4, 0xb5, 0xfa, 0x10, 0x38, 0x14, 0xd9, 0xd7, 0xc2, 0x2a, 0x7d, 0xa6, 0x9a, 0x46,
-	0xd1, 0x2c, 0x46, 0x91, 0xc2, 0x3e, 0xc5, 0x81, 0x7f, 0xc0, 0x28, 0xba, 0x05, 0x37, 0xff, 0xc3,
-	0x5e, 0x1e, 0xc3, 0x53, 0x00, 0xaf, 0x0a, 0x9c, 0xe5, 0x52, 0xfc, 0x00, 0x47, 0x24, 0x5c, 0x7e,
-	0xf7, 0x7d, 0x01, 0xd7, 0x3c, 0x97, 0x62, 0x67, 0xc0, 0xe9, 0xb2, 0xe5, 0xb7, 0x59, 0xff, 0x3a,
-	0xe4, 0xa3, 0x2d, 0x25, 0x7b, 0x13, 0x24, 0x31, 0xee, 0x39, 0x16, 0x64, 0x43, 0x2f, 0x57, 0x68,
-	0xde, 0x4c, 0xdd, 0xcb, 0x45, 0xf7, 0x73, 0x03, 0x48, 0x99, 0x3d, 0xda, 0xf3, 0xda, 0xcc, 0x71,
-	0xef, 0xfb, 0x97, 0xe1, 0xa5, 0x1d, 0xea, 0x4b, 0xdf, 0x02, 0x78, 0xa5, 0xb0, 0xf1, 0xb7, 0xea,
-	0x05, 0x2e, 0xec, 0x50, 0xa5, 0x7b, 0x6e, 0x68, 0x1e, 0x74, 0xe7, 0xcb, 0xa7, 0x7f, 0x7d, 0xb3,
-	0x8a, 0x90, 0x66, 0x94, 0x3e, 0x58, 0x14, 0x33, 0x67, 0xbe, 0xdd, 0xa5, 0x9f, 0x00, 0x7c, 0xbd,
-	0x6a, 0x2b, 0xbf, 0x75, 0xd6, 0xd0, 0xc5, 0x0e, 0xe5, 0xfd, 0x8b, 0x76, 0xe4, 0x6a, 0x0d, 0xae,
-	0x76, 0x0b, 0xdd, 0xae, 0x56, 0x5b, 0x5a, 0xdd, 0xd2, 0x2f, 0x00, 0x5e, 0xab, 0x5e, 0x25, 0xbd,
-	0xb3, 0x44, 0x94, 0x7b, 0x14, 0xf3, 0xe2, 0x3d, 0xb9, 0xf4, 0xfb, 0x5c, 0xba, 0x8e, 0xee, 0x56,
-	0x4b, 0xaf, 0x5e, 0x37, 0xd2, 0xaf, 0x00, 0xca, 0xb5, 0xbb, 0xe0, 0x9d, 0x8b, 0xca, 0xe1, 0x6d,
-	0xca, 0x07, 0xff, 0xab, 0x2d, 0x37, 0xf2, 0x1e, 0x37, 0xd2, 0x45, 0xc6, 0xf9, 0x8d, 0xf0, 0x95,
-	0x21, 0xfd, 0x00, 0x60, 0xa3, 0xfc, 0xa5, 0xd3, 0xcf, 0x52, 0x53, 0xc4, 0x2b, 0xef, 0x5e, 0x0c,
-	0x7f, 0xde, 0x47, 0xa7, 0xf4, 0x71, 0x93, 0xbe, 0x03, 0xf0, 0x95, 0xe2, 0xfe, 0xb9, 0x73, 0xd6,
-	0xe8, 0x39, 0x56, 0xe9, 0x9d, 0x1f, 0x9b, 0x4b, 0xdc, 0xe2, 0x12, 0x37, 0xd1, 0x46, 0xb5, 0xc4,
-	0xe7, 0xb6, 0x8e, 0xf5, 0xf1, 0xf1, 0x89, 0x0a, 0x9e, 0x9c, 0xa8, 0xe0, 0xcf, 0x13, 0x15, 0x7c,
-	0x7d, 0xaa, 0xae, 0x3c, 0x39, 0x55, 0x57, 0x9e, 0x9d, 0xaa, 0x2b, 0x9f, 0xdd, 0xf7, 0x03, 0x76,
-	0x70, 0xe8, 0xe9, 0x7d, 0x12, 0xce, 0x68, 0xee, 0x0d, 0x5d, 0x8f, 0xe6, 0x9c, 0x47, 0xbd, 0xae,
-	0x31, 0x9a, 0x33, 0xa7, 0x5e, 0xa9, 0xf7, 0x12, 0xbf, 0x7e, 0xfb, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x10, 0xd2, 0x39, 0x6c, 0x3b, 0x0b, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xbb, 0x80, 0xd4, 0xe9, 0x02, 0x1b, 0xd3, 0xdd, 0x75, 0x4c, 0xd6, 0x71, 0xa7, 0xac,
+	0x36, 0x5d, 0xb6, 0x31, 0xc9, 0x2e, 0x3f, 0x64, 0x09, 0xa4, 0x9a, 0x3d, 0xec, 0x1e, 0x8a, 0x2a,
+	0xb7, 0x08, 0x89, 0x03, 0xc6, 0x4e, 0xa6, 0x8e, 0xb5, 0xb1, 0xc7, 0xf2, 0xb8, 0x55, 0x72, 0xe5,
+	0xc8, 0x09, 0x09, 0x89, 0x03, 0x7f, 0x03, 0x07, 0x84, 0x38, 0xc2, 0xbd, 0xdc, 0x56, 0xac, 0x04,
+	0xbd, 0x10, 0xa1, 0x16, 0x09, 0x89, 0x13, 0xca, 0x5f, 0x80, 0x3c, 0xe3, 0x38, 0xf5, 0x2f, 0x92,
+	0x6c, 0x2e, 0x55, 0x33, 0xf3, 0xbd, 0x6f, 0xbe, 0xef, 0xbd, 0xe7, 0xf7, 0xc0, 0x26, 0x26, 0x2e,
+	0x26, 0x0e, 0x51, 0xfc, 0x00, 0x87, 0x38, 0x40, 0x27, 0xca, 0x49, 0xcb, 0x42, 0xa1, 0xd9, 0x52,
+	0xc2, 0x41, 0x93, 0x9e, 0xf1, 0x42, 0x0c, 0x69, 0x4e, 0x20, 0xcd, 0x18, 0x22, 0x6e, 0xd8, 0xd8,
+	0xc6, 0xf4, 0x54, 0x89, 0xfe, 0x63, 0x00, 0xb1, 0x62, 0xba, 0x8e, 0x87, 0x15, 0xfa, 0x37, 0x3e,
+	0xaa, 0xd9, 0x18, 0xdb, 0x7d, 0xa4, 0x98, 0xbe, 0xa3, 0x98, 0x9e, 0x87, 0x43, 0x33, 0x74, 0xb0,
+	0x17, 0x33, 0x8a, 0x77, 0x4a, 0x35, 0x24, 0x2f, 0x32, 0x60, 0xb5, 0x43, 0x91, 0x06, 0x7b, 0x92,
+	0xfd, 0x60, 0x57, 0xf0, 0x77, 0x0e, 0xbc, 0xba, 0x47, 0xec, 0x03, 0x14, 0x3e, 0xc2, 0xa1, 0x8e,
+	0x8f, 0x43, 0x44, 0xf8, 0x0f, 0xc0, 0x8b, 0x66, 0xd7, 0x75, 0x3c, 0x81, 0x93, 0xb9, 0xc6, 0x9a,
+	0xd6, 0x18, 0x8f, 0xea, 0x57, 0x87, 0xa6, 0xdb, 0x57, 0x21, 0x3d, 0x86, 0xbf, 0xfe, 0xb8, 0xb3,
+	0x11, 0x93, 0xec, 0x76, 0xbb, 0x01, 0x22, 0xe4, 0x20, 0x0c, 0x1c, 0xcf, 0xd6, 0x59, 0x18, 0x7f,
+	0x04, 0x40, 0x0f, 0x87, 0x46, 0x40, 0xd9, 0x84, 0x55, 0xf9, 0x4a, 0x63, 0xbd, 0x7d, 0xaf, 0x59,
+	0x96, 0x8d, 0xe6, 0x21, 0x7e, 0x82, 0xbc, 0x7d, 0xd3, 0x09, 0x76, 0x03, 0x8b, 0x29, 0xd0, 0xaa,
+	0xa7, 0xa3, 0xfa, 0xca, 0x78, 0x54, 0xaf, 0xb0, 0x67, 0xa7, 0x6c, 0x50, 0x5f, 0xeb, 0x4d, 0x74,
+	0xaa, 0xb5, 0x2f, 0xff, 0xfe, 0xfe, 0xee, 0xcd, 0x49, 0x12, 0x32, 0x2e, 0x60, 0x15, 0xdc, 0xcc,
+	0x1c, 0xe9, 0x88, 0xf8, 0xd8, 0x23, 0x08, 0x9e, 0x72, 0xe0, 0x06, 0xbb, 0x7b, 0x88, 0x4e, 0x50,
+	0x1f, 0xfb, 0x28, 0xd8, 0xed, 0x74, 0xf0, 0xb1, 0x17, 0x2e, 0xed, 0xfd, 0x31, 0xa8, 0x74, 0x27,
+	0x9c, 0x86, 0xc9, 0x48, 0x85, 0x55, 0xca, 0x55, 0x1b, 0x8f, 0xea, 0x02, 0xe3, 0xca, 0x41, 0xa0,
+	0x7e, 0xad, 0x9b, 0x91, 0xa2, 0x6e, 0x45, 0xf6, 0xa4, 0xb4, 0xbd, 0xac, 0x5e, 0x28, 0x03, 0xa9,
+	0xf8, 0x26, 0x31, 0xfb, 0x2f, 0x07, 0x36, 0x18, 0xe4, 0xb1, 0x77, 0x84, 0xb5, 0xe1, 0x3e, 0xc6,
+	0xfd, 0xc3, 0xa1, 0x8f, 0x96, 0xb6, 0x7a, 0x0c, 0x2a, 0x8e, 0x77, 0x84, 0x0d, 0x6b, 0x68, 0xf8,
+	0x18, 0xf7, 0x8d, 0x70, 0xe8, 0x23, 0x6a, 0x75, 0xbd, 0xdd, 0x28, 0xaf, 0x76, 0x5a, 0x84, 0x26,
+	0xc7, 0x95, 0x8e, 0x13, 0x93, 0x23, 0x84, 0xfa, 0x2b, 0x4e, 0x2a, 0x42, 0xdd, 0x8c, 0xd2, 0x52,
+	0x4b, 0xa7, 0x25, 0x4d, 0x0a, 0x25, 0x50, 0x2b, 0x3a, 0x4f, 0x52, 0x72, 0xc6, 0x01, 0x81, 0x01,
+	0xf6, 0xcc, 0x41, 0x74, 0xbb, 0x8f, 0x1d, 0x2f, 0x24, 0xfb, 0x28, 0x38, 0x1c, 0x2c, 0x9d, 0x96,
+	0x8f, 0xc1, 0x0d, 0xd7, 0x1c, 0x30, 0x07, 0x3e, 0xe5, 0x35, 0xa2, 0x42, 0x87, 0x03, 0x9a, 0x9b,
+	0x17, 0xb4, 0xcd, 0xf1, 0xa8, 0x7e, 0x8b, 0x11, 0x16, 0xe3, 0xa0, 0xce, 0xbb, 0x39, 0x59, 0xea,
+	0xed, 0xc8, 0xb6, 0x9c, 0xb6, 0x9d, 0x57, 0x0f, 0x21, 0x90, 0xcb, 0xee, 0x12, 0xfb, 0x7f, 0x70,
+	0xe0, 0xf5, 0x62, 0x90, 0xd6, 0xc7, 0x9d, 0x27, 0x4b, 0x67, 0xe0, 0x33, 0x50, 0x2d, 0x72, 0x66,
+	0x45, 0xe4, 0x71, 0x12, 0xde, 0x18, 0x8f, 0xea, 0x72, 0x79, 0x12, 0x28, 0x14, 0xea, 0xd7, 0xdd,
+	0x22, 0x7d, 0xaa, 0x14, 0xa5, 0xa2, 0x9a, 0x4e, 0x45, 0x04, 0xfb, 0x04, 0x39, 0x76, 0x2f, 0x24,
+	0xf0, 0x36, 0xd8, 0xfa, 0x1f, 0x7b, 0x49, 0x1a, 0x9e, 0x71, 0xe0, 0x1a, 0xc3, 0x69, 0x26, 0x41,
+	0x0f, 0x91, 0x87, 0xdd, 0xe5, 0x67, 0xdf, 0xe7, 0x60, 0xdd, 0x32, 0x09, 0x32, 0xba, 0x94, 0x2e,
+	0x1e, 0x7e, 0x5b, 0xe5, 0x9f, 0x43, 0xf2, 0xb4, 0x26, 0xc6, 0x5f, 0x02, 0xcf, 0x9e, 0xbb, 0xc4,
+	0x02, 0x75, 0x60, 0x25, 0x0a, 0xd5, 0x5b, 0x91, 0x7b, 0x21, 0xed, 0x7e, 0x6a, 0x00, 0x8a, 0x40,
+	0xc8, 0x9e, 0x25, 0x8e, 0x7f, 0xbb, 0xdc, 0xf7, 0x1f, 0x0e, 0x3b, 0x7d, 0xa7, 0x43, 0x27, 0xe3,
+	0x23, 0xec, 0x2f, 0xef, 0xfc, 0x00, 0x44, 0xe5, 0x32, 0x3a, 0x94, 0x96, 0x8d, 0x6b, 0xa3, 0x87,
+	0x7d, 0x12, 0x57, 0x5c, 0x1e, 0x8f, 0xea, 0xb5, 0x69, 0xc5, 0x73, 0x30, 0xd6, 0xf5, 0x19, 0x51,
+	0x65, 0x5d, 0x9f, 0x81, 0xa5, 0xba, 0x3e, 0x73, 0x37, 0x31, 0xdf, 0xfe, 0x67, 0x0d, 0x5c, 0xd9,
+	0x23, 0x36, 0xff, 0x0d, 0x07, 0xae, 0xa6, 0xd6, 0xdd, 0x76, 0x79, 0x75, 0x32, 0x0b, 0x44, 0x6c,
+	0xcd, 0x0d, 0x4d, 0x72, 0xde, 0xf8, 0xe2, 0xd9, 0x5f, 0x5f, 0xaf, 0x42, 0x28, 0x2b, 0xb9, 0x6d,
+	0x4d, 0x50, 0x68, 0x4c, 0x57, 0x1b, 0xff, 0x03, 0x07, 0x5e, 0x2b, 0x5a, 0x49, 0x6f, 0xcd, 0x7a,
+	0x34, 0x1b, 0x21, 0xbe, 0xb7, 0x68, 0x44, 0xa2, 0x56, 0xa1, 0x6a, 0xb7, 0xe1, 0x9d, 0x62, 0xb5,
+	0xb9, 0xbd, 0xc5, 0xff, 0xcc, 0x81, 0xeb, 0xc5, 0x73, 0xb4, 0x3d, 0x4b, 0x44, 0x3e, 0x46, 0x54,
+	0x17, 0x8f, 0x49, 0xa4, 0x3f, 0xa0, 0xd2, 0x9b, 0xf0, 0x5e, 0xb1, 0xf4, 0xe2, 0x59, 0xcb, 0xff,
+	0xc2, 0x01, 0xa1, 0x74, 0x10, 0xbe, 0xbd, 0xa8, 0x1c, 0x1a, 0x26, 0xbe, 0xff, 0x5c, 0x61, 0x89,
+	0x91, 0x77, 0xa9, 0x91, 0x16, 0x54, 0xe6, 0x37, 0x42, 0xe7, 0x25, 0xff, 0x1d, 0x07, 0x2a, 0xf9,
+	0x35, 0xdf, 0x9c, 0xa5, 0x26, 0x8d, 0x17, 0xdf, 0x59, 0x0c, 0x3f, 0x6f, 0xeb, 0xe4, 0x36, 0x3b,
+	0xff, 0x2d, 0x07, 0x5e, 0x4e, 0x0f, 0xdf, 0xbb, 0xb3, 0x9e, 0x9e, 0x62, 0xc5, 0xf6, 0xfc, 0xd8,
+	0x44, 0xe2, 0x36, 0x95, 0xb8, 0x05, 0x37, 0x8b, 0x25, 0x5e, 0x1a, 0xb9, 0xfc, 0x4f, 0x49, 0x5f,
+	0x67, 0xe7, 0xe4, 0x3c, 0x7d, 0x9d, 0x89, 0x11, 0xd5, 0xc5, 0x63, 0x12, 0xd1, 0xf7, 0xa9, 0xe8,
+	0x1d, 0xf8, 0x66, 0x79, 0x3b, 0xe4, 0x86, 0xa9, 0xf6, 0xd1, 0xe9, 0xb9, 0xc4, 0x3d, 0x3d, 0x97,
+	0xb8, 0x3f, 0xcf, 0x25, 0xee, 0xab, 0x0b, 0x69, 0xe5, 0xe9, 0x85, 0xb4, 0x72, 0x76, 0x21, 0xad,
+	0x7c, 0xfa, 0xc0, 0x76, 0xc2, 0xde, 0xb1, 0xd5, 0xec, 0x60, 0x77, 0x42, 0xb8, 0xd3, 0x37, 0x2d,
+	0x92, 0xb0, 0x9f, 0xb4, 0x5b, 0xca, 0x60, 0xfa, 0x46, 0x54, 0x2a, 0x62, 0xbd, 0x44, 0x7f, 0xdf,
+	0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x53, 0x1c, 0x1f, 0x77, 0xf7, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -698,6 +799,10 @@ type MsgClient interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opts ...grpc.CallOption) (*MsgSetBaseDenomsResponse, error)
+	// SetMaxCyclicRouteHops sets the maximum number of hops of the cyclic
+	// arbitrage routes found by the cycle finder. Can only be called by the admin
+	// account.
+	SetMaxCyclicRouteHops(ctx context.Context, in *MsgSetMaxCyclicRouteHops, opts ...grpc.CallOption) (*MsgSetMaxCyclicRouteHopsResponse, error)
 }
 
 type msgClient struct {
@@ -762,6 +867,15 @@ func (c *msgClient) SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opt
 	return out, nil
 }
 
+func (c *msgClient) SetMaxCyclicRouteHops(ctx context.Context, in *MsgSetMaxCyclicRouteHops, opts ...grpc.CallOption) (*MsgSetMaxCyclicRouteHopsResponse, error) {
+	out := new(MsgSetMaxCyclicRouteHopsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetMaxCyclicRouteHops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetHotRoutes sets the hot routes that will be explored when creating
@@ -782,6 +896,10 @@ type MsgServer interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(context.Context, *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error)
+	// SetMaxCyclicRouteHops sets the maximum number of hops of the cyclic
+	// arbitrage routes found by the cycle finder. Can only be called by the admin
+	// account.
+	SetMaxCyclicRouteHops(context.Context, *MsgSetMaxCyclicRouteHops) (*MsgSetMaxCyclicRouteHopsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -806,6 +924,9 @@ func (*UnimplementedMsgServer) SetInfoByPoolType(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) SetBaseDenoms(ctx context.Context, req *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBaseDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetMaxCyclicRouteHops(ctx context.Context, req *MsgSetMaxCyclicRouteHops) (*MsgSetMaxCyclicRouteHopsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxCyclicRouteHops not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -919,6 +1040,24 @@ func _Msg_SetBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMaxCyclicRouteHops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxCyclicRouteHops)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxCyclicRouteHops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetMaxCyclicRouteHops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxCyclicRouteHops(ctx, req.(*MsgSetMaxCyclicRouteHops))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -947,6 +1086,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBaseDenoms",
 			Handler:    _Msg_SetBaseDenoms_Handler,
 		},
+		{
+			MethodName: "SetMaxCyclicRouteHops",
+			Handler:    _Msg_SetMaxCyclicRouteHops_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/tx.proto",
@@ -1325,6 +1468,64 @@ func (m *MsgSetBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMaxCyclicRouteHops) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxCyclicRouteHops) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxCyclicRouteHops) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCyclicRouteHops != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCyclicRouteHops))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxCyclicRouteHopsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxCyclicRouteHopsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxCyclicRouteHopsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1492,6 +1693,31 @@ func (m *MsgSetBaseDenomsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMaxCyclicRouteHops) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxCyclicRouteHops != 0 {
+		n += 1 + sovTx(uint64(m.MaxCyclicRouteHops))
+	}
+	return n
+}
+
+func (m *MsgSetMaxCyclicRouteHopsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2461,6 +2687,157 @@ func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetMaxCyclicRouteHops) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxCyclicRouteHops: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxCyclicRouteHops: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCyclicRouteHops", wireType)
+			}
+			m.MaxCyclicRouteHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCyclicRouteHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxCyclicRouteHopsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxCyclicRouteHopsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxCyclicRouteHopsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
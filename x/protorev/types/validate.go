@@ -169,3 +169,13 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ---------------------- Cyclic Route Validation ---------------------- //
+// ValidateMaxCyclicRouteHops validates the max cyclic route hops. 0 disables the cycle finder.
+func ValidateMaxCyclicRouteHops(hops uint64) error {
+	if hops != 0 && (hops < MinCyclicRouteHops || hops > MaxCyclicRouteHops) {
+		return fmt.Errorf("max cyclic route hops must be 0 or between %d and %d", MinCyclicRouteHops, MaxCyclicRouteHops)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package twap
 
 import (
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -79,6 +80,238 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetTwapConfidenceBand returns the arithmetic TWAP of the base asset in units of the quote asset
+// from (startTime, endTime) together with signals of how much the price moved within the window:
+// * the volatility, as the time weighted standard deviation of log_{2} of the spot price.
+// * the lowest and highest spot price in effect during the window.
+//
+// A TWAP with a high volatility or a wide spot price range relative to the TWAP
+// indicates that the pool price may have been manipulated.
+//
+// The time semantics and errors are the same as GetArithmeticTwap.
+// Additionally, errors if startTime is before the geometric variance accumulator was populated,
+// since records written before then have a zero accumulator that would understate the volatility.
+// Unlike the TWAP, the spot price range requires iterating over every record in the window.
+func (k Keeper) GetTwapConfidenceBand(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (types.TwapConfidenceBand, error) {
+	if startTime.After(endTime) {
+		return types.TwapConfidenceBand{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return types.TwapConfidenceBand{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	if err := k.validateVarianceAccumulatorStartTime(ctx, startTime); err != nil {
+		return types.TwapConfidenceBand{}, err
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapConfidenceBand{}, err
+	}
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	}
+	if err != nil {
+		return types.TwapConfidenceBand{}, err
+	}
+
+	// N.B. a spot price error within the window is returned together with the result,
+	// matching the behavior of GetArithmeticTwap.
+	arithmeticTwap, spotPriceErr := computeTwap(startRecord, endRecord, quoteAssetDenom, k.GetArithmeticStrategy())
+
+	volatility, err := computeVolatility(startRecord, endRecord)
+	if err != nil {
+		return types.TwapConfidenceBand{}, err
+	}
+
+	laterRecords, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapConfidenceBand{}, err
+	}
+	minSpotPrice, maxSpotPrice := computeSpotPriceRange(startRecord, laterRecords, quoteAssetDenom)
+
+	return types.TwapConfidenceBand{
+		ArithmeticTwap: arithmeticTwap,
+		Volatility:     volatility,
+		MinSpotPrice:   minSpotPrice,
+		MaxSpotPrice:   maxSpotPrice,
+	}, spotPriceErr
+}
+
+// GetGeometricVolatilityToNow returns the volatility of the spot price of the base asset in units of
+// the quote asset from startTime until the current block time, as the time weighted standard deviation
+// of log_{2} of the spot price. Unlike GetTwapConfidenceBand, only the records at the start and the end
+// of the window are read, so the cost does not grow with the number of records within the window.
+//
+// The time semantics and errors are the same as GetArithmeticTwapToNow.
+// Additionally, errors if startTime is before the geometric variance accumulator was populated.
+func (k Keeper) GetGeometricVolatilityToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: ctx.BlockTime()}
+	}
+	if err := k.validateVarianceAccumulatorStartTime(ctx, startTime); err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	endRecord, err := k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeVolatility(startRecord, endRecord)
+}
+
+// validateVarianceAccumulatorStartTime returns an error if startTime is before the geometric variance
+// accumulator was populated, since records written before then have a zero accumulator that would
+// understate the volatility.
+//
+// N.B. if startTime is at or after the accumulator start time, the start record is interpolated
+// either from a record written after the accumulator start time or from the last record written
+// before it. The first record written after the accumulator start time accumulates on top of
+// the latter, so the accumulator difference is accurate in both cases.
+func (k Keeper) validateVarianceAccumulatorStartTime(ctx sdk.Context, startTime time.Time) error {
+	accumulatorStartTime, err := k.GetGeometricVarianceAccumulatorStartTime(ctx)
+	if err != nil {
+		return err
+	}
+	if startTime.Before(accumulatorStartTime) {
+		return types.StartTimeBeforeVarianceAccumulatorStartError{StartTime: startTime, AccumulatorStartTime: accumulatorStartTime}
+	}
+	return nil
+}
+
+// GetAggregatedArithmeticTwap returns the arithmetic TWAP of the base asset in units of the quote asset
+// from (startTime, endTime), combined across all pools that track the denom pair.
+// If poolIds is non-empty, only the given pools are combined.
+//
+// Each pool TWAP is weighted by the liquidity of the pair in the pool as of startTime, i.e. as stored
+// with the latest record at or before startTime, valued in units of the quote asset using the pool TWAP
+// as the price of the base asset. Liquidity added within the window, e.g. to skew the aggregate
+// towards a manipulated pool, does not affect the weights. This lets integrators keep a single oracle
+// for the pair when liquidity migrates between pools.
+//
+// Without poolIds, pools without records for the entire time range, e.g. created after startTime,
+// and pools without liquidity are skipped. The pools tracking the denom pair are read from an index,
+// so pools with other denom pairs add no cost.
+//
+// The time semantics are the same as GetArithmeticTwap.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * poolIds contains duplicates or ids of pools that do not exist
+// * a pool in poolIds does not contain the denom pair or has no records for the entire time range
+// * no pool with liquidity tracks the denom pair over the time range
+// * there was a spot price error in one of the pools within the time range, alongside the result.
+func (k Keeper) GetAggregatedArithmeticTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	poolIds []uint64,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	skipPoolsWithoutHistory := len(poolIds) == 0
+	if skipPoolsWithoutHistory {
+		var err error
+		poolIds, err = k.getPoolIdsTrackingDenomPair(ctx, baseAssetDenom, quoteAssetDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+	} else if err := k.validateAggregatedPoolIds(ctx, poolIds); err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	// N.B. BigDec is used since the product of a pool TWAP and its liquidity may exceed the bounds of Dec.
+	weightedTwapSum := osmomath.ZeroBigDec()
+	totalWeight := osmomath.ZeroBigDec()
+	var spotPriceErr error
+	for _, poolId := range poolIds {
+		twap, err := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetArithmeticStrategy())
+		if skipPoolsWithoutHistory && errors.As(err, &types.TimeTooOldError{}) {
+			continue
+		}
+		// A spot price error is returned together with the TWAP.
+		if err != nil && twap.IsNil() {
+			return osmomath.Dec{}, err
+		}
+
+		liquidity, liquidityErr := k.getPairLiquidityAtOrBeforeTime(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+		if skipPoolsWithoutHistory && errors.As(liquidityErr, &types.TimeTooOldError{}) {
+			continue
+		}
+		if liquidityErr != nil {
+			return osmomath.Dec{}, liquidityErr
+		}
+		baseLiquidity, quoteLiquidity := liquidity.Asset0Liquidity, liquidity.Asset1Liquidity
+		if baseAssetDenom > quoteAssetDenom {
+			baseLiquidity, quoteLiquidity = quoteLiquidity, baseLiquidity
+		}
+
+		// weight = base liquidity * twap + quote liquidity
+		weight := osmomath.BigDecFromSDKInt(baseLiquidity).MulMut(osmomath.BigDecFromDec(twap))
+		weight.AddMut(osmomath.BigDecFromSDKInt(quoteLiquidity))
+		if !weight.IsPositive() {
+			continue
+		}
+
+		if err != nil {
+			spotPriceErr = err
+		}
+		weightedTwapSum.AddMut(weight.Mul(osmomath.BigDecFromDec(twap)))
+		totalWeight.AddMut(weight)
+	}
+
+	if totalWeight.IsZero() {
+		return osmomath.Dec{}, types.NoPoolsForDenomPairError{BaseDenom: baseAssetDenom, QuoteDenom: quoteAssetDenom}
+	}
+
+	return weightedTwapSum.QuoMut(totalWeight).Dec(), spotPriceErr
+}
+
+// validateAggregatedPoolIds returns an error if the given pool ids contain duplicates
+// or ids outside of [1, next pool id).
+func (k Keeper) validateAggregatedPoolIds(ctx sdk.Context, poolIds []uint64) error {
+	nextPoolId := k.poolmanagerKeeper.GetNextPoolId(ctx)
+	seenPoolIds := make(map[uint64]struct{}, len(poolIds))
+	for _, poolId := range poolIds {
+		if poolId == 0 || poolId >= nextPoolId {
+			return types.PoolIdOutOfRangeError{PoolId: poolId, NextPoolId: nextPoolId}
+		}
+		if _, ok := seenPoolIds[poolId]; ok {
+			return types.DuplicatePoolIdError{PoolId: poolId}
+		}
+		seenPoolIds[poolId] = struct{}{}
+	}
+	return nil
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
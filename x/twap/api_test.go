@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	sdkrand "github.com/osmosis-labs/osmosis/v21/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v21/x/twap"
 	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
 )
@@ -112,7 +113,7 @@ func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
 		"idempotent overwrite2":                             {initStartRecord, recordWithUpdatedAccum(initStartRecord, OneSec, OneSec, osmomath.ZeroDec()), tPlusOne, 1, denomA, denomB, nil},
 		"diff spot price": {
 			zeroAccumTenPoint1Record,
-			recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			withGeometricVarianceAccum(recordWithUpdatedAccum(zeroAccumTenPoint1Record, OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), geometricVarianceTenSecAccum),
 			tPlusOne, 1, denomA, denomB, nil,
 		},
 	}
@@ -842,6 +843,372 @@ func (s *TestSuite) TestGetArithmeticTwapToNow_ThreeAsset() {
 	}
 }
 
+// TestGetTwapConfidenceBand tests that the volatility and the spot price range
+// returned by `GetTwapConfidenceBand` reflect the spot prices within the window.
+func (s *TestSuite) TestGetTwapConfidenceBand() {
+	volatilityTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: osmomath.NewDecWithPrec(1, 9),
+	}
+
+	// spot price 0 of 10 until baseTime + 10s, 5 until baseTime + 20s and 2 afterwards.
+	tPlus10Record := withSp1(withSp0(twap.RecordWithUpdatedAccumulators(baseRecord, baseTime.Add(10*time.Second)), osmomath.NewDec(5)), osmomath.NewDecWithPrec(2, 1))
+	tPlus20Record := withSp1(withSp0(twap.RecordWithUpdatedAccumulators(tPlus10Record, baseTime.Add(20*time.Second)), osmomath.NewDec(2)), osmomath.NewDecWithPrec(5, 1))
+	records := []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record}
+
+	tests := map[string]struct {
+		recordsToSet         []types.TwapRecord
+		accumulatorStartTime time.Time
+		input                getTwapInput
+		expected             types.TwapConfidenceBand
+		expectError          error
+	}{
+		"constant spot price": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expected: types.TwapConfidenceBand{
+				ArithmeticTwap: osmomath.NewDec(10),
+				Volatility:     osmomath.ZeroDec(),
+				MinSpotPrice:   osmomath.NewDec(10),
+				MaxSpotPrice:   osmomath.NewDec(10),
+			},
+		},
+		"spot price change within window, record at end time is excluded from range": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			expected: types.TwapConfidenceBand{
+				ArithmeticTwap: osmomath.NewDecWithPrec(75, 1),
+				// log_2{10} - log_2{5} = 1, so the standard deviation is 1 / 2.
+				Volatility:   osmomath.NewDecWithPrec(5, 1),
+				MinSpotPrice: osmomath.NewDec(5),
+				MaxSpotPrice: osmomath.NewDec(10),
+			},
+		},
+		"spot price change within window, use sp1": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			expected: types.TwapConfidenceBand{
+				ArithmeticTwap: osmomath.NewDecWithPrec(15, 2),
+				Volatility:     osmomath.NewDecWithPrec(5, 1),
+				MinSpotPrice:   osmomath.NewDecWithPrec(1, 1),
+				MaxSpotPrice:   osmomath.NewDecWithPrec(2, 1),
+			},
+		},
+		"start time interpolated, end time = now": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), tPlusOneMin, baseQuoteBA),
+			expected: types.TwapConfidenceBand{
+				// 10 for 5s, 5 for 10s, 2 for 40s = 180 / 55
+				ArithmeticTwap: osmomath.MustNewDecFromStr("3.272727272727272727"),
+				Volatility:     osmomath.MustNewDecFromStr("0.777210007206102700"),
+				MinSpotPrice:   osmomath.NewDec(2),
+				MaxSpotPrice:   osmomath.NewDec(10),
+			},
+		},
+		"start time after end time": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime.Add(20*time.Second), baseTime.Add(10*time.Second), baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(20 * time.Second), EndTime: baseTime.Add(10 * time.Second)},
+		},
+		"end time in the future": {
+			recordsToSet: records,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin.Add(time.Second), baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin.Add(time.Second), BlockTime: tPlusOneMin},
+		},
+		"spot price error within window": {
+			recordsToSet: []types.TwapRecord{withLastErrTime(baseRecord, baseTime)},
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expected: types.TwapConfidenceBand{
+				ArithmeticTwap: osmomath.NewDec(10),
+				Volatility:     osmomath.ZeroDec(),
+				MinSpotPrice:   osmomath.NewDec(10),
+				MaxSpotPrice:   osmomath.NewDec(10),
+			},
+			expectError: errSpotPrice,
+		},
+		"start time at accumulator start time": {
+			recordsToSet:         records,
+			accumulatorStartTime: baseTime.Add(10 * time.Second),
+			input:                makeSimpleTwapInput(baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expected: types.TwapConfidenceBand{
+				ArithmeticTwap: osmomath.NewDec(5),
+				Volatility:     osmomath.ZeroDec(),
+				MinSpotPrice:   osmomath.NewDec(5),
+				MaxSpotPrice:   osmomath.NewDec(5),
+			},
+		},
+		"start time before accumulator start time": {
+			recordsToSet:         records,
+			accumulatorStartTime: baseTime.Add(10 * time.Second),
+			input:                makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expectError:          types.StartTimeBeforeVarianceAccumulatorStartError{StartTime: baseTime.Add(5 * time.Second), AccumulatorStartTime: baseTime.Add(10 * time.Second)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			if !test.accumulatorStartTime.IsZero() {
+				s.twapkeeper.SetGeometricVarianceAccumulatorStartTime(s.Ctx, test.accumulatorStartTime)
+			}
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			confidenceBand, err := s.twapkeeper.GetTwapConfidenceBand(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				if test.expected.ArithmeticTwap.IsNil() {
+					return
+				}
+			} else {
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal(test.expected.ArithmeticTwap, confidenceBand.ArithmeticTwap)
+			s.Require().Equal(0, volatilityTolerance.CompareDec(test.expected.Volatility, confidenceBand.Volatility), "expected %s, actual %s", test.expected.Volatility, confidenceBand.Volatility)
+			s.Require().Equal(test.expected.MinSpotPrice, confidenceBand.MinSpotPrice)
+			s.Require().Equal(test.expected.MaxSpotPrice, confidenceBand.MaxSpotPrice)
+		})
+	}
+}
+
+// TestGetGeometricVolatilityToNow tests that the volatility from the start time until now
+// matches the volatility of the confidence band over the same window.
+func (s *TestSuite) TestGetGeometricVolatilityToNow() {
+	volatilityTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: osmomath.NewDecWithPrec(1, 9),
+	}
+
+	// spot price 0 of 10 until baseTime + 10s, 5 until baseTime + 20s and 2 afterwards.
+	tPlus10Record := withSp1(withSp0(twap.RecordWithUpdatedAccumulators(baseRecord, baseTime.Add(10*time.Second)), osmomath.NewDec(5)), osmomath.NewDecWithPrec(2, 1))
+	tPlus20Record := withSp1(withSp0(twap.RecordWithUpdatedAccumulators(tPlus10Record, baseTime.Add(20*time.Second)), osmomath.NewDec(2)), osmomath.NewDecWithPrec(5, 1))
+	records := []types.TwapRecord{baseRecord, tPlus10Record, tPlus20Record}
+
+	tests := map[string]struct {
+		accumulatorStartTime time.Time
+		startTime            time.Time
+		expected             osmomath.Dec
+		expectError          error
+	}{
+		"start time interpolated": {
+			startTime: baseTime.Add(5 * time.Second),
+			expected:  osmomath.MustNewDecFromStr("0.777210007206102700"),
+		},
+		"start time after the last price change": {
+			startTime: baseTime.Add(30 * time.Second),
+			expected:  osmomath.ZeroDec(),
+		},
+		"start time now": {
+			startTime: tPlusOneMin,
+			expected:  osmomath.ZeroDec(),
+		},
+		"start time in the future": {
+			startTime:   tPlusOneMin.Add(time.Second),
+			expectError: types.StartTimeAfterEndTimeError{StartTime: tPlusOneMin.Add(time.Second), EndTime: tPlusOneMin},
+		},
+		"start time before accumulator start time": {
+			accumulatorStartTime: baseTime.Add(10 * time.Second),
+			startTime:            baseTime.Add(5 * time.Second),
+			expectError:          types.StartTimeBeforeVarianceAccumulatorStartError{StartTime: baseTime.Add(5 * time.Second), AccumulatorStartTime: baseTime.Add(10 * time.Second)},
+		},
+		"start time before the first record": {
+			startTime:   baseTime.Add(-time.Second),
+			expectError: twap.TimeTooOldError{Time: baseTime.Add(-time.Second)},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(records)
+			if !test.accumulatorStartTime.IsZero() {
+				s.twapkeeper.SetGeometricVarianceAccumulatorStartTime(s.Ctx, test.accumulatorStartTime)
+			}
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			volatility, err := s.twapkeeper.GetGeometricVolatilityToNow(s.Ctx, baseRecord.PoolId, denom0, denom1, test.startTime)
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(0, volatilityTolerance.CompareDec(test.expected, volatility), "expected %s, actual %s", test.expected, volatility)
+		})
+	}
+}
+
+// TestGetAggregatedArithmeticTwap tests that the TWAPs of all pools tracking a denom pair
+// are weighted by the liquidity of the pair in each pool.
+func (s *TestSuite) TestGetAggregatedArithmeticTwap() {
+	tPlusOneHour := baseTime.Add(time.Hour)
+
+	tests := map[string]struct {
+		baseAssetDenom           string
+		quoteAssetDenom          string
+		poolIds                  []uint64
+		startTime                time.Time
+		endTime                  time.Time
+		addLiquidityWithinWindow bool
+		expected                 osmomath.Dec
+		expectError              error
+	}{
+		"all pools tracking the pair, pool created after start time is skipped": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			// pool 1: twap 2, weight 1000 * 2 + 2000 = 4000
+			// pool 2: twap 4, weight 1000 * 4 + 4000 = 8000
+			// (4000 * 2 + 8000 * 4) / 12000 = 10 / 3
+			expected: ThreePlusOneThird,
+		},
+		"all pools tracking the pair, quote and base swapped": {
+			baseAssetDenom:  denom1,
+			quoteAssetDenom: denom0,
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			// pool 1: twap 0.5, weight 2000 * 0.5 + 1000 = 2000
+			// pool 2: twap 0.25, weight 4000 * 0.25 + 1000 = 2000
+			expected: osmomath.NewDecWithPrec(375, 3),
+		},
+		"end time before now": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			startTime:       baseTime,
+			endTime:         baseTime.Add(time.Minute),
+			expected:        ThreePlusOneThird,
+		},
+		"explicit pool ids": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{1},
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expected:        osmomath.NewDec(2),
+		},
+		"explicit pool ids, start time after pool creation": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{1, 3},
+			startTime:       baseTime.Add(time.Minute),
+			endTime:         tPlusOneHour,
+			// pool 3: twap 100, weight 1000 * 100 + 100000 = 200000
+			// (4000 * 2 + 200000 * 100) / 204000
+			expected: osmomath.MustNewDecFromStr("98.078431372549019607"),
+		},
+		"liquidity added within the window does not change the weights": {
+			baseAssetDenom:           denom0,
+			quoteAssetDenom:          denom1,
+			startTime:                baseTime,
+			endTime:                  tPlusOneHour,
+			addLiquidityWithinWindow: true,
+			expected:                 ThreePlusOneThird,
+		},
+		"liquidity added before the window is used for the weights": {
+			baseAssetDenom:           denom0,
+			quoteAssetDenom:          denom1,
+			poolIds:                  []uint64{1, 2},
+			startTime:                baseTime.Add(time.Hour / 2),
+			endTime:                  tPlusOneHour,
+			addLiquidityWithinWindow: true,
+			// pool 2: weight doubles to 16000
+			// (4000 * 2 + 16000 * 4) / 20000
+			expected: osmomath.MustNewDecFromStr("3.6"),
+		},
+		"explicit pool ids, pool created after start time": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{1, 3},
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expectError:     twap.TimeTooOldError{Time: baseTime},
+		},
+		"explicit pool ids, duplicate pool id": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{1, 2, 1},
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expectError:     types.DuplicatePoolIdError{PoolId: 1},
+		},
+		"explicit pool ids, pool does not exist": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{1, 5},
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expectError:     types.PoolIdOutOfRangeError{PoolId: 5, NextPoolId: 5},
+		},
+		"explicit pool ids, pool id zero": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			poolIds:         []uint64{0},
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expectError:     types.PoolIdOutOfRangeError{PoolId: 0, NextPoolId: 5},
+		},
+		"only pool tracking the pair was created after start time": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom2,
+			startTime:       baseTime,
+			endTime:         tPlusOneHour,
+			expectError:     types.NoPoolsForDenomPairError{BaseDenom: denom0, QuoteDenom: denom2},
+		},
+		"start time after end time": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			startTime:       tPlusOneHour,
+			endTime:         baseTime,
+			expectError:     types.StartTimeAfterEndTimeError{StartTime: tPlusOneHour, EndTime: baseTime},
+		},
+		"end time in the future": {
+			baseAssetDenom:  denom0,
+			quoteAssetDenom: denom1,
+			startTime:       baseTime,
+			endTime:         tPlusOneHour.Add(time.Second),
+			expectError:     types.EndTimeInFutureError{EndTime: tPlusOneHour.Add(time.Second), BlockTime: tPlusOneHour},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 2000))
+			s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 4000))
+
+			s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Minute))
+			s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 100000))
+			s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom2, 1000))
+
+			if test.addLiquidityWithinWindow {
+				// Doubles the liquidity of pool 2 without changing its spot price.
+				s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Hour / 2)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+				tokensIn := sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 4000))
+				s.FundAcc(s.TestAccs[0], tokensIn)
+				_, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, s.TestAccs[0], 2, gammtypes.InitPoolSharesSupply, tokensIn)
+				s.Require().NoError(err)
+				s.twapkeeper.EndBlock(s.Ctx)
+			}
+
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneHour)
+
+			twap, err := s.twapkeeper.GetAggregatedArithmeticTwap(s.Ctx, test.baseAssetDenom, test.quoteAssetDenom,
+				test.poolIds, test.startTime, test.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expected, twap)
+		})
+	}
+}
+
 // TestGeometricTwapToNow_BalancerPool_Randomized the goal of this test case is to validate
 // that no internal panics occur when computing geometric twap. It also sanity checks
 // that geometric twap is roughly close to spot price.
@@ -10,12 +10,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	poolmanager "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v21/x/twap/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
 )
 
+// FlagPoolIds is the flag to limit the pools that an aggregated twap is computed over.
+const FlagPoolIds = "pool-ids"
+
 // twapQueryParseArgs represents the outcome
 // of parsing the arguments for twap query command.
 type twapQueryArgs struct {
@@ -30,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryConfidenceBandCommand())
+	cmd.AddCommand(GetQueryAggregatedArithmeticCommand())
 
 	return cmd
 }
@@ -134,6 +140,118 @@ Example:
 	return cmd
 }
 
+// GetQueryConfidenceBandCommand returns a twap confidence band query command.
+func GetQueryConfidenceBandCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confidence-band [poolid] [base denom] [start time] [end time]",
+		Short: "Query arithmetic twap with its volatility and spot price range",
+		Long: osmocli.FormatLongDescDirect(`Query arithmetic twap for pool together with the volatility of the spot price and the min and max spot price within the window. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} confidence-band 1 uosmo 1667088000 24h
+{{.CommandPrefix}} confidence-band 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.TwapConfidenceBand(cmd.Context(), &queryproto.TwapConfidenceBandRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryAggregatedArithmeticCommand returns an aggregated arithmetic twap query command.
+func GetQueryAggregatedArithmeticCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregated [base denom] [quote denom] [start time] [end time]",
+		Short: "Query arithmetic twap of a denom pair aggregated across pools",
+		Long: osmocli.FormatLongDescDirect(`Query arithmetic twap of a denom pair, weighted by the liquidity of every pool tracking the pair. Start time must be unix time. End time can be unix time or duration.
+Optionally, the pools to aggregate over can be limited with the --pool-ids flag.
+
+Example:
+{{.CommandPrefix}} aggregated uatom uosmo 1667088000 24h
+{{.CommandPrefix}} aggregated uatom uosmo 1667088000 1667174400 --pool-ids=1,1135
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom := strings.TrimSpace(args[0])
+			quoteDenom := strings.TrimSpace(args[1])
+			startTime, err := osmocli.ParseUnixTime(args[2], "start time")
+			if err != nil {
+				return err
+			}
+			endTime, err := parseEndTime(args[3], startTime)
+			if err != nil {
+				return err
+			}
+
+			poolIdsStr, err := cmd.Flags().GetString(FlagPoolIds)
+			if err != nil {
+				return err
+			}
+			var poolIds []uint64
+			if poolIdsStr != "" {
+				poolIds, err = osmoutils.ParseUint64SliceFromString(poolIdsStr, ",")
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.AggregatedArithmeticTwap(cmd.Context(), &queryproto.AggregatedArithmeticTwapRequest{
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+				PoolIds:    poolIds,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPoolIds, "", "Comma separated ids of the pools to aggregate over. Defaults to all pools tracking the denom pair")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -175,23 +293,31 @@ func twapQueryParseArgs(args []string) (twapQueryArgs, error) {
 		return twapQueryArgs{}, err
 	}
 
+	endTime, err := parseEndTime(args[3], startTime)
+	if err != nil {
+		return twapQueryArgs{}, err
+	}
+	return twapQueryArgs{
+		PoolId:    poolId,
+		BaseDenom: baseDenom,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// parseEndTime parses the end time argument as either unix time or a duration after the start time.
+func parseEndTime(arg string, startTime time.Time) (time.Time, error) {
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err := osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err := osmocli.ParseUnixTime(arg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(arg)
 		if err2 != nil {
-			err = err2
-			return twapQueryArgs{}, err
+			return time.Time{}, err2
 		}
 		endTime = startTime.Add(duration)
 	}
-	return twapQueryArgs{
-		PoolId:    poolId,
-		BaseDenom: baseDenom,
-		StartTime: startTime,
-		EndTime:   endTime,
-	}, nil
+	return endTime, nil
 }
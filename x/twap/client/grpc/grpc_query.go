@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TwapConfidenceBand(grpcCtx context.Context,
+	req *queryproto.TwapConfidenceBandRequest,
+) (*queryproto.TwapConfidenceBandResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TwapConfidenceBand(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -61,6 +71,16 @@ func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	return q.Q.ArithmeticTwapToNow(ctx, *req)
 }
 
+func (q Querier) AggregatedArithmeticTwap(grpcCtx context.Context,
+	req *queryproto.AggregatedArithmeticTwapRequest,
+) (*queryproto.AggregatedArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AggregatedArithmeticTwap(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwap(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapRequest,
 ) (*queryproto.ArithmeticTwapResponse, error) {
@@ -61,6 +61,36 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) TwapConfidenceBand(ctx sdk.Context,
+	req queryproto.TwapConfidenceBandRequest,
+) (*queryproto.TwapConfidenceBandResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	confidenceBand, err := q.K.GetTwapConfidenceBand(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.TwapConfidenceBandResponse{ConfidenceBand: confidenceBand}, err
+}
+
+func (q Querier) AggregatedArithmeticTwap(ctx sdk.Context,
+	req queryproto.AggregatedArithmeticTwapRequest,
+) (*queryproto.AggregatedArithmeticTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetAggregatedArithmeticTwap(ctx, req.BaseAsset, req.QuoteAsset, req.PoolIds, req.StartTime, *req.EndTime)
+
+	return &queryproto.AggregatedArithmeticTwapResponse{ArithmeticTwap: twap}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
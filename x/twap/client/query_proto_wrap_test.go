@@ -232,5 +232,58 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 				suite.Require().Equal(result.GeometricTwap.String(), resultToNow.GeometricTwap.String())
 			}
 		})
+
+		suite.Run(tc.name+" confidence band", func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			startTime := validStartTime
+			if tc.startTimeOverwrite != nil {
+				startTime = *tc.startTimeOverwrite
+			}
+
+			result, err := client.TwapConfidenceBand(ctx, queryproto.TwapConfidenceBandRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+				EndTime:    tc.endTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - TwapConfidenceBand")
+			} else {
+				suite.Require().NoError(err, "unexpected error - TwapConfidenceBand")
+				// The spot price is constant over the window.
+				suite.Require().Equal(tc.result, result.ConfidenceBand.ArithmeticTwap.String())
+				suite.Require().Equal(osmomath.ZeroDec().String(), result.ConfidenceBand.Volatility.String())
+				suite.Require().Equal(tc.result, result.ConfidenceBand.MinSpotPrice.String())
+				suite.Require().Equal(tc.result, result.ConfidenceBand.MaxSpotPrice.String())
+			}
+		})
+
+		suite.Run(tc.name+" aggregated", func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			startTime := validStartTime
+			if tc.startTimeOverwrite != nil {
+				startTime = *tc.startTimeOverwrite
+			}
+
+			result, err := client.AggregatedArithmeticTwap(ctx, queryproto.AggregatedArithmeticTwapRequest{
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+				EndTime:    tc.endTime,
+				PoolIds:    []uint64{tc.poolId},
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - AggregatedArithmeticTwap")
+			} else {
+				suite.Require().NoError(err, "unexpected error - AggregatedArithmeticTwap")
+				// A single pool is aggregated.
+				suite.Require().Equal(tc.result, result.ArithmeticTwap.String())
+			}
+		})
 	}
 }
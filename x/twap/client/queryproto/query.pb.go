@@ -475,6 +475,241 @@ func (m *GeometricTwapToNowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeometricTwapToNowResponse proto.InternalMessageInfo
 
+type TwapConfidenceBandRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *TwapConfidenceBandRequest) Reset()         { *m = TwapConfidenceBandRequest{} }
+func (m *TwapConfidenceBandRequest) String() string { return proto.CompactTextString(m) }
+func (*TwapConfidenceBandRequest) ProtoMessage()    {}
+func (*TwapConfidenceBandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{8}
+}
+func (m *TwapConfidenceBandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapConfidenceBandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapConfidenceBandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapConfidenceBandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapConfidenceBandRequest.Merge(m, src)
+}
+func (m *TwapConfidenceBandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapConfidenceBandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapConfidenceBandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapConfidenceBandRequest proto.InternalMessageInfo
+
+func (m *TwapConfidenceBandRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapConfidenceBandRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *TwapConfidenceBandRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *TwapConfidenceBandRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapConfidenceBandRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type TwapConfidenceBandResponse struct {
+	ConfidenceBand types.TwapConfidenceBand `protobuf:"bytes,1,opt,name=confidence_band,json=confidenceBand,proto3" json:"confidence_band" yaml:"confidence_band"`
+}
+
+func (m *TwapConfidenceBandResponse) Reset()         { *m = TwapConfidenceBandResponse{} }
+func (m *TwapConfidenceBandResponse) String() string { return proto.CompactTextString(m) }
+func (*TwapConfidenceBandResponse) ProtoMessage()    {}
+func (*TwapConfidenceBandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{9}
+}
+func (m *TwapConfidenceBandResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapConfidenceBandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapConfidenceBandResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapConfidenceBandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapConfidenceBandResponse.Merge(m, src)
+}
+func (m *TwapConfidenceBandResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapConfidenceBandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapConfidenceBandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapConfidenceBandResponse proto.InternalMessageInfo
+
+func (m *TwapConfidenceBandResponse) GetConfidenceBand() types.TwapConfidenceBand {
+	if m != nil {
+		return m.ConfidenceBand
+	}
+	return types.TwapConfidenceBand{}
+}
+
+type AggregatedArithmeticTwapRequest struct {
+	BaseAsset  string     `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// Pools to aggregate over. If empty, all pools tracking the denom pair are
+	// used.
+	PoolIds []uint64 `protobuf:"varint,5,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *AggregatedArithmeticTwapRequest) Reset()         { *m = AggregatedArithmeticTwapRequest{} }
+func (m *AggregatedArithmeticTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregatedArithmeticTwapRequest) ProtoMessage()    {}
+func (*AggregatedArithmeticTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{10}
+}
+func (m *AggregatedArithmeticTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedArithmeticTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedArithmeticTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedArithmeticTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedArithmeticTwapRequest.Merge(m, src)
+}
+func (m *AggregatedArithmeticTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedArithmeticTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedArithmeticTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedArithmeticTwapRequest proto.InternalMessageInfo
+
+func (m *AggregatedArithmeticTwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *AggregatedArithmeticTwapRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *AggregatedArithmeticTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AggregatedArithmeticTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *AggregatedArithmeticTwapRequest) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+type AggregatedArithmeticTwapResponse struct {
+	ArithmeticTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
+}
+
+func (m *AggregatedArithmeticTwapResponse) Reset()         { *m = AggregatedArithmeticTwapResponse{} }
+func (m *AggregatedArithmeticTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregatedArithmeticTwapResponse) ProtoMessage()    {}
+func (*AggregatedArithmeticTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{11}
+}
+func (m *AggregatedArithmeticTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedArithmeticTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedArithmeticTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedArithmeticTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedArithmeticTwapResponse.Merge(m, src)
+}
+func (m *AggregatedArithmeticTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedArithmeticTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedArithmeticTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedArithmeticTwapResponse proto.InternalMessageInfo
+
 type ParamsRequest struct {
 }
 
@@ -482,7 +717,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141a22dba58615af, []int{8}
+	return fileDescriptor_141a22dba58615af, []int{12}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +754,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141a22dba58615af, []int{9}
+	return fileDescriptor_141a22dba58615af, []int{13}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,6 +799,10 @@ func init() {
 	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
 	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
 	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
+	proto.RegisterType((*TwapConfidenceBandRequest)(nil), "osmosis.twap.v1beta1.TwapConfidenceBandRequest")
+	proto.RegisterType((*TwapConfidenceBandResponse)(nil), "osmosis.twap.v1beta1.TwapConfidenceBandResponse")
+	proto.RegisterType((*AggregatedArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.AggregatedArithmeticTwapRequest")
+	proto.RegisterType((*AggregatedArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.AggregatedArithmeticTwapResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
 }
@@ -571,57 +810,69 @@ func init() {
 func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
 
 var fileDescriptor_141a22dba58615af = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x33, 0x29, 0x84, 0x66, 0x10, 0x41, 0x9d, 0x02, 0x05, 0x03, 0x76, 0x64, 0x28, 0x4a,
-	0x09, 0xb5, 0x49, 0x7a, 0x43, 0xf4, 0x40, 0x54, 0xa9, 0xaa, 0x84, 0xaa, 0xd6, 0x42, 0x55, 0xd5,
-	0x4b, 0x34, 0x71, 0x06, 0x63, 0x35, 0xf6, 0x18, 0x7b, 0x02, 0x8d, 0xd4, 0x43, 0x5b, 0xa9, 0x77,
-	0xd4, 0xaa, 0x87, 0x1e, 0xda, 0x7b, 0x0f, 0xfd, 0x1e, 0x9c, 0x5a, 0xa4, 0xbd, 0xac, 0xf6, 0x90,
-	0x5d, 0xc1, 0x7e, 0x02, 0x3e, 0xc1, 0xca, 0x33, 0xe3, 0x6c, 0x9c, 0xb5, 0x58, 0xef, 0x05, 0x09,
-	0x69, 0x4f, 0x30, 0xf3, 0xfe, 0xef, 0xfd, 0x7f, 0xf3, 0x66, 0x3c, 0x13, 0x58, 0xa5, 0x91, 0x47,
-	0x23, 0x37, 0x32, 0xd9, 0x39, 0x0e, 0xcc, 0xb3, 0x46, 0x87, 0x30, 0xdc, 0x30, 0x4f, 0xfb, 0x24,
-	0x1c, 0x18, 0x41, 0x48, 0x19, 0x45, 0x0b, 0x52, 0x61, 0xc4, 0x0a, 0x43, 0x2a, 0x94, 0x05, 0x87,
-	0x3a, 0x94, 0x0b, 0xcc, 0xf8, 0x3f, 0xa1, 0x55, 0xb6, 0x32, 0xab, 0xc5, 0x83, 0x76, 0x48, 0x6c,
-	0x1a, 0x76, 0xa5, 0x4e, 0xcf, 0xd4, 0x39, 0xc4, 0x27, 0xb1, 0x91, 0xd0, 0xa8, 0x36, 0x17, 0x99,
-	0x1d, 0x1c, 0x91, 0x91, 0xc4, 0xa6, 0xae, 0x2f, 0xe3, 0xdb, 0xe3, 0x71, 0x0e, 0x3c, 0x52, 0x05,
-	0xd8, 0x71, 0x7d, 0xcc, 0x5c, 0x9a, 0x68, 0xd7, 0x1c, 0x4a, 0x9d, 0x1e, 0x31, 0x71, 0xe0, 0x9a,
-	0xd8, 0xf7, 0x29, 0xe3, 0xc1, 0xc4, 0x69, 0x45, 0x46, 0xf9, 0xa8, 0xd3, 0x3f, 0x36, 0xb1, 0x3f,
-	0x48, 0x42, 0xc2, 0xa4, 0x2d, 0x56, 0x2a, 0x06, 0x32, 0xa4, 0x4d, 0x66, 0x31, 0xd7, 0x23, 0x11,
-	0xc3, 0x5e, 0x20, 0x04, 0xfa, 0xdf, 0x45, 0xb8, 0x78, 0x10, 0xba, 0xec, 0xc4, 0x23, 0xcc, 0xb5,
-	0x8f, 0xce, 0x71, 0x60, 0x91, 0xd3, 0x3e, 0x89, 0x18, 0xfa, 0x00, 0xce, 0x04, 0x94, 0xf6, 0xda,
-	0x6e, 0x77, 0x19, 0x54, 0x41, 0x6d, 0xca, 0x2a, 0xc5, 0xc3, 0x2f, 0xba, 0x68, 0x1d, 0xc2, 0x78,
-	0x39, 0x6d, 0x1c, 0x45, 0x84, 0x2d, 0x17, 0xab, 0xa0, 0x56, 0xb6, 0xca, 0xf1, 0xcc, 0x41, 0x3c,
-	0x81, 0x34, 0x38, 0x7b, 0xda, 0xa7, 0x2c, 0x89, 0xbf, 0xc3, 0xe3, 0x90, 0x4f, 0x09, 0xc1, 0xb7,
-	0x10, 0x46, 0x0c, 0x87, 0xac, 0x1d, 0xb3, 0x2c, 0x4f, 0x55, 0x41, 0x6d, 0xb6, 0xa9, 0x18, 0x02,
-	0xd4, 0x48, 0x40, 0x8d, 0xa3, 0x04, 0xb4, 0xb5, 0x7e, 0x39, 0xd4, 0x0a, 0xb7, 0x43, 0xed, 0xbd,
-	0x01, 0xf6, 0x7a, 0x7b, 0xfa, 0xcb, 0x5c, 0xfd, 0xe2, 0xa9, 0x06, 0xac, 0x32, 0x9f, 0x88, 0xe5,
-	0xc8, 0x82, 0xef, 0x12, 0xbf, 0x2b, 0xea, 0x4e, 0xbf, 0xb6, 0xee, 0xea, 0xe5, 0x50, 0x03, 0xb7,
-	0x43, 0x6d, 0x5e, 0xd4, 0x4d, 0x32, 0x45, 0xd5, 0x19, 0xe2, 0x77, 0x63, 0xa9, 0xfe, 0x13, 0x80,
-	0x4b, 0x93, 0x0d, 0x8a, 0x02, 0xea, 0x47, 0x04, 0x1d, 0xc3, 0x79, 0x3c, 0x8a, 0xb4, 0xe3, 0x53,
-	0xc2, 0x3b, 0x55, 0x6e, 0x7d, 0x1a, 0x13, 0x3f, 0x19, 0x6a, 0xab, 0x62, 0x2f, 0xa2, 0xee, 0xf7,
-	0x86, 0x4b, 0x4d, 0x0f, 0xb3, 0x13, 0xe3, 0x90, 0x38, 0xd8, 0x1e, 0x7c, 0x46, 0xec, 0xdb, 0xa1,
-	0xb6, 0x24, 0x8c, 0x27, 0x6a, 0xe8, 0x56, 0x05, 0xa7, 0xfc, 0xf4, 0xff, 0x01, 0x54, 0xd2, 0x08,
-	0x47, 0xf4, 0x4b, 0x7a, 0xfe, 0x70, 0x37, 0x4a, 0xff, 0x15, 0xc0, 0xd5, 0xcc, 0x15, 0xdd, 0x73,
-	0x67, 0xff, 0x2a, 0xc2, 0x85, 0xcf, 0x09, 0xf5, 0x08, 0x0b, 0xdf, 0x1e, 0xfe, 0x8c, 0xc3, 0xff,
-	0x23, 0x5c, 0x9c, 0x68, 0x8f, 0xdc, 0x20, 0x1b, 0x56, 0x9c, 0x24, 0x30, 0xbe, 0x3f, 0xfb, 0xf9,
-	0xf6, 0x67, 0x51, 0xb8, 0xa6, 0x4b, 0xe8, 0xd6, 0x9c, 0x33, 0x6e, 0xa6, 0xff, 0x07, 0xe0, 0x4a,
-	0xca, 0xfe, 0xa1, 0x1f, 0xfb, 0x9f, 0x01, 0x54, 0xb2, 0x16, 0x74, 0x9f, 0x4d, 0x9d, 0x87, 0x73,
-	0x5f, 0xe1, 0x10, 0x7b, 0x91, 0xec, 0xa3, 0x7e, 0x08, 0x2b, 0xc9, 0x84, 0xe4, 0xd8, 0x83, 0xa5,
-	0x80, 0xcf, 0x70, 0xff, 0xd9, 0xe6, 0x9a, 0x91, 0xf5, 0xba, 0x1a, 0x22, 0xab, 0x35, 0x15, 0xd3,
-	0x59, 0x32, 0xa3, 0xf9, 0x5b, 0x09, 0x4e, 0x7f, 0x1d, 0xbf, 0x73, 0x68, 0x00, 0x4b, 0x42, 0x81,
-	0x36, 0xee, 0xca, 0x97, 0x18, 0xca, 0xe6, 0xdd, 0x22, 0x81, 0xa6, 0x6f, 0xfe, 0xf2, 0xe8, 0xf9,
-	0xef, 0x45, 0x15, 0xad, 0x99, 0x99, 0x8f, 0xb3, 0x34, 0xfc, 0x13, 0xc0, 0x4a, 0xfa, 0x7a, 0x41,
-	0xf5, 0xec, 0xf2, 0x99, 0x4f, 0x9f, 0xb2, 0x93, 0x4f, 0x2c, 0x99, 0x76, 0x38, 0xd3, 0x16, 0xda,
-	0xcc, 0x66, 0x9a, 0x00, 0xf9, 0x17, 0xc0, 0xf7, 0x33, 0xae, 0x3e, 0xb4, 0x9b, 0xc7, 0x73, 0xfc,
-	0x03, 0x50, 0x1a, 0x6f, 0x90, 0x21, 0x51, 0x1b, 0x1c, 0xb5, 0x8e, 0x3e, 0xca, 0x83, 0x2a, 0xb8,
-	0xfe, 0x00, 0x70, 0x2e, 0x75, 0x66, 0xd1, 0x76, 0xb6, 0x6f, 0xd6, 0x3d, 0xaa, 0xd4, 0x73, 0x69,
-	0x25, 0x5d, 0x9d, 0xd3, 0x7d, 0x88, 0x36, 0xb2, 0xe9, 0xd2, 0x14, 0xff, 0x00, 0x88, 0x5e, 0xfd,
-	0x96, 0x90, 0x99, 0xc3, 0x30, 0xd5, 0xc5, 0xdd, 0xfc, 0x09, 0x12, 0x73, 0x97, 0x63, 0x6e, 0xa3,
-	0x5a, 0x0e, 0x4c, 0x9e, 0xd9, 0xfa, 0xe6, 0xf2, 0x5a, 0x05, 0x57, 0xd7, 0x2a, 0x78, 0x76, 0xad,
-	0x82, 0x8b, 0x1b, 0xb5, 0x70, 0x75, 0xa3, 0x16, 0x1e, 0xdf, 0xa8, 0x85, 0xef, 0xf6, 0x1d, 0x97,
-	0x9d, 0xf4, 0x3b, 0x86, 0x4d, 0xbd, 0xa4, 0xda, 0xc7, 0x3d, 0xdc, 0x89, 0x46, 0xa5, 0xcf, 0x9a,
-	0x0d, 0xf3, 0x07, 0x61, 0x60, 0xf7, 0x5c, 0xe2, 0x33, 0xf1, 0x2b, 0x52, 0x5c, 0x43, 0x25, 0xfe,
-	0xe7, 0x93, 0x17, 0x01, 0x00, 0x00, 0xff, 0xff, 0x05, 0xf5, 0xca, 0xdf, 0x20, 0x0b, 0x00, 0x00,
+	// 980 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x3b, 0x69, 0xda, 0x6e, 0xa6, 0x6a, 0x2a, 0x66, 0xdb, 0x25, 0x75, 0xbb, 0x76, 0xe4,
+	0x2d, 0xab, 0xd0, 0x2e, 0x76, 0x52, 0xc4, 0x1e, 0x56, 0xcb, 0xa1, 0x01, 0x09, 0x21, 0xad, 0x10,
+	0x58, 0x15, 0x42, 0x5c, 0xa2, 0x89, 0x3d, 0x75, 0x2d, 0x62, 0x8f, 0x6b, 0x4f, 0xb6, 0x44, 0xe2,
+	0x00, 0x2b, 0x71, 0xe1, 0xc2, 0x4a, 0x88, 0x03, 0x07, 0x38, 0x70, 0xe3, 0xc0, 0x67, 0x40, 0xdc,
+	0x7a, 0x82, 0x95, 0xb8, 0x20, 0x0e, 0x01, 0xb5, 0x7c, 0x82, 0x7e, 0x02, 0x64, 0xcf, 0x38, 0x5b,
+	0xa7, 0x93, 0xd6, 0x2b, 0xb1, 0x95, 0x56, 0xea, 0xa9, 0x99, 0x79, 0xff, 0xf7, 0xde, 0x6f, 0xde,
+	0x7b, 0xf2, 0x4c, 0x61, 0x9d, 0xc6, 0x3e, 0x8d, 0xbd, 0xd8, 0x64, 0x07, 0x38, 0x34, 0x1f, 0xb6,
+	0xba, 0x84, 0xe1, 0x96, 0xb9, 0xdf, 0x27, 0xd1, 0xc0, 0x08, 0x23, 0xca, 0x28, 0x5a, 0x12, 0x0a,
+	0x23, 0x51, 0x18, 0x42, 0xa1, 0x2c, 0xb9, 0xd4, 0xa5, 0xa9, 0xc0, 0x4c, 0x7e, 0x71, 0xad, 0x72,
+	0x5b, 0x1a, 0x2d, 0x59, 0x74, 0x22, 0x62, 0xd3, 0xc8, 0x11, 0x3a, 0x5d, 0xaa, 0x73, 0x49, 0x40,
+	0x92, 0x44, 0x5c, 0xa3, 0xda, 0xa9, 0xc8, 0xec, 0xe2, 0x98, 0x8c, 0x24, 0x36, 0xf5, 0x02, 0x61,
+	0xdf, 0x38, 0x6d, 0x4f, 0x81, 0x47, 0xaa, 0x10, 0xbb, 0x5e, 0x80, 0x99, 0x47, 0x33, 0xed, 0x9a,
+	0x4b, 0xa9, 0xdb, 0x23, 0x26, 0x0e, 0x3d, 0x13, 0x07, 0x01, 0x65, 0xa9, 0x31, 0xcb, 0xb4, 0x22,
+	0xac, 0xe9, 0xaa, 0xdb, 0xdf, 0x35, 0x71, 0x30, 0xc8, 0x4c, 0x3c, 0x49, 0x87, 0x9f, 0x94, 0x2f,
+	0x84, 0x49, 0x1b, 0xf7, 0x62, 0x9e, 0x4f, 0x62, 0x86, 0xfd, 0x90, 0x0b, 0xf4, 0x1f, 0x4a, 0x70,
+	0x79, 0x3b, 0xf2, 0xd8, 0x9e, 0x4f, 0x98, 0x67, 0xef, 0x1c, 0xe0, 0xd0, 0x22, 0xfb, 0x7d, 0x12,
+	0x33, 0xf4, 0x32, 0x9c, 0x0b, 0x29, 0xed, 0x75, 0x3c, 0xa7, 0x06, 0xea, 0xa0, 0x51, 0xb6, 0x66,
+	0x93, 0xe5, 0xbb, 0x0e, 0xba, 0x09, 0x61, 0x72, 0x9c, 0x0e, 0x8e, 0x63, 0xc2, 0x6a, 0xa5, 0x3a,
+	0x68, 0x54, 0xac, 0x4a, 0xb2, 0xb3, 0x9d, 0x6c, 0x20, 0x0d, 0xce, 0xef, 0xf7, 0x29, 0xcb, 0xec,
+	0xd3, 0xa9, 0x1d, 0xa6, 0x5b, 0x5c, 0xf0, 0x11, 0x84, 0x31, 0xc3, 0x11, 0xeb, 0x24, 0x2c, 0xb5,
+	0x72, 0x1d, 0x34, 0xe6, 0xb7, 0x14, 0x83, 0x83, 0x1a, 0x19, 0xa8, 0xb1, 0x93, 0x81, 0xb6, 0x6f,
+	0x1e, 0x0e, 0xb5, 0xa9, 0x93, 0xa1, 0xf6, 0xd2, 0x00, 0xfb, 0xbd, 0x7b, 0xfa, 0x53, 0x5f, 0xfd,
+	0xf1, 0xdf, 0x1a, 0xb0, 0x2a, 0xe9, 0x46, 0x22, 0x47, 0x16, 0xbc, 0x46, 0x02, 0x87, 0xc7, 0x9d,
+	0xb9, 0x30, 0xee, 0xea, 0xe1, 0x50, 0x03, 0x27, 0x43, 0x6d, 0x91, 0xc7, 0xcd, 0x3c, 0x79, 0xd4,
+	0x39, 0x12, 0x38, 0x89, 0x54, 0xff, 0x1c, 0xc0, 0x1b, 0xe3, 0x05, 0x8a, 0x43, 0x1a, 0xc4, 0x04,
+	0xed, 0xc2, 0x45, 0x3c, 0xb2, 0x74, 0x92, 0x29, 0x49, 0x2b, 0x55, 0x69, 0xbf, 0x99, 0x10, 0xff,
+	0x35, 0xd4, 0x56, 0x79, 0x2f, 0x62, 0xe7, 0x13, 0xc3, 0xa3, 0xa6, 0x8f, 0xd9, 0x9e, 0xf1, 0x80,
+	0xb8, 0xd8, 0x1e, 0xbc, 0x4d, 0xec, 0x93, 0xa1, 0x76, 0x83, 0x27, 0x1e, 0x8b, 0xa1, 0x5b, 0x55,
+	0x9c, 0xcb, 0xa7, 0xff, 0x0e, 0xa0, 0x92, 0x47, 0xd8, 0xa1, 0xef, 0xd1, 0x83, 0x17, 0xb7, 0x51,
+	0xfa, 0x97, 0x00, 0xae, 0x4a, 0x4f, 0x74, 0xc9, 0x95, 0xfd, 0xbe, 0x04, 0x97, 0xde, 0x21, 0xd4,
+	0x27, 0x2c, 0xba, 0x1a, 0x7e, 0xc9, 0xf0, 0x7f, 0x06, 0x97, 0xc7, 0xca, 0x23, 0x1a, 0x64, 0xc3,
+	0xaa, 0x9b, 0x19, 0x4e, 0xf7, 0xe7, 0x7e, 0xb1, 0xfe, 0x2c, 0xf3, 0xac, 0xf9, 0x10, 0xba, 0xb5,
+	0xe0, 0x9e, 0x4e, 0xa6, 0xff, 0x06, 0xe0, 0x4a, 0x2e, 0xfd, 0x8b, 0x3e, 0xf6, 0x5f, 0x00, 0xa8,
+	0xc8, 0x0e, 0x74, 0x99, 0x45, 0xfd, 0xb1, 0x04, 0x57, 0x92, 0x1f, 0x6f, 0xd1, 0x60, 0xd7, 0x73,
+	0x48, 0x60, 0x93, 0x36, 0x0e, 0x9c, 0xab, 0xb9, 0xcf, 0xcd, 0xfd, 0xd7, 0x00, 0x2a, 0xb2, 0x22,
+	0x89, 0x46, 0xed, 0xc3, 0x45, 0x7b, 0x64, 0xe9, 0x74, 0x71, 0xc0, 0xab, 0x35, 0xbf, 0xd5, 0x30,
+	0x64, 0xef, 0x10, 0xe3, 0x6c, 0xa8, 0xb6, 0x2a, 0xce, 0x27, 0xbe, 0x54, 0x63, 0xe1, 0x74, 0xab,
+	0x6a, 0xe7, 0xf4, 0xfa, 0x2f, 0x25, 0xa8, 0x6d, 0xbb, 0x6e, 0x44, 0x5c, 0xcc, 0x88, 0x23, 0xbf,
+	0xb1, 0xf3, 0x3d, 0x02, 0x17, 0xf4, 0xa8, 0x74, 0x41, 0x8f, 0xa6, 0x9f, 0x53, 0x8f, 0xca, 0xff,
+	0x4f, 0x8f, 0x90, 0x01, 0xaf, 0x89, 0x51, 0x8d, 0x6b, 0x33, 0xf5, 0xe9, 0x46, 0xb9, 0x7d, 0xfd,
+	0xa9, 0x4f, 0x66, 0xd1, 0xad, 0x39, 0x3e, 0xc0, 0xb1, 0xfe, 0x15, 0x80, 0xf5, 0xc9, 0x15, 0xbc,
+	0xe4, 0x8b, 0x67, 0x11, 0x2e, 0xbc, 0x8f, 0x23, 0xec, 0xc7, 0xa2, 0x77, 0xfa, 0x03, 0x58, 0xcd,
+	0x36, 0x04, 0xca, 0x3d, 0x38, 0x1b, 0xa6, 0x3b, 0x62, 0xb6, 0xd6, 0xe4, 0xb3, 0xc5, 0xbd, 0xda,
+	0xe5, 0x84, 0xcf, 0x12, 0x1e, 0x5b, 0x8f, 0x2a, 0x70, 0xe6, 0x83, 0xe4, 0xb5, 0x89, 0x06, 0x70,
+	0x96, 0x2b, 0xd0, 0xad, 0xf3, 0xfc, 0x05, 0x86, 0xb2, 0x7e, 0xbe, 0x88, 0xa3, 0xe9, 0xeb, 0x8f,
+	0xfe, 0xf8, 0xf7, 0x9b, 0x92, 0x8a, 0xd6, 0x4c, 0xe9, 0x13, 0x59, 0x24, 0xfc, 0x0e, 0xc0, 0x6a,
+	0xbe, 0xcc, 0x68, 0x53, 0x1e, 0x5e, 0x3a, 0xce, 0xca, 0x9d, 0x62, 0x62, 0xc1, 0x74, 0x27, 0x65,
+	0xba, 0x8d, 0xd6, 0xe5, 0x4c, 0x63, 0x20, 0x3f, 0x03, 0x78, 0x5d, 0xf2, 0x00, 0x41, 0xcd, 0x22,
+	0x39, 0x4f, 0x5f, 0x43, 0x4a, 0xeb, 0x19, 0x3c, 0x04, 0x6a, 0x2b, 0x45, 0xdd, 0x44, 0xaf, 0x16,
+	0x41, 0xe5, 0x5c, 0xdf, 0x02, 0xb8, 0x90, 0xbb, 0x39, 0xd0, 0x86, 0x3c, 0xaf, 0xec, 0x35, 0xa3,
+	0x6c, 0x16, 0xd2, 0x0a, 0xba, 0xcd, 0x94, 0xee, 0x15, 0x74, 0x4b, 0x4e, 0x97, 0xa7, 0xf8, 0x09,
+	0x40, 0x74, 0xf6, 0x46, 0x43, 0x66, 0x81, 0x84, 0xb9, 0x2a, 0x36, 0x8b, 0x3b, 0x08, 0xcc, 0x66,
+	0x8a, 0xb9, 0x81, 0x1a, 0x05, 0x30, 0x39, 0x54, 0xc2, 0x7a, 0xf6, 0x4b, 0x3c, 0x89, 0x75, 0xe2,
+	0x1d, 0xa9, 0x34, 0x8b, 0x3b, 0x14, 0x63, 0x95, 0x40, 0xfd, 0x0a, 0x60, 0x6d, 0xd2, 0xc7, 0x0a,
+	0xbd, 0x31, 0x61, 0xe4, 0xce, 0xbf, 0x1e, 0x94, 0xbb, 0xcf, 0xea, 0x26, 0xe8, 0xef, 0xa6, 0xf4,
+	0x4d, 0x64, 0x4c, 0x18, 0xd7, 0x09, 0xfe, 0xed, 0x0f, 0x0f, 0x8f, 0x54, 0xf0, 0xe4, 0x48, 0x05,
+	0xff, 0x1c, 0xa9, 0xe0, 0xf1, 0xb1, 0x3a, 0xf5, 0xe4, 0x58, 0x9d, 0xfa, 0xf3, 0x58, 0x9d, 0xfa,
+	0xf8, 0xbe, 0xeb, 0xb1, 0xbd, 0x7e, 0xd7, 0xb0, 0xa9, 0x9f, 0xc5, 0x7c, 0xad, 0x87, 0xbb, 0xf1,
+	0x28, 0xc1, 0xc3, 0xad, 0x96, 0xf9, 0x29, 0x4f, 0x63, 0xf7, 0x3c, 0x12, 0x30, 0xfe, 0xbf, 0x33,
+	0xbf, 0x2b, 0x66, 0xd3, 0x3f, 0xaf, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x38, 0x67, 0xff, 0x00,
+	0x16, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -641,6 +892,8 @@ type QueryClient interface {
 	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+	TwapConfidenceBand(ctx context.Context, in *TwapConfidenceBandRequest, opts ...grpc.CallOption) (*TwapConfidenceBandResponse, error)
+	AggregatedArithmeticTwap(ctx context.Context, in *AggregatedArithmeticTwapRequest, opts ...grpc.CallOption) (*AggregatedArithmeticTwapResponse, error)
 }
 
 type queryClient struct {
@@ -696,6 +949,24 @@ func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapT
 	return out, nil
 }
 
+func (c *queryClient) TwapConfidenceBand(ctx context.Context, in *TwapConfidenceBandRequest, opts ...grpc.CallOption) (*TwapConfidenceBandResponse, error) {
+	out := new(TwapConfidenceBandResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/TwapConfidenceBand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatedArithmeticTwap(ctx context.Context, in *AggregatedArithmeticTwapRequest, opts ...grpc.CallOption) (*AggregatedArithmeticTwapResponse, error) {
+	out := new(AggregatedArithmeticTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/AggregatedArithmeticTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -703,6 +974,8 @@ type QueryServer interface {
 	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+	TwapConfidenceBand(context.Context, *TwapConfidenceBandRequest) (*TwapConfidenceBandResponse, error)
+	AggregatedArithmeticTwap(context.Context, *AggregatedArithmeticTwapRequest) (*AggregatedArithmeticTwapResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -724,6 +997,12 @@ func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *Geometr
 func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
 }
+func (*UnimplementedQueryServer) TwapConfidenceBand(ctx context.Context, req *TwapConfidenceBandRequest) (*TwapConfidenceBandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwapConfidenceBand not implemented")
+}
+func (*UnimplementedQueryServer) AggregatedArithmeticTwap(ctx context.Context, req *AggregatedArithmeticTwapRequest) (*AggregatedArithmeticTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedArithmeticTwap not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -819,6 +1098,42 @@ func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TwapConfidenceBand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwapConfidenceBandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TwapConfidenceBand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/TwapConfidenceBand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TwapConfidenceBand(ctx, req.(*TwapConfidenceBandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatedArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatedArithmeticTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedArithmeticTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/AggregatedArithmeticTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedArithmeticTwap(ctx, req.(*AggregatedArithmeticTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.twap.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -843,6 +1158,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GeometricTwapToNow",
 			Handler:    _Query_GeometricTwapToNow_Handler,
 		},
+		{
+			MethodName: "TwapConfidenceBand",
+			Handler:    _Query_TwapConfidenceBand_Handler,
+		},
+		{
+			MethodName: "AggregatedArithmeticTwap",
+			Handler:    _Query_AggregatedArithmeticTwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/twap/v1beta1/query.proto",
@@ -1200,7 +1523,7 @@ func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TwapConfidenceBandRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1210,20 +1533,57 @@ func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TwapConfidenceBandRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TwapConfidenceBandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *TwapConfidenceBandResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1233,18 +1593,18 @@ func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TwapConfidenceBandResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TwapConfidenceBandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ConfidenceBand.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1256,23 +1616,185 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AggregatedArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ArithmeticTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *AggregatedArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA11 := make([]byte, len(m.PoolIds)*10)
+		var j10 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregatedArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.PoolId != 0 {
 		n += 1 + sovQuery(uint64(m.PoolId))
 	}
@@ -1391,49 +1913,663 @@ func (m *GeometricTwapToNowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TwapConfidenceBandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TwapConfidenceBandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConfidenceBand.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AggregatedArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AggregatedArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GeometricTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.GeometricTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,10 +2592,10 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1635,7 +2771,7 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,15 +2794,15 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1694,7 +2830,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1719,7 +2855,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +2878,10 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1885,7 +3021,7 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,15 +3044,15 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,7 +3080,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1969,7 +3105,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *TwapConfidenceBandRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1992,10 +3128,10 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TwapConfidenceBandRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TwapConfidenceBandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2171,7 +3307,7 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *TwapConfidenceBandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,17 +3330,17 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TwapConfidenceBandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TwapConfidenceBandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidenceBand", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2214,23 +3350,22 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfidenceBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2255,7 +3390,7 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *AggregatedArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2278,17 +3413,17 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedArithmeticTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2298,14 +3433,27 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,13 +3481,13 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2349,27 +3497,28 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2396,10 +3545,89 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2421,7 +3649,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *AggregatedArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2444,15 +3672,15 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedArithmeticTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2480,7 +3708,7 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10,7 +10,7 @@ import (
 )
 
 type (
-	TimeTooOldError        = timeTooOldError
+	TimeTooOldError        = types.TimeTooOldError
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
@@ -24,6 +24,18 @@ func (k Keeper) GetRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, time tim
 	return k.getRecordAtOrBeforeTime(ctx, poolId, time, asset0Denom, asset1Denom)
 }
 
+func (k Keeper) GetPairLiquidityAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.PairLiquidityRecord, error) {
+	return k.getPairLiquidityAtOrBeforeTime(ctx, poolId, t, asset0Denom, asset1Denom)
+}
+
+func (k Keeper) GetPoolIdsTrackingDenomPair(ctx sdk.Context, asset0Denom string, asset1Denom string) ([]uint64, error) {
+	return k.getPoolIdsTrackingDenomPair(ctx, asset0Denom, asset1Denom)
+}
+
+func (k Keeper) DeleteDenomPairPoolIndex(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatDenomPairPoolIndexKey(asset0Denom, asset1Denom, poolId))
+}
+
 func (k Keeper) TrackChangedPool(ctx sdk.Context, poolId uint64) {
 	k.trackChangedPool(ctx, poolId)
 }
@@ -64,6 +76,18 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func ComputeVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	return computeVolatility(startRecord, endRecord)
+}
+
+func ComputeSpotPriceRange(startRecord types.TwapRecord, laterRecords []types.TwapRecord, quoteAsset string) (osmomath.Dec, osmomath.Dec) {
+	return computeSpotPriceRange(startRecord, laterRecords, quoteAsset)
+}
+
+func (k Keeper) GetRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	return k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, asset0Denom, asset1Denom)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -73,6 +73,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
+		k.setDenomPairPoolIndex(ctx, twap)
+	}
+
+	// The genesis state does not track since when the imported records populate the
+	// geometric variance accumulator, so it is conservatively only trusted from genesis onwards.
+	if len(genState.Twaps) > 0 {
+		k.SetGeometricVarianceAccumulatorStartTime(ctx, ctx.BlockTime())
 	}
 }
 
@@ -53,16 +53,17 @@ var (
 	basicParams = types.NewParams("week", 48*time.Hour)
 
 	mostRecentRecordPoolOne = types.TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      3,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             osmomath.OneDec(),
-		P1LastSpotPrice:             osmomath.OneDec(),
-		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-		GeometricTwapAccumulator:    osmomath.OneDec(),
+		PoolId:                       basePoolId,
+		Asset0Denom:                  denom0,
+		Asset1Denom:                  denom1,
+		Height:                       3,
+		Time:                         tPlusOne.Add(time.Second),
+		P0LastSpotPrice:              osmomath.OneDec(),
+		P1LastSpotPrice:              osmomath.OneDec(),
+		P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		GeometricTwapAccumulator:     osmomath.OneDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -75,43 +76,46 @@ var (
 		basicParams,
 		[]types.TwapRecord{
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom1,
-				Height:                      1,
-				Time:                        baseTime,
-				P0LastSpotPrice:             osmomath.OneDec(),
-				P1LastSpotPrice:             osmomath.OneDec(),
-				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-				GeometricTwapAccumulator:    osmomath.OneDec(),
+				PoolId:                       basePoolId,
+				Asset0Denom:                  denom0,
+				Asset1Denom:                  denom1,
+				Height:                       1,
+				Time:                         baseTime,
+				P0LastSpotPrice:              osmomath.OneDec(),
+				P1LastSpotPrice:              osmomath.OneDec(),
+				P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				GeometricTwapAccumulator:     osmomath.OneDec(),
+				GeometricVarianceAccumulator: osmomath.ZeroDec(),
 			},
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom1,
-				Height:                      2,
-				Time:                        tPlusOne,
-				P0LastSpotPrice:             osmomath.OneDec(),
-				P1LastSpotPrice:             osmomath.OneDec(),
-				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-				GeometricTwapAccumulator:    osmomath.OneDec(),
+				PoolId:                       basePoolId,
+				Asset0Denom:                  denom0,
+				Asset1Denom:                  denom1,
+				Height:                       2,
+				Time:                         tPlusOne,
+				P0LastSpotPrice:              osmomath.OneDec(),
+				P1LastSpotPrice:              osmomath.OneDec(),
+				P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				GeometricTwapAccumulator:     osmomath.OneDec(),
+				GeometricVarianceAccumulator: osmomath.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
 
 	mostRecentRecordPoolTwo = types.TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom2,
-		Height:                      1,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             osmomath.OneDec(),
-		P1LastSpotPrice:             osmomath.OneDec(),
-		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-		GeometricTwapAccumulator:    osmomath.OneDec(),
+		PoolId:                       basePoolId,
+		Asset0Denom:                  denom0,
+		Asset1Denom:                  denom2,
+		Height:                       1,
+		Time:                         tPlusOne.Add(time.Second),
+		P0LastSpotPrice:              osmomath.OneDec(),
+		P1LastSpotPrice:              osmomath.OneDec(),
+		P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		GeometricTwapAccumulator:     osmomath.OneDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -119,28 +123,30 @@ var (
 		[]types.TwapRecord{
 			mostRecentRecordPoolTwo,
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom2,
-				Height:                      2,
-				Time:                        tPlusOne,
-				P0LastSpotPrice:             osmomath.OneDec(),
-				P1LastSpotPrice:             osmomath.OneDec(),
-				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-				GeometricTwapAccumulator:    osmomath.OneDec(),
+				PoolId:                       basePoolId,
+				Asset0Denom:                  denom0,
+				Asset1Denom:                  denom2,
+				Height:                       2,
+				Time:                         tPlusOne,
+				P0LastSpotPrice:              osmomath.OneDec(),
+				P1LastSpotPrice:              osmomath.OneDec(),
+				P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				GeometricTwapAccumulator:     osmomath.OneDec(),
+				GeometricVarianceAccumulator: osmomath.ZeroDec(),
 			},
 			{
-				PoolId:                      basePoolId,
-				Asset0Denom:                 denom0,
-				Asset1Denom:                 denom2,
-				Height:                      3,
-				Time:                        baseTime,
-				P0LastSpotPrice:             osmomath.OneDec(),
-				P1LastSpotPrice:             osmomath.OneDec(),
-				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-				GeometricTwapAccumulator:    osmomath.OneDec(),
+				PoolId:                       basePoolId,
+				Asset0Denom:                  denom0,
+				Asset1Denom:                  denom2,
+				Height:                       3,
+				Time:                         baseTime,
+				P0LastSpotPrice:              osmomath.OneDec(),
+				P1LastSpotPrice:              osmomath.OneDec(),
+				P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+				GeometricTwapAccumulator:     osmomath.OneDec(),
+				GeometricVarianceAccumulator: osmomath.ZeroDec(),
 			},
 		})
 
@@ -160,6 +166,11 @@ func withLastErrTime(twap types.TwapRecord, lastErrorTime time.Time) types.TwapR
 	return twap
 }
 
+func withGeometricVarianceAccum(twap types.TwapRecord, accum osmomath.Dec) types.TwapRecord {
+	twap.GeometricVarianceAccumulator = accum
+	return twap
+}
+
 func withSp0(twap types.TwapRecord, sp osmomath.Dec) types.TwapRecord {
 	twap.P0LastSpotPrice = sp
 	return twap
@@ -217,16 +228,17 @@ func (s *TestSuite) TestTwapInitGenesis() {
 				types.NewParams("week", 48*time.Hour),
 				[]types.TwapRecord{
 					{
-						PoolId:                      0, // invalid
-						Asset0Denom:                 "test1",
-						Asset1Denom:                 "test2",
-						Height:                      1,
-						Time:                        baseTime,
-						P0LastSpotPrice:             osmomath.OneDec(),
-						P1LastSpotPrice:             osmomath.OneDec(),
-						P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-						P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-						GeometricTwapAccumulator:    osmomath.OneDec(),
+						PoolId:                       0, // invalid
+						Asset0Denom:                  "test1",
+						Asset1Denom:                  "test2",
+						Height:                       1,
+						Time:                         baseTime,
+						P0LastSpotPrice:              osmomath.OneDec(),
+						P1LastSpotPrice:              osmomath.OneDec(),
+						P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+						P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+						GeometricTwapAccumulator:     osmomath.OneDec(),
+						GeometricVarianceAccumulator: osmomath.ZeroDec(),
 					},
 				}),
 
@@ -419,11 +431,12 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		Asset0Denom: denom0,
 		Asset1Denom: denom1,
 
-		P0LastSpotPrice:             sp0,
-		P1LastSpotPrice:             osmomath.OneDec().Quo(sp0),
-		P0ArithmeticTwapAccumulator: accum0,
-		P1ArithmeticTwapAccumulator: accum1,
-		GeometricTwapAccumulator:    geomAccum,
+		P0LastSpotPrice:              sp0,
+		P1LastSpotPrice:              osmomath.OneDec().Quo(sp0),
+		P0ArithmeticTwapAccumulator:  accum0,
+		P1ArithmeticTwapAccumulator:  accum1,
+		GeometricTwapAccumulator:     geomAccum,
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 }
 
@@ -440,11 +453,12 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		Asset0Denom: denom0,
 		Asset1Denom: denom1,
 
-		P0LastSpotPrice:             spA,
-		P1LastSpotPrice:             spB,
-		P0ArithmeticTwapAccumulator: accumA,
-		P1ArithmeticTwapAccumulator: accumB,
-		GeometricTwapAccumulator:    geomAccumAB,
+		P0LastSpotPrice:              spA,
+		P1LastSpotPrice:              spB,
+		P0ArithmeticTwapAccumulator:  accumA,
+		P1ArithmeticTwapAccumulator:  accumB,
+		GeometricTwapAccumulator:     geomAccumAB,
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -467,11 +481,12 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		Asset0Denom: asset0,
 		Asset1Denom: asset1,
 
-		P0LastSpotPrice:             osmomath.ZeroDec(),
-		P1LastSpotPrice:             osmomath.ZeroDec(),
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		P0LastSpotPrice:              osmomath.ZeroDec(),
+		P1LastSpotPrice:              osmomath.ZeroDec(),
+		P0ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		GeometricTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 }
 
@@ -499,9 +514,10 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum osmoma
 		P0LastSpotPrice: sp0,
 		P1LastSpotPrice: osmomath.OneDec().Quo(sp0),
 		// make new copies
-		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		P0ArithmeticTwapAccumulator:  accum0.Add(osmomath.ZeroDec()),
+		P1ArithmeticTwapAccumulator:  accum1.Add(osmomath.ZeroDec()),
+		GeometricTwapAccumulator:     geomAccum.Add(osmomath.ZeroDec()),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 }
 
@@ -511,9 +527,10 @@ func newExpRecord(accum0, accum1, geomAccum osmomath.Dec) types.TwapRecord {
 		Asset0Denom: defaultTwoAssetCoins[0].Denom,
 		Asset1Denom: defaultTwoAssetCoins[1].Denom,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+		P0ArithmeticTwapAccumulator:  accum0.Add(osmomath.ZeroDec()),
+		P1ArithmeticTwapAccumulator:  accum1.Add(osmomath.ZeroDec()),
+		GeometricTwapAccumulator:     geomAccum.Add(osmomath.ZeroDec()),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 }
 
@@ -529,9 +546,10 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0LastSpotPrice: spA,
 		P1LastSpotPrice: spB,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		P0ArithmeticTwapAccumulator:  accumA.Add(osmomath.ZeroDec()),
+		P1ArithmeticTwapAccumulator:  accumB.Add(osmomath.ZeroDec()),
+		GeometricTwapAccumulator:     geomAccumAB.Add(osmomath.ZeroDec()),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -553,9 +571,10 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		Asset0Denom: defaultThreeAssetCoins[0].Denom,
 		Asset1Denom: defaultThreeAssetCoins[1].Denom,
 		// make new copies
-		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
-		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
-		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+		P0ArithmeticTwapAccumulator:  accumA.Add(osmomath.ZeroDec()),
+		P1ArithmeticTwapAccumulator:  accumB.Add(osmomath.ZeroDec()),
+		GeometricTwapAccumulator:     geomAccumAB.Add(osmomath.ZeroDec()),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -568,6 +587,15 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 	return []types.TwapRecord{twapAB, twapAC, twapBC}
 }
 
+// withThreeAssetGeometricVarianceAccums sets the geometric variance accumulators of the records
+// returned by newThreeAssetRecord or newThreeAssetExpRecord.
+func withThreeAssetGeometricVarianceAccums(records []types.TwapRecord, accumAB, accumAC, accumBC osmomath.Dec) []types.TwapRecord {
+	records[0].GeometricVarianceAccumulator = accumAB
+	records[1].GeometricVarianceAccumulator = accumAC
+	records[2].GeometricVarianceAccumulator = accumBC
+	return records
+}
+
 func newOneSidedRecord(time time.Time, accum osmomath.Dec, useP0 bool) types.TwapRecord {
 	record := types.TwapRecord{Time: time, Asset0Denom: denom0, Asset1Denom: denom1}
 	if useP0 {
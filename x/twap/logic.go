@@ -19,17 +19,18 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
 	return types.TwapRecord{
-		PoolId:                      poolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      ctx.BlockHeight(),
-		Time:                        ctx.BlockTime(),
-		P0LastSpotPrice:             sp0,
-		P1LastSpotPrice:             sp1,
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
-		LastErrorTime:               lastErrorTime,
+		PoolId:                       poolId,
+		Asset0Denom:                  denom0,
+		Asset1Denom:                  denom1,
+		Height:                       ctx.BlockHeight(),
+		Time:                         ctx.BlockTime(),
+		P0LastSpotPrice:              sp0,
+		P1LastSpotPrice:              sp1,
+		P0ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		GeometricTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
+		LastErrorTime:                lastErrorTime,
 	}, nil
 }
 
@@ -87,6 +88,7 @@ func (k Keeper) mustTrackCreatedPool(ctx sdk.Context, poolId uint64) {
 func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
 	denomPairs := types.GetAllUniqueDenomPairs(denoms)
+	records := make([]types.TwapRecord, 0, len(denomPairs))
 	for _, denomPair := range denomPairs {
 		record, err := newTwapRecord(k.poolmanagerKeeper, ctx, poolId, denomPair.Denom0, denomPair.Denom1)
 		// err should be impossible given GetAllUniqueDenomPairs guarantees
@@ -98,7 +100,10 @@ func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
 		// furthermore, this protects against an edge case where a pool is created
 		// during EndBlock, after twapkeeper's endblock.
 		k.StoreNewRecord(ctx, record)
+		k.setDenomPairPoolIndex(ctx, record)
+		records = append(records, record)
 	}
+	k.storePairLiquidityRecords(ctx, poolId, records)
 	k.trackChangedPool(ctx, poolId)
 	return err
 }
@@ -147,13 +152,16 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 		return types.InvalidRecordCountError{Expected: expectedRecordsLength, Actual: len(records)}
 	}
 
+	newRecords := make([]types.TwapRecord, 0, len(records))
 	for _, record := range records {
 		newRecord, err := k.updateRecord(ctx, record)
 		if err != nil {
 			return err
 		}
 		k.StoreNewRecord(ctx, newRecord)
+		newRecords = append(newRecords, newRecord)
 	}
+	k.storePairLiquidityRecords(ctx, poolId, newRecords)
 	return nil
 }
 
@@ -251,6 +259,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
 
+	// p0NewVarianceAccum = (log_{2}{P_0})^2 * timeDelta
+	p0NewVarianceAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+	newRecord.GeometricVarianceAccumulator = p0NewVarianceAccum.AddMut(newRecord.GeometricVarianceAccumulator)
+
 	return newRecord
 }
 
@@ -309,6 +321,66 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// varianceRoundingTolerance is the largest negative variance attributed to rounding
+// of the accumulators. Variances within the tolerance are treated as zero.
+var varianceRoundingTolerance = osmomath.NewDecWithPrec(1, 12)
+
+// computeVolatility computes and returns the time weighted standard deviation
+// of log_{2} of the spot price between two records.
+// It is the same for both assets since log_{2}{P_1} = -log_{2}{P_0}.
+// precondition: endRecord.Time >= startRecord.Time
+// if (endRecord.Time == startRecord.Time) returns zero
+// else returns
+// sqrt(E[log_{2}{P_0}^2] - E[log_{2}{P_0}]^2)
+// where each expected value is the accumulator difference divided by the time delta.
+// Errors if the variance is negative beyond varianceRoundingTolerance.
+func computeVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	if timeDelta == 0 {
+		return osmomath.ZeroDec(), nil
+	}
+
+	meanLogPrice := types.AccumDiffDivDuration(endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator), timeDelta)
+	meanSquaredLogPrice := types.AccumDiffDivDuration(endRecord.GeometricVarianceAccumulator.Sub(startRecord.GeometricVarianceAccumulator), timeDelta)
+
+	// N.B. rounding may make the variance of a constant price slightly negative.
+	// Anything beyond that indicates inconsistent accumulators and must not be hidden.
+	variance := meanSquaredLogPrice.Sub(meanLogPrice.Mul(meanLogPrice))
+	if variance.LT(varianceRoundingTolerance.Neg()) {
+		return osmomath.Dec{}, types.NegativeVarianceError{Variance: variance}
+	}
+	if !variance.IsPositive() {
+		return osmomath.ZeroDec(), nil
+	}
+
+	return variance.ApproxSqrt()
+}
+
+// computeSpotPriceRange returns the lowest and highest spot price of the quote asset in effect
+// from startRecord.Time onwards, given the records that were written after startRecord.Time.
+// The last spot price of startRecord is in effect until the first of the later records.
+func computeSpotPriceRange(startRecord types.TwapRecord, laterRecords []types.TwapRecord, quoteAsset string) (minSpotPrice osmomath.Dec, maxSpotPrice osmomath.Dec) {
+	lastSpotPrice := func(record types.TwapRecord) osmomath.Dec {
+		if quoteAsset == record.Asset0Denom {
+			return record.P0LastSpotPrice
+		}
+		return record.P1LastSpotPrice
+	}
+
+	minSpotPrice = lastSpotPrice(startRecord)
+	maxSpotPrice = minSpotPrice
+	for _, record := range laterRecords {
+		spotPrice := lastSpotPrice(record)
+		if spotPrice.LT(minSpotPrice) {
+			minSpotPrice = spotPrice
+		}
+		if spotPrice.GT(maxSpotPrice) {
+			maxSpotPrice = spotPrice
+		}
+	}
+	return minSpotPrice, maxSpotPrice
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
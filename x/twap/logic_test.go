@@ -28,6 +28,9 @@ var (
 	logOneOverTen        = twap.TwapLog(osmomath.OneDec().QuoInt64(10))
 	tenSecAccum          = OneSec.MulInt64(10)
 	geometricTenSecAccum = OneSec.Mul(logTen)
+
+	geometricVarianceTenSecAccum        = OneSec.Mul(logTen.Mul(logTen))
+	geometricVarianceOneOverTenSecAccum = OneSec.Mul(logOneOverTen.Mul(logOneOverTen))
 )
 
 func (s *TestSuite) TestGetSpotPrices() {
@@ -177,7 +180,7 @@ func (s *TestSuite) TestUpdateRecord() {
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
 	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec)
-	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	sp10OneTimeUnitAccumRecord := withGeometricVarianceAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), geometricVarianceTenSecAccum)
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -263,17 +266,17 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"accum with zero value": {
 			record:    newRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), zeroDec, zeroDec, zeroDec),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum),
+			expRecord: withGeometricVarianceAccum(newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum), geometricVarianceTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:    defaultRecord,
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withGeometricVarianceAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), geometricVarianceTenSecAccum),
 		},
 		"larger time interval": {
 			record:    newRecord(poolId, time.Unix(11, 0), osmomath.NewDec(10), oneDec, twoDec, pointFiveDec),
 			newTime:   time.Unix(55, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))),
+			expRecord: withGeometricVarianceAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(44*10)), twoDec.Add(OneSec.MulInt64(44).QuoInt64(10)), pointFiveDec.Add(OneSec.MulInt64(44).Mul(logTen))), OneSec.MulInt64(44).Mul(logTen.Mul(logTen))),
 		},
 		"same time, accumulator should not change": {
 			record:    defaultRecord,
@@ -288,7 +291,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"sp1 - zero spot price - accum0 updated, accum1 unchanged, geom accum updated correctly": {
 			record:    withPrice1Set(defaultRecord, osmomath.ZeroDec()),
 			newTime:   defaultRecord.Time.Add(time.Second),
-			expRecord: newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withGeometricVarianceAccum(newExpRecord(tenSecAccum.Add(oneDec), twoDec, pointFiveDec.Add(geometricTenSecAccum)), geometricVarianceTenSecAccum),
 		},
 		"both sp - zero spot price - accum0 unchange, accum1 unchanged, geom accum unchanged": {
 			record:    withPrice1Set(withPrice0Set(defaultRecord, osmomath.ZeroDec()), osmomath.ZeroDec()),
@@ -304,7 +307,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 		"nanoseconds in time of the original record do not affect final result": {
 			record:    withTime(defaultRecord, defaultRecord.Time.Add(oneHundredNanoseconds)),
 			newTime:   time.Unix(2, 0),
-			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+			expRecord: withGeometricVarianceAccum(newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)), geometricVarianceTenSecAccum),
 		},
 	}
 
@@ -334,17 +337,23 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 		"accum with zero value": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), zeroDec, zeroDec, zeroDec, zeroDec, zeroDec, zeroDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+			expRecord: withThreeAssetGeometricVarianceAccums(
+				newThreeAssetExpRecord(poolId, OneSec.MulInt64(10), OneSec.QuoInt64(10), OneSec.MulInt64(20), geometricTenSecAccum, geometricTenSecAccum, OneSec.Mul(logOneOverTen)),
+				geometricVarianceTenSecAccum, geometricVarianceTenSecAccum, geometricVarianceOneOverTenSecAccum),
 		},
 		"small starting accumulators": {
 			record:          newThreeAssetRecord(poolId, time.Unix(1, 0), osmomath.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(2, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+			expRecord: withThreeAssetGeometricVarianceAccums(
+				newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(10)), oneDec.Add(OneSec.QuoInt64(10)), twoDec.Add(OneSec.MulInt64(20)), oneDec.Add(geometricTenSecAccum), twoDec.Add(geometricTenSecAccum), oneDec.Add(OneSec.Mul(logOneOverTen))),
+				geometricVarianceTenSecAccum, geometricVarianceTenSecAccum, geometricVarianceOneOverTenSecAccum),
 		},
 		"larger time interval": {
 			record:          newThreeAssetRecord(poolId, time.Unix(11, 0), osmomath.NewDec(10), twoDec, oneDec, twoDec, oneDec, twoDec, oneDec),
 			interpolateTime: time.Unix(55, 0),
-			expRecord:       newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+			expRecord: withThreeAssetGeometricVarianceAccums(
+				newThreeAssetExpRecord(poolId, twoDec.Add(OneSec.MulInt64(44*10)), oneDec.Add(OneSec.MulInt64(44).QuoInt64(10)), twoDec.Add(OneSec.MulInt64(44*20)), oneDec.Add(OneSec.MulInt64(44).Mul(logTen)), twoDec.Add(OneSec.MulInt64(44).Mul(logTen)), oneDec.Add(OneSec.MulInt64(44).Mul(logOneOverTen))),
+				OneSec.MulInt64(44).Mul(logTen.Mul(logTen)), OneSec.MulInt64(44).Mul(logTen.Mul(logTen)), OneSec.MulInt64(44).Mul(logOneOverTen.Mul(logOneOverTen))),
 		},
 	}
 
@@ -1510,6 +1519,108 @@ func testCaseFromDeltasAsset1(s *TestSuite, startAccum, accumDiff osmomath.Dec,
 	}
 }
 
+func TestComputeVolatility(t *testing.T) {
+	volatilityTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: osmomath.NewDecWithPrec(1, 12),
+	}
+
+	startRecord := newRecord(1, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec)
+	// spot price of 10 for 10 seconds.
+	tPlus10Record := withSp1(withSp0(twap.RecordWithUpdatedAccumulators(startRecord, baseTime.Add(10*time.Second)), osmomath.NewDec(5)), osmomath.NewDecWithPrec(2, 1))
+	// spot price of 5 for 10 seconds.
+	tPlus20Record := twap.RecordWithUpdatedAccumulators(tPlus10Record, baseTime.Add(20*time.Second))
+
+	tests := map[string]struct {
+		startRecord types.TwapRecord
+		endRecord   types.TwapRecord
+		expected    osmomath.Dec
+		expectError bool
+	}{
+		"same time - zero": {
+			startRecord: startRecord,
+			endRecord:   startRecord,
+			expected:    osmomath.ZeroDec(),
+		},
+		"constant spot price - zero": {
+			startRecord: startRecord,
+			endRecord:   tPlus10Record,
+			expected:    osmomath.ZeroDec(),
+		},
+		"spot price halved for half of the window": {
+			startRecord: startRecord,
+			endRecord:   tPlus20Record,
+			// log_2{10} - log_2{5} = 1, so the standard deviation is 1 / 2.
+			expected: osmomath.NewDecWithPrec(5, 1),
+		},
+		"start record interpolated": {
+			startRecord: twap.RecordWithUpdatedAccumulators(startRecord, baseTime.Add(5*time.Second)),
+			endRecord:   tPlus20Record,
+			// 5 seconds at log_2{10} and 10 seconds at log_2{5} = log_2{10} - 1.
+			// variance = (1/3) * (2/3) * 1^2 = 2/9.
+			expected: osmomath.MustNewDecFromStr("0.471404520791031683"),
+		},
+		"end record without variance accumulator - error": {
+			startRecord: startRecord,
+			endRecord:   withGeometricVarianceAccum(tPlus20Record, zeroDec),
+			// mean of log_2{P} = (log_2{10} + log_2{5}) / 2 = log_2{5} + 1/2 with a zero mean of squares.
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			volatility, err := twap.ComputeVolatility(test.startRecord, test.endRecord)
+			if test.expectError {
+				require.ErrorAs(t, err, &types.NegativeVarianceError{})
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 0, volatilityTolerance.CompareDec(test.expected, volatility), "expected %s, actual %s", test.expected, volatility)
+		})
+	}
+}
+
+func TestComputeSpotPriceRange(t *testing.T) {
+	startRecord := newRecord(1, baseTime, osmomath.NewDec(10), zeroDec, zeroDec, zeroDec)
+	laterRecords := []types.TwapRecord{
+		newRecord(1, baseTime.Add(time.Second), osmomath.NewDec(5), zeroDec, zeroDec, zeroDec),
+		newRecord(1, baseTime.Add(2*time.Second), osmomath.NewDec(20), zeroDec, zeroDec, zeroDec),
+	}
+
+	tests := map[string]struct {
+		laterRecords []types.TwapRecord
+		quoteAsset   string
+		expectedMin  osmomath.Dec
+		expectedMax  osmomath.Dec
+	}{
+		"no later records - start spot price": {
+			quoteAsset:  startRecord.Asset0Denom,
+			expectedMin: osmomath.NewDec(10),
+			expectedMax: osmomath.NewDec(10),
+		},
+		"quote asset 0": {
+			laterRecords: laterRecords,
+			quoteAsset:   startRecord.Asset0Denom,
+			expectedMin:  osmomath.NewDec(5),
+			expectedMax:  osmomath.NewDec(20),
+		},
+		"quote asset 1": {
+			laterRecords: laterRecords,
+			quoteAsset:   startRecord.Asset1Denom,
+			expectedMin:  osmomath.OneDec().QuoInt64(20),
+			expectedMax:  osmomath.OneDec().QuoInt64(5),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			minSpotPrice, maxSpotPrice := twap.ComputeSpotPriceRange(startRecord, test.laterRecords, test.quoteAsset)
+			require.Equal(t, test.expectedMin, minSpotPrice)
+			require.Equal(t, test.expectedMax, maxSpotPrice)
+		})
+	}
+}
+
 func geometricTestCaseFromDeltas0(s *TestSuite, startAccum, accumDiff osmomath.Dec, timeDelta time.Duration, expectedTwap osmomath.Dec) computeTwapTestCase {
 	return computeTwapTestCase{
 		newOneSidedGeometricRecord(baseTime, startAccum),
@@ -11,15 +11,6 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
 )
 
-type timeTooOldError struct {
-	Time time.Time
-}
-
-func (e timeTooOldError) Error() string {
-	return fmt.Sprintf("looking for a time that's too old, not in the historical index. "+
-		" Try storing the accumulator value. (requested time %s)", e.Time)
-}
-
 // just has to not be empty, for store to work / not register as a delete.
 var sentinelExistsValue = []byte{1}
 
@@ -121,6 +112,7 @@ func (k Keeper) DeleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
 	key2 := types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
 	store.Delete(key1)
 	store.Delete(key2)
+	store.Delete(types.FormatHistoricalPairLiquidityKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time))
 }
 
 // getMostRecentRecordStoreRepresentation returns the most recent twap record in the store
@@ -151,6 +143,58 @@ func (k Keeper) GetAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) (
 	return types.GetAllMostRecentTwapsForPool(store, poolId)
 }
 
+// getPoolIdsTrackingDenomPair returns the ids of all pools with a most recent record
+// for the (asset0, asset1) pair, in ascending order.
+// The ids are read from the denom pair index, so only pools tracking the pair are visited.
+func (k Keeper) getPoolIdsTrackingDenomPair(ctx sdk.Context, asset0Denom string, asset1Denom string) ([]uint64, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	return osmoutils.GatherValuesFromStorePrefix(store, types.FormatDenomPairPoolIndexPrefix(asset0Denom, asset1Denom), func(bz []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(bz), nil
+	})
+}
+
+// setDenomPairPoolIndex marks the pool of the given record as tracking the record's denom pair.
+func (k Keeper) setDenomPairPoolIndex(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatDenomPairPoolIndexKey(twap.Asset0Denom, twap.Asset1Denom, twap.PoolId)
+	store.Set(key, sdk.Uint64ToBigEndian(twap.PoolId))
+}
+
+// IndexPoolsByDenomPair adds every pool with a most recent record to the denom pair index.
+// It is used to index pools created before the index existed.
+func (k Keeper) IndexPoolsByDenomPair(ctx sdk.Context) error {
+	records, err := types.GetAllMostRecentTwaps(ctx.KVStore(k.storeKey))
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		k.setDenomPairPoolIndex(ctx, record)
+	}
+	return nil
+}
+
+// SetGeometricVarianceAccumulatorStartTime sets the time from which the geometric variance
+// accumulator is populated. Records written before this time have a zero accumulator.
+func (k Keeper) SetGeometricVarianceAccumulatorStartTime(ctx sdk.Context, startTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GeometricVarianceAccumulatorStartTimeKey, sdk.FormatTimeBytes(startTime))
+}
+
+// GetGeometricVarianceAccumulatorStartTime returns the time from which the geometric variance
+// accumulator is populated. Returns the zero time if the accumulator has been populated since genesis.
+func (k Keeper) GetGeometricVarianceAccumulatorStartTime(ctx sdk.Context) (time.Time, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GeometricVarianceAccumulatorStartTimeKey)
+	if bz == nil {
+		return time.Time{}, nil
+	}
+	return sdk.ParseTimeBytes(bz)
+}
+
 // getAllHistoricalTimeIndexedTWAPs returns all historical TWAPs indexed by time.
 func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPTimeIndexPrefix), types.ParseTwapFromBz)
@@ -215,7 +259,7 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 				"getTwapRecord: querying for assets %s %s that are not in pool id %d",
 				asset0Denom, asset1Denom, poolId)
 		} else {
-			return types.TwapRecord{}, timeTooOldError{Time: t}
+			return types.TwapRecord{}, types.TimeTooOldError{Time: t}
 		}
 	}
 	if twap.Asset0Denom != asset0Denom || twap.Asset1Denom != asset1Denom || twap.PoolId != poolId {
@@ -224,3 +268,61 @@ func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.T
 
 	return twap, nil
 }
+
+// getRecordsInTimeRange returns the historical records of the (pool, asset0, asset1) triplet
+// with a time strictly after startTime and strictly before endTime, in ascending order by time.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime time.Time, endTime time.Time, asset0Denom string, asset1Denom string) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !startTime.Before(endTime) {
+		return []types.TwapRecord{}, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	// The time suffix sorts after the key of a record at startTime,
+	// while the end key of a record at endTime is exclusive.
+	startKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, endTime)
+	return osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+}
+
+// storePairLiquidityRecords stores the current liquidity of the asset pair of each given record
+// of the pool, indexed like the record. These are deleted together with the records when pruning.
+// If the pool liquidity cannot be queried, no liquidity is stored and the previous one remains in effect.
+func (k Keeper) storePairLiquidityRecords(ctx sdk.Context, poolId uint64, records []types.TwapRecord) {
+	liquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("error getting liquidity of pool id %d for TWAP liquidity records: %s", poolId, err))
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, record := range records {
+		key := types.FormatHistoricalPairLiquidityKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time)
+		osmoutils.MustSet(store, key, &types.PairLiquidityRecord{
+			Asset0Liquidity: liquidity.AmountOf(record.Asset0Denom),
+			Asset1Liquidity: liquidity.AmountOf(record.Asset1Denom),
+		})
+	}
+}
+
+// getPairLiquidityAtOrBeforeTime returns the liquidity of the (pool, asset0, asset1) triplet
+// stored with the latest record at or before t.
+// Returns types.TimeTooOldError if there is no liquidity stored at or before t.
+func (k Keeper) getPairLiquidityAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.PairLiquidityRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return types.PairLiquidityRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatHistoricalPairLiquidityTimePrefix(poolId, asset0Denom, asset1Denom)
+	endKey := types.FormatHistoricalPairLiquidityTimeSuffix(poolId, asset0Denom, asset1Denom, t)
+	reverseIterate := true
+
+	liquidity, err := osmoutils.GetFirstValueInRange(store, startKey, endKey, reverseIterate, types.ParsePairLiquidityFromBz)
+	if err != nil {
+		return types.PairLiquidityRecord{}, types.TimeTooOldError{Time: t}
+	}
+	return liquidity, nil
+}
@@ -5,6 +5,8 @@ import (
 	"math"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/twap"
 
@@ -615,3 +617,80 @@ func (s *TestSuite) TestGetAllHistoricalPoolIndexedTWAPsForPooId() {
 		})
 	}
 }
+
+// TestPairLiquidityRecords tests that the liquidity of each asset pair is stored alongside
+// the records of a pool and deleted together with the records it was stored with.
+func (s *TestSuite) TestPairLiquidityRecords() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 2000))
+
+	// The liquidity is stored when the pool is created.
+	liquidity, err := s.twapkeeper.GetPairLiquidityAtOrBeforeTime(s.Ctx, poolId, baseTime, denom1, denom0)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PairLiquidityRecord{Asset0Liquidity: osmomath.NewInt(1000), Asset1Liquidity: osmomath.NewInt(2000)}, liquidity)
+
+	_, err = s.twapkeeper.GetPairLiquidityAtOrBeforeTime(s.Ctx, poolId, baseTime.Add(-time.Second), denom0, denom1)
+	s.Require().ErrorAs(err, &twap.TimeTooOldError{})
+
+	// The liquidity is stored again when the records are updated.
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOne).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 2000))
+	s.FundAcc(s.TestAccs[0], tokensIn)
+	_, _, err = s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, s.TestAccs[0], poolId, gammtypes.InitPoolSharesSupply, tokensIn)
+	s.Require().NoError(err)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	liquidity, err = s.twapkeeper.GetPairLiquidityAtOrBeforeTime(s.Ctx, poolId, tPlusOne, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PairLiquidityRecord{Asset0Liquidity: osmomath.NewInt(2000), Asset1Liquidity: osmomath.NewInt(4000)}, liquidity)
+
+	liquidity, err = s.twapkeeper.GetPairLiquidityAtOrBeforeTime(s.Ctx, poolId, tPlusOne.Add(-time.Millisecond), denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(1000), liquidity.Asset0Liquidity)
+
+	// Deleting the first record deletes the liquidity stored with it.
+	record, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, baseTime, denom0, denom1)
+	s.Require().NoError(err)
+	s.twapkeeper.DeleteHistoricalRecord(s.Ctx, record)
+
+	_, err = s.twapkeeper.GetPairLiquidityAtOrBeforeTime(s.Ctx, poolId, tPlusOne.Add(-time.Millisecond), denom0, denom1)
+	s.Require().ErrorAs(err, &twap.TimeTooOldError{})
+}
+
+// TestGetPoolIdsTrackingDenomPair tests that pools are indexed by their denom pairs on creation,
+// and that IndexPoolsByDenomPair restores the index from the most recent records.
+func (s *TestSuite) TestGetPoolIdsTrackingDenomPair() {
+	s.SetupTest()
+	s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 1000))
+	s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom2, 1000))
+	s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 1000), sdk.NewInt64Coin(denom1, 1000), sdk.NewInt64Coin(denom2, 1000))
+
+	expectedPoolIds := map[[2]string][]uint64{
+		{denom0, denom1}:  {1, 3},
+		{denom1, denom0}:  {1, 3},
+		{denom0, denom2}:  {2, 3},
+		{denom1, denom2}:  {3},
+		{denom0, "other"}: {},
+	}
+	requirePoolIds := func() {
+		for pair, expected := range expectedPoolIds {
+			poolIds, err := s.twapkeeper.GetPoolIdsTrackingDenomPair(s.Ctx, pair[0], pair[1])
+			s.Require().NoError(err)
+			s.Require().Equal(expected, poolIds, pair)
+		}
+	}
+	requirePoolIds()
+
+	_, err := s.twapkeeper.GetPoolIdsTrackingDenomPair(s.Ctx, denom0, denom0)
+	s.Require().Error(err)
+
+	// Pools created before the index existed are added from their most recent records.
+	s.twapkeeper.DeleteDenomPairPoolIndex(s.Ctx, 1, denom0, denom1)
+	s.twapkeeper.DeleteDenomPairPoolIndex(s.Ctx, 3, denom1, denom2)
+	poolIds, err := s.twapkeeper.GetPoolIdsTrackingDenomPair(s.Ctx, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{3}, poolIds)
+
+	s.Require().NoError(s.twapkeeper.IndexPoolsByDenomPair(s.Ctx))
+	requirePoolIds()
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	time "time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 type EndTimeInFutureError struct {
@@ -15,6 +17,15 @@ func (e EndTimeInFutureError) Error() string {
 		" (end time %s, current time %s)", e.EndTime, e.BlockTime)
 }
 
+type TimeTooOldError struct {
+	Time time.Time
+}
+
+func (e TimeTooOldError) Error() string {
+	return fmt.Sprintf("looking for a time that's too old, not in the historical index. "+
+		" Try storing the accumulator value. (requested time %s)", e.Time)
+}
+
 type StartTimeAfterEndTimeError struct {
 	StartTime time.Time
 	EndTime   time.Time
@@ -25,6 +36,24 @@ func (e StartTimeAfterEndTimeError) Error() string {
 		" (start time %s, end time %s)", e.StartTime, e.EndTime)
 }
 
+type StartTimeBeforeVarianceAccumulatorStartError struct {
+	StartTime            time.Time
+	AccumulatorStartTime time.Time
+}
+
+func (e StartTimeBeforeVarianceAccumulatorStartError) Error() string {
+	return fmt.Sprintf("called GetTwapConfidenceBand with a start time before the geometric variance accumulator was populated."+
+		" (start time %s, accumulator start time %s)", e.StartTime, e.AccumulatorStartTime)
+}
+
+type NegativeVarianceError struct {
+	Variance osmomath.Dec
+}
+
+func (e NegativeVarianceError) Error() string {
+	return fmt.Sprintf("computed a negative variance of log prices beyond rounding error: %s", e.Variance)
+}
+
 type KeySeparatorLengthError struct {
 	ExpectedLength int
 	ActualLength   int
@@ -73,3 +102,30 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type NoPoolsForDenomPairError struct {
+	BaseDenom  string
+	QuoteDenom string
+}
+
+func (e NoPoolsForDenomPairError) Error() string {
+	return fmt.Sprintf("no pool with liquidity tracks the denom pair over the given time range."+
+		" (base denom %s, quote denom %s)", e.BaseDenom, e.QuoteDenom)
+}
+
+type DuplicatePoolIdError struct {
+	PoolId uint64
+}
+
+func (e DuplicatePoolIdError) Error() string {
+	return fmt.Sprintf("pool id %d is given more than once", e.PoolId)
+}
+
+type PoolIdOutOfRangeError struct {
+	PoolId     uint64
+	NextPoolId uint64
+}
+
+func (e PoolIdOutOfRangeError) Error() string {
+	return fmt.Sprintf("pool id %d does not exist. (next pool id %d)", e.PoolId, e.NextPoolId)
+}
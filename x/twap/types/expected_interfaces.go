@@ -19,4 +19,8 @@ type PoolManagerInterface interface {
 		quoteAssetDenom string,
 		baseAssetDenom string,
 	) (price osmomath.BigDec, err error)
+	// GetTotalPoolLiquidity returns the coins in the pool owned by all LPs.
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
+	// GetNextPoolId returns the id that will be assigned to the next pool.
+	GetNextPoolId(ctx sdk.Context) uint64
 }
@@ -84,5 +84,10 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	// records exported before the variance accumulator was introduced do not have it set.
+	if !t.GeometricVarianceAccumulator.IsNil() && t.GeometricVarianceAccumulator.IsNegative() {
+		return fmt.Errorf("twap record geometric variance accumulator cannot be negative, was (%s)", t.GeometricVarianceAccumulator)
+	}
 	return nil
 }
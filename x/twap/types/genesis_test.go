@@ -20,16 +20,17 @@ var (
 	baseTime   = time.Unix(1257894000, 0).UTC()
 	tPlusOne   = baseTime.Add(time.Second)
 	baseRecord = TwapRecord{
-		PoolId:                      basePoolId,
-		Asset0Denom:                 denom0,
-		Asset1Denom:                 denom1,
-		Height:                      3,
-		Time:                        tPlusOne.Add(time.Second),
-		P0LastSpotPrice:             osmomath.OneDec(),
-		P1LastSpotPrice:             osmomath.OneDec(),
-		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-		GeometricTwapAccumulator:    osmomath.OneDec(),
+		PoolId:                       basePoolId,
+		Asset0Denom:                  denom0,
+		Asset1Denom:                  denom1,
+		Height:                       3,
+		Time:                         tPlusOne.Add(time.Second),
+		P0LastSpotPrice:              osmomath.OneDec(),
+		P1LastSpotPrice:              osmomath.OneDec(),
+		P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+		GeometricTwapAccumulator:     osmomath.OneDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 )
 
@@ -48,28 +49,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			[]TwapRecord{
 				baseRecord,
 				{
-					PoolId:                      basePoolId,
-					Asset0Denom:                 denom0,
-					Asset1Denom:                 denom1,
-					Height:                      2,
-					Time:                        tPlusOne,
-					P0LastSpotPrice:             osmomath.OneDec(),
-					P1LastSpotPrice:             osmomath.OneDec(),
-					P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-					P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-					GeometricTwapAccumulator:    osmomath.OneDec(),
+					PoolId:                       basePoolId,
+					Asset0Denom:                  denom0,
+					Asset1Denom:                  denom1,
+					Height:                       2,
+					Time:                         tPlusOne,
+					P0LastSpotPrice:              osmomath.OneDec(),
+					P1LastSpotPrice:              osmomath.OneDec(),
+					P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+					P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+					GeometricTwapAccumulator:     osmomath.OneDec(),
+					GeometricVarianceAccumulator: osmomath.ZeroDec(),
 				},
 				{
-					PoolId:                      basePoolId,
-					Asset0Denom:                 denom0,
-					Asset1Denom:                 denom1,
-					Height:                      3,
-					Time:                        tPlusOne.Add(time.Second),
-					P0LastSpotPrice:             osmomath.OneDec(),
-					P1LastSpotPrice:             osmomath.OneDec(),
-					P0ArithmeticTwapAccumulator: osmomath.OneDec(),
-					P1ArithmeticTwapAccumulator: osmomath.OneDec(),
-					GeometricTwapAccumulator:    osmomath.OneDec(),
+					PoolId:                       basePoolId,
+					Asset0Denom:                  denom0,
+					Asset1Denom:                  denom1,
+					Height:                       3,
+					Time:                         tPlusOne.Add(time.Second),
+					P0LastSpotPrice:              osmomath.OneDec(),
+					P1LastSpotPrice:              osmomath.OneDec(),
+					P0ArithmeticTwapAccumulator:  osmomath.OneDec(),
+					P1ArithmeticTwapAccumulator:  osmomath.OneDec(),
+					GeometricTwapAccumulator:     osmomath.OneDec(),
+					GeometricVarianceAccumulator: osmomath.ZeroDec(),
 				},
 			})
 	)
@@ -79,6 +82,11 @@ func TestGenesisState_Validate(t *testing.T) {
 		return record
 	}
 
+	withGeometricVarianceAcc := func(record TwapRecord, geometricVarianceAcc osmomath.Dec) TwapRecord {
+		record.GeometricVarianceAccumulator = geometricVarianceAcc
+		return record
+	}
+
 	testCases := map[string]struct {
 		twapGenesis *GenesisState
 
@@ -103,6 +111,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricAcc(baseRecord, osmomath.Dec{})}),
 			expectedErr: true,
 		},
+		"valid geometric variance acc is nil": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricVarianceAcc(baseRecord, osmomath.Dec{})}),
+		},
+		"invalid geometric variance acc is negative": {
+			twapGenesis: NewGenesisState(basicParams, []TwapRecord{withGeometricVarianceAcc(baseRecord, osmomath.NewDec(-1))}),
+			expectedErr: true,
+		},
 		"invalid genesis - pool ID doesn't exist": {
 			twapGenesis: NewGenesisState(
 				NewParams("week", 48*time.Hour),
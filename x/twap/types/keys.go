@@ -29,6 +29,8 @@ var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	historicalPairLiquidityNoSeparator = "historical_pair_liquidity"
+	denomPairPoolIndexNoSeparator      = "denom_pair_pool_index"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -44,6 +46,16 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id | denom1 | denom2 | time
+	// made for getting the pair liquidity at or before a time, mirroring the pool index
+	HistoricalPairLiquidityPrefix = historicalPairLiquidityNoSeparator + KeySeparator
+	// format is denom1 | denom2 | pool id
+	// made for getting the pools that track a denom pair without iterating over every pool
+	DenomPairPoolIndexPrefix = denomPairPoolIndexNoSeparator + KeySeparator
+
+	// GeometricVarianceAccumulatorStartTimeKey stores the time from which the
+	// geometric variance accumulator of every record is populated.
+	GeometricVarianceAccumulatorStartTimeKey = []byte("geometric_variance_accumulator_start_time")
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -82,6 +94,37 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatHistoricalPairLiquidityKey(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	var buffer bytes.Buffer
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	fmt.Fprintf(&buffer, "%s%d%s%s%s%s%s%s", HistoricalPairLiquidityPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS)
+	return buffer.Bytes()
+}
+
+func FormatHistoricalPairLiquidityTimePrefix(poolId uint64, denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s", HistoricalPairLiquidityPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+func FormatHistoricalPairLiquidityTimeSuffix(poolId uint64, denom1, denom2 string, accumulatorWriteTime time.Time) []byte {
+	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
+	// . acts as a suffix for lexicographical orderings
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalPairLiquidityPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
+}
+
+func FormatDenomPairPoolIndexKey(denom1, denom2 string, poolId uint64) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", DenomPairPoolIndexPrefix, denom1, KeySeparator, denom2, KeySeparator, poolIdS))
+}
+
+func FormatDenomPairPoolIndexPrefix(denom1, denom2 string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", DenomPairPoolIndexPrefix, denom1, KeySeparator, denom2, KeySeparator))
+}
+
+// GetAllMostRecentTwaps returns the most recent twap records of every pool.
+func GetAllMostRecentTwaps(store sdk.KVStore) ([]TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(store, []byte(mostRecentTWAPsPrefix), ParseTwapFromBz)
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -100,5 +143,16 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = osmomath.ZeroDec()
 	}
+	if twap.GeometricVarianceAccumulator.IsNil() {
+		twap.GeometricVarianceAccumulator = osmomath.ZeroDec()
+	}
 	return twap, err
 }
+
+func ParsePairLiquidityFromBz(bz []byte) (liquidity PairLiquidityRecord, err error) {
+	if len(bz) == 0 {
+		return PairLiquidityRecord{}, errors.New("pair liquidity not found")
+	}
+	err = proto.Unmarshal(bz, &liquidity)
+	return liquidity, err
+}
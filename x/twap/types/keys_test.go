@@ -63,16 +63,17 @@ func TestFormatHistoricalTwapKeys(t *testing.T) {
 func TestParseTwapFromBz(t *testing.T) {
 	baseTime := time.Unix(1257894000, 0).UTC()
 	baseParseRecord := TwapRecord{
-		PoolId:                      123,
-		Asset0Denom:                 "B",
-		Asset1Denom:                 "A",
-		Height:                      1,
-		Time:                        baseTime,
-		P0LastSpotPrice:             osmomath.NewDecWithPrec(1, 5),
-		P1LastSpotPrice:             osmomath.NewDecWithPrec(2, 5), // inconsistent value
-		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
-		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		PoolId:                       123,
+		Asset0Denom:                  "B",
+		Asset1Denom:                  "A",
+		Height:                       1,
+		Time:                         baseTime,
+		P0LastSpotPrice:              osmomath.NewDecWithPrec(1, 5),
+		P1LastSpotPrice:              osmomath.NewDecWithPrec(2, 5), // inconsistent value
+		P0ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		P1ArithmeticTwapAccumulator:  osmomath.ZeroDec(),
+		GeometricTwapAccumulator:     osmomath.ZeroDec(),
+		GeometricVarianceAccumulator: osmomath.ZeroDec(),
 	}
 
 	withGeomAcc := func(r TwapRecord, acc osmomath.Dec) TwapRecord {
@@ -80,21 +81,40 @@ func TestParseTwapFromBz(t *testing.T) {
 		return r
 	}
 
+	withGeomVarianceAcc := func(r TwapRecord, acc osmomath.Dec) TwapRecord {
+		r.GeometricVarianceAccumulator = acc
+		return r
+	}
+
 	tests := map[string]struct {
-		record              TwapRecord
-		isGeometricAccumNil bool
+		record                      TwapRecord
+		isGeometricAccumNil         bool
+		isGeometricVarianceAccumNil bool
 	}{
 		"standard": {
 			baseParseRecord,
 			false,
+			false,
 		},
 		"with nil geometric twap accumulator -> set to zero": {
 			withGeomAcc(baseParseRecord, osmomath.Dec{}),
 			true,
+			false,
 		},
 		"with non-nil geometric twap accumulator -> not overwritten": {
 			withGeomAcc(baseParseRecord, osmomath.OneDec()),
 			false,
+			false,
+		},
+		"with nil geometric variance accumulator -> set to zero": {
+			withGeomVarianceAcc(baseParseRecord, osmomath.Dec{}),
+			false,
+			true,
+		},
+		"with non-nil geometric variance accumulator -> not overwritten": {
+			withGeomVarianceAcc(baseParseRecord, osmomath.OneDec()),
+			false,
+			false,
 		},
 	}
 	for name, tt := range tests {
@@ -108,6 +128,9 @@ func TestParseTwapFromBz(t *testing.T) {
 			if tt.isGeometricAccumNil {
 				tt.record.GeometricTwapAccumulator = osmomath.ZeroDec()
 			}
+			if tt.isGeometricVarianceAccumNil {
+				tt.record.GeometricVarianceAccumulator = osmomath.ZeroDec()
+			}
 
 			require.Equal(t, tt.record, record)
 		})
@@ -56,6 +56,10 @@ type TwapRecord struct {
 	P0ArithmeticTwapAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=p0_arithmetic_twap_accumulator,json=p0ArithmeticTwapAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p0_arithmetic_twap_accumulator"`
 	P1ArithmeticTwapAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=p1_arithmetic_twap_accumulator,json=p1ArithmeticTwapAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p1_arithmetic_twap_accumulator"`
 	GeometricTwapAccumulator    cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=geometric_twap_accumulator,json=geometricTwapAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"geometric_twap_accumulator"`
+	// Accumulates the square of log_2(p0) multiplied by the time it was the
+	// spot price. Together with geometric_twap_accumulator, it gives the
+	// variance of log_2(p0) over a window.
+	GeometricVarianceAccumulator cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=geometric_variance_accumulator,json=geometricVarianceAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"geometric_variance_accumulator"`
 	// This field contains the time in which the last spot price error occurred.
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
@@ -137,8 +141,100 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 	return time.Time{}
 }
 
+// TwapConfidenceBand summarizes the spot prices of an asset pair in a pool
+// over a time window.
+type TwapConfidenceBand struct {
+	// Arithmetic TWAP of the base asset in units of the quote asset.
+	ArithmeticTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
+	// Time weighted standard deviation of log_2 of the spot price.
+	// It is the same regardless of which asset is the quote asset.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+	// Lowest spot price in effect during the window.
+	MinSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_spot_price,json=minSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_spot_price" yaml:"min_spot_price"`
+	// Highest spot price in effect during the window.
+	MaxSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_spot_price,json=maxSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spot_price" yaml:"max_spot_price"`
+}
+
+func (m *TwapConfidenceBand) Reset()         { *m = TwapConfidenceBand{} }
+func (m *TwapConfidenceBand) String() string { return proto.CompactTextString(m) }
+func (*TwapConfidenceBand) ProtoMessage()    {}
+func (*TwapConfidenceBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{1}
+}
+func (m *TwapConfidenceBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapConfidenceBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapConfidenceBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapConfidenceBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapConfidenceBand.Merge(m, src)
+}
+func (m *TwapConfidenceBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapConfidenceBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapConfidenceBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapConfidenceBand proto.InternalMessageInfo
+
+// PairLiquidityRecord is the liquidity of an asset pair in a pool at the time
+// of the TWAP record with the same (pool_id, asset pair, timestamp) index.
+// It is used to weight pool TWAPs by the liquidity at the start of a window.
+type PairLiquidityRecord struct {
+	// Amount of the lexicographically smaller denom of the pair in the pool.
+	Asset0Liquidity cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=asset0_liquidity,json=asset0Liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"asset0_liquidity"`
+	// Amount of the lexicographically larger denom of the pair in the pool.
+	Asset1Liquidity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=asset1_liquidity,json=asset1Liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"asset1_liquidity"`
+}
+
+func (m *PairLiquidityRecord) Reset()         { *m = PairLiquidityRecord{} }
+func (m *PairLiquidityRecord) String() string { return proto.CompactTextString(m) }
+func (*PairLiquidityRecord) ProtoMessage()    {}
+func (*PairLiquidityRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{2}
+}
+func (m *PairLiquidityRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairLiquidityRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairLiquidityRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairLiquidityRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairLiquidityRecord.Merge(m, src)
+}
+func (m *PairLiquidityRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairLiquidityRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairLiquidityRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairLiquidityRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
+	proto.RegisterType((*TwapConfidenceBand)(nil), "osmosis.twap.v1beta1.TwapConfidenceBand")
+	proto.RegisterType((*PairLiquidityRecord)(nil), "osmosis.twap.v1beta1.PairLiquidityRecord")
 }
 
 func init() {
@@ -146,41 +242,53 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x1a, 0x52, 0xba, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x52, 0xc9, 0x0e, 0x41, 0x42,
-	0xe1, 0x80, 0x3f, 0xca, 0x8d, 0x5b, 0xa2, 0x72, 0x00, 0x55, 0xa8, 0x32, 0x3d, 0x71, 0xb1, 0xd6,
-	0xce, 0xd6, 0xb6, 0xb0, 0x33, 0xab, 0xdd, 0x4d, 0x4b, 0xde, 0xa2, 0xaf, 0xc4, 0xad, 0xc7, 0x1e,
-	0x11, 0x07, 0x83, 0x92, 0x1b, 0xc7, 0x3e, 0x01, 0xda, 0x5d, 0x27, 0x90, 0xf0, 0xd5, 0xde, 0x3c,
-	0x33, 0xff, 0xf9, 0xfd, 0x77, 0xbc, 0xa3, 0x45, 0x4f, 0x81, 0x97, 0xc0, 0x73, 0xee, 0x89, 0x73,
-	0x4c, 0xbd, 0xb3, 0x20, 0x26, 0x02, 0x07, 0x2a, 0x88, 0x18, 0x49, 0x80, 0x8d, 0x5d, 0xca, 0x40,
-	0x80, 0xd9, 0xa9, 0x75, 0xae, 0x2c, 0xb9, 0xb5, 0xae, 0xdb, 0x49, 0x21, 0x05, 0x25, 0xf0, 0xe4,
-	0x97, 0xd6, 0x76, 0x1f, 0xa5, 0x00, 0x69, 0x41, 0x3c, 0x15, 0xc5, 0xd3, 0x53, 0x0f, 0x4f, 0x66,
-	0xcb, 0x52, 0xa2, 0x38, 0x91, 0xee, 0xd1, 0x41, 0x5d, 0xb2, 0x75, 0xe4, 0xc5, 0x98, 0x93, 0xd5,
-	0x41, 0x12, 0xc8, 0x27, 0x75, 0xdd, 0xd9, 0xa4, 0x8a, 0xbc, 0x24, 0x5c, 0xe0, 0x92, 0x6a, 0x41,
-	0xff, 0x53, 0x0b, 0xa1, 0x93, 0x73, 0x4c, 0x43, 0x75, 0x6e, 0xf3, 0x21, 0xda, 0xa6, 0x00, 0x45,
-	0x94, 0x8f, 0x2d, 0xa3, 0x67, 0x0c, 0x9a, 0x61, 0x4b, 0x86, 0xaf, 0xc7, 0xe6, 0x63, 0x74, 0x1f,
-	0x73, 0x4e, 0x84, 0x1f, 0x8d, 0xc9, 0x04, 0x4a, 0xeb, 0x4e, 0xcf, 0x18, 0xec, 0x84, 0x6d, 0x9d,
-	0x3b, 0x94, 0xa9, 0x95, 0x24, 0xa8, 0x25, 0x5b, 0xbf, 0x48, 0x02, 0x2d, 0x19, 0xa2, 0x56, 0x46,
-	0xf2, 0x34, 0x13, 0x56, 0xb3, 0x67, 0x0c, 0xb6, 0x46, 0xcf, 0xbe, 0x57, 0xce, 0xae, 0xfe, 0x65,
-	0x91, 0x2e, 0x5c, 0x57, 0x4e, 0x67, 0x86, 0xcb, 0xe2, 0x65, 0x7f, 0x2d, 0xdd, 0x0f, 0xeb, 0x46,
-	0xf3, 0x2d, 0x6a, 0xca, 0x19, 0xac, 0xbb, 0x3d, 0x63, 0xd0, 0x3e, 0xe8, 0xba, 0x7a, 0x40, 0x77,
-	0x39, 0xa0, 0x7b, 0xb2, 0x1c, 0x70, 0x64, 0x5f, 0x56, 0x4e, 0xe3, 0xba, 0x72, 0xcc, 0x35, 0x9e,
-	0x6c, 0xee, 0x5f, 0x7c, 0x75, 0x8c, 0x50, 0x71, 0xcc, 0x63, 0x64, 0x52, 0x3f, 0x2a, 0x30, 0x17,
-	0x11, 0xa7, 0x20, 0x22, 0xca, 0xf2, 0x84, 0x58, 0x2d, 0x79, 0xf6, 0xd1, 0x13, 0x49, 0xf8, 0x52,
-	0x39, 0xfb, 0xfa, 0x2f, 0xf3, 0xf1, 0x07, 0x37, 0x07, 0xaf, 0xc4, 0x22, 0x73, 0x8f, 0x48, 0x8a,
-	0x93, 0xd9, 0x21, 0x49, 0xc2, 0x3d, 0xea, 0x1f, 0x61, 0x2e, 0xde, 0x51, 0x10, 0xc7, 0xb2, 0x57,
-	0x11, 0x83, 0xdf, 0x88, 0xdb, 0xb7, 0x21, 0x06, 0xeb, 0xc4, 0x0c, 0xd9, 0xd4, 0x8f, 0x30, 0xcb,
-	0x45, 0x56, 0x12, 0x91, 0x27, 0x91, 0x5a, 0x35, 0x9c, 0x24, 0xd3, 0x72, 0x5a, 0x60, 0x01, 0xcc,
-	0xba, 0x77, 0x73, 0xfa, 0x3e, 0xf5, 0x87, 0x2b, 0x92, 0xbc, 0xfa, 0xe1, 0x4f, 0x8e, 0x72, 0x0a,
-	0xfe, 0xe9, 0xb4, 0x73, 0x1b, 0xa7, 0xe0, 0xef, 0x4e, 0x18, 0x75, 0x53, 0x02, 0x25, 0x11, 0xec,
-	0x4f, 0x2e, 0xe8, 0xe6, 0x2e, 0xd6, 0x0a, 0xb3, 0x69, 0x71, 0x8a, 0xf6, 0xd4, 0x2d, 0x10, 0xc6,
-	0x80, 0xa9, 0x8b, 0xb7, 0xda, 0xff, 0xdd, 0x9a, 0x7e, 0xbd, 0x35, 0x0f, 0xf4, 0xd6, 0x6c, 0x00,
-	0xf4, 0xe6, 0xec, 0xca, 0xec, 0x2b, 0x99, 0x94, 0x7d, 0xa3, 0x37, 0x97, 0x73, 0xdb, 0xb8, 0x9a,
-	0xdb, 0xc6, 0xb7, 0xb9, 0x6d, 0x5c, 0x2c, 0xec, 0xc6, 0xd5, 0xc2, 0x6e, 0x7c, 0x5e, 0xd8, 0x8d,
-	0xf7, 0x7e, 0x9a, 0x8b, 0x6c, 0x1a, 0xbb, 0x09, 0x94, 0x5e, 0xfd, 0x16, 0x3c, 0x2f, 0x70, 0xcc,
-	0x97, 0x81, 0x77, 0x76, 0x10, 0x78, 0x1f, 0xf5, 0x33, 0x22, 0x66, 0x94, 0xf0, 0xb8, 0xa5, 0x8e,
-	0xf4, 0xe2, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xdb, 0x71, 0xb6, 0x63, 0x04, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcb, 0x6e, 0xd3, 0x4a,
+	0x18, 0xc7, 0xe3, 0x36, 0x27, 0x3d, 0x9d, 0xde, 0xce, 0xf1, 0x69, 0x0f, 0x26, 0x05, 0x27, 0x18,
+	0x09, 0x85, 0x05, 0x76, 0x5c, 0x36, 0x08, 0xc1, 0xa2, 0xa6, 0x48, 0x14, 0x55, 0xa8, 0x32, 0x15,
+	0x42, 0x6c, 0xac, 0xb1, 0x3d, 0x75, 0x46, 0xd8, 0x1e, 0x63, 0x4f, 0xd2, 0xe4, 0x2d, 0xfa, 0x04,
+	0xec, 0x91, 0x78, 0x90, 0x2e, 0xbb, 0x44, 0x2c, 0x02, 0x6a, 0x77, 0x2c, 0xfb, 0x04, 0x68, 0x66,
+	0x1c, 0xe7, 0x52, 0x2e, 0x2e, 0xbb, 0x7c, 0xb7, 0xdf, 0x7f, 0xbe, 0x7c, 0xdf, 0x8c, 0xc1, 0x1d,
+	0x92, 0x45, 0x24, 0xc3, 0x99, 0x41, 0x8f, 0x60, 0x62, 0xf4, 0x4c, 0x17, 0x51, 0x68, 0x72, 0xc3,
+	0x49, 0x91, 0x47, 0x52, 0x5f, 0x4f, 0x52, 0x42, 0x89, 0xbc, 0x9e, 0xe7, 0xe9, 0x2c, 0xa4, 0xe7,
+	0x79, 0xf5, 0xf5, 0x80, 0x04, 0x84, 0x27, 0x18, 0xec, 0x97, 0xc8, 0xad, 0x5f, 0x0f, 0x08, 0x09,
+	0x42, 0x64, 0x70, 0xcb, 0xed, 0x1e, 0x1a, 0x30, 0x1e, 0x8c, 0x42, 0x1e, 0xe7, 0x38, 0xa2, 0x46,
+	0x18, 0x79, 0x48, 0x15, 0x96, 0xe1, 0xc2, 0x0c, 0x15, 0x07, 0xf1, 0x08, 0x8e, 0xf3, 0x78, 0x63,
+	0x96, 0x4a, 0x71, 0x84, 0x32, 0x0a, 0xa3, 0x44, 0x24, 0x68, 0x1f, 0x17, 0x00, 0x38, 0x38, 0x82,
+	0x89, 0xcd, 0xcf, 0x2d, 0x5f, 0x03, 0x0b, 0x09, 0x21, 0xa1, 0x83, 0x7d, 0x45, 0x6a, 0x4a, 0xad,
+	0xaa, 0x5d, 0x63, 0xe6, 0xae, 0x2f, 0xdf, 0x02, 0xcb, 0x30, 0xcb, 0x10, 0x6d, 0x3b, 0x3e, 0x8a,
+	0x49, 0xa4, 0xcc, 0x35, 0xa5, 0xd6, 0xa2, 0xbd, 0x24, 0x7c, 0x3b, 0xcc, 0x55, 0xa4, 0x98, 0x79,
+	0xca, 0xfc, 0x44, 0x8a, 0x29, 0x52, 0xb6, 0x41, 0xad, 0x83, 0x70, 0xd0, 0xa1, 0x4a, 0xb5, 0x29,
+	0xb5, 0xe6, 0xad, 0xbb, 0xdf, 0x86, 0x8d, 0x15, 0xf1, 0x97, 0x39, 0x22, 0x70, 0x31, 0x6c, 0xac,
+	0x0f, 0x60, 0x14, 0x3e, 0xd4, 0xa6, 0xdc, 0x9a, 0x9d, 0x17, 0xca, 0x2f, 0x40, 0x95, 0xf5, 0xa0,
+	0xfc, 0xd5, 0x94, 0x5a, 0x4b, 0x5b, 0x75, 0x5d, 0x34, 0xa8, 0x8f, 0x1a, 0xd4, 0x0f, 0x46, 0x0d,
+	0x5a, 0xea, 0xc9, 0xb0, 0x51, 0xb9, 0x18, 0x36, 0xe4, 0x29, 0x1e, 0x2b, 0xd6, 0x8e, 0xbf, 0x34,
+	0x24, 0x9b, 0x73, 0xe4, 0x7d, 0x20, 0x27, 0x6d, 0x27, 0x84, 0x19, 0x75, 0xb2, 0x84, 0x50, 0x27,
+	0x49, 0xb1, 0x87, 0x94, 0x1a, 0x3b, 0xbb, 0x75, 0x9b, 0x11, 0x3e, 0x0f, 0x1b, 0x9b, 0xe2, 0x5f,
+	0xce, 0xfc, 0xb7, 0x3a, 0x26, 0x46, 0x04, 0x69, 0x47, 0xdf, 0x43, 0x01, 0xf4, 0x06, 0x3b, 0xc8,
+	0xb3, 0xd7, 0x92, 0xf6, 0x1e, 0xcc, 0xe8, 0xcb, 0x84, 0xd0, 0x7d, 0x56, 0xcb, 0x89, 0xe6, 0x25,
+	0xe2, 0xc2, 0x55, 0x88, 0xe6, 0x34, 0xb1, 0x03, 0xd4, 0xa4, 0xed, 0xc0, 0x14, 0xd3, 0x4e, 0x84,
+	0x28, 0xf6, 0x1c, 0xbe, 0x6a, 0xd0, 0xf3, 0xba, 0x51, 0x37, 0x84, 0x94, 0xa4, 0xca, 0xdf, 0xe5,
+	0xe9, 0x9b, 0x49, 0x7b, 0xbb, 0x20, 0xb1, 0xd1, 0x6f, 0x8f, 0x39, 0x5c, 0xc9, 0xfc, 0xa5, 0xd2,
+	0xe2, 0x55, 0x94, 0xcc, 0x9f, 0x2b, 0x41, 0x50, 0x0f, 0x10, 0x89, 0x10, 0x4d, 0x7f, 0xa4, 0x02,
+	0xca, 0xab, 0x28, 0x05, 0x66, 0x56, 0x02, 0x03, 0x75, 0x2c, 0xd1, 0x83, 0x29, 0x86, 0xb1, 0x87,
+	0xa6, 0x64, 0x96, 0xcb, 0xcb, 0xdc, 0x28, 0x50, 0xaf, 0x72, 0xd2, 0xa4, 0xd4, 0x21, 0x58, 0xe3,
+	0x03, 0x47, 0x69, 0x4a, 0x52, 0xbe, 0x63, 0xca, 0xd2, 0x6f, 0x17, 0x54, 0xcb, 0x17, 0xf4, 0x7f,
+	0xb1, 0xa0, 0x33, 0x00, 0xb1, 0xa4, 0x2b, 0xcc, 0xfb, 0x94, 0x39, 0x59, 0x9d, 0xf6, 0x7e, 0x1e,
+	0xc8, 0xac, 0xcd, 0x27, 0x24, 0x3e, 0xc4, 0x3e, 0x8a, 0x3d, 0x64, 0xc1, 0xd8, 0x67, 0xf2, 0x33,
+	0x33, 0xe3, 0xd7, 0x77, 0xd1, 0x7a, 0x5c, 0xa2, 0xb5, 0xf1, 0x09, 0x66, 0x18, 0x9a, 0xbd, 0x0a,
+	0xa7, 0xe6, 0x27, 0xbf, 0x06, 0xa0, 0x47, 0x42, 0x48, 0x71, 0x88, 0xe9, 0x40, 0xbc, 0x01, 0xd6,
+	0x83, 0x72, 0x12, 0xff, 0x0a, 0x89, 0x71, 0xb9, 0x66, 0x4f, 0xb0, 0x64, 0x17, 0xac, 0x46, 0x38,
+	0x9e, 0xbc, 0x30, 0xfc, 0xf9, 0xb0, 0x1e, 0x95, 0xa3, 0x6f, 0x08, 0xfa, 0x34, 0x42, 0xb3, 0x97,
+	0x23, 0x1c, 0x8f, 0xaf, 0x11, 0xd3, 0x80, 0xfd, 0x49, 0x8d, 0xea, 0x9f, 0x68, 0xc0, 0xfe, 0x8c,
+	0x06, 0xec, 0x17, 0x1a, 0xda, 0x07, 0x09, 0xfc, 0xb7, 0x0f, 0x71, 0xba, 0x87, 0xdf, 0x75, 0xb1,
+	0x8f, 0xe9, 0x20, 0x7f, 0x58, 0x9f, 0x81, 0x7f, 0xf2, 0xf7, 0x33, 0x1c, 0x45, 0xf2, 0x11, 0xdd,
+	0xcc, 0xd5, 0x37, 0x2e, 0xab, 0xef, 0xc6, 0xd4, 0x5e, 0x13, 0x65, 0x05, 0xaf, 0x20, 0x99, 0x13,
+	0xa4, 0xb9, 0xf2, 0x24, 0xb3, 0x20, 0x59, 0xcf, 0x4f, 0xce, 0x54, 0xe9, 0xf4, 0x4c, 0x95, 0xbe,
+	0x9e, 0xa9, 0xd2, 0xf1, 0xb9, 0x5a, 0x39, 0x3d, 0x57, 0x2b, 0x9f, 0xce, 0xd5, 0xca, 0x9b, 0x76,
+	0x80, 0x69, 0xa7, 0xeb, 0xea, 0x1e, 0x89, 0x8c, 0xfc, 0x1b, 0x76, 0x2f, 0x84, 0x6e, 0x36, 0x32,
+	0x8c, 0xde, 0x96, 0x69, 0xf4, 0xc5, 0xe7, 0x8f, 0x0e, 0x12, 0x94, 0xb9, 0x35, 0xbe, 0xdf, 0xf7,
+	0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xde, 0x39, 0x99, 0x48, 0x1b, 0x07, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +311,16 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GeometricVarianceAccumulator.Size()
+		i -= size
+		if _, err := m.GeometricVarianceAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -296,6 +414,112 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapConfidenceBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapConfidenceBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapConfidenceBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSpotPrice.Size()
+		i -= size
+		if _, err := m.MaxSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSpotPrice.Size()
+		i -= size
+		if _, err := m.MinSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairLiquidityRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairLiquidityRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairLiquidityRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Asset1Liquidity.Size()
+		i -= size
+		if _, err := m.Asset1Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Asset0Liquidity.Size()
+		i -= size
+		if _, err := m.Asset0Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -341,6 +565,38 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.GeometricVarianceAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *TwapConfidenceBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.MinSpotPrice.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.MaxSpotPrice.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *PairLiquidityRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset0Liquidity.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Asset1Liquidity.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
 	return n
 }
 
@@ -717,6 +973,344 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricVarianceAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricVarianceAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapConfidenceBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapConfidenceBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapConfidenceBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairLiquidityRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairLiquidityRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairLiquidityRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset0Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])